@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/apiclient"
+	"github.com/georgisomnoev/feature-flag-api/internal/coderefs"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+)
+
+const requestTimeout = 30 * time.Second
+
+type patternList []string
+
+func (p *patternList) String() string {
+	return strings.Join(*p, ", ")
+}
+
+func (p *patternList) Set(value string) error {
+	*p = append(*p, value)
+	return nil
+}
+
+type options struct {
+	dir        string
+	keys       string
+	patterns   patternList
+	exclude    string
+	output     string
+	upload     bool
+	apiURL     string
+	token      string
+	repository string
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.dir, "dir", ".", "source tree to scan")
+	flag.StringVar(&opts.keys, "keys", "", "comma separated flag keys to look for; fetched from the API when empty")
+	flag.Var(&opts.patterns, "pattern", "custom regular expression with "+coderefs.KeyPlaceholder+
+		" in place of the flag key; can be repeated and replaces the language defaults")
+	flag.StringVar(&opts.exclude, "exclude", strings.Join(coderefs.DefaultExcludedDirs, ","), "comma separated directory names to skip")
+	flag.StringVar(&opts.output, "output", "table", "output format: table or json")
+	flag.BoolVar(&opts.upload, "upload", false, "upload the references to the API")
+	flag.StringVar(&opts.apiURL, "api-url", "http://127.0.0.1:8080", "feature flags API base URL")
+	flag.StringVar(&opts.token, "token", os.Getenv("FEATURE_FLAGS_TOKEN"), "API bearer token, defaults to $FEATURE_FLAGS_TOKEN")
+	flag.StringVar(&opts.repository, "repository", "", "repository name used for the upload, defaults to the scanned directory name")
+	flag.Parse()
+
+	if err := run(opts, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "coderefs: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(opts options, w io.Writer) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	client := apiclient.NewClient(opts.apiURL, opts.token, nil)
+
+	keys := splitList(opts.keys)
+	if len(keys) == 0 {
+		var err error
+		if keys, err = fetchKeys(ctx, client); err != nil {
+			return fmt.Errorf("failed fetching flag keys: %w", err)
+		}
+	}
+
+	scanner, err := coderefs.NewScanner(keys, opts.patterns, splitList(opts.exclude))
+	if err != nil {
+		return err
+	}
+	result, err := scanner.Scan(opts.dir)
+	if err != nil {
+		return err
+	}
+
+	if opts.upload {
+		if err := upload(ctx, client, opts, result); err != nil {
+			return fmt.Errorf("failed uploading code references: %w", err)
+		}
+	}
+
+	switch opts.output {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	case "table":
+		return printTable(w, result)
+	default:
+		return fmt.Errorf("unsupported output format: %s", opts.output)
+	}
+}
+
+func fetchKeys(ctx context.Context, client *apiclient.Client) ([]string, error) {
+	flags, err := client.ListFlags(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, len(flags))
+	for i, flag := range flags {
+		keys[i] = flag.Key
+	}
+	return keys, nil
+}
+
+func upload(ctx context.Context, client *apiclient.Client, opts options, result coderefs.Result) error {
+	repository := opts.repository
+	if repository == "" {
+		absDir, err := filepath.Abs(opts.dir)
+		if err != nil {
+			return err
+		}
+		repository = filepath.Base(absDir)
+	}
+
+	body := model.CodeReferencesUpload{
+		Repository: repository,
+		References: make([]model.CodeReferenceEntry, len(result.References)),
+	}
+	for i, reference := range result.References {
+		body.References[i] = model.CodeReferenceEntry{Key: reference.Key, Path: reference.Path, Line: reference.Line}
+	}
+
+	return client.UploadCodeReferences(ctx, body)
+}
+
+func printTable(w io.Writer, result coderefs.Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tLOCATION")
+	for _, reference := range result.References {
+		fmt.Fprintf(tw, "%s\t%s:%d\n", reference.Key, reference.Path, reference.Line)
+	}
+	for _, key := range result.Unreferenced {
+		fmt.Fprintf(tw, "%s\t(unreferenced)\n", key)
+	}
+
+	return tw.Flush()
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -1,26 +1,46 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
 
 	"github.com/georgisomnoev/feature-flag-api/internal/auth"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/apikey"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/denylist"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/oidc"
 	"github.com/georgisomnoev/feature-flag-api/internal/config"
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/notifier"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
 	"github.com/georgisomnoev/feature-flag-api/internal/healthcheck"
 	"github.com/georgisomnoev/feature-flag-api/internal/healthcheck/component"
 	"github.com/georgisomnoev/feature-flag-api/internal/jwthelper"
 	"github.com/georgisomnoev/feature-flag-api/internal/lifecycle"
 	"github.com/georgisomnoev/feature-flag-api/internal/observability"
-	"github.com/georgisomnoev/feature-flag-api/internal/pg"
+	"github.com/georgisomnoev/feature-flag-api/internal/storage"
 	"github.com/georgisomnoev/feature-flag-api/internal/webapi"
+	"github.com/georgisomnoev/feature-flag-api/internal/webapi/ratelimit"
+	"github.com/labstack/echo/v4"
 )
 
-const serviceName = "featureflagsapi"
+const (
+	serviceName = "featureflagsapi"
+
+	denylistSyncInterval     = 10 * time.Second
+	oidcKeysRefreshInterval  = 15 * time.Minute
+	jwtKeysReloadInterval    = time.Minute
+	rateLimitCleanupInterval = time.Minute
+	expiryWebhookTimeout     = 10 * time.Second
+)
 
 func main() {
 	appCtx := lifecycle.CreateAppContext()
 
 	cfg := config.Load()
+	logger := slog.Default()
 
 	if cfg.OtelCollectorEnabled {
 		if err := observability.InitOtel(appCtx, cfg.OtelCollectorHost, serviceName); err != nil {
@@ -28,34 +48,70 @@ func main() {
 		}
 	}
 
-	srv := webapi.NewWebAPI()
-
-	dbCfg := pg.PoolConfig{
-		MinConns:          cfg.DBMinConns,
-		MaxConns:          cfg.DBMaxConns,
-		MaxConnLifetime:   cfg.DBMaxConnLifetime,
-		MaxConnIdleTime:   cfg.DBMaxConnIdleTime,
-		HealthCheckPeriod: cfg.DBHealthCheck,
-	}
-	pool, err := pg.InitPool(appCtx, cfg.DBConnectionURL, dbCfg)
+	store, err := storage.Open(appCtx, cfg.Storage())
 	if err != nil {
-		panic(fmt.Errorf("failed initializing DB pool: %w", err))
+		panic(fmt.Errorf("failed initializing storage: %w", err))
 	}
-	defer pool.Close()
+	defer store.Close()
 
 	jwtHelper, err := jwthelper.NewJWTHelper(
 		cfg.JWTPrivateKeyPath,
 		cfg.JWTPublicKeyPath,
+		cfg.JWTVerifyKeysDir,
+		cfg.TokenIssuer,
+		logger,
 	)
 	if err != nil {
 		panic(fmt.Errorf("failed initializing JWT helper: %w", err))
 	}
+	go jwtHelper.Start(appCtx, jwtKeysReloadInterval)
+
+	var oidcProviders []oidc.ProviderConfig
+	if cfg.OIDCConfigPath != "" {
+		oidcProviders, err = oidc.LoadConfig(cfg.OIDCConfigPath)
+		if err != nil {
+			panic(fmt.Errorf("failed loading OIDC providers: %w", err))
+		}
+	}
+	tokenValidator := oidc.NewValidator(jwtHelper, store.Auth, oidcProviders, logger)
+	go tokenValidator.Start(appCtx, oidcKeysRefreshInterval)
+
+	ipExtractor, err := webapi.IPExtractor(cfg.TrustedProxies)
+	if err != nil {
+		panic(fmt.Errorf("failed configuring trusted proxies: %w", err))
+	}
+	apiKeys := apikey.NewAuthenticator(store.Auth, logger)
+	var middlewares []echo.MiddlewareFunc
+	if cfg.RateLimitEnabled {
+		var counter ratelimit.Counter = ratelimit.NewMemoryCounter()
+		if cfg.RateLimitShared {
+			if store.RateLimits == nil {
+				panic(errors.New("shared rate limits require the postgres storage backend"))
+			}
+			counter = store.RateLimits
+			go store.RateLimits.Start(appCtx, rateLimitCleanupInterval, logger)
+		}
+		middlewares = append(middlewares, ratelimit.NewLimiter(cfg.RateLimits, counter).Middleware())
+	}
+	srv := webapi.NewWebAPI(ipExtractor, middlewares...)
+	grpcSrv := webapi.NewGRPCServer(logger)
+
+	tokenDenylist := denylist.New(store.Auth, logger)
+	go tokenDenylist.Start(appCtx, denylistSyncInterval)
+
+	var expiryNotifier service.Notifier = notifier.NewLog(logger)
+	if cfg.ExpiryWebhookURL != "" {
+		expiryNotifier = notifier.NewWebhook(cfg.ExpiryWebhookURL, &http.Client{Timeout: expiryWebhookTimeout})
+	}
 
-	authStore := auth.Process(pool, srv, jwtHelper)
-	featureflags.Process(pool, srv, authStore, jwtHelper)
+	auth.Process(store.Auth, tokenDenylist, srv, jwtHelper, oidc.MaxTokenLifetime(oidcProviders), middlewares...)
+	featureflags.Process(appCtx, store.FeatureFlags, srv, grpcSrv, store.Auth, tokenDenylist, tokenValidator, apiKeys, cfg.Environment,
+		logger,
+		expiryNotifier,
+		middlewares...)
 
-	dbComp := component.NewDBComponent(pool)
+	dbComp := component.NewDBComponent(store)
 	healthcheck.Process(srv, dbComp)
 
-	webapi.Start(appCtx, srv, cfg.APIPort)
+	webapi.Start(appCtx, srv, cfg.APIPort, grpcSrv, cfg.GRPCPort)
 }
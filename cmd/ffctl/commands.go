@@ -0,0 +1,470 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/apiclient"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// flagFields are the flag attributes that can be set from the command line on create and update.
+type flagFields struct {
+	key         string
+	description string
+	enabled     bool
+	owner       string
+	ownerType   string
+	kind        string
+	maintainers stringList
+	tags        stringList
+	file        string
+}
+
+func (f *flagFields) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.key, "key", "", "flag key")
+	fs.StringVar(&f.description, "description", "", "flag description")
+	fs.BoolVar(&f.enabled, "enabled", false, "whether the flag is enabled")
+	fs.StringVar(&f.owner, "owner", "", "owning user or team")
+	fs.StringVar(&f.ownerType, "owner-type", "", "owner type: user or team")
+	fs.StringVar(&f.kind, "kind", "", "flag kind: release, experiment, ops, permission or kill-switch")
+	fs.Var(&f.maintainers, "maintainer", "maintainer, can be repeated")
+	fs.Var(&f.tags, "tag", "tag, can be repeated")
+	fs.StringVar(&f.file, "f", "", "JSON or YAML file with the flag definition")
+}
+
+// apply overrides the request with the flags that were explicitly set on the command line.
+func (f *flagFields) apply(fs *flag.FlagSet, req *model.FeatureFlagRequest) {
+	fs.Visit(func(set *flag.Flag) {
+		switch set.Name {
+		case "key":
+			req.Key = f.key
+		case "description":
+			req.Description = f.description
+		case "enabled":
+			req.Enabled = f.enabled
+		case "owner":
+			req.Owner = f.owner
+		case "owner-type":
+			req.OwnerType = model.OwnerType(f.ownerType)
+		case "kind":
+			req.Kind = model.FlagKind(f.kind)
+		case "maintainer":
+			req.Maintainers = f.maintainers
+		case "tag":
+			req.Tags = f.tags
+		}
+	})
+}
+
+func loginCommand(ctx context.Context, app *app, args []string) error {
+	fs := newFlagSet("login", app)
+	username := fs.String("username", "", "user name")
+	password := fs.String("password", os.Getenv("FFCTL_PASSWORD"), "password, defaults to $FFCTL_PASSWORD")
+	apiURL := fs.String("api-url", app.config.APIURL, "feature flags API base URL")
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	if *username == "" || *password == "" {
+		return fmt.Errorf("%w: username and password are required", errUsage)
+	}
+
+	token, err := apiclient.NewClient(*apiURL, "", nil).Login(ctx, *username, *password)
+	if err != nil {
+		return err
+	}
+
+	app.config.APIURL = *apiURL
+	app.config.Token = token
+	if err := saveConfig(app.configPath, app.config); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(app.stdout, "Logged in as %s, token saved to %s\n", *username, app.configPath)
+	return nil
+}
+
+func listCommand(ctx context.Context, app *app, args []string) error {
+	fs := newFlagSet("list", app)
+	owner := fs.String("owner", "", "only flags of this owner")
+	maintainer := fs.String("maintainer", "", "only flags with this maintainer")
+	kind := fs.String("kind", "", "only flags of this kind")
+	var tags stringList
+	fs.Var(&tags, "tag", "only flags with this tag, can be repeated")
+	output := fs.String("o", outputTable, "output format: table, json or yaml")
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	for name, value := range map[string]string{"owner": *owner, "maintainer": *maintainer, "kind": *kind} {
+		if value != "" {
+			query.Set(name, value)
+		}
+	}
+	for _, tag := range tags {
+		query.Add("tag", tag)
+	}
+
+	flags, err := app.client().ListFlags(ctx, query)
+	if err != nil {
+		return err
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Key < flags[j].Key })
+
+	return writeOutput(app.stdout, *output, flags, func(tw *tabwriter.Writer) {
+		printFlagsTable(tw, flags)
+	})
+}
+
+func getCommand(ctx context.Context, app *app, args []string) error {
+	fs := newFlagSet("get", app)
+	output := fs.String("o", outputTable, "output format: table, json or yaml")
+	positional, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	client := app.client()
+	flagID, err := resolveFlagID(ctx, client, positional[0])
+	if err != nil {
+		return err
+	}
+	details, err := client.GetFlag(ctx, flagID)
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(app.stdout, *output, details, func(tw *tabwriter.Writer) {
+		printFlagDetailsTable(tw, details)
+	})
+}
+
+func createCommand(ctx context.Context, app *app, args []string) error {
+	fs := newFlagSet("create", app)
+	var fields flagFields
+	fields.register(fs)
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	var req model.FeatureFlagRequest
+	if fields.file != "" {
+		definition, err := readSingleDefinition(fields.file)
+		if err != nil {
+			return err
+		}
+		req = definition
+	}
+	fields.apply(fs, &req)
+	if req.Key == "" {
+		return fmt.Errorf("%w: a key is required", errUsage)
+	}
+
+	flagID, err := app.client().CreateFlag(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(app.stdout, "Created flag %s (%s)\n", req.Key, flagID)
+	return nil
+}
+
+func updateCommand(ctx context.Context, app *app, args []string) error {
+	fs := newFlagSet("update", app)
+	var fields flagFields
+	fields.register(fs)
+	positional, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	client := app.client()
+	current, err := resolveFlag(ctx, client, positional[0])
+	if err != nil {
+		return err
+	}
+
+	req := current.Request()
+	if fields.file != "" {
+		if req, err = readSingleDefinition(fields.file); err != nil {
+			return err
+		}
+	}
+	fields.apply(fs, &req)
+
+	if err := client.UpdateFlag(ctx, current.ID, req); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(app.stdout, "Updated flag %s\n", req.Key)
+	return nil
+}
+
+func toggleCommand(ctx context.Context, app *app, args []string) error {
+	fs := newFlagSet("toggle", app)
+	on := fs.Bool("on", false, "enable the flag")
+	off := fs.Bool("off", false, "disable the flag")
+	positional, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	if *on && *off {
+		return fmt.Errorf("%w: -on and -off are mutually exclusive", errUsage)
+	}
+
+	client := app.client()
+	current, err := resolveFlag(ctx, client, positional[0])
+	if err != nil {
+		return err
+	}
+
+	req := current.Request()
+	switch {
+	case *on:
+		req.Enabled = true
+	case *off:
+		req.Enabled = false
+	default:
+		req.Enabled = !current.Enabled
+	}
+
+	if err := client.UpdateFlag(ctx, current.ID, req); err != nil {
+		return err
+	}
+
+	state := "disabled"
+	if req.Enabled {
+		state = "enabled"
+	}
+	fmt.Fprintf(app.stdout, "Flag %s is now %s\n", current.Key, state)
+	return nil
+}
+
+func deleteCommand(ctx context.Context, app *app, args []string) error {
+	fs := newFlagSet("delete", app)
+	positional, err := parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	client := app.client()
+	current, err := resolveFlag(ctx, client, positional[0])
+	if err != nil {
+		return err
+	}
+	if err := client.DeleteFlag(ctx, current.ID); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(app.stdout, "Deleted flag %s\n", current.Key)
+	return nil
+}
+
+func exportCommand(ctx context.Context, app *app, args []string) error {
+	fs := newFlagSet("export", app)
+	output := fs.String("o", outputYAML, "output format: json or yaml")
+	file := fs.String("file", "", "write the export to this file instead of stdout")
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+
+	flags, err := app.client().ListFlags(ctx, nil)
+	if err != nil {
+		return err
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Key < flags[j].Key })
+
+	definitions := make([]model.FeatureFlagRequest, len(flags))
+	for i, flag := range flags {
+		definitions[i] = flag.Request()
+	}
+
+	var w io.Writer = app.stdout
+	if *file != "" {
+		f, err := os.Create(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	return writeOutput(w, *output, definitions, nil)
+}
+
+// importCommand creates the flags from the file that do not exist yet and updates the ones
+// whose definition changed, matching them by key.
+func importCommand(ctx context.Context, app *app, args []string) error {
+	fs := newFlagSet("import", app)
+	file := fs.String("f", "", "JSON or YAML file with the flag definitions")
+	dryRun := fs.Bool("dry-run", false, "only print what would change")
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	if *file == "" {
+		return fmt.Errorf("%w: -f is required", errUsage)
+	}
+
+	definitions, err := readDefinitions(*file)
+	if err != nil {
+		return err
+	}
+
+	client := app.client()
+	flags, err := client.ListFlags(ctx, nil)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]model.FeatureFlag, len(flags))
+	for _, flag := range flags {
+		existing[flag.Key] = flag
+	}
+
+	var errs []error
+	for _, definition := range definitions {
+		if definition.Key == "" {
+			errs = append(errs, errors.New("definition without a key"))
+			continue
+		}
+
+		current, found := existing[definition.Key]
+		action := "created"
+		switch {
+		case !found:
+			if !*dryRun {
+				_, err = client.CreateFlag(ctx, definition)
+			}
+		case sameDefinition(current.Request(), definition):
+			fmt.Fprintf(app.stdout, "unchanged %s\n", definition.Key)
+			continue
+		default:
+			action = "updated"
+			if !*dryRun {
+				err = client.UpdateFlag(ctx, current.ID, definition)
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", definition.Key, err))
+			continue
+		}
+		fmt.Fprintf(app.stdout, "%s %s\n", action, definition.Key)
+	}
+
+	return errors.Join(errs...)
+}
+
+func newFlagSet(name string, app *app) *flag.FlagSet {
+	fs := flag.NewFlagSet("ffctl "+name, flag.ContinueOnError)
+	fs.SetOutput(app.stderr)
+	return fs
+}
+
+// parseArgs parses flags that may appear before or after the positional arguments and checks
+// that exactly the expected number of positional arguments was given.
+func parseArgs(fs *flag.FlagSet, args []string, positionalCount int) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%w: %v", errUsage, err)
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+
+	if len(positional) != positionalCount {
+		return nil, fmt.Errorf("%w: expected %d argument(s), got %d", errUsage, positionalCount, len(positional))
+	}
+	return positional, nil
+}
+
+func resolveFlagID(ctx context.Context, client *apiclient.Client, idOrKey string) (uuid.UUID, error) {
+	if id, err := uuid.Parse(idOrKey); err == nil {
+		return id, nil
+	}
+
+	flag, err := findFlagByKey(ctx, client, idOrKey)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return flag.ID, nil
+}
+
+func resolveFlag(ctx context.Context, client *apiclient.Client, idOrKey string) (model.FeatureFlag, error) {
+	if id, err := uuid.Parse(idOrKey); err == nil {
+		details, err := client.GetFlag(ctx, id)
+		if err != nil {
+			return model.FeatureFlag{}, err
+		}
+		return details.FeatureFlag, nil
+	}
+
+	return findFlagByKey(ctx, client, idOrKey)
+}
+
+func findFlagByKey(ctx context.Context, client *apiclient.Client, key string) (model.FeatureFlag, error) {
+	flags, err := client.ListFlags(ctx, nil)
+	if err != nil {
+		return model.FeatureFlag{}, err
+	}
+	for _, flag := range flags {
+		if flag.Key == key {
+			return flag, nil
+		}
+	}
+	return model.FeatureFlag{}, fmt.Errorf("no flag with key %q", key)
+}
+
+func readSingleDefinition(path string) (model.FeatureFlagRequest, error) {
+	definitions, err := readDefinitions(path)
+	if err != nil {
+		return model.FeatureFlagRequest{}, err
+	}
+	if len(definitions) != 1 {
+		return model.FeatureFlagRequest{}, fmt.Errorf("%w: %s must contain exactly one flag definition", errUsage, path)
+	}
+	return definitions[0], nil
+}
+
+func sameDefinition(a, b model.FeatureFlagRequest) bool {
+	first, errFirst := json.Marshal(normalizeDefinition(a))
+	second, errSecond := json.Marshal(normalizeDefinition(b))
+	return errFirst == nil && errSecond == nil && bytes.Equal(first, second)
+}
+
+func normalizeDefinition(req model.FeatureFlagRequest) model.FeatureFlagRequest {
+	req = req.WithDefaults()
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.UTC()
+		req.ExpiresAt = &expiresAt
+	}
+	return req
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+)
+
+var _ = Describe("Commands", func() {
+	var (
+		c        *cli
+		checkout model.FeatureFlag
+		payments model.FeatureFlag
+	)
+
+	BeforeEach(func() {
+		c = newCLI()
+		checkout = c.api.addFlag(model.FeatureFlagRequest{
+			Key: "checkout", Description: "New checkout", Enabled: true, Owner: "payments-team", OwnerType: model.OwnerTypeTeam, Tags: []string{"web", "beta"},
+		})
+		payments = c.api.addFlag(model.FeatureFlagRequest{
+			Key: "payments", Description: "Payments v2", Owner: "jane", OwnerType: model.OwnerTypeUser,
+		})
+	})
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), name)
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+		return path
+	}
+
+	Describe("list", func() {
+		It("prints the flags sorted by key as a table", func() {
+			Expect(c.run("list")).To(BeZero())
+
+			lines := strings.Split(strings.TrimSpace(c.stdout.String()), "\n")
+			Expect(lines).To(HaveLen(3))
+			Expect(strings.Fields(lines[0])).To(Equal([]string{"ID", "KEY", "ENABLED", "KIND", "OWNER", "TAGS"}))
+			Expect(strings.Fields(lines[1])).To(Equal([]string{checkout.ID.String(), "checkout", "true", "release", "payments-team", "web,beta"}))
+			Expect(strings.Fields(lines[2])).To(Equal([]string{payments.ID.String(), "payments", "false", "release", "jane"}))
+		})
+
+		It("prints the flags as JSON", func() {
+			Expect(c.run("list", "-o", "json")).To(BeZero())
+
+			var flags []model.FeatureFlag
+			Expect(json.Unmarshal(c.stdout.Bytes(), &flags)).To(Succeed())
+			Expect(flags).To(HaveLen(2))
+			Expect(flags[0].Key).To(Equal("checkout"))
+			Expect(flags[1].Key).To(Equal("payments"))
+		})
+
+		It("prints the flags as YAML with the API field names", func() {
+			Expect(c.run("list", "-o", "yaml")).To(BeZero())
+			Expect(c.stdout.String()).To(ContainSubstring("owner_type: team"))
+
+			var flags []map[string]any
+			Expect(yaml.Unmarshal(c.stdout.Bytes(), &flags)).To(Succeed())
+			Expect(flags).To(HaveLen(2))
+			Expect(flags[0]).To(HaveKeyWithValue("key", "checkout"))
+		})
+
+		It("passes the filters to the API", func() {
+			Expect(c.run("list", "-owner", "jane", "-o", "json")).To(BeZero())
+
+			var flags []model.FeatureFlag
+			Expect(json.Unmarshal(c.stdout.Bytes(), &flags)).To(Succeed())
+			Expect(flags).To(HaveLen(1))
+			Expect(flags[0].Key).To(Equal("payments"))
+		})
+	})
+
+	Describe("get", func() {
+		It("prints the flag found by key", func() {
+			Expect(c.run("get", "checkout")).To(BeZero())
+			Expect(c.stdout.String()).To(MatchRegexp(`ID:\s+` + checkout.ID.String()))
+			Expect(c.stdout.String()).To(MatchRegexp(`Owner:\s+team payments-team`))
+			Expect(c.stdout.String()).To(MatchRegexp(`Tags:\s+web, beta`))
+		})
+
+		It("prints the flag found by ID", func() {
+			Expect(c.run("get", payments.ID.String(), "-o", "json")).To(BeZero())
+
+			var details model.FlagDetails
+			Expect(json.Unmarshal(c.stdout.Bytes(), &details)).To(Succeed())
+			Expect(details.ID).To(Equal(payments.ID))
+			Expect(details.Key).To(Equal("payments"))
+		})
+	})
+
+	Describe("create", func() {
+		It("creates the flag from the options", func() {
+			Expect(c.run("create", "-key", "search", "-description", "New search", "-enabled", "-tag", "web", "-tag", "api")).To(BeZero())
+
+			flag, ok := c.api.flag("search")
+			Expect(ok).To(BeTrue())
+			Expect(c.stdout.String()).To(Equal("Created flag search (" + flag.ID.String() + ")\n"))
+			Expect(flag.Description).To(Equal("New search"))
+			Expect(flag.Enabled).To(BeTrue())
+			Expect(flag.Tags).To(Equal([]string{"web", "api"}))
+		})
+
+		It("lets the options override the definition file", func() {
+			path := writeFile("search.yaml", "key: search\ndescription: From the file\nowner: jane\nowner_type: user\n")
+			Expect(c.run("create", "-f", path, "-description", "From the options")).To(BeZero())
+
+			flag, ok := c.api.flag("search")
+			Expect(ok).To(BeTrue())
+			Expect(flag.Description).To(Equal("From the options"))
+			Expect(flag.Owner).To(Equal("jane"))
+		})
+
+		It("exits with 2 when the file holds several definitions", func() {
+			path := writeFile("flags.yaml", "- key: one\n- key: two\n")
+			Expect(c.run("create", "-f", path)).To(Equal(exitUsage))
+		})
+	})
+
+	Describe("update", func() {
+		It("changes only the given options", func() {
+			Expect(c.run("update", "checkout", "-description", "Checkout v2")).To(BeZero())
+			Expect(c.stdout.String()).To(Equal("Updated flag checkout\n"))
+
+			flag, _ := c.api.flag("checkout")
+			Expect(flag.Description).To(Equal("Checkout v2"))
+			Expect(flag.Enabled).To(BeTrue())
+			Expect(flag.Tags).To(Equal([]string{"web", "beta"}))
+		})
+	})
+
+	Describe("toggle", func() {
+		It("flips the flag", func() {
+			Expect(c.run("toggle", "checkout")).To(BeZero())
+			Expect(c.stdout.String()).To(Equal("Flag checkout is now disabled\n"))
+
+			flag, _ := c.api.flag("checkout")
+			Expect(flag.Enabled).To(BeFalse())
+		})
+
+		It("sets the requested state", func() {
+			Expect(c.run("toggle", payments.ID.String(), "-on")).To(BeZero())
+			Expect(c.stdout.String()).To(Equal("Flag payments is now enabled\n"))
+
+			Expect(c.run("toggle", "payments", "-on")).To(BeZero())
+			flag, _ := c.api.flag("payments")
+			Expect(flag.Enabled).To(BeTrue())
+		})
+	})
+
+	Describe("delete", func() {
+		It("deletes the flag", func() {
+			Expect(c.run("delete", "payments")).To(BeZero())
+			Expect(c.stdout.String()).To(Equal("Deleted flag payments\n"))
+
+			_, ok := c.api.flag("payments")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("export and import", func() {
+		It("exports the definitions as YAML by default", func() {
+			Expect(c.run("export")).To(BeZero())
+
+			var definitions []map[string]any
+			Expect(yaml.Unmarshal(c.stdout.Bytes(), &definitions)).To(Succeed())
+			Expect(definitions).To(HaveLen(2))
+			Expect(definitions[0]).To(HaveKeyWithValue("key", "checkout"))
+			Expect(definitions[0]).To(HaveKeyWithValue("owner_type", "team"))
+			Expect(definitions[0]).NotTo(HaveKey("id"))
+		})
+
+		It("writes the export to a file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "flags.json")
+			Expect(c.run("export", "-o", "json", "-file", path)).To(BeZero())
+			Expect(c.stdout.String()).To(BeEmpty())
+
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			var definitions []model.FeatureFlagRequest
+			Expect(json.Unmarshal(data, &definitions)).To(Succeed())
+			Expect(definitions).To(HaveLen(2))
+		})
+
+		It("leaves the flags unchanged when importing an export", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "flags.yaml")
+			Expect(c.run("export", "-file", path)).To(BeZero())
+
+			Expect(c.run("import", "-f", path)).To(BeZero())
+			Expect(c.stdout.String()).To(Equal("unchanged checkout\nunchanged payments\n"))
+		})
+
+		It("creates and updates the flags by key", func() {
+			path := writeFile("flags.yaml", `
+- key: checkout
+  description: New checkout
+  enabled: false
+  owner: payments-team
+  owner_type: team
+  tags: [web, beta]
+- key: search
+  description: New search
+`)
+			Expect(c.run("import", "-f", path)).To(BeZero())
+			Expect(c.stdout.String()).To(Equal("updated checkout\ncreated search\n"))
+
+			flag, _ := c.api.flag("checkout")
+			Expect(flag.Enabled).To(BeFalse())
+			_, ok := c.api.flag("search")
+			Expect(ok).To(BeTrue())
+		})
+
+		It("changes nothing on a dry run", func() {
+			path := writeFile("flags.json", `[{"key":"checkout","description":"Changed"},{"key":"search","description":"New search"}]`)
+			Expect(c.run("import", "-f", path, "-dry-run")).To(BeZero())
+			Expect(c.stdout.String()).To(Equal("updated checkout\ncreated search\n"))
+
+			flag, _ := c.api.flag("checkout")
+			Expect(flag.Description).To(Equal("New checkout"))
+			_, ok := c.api.flag("search")
+			Expect(ok).To(BeFalse())
+		})
+
+		It("exits with 1 for definitions without a key", func() {
+			path := writeFile("flags.json", `[{"description":"No key"},{"key":"search","description":"New search"}]`)
+			Expect(c.run("import", "-f", path)).To(Equal(exitFailure))
+			Expect(c.stdout.String()).To(Equal("created search\n"))
+			Expect(c.stderr.String()).To(ContainSubstring("definition without a key"))
+		})
+	})
+
+	It("exits with 1 when the API is unreachable", func() {
+		c.api.server.Close()
+		Expect(c.run("list")).To(Equal(exitFailure))
+		Expect(c.stderr.String()).To(HavePrefix("ffctl list: "))
+	})
+})
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/apiclient"
+)
+
+const defaultAPIURL = "http://127.0.0.1:8080"
+
+type config struct {
+	APIURL string `json:"api_url"`
+	Token  string `json:"token"`
+	// APIKey is only read from FFCTL_API_KEY and takes precedence over the token.
+	APIKey string `json:"-"`
+}
+
+type app struct {
+	config     config
+	configPath string
+	stdout     io.Writer
+	stderr     io.Writer
+}
+
+func newApp(stdout, stderr io.Writer) (*app, error) {
+	configPath, err := configFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+	if apiURL := os.Getenv("FFCTL_API_URL"); apiURL != "" {
+		cfg.APIURL = apiURL
+	}
+	if token := os.Getenv("FFCTL_TOKEN"); token != "" {
+		cfg.Token = token
+	}
+	cfg.APIKey = os.Getenv("FFCTL_API_KEY")
+	if cfg.APIURL == "" {
+		cfg.APIURL = defaultAPIURL
+	}
+
+	return &app{config: cfg, configPath: configPath, stdout: stdout, stderr: stderr}, nil
+}
+
+func (a *app) client() *apiclient.Client {
+	client := apiclient.NewClient(a.config.APIURL, a.config.Token, nil)
+	if a.config.APIKey != "" {
+		return client.WithAPIKey(a.config.APIKey)
+	}
+	return client
+}
+
+func configFilePath() (string, error) {
+	if path := os.Getenv("FFCTL_CONFIG"); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the config directory: %w", err)
+	}
+	return filepath.Join(dir, "ffctl", "config.json"), nil
+}
+
+func loadConfig(path string) (config, error) {
+	var cfg config
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("failed to read config: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// saveConfig writes the config readable only by the current user, since it holds the token.
+func saveConfig(path string, cfg config) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var c *cli
+
+	BeforeEach(func() {
+		c = newCLI()
+	})
+
+	readConfig := func() config {
+		data, err := os.ReadFile(c.configPath)
+		Expect(err).NotTo(HaveOccurred())
+		var cfg config
+		Expect(json.Unmarshal(data, &cfg)).To(Succeed())
+		return cfg
+	}
+
+	Describe("login", func() {
+		It("saves the token and the API URL readable only by the user", func() {
+			Expect(c.run("login", "-username", "jane", "-password", "secret")).To(BeZero())
+			Expect(c.stdout.String()).To(ContainSubstring("Logged in as jane, token saved to " + c.configPath))
+
+			Expect(readConfig()).To(Equal(config{APIURL: c.api.server.URL, Token: "token-of-jane"}))
+			info, err := os.Stat(c.configPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+		})
+
+		It("reads the password from FFCTL_PASSWORD", func() {
+			setEnv("FFCTL_PASSWORD", "secret")
+			Expect(c.run("login", "-username", "jane")).To(BeZero())
+			Expect(readConfig().Token).To(Equal("token-of-jane"))
+		})
+
+		It("sends the saved token with later requests", func() {
+			Expect(c.run("login", "-username", "jane", "-password", "secret")).To(BeZero())
+			Expect(c.run("list")).To(BeZero())
+			Expect(c.api.lastToken()).To(Equal("Bearer token-of-jane"))
+		})
+
+		It("does not overwrite the config when the login fails", func() {
+			Expect(saveConfig(c.configPath, config{APIURL: c.api.server.URL, Token: "old"})).To(Succeed())
+			Expect(c.run("login", "-username", "jane", "-password", "wrong")).To(Equal(exitFailure))
+			Expect(readConfig().Token).To(Equal("old"))
+		})
+	})
+
+	It("lets FFCTL_TOKEN override the saved token", func() {
+		Expect(saveConfig(c.configPath, config{APIURL: c.api.server.URL, Token: "saved"})).To(Succeed())
+		setEnv("FFCTL_TOKEN", "from-env")
+
+		Expect(c.run("list")).To(BeZero())
+		Expect(c.api.lastToken()).To(Equal("Bearer from-env"))
+	})
+
+	It("authenticates with FFCTL_API_KEY instead of the token", func() {
+		Expect(saveConfig(c.configPath, config{APIURL: c.api.server.URL, Token: "saved"})).To(Succeed())
+		setEnv("FFCTL_API_KEY", "ffs_sync")
+
+		Expect(c.run("list")).To(BeZero())
+		Expect(c.api.lastAPIKey()).To(Equal("ffs_sync"))
+		Expect(c.api.lastToken()).To(BeEmpty())
+		Expect(readConfig().APIKey).To(BeEmpty())
+	})
+
+	It("lets FFCTL_API_URL override the saved API URL", func() {
+		Expect(saveConfig(c.configPath, config{APIURL: "http://127.0.0.1:1", Token: "saved"})).To(Succeed())
+
+		Expect(c.run("list")).To(BeZero())
+		Expect(c.api.lastToken()).To(Equal("Bearer saved"))
+	})
+
+	It("defaults to the local API without a config", func() {
+		setEnv("FFCTL_API_URL", "")
+		app, err := newApp(c.stdout, c.stderr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(app.config).To(Equal(config{APIURL: defaultAPIURL}))
+	})
+
+	It("rejects a corrupt config", func() {
+		Expect(saveConfig(c.configPath, config{})).To(Succeed())
+		Expect(os.WriteFile(c.configPath, []byte("{"), 0o600)).To(Succeed())
+
+		Expect(c.run("list")).To(Equal(exitFailure))
+		Expect(c.stderr.String()).To(ContainSubstring("failed to parse config"))
+	})
+})
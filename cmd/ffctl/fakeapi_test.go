@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	authModel "github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeAPI serves the flag endpoints the CLI uses from memory and records the tokens and API keys it
+// was called with.
+type fakeAPI struct {
+	server *httptest.Server
+
+	mu      sync.Mutex
+	flags   map[uuid.UUID]model.FeatureFlag
+	tokens  []string
+	apiKeys []string
+}
+
+func newFakeAPI() *fakeAPI {
+	api := &fakeAPI{flags: map[uuid.UUID]model.FeatureFlag{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /auth", api.login)
+	mux.HandleFunc("GET /flags", api.listFlags)
+	mux.HandleFunc("POST /flags", api.createFlag)
+	mux.HandleFunc("GET /flags/{id}", api.getFlag)
+	mux.HandleFunc("PUT /flags/{id}", api.updateFlag)
+	mux.HandleFunc("DELETE /flags/{id}", api.deleteFlag)
+	api.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		api.tokens = append(api.tokens, r.Header.Get("Authorization"))
+		api.apiKeys = append(api.apiKeys, r.Header.Get("X-API-Key"))
+		api.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	}))
+	return api
+}
+
+func (a *fakeAPI) addFlag(req model.FeatureFlagRequest) model.FeatureFlag {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	flag := req.Flag(uuid.New())
+	flag.CreatedAt = time.Now().UTC()
+	flag.UpdatedAt = flag.CreatedAt
+	a.flags[flag.ID] = flag
+	return flag
+}
+
+func (a *fakeAPI) flag(key string) (model.FeatureFlag, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, flag := range a.flags {
+		if flag.Key == key {
+			return flag, true
+		}
+	}
+	return model.FeatureFlag{}, false
+}
+
+func (a *fakeAPI) lastToken() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.tokens[len(a.tokens)-1]
+}
+
+func (a *fakeAPI) lastAPIKey() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.apiKeys[len(a.apiKeys)-1]
+}
+
+func (a *fakeAPI) login(w http.ResponseWriter, r *http.Request) {
+	var req authModel.AuthRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Password != "secret" {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "invalid credentials"})
+		return
+	}
+	writeJSON(w, http.StatusOK, authModel.AuthResponse{Token: "token-of-" + req.Username})
+}
+
+func (a *fakeAPI) listFlags(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	flags := []model.FeatureFlag{}
+	for _, flag := range a.flags {
+		if owner := r.URL.Query().Get("owner"); owner != "" && flag.Owner != owner {
+			continue
+		}
+		flags = append(flags, flag)
+	}
+	writeJSON(w, http.StatusOK, flags)
+}
+
+func (a *fakeAPI) getFlag(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	flag, ok := a.flags[uuid.MustParse(r.PathValue("id"))]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "feature flag not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, model.FlagDetails{FeatureFlag: flag})
+}
+
+func (a *fakeAPI) createFlag(w http.ResponseWriter, r *http.Request) {
+	var req model.FeatureFlagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "invalid request format"})
+		return
+	}
+	flag := a.addFlag(req)
+	writeJSON(w, http.StatusCreated, map[string]string{"id": flag.ID.String()})
+}
+
+func (a *fakeAPI) updateFlag(w http.ResponseWriter, r *http.Request) {
+	var req model.FeatureFlagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "invalid request format"})
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	id := uuid.MustParse(r.PathValue("id"))
+	current, ok := a.flags[id]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "feature flag not found"})
+		return
+	}
+	flag := req.Flag(id)
+	flag.CreatedAt = current.CreatedAt
+	flag.UpdatedAt = time.Now().UTC()
+	a.flags[id] = flag
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *fakeAPI) deleteFlag(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	id := uuid.MustParse(r.PathValue("id"))
+	if _, ok := a.flags[id]; !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "feature flag not found"})
+		return
+	}
+	delete(a.flags, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	Expect(json.NewEncoder(w).Encode(body)).To(Succeed())
+}
+
+// setEnv sets the variable for the current spec only.
+func setEnv(key, value string) {
+	previous, ok := os.LookupEnv(key)
+	Expect(os.Setenv(key, value)).To(Succeed())
+	DeferCleanup(func() {
+		if ok {
+			Expect(os.Setenv(key, previous)).To(Succeed())
+		} else {
+			Expect(os.Unsetenv(key)).To(Succeed())
+		}
+	})
+}
+
+// cli runs ffctl against the fake API with a config file of its own.
+type cli struct {
+	api        *fakeAPI
+	configPath string
+	stdout     *bytes.Buffer
+	stderr     *bytes.Buffer
+}
+
+func newCLI() *cli {
+	api := newFakeAPI()
+	DeferCleanup(api.server.Close)
+
+	c := &cli{
+		api:        api,
+		configPath: filepath.Join(GinkgoT().TempDir(), "ffctl", "config.json"),
+		stdout:     &bytes.Buffer{},
+		stderr:     &bytes.Buffer{},
+	}
+	setEnv("FFCTL_CONFIG", c.configPath)
+	setEnv("FFCTL_API_URL", api.server.URL)
+	setEnv("FFCTL_TOKEN", "")
+	setEnv("FFCTL_API_KEY", "")
+	return c
+}
+
+// run executes the command with fresh output buffers and returns its exit code.
+func (c *cli) run(args ...string) int {
+	c.stdout.Reset()
+	c.stderr.Reset()
+	return run(slices.Clone(args), c.stdout, c.stderr)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+const (
+	exitFailure = 1
+	exitUsage   = 2
+
+	requestTimeout = 30 * time.Second
+)
+
+const usage = `ffctl manages feature flags through the feature flags API.
+
+Usage:
+  ffctl login -username <name> [-password <password>] [-api-url <url>]
+  ffctl list [-owner <owner>] [-maintainer <name>] [-kind <kind>] [-tag <tag>]... [-o table|json|yaml]
+  ffctl get <id|key> [-o table|json|yaml]
+  ffctl create -key <key> [-description <text>] [-enabled] [-tag <tag>]... [-f <file>]
+  ffctl update <id|key> [-description <text>] [-enabled=true|false] [-f <file>]
+  ffctl toggle <id|key> [-on|-off]
+  ffctl delete <id|key>
+  ffctl export [-o json|yaml] [-file <path>]
+  ffctl import -f <file> [-dry-run]
+  ffctl sync -dir <dir> [-apply] [-prune] [-interval <duration>]
+
+The API URL and token are read from the config written by login and can be
+overridden with FFCTL_API_URL and FFCTL_TOKEN. With FFCTL_API_KEY set the CLI
+authenticates with that API key instead; sync needs a key with the sync:flags
+scope to change the flags it manages.
+`
+
+// errUsage marks invalid invocations, which exit with a different code than failed requests.
+var errUsage = errors.New("invalid usage")
+
+type command func(ctx context.Context, app *app, args []string) error
+
+var commands = map[string]command{
+	"login":  loginCommand,
+	"list":   listCommand,
+	"get":    getCommand,
+	"create": createCommand,
+	"update": updateCommand,
+	"toggle": toggleCommand,
+	"delete": deleteCommand,
+	"export": exportCommand,
+	"import": importCommand,
+	"sync":   syncCommand,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		fmt.Fprint(stderr, usage)
+		if len(args) == 0 {
+			return exitUsage
+		}
+		return 0
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "ffctl: unknown command %q\n\n%s", args[0], usage)
+		return exitUsage
+	}
+
+	app, err := newApp(stdout, stderr)
+	if err != nil {
+		fmt.Fprintf(stderr, "ffctl: %v\n", err)
+		return exitFailure
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	if err := cmd(ctx, app, args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		fmt.Fprintf(stderr, "ffctl %s: %v\n", args[0], err)
+		if errors.Is(err, errUsage) {
+			return exitUsage
+		}
+		return exitFailure
+	}
+	return 0
+}
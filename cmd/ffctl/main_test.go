@@ -0,0 +1,68 @@
+package main
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("run", func() {
+	var c *cli
+
+	BeforeEach(func() {
+		c = newCLI()
+	})
+
+	It("prints the usage and exits with 2 without a command", func() {
+		Expect(c.run()).To(Equal(exitUsage))
+		Expect(c.stderr.String()).To(ContainSubstring("Usage:"))
+	})
+
+	It("prints the usage and exits with 0 when asked for help", func() {
+		Expect(c.run("help")).To(BeZero())
+		Expect(c.stderr.String()).To(ContainSubstring("Usage:"))
+	})
+
+	It("exits with 2 for unknown commands", func() {
+		Expect(c.run("frobnicate")).To(Equal(exitUsage))
+		Expect(c.stderr.String()).To(ContainSubstring(`unknown command "frobnicate"`))
+	})
+
+	It("exits with 0 when a command is asked for help", func() {
+		Expect(c.run("list", "-h")).To(BeZero())
+		Expect(c.stderr.String()).To(ContainSubstring("-owner"))
+	})
+
+	DescribeTable("exits with 2 for invalid invocations",
+		func(args ...string) {
+			Expect(c.run(args...)).To(Equal(exitUsage))
+			Expect(c.stderr.String()).To(ContainSubstring("invalid usage"))
+		},
+		Entry("for unknown options", "list", "-unknown"),
+		Entry("for missing arguments", "get"),
+		Entry("for too many arguments", "delete", "checkout", "payments"),
+		Entry("for unsupported output formats", "list", "-o", "xml"),
+		Entry("for table output of exports", "export", "-o", "table"),
+		Entry("for a create without a key", "create", "-description", "text"),
+		Entry("for both -on and -off", "toggle", "checkout", "-on", "-off"),
+		Entry("for an import without a file", "import"),
+		Entry("for a sync without a directory", "sync"),
+		Entry("for a login without a password", "login", "-username", "jane"),
+	)
+
+	DescribeTable("exits with 1 when the command fails",
+		func(args ...string) {
+			Expect(c.run(args...)).To(Equal(exitFailure))
+			Expect(c.stderr.String()).To(HavePrefix("ffctl " + args[0] + ": "))
+		},
+		Entry("for unknown flag keys", "get", "missing"),
+		Entry("for flags the API does not find", "delete", "8f14e45f-ceea-4671-a1c5-3f4c1e3d8e2a"),
+		Entry("for rejected logins", "login", "-username", "jane", "-password", "wrong"),
+		Entry("for unreadable definition files", "import", "-f", "/does/not/exist.yaml"),
+	)
+
+	It("exits with 1 when the config cannot be read", func() {
+		setEnv("FFCTL_CONFIG", GinkgoT().TempDir())
+		Expect(c.run("list")).To(Equal(exitFailure))
+		Expect(c.stderr.String()).To(ContainSubstring("failed to read config"))
+	})
+})
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// writeOutput prints value in the requested format. Table output is delegated to printTable,
+// which may be nil for commands that only support structured output.
+func writeOutput(w io.Writer, format string, value any, printTable func(*tabwriter.Writer)) error {
+	switch format {
+	case outputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	case outputYAML:
+		// Going through JSON keeps the snake_case field names of the API.
+		generic, err := toGeneric(value)
+		if err != nil {
+			return err
+		}
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(generic); err != nil {
+			return err
+		}
+		return encoder.Close()
+	case outputTable:
+		if printTable == nil {
+			return fmt.Errorf("%w: table output is not supported here", errUsage)
+		}
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		printTable(tw)
+		return tw.Flush()
+	default:
+		return fmt.Errorf("%w: unsupported output format %q", errUsage, format)
+	}
+}
+
+func toGeneric(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
+
+// readDefinitions reads one or more flag definitions from a JSON or YAML file, or from
+// stdin when path is "-".
+func readDefinitions(path string) ([]model.FeatureFlagRequest, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	// YAML is a superset of JSON, so one decoder handles both formats.
+	var generic any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if _, isList := generic.([]any); !isList {
+		generic = []any{generic}
+	}
+	normalized, err := json.Marshal(generic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	var definitions []model.FeatureFlagRequest
+	if err := json.Unmarshal(normalized, &definitions); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return definitions, nil
+}
+
+func printFlagsTable(tw *tabwriter.Writer, flags []model.FeatureFlag) {
+	fmt.Fprintln(tw, "ID\tKEY\tENABLED\tKIND\tOWNER\tTAGS")
+	for _, flag := range flags {
+		fmt.Fprintf(tw, "%s\t%s\t%t\t%s\t%s\t%s\n",
+			flag.ID,
+			flag.Key,
+			flag.Enabled,
+			flag.Kind,
+			flag.Owner,
+			strings.Join(flag.Tags, ","),
+		)
+	}
+}
+
+func printFlagDetailsTable(tw *tabwriter.Writer, flag model.FlagDetails) {
+	rows := [][2]string{
+		{"ID", flag.ID.String()},
+		{"Key", flag.Key},
+		{"Description", flag.Description},
+		{"Enabled", fmt.Sprint(flag.Enabled)},
+		{"Kind", string(flag.Kind)},
+		{"Owner", strings.TrimSpace(fmt.Sprintf("%s %s", flag.OwnerType, flag.Owner))},
+		{"Maintainers", strings.Join(flag.Maintainers, ", ")},
+		{"Tags", strings.Join(flag.Tags, ", ")},
+		{"Permanent", fmt.Sprint(flag.Permanent)},
+		{"Protected", fmt.Sprint(flag.Protected)},
+		{"Issues", strings.Join(flag.IssueURLs, ", ")},
+	}
+	if flag.ExpiresAt != nil {
+		rows = append(rows, [2]string{"Expires at", flag.ExpiresAt.Format("2006-01-02 15:04:05")})
+	}
+	for _, key := range slices.Sorted(maps.Keys(flag.Metadata)) {
+		rows = append(rows, [2]string{"Metadata " + key, flag.Metadata[key]})
+	}
+	for _, reference := range flag.CodeReferences {
+		rows = append(rows, [2]string{"Referenced in", fmt.Sprintf("%s %s:%d", reference.Repository, reference.Path, reference.Line)})
+	}
+
+	for _, row := range rows {
+		fmt.Fprintf(tw, "%s:\t%s\n", row[0], row[1])
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/gitops"
+)
+
+// syncCommand reconciles the flags with the definitions in a directory of YAML files. With
+// -interval it keeps running and reconciles again on every tick.
+func syncCommand(ctx context.Context, app *app, args []string) error {
+	fs := newFlagSet("sync", app)
+	dir := fs.String("dir", "", "directory with the flag definitions")
+	apply := fs.Bool("apply", false, "apply the plan instead of only printing it")
+	prune := fs.Bool("prune", false, "delete the flags managed by git that are no longer defined")
+	interval := fs.Duration("interval", 0, "keep running and sync again after this interval")
+	if _, err := parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	if *dir == "" {
+		return fmt.Errorf("%w: -dir is required", errUsage)
+	}
+
+	sync := func(ctx context.Context) error {
+		return syncDir(ctx, app, *dir, *apply, *prune)
+	}
+	if *interval <= 0 {
+		return sync(ctx)
+	}
+
+	// The server mode outlives the timeout of a single invocation, so every round gets its own.
+	base, stop := signal.NotifyContext(context.WithoutCancel(ctx), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for {
+		roundCtx, cancel := context.WithTimeout(base, requestTimeout)
+		if err := sync(roundCtx); err != nil {
+			fmt.Fprintf(app.stderr, "ffctl sync: %v\n", err)
+		}
+		cancel()
+
+		select {
+		case <-base.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func syncDir(ctx context.Context, app *app, dir string, apply, prune bool) error {
+	definitions, err := gitops.LoadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	client := app.client()
+	flags, err := client.ListFlags(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	plan, err := gitops.NewPlan(definitions, flags, prune)
+	if err != nil {
+		return err
+	}
+	plan.Write(app.stdout)
+	if !apply || !plan.HasChanges() {
+		return nil
+	}
+
+	return gitops.Apply(ctx, client, plan, app.stdout)
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	authModel "github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/config"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/storage"
+	"github.com/google/uuid"
+)
+
+const reportTimeout = 30 * time.Second
+
+func main() {
+	staleDays := flag.Int("stale-days", 30, "number of days without changes after which a flag is considered stale")
+	output := flag.String("output", "table", "output format: table or json")
+	org := flag.String("org", authModel.DefaultOrgID.String(), "ID of the organization to report on")
+	flag.Parse()
+
+	orgID, err := uuid.Parse(*org)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "flagsreport: invalid organization ID: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := run(orgID, *staleDays, *output, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "flagsreport: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(orgID uuid.UUID, staleDays int, output string, w io.Writer) error {
+	ctx, cancel := context.WithTimeout(context.Background(), reportTimeout)
+	defer cancel()
+
+	cfg := config.Load()
+
+	store, err := storage.Open(ctx, cfg.Storage())
+	if err != nil {
+		return fmt.Errorf("failed initializing storage: %w", err)
+	}
+	defer store.Close()
+
+	svc := service.NewService(store.FeatureFlags, slog.Default())
+	report, err := svc.GenerateReport(ctx, orgID, staleDays)
+	if err != nil {
+		return fmt.Errorf("failed generating report: %w", err)
+	}
+
+	switch output {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	case "table":
+		return printTable(w, report)
+	default:
+		return fmt.Errorf("unsupported output format: %s", output)
+	}
+}
+
+func printTable(w io.Writer, report model.FlagReport) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tENABLED\tCATEGORIES\tDAYS SINCE UPDATE\tUPDATED AT\tLAST EVALUATED AT")
+	for _, entry := range report.Flags {
+		categories := make([]string, len(entry.Categories))
+		for i, category := range entry.Categories {
+			categories[i] = string(category)
+		}
+		lastEvaluatedAt := "never"
+		if entry.LastEvaluatedAt != nil {
+			lastEvaluatedAt = entry.LastEvaluatedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(tw, "%s\t%t\t%s\t%d\t%s\t%s\n",
+			entry.Key,
+			entry.Enabled,
+			strings.Join(categories, ","),
+			entry.DaysSinceUpdate,
+			entry.UpdatedAt.Format(time.RFC3339),
+			lastEvaluatedAt,
+		)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/config"
+	featureflagsv1 "github.com/georgisomnoev/feature-flag-api/internal/gen/featureflags/v1"
+	"github.com/georgisomnoev/feature-flag-api/internal/healthcheck"
+	"github.com/georgisomnoev/feature-flag-api/internal/lifecycle"
+	"github.com/georgisomnoev/feature-flag-api/internal/observability"
+	"github.com/georgisomnoev/feature-flag-api/internal/relay"
+	"github.com/georgisomnoev/feature-flag-api/internal/webapi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const serviceName = "relayproxy"
+
+func main() {
+	appCtx := lifecycle.CreateAppContext()
+
+	cfg := config.LoadRelay()
+	if cfg.UpstreamAPIKey == "" {
+		panic(errors.New("RELAY_UPSTREAM_API_KEY is required"))
+	}
+	if len(cfg.APIKeys) == 0 {
+		panic(errors.New("RELAY_API_KEYS is required"))
+	}
+
+	if cfg.OtelCollectorEnabled {
+		if err := observability.InitOtel(appCtx, cfg.OtelCollectorHost, serviceName); err != nil {
+			panic(fmt.Errorf("failed initializing Otel: %w", err))
+		}
+	}
+
+	srv := webapi.NewRelayWebAPI()
+
+	cache := relay.NewCache(cfg.CacheFile)
+	if err := cache.Load(); err != nil {
+		// A broken cache file only costs the fallback until the first snapshot arrives.
+		srv.Logger.Errorf("failed loading cached flags: %v", err)
+	}
+
+	transport := insecure.NewCredentials()
+	if cfg.UpstreamTLS {
+		transport = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	conn, err := grpc.NewClient(cfg.UpstreamGRPCAddr, grpc.WithTransportCredentials(transport))
+	if err != nil {
+		panic(fmt.Errorf("failed creating upstream client: %w", err))
+	}
+	defer conn.Close()
+
+	upstream := relay.NewUpstream(featureflagsv1.NewFeatureFlagServiceClient(conn), cfg.UpstreamAPIKey, cfg.Environment, cache,
+		srv.Logger)
+	go upstream.Run(appCtx)
+
+	relay.NewHandler(cache, cfg.APIKeys).RegisterHandlers(srv)
+	healthcheck.Process(srv, cache)
+
+	webapi.StartHTTP(appCtx, srv, cfg.APIPort)
+}
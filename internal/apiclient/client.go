@@ -0,0 +1,163 @@
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	authModel "github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+// APIError is returned for every response with an error status code.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("api returned %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// apiKeyHeader carries the API key of the client instead of a bearer token.
+const apiKeyHeader = "X-API-Key"
+
+type Client struct {
+	baseURL    string
+	token      string
+	apiKey     string
+	httpClient *http.Client
+}
+
+func NewClient(baseURL, token string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		httpClient: httpClient,
+	}
+}
+
+// WithAPIKey returns a copy of the client that authenticates with the API key instead of the token,
+// e.g. a key with the sync scope, which is required to change managed flags.
+func (c *Client) WithAPIKey(key string) *Client {
+	clone := *c
+	clone.apiKey = key
+	return &clone
+}
+
+func (c *Client) Login(ctx context.Context, username, password string) (string, error) {
+	var resp authModel.AuthResponse
+	req := authModel.AuthRequest{Username: username, Password: password}
+	if err := c.do(ctx, http.MethodPost, "/auth", req, &resp); err != nil {
+		return "", err
+	}
+	return resp.Token, nil
+}
+
+func (c *Client) ListFlags(ctx context.Context, query url.Values) ([]model.FeatureFlag, error) {
+	path := "/flags"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var flags []model.FeatureFlag
+	if err := c.do(ctx, http.MethodGet, path, nil, &flags); err != nil {
+		return nil, err
+	}
+	return flags, nil
+}
+
+func (c *Client) GetFlag(ctx context.Context, id uuid.UUID) (model.FlagDetails, error) {
+	var flag model.FlagDetails
+	if err := c.do(ctx, http.MethodGet, "/flags/"+id.String(), nil, &flag); err != nil {
+		return model.FlagDetails{}, err
+	}
+	return flag, nil
+}
+
+func (c *Client) CreateFlag(ctx context.Context, req model.FeatureFlagRequest) (uuid.UUID, error) {
+	var resp struct {
+		ID uuid.UUID `json:"id"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/flags", req, &resp); err != nil {
+		return uuid.Nil, err
+	}
+	return resp.ID, nil
+}
+
+func (c *Client) UpdateFlag(ctx context.Context, id uuid.UUID, req model.FeatureFlagRequest) error {
+	return c.do(ctx, http.MethodPut, "/flags/"+id.String(), req, nil)
+}
+
+func (c *Client) DeleteFlag(ctx context.Context, id uuid.UUID) error {
+	return c.do(ctx, http.MethodDelete, "/flags/"+id.String(), nil, nil)
+}
+
+func (c *Client) UploadCodeReferences(ctx context.Context, upload model.CodeReferencesUpload) error {
+	return c.do(ctx, http.MethodPut, "/code-references", upload, nil)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, response any) error {
+	var reqBody io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	switch {
+	case c.apiKey != "":
+		req.Header.Set(apiKeyHeader, c.apiKey)
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return newAPIError(resp)
+	}
+	if response == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+func newAPIError(resp *http.Response) error {
+	payload, _ := io.ReadAll(resp.Body)
+
+	var echoError struct {
+		Message string `json:"message"`
+	}
+	message := strings.TrimSpace(string(payload))
+	if err := json.Unmarshal(payload, &echoError); err == nil && echoError.Message != "" {
+		message = echoError.Message
+	}
+
+	return &APIError{StatusCode: resp.StatusCode, Message: message}
+}
@@ -0,0 +1,196 @@
+package apiclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/apiclient"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		ctx     context.Context
+		server  *httptest.Server
+		client  *apiclient.Client
+		handler http.HandlerFunc
+
+		lastRequest *http.Request
+		lastBody    []byte
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		lastRequest = nil
+		lastBody = nil
+	})
+
+	JustBeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lastRequest = r
+			lastBody, _ = io.ReadAll(r.Body)
+			handler(w, r)
+		}))
+		client = apiclient.NewClient(server.URL+"/", "token", server.Client())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	respondWith := func(status int, body any) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			if body != nil {
+				Expect(json.NewEncoder(w).Encode(body)).To(Succeed())
+			}
+		}
+	}
+
+	Describe("Login", func() {
+		var (
+			token    string
+			errLogin error
+		)
+
+		BeforeEach(func() {
+			handler = respondWith(http.StatusOK, map[string]string{"token": "jwt"})
+		})
+
+		JustBeforeEach(func() {
+			token, errLogin = client.Login(ctx, "john", "secret")
+		})
+
+		It("posts the credentials and returns the token", func() {
+			Expect(errLogin).ToNot(HaveOccurred())
+			Expect(token).To(Equal("jwt"))
+			Expect(lastRequest.Method).To(Equal(http.MethodPost))
+			Expect(lastRequest.URL.Path).To(Equal("/auth"))
+			Expect(lastBody).To(MatchJSON(`{"username": "john", "password": "secret"}`))
+		})
+
+		Context("when the credentials are rejected", func() {
+			BeforeEach(func() {
+				handler = respondWith(http.StatusUnauthorized, map[string]string{"message": "invalid credentials"})
+			})
+
+			It("returns an API error with the message", func() {
+				Expect(errLogin).To(MatchError(&apiclient.APIError{
+					StatusCode: http.StatusUnauthorized,
+					Message:    "invalid credentials",
+				}))
+			})
+		})
+	})
+
+	Describe("ListFlags", func() {
+		var (
+			flags   []model.FeatureFlag
+			errList error
+		)
+
+		BeforeEach(func() {
+			handler = respondWith(http.StatusOK, []model.FeatureFlag{{ID: uuid.New(), Key: "dark_mode"}})
+		})
+
+		JustBeforeEach(func() {
+			flags, errList = client.ListFlags(ctx, url.Values{"tag": {"ui", "web"}})
+		})
+
+		It("sends the token and the filters", func() {
+			Expect(errList).ToNot(HaveOccurred())
+			Expect(flags).To(HaveLen(1))
+			Expect(flags[0].Key).To(Equal("dark_mode"))
+			Expect(lastRequest.Header.Get("Authorization")).To(Equal("Bearer token"))
+			Expect(lastRequest.URL.Query()["tag"]).To(Equal([]string{"ui", "web"}))
+		})
+	})
+
+	Describe("CreateFlag", func() {
+		var (
+			flagID    uuid.UUID
+			createdID uuid.UUID
+			errCreate error
+		)
+
+		BeforeEach(func() {
+			flagID = uuid.New()
+			handler = respondWith(http.StatusCreated, map[string]string{"id": flagID.String()})
+		})
+
+		JustBeforeEach(func() {
+			createdID, errCreate = client.CreateFlag(ctx, model.FeatureFlagRequest{Key: "dark_mode", Enabled: true})
+		})
+
+		It("returns the ID of the new flag", func() {
+			Expect(errCreate).ToNot(HaveOccurred())
+			Expect(createdID).To(Equal(flagID))
+			Expect(lastRequest.Header.Get("Content-Type")).To(Equal("application/json"))
+		})
+
+		Context("when the response is not JSON", func() {
+			BeforeEach(func() {
+				handler = func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusBadGateway)
+					_, _ = w.Write([]byte("bad gateway\n"))
+				}
+			})
+
+			It("returns the raw body in the error", func() {
+				Expect(errCreate).To(MatchError(&apiclient.APIError{
+					StatusCode: http.StatusBadGateway,
+					Message:    "bad gateway",
+				}))
+			})
+		})
+	})
+
+	Describe("DeleteFlag", func() {
+		var (
+			flagID    uuid.UUID
+			errDelete error
+		)
+
+		BeforeEach(func() {
+			flagID = uuid.New()
+			handler = respondWith(http.StatusNoContent, nil)
+		})
+
+		JustBeforeEach(func() {
+			errDelete = client.DeleteFlag(ctx, flagID)
+		})
+
+		It("deletes the flag by ID", func() {
+			Expect(errDelete).ToNot(HaveOccurred())
+			Expect(lastRequest.Method).To(Equal(http.MethodDelete))
+			Expect(lastRequest.URL.Path).To(Equal("/flags/" + flagID.String()))
+		})
+	})
+
+	Describe("WithAPIKey", func() {
+		BeforeEach(func() {
+			handler = respondWith(http.StatusOK, nil)
+		})
+
+		It("authenticates the requests of the copy only with the key", func() {
+			flagID := uuid.New()
+			withKey := client.WithAPIKey("ffs_key")
+
+			Expect(withKey.UpdateFlag(ctx, flagID, model.FeatureFlagRequest{Key: "flag"})).To(Succeed())
+			Expect(lastRequest.Header.Get("X-API-Key")).To(Equal("ffs_key"))
+			Expect(lastRequest.Header.Get("Authorization")).To(BeEmpty())
+
+			Expect(client.UpdateFlag(ctx, flagID, model.FeatureFlagRequest{Key: "flag"})).To(Succeed())
+			Expect(lastRequest.Header.Get("X-API-Key")).To(BeEmpty())
+			Expect(lastRequest.Header.Get("Authorization")).To(Equal("Bearer token"))
+		})
+	})
+})
@@ -0,0 +1,140 @@
+package apikey_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/apikey"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/apikey/apikeyfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+var ErrDatabaseError = errors.New("database error")
+
+var _ = Describe("API keys", func() {
+	Describe("Generate", func() {
+		It("returns a random key with its prefix and hash", func() {
+			key, prefix, hash, err := apikey.Generate(model.APIKeyKindServer)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key).To(HavePrefix("ffs_"))
+			Expect(strings.HasPrefix(key, prefix)).To(BeTrue())
+			Expect(len(prefix)).To(BeNumerically("<", len(key)))
+			Expect(hash).To(Equal(apikey.Hash(key)))
+			Expect(hash).ToNot(ContainSubstring(key))
+
+			other, _, _, err := apikey.Generate(model.APIKeyKindServer)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(other).ToNot(Equal(key))
+		})
+
+		It("marks client keys", func() {
+			key, _, _, err := apikey.Generate(model.APIKeyKindClient)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key).To(HavePrefix("ffc_"))
+		})
+	})
+
+	Describe("Authenticator", func() {
+		var (
+			ctx           context.Context
+			store         *apikeyfakes.FakeStore
+			authenticator *apikey.Authenticator
+			stored        model.APIKey
+			claims        jwt.MapClaims
+			errAction     error
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			store = &apikeyfakes.FakeStore{}
+			authenticator = apikey.NewAuthenticator(store, slog.New(slog.DiscardHandler))
+			stored = model.APIKey{
+				ID:          uuid.New(),
+				OrgID:       uuid.New(),
+				Environment: "staging",
+				Kind:        model.APIKeyKindServer,
+				Scopes:      []string{"read:flags", "write:flags"},
+				CreatedBy:   uuid.New(),
+			}
+		})
+
+		JustBeforeEach(func() {
+			store.GetAPIKeyByHashReturns(stored, nil)
+			claims, errAction = authenticator.Authenticate(ctx, "ffs_key")
+		})
+
+		It("returns the claims of the key", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			Expect(claims).To(Equal(jwt.MapClaims{
+				"sub":     stored.CreatedBy.String(),
+				"org":     stored.OrgID.String(),
+				"scopes":  []string{"read:flags", "write:flags"},
+				"api_key": stored.ID.String(),
+				"env":     "staging",
+			}))
+
+			_, hash := store.GetAPIKeyByHashArgsForCall(0)
+			Expect(hash).To(Equal(apikey.Hash("ffs_key")))
+		})
+
+		It("records the use of the key", func() {
+			Expect(store.TouchAPIKeyCallCount()).To(Equal(1))
+			_, id, usedAt := store.TouchAPIKeyArgsForCall(0)
+			Expect(id).To(Equal(stored.ID))
+			Expect(usedAt).To(BeTemporally("~", time.Now(), time.Second))
+		})
+
+		Context("when the key was used a moment ago", func() {
+			BeforeEach(func() {
+				usedAt := time.Now().Add(-time.Second)
+				stored.LastUsedAt = &usedAt
+			})
+
+			It("does not record the use again", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				Expect(store.TouchAPIKeyCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the use cannot be recorded", func() {
+			BeforeEach(func() {
+				store.TouchAPIKeyReturns(ErrDatabaseError)
+			})
+
+			It("still accepts the key", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the key was revoked", func() {
+			BeforeEach(func() {
+				revokedAt := time.Now()
+				stored.RevokedAt = &revokedAt
+			})
+
+			It("rejects the key", func() {
+				Expect(errAction).To(MatchError(apikey.ErrInvalidKey))
+				Expect(store.TouchAPIKeyCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the key is unknown", func() {
+			JustBeforeEach(func() {
+				store.GetAPIKeyByHashReturns(model.APIKey{}, model.ErrAPIKeyNotFound)
+				_, errAction = authenticator.Authenticate(ctx, "ffs_unknown")
+			})
+
+			It("rejects the key", func() {
+				Expect(errAction).To(MatchError(apikey.ErrInvalidKey))
+			})
+		})
+	})
+})
@@ -0,0 +1,196 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package apikeyfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/apikey"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+type FakeStore struct {
+	GetAPIKeyByHashStub        func(context.Context, string) (model.APIKey, error)
+	getAPIKeyByHashMutex       sync.RWMutex
+	getAPIKeyByHashArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAPIKeyByHashReturns struct {
+		result1 model.APIKey
+		result2 error
+	}
+	getAPIKeyByHashReturnsOnCall map[int]struct {
+		result1 model.APIKey
+		result2 error
+	}
+	TouchAPIKeyStub        func(context.Context, uuid.UUID, time.Time) error
+	touchAPIKeyMutex       sync.RWMutex
+	touchAPIKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	touchAPIKeyReturns struct {
+		result1 error
+	}
+	touchAPIKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStore) GetAPIKeyByHash(arg1 context.Context, arg2 string) (model.APIKey, error) {
+	fake.getAPIKeyByHashMutex.Lock()
+	ret, specificReturn := fake.getAPIKeyByHashReturnsOnCall[len(fake.getAPIKeyByHashArgsForCall)]
+	fake.getAPIKeyByHashArgsForCall = append(fake.getAPIKeyByHashArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAPIKeyByHashStub
+	fakeReturns := fake.getAPIKeyByHashReturns
+	fake.recordInvocation("GetAPIKeyByHash", []interface{}{arg1, arg2})
+	fake.getAPIKeyByHashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetAPIKeyByHashCallCount() int {
+	fake.getAPIKeyByHashMutex.RLock()
+	defer fake.getAPIKeyByHashMutex.RUnlock()
+	return len(fake.getAPIKeyByHashArgsForCall)
+}
+
+func (fake *FakeStore) GetAPIKeyByHashCalls(stub func(context.Context, string) (model.APIKey, error)) {
+	fake.getAPIKeyByHashMutex.Lock()
+	defer fake.getAPIKeyByHashMutex.Unlock()
+	fake.GetAPIKeyByHashStub = stub
+}
+
+func (fake *FakeStore) GetAPIKeyByHashArgsForCall(i int) (context.Context, string) {
+	fake.getAPIKeyByHashMutex.RLock()
+	defer fake.getAPIKeyByHashMutex.RUnlock()
+	argsForCall := fake.getAPIKeyByHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetAPIKeyByHashReturns(result1 model.APIKey, result2 error) {
+	fake.getAPIKeyByHashMutex.Lock()
+	defer fake.getAPIKeyByHashMutex.Unlock()
+	fake.GetAPIKeyByHashStub = nil
+	fake.getAPIKeyByHashReturns = struct {
+		result1 model.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetAPIKeyByHashReturnsOnCall(i int, result1 model.APIKey, result2 error) {
+	fake.getAPIKeyByHashMutex.Lock()
+	defer fake.getAPIKeyByHashMutex.Unlock()
+	fake.GetAPIKeyByHashStub = nil
+	if fake.getAPIKeyByHashReturnsOnCall == nil {
+		fake.getAPIKeyByHashReturnsOnCall = make(map[int]struct {
+			result1 model.APIKey
+			result2 error
+		})
+	}
+	fake.getAPIKeyByHashReturnsOnCall[i] = struct {
+		result1 model.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) TouchAPIKey(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) error {
+	fake.touchAPIKeyMutex.Lock()
+	ret, specificReturn := fake.touchAPIKeyReturnsOnCall[len(fake.touchAPIKeyArgsForCall)]
+	fake.touchAPIKeyArgsForCall = append(fake.touchAPIKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.TouchAPIKeyStub
+	fakeReturns := fake.touchAPIKeyReturns
+	fake.recordInvocation("TouchAPIKey", []interface{}{arg1, arg2, arg3})
+	fake.touchAPIKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) TouchAPIKeyCallCount() int {
+	fake.touchAPIKeyMutex.RLock()
+	defer fake.touchAPIKeyMutex.RUnlock()
+	return len(fake.touchAPIKeyArgsForCall)
+}
+
+func (fake *FakeStore) TouchAPIKeyCalls(stub func(context.Context, uuid.UUID, time.Time) error) {
+	fake.touchAPIKeyMutex.Lock()
+	defer fake.touchAPIKeyMutex.Unlock()
+	fake.TouchAPIKeyStub = stub
+}
+
+func (fake *FakeStore) TouchAPIKeyArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.touchAPIKeyMutex.RLock()
+	defer fake.touchAPIKeyMutex.RUnlock()
+	argsForCall := fake.touchAPIKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) TouchAPIKeyReturns(result1 error) {
+	fake.touchAPIKeyMutex.Lock()
+	defer fake.touchAPIKeyMutex.Unlock()
+	fake.TouchAPIKeyStub = nil
+	fake.touchAPIKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) TouchAPIKeyReturnsOnCall(i int, result1 error) {
+	fake.touchAPIKeyMutex.Lock()
+	defer fake.touchAPIKeyMutex.Unlock()
+	fake.TouchAPIKeyStub = nil
+	if fake.touchAPIKeyReturnsOnCall == nil {
+		fake.touchAPIKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.touchAPIKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStore) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ apikey.Store = new(FakeStore)
@@ -0,0 +1,244 @@
+// Package authn authenticates the callers of the REST and gRPC APIs by their bearer token or API
+// key and checks that they are still enabled members of the organization the credentials are for.
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/denylist"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	ReadFlagsScope    = "read:flags"
+	WriteFlagsScope   = "write:flags"
+	ReviewFlagsScope  = "review:flags"
+	AdminFlagsScope   = "admin:flags"
+	APIKeysAdminScope = "admin:apikeys"
+	ClientsAdminScope = "admin:clients"
+	OrgsAdminScope    = "admin:orgs"
+	TokensAdminScope  = "admin:tokens"
+	UsersAdminScope   = "admin:users"
+)
+
+var (
+	ErrMissingToken      = errors.New("missing token")
+	ErrInvalidToken      = errors.New("invalid or expired token")
+	ErrRevokedToken      = errors.New("token has been revoked")
+	ErrInvalidAPIKey     = errors.New("invalid or revoked API key")
+	ErrInvalidUser       = errors.New("invalid user ID in token")
+	ErrUserNotFound      = errors.New("user not found")
+	ErrInvalidOrg        = errors.New("invalid organization in token")
+	ErrNotOrgMember      = errors.New("not a member of the organization")
+	ErrInsufficientScope = errors.New("insufficient permissions")
+	ErrWrongEnvironment  = errors.New("credentials are for another environment")
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate . AuthStore
+type AuthStore interface {
+	UserExists(context.Context, uuid.UUID) (bool, error)
+	IsOrgMember(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+}
+
+//counterfeiter:generate . Denylist
+type Denylist interface {
+	IsRevoked(jwt.MapClaims) bool
+}
+
+//counterfeiter:generate . TokenValidator
+type TokenValidator interface {
+	ValidateToken(string) (jwt.MapClaims, error)
+}
+
+//counterfeiter:generate . APIKeyAuthenticator
+type APIKeyAuthenticator interface {
+	// Authenticate returns the claims the API key stands for, in the form of access token claims.
+	Authenticate(context.Context, string) (jwt.MapClaims, error)
+}
+
+// Credentials are the API key or, without one, the bearer token a request was made with.
+type Credentials struct {
+	APIKey      string
+	BearerToken string
+	// Environment is the environment the caller declares to serve, like the relay does. It is
+	// optional.
+	Environment string
+}
+
+// Caller is the authenticated user of a request, acting in one organization within the scopes of
+// the credentials.
+type Caller struct {
+	UserID uuid.UUID
+	OrgID  uuid.UUID
+	Scopes []string
+	// APIKeyID is the API key of the request, nil for bearer tokens.
+	APIKeyID uuid.UUID
+	// Environment is the environment of the API key, empty for bearer tokens.
+	Environment string
+	// AccessToken is the bearer token of the request, nil for API keys.
+	AccessToken *model.AccessToken
+}
+
+func (c Caller) HasScope(scope string) bool {
+	return slices.Contains(c.Scopes, scope)
+}
+
+type Authenticator struct {
+	authStore   AuthStore
+	denylist    Denylist
+	tokens      TokenValidator
+	apiKeys     APIKeyAuthenticator
+	environment string
+}
+
+// NewAuthenticator returns an authenticator of bearer tokens and, unless apiKeys is nil, of the API
+// keys of the environment the API serves.
+func NewAuthenticator(
+	authStore AuthStore,
+	denylist Denylist,
+	tokens TokenValidator,
+	apiKeys APIKeyAuthenticator,
+	environment string,
+) *Authenticator {
+	return &Authenticator{
+		authStore:   authStore,
+		denylist:    denylist,
+		tokens:      tokens,
+		apiKeys:     apiKeys,
+		environment: environment,
+	}
+}
+
+// Authenticate validates the credentials, that the token was not revoked, that its user is still
+// an enabled member of its organization and, unless requiredScope is empty, that it grants the
+// scope. API keys are checked for revocation by their authenticator, not the denylist, and must be
+// for the environment the API serves, as must the environment the caller declares.
+func (a *Authenticator) Authenticate(ctx context.Context, credentials Credentials, requiredScope string) (Caller, error) {
+	if credentials.Environment != "" && credentials.Environment != a.environment {
+		return Caller{}, ErrWrongEnvironment
+	}
+	claims, err := a.claims(ctx, credentials)
+	if err != nil {
+		return Caller{}, err
+	}
+
+	var caller Caller
+	subject, _ := claims.GetSubject()
+	caller.UserID, err = uuid.Parse(subject)
+	if err != nil || caller.UserID == uuid.Nil {
+		return Caller{}, ErrInvalidUser
+	}
+	org, _ := claims["org"].(string)
+	caller.OrgID, err = uuid.Parse(org)
+	if err != nil || caller.OrgID == uuid.Nil {
+		return Caller{}, ErrInvalidOrg
+	}
+	caller.Scopes, err = scopesFromClaims(claims)
+	if err != nil {
+		return Caller{}, ErrInvalidToken
+	}
+
+	if credentials.APIKey == "" || a.apiKeys == nil {
+		accessToken, err := denylist.AccessTokenFromClaims(claims)
+		if err != nil {
+			return Caller{}, ErrInvalidToken
+		}
+		if a.denylist.IsRevoked(claims) {
+			return Caller{}, ErrRevokedToken
+		}
+		caller.AccessToken = &accessToken
+	} else {
+		apiKey, _ := claims["api_key"].(string)
+		caller.APIKeyID, err = uuid.Parse(apiKey)
+		if err != nil {
+			return Caller{}, ErrInvalidAPIKey
+		}
+		caller.Environment, _ = claims["env"].(string)
+		if caller.Environment != a.environment {
+			return Caller{}, ErrWrongEnvironment
+		}
+	}
+
+	exists, err := a.authStore.UserExists(ctx, caller.UserID)
+	if err != nil || !exists {
+		return Caller{}, ErrUserNotFound
+	}
+	isMember, err := a.authStore.IsOrgMember(ctx, caller.OrgID, caller.UserID)
+	if err != nil {
+		return Caller{}, fmt.Errorf("failed to check organization membership: %w", err)
+	}
+	if !isMember {
+		return Caller{}, ErrNotOrgMember
+	}
+
+	if requiredScope != "" && !caller.HasScope(requiredScope) {
+		return Caller{}, ErrInsufficientScope
+	}
+	return caller, nil
+}
+
+func (a *Authenticator) claims(ctx context.Context, credentials Credentials) (jwt.MapClaims, error) {
+	if credentials.APIKey != "" && a.apiKeys != nil {
+		claims, err := a.apiKeys.Authenticate(ctx, credentials.APIKey)
+		if err != nil {
+			return nil, ErrInvalidAPIKey
+		}
+		return claims, nil
+	}
+
+	if credentials.BearerToken == "" {
+		return nil, ErrMissingToken
+	}
+	claims, err := a.tokens.ValidateToken(credentials.BearerToken)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// IsUnauthenticated reports whether the error means the credentials do not identify a caller, as
+// opposed to a caller who lacks the permission or a failure to check.
+func IsUnauthenticated(err error) bool {
+	for _, target := range []error{
+		ErrMissingToken, ErrInvalidToken, ErrRevokedToken, ErrInvalidAPIKey, ErrInvalidUser, ErrUserNotFound, ErrInvalidOrg,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPermissionDenied reports whether the error means the caller may not make the request.
+func IsPermissionDenied(err error) bool {
+	return errors.Is(err, ErrNotOrgMember) || errors.Is(err, ErrInsufficientScope) || errors.Is(err, ErrWrongEnvironment)
+}
+
+// scopesFromClaims reads the scopes claim, a list of strings in the claims of API keys and a list
+// of values after a token was decoded. Claims without scopes grant none.
+func scopesFromClaims(claims jwt.MapClaims) ([]string, error) {
+	switch v := claims["scopes"].(type) {
+	case nil:
+		return nil, nil
+	case []string:
+		return v, nil
+	case []any:
+		scopes := make([]string, len(v))
+		for i, value := range v {
+			scope, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid type in scope: %v", value)
+			}
+			scopes[i] = scope
+		}
+		return scopes, nil
+	default:
+		return nil, fmt.Errorf("unsupported scopes type: %T", v)
+	}
+}
@@ -0,0 +1,240 @@
+package authn_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn/authnfakes"
+)
+
+var _ = Describe("Authenticator", func() {
+	var (
+		ctx       context.Context
+		authStore *authnfakes.FakeAuthStore
+		denylist  *authnfakes.FakeDenylist
+		tokens    *authnfakes.FakeTokenValidator
+		apiKeys   *authnfakes.FakeAPIKeyAuthenticator
+		auth      *authn.Authenticator
+		userID    uuid.UUID
+		orgID     uuid.UUID
+		claims    jwt.MapClaims
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		authStore = &authnfakes.FakeAuthStore{}
+		authStore.UserExistsReturns(true, nil)
+		authStore.IsOrgMemberReturns(true, nil)
+		denylist = &authnfakes.FakeDenylist{}
+		tokens = &authnfakes.FakeTokenValidator{}
+		apiKeys = &authnfakes.FakeAPIKeyAuthenticator{}
+		auth = authn.NewAuthenticator(authStore, denylist, tokens, apiKeys, "production")
+		userID = uuid.New()
+		orgID = uuid.New()
+
+		now := time.Now()
+		claims = jwt.MapClaims{
+			"jti":    uuid.NewString(),
+			"sub":    userID.String(),
+			"org":    orgID.String(),
+			"scopes": []any{"read:flags"},
+			"iat":    float64(now.Unix()),
+			"exp":    float64(now.Add(time.Hour).Unix()),
+		}
+		tokens.ValidateTokenReturns(claims, nil)
+	})
+
+	Describe("bearer tokens", func() {
+		It("returns the caller with the access token", func() {
+			caller, err := auth.Authenticate(ctx, authn.Credentials{BearerToken: "token"}, authn.ReadFlagsScope)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(caller.UserID).To(Equal(userID))
+			Expect(caller.OrgID).To(Equal(orgID))
+			Expect(caller.Scopes).To(Equal([]string{"read:flags"}))
+			Expect(caller.AccessToken).ToNot(BeNil())
+			Expect(caller.AccessToken.ID).To(Equal(claims["jti"]))
+			Expect(tokens.ValidateTokenArgsForCall(0)).To(Equal("token"))
+		})
+
+		It("rejects requests without a token", func() {
+			_, err := auth.Authenticate(ctx, authn.Credentials{}, "")
+			Expect(err).To(MatchError(authn.ErrMissingToken))
+		})
+
+		It("rejects invalid tokens", func() {
+			tokens.ValidateTokenReturns(nil, errors.New("expired"))
+			_, err := auth.Authenticate(ctx, authn.Credentials{BearerToken: "token"}, "")
+			Expect(err).To(MatchError(authn.ErrInvalidToken))
+		})
+
+		It("rejects tokens without the claims of an access token", func() {
+			delete(claims, "jti")
+			_, err := auth.Authenticate(ctx, authn.Credentials{BearerToken: "token"}, "")
+			Expect(err).To(MatchError(authn.ErrInvalidToken))
+		})
+
+		It("rejects revoked tokens before looking up the user", func() {
+			denylist.IsRevokedReturns(true)
+			_, err := auth.Authenticate(ctx, authn.Credentials{BearerToken: "token"}, "")
+			Expect(err).To(MatchError(authn.ErrRevokedToken))
+			Expect(authStore.UserExistsCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("API keys", func() {
+		var apiKeyID uuid.UUID
+
+		BeforeEach(func() {
+			apiKeyID = uuid.New()
+			apiKeys.AuthenticateReturns(jwt.MapClaims{
+				"sub":     userID.String(),
+				"org":     orgID.String(),
+				"scopes":  []string{"read:flags"},
+				"api_key": apiKeyID.String(),
+				"env":     "production",
+			}, nil)
+		})
+
+		It("returns the caller without an access token and skips the denylist", func() {
+			caller, err := auth.Authenticate(ctx, authn.Credentials{APIKey: "ffs_key", BearerToken: "token"}, authn.ReadFlagsScope)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(caller.UserID).To(Equal(userID))
+			Expect(caller.AccessToken).To(BeNil())
+			Expect(caller.APIKeyID).To(Equal(apiKeyID))
+			Expect(caller.Environment).To(Equal("production"))
+			_, key := apiKeys.AuthenticateArgsForCall(0)
+			Expect(key).To(Equal("ffs_key"))
+			Expect(tokens.ValidateTokenCallCount()).To(BeZero())
+			Expect(denylist.IsRevokedCallCount()).To(BeZero())
+		})
+
+		It("rejects revoked keys", func() {
+			apiKeys.AuthenticateReturns(nil, errors.New("revoked"))
+			_, err := auth.Authenticate(ctx, authn.Credentials{APIKey: "ffs_key"}, "")
+			Expect(err).To(MatchError(authn.ErrInvalidAPIKey))
+		})
+
+		It("rejects keys of another environment", func() {
+			apiKeys.AuthenticateReturns(jwt.MapClaims{
+				"sub":     userID.String(),
+				"org":     orgID.String(),
+				"scopes":  []string{"read:flags"},
+				"api_key": apiKeyID.String(),
+				"env":     "staging",
+			}, nil)
+			_, err := auth.Authenticate(ctx, authn.Credentials{APIKey: "ffs_key"}, "")
+			Expect(err).To(MatchError(authn.ErrWrongEnvironment))
+			Expect(authn.IsPermissionDenied(err)).To(BeTrue())
+			Expect(authStore.UserExistsCallCount()).To(BeZero())
+		})
+
+		It("rejects keys without an ID", func() {
+			apiKeys.AuthenticateReturns(jwt.MapClaims{
+				"sub":    userID.String(),
+				"org":    orgID.String(),
+				"scopes": []string{"read:flags"},
+				"env":    "production",
+			}, nil)
+			_, err := auth.Authenticate(ctx, authn.Credentials{APIKey: "ffs_key"}, "")
+			Expect(err).To(MatchError(authn.ErrInvalidAPIKey))
+		})
+
+		It("rejects callers that declare another environment", func() {
+			_, err := auth.Authenticate(ctx, authn.Credentials{APIKey: "ffs_key", Environment: "staging"}, "")
+			Expect(err).To(MatchError(authn.ErrWrongEnvironment))
+			Expect(apiKeys.AuthenticateCallCount()).To(BeZero())
+		})
+
+		It("are ignored without an authenticator for them", func() {
+			auth = authn.NewAuthenticator(authStore, denylist, tokens, nil, "production")
+			caller, err := auth.Authenticate(ctx, authn.Credentials{APIKey: "ffs_key", BearerToken: "token"}, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(caller.AccessToken).ToNot(BeNil())
+		})
+	})
+
+	Describe("the caller", func() {
+		It("must exist", func() {
+			authStore.UserExistsReturns(false, nil)
+			_, err := auth.Authenticate(ctx, authn.Credentials{BearerToken: "token"}, "")
+			Expect(err).To(MatchError(authn.ErrUserNotFound))
+			Expect(authn.IsUnauthenticated(err)).To(BeTrue())
+		})
+
+		It("must be a member of the organization of the token", func() {
+			authStore.IsOrgMemberReturns(false, nil)
+			_, err := auth.Authenticate(ctx, authn.Credentials{BearerToken: "token"}, "")
+			Expect(err).To(MatchError(authn.ErrNotOrgMember))
+			Expect(authn.IsPermissionDenied(err)).To(BeTrue())
+
+			_, actualOrgID, actualUserID := authStore.IsOrgMemberArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualUserID).To(Equal(userID))
+		})
+
+		It("must have the required scope", func() {
+			_, err := auth.Authenticate(ctx, authn.Credentials{BearerToken: "token"}, authn.WriteFlagsScope)
+			Expect(err).To(MatchError(authn.ErrInsufficientScope))
+			Expect(authn.IsPermissionDenied(err)).To(BeTrue())
+		})
+
+		It("reports the failures to check the membership as neither", func() {
+			authStore.IsOrgMemberReturns(false, errors.New("connection refused"))
+			_, err := auth.Authenticate(ctx, authn.Credentials{BearerToken: "token"}, "")
+			Expect(err).To(HaveOccurred())
+			Expect(authn.IsUnauthenticated(err)).To(BeFalse())
+			Expect(authn.IsPermissionDenied(err)).To(BeFalse())
+		})
+	})
+
+	Describe("Middleware", func() {
+		var (
+			e        *echo.Echo
+			recorder *httptest.ResponseRecorder
+			request  *http.Request
+		)
+
+		BeforeEach(func() {
+			e = echo.New()
+			e.GET("/flags", func(c echo.Context) error {
+				caller, err := authn.CallerFromContext(c)
+				if err != nil {
+					return err
+				}
+				return c.String(http.StatusOK, caller.OrgID.String())
+			}, auth.Middleware(authn.ReadFlagsScope))
+			recorder = httptest.NewRecorder()
+			request = httptest.NewRequest(http.MethodGet, "/flags", nil)
+		})
+
+		It("stores the caller in the context", func() {
+			request.Header.Set(echo.HeaderAuthorization, "Bearer token")
+			e.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(Equal(orgID.String()))
+			Expect(tokens.ValidateTokenArgsForCall(0)).To(Equal("token"))
+		})
+
+		It("answers unauthenticated requests with 401", func() {
+			e.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("answers requests without the permission with 403", func() {
+			authStore.IsOrgMemberReturns(false, nil)
+			request.Header.Set(echo.HeaderAuthorization, "Bearer token")
+			e.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package authnfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+type FakeAPIKeyAuthenticator struct {
+	AuthenticateStub        func(context.Context, string) (jwt.MapClaims, error)
+	authenticateMutex       sync.RWMutex
+	authenticateArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	authenticateReturns struct {
+		result1 jwt.MapClaims
+		result2 error
+	}
+	authenticateReturnsOnCall map[int]struct {
+		result1 jwt.MapClaims
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAPIKeyAuthenticator) Authenticate(arg1 context.Context, arg2 string) (jwt.MapClaims, error) {
+	fake.authenticateMutex.Lock()
+	ret, specificReturn := fake.authenticateReturnsOnCall[len(fake.authenticateArgsForCall)]
+	fake.authenticateArgsForCall = append(fake.authenticateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AuthenticateStub
+	fakeReturns := fake.authenticateReturns
+	fake.recordInvocation("Authenticate", []interface{}{arg1, arg2})
+	fake.authenticateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPIKeyAuthenticator) AuthenticateCallCount() int {
+	fake.authenticateMutex.RLock()
+	defer fake.authenticateMutex.RUnlock()
+	return len(fake.authenticateArgsForCall)
+}
+
+func (fake *FakeAPIKeyAuthenticator) AuthenticateCalls(stub func(context.Context, string) (jwt.MapClaims, error)) {
+	fake.authenticateMutex.Lock()
+	defer fake.authenticateMutex.Unlock()
+	fake.AuthenticateStub = stub
+}
+
+func (fake *FakeAPIKeyAuthenticator) AuthenticateArgsForCall(i int) (context.Context, string) {
+	fake.authenticateMutex.RLock()
+	defer fake.authenticateMutex.RUnlock()
+	argsForCall := fake.authenticateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPIKeyAuthenticator) AuthenticateReturns(result1 jwt.MapClaims, result2 error) {
+	fake.authenticateMutex.Lock()
+	defer fake.authenticateMutex.Unlock()
+	fake.AuthenticateStub = nil
+	fake.authenticateReturns = struct {
+		result1 jwt.MapClaims
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPIKeyAuthenticator) AuthenticateReturnsOnCall(i int, result1 jwt.MapClaims, result2 error) {
+	fake.authenticateMutex.Lock()
+	defer fake.authenticateMutex.Unlock()
+	fake.AuthenticateStub = nil
+	if fake.authenticateReturnsOnCall == nil {
+		fake.authenticateReturnsOnCall = make(map[int]struct {
+			result1 jwt.MapClaims
+			result2 error
+		})
+	}
+	fake.authenticateReturnsOnCall[i] = struct {
+		result1 jwt.MapClaims
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPIKeyAuthenticator) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAPIKeyAuthenticator) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ authn.APIKeyAuthenticator = new(FakeAPIKeyAuthenticator)
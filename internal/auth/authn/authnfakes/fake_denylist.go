@@ -0,0 +1,110 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package authnfakes
+
+import (
+	"sync"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+type FakeDenylist struct {
+	IsRevokedStub        func(jwt.MapClaims) bool
+	isRevokedMutex       sync.RWMutex
+	isRevokedArgsForCall []struct {
+		arg1 jwt.MapClaims
+	}
+	isRevokedReturns struct {
+		result1 bool
+	}
+	isRevokedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDenylist) IsRevoked(arg1 jwt.MapClaims) bool {
+	fake.isRevokedMutex.Lock()
+	ret, specificReturn := fake.isRevokedReturnsOnCall[len(fake.isRevokedArgsForCall)]
+	fake.isRevokedArgsForCall = append(fake.isRevokedArgsForCall, struct {
+		arg1 jwt.MapClaims
+	}{arg1})
+	stub := fake.IsRevokedStub
+	fakeReturns := fake.isRevokedReturns
+	fake.recordInvocation("IsRevoked", []interface{}{arg1})
+	fake.isRevokedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDenylist) IsRevokedCallCount() int {
+	fake.isRevokedMutex.RLock()
+	defer fake.isRevokedMutex.RUnlock()
+	return len(fake.isRevokedArgsForCall)
+}
+
+func (fake *FakeDenylist) IsRevokedCalls(stub func(jwt.MapClaims) bool) {
+	fake.isRevokedMutex.Lock()
+	defer fake.isRevokedMutex.Unlock()
+	fake.IsRevokedStub = stub
+}
+
+func (fake *FakeDenylist) IsRevokedArgsForCall(i int) jwt.MapClaims {
+	fake.isRevokedMutex.RLock()
+	defer fake.isRevokedMutex.RUnlock()
+	argsForCall := fake.isRevokedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDenylist) IsRevokedReturns(result1 bool) {
+	fake.isRevokedMutex.Lock()
+	defer fake.isRevokedMutex.Unlock()
+	fake.IsRevokedStub = nil
+	fake.isRevokedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeDenylist) IsRevokedReturnsOnCall(i int, result1 bool) {
+	fake.isRevokedMutex.Lock()
+	defer fake.isRevokedMutex.Unlock()
+	fake.IsRevokedStub = nil
+	if fake.isRevokedReturnsOnCall == nil {
+		fake.isRevokedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isRevokedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeDenylist) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDenylist) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ authn.Denylist = new(FakeDenylist)
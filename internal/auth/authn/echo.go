@@ -0,0 +1,78 @@
+package authn
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// APIKeyHeader carries the API key of services calling instead of a bearer token.
+	APIKeyHeader = "X-API-Key"
+
+	callerKey = "caller"
+)
+
+// Middleware authenticates the request by its API key or bearer token, see Authenticate, and
+// stores the caller in the echo context.
+func (a *Authenticator) Middleware(requiredScope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			credentials := Credentials{
+				APIKey:      c.Request().Header.Get(APIKeyHeader),
+				BearerToken: strings.TrimPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer "),
+			}
+			caller, err := a.Authenticate(c.Request().Context(), credentials, requiredScope)
+			if err != nil {
+				return httpError(err)
+			}
+
+			SetCaller(c, caller)
+			return next(c)
+		}
+	}
+}
+
+// SetCaller stores the caller in the echo context, where the handlers and the middlewares after
+// authentication find it.
+func SetCaller(c echo.Context, caller Caller) {
+	c.Set(callerKey, caller)
+}
+
+// CallerFromContext returns the caller the middleware authenticated.
+func CallerFromContext(c echo.Context) (Caller, error) {
+	caller, ok := c.Get(callerKey).(Caller)
+	if !ok {
+		return Caller{}, echo.NewHTTPError(http.StatusUnauthorized, ErrInvalidToken.Error())
+	}
+	return caller, nil
+}
+
+func UserIDFromContext(c echo.Context) (uuid.UUID, error) {
+	caller, err := CallerFromContext(c)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return caller.UserID, nil
+}
+
+func OrgIDFromContext(c echo.Context) (uuid.UUID, error) {
+	caller, err := CallerFromContext(c)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return caller.OrgID, nil
+}
+
+func httpError(err error) error {
+	switch {
+	case IsUnauthenticated(err):
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	case IsPermissionDenied(err):
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
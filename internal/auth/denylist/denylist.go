@@ -0,0 +1,207 @@
+// Package denylist keeps the revoked access tokens in memory so that every request can be checked
+// against them without a round trip to the store.
+package denylist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidClaims = errors.New("token lacks the claims required for revocation")
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate . Store
+type Store interface {
+	CreateTokenRevocation(context.Context, model.TokenRevocation) error
+	ListTokenRevocations(context.Context, time.Time) ([]model.TokenRevocation, error)
+	DeleteExpiredTokenRevocations(context.Context, time.Time) error
+}
+
+type cutoffKey struct {
+	orgID  uuid.UUID
+	userID uuid.UUID
+}
+
+type cutoff struct {
+	issuedBefore time.Time
+	expiresAt    time.Time
+}
+
+// Denylist persists revocations in the store and serves the checks from memory. Revocations made
+// by other instances are picked up on the next Sync.
+type Denylist struct {
+	store  Store
+	logger *slog.Logger
+
+	mu      sync.RWMutex
+	tokens  map[string]time.Time
+	cutoffs map[cutoffKey]cutoff
+}
+
+func New(store Store, logger *slog.Logger) *Denylist {
+	return &Denylist{
+		store:   store,
+		logger:  logger,
+		tokens:  map[string]time.Time{},
+		cutoffs: map[cutoffKey]cutoff{},
+	}
+}
+
+func (d *Denylist) Revoke(ctx context.Context, revocation model.TokenRevocation) error {
+	if err := d.store.CreateTokenRevocation(ctx, revocation); err != nil {
+		return fmt.Errorf("failed to store token revocation: %w", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dropExpired(time.Now())
+	d.add(revocation)
+	return nil
+}
+
+// IsRevoked reports whether the token with the given claims was revoked. Tokens without an ID or
+// issue time cannot be matched against revocations and are reported as revoked.
+func (d *Denylist) IsRevoked(claims jwt.MapClaims) bool {
+	token, err := AccessTokenFromClaims(claims)
+	if err != nil {
+		return true
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if _, ok := d.tokens[token.ID]; ok {
+		return true
+	}
+	for _, key := range []cutoffKey{{orgID: token.OrgID}, {orgID: token.OrgID, userID: token.UserID}} {
+		if c, ok := d.cutoffs[key]; ok && token.IssuedAt.Before(c.issuedBefore) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sync replaces the revocations in memory with those in the store and drops the expired ones.
+func (d *Denylist) Sync(ctx context.Context) error {
+	now := time.Now()
+	if err := d.store.DeleteExpiredTokenRevocations(ctx, now); err != nil {
+		return fmt.Errorf("failed to delete expired token revocations: %w", err)
+	}
+	revocations, err := d.store.ListTokenRevocations(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to list token revocations: %w", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.tokens = map[string]time.Time{}
+	d.cutoffs = map[cutoffKey]cutoff{}
+	for _, revocation := range revocations {
+		d.add(revocation)
+	}
+	return nil
+}
+
+// Start syncs the denylist right away and then on every interval until the context is done.
+func (d *Denylist) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Sync(ctx); err != nil {
+			d.logger.ErrorContext(ctx, "failed to sync token denylist", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Denylist) add(revocation model.TokenRevocation) {
+	if revocation.TokenID != "" {
+		d.tokens[revocation.TokenID] = revocation.ExpiresAt
+		return
+	}
+
+	key := cutoffKey{orgID: revocation.OrgID, userID: revocation.UserID}
+	current := d.cutoffs[key]
+	if revocation.IssuedBefore.After(current.issuedBefore) {
+		current.issuedBefore = revocation.IssuedBefore
+	}
+	if revocation.ExpiresAt.After(current.expiresAt) {
+		current.expiresAt = revocation.ExpiresAt
+	}
+	d.cutoffs[key] = current
+}
+
+// dropExpired removes the revocations of tokens that expired, which keeps the memory bounded while
+// the store cannot be synced.
+func (d *Denylist) dropExpired(now time.Time) {
+	for id, expiresAt := range d.tokens {
+		if !expiresAt.After(now) {
+			delete(d.tokens, id)
+		}
+	}
+	for key, c := range d.cutoffs {
+		if !c.expiresAt.After(now) {
+			delete(d.cutoffs, key)
+		}
+	}
+}
+
+func AccessTokenFromClaims(claims jwt.MapClaims) (model.AccessToken, error) {
+	id, _ := claims["jti"].(string)
+	if id == "" {
+		return model.AccessToken{}, ErrInvalidClaims
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil {
+		return model.AccessToken{}, ErrInvalidClaims
+	}
+	userID, err := uuid.Parse(subject)
+	if err != nil {
+		return model.AccessToken{}, ErrInvalidClaims
+	}
+	orgClaim, _ := claims["org"].(string)
+	orgID, err := uuid.Parse(orgClaim)
+	if err != nil {
+		return model.AccessToken{}, ErrInvalidClaims
+	}
+
+	// Revocations by issue time are checked in milliseconds, which the iat_ms claim carries next to
+	// the iat claim in seconds. Tokens without it, like the ones of OIDC providers, are checked by
+	// their iat claim.
+	iat, ok := claims["iat"].(float64)
+	if !ok {
+		return model.AccessToken{}, ErrInvalidClaims
+	}
+	issuedAt := time.UnixMilli(int64(math.Round(iat * 1000)))
+	if iatMillis, ok := claims["iat_ms"].(float64); ok {
+		issuedAt = time.UnixMilli(int64(iatMillis))
+	}
+	expiresAt, err := claims.GetExpirationTime()
+	if err != nil || expiresAt == nil {
+		return model.AccessToken{}, ErrInvalidClaims
+	}
+
+	return model.AccessToken{
+		ID:        id,
+		UserID:    userID,
+		OrgID:     orgID,
+		IssuedAt:  issuedAt,
+		ExpiresAt: expiresAt.Time,
+	}, nil
+}
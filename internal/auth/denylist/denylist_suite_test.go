@@ -0,0 +1,13 @@
+package denylist_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDenylist(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Denylist Suite")
+}
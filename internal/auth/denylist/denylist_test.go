@@ -0,0 +1,187 @@
+package denylist_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/denylist"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/denylist/denylistfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+var ErrDatabaseError = errors.New("database error")
+
+var _ = Describe("Denylist", func() {
+	var (
+		ctx    context.Context
+		store  *denylistfakes.FakeStore
+		list   *denylist.Denylist
+		userID uuid.UUID
+		orgID  uuid.UUID
+		now    time.Time
+	)
+
+	claimsIssuedAt := func(userID uuid.UUID, issuedAt time.Time) jwt.MapClaims {
+		return jwt.MapClaims{
+			"jti":    uuid.NewString(),
+			"sub":    userID.String(),
+			"org":    orgID.String(),
+			"iat":    float64(issuedAt.Unix()),
+			"iat_ms": float64(issuedAt.UnixMilli()),
+			"exp":    float64(issuedAt.Add(15 * time.Minute).Unix()),
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = &denylistfakes.FakeStore{}
+		list = denylist.New(store, slog.New(slog.DiscardHandler))
+		userID = uuid.New()
+		orgID = uuid.New()
+		now = time.Now()
+	})
+
+	It("lets tokens that were not revoked through", func() {
+		Expect(list.IsRevoked(claimsIssuedAt(userID, now))).To(BeFalse())
+	})
+
+	It("reports tokens without the revocation claims as revoked", func() {
+		claims := claimsIssuedAt(userID, now)
+		delete(claims, "jti")
+		Expect(list.IsRevoked(claims)).To(BeTrue())
+	})
+
+	Describe("Revoke", func() {
+		It("revokes a single token", func() {
+			claims := claimsIssuedAt(userID, now)
+			Expect(list.Revoke(ctx, model.TokenRevocation{
+				TokenID:   claims["jti"].(string),
+				OrgID:     orgID,
+				ExpiresAt: now.Add(time.Minute),
+			})).To(Succeed())
+
+			Expect(store.CreateTokenRevocationCallCount()).To(Equal(1))
+			Expect(list.IsRevoked(claims)).To(BeTrue())
+			Expect(list.IsRevoked(claimsIssuedAt(userID, now))).To(BeFalse())
+		})
+
+		It("revokes the tokens of a user issued before the cutoff", func() {
+			Expect(list.Revoke(ctx, model.TokenRevocation{
+				OrgID:        orgID,
+				UserID:       userID,
+				IssuedBefore: now,
+				ExpiresAt:    now.Add(15 * time.Minute),
+			})).To(Succeed())
+
+			Expect(list.IsRevoked(claimsIssuedAt(userID, now.Add(-time.Millisecond)))).To(BeTrue())
+			Expect(list.IsRevoked(claimsIssuedAt(userID, now.Add(time.Millisecond)))).To(BeFalse())
+			Expect(list.IsRevoked(claimsIssuedAt(uuid.New(), now.Add(-time.Minute)))).To(BeFalse())
+		})
+
+		It("revokes the tokens of the whole organization issued before the cutoff", func() {
+			Expect(list.Revoke(ctx, model.TokenRevocation{
+				OrgID:        orgID,
+				IssuedBefore: now,
+				ExpiresAt:    now.Add(15 * time.Minute),
+			})).To(Succeed())
+
+			Expect(list.IsRevoked(claimsIssuedAt(uuid.New(), now.Add(-time.Minute)))).To(BeTrue())
+
+			claims := claimsIssuedAt(userID, now.Add(-time.Minute))
+			claims["org"] = uuid.NewString()
+			Expect(list.IsRevoked(claims)).To(BeFalse())
+		})
+
+		It("keeps the latest cutoff", func() {
+			for _, issuedBefore := range []time.Time{now, now.Add(-time.Hour)} {
+				Expect(list.Revoke(ctx, model.TokenRevocation{
+					OrgID:        orgID,
+					IssuedBefore: issuedBefore,
+					ExpiresAt:    issuedBefore.Add(15 * time.Minute),
+				})).To(Succeed())
+			}
+
+			Expect(list.IsRevoked(claimsIssuedAt(userID, now.Add(-time.Minute)))).To(BeTrue())
+		})
+
+		It("does not revoke the token when the revocation cannot be stored", func() {
+			store.CreateTokenRevocationReturns(ErrDatabaseError)
+			claims := claimsIssuedAt(userID, now)
+
+			err := list.Revoke(ctx, model.TokenRevocation{TokenID: claims["jti"].(string), OrgID: orgID, ExpiresAt: now.Add(time.Minute)})
+			Expect(err).To(MatchError(ErrDatabaseError))
+			Expect(list.IsRevoked(claims)).To(BeFalse())
+		})
+	})
+
+	Describe("Sync", func() {
+		It("loads the revocations made by other instances", func() {
+			claims := claimsIssuedAt(userID, now)
+			store.ListTokenRevocationsReturns([]model.TokenRevocation{
+				{TokenID: claims["jti"].(string), OrgID: orgID, ExpiresAt: now.Add(time.Minute)},
+			}, nil)
+
+			Expect(list.Sync(ctx)).To(Succeed())
+			Expect(store.DeleteExpiredTokenRevocationsCallCount()).To(Equal(1))
+			Expect(list.IsRevoked(claims)).To(BeTrue())
+		})
+
+		It("forgets the revocations that are gone from the store", func() {
+			claims := claimsIssuedAt(userID, now)
+			Expect(list.Revoke(ctx, model.TokenRevocation{
+				TokenID:   claims["jti"].(string),
+				OrgID:     orgID,
+				ExpiresAt: now.Add(time.Minute),
+			})).To(Succeed())
+
+			Expect(list.Sync(ctx)).To(Succeed())
+			Expect(list.IsRevoked(claims)).To(BeFalse())
+		})
+
+		It("keeps the revocations in memory when the store cannot be read", func() {
+			claims := claimsIssuedAt(userID, now)
+			Expect(list.Revoke(ctx, model.TokenRevocation{
+				TokenID:   claims["jti"].(string),
+				OrgID:     orgID,
+				ExpiresAt: now.Add(time.Minute),
+			})).To(Succeed())
+			store.ListTokenRevocationsReturns(nil, ErrDatabaseError)
+
+			Expect(list.Sync(ctx)).To(MatchError(ErrDatabaseError))
+			Expect(list.IsRevoked(claims)).To(BeTrue())
+		})
+	})
+
+	Describe("AccessTokenFromClaims", func() {
+		It("keeps the milliseconds of the issue time", func() {
+			issuedAt := time.UnixMilli(now.UnixMilli())
+			token, err := denylist.AccessTokenFromClaims(claimsIssuedAt(userID, issuedAt))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.IssuedAt).To(BeTemporally("==", issuedAt))
+			Expect(token.UserID).To(Equal(userID))
+			Expect(token.OrgID).To(Equal(orgID))
+		})
+
+		It("falls back to the issue time in seconds without the milliseconds", func() {
+			claims := claimsIssuedAt(userID, now)
+			delete(claims, "iat_ms")
+			token, err := denylist.AccessTokenFromClaims(claims)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.IssuedAt).To(BeTemporally("==", time.Unix(now.Unix(), 0)))
+		})
+
+		It("rejects tokens without an organization", func() {
+			claims := claimsIssuedAt(userID, now)
+			delete(claims, "org")
+			_, err := denylist.AccessTokenFromClaims(claims)
+			Expect(err).To(MatchError(denylist.ErrInvalidClaims))
+		})
+	})
+})
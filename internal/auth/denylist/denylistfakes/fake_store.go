@@ -0,0 +1,267 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package denylistfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/denylist"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+type FakeStore struct {
+	CreateTokenRevocationStub        func(context.Context, model.TokenRevocation) error
+	createTokenRevocationMutex       sync.RWMutex
+	createTokenRevocationArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.TokenRevocation
+	}
+	createTokenRevocationReturns struct {
+		result1 error
+	}
+	createTokenRevocationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteExpiredTokenRevocationsStub        func(context.Context, time.Time) error
+	deleteExpiredTokenRevocationsMutex       sync.RWMutex
+	deleteExpiredTokenRevocationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	deleteExpiredTokenRevocationsReturns struct {
+		result1 error
+	}
+	deleteExpiredTokenRevocationsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListTokenRevocationsStub        func(context.Context, time.Time) ([]model.TokenRevocation, error)
+	listTokenRevocationsMutex       sync.RWMutex
+	listTokenRevocationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	listTokenRevocationsReturns struct {
+		result1 []model.TokenRevocation
+		result2 error
+	}
+	listTokenRevocationsReturnsOnCall map[int]struct {
+		result1 []model.TokenRevocation
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStore) CreateTokenRevocation(arg1 context.Context, arg2 model.TokenRevocation) error {
+	fake.createTokenRevocationMutex.Lock()
+	ret, specificReturn := fake.createTokenRevocationReturnsOnCall[len(fake.createTokenRevocationArgsForCall)]
+	fake.createTokenRevocationArgsForCall = append(fake.createTokenRevocationArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.TokenRevocation
+	}{arg1, arg2})
+	stub := fake.CreateTokenRevocationStub
+	fakeReturns := fake.createTokenRevocationReturns
+	fake.recordInvocation("CreateTokenRevocation", []interface{}{arg1, arg2})
+	fake.createTokenRevocationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateTokenRevocationCallCount() int {
+	fake.createTokenRevocationMutex.RLock()
+	defer fake.createTokenRevocationMutex.RUnlock()
+	return len(fake.createTokenRevocationArgsForCall)
+}
+
+func (fake *FakeStore) CreateTokenRevocationCalls(stub func(context.Context, model.TokenRevocation) error) {
+	fake.createTokenRevocationMutex.Lock()
+	defer fake.createTokenRevocationMutex.Unlock()
+	fake.CreateTokenRevocationStub = stub
+}
+
+func (fake *FakeStore) CreateTokenRevocationArgsForCall(i int) (context.Context, model.TokenRevocation) {
+	fake.createTokenRevocationMutex.RLock()
+	defer fake.createTokenRevocationMutex.RUnlock()
+	argsForCall := fake.createTokenRevocationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CreateTokenRevocationReturns(result1 error) {
+	fake.createTokenRevocationMutex.Lock()
+	defer fake.createTokenRevocationMutex.Unlock()
+	fake.CreateTokenRevocationStub = nil
+	fake.createTokenRevocationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateTokenRevocationReturnsOnCall(i int, result1 error) {
+	fake.createTokenRevocationMutex.Lock()
+	defer fake.createTokenRevocationMutex.Unlock()
+	fake.CreateTokenRevocationStub = nil
+	if fake.createTokenRevocationReturnsOnCall == nil {
+		fake.createTokenRevocationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createTokenRevocationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteExpiredTokenRevocations(arg1 context.Context, arg2 time.Time) error {
+	fake.deleteExpiredTokenRevocationsMutex.Lock()
+	ret, specificReturn := fake.deleteExpiredTokenRevocationsReturnsOnCall[len(fake.deleteExpiredTokenRevocationsArgsForCall)]
+	fake.deleteExpiredTokenRevocationsArgsForCall = append(fake.deleteExpiredTokenRevocationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.DeleteExpiredTokenRevocationsStub
+	fakeReturns := fake.deleteExpiredTokenRevocationsReturns
+	fake.recordInvocation("DeleteExpiredTokenRevocations", []interface{}{arg1, arg2})
+	fake.deleteExpiredTokenRevocationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteExpiredTokenRevocationsCallCount() int {
+	fake.deleteExpiredTokenRevocationsMutex.RLock()
+	defer fake.deleteExpiredTokenRevocationsMutex.RUnlock()
+	return len(fake.deleteExpiredTokenRevocationsArgsForCall)
+}
+
+func (fake *FakeStore) DeleteExpiredTokenRevocationsCalls(stub func(context.Context, time.Time) error) {
+	fake.deleteExpiredTokenRevocationsMutex.Lock()
+	defer fake.deleteExpiredTokenRevocationsMutex.Unlock()
+	fake.DeleteExpiredTokenRevocationsStub = stub
+}
+
+func (fake *FakeStore) DeleteExpiredTokenRevocationsArgsForCall(i int) (context.Context, time.Time) {
+	fake.deleteExpiredTokenRevocationsMutex.RLock()
+	defer fake.deleteExpiredTokenRevocationsMutex.RUnlock()
+	argsForCall := fake.deleteExpiredTokenRevocationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) DeleteExpiredTokenRevocationsReturns(result1 error) {
+	fake.deleteExpiredTokenRevocationsMutex.Lock()
+	defer fake.deleteExpiredTokenRevocationsMutex.Unlock()
+	fake.DeleteExpiredTokenRevocationsStub = nil
+	fake.deleteExpiredTokenRevocationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteExpiredTokenRevocationsReturnsOnCall(i int, result1 error) {
+	fake.deleteExpiredTokenRevocationsMutex.Lock()
+	defer fake.deleteExpiredTokenRevocationsMutex.Unlock()
+	fake.DeleteExpiredTokenRevocationsStub = nil
+	if fake.deleteExpiredTokenRevocationsReturnsOnCall == nil {
+		fake.deleteExpiredTokenRevocationsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteExpiredTokenRevocationsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) ListTokenRevocations(arg1 context.Context, arg2 time.Time) ([]model.TokenRevocation, error) {
+	fake.listTokenRevocationsMutex.Lock()
+	ret, specificReturn := fake.listTokenRevocationsReturnsOnCall[len(fake.listTokenRevocationsArgsForCall)]
+	fake.listTokenRevocationsArgsForCall = append(fake.listTokenRevocationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.ListTokenRevocationsStub
+	fakeReturns := fake.listTokenRevocationsReturns
+	fake.recordInvocation("ListTokenRevocations", []interface{}{arg1, arg2})
+	fake.listTokenRevocationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListTokenRevocationsCallCount() int {
+	fake.listTokenRevocationsMutex.RLock()
+	defer fake.listTokenRevocationsMutex.RUnlock()
+	return len(fake.listTokenRevocationsArgsForCall)
+}
+
+func (fake *FakeStore) ListTokenRevocationsCalls(stub func(context.Context, time.Time) ([]model.TokenRevocation, error)) {
+	fake.listTokenRevocationsMutex.Lock()
+	defer fake.listTokenRevocationsMutex.Unlock()
+	fake.ListTokenRevocationsStub = stub
+}
+
+func (fake *FakeStore) ListTokenRevocationsArgsForCall(i int) (context.Context, time.Time) {
+	fake.listTokenRevocationsMutex.RLock()
+	defer fake.listTokenRevocationsMutex.RUnlock()
+	argsForCall := fake.listTokenRevocationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListTokenRevocationsReturns(result1 []model.TokenRevocation, result2 error) {
+	fake.listTokenRevocationsMutex.Lock()
+	defer fake.listTokenRevocationsMutex.Unlock()
+	fake.ListTokenRevocationsStub = nil
+	fake.listTokenRevocationsReturns = struct {
+		result1 []model.TokenRevocation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListTokenRevocationsReturnsOnCall(i int, result1 []model.TokenRevocation, result2 error) {
+	fake.listTokenRevocationsMutex.Lock()
+	defer fake.listTokenRevocationsMutex.Unlock()
+	fake.ListTokenRevocationsStub = nil
+	if fake.listTokenRevocationsReturnsOnCall == nil {
+		fake.listTokenRevocationsReturnsOnCall = make(map[int]struct {
+			result1 []model.TokenRevocation
+			result2 error
+		})
+	}
+	fake.listTokenRevocationsReturnsOnCall[i] = struct {
+		result1 []model.TokenRevocation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStore) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ denylist.Store = new(FakeStore)
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handler) listAPIKeys(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	keys, err := h.svc.ListAPIKeys(c.Request().Context(), orgID)
+	if err != nil {
+		return apiKeyError(err)
+	}
+
+	return c.JSON(http.StatusOK, keys)
+}
+
+func (h *Handler) createAPIKey(c echo.Context) error {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.APIKeyRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid API key: %w", err))
+	}
+
+	key, err := h.svc.CreateAPIKey(c.Request().Context(), orgID, userID, req)
+	if err != nil {
+		return apiKeyError(err)
+	}
+
+	return c.JSON(http.StatusCreated, key)
+}
+
+func (h *Handler) revokeAPIKey(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid API key ID")
+	}
+
+	if err := h.svc.RevokeAPIKey(c.Request().Context(), orgID, id); err != nil {
+		return apiKeyError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func apiKeyError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrAPIKeyNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrClientAPIKeyScopes):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,152 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler/handlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/labstack/echo/v4"
+)
+
+var _ = Describe("API Keys Handler", func() {
+	var (
+		e         *echo.Echo
+		recorder  *httptest.ResponseRecorder
+		svc       *handlerfakes.FakeService
+		jwtHelper *handlerfakes.FakeJWTHelper
+		userID    uuid.UUID
+		orgID     uuid.UUID
+		scopes    []any
+		key       model.APIKey
+	)
+
+	BeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
+		e.Validator = validator.GetValidator()
+		recorder = httptest.NewRecorder()
+		svc = &handlerfakes.FakeService{}
+		jwtHelper = &handlerfakes.FakeJWTHelper{}
+		handler.NewHandler(svc, memberAuthStore(), &handlerfakes.FakeDenylist{}, jwtHelper).RegisterHandlers(e)
+
+		userID = uuid.New()
+		orgID = uuid.New()
+		scopes = []any{"read:flags", "admin:apikeys"}
+		key = model.APIKey{
+			ID:          uuid.New(),
+			OrgID:       orgID,
+			Name:        "checkout",
+			Environment: "production",
+			Kind:        model.APIKeyKindServer,
+			Prefix:      "ffs_abcdefgh",
+			Hash:        "hash",
+			Scopes:      []string{"read:flags"},
+			CreatedBy:   userID,
+			CreatedAt:   time.Now().UTC(),
+		}
+	})
+
+	serve := func(method, target, body string) {
+		jwtHelper.ValidateTokenReturns(accessTokenClaims(userID, orgID, scopes), nil)
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer token")
+		e.ServeHTTP(recorder, req)
+	}
+
+	It("requires the admin:apikeys scope", func() {
+		scopes = []any{"read:flags", "admin:users"}
+		serve(http.MethodGet, "/api-keys", "")
+		Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		Expect(svc.ListAPIKeysCallCount()).To(BeZero())
+	})
+
+	Describe("GET /api-keys", func() {
+		It("lists the keys of the organization without their hashes", func() {
+			svc.ListAPIKeysReturns([]model.APIKey{key}, nil)
+			serve(http.MethodGet, "/api-keys", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, actualOrgID := svc.ListAPIKeysArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(recorder.Body.String()).ToNot(ContainSubstring(`"hash"`))
+		})
+	})
+
+	Describe("POST /api-keys", func() {
+		It("creates the key for the caller and returns it once", func() {
+			svc.CreateAPIKeyReturns(model.CreatedAPIKey{APIKey: key, Key: "ffs_abcdefghsecret"}, nil)
+			serve(http.MethodPost, "/api-keys",
+				`{"name":"checkout","environment":"production","kind":"server","scopes":["read:flags"]}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			_, actualOrgID, actualUserID, req := svc.CreateAPIKeyArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualUserID).To(Equal(userID))
+			Expect(req).To(Equal(model.APIKeyRequest{
+				Name:        "checkout",
+				Environment: "production",
+				Kind:        model.APIKeyKindServer,
+				Scopes:      []string{"read:flags"},
+			}))
+
+			var created map[string]any
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &created)).To(Succeed())
+			Expect(created).To(HaveKeyWithValue("key", "ffs_abcdefghsecret"))
+			Expect(created).To(HaveKeyWithValue("environment", "production"))
+		})
+
+		It("accepts the sync scope for the key of a sync tool", func() {
+			svc.CreateAPIKeyReturns(model.CreatedAPIKey{APIKey: key, Key: "ffs_abcdefghsecret"}, nil)
+			serve(http.MethodPost, "/api-keys",
+				`{"name":"gitops","environment":"production","kind":"server","scopes":["write:flags","sync:flags"]}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			_, _, _, req := svc.CreateAPIKeyArgsForCall(0)
+			Expect(req.Scopes).To(Equal([]string{"write:flags", "sync:flags"}))
+		})
+
+		It("rejects scopes keys cannot have", func() {
+			serve(http.MethodPost, "/api-keys",
+				`{"name":"checkout","environment":"production","kind":"server","scopes":["admin:flags"]}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(svc.CreateAPIKeyCallCount()).To(BeZero())
+		})
+
+		It("returns 400 for client keys that could write", func() {
+			svc.CreateAPIKeyReturns(model.CreatedAPIKey{}, service.ErrClientAPIKeyScopes)
+			serve(http.MethodPost, "/api-keys",
+				`{"name":"web","environment":"production","kind":"client","scopes":["write:flags"]}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("DELETE /api-keys/:id", func() {
+		It("revokes the key", func() {
+			serve(http.MethodDelete, "/api-keys/"+key.ID.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualOrgID, actualID := svc.RevokeAPIKeyArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(key.ID))
+		})
+
+		It("returns 404 for unknown keys", func() {
+			svc.RevokeAPIKeyReturns(model.ErrAPIKeyNotFound)
+			serve(http.MethodDelete, "/api-keys/"+key.ID.String(), "")
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -4,10 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/jwthelper"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
@@ -15,19 +22,164 @@ import (
 //go:generate gowrap gen -g -p ./ -i Service -t ../../observability/templates/otel_trace.tmpl -o ./wrapped/trace/service.go
 //counterfeiter:generate . Service
 type Service interface {
-	Authenticate(context.Context, string, string) (string, error)
+	Authenticate(context.Context, string, string, uuid.UUID, string) (model.AuthResponse, error)
+	CompleteMFALogin(context.Context, model.MFALoginRequest, string) (model.AuthResponse, error)
+	Refresh(context.Context, string) (model.AuthResponse, error)
+
+	CreateOrg(context.Context, uuid.UUID, model.OrganizationRequest) (model.Organization, error)
+	ListUserOrgs(context.Context, uuid.UUID) ([]model.Organization, error)
+	GetOrg(context.Context, uuid.UUID, uuid.UUID) (model.Organization, error)
+	ListOrgMembers(context.Context, uuid.UUID, uuid.UUID) ([]model.OrganizationMember, error)
+	InviteOrgMember(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, model.RoleType) (model.OrgInvitation, error)
+	RemoveOrgMember(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	ListInvitations(context.Context, uuid.UUID) ([]model.OrgInvitation, error)
+	AcceptOrgInvitation(context.Context, uuid.UUID, uuid.UUID) error
+	DeclineOrgInvitation(context.Context, uuid.UUID, uuid.UUID) error
+
+	CreateUser(context.Context, uuid.UUID, model.CreateUserRequest) (model.User, error)
+	ListUsers(context.Context, uuid.UUID) ([]model.User, error)
+	GetUser(context.Context, uuid.UUID, uuid.UUID) (model.User, error)
+	UpdateUserRole(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, model.RoleType) error
+	SetUserDisabled(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, bool) error
+	ResetUserPassword(context.Context, uuid.UUID, uuid.UUID, string) error
+	DeleteUser(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	UnlockUser(context.Context, uuid.UUID, uuid.UUID) error
+	ResetUserMFA(context.Context, uuid.UUID, uuid.UUID) error
+	ListUserIdentities(context.Context, uuid.UUID, uuid.UUID) ([]model.ExternalIdentity, error)
+	LinkUserIdentity(context.Context, uuid.UUID, uuid.UUID, model.ExternalIdentityRequest) (model.ExternalIdentity, error)
+	UnlinkUserIdentity(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+
+	MFAStatus(context.Context, uuid.UUID) (model.MFAStatus, error)
+	EnrollMFA(context.Context, uuid.UUID) (model.MFAEnrollment, error)
+	VerifyMFA(context.Context, uuid.UUID, string) (model.RecoveryCodes, error)
+	RegenerateRecoveryCodes(context.Context, uuid.UUID, string) (model.RecoveryCodes, error)
+	DisableMFA(context.Context, uuid.UUID, uuid.UUID, string) error
+	GetMFAPolicy(context.Context, uuid.UUID) (model.MFAPolicy, error)
+	UpdateMFAPolicy(context.Context, uuid.UUID, model.MFAPolicy) (model.MFAPolicy, error)
+
+	Logout(context.Context, model.AccessToken, string) error
+	RevokeToken(context.Context, uuid.UUID, uuid.UUID, model.AccessToken) error
+	RevokeUserTokens(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	RevokeTokensIssuedBefore(context.Context, uuid.UUID, uuid.UUID, time.Time) error
+
+	CreateAPIKey(context.Context, uuid.UUID, uuid.UUID, model.APIKeyRequest) (model.CreatedAPIKey, error)
+	ListAPIKeys(context.Context, uuid.UUID) ([]model.APIKey, error)
+	RevokeAPIKey(context.Context, uuid.UUID, uuid.UUID) error
+
+	OAuthToken(context.Context, model.TokenRequest, string) (model.TokenResponse, error)
+	IntrospectToken(context.Context, model.ClientTokenRequest) (model.IntrospectionResponse, error)
+	RevokeOAuthToken(context.Context, model.ClientTokenRequest) error
+	RegisterOAuthClient(context.Context, uuid.UUID, uuid.UUID, model.OAuthClientRequest) (model.CreatedOAuthClient, error)
+	ListOAuthClients(context.Context, uuid.UUID) ([]model.OAuthClient, error)
+	DeleteOAuthClient(context.Context, uuid.UUID, uuid.UUID) error
+}
+
+//go:generate gowrap gen -g -p ./ -i AuthStore -t ../../observability/templates/otel_trace.tmpl -o ./wrapped/trace/auth_store.go
+//go:generate gowrap gen -g -p ./ -i AuthStore -t ../../observability/templates/otel_metric.tmpl -o ./wrapped/metric/auth_store.go
+//counterfeiter:generate . AuthStore
+type AuthStore interface {
+	UserExists(context.Context, uuid.UUID) (bool, error)
+	IsOrgMember(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+}
+
+//counterfeiter:generate . Denylist
+type Denylist interface {
+	IsRevoked(jwt.MapClaims) bool
+}
+
+//go:generate gowrap gen -g -p ./ -i JWTHelper -t ../../observability/templates/otel_trace.tmpl -o ./wrapped/trace/jwt_helper.go
+//go:generate gowrap gen -g -p ./ -i JWTHelper -t ../../observability/templates/otel_metric.tmpl -o ./wrapped/metric/jwt_helper.go
+//counterfeiter:generate . JWTHelper
+type JWTHelper interface {
+	ValidateToken(string) (jwt.MapClaims, error)
+	JWKS() jwthelper.JWKSet
+	Issuer() string
 }
 
 type Handler struct {
-	svc Service
+	svc       Service
+	jwtHelper JWTHelper
+	auth      *authn.Authenticator
 }
 
-func NewHandler(svc Service) *Handler {
-	return &Handler{svc: svc}
+// NewHandler returns the handler of the auth endpoints, which take bearer tokens but no API keys.
+func NewHandler(svc Service, authStore AuthStore, denylist Denylist, jwtHelper JWTHelper) *Handler {
+	return &Handler{
+		svc:       svc,
+		jwtHelper: jwtHelper,
+		auth:      authn.NewAuthenticator(authStore, denylist, jwtHelper, nil, ""),
+	}
 }
 
-func (h *Handler) RegisterHandlers(srv *echo.Echo) {
-	srv.POST("/auth", h.authenticateHandler)
+// RegisterHandlers registers the routes of the handler. The middlewares run on every route, after
+// the authentication of those that require it.
+func (h *Handler) RegisterHandlers(srv *echo.Echo, middlewares ...echo.MiddlewareFunc) {
+	authenticated := func(scope string) []echo.MiddlewareFunc {
+		return append([]echo.MiddlewareFunc{h.auth.Middleware(scope)}, middlewares...)
+	}
+
+	srv.GET(jwksPath, h.jwks, middlewares...)
+	srv.GET("/.well-known/openid-configuration", h.discovery, middlewares...)
+
+	srv.POST("/auth", h.authenticateHandler, middlewares...)
+	srv.POST("/auth/mfa", h.mfaLoginHandler, middlewares...)
+	srv.POST("/auth/refresh", h.refreshHandler, middlewares...)
+	srv.POST("/auth/logout", h.logoutHandler, authenticated("")...)
+	srv.POST("/auth/revoke", h.revokeHandler, authenticated(authn.TokensAdminScope)...)
+
+	srv.POST(oauthTokenPath, h.tokenHandler, middlewares...)
+	srv.POST(oauthIntrospectPath, h.introspectHandler, middlewares...)
+	srv.POST(oauthRevokePath, h.revokeTokenHandler, middlewares...)
+	oauthClientsGroup := srv.Group("/oauth/clients", authenticated(authn.ClientsAdminScope)...)
+	oauthClientsGroup.GET("", h.listOAuthClients)
+	oauthClientsGroup.POST("", h.registerOAuthClient)
+	oauthClientsGroup.DELETE("/:id", h.deleteOAuthClient)
+
+	orgsGroup := srv.Group("/orgs", authenticated("")...)
+	orgsGroup.GET("", h.listOrgs)
+	orgsGroup.GET("/:id", h.getOrg)
+	orgsGroup.GET("/:id/members", h.listOrgMembers)
+
+	orgsAdminGroup := srv.Group("/orgs", authenticated(authn.OrgsAdminScope)...)
+	orgsAdminGroup.POST("", h.createOrg)
+	orgsAdminGroup.PUT("/:id/members/:userID", h.inviteOrgMember)
+	orgsAdminGroup.DELETE("/:id/members/:userID", h.removeOrgMember)
+
+	invitationsGroup := srv.Group("/invitations", authenticated("")...)
+	invitationsGroup.GET("", h.listInvitations)
+	invitationsGroup.POST("/:orgID/accept", h.acceptInvitation)
+	invitationsGroup.DELETE("/:orgID", h.declineInvitation)
+
+	usersGroup := srv.Group("/users", authenticated(authn.UsersAdminScope)...)
+	usersGroup.GET("", h.listUsers)
+	usersGroup.POST("", h.createUser)
+	usersGroup.GET("/:id", h.getUser)
+	usersGroup.DELETE("/:id", h.deleteUser)
+	usersGroup.PUT("/:id/role", h.updateUserRole)
+	usersGroup.PUT("/:id/password", h.resetUserPassword)
+	usersGroup.POST("/:id/disable", h.disableUser)
+	usersGroup.POST("/:id/enable", h.enableUser)
+	usersGroup.POST("/:id/unlock", h.unlockUser)
+	usersGroup.DELETE("/:id/mfa", h.resetUserMFA)
+	usersGroup.GET("/:id/identities", h.listUserIdentities)
+	usersGroup.POST("/:id/identities", h.linkUserIdentity)
+	usersGroup.DELETE("/:id/identities/:identityID", h.unlinkUserIdentity)
+
+	mfaGroup := srv.Group("/mfa", authenticated("")...)
+	mfaGroup.GET("", h.mfaStatus)
+	mfaGroup.POST("/enroll", h.enrollMFA)
+	mfaGroup.POST("/verify", h.verifyMFA)
+	mfaGroup.POST("/recovery-codes", h.regenerateRecoveryCodes)
+	mfaGroup.POST("/disable", h.disableMFA)
+
+	mfaPolicyGroup := srv.Group("/mfa/policy", authenticated(authn.UsersAdminScope)...)
+	mfaPolicyGroup.GET("", h.getMFAPolicy)
+	mfaPolicyGroup.PUT("", h.updateMFAPolicy)
+
+	apiKeysGroup := srv.Group("/api-keys", authenticated(authn.APIKeysAdminScope)...)
+	apiKeysGroup.GET("", h.listAPIKeys)
+	apiKeysGroup.POST("", h.createAPIKey)
+	apiKeysGroup.DELETE("/:id", h.revokeAPIKey)
 }
 
 func (h *Handler) authenticateHandler(c echo.Context) error {
@@ -39,13 +191,57 @@ func (h *Handler) authenticateHandler(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
 	}
 
-	token, err := h.svc.Authenticate(c.Request().Context(), req.Username, req.Password)
+	resp, err := h.svc.Authenticate(c.Request().Context(), req.Username, req.Password, req.OrgID, c.RealIP())
 	if err != nil {
+		var mfaRequired *service.MFARequiredError
+		if errors.As(err, &mfaRequired) {
+			return c.JSON(http.StatusAccepted, mfaRequired.Challenge)
+		}
+		if retryAfter, ok := loginRetryAfter(err); ok {
+			c.Response().Header().Set(echo.HeaderRetryAfter, retryAfter)
+			return echo.NewHTTPError(http.StatusTooManyRequests, err.Error())
+		}
 		if errors.Is(err, service.ErrInvalidCredentials) {
 			return echo.NewHTTPError(http.StatusUnauthorized, "invalid credentials")
 		}
+		if errors.Is(err, service.ErrNotOrgMember) || errors.Is(err, service.ErrUserDisabled) {
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to authenticate user")
 	}
 
-	return c.JSON(http.StatusOK, model.AuthResponse{Token: token})
+	return c.JSON(http.StatusOK, resp)
+}
+
+// loginRetryAfter returns the Retry-After header value, in seconds, of a login that is delayed or
+// locked after failed attempts.
+func loginRetryAfter(err error) (string, bool) {
+	var locked *service.LoginLockedError
+	if !errors.As(err, &locked) {
+		return "", false
+	}
+	return strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds()))), true
+}
+
+func (h *Handler) refreshHandler(c echo.Context) error {
+	var req model.RefreshRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
+	}
+
+	resp, err := h.svc.Refresh(c.Request().Context(), req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRefreshToken) || errors.Is(err, service.ErrRefreshTokenReused) {
+			return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+		}
+		if errors.Is(err, service.ErrNotOrgMember) {
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to refresh token")
+	}
+
+	return c.JSON(http.StatusOK, resp)
 }
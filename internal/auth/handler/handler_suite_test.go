@@ -2,7 +2,11 @@ package handler_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler/handlerfakes"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -11,3 +15,26 @@ func TestHandler(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Auth Handler Suite")
 }
+
+// memberAuthStore returns an auth store that reports every caller as an enabled member of their
+// organization.
+func memberAuthStore() *handlerfakes.FakeAuthStore {
+	authStore := &handlerfakes.FakeAuthStore{}
+	authStore.UserExistsReturns(true, nil)
+	authStore.IsOrgMemberReturns(true, nil)
+	return authStore
+}
+
+// accessTokenClaims returns the claims of an access token as the JWT helper decodes them.
+func accessTokenClaims(userID, orgID uuid.UUID, scopes []any) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"jti":    uuid.NewString(),
+		"sub":    userID.String(),
+		"org":    orgID.String(),
+		"scopes": scopes,
+		"iat":    float64(now.Unix()),
+		"iat_ms": float64(now.UnixMilli()),
+		"exp":    float64(now.Add(time.Hour).Unix()),
+	}
+}
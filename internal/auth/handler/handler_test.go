@@ -6,12 +6,15 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler/handlerfakes"
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
 	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -24,24 +27,28 @@ var _ = Describe("Authentication Handler", func() {
 		e           *echo.Echo
 		recorder    *httptest.ResponseRecorder
 		authService *handlerfakes.FakeService
+		jwtHelper   *handlerfakes.FakeJWTHelper
 		authHandler *handler.Handler
 	)
 
 	BeforeEach(func() {
 		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
 		e.Validator = validator.GetValidator()
 		recorder = httptest.NewRecorder()
 		authService = &handlerfakes.FakeService{}
+		jwtHelper = &handlerfakes.FakeJWTHelper{}
 
-		authHandler = handler.NewHandler(authService)
+		authHandler = handler.NewHandler(authService, memberAuthStore(), &handlerfakes.FakeDenylist{}, jwtHelper)
 		authHandler.RegisterHandlers(e)
 	})
 
 	Describe("POST /auth", func() {
 		var (
-			credentials model.AuthRequest
-			token       string
-			req         *http.Request
+			credentials  model.AuthRequest
+			authResponse model.AuthResponse
+			orgID        uuid.UUID
+			req          *http.Request
 		)
 
 		BeforeEach(func() {
@@ -49,9 +56,15 @@ var _ = Describe("Authentication Handler", func() {
 				Username: "testuser",
 				Password: "testpass",
 			}
-			token = "testtoken"
+			orgID = uuid.New()
+			authResponse = model.AuthResponse{
+				Token:        "testtoken",
+				RefreshToken: "testrefreshtoken",
+				ExpiresIn:    900,
+				OrgID:        orgID,
+			}
 
-			authService.AuthenticateReturns(token, nil)
+			authService.AuthenticateReturns(authResponse, nil)
 
 			body, _ := json.Marshal(credentials)
 			req = httptest.NewRequest(http.MethodPost, "/auth", bytes.NewReader(body))
@@ -66,15 +79,32 @@ var _ = Describe("Authentication Handler", func() {
 			Expect(recorder.Code).To(Equal(http.StatusOK))
 			Expect(authService.AuthenticateCallCount()).To(Equal(1))
 
-			actualCtx, actualUsername, actualPassword := authService.AuthenticateArgsForCall(0)
+			actualCtx, actualUsername, actualPassword, actualOrgID, actualIP := authService.AuthenticateArgsForCall(0)
 			Expect(actualCtx).NotTo(BeNil())
 			Expect(actualUsername).To(Equal(credentials.Username))
 			Expect(actualPassword).To(Equal(credentials.Password))
+			Expect(actualOrgID).To(Equal(uuid.Nil))
+			Expect(actualIP).To(Equal("192.0.2.1"))
 
 			var response model.AuthResponse
 			err := json.Unmarshal(recorder.Body.Bytes(), &response)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(response.Token).To(Equal(token))
+			Expect(response).To(Equal(authResponse))
+		})
+
+		When("an organization is requested", func() {
+			BeforeEach(func() {
+				credentials.OrgID = orgID
+				body, _ := json.Marshal(credentials)
+				req = httptest.NewRequest(http.MethodPost, "/auth", bytes.NewReader(body))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			})
+
+			It("authenticates for that organization", func() {
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				_, _, _, actualOrgID, _ := authService.AuthenticateArgsForCall(0)
+				Expect(actualOrgID).To(Equal(orgID))
+			})
 		})
 
 		When("the request contains invalid JSON", func() {
@@ -139,7 +169,7 @@ var _ = Describe("Authentication Handler", func() {
 
 		When("authentication fails due to invalid credentials", func() {
 			BeforeEach(func() {
-				authService.AuthenticateReturns("", service.ErrInvalidCredentials)
+				authService.AuthenticateReturns(model.AuthResponse{}, service.ErrInvalidCredentials)
 			})
 
 			It("returns 401", func() {
@@ -152,9 +182,58 @@ var _ = Describe("Authentication Handler", func() {
 			})
 		})
 
+		When("the user needs MFA", func() {
+			BeforeEach(func() {
+				authService.AuthenticateReturns(model.AuthResponse{}, &service.MFARequiredError{
+					Challenge: model.MFAChallenge{MFAToken: "mfa-token", ExpiresIn: 300},
+				})
+			})
+
+			It("returns 202 with the challenge", func() {
+				Expect(recorder.Code).To(Equal(http.StatusAccepted))
+
+				var challenge model.MFAChallenge
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &challenge)).To(Succeed())
+				Expect(challenge.MFAToken).To(Equal("mfa-token"))
+				Expect(challenge.ExpiresIn).To(Equal(300))
+				Expect(recorder.Body.String()).ToNot(ContainSubstring(`"token"`))
+			})
+		})
+
+		When("too many logins failed lately", func() {
+			BeforeEach(func() {
+				authService.AuthenticateReturns(model.AuthResponse{}, &service.LoginLockedError{RetryAfter: 1500 * time.Millisecond})
+			})
+
+			It("returns 429 with the seconds to wait", func() {
+				Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+				Expect(recorder.Header().Get("Retry-After")).To(Equal("2"))
+			})
+		})
+
+		When("the user is not a member of the organization", func() {
+			BeforeEach(func() {
+				authService.AuthenticateReturns(model.AuthResponse{}, service.ErrNotOrgMember)
+			})
+
+			It("returns 403", func() {
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("the user is disabled", func() {
+			BeforeEach(func() {
+				authService.AuthenticateReturns(model.AuthResponse{}, service.ErrUserDisabled)
+			})
+
+			It("returns 403", func() {
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
 		When("authentication fails due to an unexpected error", func() {
 			BeforeEach(func() {
-				authService.AuthenticateReturns("", ErrUnexpectedError)
+				authService.AuthenticateReturns(model.AuthResponse{}, ErrUnexpectedError)
 			})
 
 			It("returns 500", func() {
@@ -167,4 +246,93 @@ var _ = Describe("Authentication Handler", func() {
 			})
 		})
 	})
+
+	Describe("POST /auth/refresh", func() {
+		var (
+			authResponse model.AuthResponse
+			body         string
+		)
+
+		BeforeEach(func() {
+			authResponse = model.AuthResponse{
+				Token:        "newtoken",
+				RefreshToken: "newrefreshtoken",
+				ExpiresIn:    900,
+				OrgID:        uuid.New(),
+			}
+			authService.RefreshReturns(authResponse, nil)
+			body = `{"refresh_token":"refreshtoken"}`
+		})
+
+		JustBeforeEach(func() {
+			req := httptest.NewRequest(http.MethodPost, "/auth/refresh", bytes.NewBufferString(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			e.ServeHTTP(recorder, req)
+		})
+
+		It("returns the rotated tokens", func() {
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, refreshToken := authService.RefreshArgsForCall(0)
+			Expect(refreshToken).To(Equal("refreshtoken"))
+
+			var response model.AuthResponse
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &response)).To(Succeed())
+			Expect(response).To(Equal(authResponse))
+		})
+
+		When("the refresh token is missing", func() {
+			BeforeEach(func() {
+				body = `{}`
+			})
+
+			It("returns 400", func() {
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(authService.RefreshCallCount()).To(BeZero())
+			})
+		})
+
+		When("the refresh token is invalid", func() {
+			BeforeEach(func() {
+				authService.RefreshReturns(model.AuthResponse{}, service.ErrInvalidRefreshToken)
+			})
+
+			It("returns 401", func() {
+				Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		When("the refresh token was already used", func() {
+			BeforeEach(func() {
+				authService.RefreshReturns(model.AuthResponse{}, service.ErrRefreshTokenReused)
+			})
+
+			It("returns 401", func() {
+				Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+
+				var response map[string]string
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &response)).To(Succeed())
+				Expect(response["message"]).To(Equal(service.ErrRefreshTokenReused.Error()))
+			})
+		})
+
+		When("the user left the organization", func() {
+			BeforeEach(func() {
+				authService.RefreshReturns(model.AuthResponse{}, service.ErrNotOrgMember)
+			})
+
+			It("returns 403", func() {
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("refreshing fails unexpectedly", func() {
+			BeforeEach(func() {
+				authService.RefreshReturns(model.AuthResponse{}, ErrUnexpectedError)
+			})
+
+			It("returns 500", func() {
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
 })
@@ -0,0 +1,242 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package handlerfakes
+
+import (
+	"sync"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/jwthelper"
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+type FakeJWTHelper struct {
+	IssuerStub        func() string
+	issuerMutex       sync.RWMutex
+	issuerArgsForCall []struct {
+	}
+	issuerReturns struct {
+		result1 string
+	}
+	issuerReturnsOnCall map[int]struct {
+		result1 string
+	}
+	JWKSStub        func() jwthelper.JWKSet
+	jWKSMutex       sync.RWMutex
+	jWKSArgsForCall []struct {
+	}
+	jWKSReturns struct {
+		result1 jwthelper.JWKSet
+	}
+	jWKSReturnsOnCall map[int]struct {
+		result1 jwthelper.JWKSet
+	}
+	ValidateTokenStub        func(string) (jwt.MapClaims, error)
+	validateTokenMutex       sync.RWMutex
+	validateTokenArgsForCall []struct {
+		arg1 string
+	}
+	validateTokenReturns struct {
+		result1 jwt.MapClaims
+		result2 error
+	}
+	validateTokenReturnsOnCall map[int]struct {
+		result1 jwt.MapClaims
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeJWTHelper) Issuer() string {
+	fake.issuerMutex.Lock()
+	ret, specificReturn := fake.issuerReturnsOnCall[len(fake.issuerArgsForCall)]
+	fake.issuerArgsForCall = append(fake.issuerArgsForCall, struct {
+	}{})
+	stub := fake.IssuerStub
+	fakeReturns := fake.issuerReturns
+	fake.recordInvocation("Issuer", []interface{}{})
+	fake.issuerMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeJWTHelper) IssuerCallCount() int {
+	fake.issuerMutex.RLock()
+	defer fake.issuerMutex.RUnlock()
+	return len(fake.issuerArgsForCall)
+}
+
+func (fake *FakeJWTHelper) IssuerCalls(stub func() string) {
+	fake.issuerMutex.Lock()
+	defer fake.issuerMutex.Unlock()
+	fake.IssuerStub = stub
+}
+
+func (fake *FakeJWTHelper) IssuerReturns(result1 string) {
+	fake.issuerMutex.Lock()
+	defer fake.issuerMutex.Unlock()
+	fake.IssuerStub = nil
+	fake.issuerReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeJWTHelper) IssuerReturnsOnCall(i int, result1 string) {
+	fake.issuerMutex.Lock()
+	defer fake.issuerMutex.Unlock()
+	fake.IssuerStub = nil
+	if fake.issuerReturnsOnCall == nil {
+		fake.issuerReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.issuerReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeJWTHelper) JWKS() jwthelper.JWKSet {
+	fake.jWKSMutex.Lock()
+	ret, specificReturn := fake.jWKSReturnsOnCall[len(fake.jWKSArgsForCall)]
+	fake.jWKSArgsForCall = append(fake.jWKSArgsForCall, struct {
+	}{})
+	stub := fake.JWKSStub
+	fakeReturns := fake.jWKSReturns
+	fake.recordInvocation("JWKS", []interface{}{})
+	fake.jWKSMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeJWTHelper) JWKSCallCount() int {
+	fake.jWKSMutex.RLock()
+	defer fake.jWKSMutex.RUnlock()
+	return len(fake.jWKSArgsForCall)
+}
+
+func (fake *FakeJWTHelper) JWKSCalls(stub func() jwthelper.JWKSet) {
+	fake.jWKSMutex.Lock()
+	defer fake.jWKSMutex.Unlock()
+	fake.JWKSStub = stub
+}
+
+func (fake *FakeJWTHelper) JWKSReturns(result1 jwthelper.JWKSet) {
+	fake.jWKSMutex.Lock()
+	defer fake.jWKSMutex.Unlock()
+	fake.JWKSStub = nil
+	fake.jWKSReturns = struct {
+		result1 jwthelper.JWKSet
+	}{result1}
+}
+
+func (fake *FakeJWTHelper) JWKSReturnsOnCall(i int, result1 jwthelper.JWKSet) {
+	fake.jWKSMutex.Lock()
+	defer fake.jWKSMutex.Unlock()
+	fake.JWKSStub = nil
+	if fake.jWKSReturnsOnCall == nil {
+		fake.jWKSReturnsOnCall = make(map[int]struct {
+			result1 jwthelper.JWKSet
+		})
+	}
+	fake.jWKSReturnsOnCall[i] = struct {
+		result1 jwthelper.JWKSet
+	}{result1}
+}
+
+func (fake *FakeJWTHelper) ValidateToken(arg1 string) (jwt.MapClaims, error) {
+	fake.validateTokenMutex.Lock()
+	ret, specificReturn := fake.validateTokenReturnsOnCall[len(fake.validateTokenArgsForCall)]
+	fake.validateTokenArgsForCall = append(fake.validateTokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateTokenStub
+	fakeReturns := fake.validateTokenReturns
+	fake.recordInvocation("ValidateToken", []interface{}{arg1})
+	fake.validateTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJWTHelper) ValidateTokenCallCount() int {
+	fake.validateTokenMutex.RLock()
+	defer fake.validateTokenMutex.RUnlock()
+	return len(fake.validateTokenArgsForCall)
+}
+
+func (fake *FakeJWTHelper) ValidateTokenCalls(stub func(string) (jwt.MapClaims, error)) {
+	fake.validateTokenMutex.Lock()
+	defer fake.validateTokenMutex.Unlock()
+	fake.ValidateTokenStub = stub
+}
+
+func (fake *FakeJWTHelper) ValidateTokenArgsForCall(i int) string {
+	fake.validateTokenMutex.RLock()
+	defer fake.validateTokenMutex.RUnlock()
+	argsForCall := fake.validateTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJWTHelper) ValidateTokenReturns(result1 jwt.MapClaims, result2 error) {
+	fake.validateTokenMutex.Lock()
+	defer fake.validateTokenMutex.Unlock()
+	fake.ValidateTokenStub = nil
+	fake.validateTokenReturns = struct {
+		result1 jwt.MapClaims
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJWTHelper) ValidateTokenReturnsOnCall(i int, result1 jwt.MapClaims, result2 error) {
+	fake.validateTokenMutex.Lock()
+	defer fake.validateTokenMutex.Unlock()
+	fake.ValidateTokenStub = nil
+	if fake.validateTokenReturnsOnCall == nil {
+		fake.validateTokenReturnsOnCall = make(map[int]struct {
+			result1 jwt.MapClaims
+			result2 error
+		})
+	}
+	fake.validateTokenReturnsOnCall[i] = struct {
+		result1 jwt.MapClaims
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJWTHelper) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeJWTHelper) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handler.JWTHelper = new(FakeJWTHelper)
@@ -4,44 +4,729 @@ package handlerfakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
 )
 
 type FakeService struct {
-	AuthenticateStub        func(context.Context, string, string) (string, error)
+	AcceptOrgInvitationStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	acceptOrgInvitationMutex       sync.RWMutex
+	acceptOrgInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	acceptOrgInvitationReturns struct {
+		result1 error
+	}
+	acceptOrgInvitationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AuthenticateStub        func(context.Context, string, string, uuid.UUID, string) (model.AuthResponse, error)
 	authenticateMutex       sync.RWMutex
 	authenticateArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
+		arg4 uuid.UUID
+		arg5 string
 	}
 	authenticateReturns struct {
-		result1 string
+		result1 model.AuthResponse
 		result2 error
 	}
 	authenticateReturnsOnCall map[int]struct {
-		result1 string
+		result1 model.AuthResponse
+		result2 error
+	}
+	CompleteMFALoginStub        func(context.Context, model.MFALoginRequest, string) (model.AuthResponse, error)
+	completeMFALoginMutex       sync.RWMutex
+	completeMFALoginArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.MFALoginRequest
+		arg3 string
+	}
+	completeMFALoginReturns struct {
+		result1 model.AuthResponse
+		result2 error
+	}
+	completeMFALoginReturnsOnCall map[int]struct {
+		result1 model.AuthResponse
+		result2 error
+	}
+	CreateAPIKeyStub        func(context.Context, uuid.UUID, uuid.UUID, model.APIKeyRequest) (model.CreatedAPIKey, error)
+	createAPIKeyMutex       sync.RWMutex
+	createAPIKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.APIKeyRequest
+	}
+	createAPIKeyReturns struct {
+		result1 model.CreatedAPIKey
+		result2 error
+	}
+	createAPIKeyReturnsOnCall map[int]struct {
+		result1 model.CreatedAPIKey
+		result2 error
+	}
+	CreateOrgStub        func(context.Context, uuid.UUID, model.OrganizationRequest) (model.Organization, error)
+	createOrgMutex       sync.RWMutex
+	createOrgArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.OrganizationRequest
+	}
+	createOrgReturns struct {
+		result1 model.Organization
+		result2 error
+	}
+	createOrgReturnsOnCall map[int]struct {
+		result1 model.Organization
+		result2 error
+	}
+	CreateUserStub        func(context.Context, uuid.UUID, model.CreateUserRequest) (model.User, error)
+	createUserMutex       sync.RWMutex
+	createUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.CreateUserRequest
+	}
+	createUserReturns struct {
+		result1 model.User
+		result2 error
+	}
+	createUserReturnsOnCall map[int]struct {
+		result1 model.User
+		result2 error
+	}
+	DeclineOrgInvitationStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	declineOrgInvitationMutex       sync.RWMutex
+	declineOrgInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	declineOrgInvitationReturns struct {
+		result1 error
+	}
+	declineOrgInvitationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteOAuthClientStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	deleteOAuthClientMutex       sync.RWMutex
+	deleteOAuthClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deleteOAuthClientReturns struct {
+		result1 error
+	}
+	deleteOAuthClientReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteUserStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	deleteUserMutex       sync.RWMutex
+	deleteUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	deleteUserReturns struct {
+		result1 error
+	}
+	deleteUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DisableMFAStub        func(context.Context, uuid.UUID, uuid.UUID, string) error
+	disableMFAMutex       sync.RWMutex
+	disableMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+	}
+	disableMFAReturns struct {
+		result1 error
+	}
+	disableMFAReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EnrollMFAStub        func(context.Context, uuid.UUID) (model.MFAEnrollment, error)
+	enrollMFAMutex       sync.RWMutex
+	enrollMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	enrollMFAReturns struct {
+		result1 model.MFAEnrollment
+		result2 error
+	}
+	enrollMFAReturnsOnCall map[int]struct {
+		result1 model.MFAEnrollment
+		result2 error
+	}
+	GetMFAPolicyStub        func(context.Context, uuid.UUID) (model.MFAPolicy, error)
+	getMFAPolicyMutex       sync.RWMutex
+	getMFAPolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getMFAPolicyReturns struct {
+		result1 model.MFAPolicy
+		result2 error
+	}
+	getMFAPolicyReturnsOnCall map[int]struct {
+		result1 model.MFAPolicy
+		result2 error
+	}
+	GetOrgStub        func(context.Context, uuid.UUID, uuid.UUID) (model.Organization, error)
+	getOrgMutex       sync.RWMutex
+	getOrgArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getOrgReturns struct {
+		result1 model.Organization
+		result2 error
+	}
+	getOrgReturnsOnCall map[int]struct {
+		result1 model.Organization
+		result2 error
+	}
+	GetUserStub        func(context.Context, uuid.UUID, uuid.UUID) (model.User, error)
+	getUserMutex       sync.RWMutex
+	getUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getUserReturns struct {
+		result1 model.User
+		result2 error
+	}
+	getUserReturnsOnCall map[int]struct {
+		result1 model.User
+		result2 error
+	}
+	IntrospectTokenStub        func(context.Context, model.ClientTokenRequest) (model.IntrospectionResponse, error)
+	introspectTokenMutex       sync.RWMutex
+	introspectTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.ClientTokenRequest
+	}
+	introspectTokenReturns struct {
+		result1 model.IntrospectionResponse
+		result2 error
+	}
+	introspectTokenReturnsOnCall map[int]struct {
+		result1 model.IntrospectionResponse
+		result2 error
+	}
+	InviteOrgMemberStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, model.RoleType) (model.OrgInvitation, error)
+	inviteOrgMemberMutex       sync.RWMutex
+	inviteOrgMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 model.RoleType
+	}
+	inviteOrgMemberReturns struct {
+		result1 model.OrgInvitation
+		result2 error
+	}
+	inviteOrgMemberReturnsOnCall map[int]struct {
+		result1 model.OrgInvitation
+		result2 error
+	}
+	LinkUserIdentityStub        func(context.Context, uuid.UUID, uuid.UUID, model.ExternalIdentityRequest) (model.ExternalIdentity, error)
+	linkUserIdentityMutex       sync.RWMutex
+	linkUserIdentityArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.ExternalIdentityRequest
+	}
+	linkUserIdentityReturns struct {
+		result1 model.ExternalIdentity
+		result2 error
+	}
+	linkUserIdentityReturnsOnCall map[int]struct {
+		result1 model.ExternalIdentity
+		result2 error
+	}
+	ListAPIKeysStub        func(context.Context, uuid.UUID) ([]model.APIKey, error)
+	listAPIKeysMutex       sync.RWMutex
+	listAPIKeysArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listAPIKeysReturns struct {
+		result1 []model.APIKey
+		result2 error
+	}
+	listAPIKeysReturnsOnCall map[int]struct {
+		result1 []model.APIKey
+		result2 error
+	}
+	ListInvitationsStub        func(context.Context, uuid.UUID) ([]model.OrgInvitation, error)
+	listInvitationsMutex       sync.RWMutex
+	listInvitationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listInvitationsReturns struct {
+		result1 []model.OrgInvitation
+		result2 error
+	}
+	listInvitationsReturnsOnCall map[int]struct {
+		result1 []model.OrgInvitation
+		result2 error
+	}
+	ListOAuthClientsStub        func(context.Context, uuid.UUID) ([]model.OAuthClient, error)
+	listOAuthClientsMutex       sync.RWMutex
+	listOAuthClientsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listOAuthClientsReturns struct {
+		result1 []model.OAuthClient
+		result2 error
+	}
+	listOAuthClientsReturnsOnCall map[int]struct {
+		result1 []model.OAuthClient
+		result2 error
+	}
+	ListOrgMembersStub        func(context.Context, uuid.UUID, uuid.UUID) ([]model.OrganizationMember, error)
+	listOrgMembersMutex       sync.RWMutex
+	listOrgMembersArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	listOrgMembersReturns struct {
+		result1 []model.OrganizationMember
+		result2 error
+	}
+	listOrgMembersReturnsOnCall map[int]struct {
+		result1 []model.OrganizationMember
+		result2 error
+	}
+	ListUserIdentitiesStub        func(context.Context, uuid.UUID, uuid.UUID) ([]model.ExternalIdentity, error)
+	listUserIdentitiesMutex       sync.RWMutex
+	listUserIdentitiesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	listUserIdentitiesReturns struct {
+		result1 []model.ExternalIdentity
+		result2 error
+	}
+	listUserIdentitiesReturnsOnCall map[int]struct {
+		result1 []model.ExternalIdentity
+		result2 error
+	}
+	ListUserOrgsStub        func(context.Context, uuid.UUID) ([]model.Organization, error)
+	listUserOrgsMutex       sync.RWMutex
+	listUserOrgsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listUserOrgsReturns struct {
+		result1 []model.Organization
+		result2 error
+	}
+	listUserOrgsReturnsOnCall map[int]struct {
+		result1 []model.Organization
+		result2 error
+	}
+	ListUsersStub        func(context.Context, uuid.UUID) ([]model.User, error)
+	listUsersMutex       sync.RWMutex
+	listUsersArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listUsersReturns struct {
+		result1 []model.User
+		result2 error
+	}
+	listUsersReturnsOnCall map[int]struct {
+		result1 []model.User
+		result2 error
+	}
+	LogoutStub        func(context.Context, model.AccessToken, string) error
+	logoutMutex       sync.RWMutex
+	logoutArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.AccessToken
+		arg3 string
+	}
+	logoutReturns struct {
+		result1 error
+	}
+	logoutReturnsOnCall map[int]struct {
+		result1 error
+	}
+	MFAStatusStub        func(context.Context, uuid.UUID) (model.MFAStatus, error)
+	mFAStatusMutex       sync.RWMutex
+	mFAStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	mFAStatusReturns struct {
+		result1 model.MFAStatus
+		result2 error
+	}
+	mFAStatusReturnsOnCall map[int]struct {
+		result1 model.MFAStatus
+		result2 error
+	}
+	OAuthTokenStub        func(context.Context, model.TokenRequest, string) (model.TokenResponse, error)
+	oAuthTokenMutex       sync.RWMutex
+	oAuthTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.TokenRequest
+		arg3 string
+	}
+	oAuthTokenReturns struct {
+		result1 model.TokenResponse
+		result2 error
+	}
+	oAuthTokenReturnsOnCall map[int]struct {
+		result1 model.TokenResponse
+		result2 error
+	}
+	RefreshStub        func(context.Context, string) (model.AuthResponse, error)
+	refreshMutex       sync.RWMutex
+	refreshArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	refreshReturns struct {
+		result1 model.AuthResponse
+		result2 error
+	}
+	refreshReturnsOnCall map[int]struct {
+		result1 model.AuthResponse
+		result2 error
+	}
+	RegenerateRecoveryCodesStub        func(context.Context, uuid.UUID, string) (model.RecoveryCodes, error)
+	regenerateRecoveryCodesMutex       sync.RWMutex
+	regenerateRecoveryCodesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	regenerateRecoveryCodesReturns struct {
+		result1 model.RecoveryCodes
+		result2 error
+	}
+	regenerateRecoveryCodesReturnsOnCall map[int]struct {
+		result1 model.RecoveryCodes
+		result2 error
+	}
+	RegisterOAuthClientStub        func(context.Context, uuid.UUID, uuid.UUID, model.OAuthClientRequest) (model.CreatedOAuthClient, error)
+	registerOAuthClientMutex       sync.RWMutex
+	registerOAuthClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.OAuthClientRequest
+	}
+	registerOAuthClientReturns struct {
+		result1 model.CreatedOAuthClient
+		result2 error
+	}
+	registerOAuthClientReturnsOnCall map[int]struct {
+		result1 model.CreatedOAuthClient
+		result2 error
+	}
+	RemoveOrgMemberStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	removeOrgMemberMutex       sync.RWMutex
+	removeOrgMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	removeOrgMemberReturns struct {
+		result1 error
+	}
+	removeOrgMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ResetUserMFAStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	resetUserMFAMutex       sync.RWMutex
+	resetUserMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	resetUserMFAReturns struct {
+		result1 error
+	}
+	resetUserMFAReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ResetUserPasswordStub        func(context.Context, uuid.UUID, uuid.UUID, string) error
+	resetUserPasswordMutex       sync.RWMutex
+	resetUserPasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+	}
+	resetUserPasswordReturns struct {
+		result1 error
+	}
+	resetUserPasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeAPIKeyStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	revokeAPIKeyMutex       sync.RWMutex
+	revokeAPIKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	revokeAPIKeyReturns struct {
+		result1 error
+	}
+	revokeAPIKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeOAuthTokenStub        func(context.Context, model.ClientTokenRequest) error
+	revokeOAuthTokenMutex       sync.RWMutex
+	revokeOAuthTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.ClientTokenRequest
+	}
+	revokeOAuthTokenReturns struct {
+		result1 error
+	}
+	revokeOAuthTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeTokenStub        func(context.Context, uuid.UUID, uuid.UUID, model.AccessToken) error
+	revokeTokenMutex       sync.RWMutex
+	revokeTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.AccessToken
+	}
+	revokeTokenReturns struct {
+		result1 error
+	}
+	revokeTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeTokensIssuedBeforeStub        func(context.Context, uuid.UUID, uuid.UUID, time.Time) error
+	revokeTokensIssuedBeforeMutex       sync.RWMutex
+	revokeTokensIssuedBeforeArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 time.Time
+	}
+	revokeTokensIssuedBeforeReturns struct {
+		result1 error
+	}
+	revokeTokensIssuedBeforeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeUserTokensStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	revokeUserTokensMutex       sync.RWMutex
+	revokeUserTokensArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	revokeUserTokensReturns struct {
+		result1 error
+	}
+	revokeUserTokensReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetUserDisabledStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, bool) error
+	setUserDisabledMutex       sync.RWMutex
+	setUserDisabledArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 bool
+	}
+	setUserDisabledReturns struct {
+		result1 error
+	}
+	setUserDisabledReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UnlinkUserIdentityStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	unlinkUserIdentityMutex       sync.RWMutex
+	unlinkUserIdentityArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	unlinkUserIdentityReturns struct {
+		result1 error
+	}
+	unlinkUserIdentityReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UnlockUserStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	unlockUserMutex       sync.RWMutex
+	unlockUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	unlockUserReturns struct {
+		result1 error
+	}
+	unlockUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateMFAPolicyStub        func(context.Context, uuid.UUID, model.MFAPolicy) (model.MFAPolicy, error)
+	updateMFAPolicyMutex       sync.RWMutex
+	updateMFAPolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.MFAPolicy
+	}
+	updateMFAPolicyReturns struct {
+		result1 model.MFAPolicy
+		result2 error
+	}
+	updateMFAPolicyReturnsOnCall map[int]struct {
+		result1 model.MFAPolicy
+		result2 error
+	}
+	UpdateUserRoleStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, model.RoleType) error
+	updateUserRoleMutex       sync.RWMutex
+	updateUserRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 model.RoleType
+	}
+	updateUserRoleReturns struct {
+		result1 error
+	}
+	updateUserRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VerifyMFAStub        func(context.Context, uuid.UUID, string) (model.RecoveryCodes, error)
+	verifyMFAMutex       sync.RWMutex
+	verifyMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	verifyMFAReturns struct {
+		result1 model.RecoveryCodes
+		result2 error
+	}
+	verifyMFAReturnsOnCall map[int]struct {
+		result1 model.RecoveryCodes
 		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeService) Authenticate(arg1 context.Context, arg2 string, arg3 string) (string, error) {
+func (fake *FakeService) AcceptOrgInvitation(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.acceptOrgInvitationMutex.Lock()
+	ret, specificReturn := fake.acceptOrgInvitationReturnsOnCall[len(fake.acceptOrgInvitationArgsForCall)]
+	fake.acceptOrgInvitationArgsForCall = append(fake.acceptOrgInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.AcceptOrgInvitationStub
+	fakeReturns := fake.acceptOrgInvitationReturns
+	fake.recordInvocation("AcceptOrgInvitation", []interface{}{arg1, arg2, arg3})
+	fake.acceptOrgInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) AcceptOrgInvitationCallCount() int {
+	fake.acceptOrgInvitationMutex.RLock()
+	defer fake.acceptOrgInvitationMutex.RUnlock()
+	return len(fake.acceptOrgInvitationArgsForCall)
+}
+
+func (fake *FakeService) AcceptOrgInvitationCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.acceptOrgInvitationMutex.Lock()
+	defer fake.acceptOrgInvitationMutex.Unlock()
+	fake.AcceptOrgInvitationStub = stub
+}
+
+func (fake *FakeService) AcceptOrgInvitationArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.acceptOrgInvitationMutex.RLock()
+	defer fake.acceptOrgInvitationMutex.RUnlock()
+	argsForCall := fake.acceptOrgInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) AcceptOrgInvitationReturns(result1 error) {
+	fake.acceptOrgInvitationMutex.Lock()
+	defer fake.acceptOrgInvitationMutex.Unlock()
+	fake.AcceptOrgInvitationStub = nil
+	fake.acceptOrgInvitationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) AcceptOrgInvitationReturnsOnCall(i int, result1 error) {
+	fake.acceptOrgInvitationMutex.Lock()
+	defer fake.acceptOrgInvitationMutex.Unlock()
+	fake.AcceptOrgInvitationStub = nil
+	if fake.acceptOrgInvitationReturnsOnCall == nil {
+		fake.acceptOrgInvitationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.acceptOrgInvitationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) Authenticate(arg1 context.Context, arg2 string, arg3 string, arg4 uuid.UUID, arg5 string) (model.AuthResponse, error) {
 	fake.authenticateMutex.Lock()
 	ret, specificReturn := fake.authenticateReturnsOnCall[len(fake.authenticateArgsForCall)]
 	fake.authenticateArgsForCall = append(fake.authenticateArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
-	}{arg1, arg2, arg3})
+		arg4 uuid.UUID
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
 	stub := fake.AuthenticateStub
 	fakeReturns := fake.authenticateReturns
-	fake.recordInvocation("Authenticate", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("Authenticate", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.authenticateMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -55,41 +740,2768 @@ func (fake *FakeService) AuthenticateCallCount() int {
 	return len(fake.authenticateArgsForCall)
 }
 
-func (fake *FakeService) AuthenticateCalls(stub func(context.Context, string, string) (string, error)) {
+func (fake *FakeService) AuthenticateCalls(stub func(context.Context, string, string, uuid.UUID, string) (model.AuthResponse, error)) {
 	fake.authenticateMutex.Lock()
 	defer fake.authenticateMutex.Unlock()
 	fake.AuthenticateStub = stub
 }
 
-func (fake *FakeService) AuthenticateArgsForCall(i int) (context.Context, string, string) {
+func (fake *FakeService) AuthenticateArgsForCall(i int) (context.Context, string, string, uuid.UUID, string) {
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
 	argsForCall := fake.authenticateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakeService) AuthenticateReturns(result1 string, result2 error) {
+func (fake *FakeService) AuthenticateReturns(result1 model.AuthResponse, result2 error) {
 	fake.authenticateMutex.Lock()
 	defer fake.authenticateMutex.Unlock()
 	fake.AuthenticateStub = nil
 	fake.authenticateReturns = struct {
-		result1 string
+		result1 model.AuthResponse
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeService) AuthenticateReturnsOnCall(i int, result1 string, result2 error) {
+func (fake *FakeService) AuthenticateReturnsOnCall(i int, result1 model.AuthResponse, result2 error) {
 	fake.authenticateMutex.Lock()
 	defer fake.authenticateMutex.Unlock()
 	fake.AuthenticateStub = nil
 	if fake.authenticateReturnsOnCall == nil {
 		fake.authenticateReturnsOnCall = make(map[int]struct {
-			result1 string
+			result1 model.AuthResponse
 			result2 error
 		})
 	}
 	fake.authenticateReturnsOnCall[i] = struct {
-		result1 string
+		result1 model.AuthResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CompleteMFALogin(arg1 context.Context, arg2 model.MFALoginRequest, arg3 string) (model.AuthResponse, error) {
+	fake.completeMFALoginMutex.Lock()
+	ret, specificReturn := fake.completeMFALoginReturnsOnCall[len(fake.completeMFALoginArgsForCall)]
+	fake.completeMFALoginArgsForCall = append(fake.completeMFALoginArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.MFALoginRequest
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CompleteMFALoginStub
+	fakeReturns := fake.completeMFALoginReturns
+	fake.recordInvocation("CompleteMFALogin", []interface{}{arg1, arg2, arg3})
+	fake.completeMFALoginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) CompleteMFALoginCallCount() int {
+	fake.completeMFALoginMutex.RLock()
+	defer fake.completeMFALoginMutex.RUnlock()
+	return len(fake.completeMFALoginArgsForCall)
+}
+
+func (fake *FakeService) CompleteMFALoginCalls(stub func(context.Context, model.MFALoginRequest, string) (model.AuthResponse, error)) {
+	fake.completeMFALoginMutex.Lock()
+	defer fake.completeMFALoginMutex.Unlock()
+	fake.CompleteMFALoginStub = stub
+}
+
+func (fake *FakeService) CompleteMFALoginArgsForCall(i int) (context.Context, model.MFALoginRequest, string) {
+	fake.completeMFALoginMutex.RLock()
+	defer fake.completeMFALoginMutex.RUnlock()
+	argsForCall := fake.completeMFALoginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) CompleteMFALoginReturns(result1 model.AuthResponse, result2 error) {
+	fake.completeMFALoginMutex.Lock()
+	defer fake.completeMFALoginMutex.Unlock()
+	fake.CompleteMFALoginStub = nil
+	fake.completeMFALoginReturns = struct {
+		result1 model.AuthResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CompleteMFALoginReturnsOnCall(i int, result1 model.AuthResponse, result2 error) {
+	fake.completeMFALoginMutex.Lock()
+	defer fake.completeMFALoginMutex.Unlock()
+	fake.CompleteMFALoginStub = nil
+	if fake.completeMFALoginReturnsOnCall == nil {
+		fake.completeMFALoginReturnsOnCall = make(map[int]struct {
+			result1 model.AuthResponse
+			result2 error
+		})
+	}
+	fake.completeMFALoginReturnsOnCall[i] = struct {
+		result1 model.AuthResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateAPIKey(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 model.APIKeyRequest) (model.CreatedAPIKey, error) {
+	fake.createAPIKeyMutex.Lock()
+	ret, specificReturn := fake.createAPIKeyReturnsOnCall[len(fake.createAPIKeyArgsForCall)]
+	fake.createAPIKeyArgsForCall = append(fake.createAPIKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.APIKeyRequest
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreateAPIKeyStub
+	fakeReturns := fake.createAPIKeyReturns
+	fake.recordInvocation("CreateAPIKey", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createAPIKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) CreateAPIKeyCallCount() int {
+	fake.createAPIKeyMutex.RLock()
+	defer fake.createAPIKeyMutex.RUnlock()
+	return len(fake.createAPIKeyArgsForCall)
+}
+
+func (fake *FakeService) CreateAPIKeyCalls(stub func(context.Context, uuid.UUID, uuid.UUID, model.APIKeyRequest) (model.CreatedAPIKey, error)) {
+	fake.createAPIKeyMutex.Lock()
+	defer fake.createAPIKeyMutex.Unlock()
+	fake.CreateAPIKeyStub = stub
+}
+
+func (fake *FakeService) CreateAPIKeyArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, model.APIKeyRequest) {
+	fake.createAPIKeyMutex.RLock()
+	defer fake.createAPIKeyMutex.RUnlock()
+	argsForCall := fake.createAPIKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) CreateAPIKeyReturns(result1 model.CreatedAPIKey, result2 error) {
+	fake.createAPIKeyMutex.Lock()
+	defer fake.createAPIKeyMutex.Unlock()
+	fake.CreateAPIKeyStub = nil
+	fake.createAPIKeyReturns = struct {
+		result1 model.CreatedAPIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateAPIKeyReturnsOnCall(i int, result1 model.CreatedAPIKey, result2 error) {
+	fake.createAPIKeyMutex.Lock()
+	defer fake.createAPIKeyMutex.Unlock()
+	fake.CreateAPIKeyStub = nil
+	if fake.createAPIKeyReturnsOnCall == nil {
+		fake.createAPIKeyReturnsOnCall = make(map[int]struct {
+			result1 model.CreatedAPIKey
+			result2 error
+		})
+	}
+	fake.createAPIKeyReturnsOnCall[i] = struct {
+		result1 model.CreatedAPIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateOrg(arg1 context.Context, arg2 uuid.UUID, arg3 model.OrganizationRequest) (model.Organization, error) {
+	fake.createOrgMutex.Lock()
+	ret, specificReturn := fake.createOrgReturnsOnCall[len(fake.createOrgArgsForCall)]
+	fake.createOrgArgsForCall = append(fake.createOrgArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.OrganizationRequest
+	}{arg1, arg2, arg3})
+	stub := fake.CreateOrgStub
+	fakeReturns := fake.createOrgReturns
+	fake.recordInvocation("CreateOrg", []interface{}{arg1, arg2, arg3})
+	fake.createOrgMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) CreateOrgCallCount() int {
+	fake.createOrgMutex.RLock()
+	defer fake.createOrgMutex.RUnlock()
+	return len(fake.createOrgArgsForCall)
+}
+
+func (fake *FakeService) CreateOrgCalls(stub func(context.Context, uuid.UUID, model.OrganizationRequest) (model.Organization, error)) {
+	fake.createOrgMutex.Lock()
+	defer fake.createOrgMutex.Unlock()
+	fake.CreateOrgStub = stub
+}
+
+func (fake *FakeService) CreateOrgArgsForCall(i int) (context.Context, uuid.UUID, model.OrganizationRequest) {
+	fake.createOrgMutex.RLock()
+	defer fake.createOrgMutex.RUnlock()
+	argsForCall := fake.createOrgArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) CreateOrgReturns(result1 model.Organization, result2 error) {
+	fake.createOrgMutex.Lock()
+	defer fake.createOrgMutex.Unlock()
+	fake.CreateOrgStub = nil
+	fake.createOrgReturns = struct {
+		result1 model.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateOrgReturnsOnCall(i int, result1 model.Organization, result2 error) {
+	fake.createOrgMutex.Lock()
+	defer fake.createOrgMutex.Unlock()
+	fake.CreateOrgStub = nil
+	if fake.createOrgReturnsOnCall == nil {
+		fake.createOrgReturnsOnCall = make(map[int]struct {
+			result1 model.Organization
+			result2 error
+		})
+	}
+	fake.createOrgReturnsOnCall[i] = struct {
+		result1 model.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateUser(arg1 context.Context, arg2 uuid.UUID, arg3 model.CreateUserRequest) (model.User, error) {
+	fake.createUserMutex.Lock()
+	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
+	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.CreateUserRequest
+	}{arg1, arg2, arg3})
+	stub := fake.CreateUserStub
+	fakeReturns := fake.createUserReturns
+	fake.recordInvocation("CreateUser", []interface{}{arg1, arg2, arg3})
+	fake.createUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) CreateUserCallCount() int {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	return len(fake.createUserArgsForCall)
+}
+
+func (fake *FakeService) CreateUserCalls(stub func(context.Context, uuid.UUID, model.CreateUserRequest) (model.User, error)) {
+	fake.createUserMutex.Lock()
+	defer fake.createUserMutex.Unlock()
+	fake.CreateUserStub = stub
+}
+
+func (fake *FakeService) CreateUserArgsForCall(i int) (context.Context, uuid.UUID, model.CreateUserRequest) {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	argsForCall := fake.createUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) CreateUserReturns(result1 model.User, result2 error) {
+	fake.createUserMutex.Lock()
+	defer fake.createUserMutex.Unlock()
+	fake.CreateUserStub = nil
+	fake.createUserReturns = struct {
+		result1 model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateUserReturnsOnCall(i int, result1 model.User, result2 error) {
+	fake.createUserMutex.Lock()
+	defer fake.createUserMutex.Unlock()
+	fake.CreateUserStub = nil
+	if fake.createUserReturnsOnCall == nil {
+		fake.createUserReturnsOnCall = make(map[int]struct {
+			result1 model.User
+			result2 error
+		})
+	}
+	fake.createUserReturnsOnCall[i] = struct {
+		result1 model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) DeclineOrgInvitation(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.declineOrgInvitationMutex.Lock()
+	ret, specificReturn := fake.declineOrgInvitationReturnsOnCall[len(fake.declineOrgInvitationArgsForCall)]
+	fake.declineOrgInvitationArgsForCall = append(fake.declineOrgInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeclineOrgInvitationStub
+	fakeReturns := fake.declineOrgInvitationReturns
+	fake.recordInvocation("DeclineOrgInvitation", []interface{}{arg1, arg2, arg3})
+	fake.declineOrgInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) DeclineOrgInvitationCallCount() int {
+	fake.declineOrgInvitationMutex.RLock()
+	defer fake.declineOrgInvitationMutex.RUnlock()
+	return len(fake.declineOrgInvitationArgsForCall)
+}
+
+func (fake *FakeService) DeclineOrgInvitationCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.declineOrgInvitationMutex.Lock()
+	defer fake.declineOrgInvitationMutex.Unlock()
+	fake.DeclineOrgInvitationStub = stub
+}
+
+func (fake *FakeService) DeclineOrgInvitationArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.declineOrgInvitationMutex.RLock()
+	defer fake.declineOrgInvitationMutex.RUnlock()
+	argsForCall := fake.declineOrgInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) DeclineOrgInvitationReturns(result1 error) {
+	fake.declineOrgInvitationMutex.Lock()
+	defer fake.declineOrgInvitationMutex.Unlock()
+	fake.DeclineOrgInvitationStub = nil
+	fake.declineOrgInvitationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DeclineOrgInvitationReturnsOnCall(i int, result1 error) {
+	fake.declineOrgInvitationMutex.Lock()
+	defer fake.declineOrgInvitationMutex.Unlock()
+	fake.DeclineOrgInvitationStub = nil
+	if fake.declineOrgInvitationReturnsOnCall == nil {
+		fake.declineOrgInvitationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.declineOrgInvitationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DeleteOAuthClient(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deleteOAuthClientMutex.Lock()
+	ret, specificReturn := fake.deleteOAuthClientReturnsOnCall[len(fake.deleteOAuthClientArgsForCall)]
+	fake.deleteOAuthClientArgsForCall = append(fake.deleteOAuthClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteOAuthClientStub
+	fakeReturns := fake.deleteOAuthClientReturns
+	fake.recordInvocation("DeleteOAuthClient", []interface{}{arg1, arg2, arg3})
+	fake.deleteOAuthClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) DeleteOAuthClientCallCount() int {
+	fake.deleteOAuthClientMutex.RLock()
+	defer fake.deleteOAuthClientMutex.RUnlock()
+	return len(fake.deleteOAuthClientArgsForCall)
+}
+
+func (fake *FakeService) DeleteOAuthClientCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deleteOAuthClientMutex.Lock()
+	defer fake.deleteOAuthClientMutex.Unlock()
+	fake.DeleteOAuthClientStub = stub
+}
+
+func (fake *FakeService) DeleteOAuthClientArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteOAuthClientMutex.RLock()
+	defer fake.deleteOAuthClientMutex.RUnlock()
+	argsForCall := fake.deleteOAuthClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) DeleteOAuthClientReturns(result1 error) {
+	fake.deleteOAuthClientMutex.Lock()
+	defer fake.deleteOAuthClientMutex.Unlock()
+	fake.DeleteOAuthClientStub = nil
+	fake.deleteOAuthClientReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DeleteOAuthClientReturnsOnCall(i int, result1 error) {
+	fake.deleteOAuthClientMutex.Lock()
+	defer fake.deleteOAuthClientMutex.Unlock()
+	fake.DeleteOAuthClientStub = nil
+	if fake.deleteOAuthClientReturnsOnCall == nil {
+		fake.deleteOAuthClientReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteOAuthClientReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DeleteUser(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) error {
+	fake.deleteUserMutex.Lock()
+	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
+	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteUserStub
+	fakeReturns := fake.deleteUserReturns
+	fake.recordInvocation("DeleteUser", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) DeleteUserCallCount() int {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return len(fake.deleteUserArgsForCall)
+}
+
+func (fake *FakeService) DeleteUserCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = stub
+}
+
+func (fake *FakeService) DeleteUserArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	argsForCall := fake.deleteUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) DeleteUserReturns(result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	fake.deleteUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DeleteUserReturnsOnCall(i int, result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	if fake.deleteUserReturnsOnCall == nil {
+		fake.deleteUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DisableMFA(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 string) error {
+	fake.disableMFAMutex.Lock()
+	ret, specificReturn := fake.disableMFAReturnsOnCall[len(fake.disableMFAArgsForCall)]
+	fake.disableMFAArgsForCall = append(fake.disableMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DisableMFAStub
+	fakeReturns := fake.disableMFAReturns
+	fake.recordInvocation("DisableMFA", []interface{}{arg1, arg2, arg3, arg4})
+	fake.disableMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) DisableMFACallCount() int {
+	fake.disableMFAMutex.RLock()
+	defer fake.disableMFAMutex.RUnlock()
+	return len(fake.disableMFAArgsForCall)
+}
+
+func (fake *FakeService) DisableMFACalls(stub func(context.Context, uuid.UUID, uuid.UUID, string) error) {
+	fake.disableMFAMutex.Lock()
+	defer fake.disableMFAMutex.Unlock()
+	fake.DisableMFAStub = stub
+}
+
+func (fake *FakeService) DisableMFAArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, string) {
+	fake.disableMFAMutex.RLock()
+	defer fake.disableMFAMutex.RUnlock()
+	argsForCall := fake.disableMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) DisableMFAReturns(result1 error) {
+	fake.disableMFAMutex.Lock()
+	defer fake.disableMFAMutex.Unlock()
+	fake.DisableMFAStub = nil
+	fake.disableMFAReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DisableMFAReturnsOnCall(i int, result1 error) {
+	fake.disableMFAMutex.Lock()
+	defer fake.disableMFAMutex.Unlock()
+	fake.DisableMFAStub = nil
+	if fake.disableMFAReturnsOnCall == nil {
+		fake.disableMFAReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.disableMFAReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) EnrollMFA(arg1 context.Context, arg2 uuid.UUID) (model.MFAEnrollment, error) {
+	fake.enrollMFAMutex.Lock()
+	ret, specificReturn := fake.enrollMFAReturnsOnCall[len(fake.enrollMFAArgsForCall)]
+	fake.enrollMFAArgsForCall = append(fake.enrollMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.EnrollMFAStub
+	fakeReturns := fake.enrollMFAReturns
+	fake.recordInvocation("EnrollMFA", []interface{}{arg1, arg2})
+	fake.enrollMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) EnrollMFACallCount() int {
+	fake.enrollMFAMutex.RLock()
+	defer fake.enrollMFAMutex.RUnlock()
+	return len(fake.enrollMFAArgsForCall)
+}
+
+func (fake *FakeService) EnrollMFACalls(stub func(context.Context, uuid.UUID) (model.MFAEnrollment, error)) {
+	fake.enrollMFAMutex.Lock()
+	defer fake.enrollMFAMutex.Unlock()
+	fake.EnrollMFAStub = stub
+}
+
+func (fake *FakeService) EnrollMFAArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.enrollMFAMutex.RLock()
+	defer fake.enrollMFAMutex.RUnlock()
+	argsForCall := fake.enrollMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) EnrollMFAReturns(result1 model.MFAEnrollment, result2 error) {
+	fake.enrollMFAMutex.Lock()
+	defer fake.enrollMFAMutex.Unlock()
+	fake.EnrollMFAStub = nil
+	fake.enrollMFAReturns = struct {
+		result1 model.MFAEnrollment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) EnrollMFAReturnsOnCall(i int, result1 model.MFAEnrollment, result2 error) {
+	fake.enrollMFAMutex.Lock()
+	defer fake.enrollMFAMutex.Unlock()
+	fake.EnrollMFAStub = nil
+	if fake.enrollMFAReturnsOnCall == nil {
+		fake.enrollMFAReturnsOnCall = make(map[int]struct {
+			result1 model.MFAEnrollment
+			result2 error
+		})
+	}
+	fake.enrollMFAReturnsOnCall[i] = struct {
+		result1 model.MFAEnrollment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetMFAPolicy(arg1 context.Context, arg2 uuid.UUID) (model.MFAPolicy, error) {
+	fake.getMFAPolicyMutex.Lock()
+	ret, specificReturn := fake.getMFAPolicyReturnsOnCall[len(fake.getMFAPolicyArgsForCall)]
+	fake.getMFAPolicyArgsForCall = append(fake.getMFAPolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetMFAPolicyStub
+	fakeReturns := fake.getMFAPolicyReturns
+	fake.recordInvocation("GetMFAPolicy", []interface{}{arg1, arg2})
+	fake.getMFAPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) GetMFAPolicyCallCount() int {
+	fake.getMFAPolicyMutex.RLock()
+	defer fake.getMFAPolicyMutex.RUnlock()
+	return len(fake.getMFAPolicyArgsForCall)
+}
+
+func (fake *FakeService) GetMFAPolicyCalls(stub func(context.Context, uuid.UUID) (model.MFAPolicy, error)) {
+	fake.getMFAPolicyMutex.Lock()
+	defer fake.getMFAPolicyMutex.Unlock()
+	fake.GetMFAPolicyStub = stub
+}
+
+func (fake *FakeService) GetMFAPolicyArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getMFAPolicyMutex.RLock()
+	defer fake.getMFAPolicyMutex.RUnlock()
+	argsForCall := fake.getMFAPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) GetMFAPolicyReturns(result1 model.MFAPolicy, result2 error) {
+	fake.getMFAPolicyMutex.Lock()
+	defer fake.getMFAPolicyMutex.Unlock()
+	fake.GetMFAPolicyStub = nil
+	fake.getMFAPolicyReturns = struct {
+		result1 model.MFAPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetMFAPolicyReturnsOnCall(i int, result1 model.MFAPolicy, result2 error) {
+	fake.getMFAPolicyMutex.Lock()
+	defer fake.getMFAPolicyMutex.Unlock()
+	fake.GetMFAPolicyStub = nil
+	if fake.getMFAPolicyReturnsOnCall == nil {
+		fake.getMFAPolicyReturnsOnCall = make(map[int]struct {
+			result1 model.MFAPolicy
+			result2 error
+		})
+	}
+	fake.getMFAPolicyReturnsOnCall[i] = struct {
+		result1 model.MFAPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetOrg(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (model.Organization, error) {
+	fake.getOrgMutex.Lock()
+	ret, specificReturn := fake.getOrgReturnsOnCall[len(fake.getOrgArgsForCall)]
+	fake.getOrgArgsForCall = append(fake.getOrgArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetOrgStub
+	fakeReturns := fake.getOrgReturns
+	fake.recordInvocation("GetOrg", []interface{}{arg1, arg2, arg3})
+	fake.getOrgMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) GetOrgCallCount() int {
+	fake.getOrgMutex.RLock()
+	defer fake.getOrgMutex.RUnlock()
+	return len(fake.getOrgArgsForCall)
+}
+
+func (fake *FakeService) GetOrgCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (model.Organization, error)) {
+	fake.getOrgMutex.Lock()
+	defer fake.getOrgMutex.Unlock()
+	fake.GetOrgStub = stub
+}
+
+func (fake *FakeService) GetOrgArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getOrgMutex.RLock()
+	defer fake.getOrgMutex.RUnlock()
+	argsForCall := fake.getOrgArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) GetOrgReturns(result1 model.Organization, result2 error) {
+	fake.getOrgMutex.Lock()
+	defer fake.getOrgMutex.Unlock()
+	fake.GetOrgStub = nil
+	fake.getOrgReturns = struct {
+		result1 model.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetOrgReturnsOnCall(i int, result1 model.Organization, result2 error) {
+	fake.getOrgMutex.Lock()
+	defer fake.getOrgMutex.Unlock()
+	fake.GetOrgStub = nil
+	if fake.getOrgReturnsOnCall == nil {
+		fake.getOrgReturnsOnCall = make(map[int]struct {
+			result1 model.Organization
+			result2 error
+		})
+	}
+	fake.getOrgReturnsOnCall[i] = struct {
+		result1 model.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetUser(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (model.User, error) {
+	fake.getUserMutex.Lock()
+	ret, specificReturn := fake.getUserReturnsOnCall[len(fake.getUserArgsForCall)]
+	fake.getUserArgsForCall = append(fake.getUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetUserStub
+	fakeReturns := fake.getUserReturns
+	fake.recordInvocation("GetUser", []interface{}{arg1, arg2, arg3})
+	fake.getUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) GetUserCallCount() int {
+	fake.getUserMutex.RLock()
+	defer fake.getUserMutex.RUnlock()
+	return len(fake.getUserArgsForCall)
+}
+
+func (fake *FakeService) GetUserCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (model.User, error)) {
+	fake.getUserMutex.Lock()
+	defer fake.getUserMutex.Unlock()
+	fake.GetUserStub = stub
+}
+
+func (fake *FakeService) GetUserArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getUserMutex.RLock()
+	defer fake.getUserMutex.RUnlock()
+	argsForCall := fake.getUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) GetUserReturns(result1 model.User, result2 error) {
+	fake.getUserMutex.Lock()
+	defer fake.getUserMutex.Unlock()
+	fake.GetUserStub = nil
+	fake.getUserReturns = struct {
+		result1 model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetUserReturnsOnCall(i int, result1 model.User, result2 error) {
+	fake.getUserMutex.Lock()
+	defer fake.getUserMutex.Unlock()
+	fake.GetUserStub = nil
+	if fake.getUserReturnsOnCall == nil {
+		fake.getUserReturnsOnCall = make(map[int]struct {
+			result1 model.User
+			result2 error
+		})
+	}
+	fake.getUserReturnsOnCall[i] = struct {
+		result1 model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) IntrospectToken(arg1 context.Context, arg2 model.ClientTokenRequest) (model.IntrospectionResponse, error) {
+	fake.introspectTokenMutex.Lock()
+	ret, specificReturn := fake.introspectTokenReturnsOnCall[len(fake.introspectTokenArgsForCall)]
+	fake.introspectTokenArgsForCall = append(fake.introspectTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.ClientTokenRequest
+	}{arg1, arg2})
+	stub := fake.IntrospectTokenStub
+	fakeReturns := fake.introspectTokenReturns
+	fake.recordInvocation("IntrospectToken", []interface{}{arg1, arg2})
+	fake.introspectTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) IntrospectTokenCallCount() int {
+	fake.introspectTokenMutex.RLock()
+	defer fake.introspectTokenMutex.RUnlock()
+	return len(fake.introspectTokenArgsForCall)
+}
+
+func (fake *FakeService) IntrospectTokenCalls(stub func(context.Context, model.ClientTokenRequest) (model.IntrospectionResponse, error)) {
+	fake.introspectTokenMutex.Lock()
+	defer fake.introspectTokenMutex.Unlock()
+	fake.IntrospectTokenStub = stub
+}
+
+func (fake *FakeService) IntrospectTokenArgsForCall(i int) (context.Context, model.ClientTokenRequest) {
+	fake.introspectTokenMutex.RLock()
+	defer fake.introspectTokenMutex.RUnlock()
+	argsForCall := fake.introspectTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) IntrospectTokenReturns(result1 model.IntrospectionResponse, result2 error) {
+	fake.introspectTokenMutex.Lock()
+	defer fake.introspectTokenMutex.Unlock()
+	fake.IntrospectTokenStub = nil
+	fake.introspectTokenReturns = struct {
+		result1 model.IntrospectionResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) IntrospectTokenReturnsOnCall(i int, result1 model.IntrospectionResponse, result2 error) {
+	fake.introspectTokenMutex.Lock()
+	defer fake.introspectTokenMutex.Unlock()
+	fake.IntrospectTokenStub = nil
+	if fake.introspectTokenReturnsOnCall == nil {
+		fake.introspectTokenReturnsOnCall = make(map[int]struct {
+			result1 model.IntrospectionResponse
+			result2 error
+		})
+	}
+	fake.introspectTokenReturnsOnCall[i] = struct {
+		result1 model.IntrospectionResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) InviteOrgMember(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 model.RoleType) (model.OrgInvitation, error) {
+	fake.inviteOrgMemberMutex.Lock()
+	ret, specificReturn := fake.inviteOrgMemberReturnsOnCall[len(fake.inviteOrgMemberArgsForCall)]
+	fake.inviteOrgMemberArgsForCall = append(fake.inviteOrgMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 model.RoleType
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.InviteOrgMemberStub
+	fakeReturns := fake.inviteOrgMemberReturns
+	fake.recordInvocation("InviteOrgMember", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.inviteOrgMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) InviteOrgMemberCallCount() int {
+	fake.inviteOrgMemberMutex.RLock()
+	defer fake.inviteOrgMemberMutex.RUnlock()
+	return len(fake.inviteOrgMemberArgsForCall)
+}
+
+func (fake *FakeService) InviteOrgMemberCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, model.RoleType) (model.OrgInvitation, error)) {
+	fake.inviteOrgMemberMutex.Lock()
+	defer fake.inviteOrgMemberMutex.Unlock()
+	fake.InviteOrgMemberStub = stub
+}
+
+func (fake *FakeService) InviteOrgMemberArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, model.RoleType) {
+	fake.inviteOrgMemberMutex.RLock()
+	defer fake.inviteOrgMemberMutex.RUnlock()
+	argsForCall := fake.inviteOrgMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeService) InviteOrgMemberReturns(result1 model.OrgInvitation, result2 error) {
+	fake.inviteOrgMemberMutex.Lock()
+	defer fake.inviteOrgMemberMutex.Unlock()
+	fake.InviteOrgMemberStub = nil
+	fake.inviteOrgMemberReturns = struct {
+		result1 model.OrgInvitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) InviteOrgMemberReturnsOnCall(i int, result1 model.OrgInvitation, result2 error) {
+	fake.inviteOrgMemberMutex.Lock()
+	defer fake.inviteOrgMemberMutex.Unlock()
+	fake.InviteOrgMemberStub = nil
+	if fake.inviteOrgMemberReturnsOnCall == nil {
+		fake.inviteOrgMemberReturnsOnCall = make(map[int]struct {
+			result1 model.OrgInvitation
+			result2 error
+		})
+	}
+	fake.inviteOrgMemberReturnsOnCall[i] = struct {
+		result1 model.OrgInvitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) LinkUserIdentity(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 model.ExternalIdentityRequest) (model.ExternalIdentity, error) {
+	fake.linkUserIdentityMutex.Lock()
+	ret, specificReturn := fake.linkUserIdentityReturnsOnCall[len(fake.linkUserIdentityArgsForCall)]
+	fake.linkUserIdentityArgsForCall = append(fake.linkUserIdentityArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.ExternalIdentityRequest
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.LinkUserIdentityStub
+	fakeReturns := fake.linkUserIdentityReturns
+	fake.recordInvocation("LinkUserIdentity", []interface{}{arg1, arg2, arg3, arg4})
+	fake.linkUserIdentityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) LinkUserIdentityCallCount() int {
+	fake.linkUserIdentityMutex.RLock()
+	defer fake.linkUserIdentityMutex.RUnlock()
+	return len(fake.linkUserIdentityArgsForCall)
+}
+
+func (fake *FakeService) LinkUserIdentityCalls(stub func(context.Context, uuid.UUID, uuid.UUID, model.ExternalIdentityRequest) (model.ExternalIdentity, error)) {
+	fake.linkUserIdentityMutex.Lock()
+	defer fake.linkUserIdentityMutex.Unlock()
+	fake.LinkUserIdentityStub = stub
+}
+
+func (fake *FakeService) LinkUserIdentityArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, model.ExternalIdentityRequest) {
+	fake.linkUserIdentityMutex.RLock()
+	defer fake.linkUserIdentityMutex.RUnlock()
+	argsForCall := fake.linkUserIdentityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) LinkUserIdentityReturns(result1 model.ExternalIdentity, result2 error) {
+	fake.linkUserIdentityMutex.Lock()
+	defer fake.linkUserIdentityMutex.Unlock()
+	fake.LinkUserIdentityStub = nil
+	fake.linkUserIdentityReturns = struct {
+		result1 model.ExternalIdentity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) LinkUserIdentityReturnsOnCall(i int, result1 model.ExternalIdentity, result2 error) {
+	fake.linkUserIdentityMutex.Lock()
+	defer fake.linkUserIdentityMutex.Unlock()
+	fake.LinkUserIdentityStub = nil
+	if fake.linkUserIdentityReturnsOnCall == nil {
+		fake.linkUserIdentityReturnsOnCall = make(map[int]struct {
+			result1 model.ExternalIdentity
+			result2 error
+		})
+	}
+	fake.linkUserIdentityReturnsOnCall[i] = struct {
+		result1 model.ExternalIdentity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListAPIKeys(arg1 context.Context, arg2 uuid.UUID) ([]model.APIKey, error) {
+	fake.listAPIKeysMutex.Lock()
+	ret, specificReturn := fake.listAPIKeysReturnsOnCall[len(fake.listAPIKeysArgsForCall)]
+	fake.listAPIKeysArgsForCall = append(fake.listAPIKeysArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListAPIKeysStub
+	fakeReturns := fake.listAPIKeysReturns
+	fake.recordInvocation("ListAPIKeys", []interface{}{arg1, arg2})
+	fake.listAPIKeysMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListAPIKeysCallCount() int {
+	fake.listAPIKeysMutex.RLock()
+	defer fake.listAPIKeysMutex.RUnlock()
+	return len(fake.listAPIKeysArgsForCall)
+}
+
+func (fake *FakeService) ListAPIKeysCalls(stub func(context.Context, uuid.UUID) ([]model.APIKey, error)) {
+	fake.listAPIKeysMutex.Lock()
+	defer fake.listAPIKeysMutex.Unlock()
+	fake.ListAPIKeysStub = stub
+}
+
+func (fake *FakeService) ListAPIKeysArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listAPIKeysMutex.RLock()
+	defer fake.listAPIKeysMutex.RUnlock()
+	argsForCall := fake.listAPIKeysArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) ListAPIKeysReturns(result1 []model.APIKey, result2 error) {
+	fake.listAPIKeysMutex.Lock()
+	defer fake.listAPIKeysMutex.Unlock()
+	fake.ListAPIKeysStub = nil
+	fake.listAPIKeysReturns = struct {
+		result1 []model.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListAPIKeysReturnsOnCall(i int, result1 []model.APIKey, result2 error) {
+	fake.listAPIKeysMutex.Lock()
+	defer fake.listAPIKeysMutex.Unlock()
+	fake.ListAPIKeysStub = nil
+	if fake.listAPIKeysReturnsOnCall == nil {
+		fake.listAPIKeysReturnsOnCall = make(map[int]struct {
+			result1 []model.APIKey
+			result2 error
+		})
+	}
+	fake.listAPIKeysReturnsOnCall[i] = struct {
+		result1 []model.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListInvitations(arg1 context.Context, arg2 uuid.UUID) ([]model.OrgInvitation, error) {
+	fake.listInvitationsMutex.Lock()
+	ret, specificReturn := fake.listInvitationsReturnsOnCall[len(fake.listInvitationsArgsForCall)]
+	fake.listInvitationsArgsForCall = append(fake.listInvitationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListInvitationsStub
+	fakeReturns := fake.listInvitationsReturns
+	fake.recordInvocation("ListInvitations", []interface{}{arg1, arg2})
+	fake.listInvitationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListInvitationsCallCount() int {
+	fake.listInvitationsMutex.RLock()
+	defer fake.listInvitationsMutex.RUnlock()
+	return len(fake.listInvitationsArgsForCall)
+}
+
+func (fake *FakeService) ListInvitationsCalls(stub func(context.Context, uuid.UUID) ([]model.OrgInvitation, error)) {
+	fake.listInvitationsMutex.Lock()
+	defer fake.listInvitationsMutex.Unlock()
+	fake.ListInvitationsStub = stub
+}
+
+func (fake *FakeService) ListInvitationsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listInvitationsMutex.RLock()
+	defer fake.listInvitationsMutex.RUnlock()
+	argsForCall := fake.listInvitationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) ListInvitationsReturns(result1 []model.OrgInvitation, result2 error) {
+	fake.listInvitationsMutex.Lock()
+	defer fake.listInvitationsMutex.Unlock()
+	fake.ListInvitationsStub = nil
+	fake.listInvitationsReturns = struct {
+		result1 []model.OrgInvitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListInvitationsReturnsOnCall(i int, result1 []model.OrgInvitation, result2 error) {
+	fake.listInvitationsMutex.Lock()
+	defer fake.listInvitationsMutex.Unlock()
+	fake.ListInvitationsStub = nil
+	if fake.listInvitationsReturnsOnCall == nil {
+		fake.listInvitationsReturnsOnCall = make(map[int]struct {
+			result1 []model.OrgInvitation
+			result2 error
+		})
+	}
+	fake.listInvitationsReturnsOnCall[i] = struct {
+		result1 []model.OrgInvitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListOAuthClients(arg1 context.Context, arg2 uuid.UUID) ([]model.OAuthClient, error) {
+	fake.listOAuthClientsMutex.Lock()
+	ret, specificReturn := fake.listOAuthClientsReturnsOnCall[len(fake.listOAuthClientsArgsForCall)]
+	fake.listOAuthClientsArgsForCall = append(fake.listOAuthClientsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListOAuthClientsStub
+	fakeReturns := fake.listOAuthClientsReturns
+	fake.recordInvocation("ListOAuthClients", []interface{}{arg1, arg2})
+	fake.listOAuthClientsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListOAuthClientsCallCount() int {
+	fake.listOAuthClientsMutex.RLock()
+	defer fake.listOAuthClientsMutex.RUnlock()
+	return len(fake.listOAuthClientsArgsForCall)
+}
+
+func (fake *FakeService) ListOAuthClientsCalls(stub func(context.Context, uuid.UUID) ([]model.OAuthClient, error)) {
+	fake.listOAuthClientsMutex.Lock()
+	defer fake.listOAuthClientsMutex.Unlock()
+	fake.ListOAuthClientsStub = stub
+}
+
+func (fake *FakeService) ListOAuthClientsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listOAuthClientsMutex.RLock()
+	defer fake.listOAuthClientsMutex.RUnlock()
+	argsForCall := fake.listOAuthClientsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) ListOAuthClientsReturns(result1 []model.OAuthClient, result2 error) {
+	fake.listOAuthClientsMutex.Lock()
+	defer fake.listOAuthClientsMutex.Unlock()
+	fake.ListOAuthClientsStub = nil
+	fake.listOAuthClientsReturns = struct {
+		result1 []model.OAuthClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListOAuthClientsReturnsOnCall(i int, result1 []model.OAuthClient, result2 error) {
+	fake.listOAuthClientsMutex.Lock()
+	defer fake.listOAuthClientsMutex.Unlock()
+	fake.ListOAuthClientsStub = nil
+	if fake.listOAuthClientsReturnsOnCall == nil {
+		fake.listOAuthClientsReturnsOnCall = make(map[int]struct {
+			result1 []model.OAuthClient
+			result2 error
+		})
+	}
+	fake.listOAuthClientsReturnsOnCall[i] = struct {
+		result1 []model.OAuthClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListOrgMembers(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]model.OrganizationMember, error) {
+	fake.listOrgMembersMutex.Lock()
+	ret, specificReturn := fake.listOrgMembersReturnsOnCall[len(fake.listOrgMembersArgsForCall)]
+	fake.listOrgMembersArgsForCall = append(fake.listOrgMembersArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListOrgMembersStub
+	fakeReturns := fake.listOrgMembersReturns
+	fake.recordInvocation("ListOrgMembers", []interface{}{arg1, arg2, arg3})
+	fake.listOrgMembersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListOrgMembersCallCount() int {
+	fake.listOrgMembersMutex.RLock()
+	defer fake.listOrgMembersMutex.RUnlock()
+	return len(fake.listOrgMembersArgsForCall)
+}
+
+func (fake *FakeService) ListOrgMembersCalls(stub func(context.Context, uuid.UUID, uuid.UUID) ([]model.OrganizationMember, error)) {
+	fake.listOrgMembersMutex.Lock()
+	defer fake.listOrgMembersMutex.Unlock()
+	fake.ListOrgMembersStub = stub
+}
+
+func (fake *FakeService) ListOrgMembersArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.listOrgMembersMutex.RLock()
+	defer fake.listOrgMembersMutex.RUnlock()
+	argsForCall := fake.listOrgMembersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) ListOrgMembersReturns(result1 []model.OrganizationMember, result2 error) {
+	fake.listOrgMembersMutex.Lock()
+	defer fake.listOrgMembersMutex.Unlock()
+	fake.ListOrgMembersStub = nil
+	fake.listOrgMembersReturns = struct {
+		result1 []model.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListOrgMembersReturnsOnCall(i int, result1 []model.OrganizationMember, result2 error) {
+	fake.listOrgMembersMutex.Lock()
+	defer fake.listOrgMembersMutex.Unlock()
+	fake.ListOrgMembersStub = nil
+	if fake.listOrgMembersReturnsOnCall == nil {
+		fake.listOrgMembersReturnsOnCall = make(map[int]struct {
+			result1 []model.OrganizationMember
+			result2 error
+		})
+	}
+	fake.listOrgMembersReturnsOnCall[i] = struct {
+		result1 []model.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListUserIdentities(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]model.ExternalIdentity, error) {
+	fake.listUserIdentitiesMutex.Lock()
+	ret, specificReturn := fake.listUserIdentitiesReturnsOnCall[len(fake.listUserIdentitiesArgsForCall)]
+	fake.listUserIdentitiesArgsForCall = append(fake.listUserIdentitiesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListUserIdentitiesStub
+	fakeReturns := fake.listUserIdentitiesReturns
+	fake.recordInvocation("ListUserIdentities", []interface{}{arg1, arg2, arg3})
+	fake.listUserIdentitiesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListUserIdentitiesCallCount() int {
+	fake.listUserIdentitiesMutex.RLock()
+	defer fake.listUserIdentitiesMutex.RUnlock()
+	return len(fake.listUserIdentitiesArgsForCall)
+}
+
+func (fake *FakeService) ListUserIdentitiesCalls(stub func(context.Context, uuid.UUID, uuid.UUID) ([]model.ExternalIdentity, error)) {
+	fake.listUserIdentitiesMutex.Lock()
+	defer fake.listUserIdentitiesMutex.Unlock()
+	fake.ListUserIdentitiesStub = stub
+}
+
+func (fake *FakeService) ListUserIdentitiesArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.listUserIdentitiesMutex.RLock()
+	defer fake.listUserIdentitiesMutex.RUnlock()
+	argsForCall := fake.listUserIdentitiesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) ListUserIdentitiesReturns(result1 []model.ExternalIdentity, result2 error) {
+	fake.listUserIdentitiesMutex.Lock()
+	defer fake.listUserIdentitiesMutex.Unlock()
+	fake.ListUserIdentitiesStub = nil
+	fake.listUserIdentitiesReturns = struct {
+		result1 []model.ExternalIdentity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListUserIdentitiesReturnsOnCall(i int, result1 []model.ExternalIdentity, result2 error) {
+	fake.listUserIdentitiesMutex.Lock()
+	defer fake.listUserIdentitiesMutex.Unlock()
+	fake.ListUserIdentitiesStub = nil
+	if fake.listUserIdentitiesReturnsOnCall == nil {
+		fake.listUserIdentitiesReturnsOnCall = make(map[int]struct {
+			result1 []model.ExternalIdentity
+			result2 error
+		})
+	}
+	fake.listUserIdentitiesReturnsOnCall[i] = struct {
+		result1 []model.ExternalIdentity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListUserOrgs(arg1 context.Context, arg2 uuid.UUID) ([]model.Organization, error) {
+	fake.listUserOrgsMutex.Lock()
+	ret, specificReturn := fake.listUserOrgsReturnsOnCall[len(fake.listUserOrgsArgsForCall)]
+	fake.listUserOrgsArgsForCall = append(fake.listUserOrgsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListUserOrgsStub
+	fakeReturns := fake.listUserOrgsReturns
+	fake.recordInvocation("ListUserOrgs", []interface{}{arg1, arg2})
+	fake.listUserOrgsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListUserOrgsCallCount() int {
+	fake.listUserOrgsMutex.RLock()
+	defer fake.listUserOrgsMutex.RUnlock()
+	return len(fake.listUserOrgsArgsForCall)
+}
+
+func (fake *FakeService) ListUserOrgsCalls(stub func(context.Context, uuid.UUID) ([]model.Organization, error)) {
+	fake.listUserOrgsMutex.Lock()
+	defer fake.listUserOrgsMutex.Unlock()
+	fake.ListUserOrgsStub = stub
+}
+
+func (fake *FakeService) ListUserOrgsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listUserOrgsMutex.RLock()
+	defer fake.listUserOrgsMutex.RUnlock()
+	argsForCall := fake.listUserOrgsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) ListUserOrgsReturns(result1 []model.Organization, result2 error) {
+	fake.listUserOrgsMutex.Lock()
+	defer fake.listUserOrgsMutex.Unlock()
+	fake.ListUserOrgsStub = nil
+	fake.listUserOrgsReturns = struct {
+		result1 []model.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListUserOrgsReturnsOnCall(i int, result1 []model.Organization, result2 error) {
+	fake.listUserOrgsMutex.Lock()
+	defer fake.listUserOrgsMutex.Unlock()
+	fake.ListUserOrgsStub = nil
+	if fake.listUserOrgsReturnsOnCall == nil {
+		fake.listUserOrgsReturnsOnCall = make(map[int]struct {
+			result1 []model.Organization
+			result2 error
+		})
+	}
+	fake.listUserOrgsReturnsOnCall[i] = struct {
+		result1 []model.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListUsers(arg1 context.Context, arg2 uuid.UUID) ([]model.User, error) {
+	fake.listUsersMutex.Lock()
+	ret, specificReturn := fake.listUsersReturnsOnCall[len(fake.listUsersArgsForCall)]
+	fake.listUsersArgsForCall = append(fake.listUsersArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListUsersStub
+	fakeReturns := fake.listUsersReturns
+	fake.recordInvocation("ListUsers", []interface{}{arg1, arg2})
+	fake.listUsersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListUsersCallCount() int {
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	return len(fake.listUsersArgsForCall)
+}
+
+func (fake *FakeService) ListUsersCalls(stub func(context.Context, uuid.UUID) ([]model.User, error)) {
+	fake.listUsersMutex.Lock()
+	defer fake.listUsersMutex.Unlock()
+	fake.ListUsersStub = stub
+}
+
+func (fake *FakeService) ListUsersArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	argsForCall := fake.listUsersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) ListUsersReturns(result1 []model.User, result2 error) {
+	fake.listUsersMutex.Lock()
+	defer fake.listUsersMutex.Unlock()
+	fake.ListUsersStub = nil
+	fake.listUsersReturns = struct {
+		result1 []model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListUsersReturnsOnCall(i int, result1 []model.User, result2 error) {
+	fake.listUsersMutex.Lock()
+	defer fake.listUsersMutex.Unlock()
+	fake.ListUsersStub = nil
+	if fake.listUsersReturnsOnCall == nil {
+		fake.listUsersReturnsOnCall = make(map[int]struct {
+			result1 []model.User
+			result2 error
+		})
+	}
+	fake.listUsersReturnsOnCall[i] = struct {
+		result1 []model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) Logout(arg1 context.Context, arg2 model.AccessToken, arg3 string) error {
+	fake.logoutMutex.Lock()
+	ret, specificReturn := fake.logoutReturnsOnCall[len(fake.logoutArgsForCall)]
+	fake.logoutArgsForCall = append(fake.logoutArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.AccessToken
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.LogoutStub
+	fakeReturns := fake.logoutReturns
+	fake.recordInvocation("Logout", []interface{}{arg1, arg2, arg3})
+	fake.logoutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) LogoutCallCount() int {
+	fake.logoutMutex.RLock()
+	defer fake.logoutMutex.RUnlock()
+	return len(fake.logoutArgsForCall)
+}
+
+func (fake *FakeService) LogoutCalls(stub func(context.Context, model.AccessToken, string) error) {
+	fake.logoutMutex.Lock()
+	defer fake.logoutMutex.Unlock()
+	fake.LogoutStub = stub
+}
+
+func (fake *FakeService) LogoutArgsForCall(i int) (context.Context, model.AccessToken, string) {
+	fake.logoutMutex.RLock()
+	defer fake.logoutMutex.RUnlock()
+	argsForCall := fake.logoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) LogoutReturns(result1 error) {
+	fake.logoutMutex.Lock()
+	defer fake.logoutMutex.Unlock()
+	fake.LogoutStub = nil
+	fake.logoutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) LogoutReturnsOnCall(i int, result1 error) {
+	fake.logoutMutex.Lock()
+	defer fake.logoutMutex.Unlock()
+	fake.LogoutStub = nil
+	if fake.logoutReturnsOnCall == nil {
+		fake.logoutReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.logoutReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) MFAStatus(arg1 context.Context, arg2 uuid.UUID) (model.MFAStatus, error) {
+	fake.mFAStatusMutex.Lock()
+	ret, specificReturn := fake.mFAStatusReturnsOnCall[len(fake.mFAStatusArgsForCall)]
+	fake.mFAStatusArgsForCall = append(fake.mFAStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.MFAStatusStub
+	fakeReturns := fake.mFAStatusReturns
+	fake.recordInvocation("MFAStatus", []interface{}{arg1, arg2})
+	fake.mFAStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) MFAStatusCallCount() int {
+	fake.mFAStatusMutex.RLock()
+	defer fake.mFAStatusMutex.RUnlock()
+	return len(fake.mFAStatusArgsForCall)
+}
+
+func (fake *FakeService) MFAStatusCalls(stub func(context.Context, uuid.UUID) (model.MFAStatus, error)) {
+	fake.mFAStatusMutex.Lock()
+	defer fake.mFAStatusMutex.Unlock()
+	fake.MFAStatusStub = stub
+}
+
+func (fake *FakeService) MFAStatusArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.mFAStatusMutex.RLock()
+	defer fake.mFAStatusMutex.RUnlock()
+	argsForCall := fake.mFAStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) MFAStatusReturns(result1 model.MFAStatus, result2 error) {
+	fake.mFAStatusMutex.Lock()
+	defer fake.mFAStatusMutex.Unlock()
+	fake.MFAStatusStub = nil
+	fake.mFAStatusReturns = struct {
+		result1 model.MFAStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) MFAStatusReturnsOnCall(i int, result1 model.MFAStatus, result2 error) {
+	fake.mFAStatusMutex.Lock()
+	defer fake.mFAStatusMutex.Unlock()
+	fake.MFAStatusStub = nil
+	if fake.mFAStatusReturnsOnCall == nil {
+		fake.mFAStatusReturnsOnCall = make(map[int]struct {
+			result1 model.MFAStatus
+			result2 error
+		})
+	}
+	fake.mFAStatusReturnsOnCall[i] = struct {
+		result1 model.MFAStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) OAuthToken(arg1 context.Context, arg2 model.TokenRequest, arg3 string) (model.TokenResponse, error) {
+	fake.oAuthTokenMutex.Lock()
+	ret, specificReturn := fake.oAuthTokenReturnsOnCall[len(fake.oAuthTokenArgsForCall)]
+	fake.oAuthTokenArgsForCall = append(fake.oAuthTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.TokenRequest
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.OAuthTokenStub
+	fakeReturns := fake.oAuthTokenReturns
+	fake.recordInvocation("OAuthToken", []interface{}{arg1, arg2, arg3})
+	fake.oAuthTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) OAuthTokenCallCount() int {
+	fake.oAuthTokenMutex.RLock()
+	defer fake.oAuthTokenMutex.RUnlock()
+	return len(fake.oAuthTokenArgsForCall)
+}
+
+func (fake *FakeService) OAuthTokenCalls(stub func(context.Context, model.TokenRequest, string) (model.TokenResponse, error)) {
+	fake.oAuthTokenMutex.Lock()
+	defer fake.oAuthTokenMutex.Unlock()
+	fake.OAuthTokenStub = stub
+}
+
+func (fake *FakeService) OAuthTokenArgsForCall(i int) (context.Context, model.TokenRequest, string) {
+	fake.oAuthTokenMutex.RLock()
+	defer fake.oAuthTokenMutex.RUnlock()
+	argsForCall := fake.oAuthTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) OAuthTokenReturns(result1 model.TokenResponse, result2 error) {
+	fake.oAuthTokenMutex.Lock()
+	defer fake.oAuthTokenMutex.Unlock()
+	fake.OAuthTokenStub = nil
+	fake.oAuthTokenReturns = struct {
+		result1 model.TokenResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) OAuthTokenReturnsOnCall(i int, result1 model.TokenResponse, result2 error) {
+	fake.oAuthTokenMutex.Lock()
+	defer fake.oAuthTokenMutex.Unlock()
+	fake.OAuthTokenStub = nil
+	if fake.oAuthTokenReturnsOnCall == nil {
+		fake.oAuthTokenReturnsOnCall = make(map[int]struct {
+			result1 model.TokenResponse
+			result2 error
+		})
+	}
+	fake.oAuthTokenReturnsOnCall[i] = struct {
+		result1 model.TokenResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) Refresh(arg1 context.Context, arg2 string) (model.AuthResponse, error) {
+	fake.refreshMutex.Lock()
+	ret, specificReturn := fake.refreshReturnsOnCall[len(fake.refreshArgsForCall)]
+	fake.refreshArgsForCall = append(fake.refreshArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RefreshStub
+	fakeReturns := fake.refreshReturns
+	fake.recordInvocation("Refresh", []interface{}{arg1, arg2})
+	fake.refreshMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) RefreshCallCount() int {
+	fake.refreshMutex.RLock()
+	defer fake.refreshMutex.RUnlock()
+	return len(fake.refreshArgsForCall)
+}
+
+func (fake *FakeService) RefreshCalls(stub func(context.Context, string) (model.AuthResponse, error)) {
+	fake.refreshMutex.Lock()
+	defer fake.refreshMutex.Unlock()
+	fake.RefreshStub = stub
+}
+
+func (fake *FakeService) RefreshArgsForCall(i int) (context.Context, string) {
+	fake.refreshMutex.RLock()
+	defer fake.refreshMutex.RUnlock()
+	argsForCall := fake.refreshArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) RefreshReturns(result1 model.AuthResponse, result2 error) {
+	fake.refreshMutex.Lock()
+	defer fake.refreshMutex.Unlock()
+	fake.RefreshStub = nil
+	fake.refreshReturns = struct {
+		result1 model.AuthResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) RefreshReturnsOnCall(i int, result1 model.AuthResponse, result2 error) {
+	fake.refreshMutex.Lock()
+	defer fake.refreshMutex.Unlock()
+	fake.RefreshStub = nil
+	if fake.refreshReturnsOnCall == nil {
+		fake.refreshReturnsOnCall = make(map[int]struct {
+			result1 model.AuthResponse
+			result2 error
+		})
+	}
+	fake.refreshReturnsOnCall[i] = struct {
+		result1 model.AuthResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) RegenerateRecoveryCodes(arg1 context.Context, arg2 uuid.UUID, arg3 string) (model.RecoveryCodes, error) {
+	fake.regenerateRecoveryCodesMutex.Lock()
+	ret, specificReturn := fake.regenerateRecoveryCodesReturnsOnCall[len(fake.regenerateRecoveryCodesArgsForCall)]
+	fake.regenerateRecoveryCodesArgsForCall = append(fake.regenerateRecoveryCodesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RegenerateRecoveryCodesStub
+	fakeReturns := fake.regenerateRecoveryCodesReturns
+	fake.recordInvocation("RegenerateRecoveryCodes", []interface{}{arg1, arg2, arg3})
+	fake.regenerateRecoveryCodesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) RegenerateRecoveryCodesCallCount() int {
+	fake.regenerateRecoveryCodesMutex.RLock()
+	defer fake.regenerateRecoveryCodesMutex.RUnlock()
+	return len(fake.regenerateRecoveryCodesArgsForCall)
+}
+
+func (fake *FakeService) RegenerateRecoveryCodesCalls(stub func(context.Context, uuid.UUID, string) (model.RecoveryCodes, error)) {
+	fake.regenerateRecoveryCodesMutex.Lock()
+	defer fake.regenerateRecoveryCodesMutex.Unlock()
+	fake.RegenerateRecoveryCodesStub = stub
+}
+
+func (fake *FakeService) RegenerateRecoveryCodesArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.regenerateRecoveryCodesMutex.RLock()
+	defer fake.regenerateRecoveryCodesMutex.RUnlock()
+	argsForCall := fake.regenerateRecoveryCodesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) RegenerateRecoveryCodesReturns(result1 model.RecoveryCodes, result2 error) {
+	fake.regenerateRecoveryCodesMutex.Lock()
+	defer fake.regenerateRecoveryCodesMutex.Unlock()
+	fake.RegenerateRecoveryCodesStub = nil
+	fake.regenerateRecoveryCodesReturns = struct {
+		result1 model.RecoveryCodes
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) RegenerateRecoveryCodesReturnsOnCall(i int, result1 model.RecoveryCodes, result2 error) {
+	fake.regenerateRecoveryCodesMutex.Lock()
+	defer fake.regenerateRecoveryCodesMutex.Unlock()
+	fake.RegenerateRecoveryCodesStub = nil
+	if fake.regenerateRecoveryCodesReturnsOnCall == nil {
+		fake.regenerateRecoveryCodesReturnsOnCall = make(map[int]struct {
+			result1 model.RecoveryCodes
+			result2 error
+		})
+	}
+	fake.regenerateRecoveryCodesReturnsOnCall[i] = struct {
+		result1 model.RecoveryCodes
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) RegisterOAuthClient(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 model.OAuthClientRequest) (model.CreatedOAuthClient, error) {
+	fake.registerOAuthClientMutex.Lock()
+	ret, specificReturn := fake.registerOAuthClientReturnsOnCall[len(fake.registerOAuthClientArgsForCall)]
+	fake.registerOAuthClientArgsForCall = append(fake.registerOAuthClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.OAuthClientRequest
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RegisterOAuthClientStub
+	fakeReturns := fake.registerOAuthClientReturns
+	fake.recordInvocation("RegisterOAuthClient", []interface{}{arg1, arg2, arg3, arg4})
+	fake.registerOAuthClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) RegisterOAuthClientCallCount() int {
+	fake.registerOAuthClientMutex.RLock()
+	defer fake.registerOAuthClientMutex.RUnlock()
+	return len(fake.registerOAuthClientArgsForCall)
+}
+
+func (fake *FakeService) RegisterOAuthClientCalls(stub func(context.Context, uuid.UUID, uuid.UUID, model.OAuthClientRequest) (model.CreatedOAuthClient, error)) {
+	fake.registerOAuthClientMutex.Lock()
+	defer fake.registerOAuthClientMutex.Unlock()
+	fake.RegisterOAuthClientStub = stub
+}
+
+func (fake *FakeService) RegisterOAuthClientArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, model.OAuthClientRequest) {
+	fake.registerOAuthClientMutex.RLock()
+	defer fake.registerOAuthClientMutex.RUnlock()
+	argsForCall := fake.registerOAuthClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) RegisterOAuthClientReturns(result1 model.CreatedOAuthClient, result2 error) {
+	fake.registerOAuthClientMutex.Lock()
+	defer fake.registerOAuthClientMutex.Unlock()
+	fake.RegisterOAuthClientStub = nil
+	fake.registerOAuthClientReturns = struct {
+		result1 model.CreatedOAuthClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) RegisterOAuthClientReturnsOnCall(i int, result1 model.CreatedOAuthClient, result2 error) {
+	fake.registerOAuthClientMutex.Lock()
+	defer fake.registerOAuthClientMutex.Unlock()
+	fake.RegisterOAuthClientStub = nil
+	if fake.registerOAuthClientReturnsOnCall == nil {
+		fake.registerOAuthClientReturnsOnCall = make(map[int]struct {
+			result1 model.CreatedOAuthClient
+			result2 error
+		})
+	}
+	fake.registerOAuthClientReturnsOnCall[i] = struct {
+		result1 model.CreatedOAuthClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) RemoveOrgMember(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) error {
+	fake.removeOrgMemberMutex.Lock()
+	ret, specificReturn := fake.removeOrgMemberReturnsOnCall[len(fake.removeOrgMemberArgsForCall)]
+	fake.removeOrgMemberArgsForCall = append(fake.removeOrgMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveOrgMemberStub
+	fakeReturns := fake.removeOrgMemberReturns
+	fake.recordInvocation("RemoveOrgMember", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeOrgMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) RemoveOrgMemberCallCount() int {
+	fake.removeOrgMemberMutex.RLock()
+	defer fake.removeOrgMemberMutex.RUnlock()
+	return len(fake.removeOrgMemberArgsForCall)
+}
+
+func (fake *FakeService) RemoveOrgMemberCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) {
+	fake.removeOrgMemberMutex.Lock()
+	defer fake.removeOrgMemberMutex.Unlock()
+	fake.RemoveOrgMemberStub = stub
+}
+
+func (fake *FakeService) RemoveOrgMemberArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.removeOrgMemberMutex.RLock()
+	defer fake.removeOrgMemberMutex.RUnlock()
+	argsForCall := fake.removeOrgMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) RemoveOrgMemberReturns(result1 error) {
+	fake.removeOrgMemberMutex.Lock()
+	defer fake.removeOrgMemberMutex.Unlock()
+	fake.RemoveOrgMemberStub = nil
+	fake.removeOrgMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) RemoveOrgMemberReturnsOnCall(i int, result1 error) {
+	fake.removeOrgMemberMutex.Lock()
+	defer fake.removeOrgMemberMutex.Unlock()
+	fake.RemoveOrgMemberStub = nil
+	if fake.removeOrgMemberReturnsOnCall == nil {
+		fake.removeOrgMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeOrgMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) ResetUserMFA(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.resetUserMFAMutex.Lock()
+	ret, specificReturn := fake.resetUserMFAReturnsOnCall[len(fake.resetUserMFAArgsForCall)]
+	fake.resetUserMFAArgsForCall = append(fake.resetUserMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ResetUserMFAStub
+	fakeReturns := fake.resetUserMFAReturns
+	fake.recordInvocation("ResetUserMFA", []interface{}{arg1, arg2, arg3})
+	fake.resetUserMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) ResetUserMFACallCount() int {
+	fake.resetUserMFAMutex.RLock()
+	defer fake.resetUserMFAMutex.RUnlock()
+	return len(fake.resetUserMFAArgsForCall)
+}
+
+func (fake *FakeService) ResetUserMFACalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.resetUserMFAMutex.Lock()
+	defer fake.resetUserMFAMutex.Unlock()
+	fake.ResetUserMFAStub = stub
+}
+
+func (fake *FakeService) ResetUserMFAArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.resetUserMFAMutex.RLock()
+	defer fake.resetUserMFAMutex.RUnlock()
+	argsForCall := fake.resetUserMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) ResetUserMFAReturns(result1 error) {
+	fake.resetUserMFAMutex.Lock()
+	defer fake.resetUserMFAMutex.Unlock()
+	fake.ResetUserMFAStub = nil
+	fake.resetUserMFAReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) ResetUserMFAReturnsOnCall(i int, result1 error) {
+	fake.resetUserMFAMutex.Lock()
+	defer fake.resetUserMFAMutex.Unlock()
+	fake.ResetUserMFAStub = nil
+	if fake.resetUserMFAReturnsOnCall == nil {
+		fake.resetUserMFAReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetUserMFAReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) ResetUserPassword(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 string) error {
+	fake.resetUserPasswordMutex.Lock()
+	ret, specificReturn := fake.resetUserPasswordReturnsOnCall[len(fake.resetUserPasswordArgsForCall)]
+	fake.resetUserPasswordArgsForCall = append(fake.resetUserPasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResetUserPasswordStub
+	fakeReturns := fake.resetUserPasswordReturns
+	fake.recordInvocation("ResetUserPassword", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resetUserPasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) ResetUserPasswordCallCount() int {
+	fake.resetUserPasswordMutex.RLock()
+	defer fake.resetUserPasswordMutex.RUnlock()
+	return len(fake.resetUserPasswordArgsForCall)
+}
+
+func (fake *FakeService) ResetUserPasswordCalls(stub func(context.Context, uuid.UUID, uuid.UUID, string) error) {
+	fake.resetUserPasswordMutex.Lock()
+	defer fake.resetUserPasswordMutex.Unlock()
+	fake.ResetUserPasswordStub = stub
+}
+
+func (fake *FakeService) ResetUserPasswordArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, string) {
+	fake.resetUserPasswordMutex.RLock()
+	defer fake.resetUserPasswordMutex.RUnlock()
+	argsForCall := fake.resetUserPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) ResetUserPasswordReturns(result1 error) {
+	fake.resetUserPasswordMutex.Lock()
+	defer fake.resetUserPasswordMutex.Unlock()
+	fake.ResetUserPasswordStub = nil
+	fake.resetUserPasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) ResetUserPasswordReturnsOnCall(i int, result1 error) {
+	fake.resetUserPasswordMutex.Lock()
+	defer fake.resetUserPasswordMutex.Unlock()
+	fake.ResetUserPasswordStub = nil
+	if fake.resetUserPasswordReturnsOnCall == nil {
+		fake.resetUserPasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetUserPasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) RevokeAPIKey(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.revokeAPIKeyMutex.Lock()
+	ret, specificReturn := fake.revokeAPIKeyReturnsOnCall[len(fake.revokeAPIKeyArgsForCall)]
+	fake.revokeAPIKeyArgsForCall = append(fake.revokeAPIKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.RevokeAPIKeyStub
+	fakeReturns := fake.revokeAPIKeyReturns
+	fake.recordInvocation("RevokeAPIKey", []interface{}{arg1, arg2, arg3})
+	fake.revokeAPIKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) RevokeAPIKeyCallCount() int {
+	fake.revokeAPIKeyMutex.RLock()
+	defer fake.revokeAPIKeyMutex.RUnlock()
+	return len(fake.revokeAPIKeyArgsForCall)
+}
+
+func (fake *FakeService) RevokeAPIKeyCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.revokeAPIKeyMutex.Lock()
+	defer fake.revokeAPIKeyMutex.Unlock()
+	fake.RevokeAPIKeyStub = stub
+}
+
+func (fake *FakeService) RevokeAPIKeyArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.revokeAPIKeyMutex.RLock()
+	defer fake.revokeAPIKeyMutex.RUnlock()
+	argsForCall := fake.revokeAPIKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) RevokeAPIKeyReturns(result1 error) {
+	fake.revokeAPIKeyMutex.Lock()
+	defer fake.revokeAPIKeyMutex.Unlock()
+	fake.RevokeAPIKeyStub = nil
+	fake.revokeAPIKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) RevokeAPIKeyReturnsOnCall(i int, result1 error) {
+	fake.revokeAPIKeyMutex.Lock()
+	defer fake.revokeAPIKeyMutex.Unlock()
+	fake.RevokeAPIKeyStub = nil
+	if fake.revokeAPIKeyReturnsOnCall == nil {
+		fake.revokeAPIKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeAPIKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) RevokeOAuthToken(arg1 context.Context, arg2 model.ClientTokenRequest) error {
+	fake.revokeOAuthTokenMutex.Lock()
+	ret, specificReturn := fake.revokeOAuthTokenReturnsOnCall[len(fake.revokeOAuthTokenArgsForCall)]
+	fake.revokeOAuthTokenArgsForCall = append(fake.revokeOAuthTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.ClientTokenRequest
+	}{arg1, arg2})
+	stub := fake.RevokeOAuthTokenStub
+	fakeReturns := fake.revokeOAuthTokenReturns
+	fake.recordInvocation("RevokeOAuthToken", []interface{}{arg1, arg2})
+	fake.revokeOAuthTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) RevokeOAuthTokenCallCount() int {
+	fake.revokeOAuthTokenMutex.RLock()
+	defer fake.revokeOAuthTokenMutex.RUnlock()
+	return len(fake.revokeOAuthTokenArgsForCall)
+}
+
+func (fake *FakeService) RevokeOAuthTokenCalls(stub func(context.Context, model.ClientTokenRequest) error) {
+	fake.revokeOAuthTokenMutex.Lock()
+	defer fake.revokeOAuthTokenMutex.Unlock()
+	fake.RevokeOAuthTokenStub = stub
+}
+
+func (fake *FakeService) RevokeOAuthTokenArgsForCall(i int) (context.Context, model.ClientTokenRequest) {
+	fake.revokeOAuthTokenMutex.RLock()
+	defer fake.revokeOAuthTokenMutex.RUnlock()
+	argsForCall := fake.revokeOAuthTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) RevokeOAuthTokenReturns(result1 error) {
+	fake.revokeOAuthTokenMutex.Lock()
+	defer fake.revokeOAuthTokenMutex.Unlock()
+	fake.RevokeOAuthTokenStub = nil
+	fake.revokeOAuthTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) RevokeOAuthTokenReturnsOnCall(i int, result1 error) {
+	fake.revokeOAuthTokenMutex.Lock()
+	defer fake.revokeOAuthTokenMutex.Unlock()
+	fake.RevokeOAuthTokenStub = nil
+	if fake.revokeOAuthTokenReturnsOnCall == nil {
+		fake.revokeOAuthTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeOAuthTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) RevokeToken(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 model.AccessToken) error {
+	fake.revokeTokenMutex.Lock()
+	ret, specificReturn := fake.revokeTokenReturnsOnCall[len(fake.revokeTokenArgsForCall)]
+	fake.revokeTokenArgsForCall = append(fake.revokeTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.AccessToken
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RevokeTokenStub
+	fakeReturns := fake.revokeTokenReturns
+	fake.recordInvocation("RevokeToken", []interface{}{arg1, arg2, arg3, arg4})
+	fake.revokeTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) RevokeTokenCallCount() int {
+	fake.revokeTokenMutex.RLock()
+	defer fake.revokeTokenMutex.RUnlock()
+	return len(fake.revokeTokenArgsForCall)
+}
+
+func (fake *FakeService) RevokeTokenCalls(stub func(context.Context, uuid.UUID, uuid.UUID, model.AccessToken) error) {
+	fake.revokeTokenMutex.Lock()
+	defer fake.revokeTokenMutex.Unlock()
+	fake.RevokeTokenStub = stub
+}
+
+func (fake *FakeService) RevokeTokenArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, model.AccessToken) {
+	fake.revokeTokenMutex.RLock()
+	defer fake.revokeTokenMutex.RUnlock()
+	argsForCall := fake.revokeTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) RevokeTokenReturns(result1 error) {
+	fake.revokeTokenMutex.Lock()
+	defer fake.revokeTokenMutex.Unlock()
+	fake.RevokeTokenStub = nil
+	fake.revokeTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) RevokeTokenReturnsOnCall(i int, result1 error) {
+	fake.revokeTokenMutex.Lock()
+	defer fake.revokeTokenMutex.Unlock()
+	fake.RevokeTokenStub = nil
+	if fake.revokeTokenReturnsOnCall == nil {
+		fake.revokeTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) RevokeTokensIssuedBefore(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 time.Time) error {
+	fake.revokeTokensIssuedBeforeMutex.Lock()
+	ret, specificReturn := fake.revokeTokensIssuedBeforeReturnsOnCall[len(fake.revokeTokensIssuedBeforeArgsForCall)]
+	fake.revokeTokensIssuedBeforeArgsForCall = append(fake.revokeTokensIssuedBeforeArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RevokeTokensIssuedBeforeStub
+	fakeReturns := fake.revokeTokensIssuedBeforeReturns
+	fake.recordInvocation("RevokeTokensIssuedBefore", []interface{}{arg1, arg2, arg3, arg4})
+	fake.revokeTokensIssuedBeforeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) RevokeTokensIssuedBeforeCallCount() int {
+	fake.revokeTokensIssuedBeforeMutex.RLock()
+	defer fake.revokeTokensIssuedBeforeMutex.RUnlock()
+	return len(fake.revokeTokensIssuedBeforeArgsForCall)
+}
+
+func (fake *FakeService) RevokeTokensIssuedBeforeCalls(stub func(context.Context, uuid.UUID, uuid.UUID, time.Time) error) {
+	fake.revokeTokensIssuedBeforeMutex.Lock()
+	defer fake.revokeTokensIssuedBeforeMutex.Unlock()
+	fake.RevokeTokensIssuedBeforeStub = stub
+}
+
+func (fake *FakeService) RevokeTokensIssuedBeforeArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, time.Time) {
+	fake.revokeTokensIssuedBeforeMutex.RLock()
+	defer fake.revokeTokensIssuedBeforeMutex.RUnlock()
+	argsForCall := fake.revokeTokensIssuedBeforeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) RevokeTokensIssuedBeforeReturns(result1 error) {
+	fake.revokeTokensIssuedBeforeMutex.Lock()
+	defer fake.revokeTokensIssuedBeforeMutex.Unlock()
+	fake.RevokeTokensIssuedBeforeStub = nil
+	fake.revokeTokensIssuedBeforeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) RevokeTokensIssuedBeforeReturnsOnCall(i int, result1 error) {
+	fake.revokeTokensIssuedBeforeMutex.Lock()
+	defer fake.revokeTokensIssuedBeforeMutex.Unlock()
+	fake.RevokeTokensIssuedBeforeStub = nil
+	if fake.revokeTokensIssuedBeforeReturnsOnCall == nil {
+		fake.revokeTokensIssuedBeforeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeTokensIssuedBeforeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) RevokeUserTokens(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) error {
+	fake.revokeUserTokensMutex.Lock()
+	ret, specificReturn := fake.revokeUserTokensReturnsOnCall[len(fake.revokeUserTokensArgsForCall)]
+	fake.revokeUserTokensArgsForCall = append(fake.revokeUserTokensArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RevokeUserTokensStub
+	fakeReturns := fake.revokeUserTokensReturns
+	fake.recordInvocation("RevokeUserTokens", []interface{}{arg1, arg2, arg3, arg4})
+	fake.revokeUserTokensMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) RevokeUserTokensCallCount() int {
+	fake.revokeUserTokensMutex.RLock()
+	defer fake.revokeUserTokensMutex.RUnlock()
+	return len(fake.revokeUserTokensArgsForCall)
+}
+
+func (fake *FakeService) RevokeUserTokensCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) {
+	fake.revokeUserTokensMutex.Lock()
+	defer fake.revokeUserTokensMutex.Unlock()
+	fake.RevokeUserTokensStub = stub
+}
+
+func (fake *FakeService) RevokeUserTokensArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.revokeUserTokensMutex.RLock()
+	defer fake.revokeUserTokensMutex.RUnlock()
+	argsForCall := fake.revokeUserTokensArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) RevokeUserTokensReturns(result1 error) {
+	fake.revokeUserTokensMutex.Lock()
+	defer fake.revokeUserTokensMutex.Unlock()
+	fake.RevokeUserTokensStub = nil
+	fake.revokeUserTokensReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) RevokeUserTokensReturnsOnCall(i int, result1 error) {
+	fake.revokeUserTokensMutex.Lock()
+	defer fake.revokeUserTokensMutex.Unlock()
+	fake.RevokeUserTokensStub = nil
+	if fake.revokeUserTokensReturnsOnCall == nil {
+		fake.revokeUserTokensReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeUserTokensReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) SetUserDisabled(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 bool) error {
+	fake.setUserDisabledMutex.Lock()
+	ret, specificReturn := fake.setUserDisabledReturnsOnCall[len(fake.setUserDisabledArgsForCall)]
+	fake.setUserDisabledArgsForCall = append(fake.setUserDisabledArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 bool
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.SetUserDisabledStub
+	fakeReturns := fake.setUserDisabledReturns
+	fake.recordInvocation("SetUserDisabled", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.setUserDisabledMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) SetUserDisabledCallCount() int {
+	fake.setUserDisabledMutex.RLock()
+	defer fake.setUserDisabledMutex.RUnlock()
+	return len(fake.setUserDisabledArgsForCall)
+}
+
+func (fake *FakeService) SetUserDisabledCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, bool) error) {
+	fake.setUserDisabledMutex.Lock()
+	defer fake.setUserDisabledMutex.Unlock()
+	fake.SetUserDisabledStub = stub
+}
+
+func (fake *FakeService) SetUserDisabledArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, bool) {
+	fake.setUserDisabledMutex.RLock()
+	defer fake.setUserDisabledMutex.RUnlock()
+	argsForCall := fake.setUserDisabledArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeService) SetUserDisabledReturns(result1 error) {
+	fake.setUserDisabledMutex.Lock()
+	defer fake.setUserDisabledMutex.Unlock()
+	fake.SetUserDisabledStub = nil
+	fake.setUserDisabledReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) SetUserDisabledReturnsOnCall(i int, result1 error) {
+	fake.setUserDisabledMutex.Lock()
+	defer fake.setUserDisabledMutex.Unlock()
+	fake.SetUserDisabledStub = nil
+	if fake.setUserDisabledReturnsOnCall == nil {
+		fake.setUserDisabledReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setUserDisabledReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) UnlinkUserIdentity(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) error {
+	fake.unlinkUserIdentityMutex.Lock()
+	ret, specificReturn := fake.unlinkUserIdentityReturnsOnCall[len(fake.unlinkUserIdentityArgsForCall)]
+	fake.unlinkUserIdentityArgsForCall = append(fake.unlinkUserIdentityArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UnlinkUserIdentityStub
+	fakeReturns := fake.unlinkUserIdentityReturns
+	fake.recordInvocation("UnlinkUserIdentity", []interface{}{arg1, arg2, arg3, arg4})
+	fake.unlinkUserIdentityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) UnlinkUserIdentityCallCount() int {
+	fake.unlinkUserIdentityMutex.RLock()
+	defer fake.unlinkUserIdentityMutex.RUnlock()
+	return len(fake.unlinkUserIdentityArgsForCall)
+}
+
+func (fake *FakeService) UnlinkUserIdentityCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) {
+	fake.unlinkUserIdentityMutex.Lock()
+	defer fake.unlinkUserIdentityMutex.Unlock()
+	fake.UnlinkUserIdentityStub = stub
+}
+
+func (fake *FakeService) UnlinkUserIdentityArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.unlinkUserIdentityMutex.RLock()
+	defer fake.unlinkUserIdentityMutex.RUnlock()
+	argsForCall := fake.unlinkUserIdentityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) UnlinkUserIdentityReturns(result1 error) {
+	fake.unlinkUserIdentityMutex.Lock()
+	defer fake.unlinkUserIdentityMutex.Unlock()
+	fake.UnlinkUserIdentityStub = nil
+	fake.unlinkUserIdentityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) UnlinkUserIdentityReturnsOnCall(i int, result1 error) {
+	fake.unlinkUserIdentityMutex.Lock()
+	defer fake.unlinkUserIdentityMutex.Unlock()
+	fake.UnlinkUserIdentityStub = nil
+	if fake.unlinkUserIdentityReturnsOnCall == nil {
+		fake.unlinkUserIdentityReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unlinkUserIdentityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) UnlockUser(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.unlockUserMutex.Lock()
+	ret, specificReturn := fake.unlockUserReturnsOnCall[len(fake.unlockUserArgsForCall)]
+	fake.unlockUserArgsForCall = append(fake.unlockUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.UnlockUserStub
+	fakeReturns := fake.unlockUserReturns
+	fake.recordInvocation("UnlockUser", []interface{}{arg1, arg2, arg3})
+	fake.unlockUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) UnlockUserCallCount() int {
+	fake.unlockUserMutex.RLock()
+	defer fake.unlockUserMutex.RUnlock()
+	return len(fake.unlockUserArgsForCall)
+}
+
+func (fake *FakeService) UnlockUserCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.unlockUserMutex.Lock()
+	defer fake.unlockUserMutex.Unlock()
+	fake.UnlockUserStub = stub
+}
+
+func (fake *FakeService) UnlockUserArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.unlockUserMutex.RLock()
+	defer fake.unlockUserMutex.RUnlock()
+	argsForCall := fake.unlockUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) UnlockUserReturns(result1 error) {
+	fake.unlockUserMutex.Lock()
+	defer fake.unlockUserMutex.Unlock()
+	fake.UnlockUserStub = nil
+	fake.unlockUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) UnlockUserReturnsOnCall(i int, result1 error) {
+	fake.unlockUserMutex.Lock()
+	defer fake.unlockUserMutex.Unlock()
+	fake.UnlockUserStub = nil
+	if fake.unlockUserReturnsOnCall == nil {
+		fake.unlockUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unlockUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) UpdateMFAPolicy(arg1 context.Context, arg2 uuid.UUID, arg3 model.MFAPolicy) (model.MFAPolicy, error) {
+	fake.updateMFAPolicyMutex.Lock()
+	ret, specificReturn := fake.updateMFAPolicyReturnsOnCall[len(fake.updateMFAPolicyArgsForCall)]
+	fake.updateMFAPolicyArgsForCall = append(fake.updateMFAPolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.MFAPolicy
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateMFAPolicyStub
+	fakeReturns := fake.updateMFAPolicyReturns
+	fake.recordInvocation("UpdateMFAPolicy", []interface{}{arg1, arg2, arg3})
+	fake.updateMFAPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) UpdateMFAPolicyCallCount() int {
+	fake.updateMFAPolicyMutex.RLock()
+	defer fake.updateMFAPolicyMutex.RUnlock()
+	return len(fake.updateMFAPolicyArgsForCall)
+}
+
+func (fake *FakeService) UpdateMFAPolicyCalls(stub func(context.Context, uuid.UUID, model.MFAPolicy) (model.MFAPolicy, error)) {
+	fake.updateMFAPolicyMutex.Lock()
+	defer fake.updateMFAPolicyMutex.Unlock()
+	fake.UpdateMFAPolicyStub = stub
+}
+
+func (fake *FakeService) UpdateMFAPolicyArgsForCall(i int) (context.Context, uuid.UUID, model.MFAPolicy) {
+	fake.updateMFAPolicyMutex.RLock()
+	defer fake.updateMFAPolicyMutex.RUnlock()
+	argsForCall := fake.updateMFAPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) UpdateMFAPolicyReturns(result1 model.MFAPolicy, result2 error) {
+	fake.updateMFAPolicyMutex.Lock()
+	defer fake.updateMFAPolicyMutex.Unlock()
+	fake.UpdateMFAPolicyStub = nil
+	fake.updateMFAPolicyReturns = struct {
+		result1 model.MFAPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) UpdateMFAPolicyReturnsOnCall(i int, result1 model.MFAPolicy, result2 error) {
+	fake.updateMFAPolicyMutex.Lock()
+	defer fake.updateMFAPolicyMutex.Unlock()
+	fake.UpdateMFAPolicyStub = nil
+	if fake.updateMFAPolicyReturnsOnCall == nil {
+		fake.updateMFAPolicyReturnsOnCall = make(map[int]struct {
+			result1 model.MFAPolicy
+			result2 error
+		})
+	}
+	fake.updateMFAPolicyReturnsOnCall[i] = struct {
+		result1 model.MFAPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) UpdateUserRole(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 model.RoleType) error {
+	fake.updateUserRoleMutex.Lock()
+	ret, specificReturn := fake.updateUserRoleReturnsOnCall[len(fake.updateUserRoleArgsForCall)]
+	fake.updateUserRoleArgsForCall = append(fake.updateUserRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 model.RoleType
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UpdateUserRoleStub
+	fakeReturns := fake.updateUserRoleReturns
+	fake.recordInvocation("UpdateUserRole", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.updateUserRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) UpdateUserRoleCallCount() int {
+	fake.updateUserRoleMutex.RLock()
+	defer fake.updateUserRoleMutex.RUnlock()
+	return len(fake.updateUserRoleArgsForCall)
+}
+
+func (fake *FakeService) UpdateUserRoleCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, model.RoleType) error) {
+	fake.updateUserRoleMutex.Lock()
+	defer fake.updateUserRoleMutex.Unlock()
+	fake.UpdateUserRoleStub = stub
+}
+
+func (fake *FakeService) UpdateUserRoleArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, model.RoleType) {
+	fake.updateUserRoleMutex.RLock()
+	defer fake.updateUserRoleMutex.RUnlock()
+	argsForCall := fake.updateUserRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeService) UpdateUserRoleReturns(result1 error) {
+	fake.updateUserRoleMutex.Lock()
+	defer fake.updateUserRoleMutex.Unlock()
+	fake.UpdateUserRoleStub = nil
+	fake.updateUserRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) UpdateUserRoleReturnsOnCall(i int, result1 error) {
+	fake.updateUserRoleMutex.Lock()
+	defer fake.updateUserRoleMutex.Unlock()
+	fake.UpdateUserRoleStub = nil
+	if fake.updateUserRoleReturnsOnCall == nil {
+		fake.updateUserRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateUserRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) VerifyMFA(arg1 context.Context, arg2 uuid.UUID, arg3 string) (model.RecoveryCodes, error) {
+	fake.verifyMFAMutex.Lock()
+	ret, specificReturn := fake.verifyMFAReturnsOnCall[len(fake.verifyMFAArgsForCall)]
+	fake.verifyMFAArgsForCall = append(fake.verifyMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.VerifyMFAStub
+	fakeReturns := fake.verifyMFAReturns
+	fake.recordInvocation("VerifyMFA", []interface{}{arg1, arg2, arg3})
+	fake.verifyMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) VerifyMFACallCount() int {
+	fake.verifyMFAMutex.RLock()
+	defer fake.verifyMFAMutex.RUnlock()
+	return len(fake.verifyMFAArgsForCall)
+}
+
+func (fake *FakeService) VerifyMFACalls(stub func(context.Context, uuid.UUID, string) (model.RecoveryCodes, error)) {
+	fake.verifyMFAMutex.Lock()
+	defer fake.verifyMFAMutex.Unlock()
+	fake.VerifyMFAStub = stub
+}
+
+func (fake *FakeService) VerifyMFAArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.verifyMFAMutex.RLock()
+	defer fake.verifyMFAMutex.RUnlock()
+	argsForCall := fake.verifyMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) VerifyMFAReturns(result1 model.RecoveryCodes, result2 error) {
+	fake.verifyMFAMutex.Lock()
+	defer fake.verifyMFAMutex.Unlock()
+	fake.VerifyMFAStub = nil
+	fake.verifyMFAReturns = struct {
+		result1 model.RecoveryCodes
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) VerifyMFAReturnsOnCall(i int, result1 model.RecoveryCodes, result2 error) {
+	fake.verifyMFAMutex.Lock()
+	defer fake.verifyMFAMutex.Unlock()
+	fake.VerifyMFAStub = nil
+	if fake.verifyMFAReturnsOnCall == nil {
+		fake.verifyMFAReturnsOnCall = make(map[int]struct {
+			result1 model.RecoveryCodes
+			result2 error
+		})
+	}
+	fake.verifyMFAReturnsOnCall[i] = struct {
+		result1 model.RecoveryCodes
 		result2 error
 	}{result1, result2}
 }
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handler) listUserIdentities(c echo.Context) error {
+	orgID, _, id, err := userParams(c)
+	if err != nil {
+		return err
+	}
+
+	identities, err := h.svc.ListUserIdentities(c.Request().Context(), orgID, id)
+	if err != nil {
+		return identityError(err)
+	}
+
+	return c.JSON(http.StatusOK, identities)
+}
+
+// linkUserIdentity lets the tokens an OIDC provider issues for the subject act as the user.
+func (h *Handler) linkUserIdentity(c echo.Context) error {
+	orgID, _, id, err := userParams(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.ExternalIdentityRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
+	}
+
+	identity, err := h.svc.LinkUserIdentity(c.Request().Context(), orgID, id, req)
+	if err != nil {
+		return identityError(err)
+	}
+
+	return c.JSON(http.StatusCreated, identity)
+}
+
+func (h *Handler) unlinkUserIdentity(c echo.Context) error {
+	orgID, _, id, err := userParams(c)
+	if err != nil {
+		return err
+	}
+	identityID, err := uuid.Parse(c.Param("identityID"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid identity ID")
+	}
+
+	if err := h.svc.UnlinkUserIdentity(c.Request().Context(), orgID, id, identityID); err != nil {
+		return identityError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func identityError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrUserNotFound), errors.Is(err, model.ErrIdentityNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, model.ErrIdentityExists), errors.Is(err, service.ErrUserInOtherOrgs):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
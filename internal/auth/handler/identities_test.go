@@ -0,0 +1,127 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler/handlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/labstack/echo/v4"
+)
+
+var _ = Describe("External Identities Handler", func() {
+	var (
+		e         *echo.Echo
+		recorder  *httptest.ResponseRecorder
+		svc       *handlerfakes.FakeService
+		jwtHelper *handlerfakes.FakeJWTHelper
+		userID    uuid.UUID
+		orgID     uuid.UUID
+		id        uuid.UUID
+		identity  model.ExternalIdentity
+	)
+
+	BeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
+		e.Validator = validator.GetValidator()
+		recorder = httptest.NewRecorder()
+		svc = &handlerfakes.FakeService{}
+		jwtHelper = &handlerfakes.FakeJWTHelper{}
+		handler.NewHandler(svc, memberAuthStore(), &handlerfakes.FakeDenylist{}, jwtHelper).RegisterHandlers(e)
+
+		userID = uuid.New()
+		orgID = uuid.New()
+		id = uuid.New()
+		identity = model.ExternalIdentity{
+			ID:        uuid.New(),
+			UserID:    id,
+			Issuer:    "https://idp.example.com",
+			Subject:   "f:idp:jane",
+			CreatedAt: time.Now().UTC(),
+		}
+	})
+
+	serve := func(method, target, body string) {
+		jwtHelper.ValidateTokenReturns(accessTokenClaims(userID, orgID, []any{"admin:users"}), nil)
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer token")
+		e.ServeHTTP(recorder, req)
+	}
+
+	Describe("POST /users/:id/identities", func() {
+		It("links the identity to the user", func() {
+			svc.LinkUserIdentityReturns(identity, nil)
+			serve(http.MethodPost, "/users/"+id.String()+"/identities", `{"issuer":"https://idp.example.com","subject":"f:idp:jane"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			_, actualOrgID, actualID, req := svc.LinkUserIdentityArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(id))
+			Expect(req).To(Equal(model.ExternalIdentityRequest{Issuer: "https://idp.example.com", Subject: "f:idp:jane"}))
+
+			var linked model.ExternalIdentity
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &linked)).To(Succeed())
+			Expect(linked.ID).To(Equal(identity.ID))
+		})
+
+		It("rejects requests without a subject", func() {
+			serve(http.MethodPost, "/users/"+id.String()+"/identities", `{"issuer":"https://idp.example.com"}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(svc.LinkUserIdentityCallCount()).To(BeZero())
+		})
+
+		DescribeTable("maps the errors of the service",
+			func(err error, status int) {
+				svc.LinkUserIdentityReturns(model.ExternalIdentity{}, err)
+				serve(http.MethodPost, "/users/"+id.String()+"/identities", `{"issuer":"https://idp.example.com","subject":"f:idp:jane"}`)
+				Expect(recorder.Code).To(Equal(status))
+			},
+			Entry("for identities linked already", model.ErrIdentityExists, http.StatusConflict),
+			Entry("for users of other organizations too", service.ErrUserInOtherOrgs, http.StatusConflict),
+			Entry("for unknown users", model.ErrUserNotFound, http.StatusNotFound),
+		)
+	})
+
+	Describe("GET /users/:id/identities", func() {
+		It("lists the identities of the user", func() {
+			svc.ListUserIdentitiesReturns([]model.ExternalIdentity{identity}, nil)
+			serve(http.MethodGet, "/users/"+id.String()+"/identities", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, actualOrgID, actualID := svc.ListUserIdentitiesArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(id))
+		})
+	})
+
+	Describe("DELETE /users/:id/identities/:identityID", func() {
+		It("unlinks the identity", func() {
+			serve(http.MethodDelete, "/users/"+id.String()+"/identities/"+identity.ID.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualOrgID, actualID, identityID := svc.UnlinkUserIdentityArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(id))
+			Expect(identityID).To(Equal(identity.ID))
+		})
+
+		It("returns not found for unknown identities", func() {
+			svc.UnlinkUserIdentityReturns(model.ErrIdentityNotFound)
+			serve(http.MethodDelete, "/users/"+id.String()+"/identities/"+identity.ID.String(), "")
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handler) mfaLoginHandler(c echo.Context) error {
+	var req model.MFALoginRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
+	}
+
+	resp, err := h.svc.CompleteMFALogin(c.Request().Context(), req, c.RealIP())
+	if err != nil {
+		if retryAfter, ok := loginRetryAfter(err); ok {
+			c.Response().Header().Set(echo.HeaderRetryAfter, retryAfter)
+			return echo.NewHTTPError(http.StatusTooManyRequests, err.Error())
+		}
+		if errors.Is(err, service.ErrInvalidMFAToken) || errors.Is(err, service.ErrInvalidMFACode) {
+			return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+		}
+		if errors.Is(err, service.ErrNotOrgMember) || errors.Is(err, service.ErrUserDisabled) {
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to authenticate user")
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) mfaStatus(c echo.Context) error {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := h.svc.MFAStatus(c.Request().Context(), userID)
+	if err != nil {
+		return mfaError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, status)
+}
+
+func (h *Handler) enrollMFA(c echo.Context) error {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	enrollment, err := h.svc.EnrollMFA(c.Request().Context(), userID)
+	if err != nil {
+		return mfaError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, enrollment)
+}
+
+func (h *Handler) verifyMFA(c echo.Context) error {
+	userID, code, err := mfaCodeParams(c)
+	if err != nil {
+		return err
+	}
+
+	codes, err := h.svc.VerifyMFA(c.Request().Context(), userID, code)
+	if err != nil {
+		return mfaError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, codes)
+}
+
+func (h *Handler) regenerateRecoveryCodes(c echo.Context) error {
+	userID, code, err := mfaCodeParams(c)
+	if err != nil {
+		return err
+	}
+
+	codes, err := h.svc.RegenerateRecoveryCodes(c.Request().Context(), userID, code)
+	if err != nil {
+		return mfaError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, codes)
+}
+
+func (h *Handler) disableMFA(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	userID, code, err := mfaCodeParams(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.DisableMFA(c.Request().Context(), orgID, userID, code); err != nil {
+		return mfaError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) resetUserMFA(c echo.Context) error {
+	orgID, _, id, err := userParams(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.ResetUserMFA(c.Request().Context(), orgID, id); err != nil {
+		return mfaError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) getMFAPolicy(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	policy, err := h.svc.GetMFAPolicy(c.Request().Context(), orgID)
+	if err != nil {
+		return mfaError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, policy)
+}
+
+func (h *Handler) updateMFAPolicy(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.MFAPolicy
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid MFA policy: %w", err))
+	}
+
+	policy, err := h.svc.UpdateMFAPolicy(c.Request().Context(), orgID, req)
+	if err != nil {
+		return mfaError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, policy)
+}
+
+func mfaCodeParams(c echo.Context) (uuid.UUID, string, error) {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+
+	var req model.MFACodeRequest
+	if err := c.Bind(&req); err != nil {
+		return uuid.Nil, "", echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return uuid.Nil, "", echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
+	}
+	return userID, req.Code, nil
+}
+
+func mfaError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrTooManyLoginAttempts):
+		if retryAfter, ok := loginRetryAfter(err); ok {
+			c.Response().Header().Set(echo.HeaderRetryAfter, retryAfter)
+		}
+		return echo.NewHTTPError(http.StatusTooManyRequests, err.Error())
+	case errors.Is(err, service.ErrInvalidMFACode):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, model.ErrUserNotFound), errors.Is(err, model.ErrMFANotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrMFAEnabled),
+		errors.Is(err, service.ErrMFANotEnabled),
+		errors.Is(err, service.ErrMFANotEnrolled),
+		errors.Is(err, service.ErrUserInOtherOrgs):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrMFARequiredByRole):
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,260 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler/handlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/labstack/echo/v4"
+)
+
+var _ = Describe("MFA Handler", func() {
+	var (
+		e         *echo.Echo
+		recorder  *httptest.ResponseRecorder
+		svc       *handlerfakes.FakeService
+		jwtHelper *handlerfakes.FakeJWTHelper
+		userID    uuid.UUID
+		orgID     uuid.UUID
+		scopes    []any
+	)
+
+	BeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
+		e.Validator = validator.GetValidator()
+		recorder = httptest.NewRecorder()
+		svc = &handlerfakes.FakeService{}
+		jwtHelper = &handlerfakes.FakeJWTHelper{}
+		handler.NewHandler(svc, memberAuthStore(), &handlerfakes.FakeDenylist{}, jwtHelper).RegisterHandlers(e)
+
+		userID = uuid.New()
+		orgID = uuid.New()
+		scopes = []any{"read:flags"}
+	})
+
+	serve := func(method, target, body string) {
+		jwtHelper.ValidateTokenReturns(accessTokenClaims(userID, orgID, scopes), nil)
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer token")
+		e.ServeHTTP(recorder, req)
+	}
+
+	Describe("POST /auth/mfa", func() {
+		login := func(body string) {
+			req := httptest.NewRequest(http.MethodPost, "/auth/mfa", strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			e.ServeHTTP(recorder, req)
+		}
+
+		It("issues the tokens", func() {
+			svc.CompleteMFALoginReturns(model.AuthResponse{Token: "token", RefreshToken: "refresh", ExpiresIn: 900, OrgID: orgID}, nil)
+			login(`{"mfa_token":"mfa-token","code":"123456"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, req, ip := svc.CompleteMFALoginArgsForCall(0)
+			Expect(req).To(Equal(model.MFALoginRequest{MFAToken: "mfa-token", Code: "123456"}))
+			Expect(ip).To(Equal("192.0.2.1"))
+
+			var resp model.AuthResponse
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Token).To(Equal("token"))
+		})
+
+		It("returns 400 without a code", func() {
+			login(`{"mfa_token":"mfa-token"}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(svc.CompleteMFALoginCallCount()).To(BeZero())
+		})
+
+		DescribeTable("maps the errors",
+			func(err error, status int) {
+				svc.CompleteMFALoginReturns(model.AuthResponse{}, err)
+				login(`{"mfa_token":"mfa-token","code":"123456"}`)
+				Expect(recorder.Code).To(Equal(status))
+			},
+			Entry("for invalid challenges", service.ErrInvalidMFAToken, http.StatusUnauthorized),
+			Entry("for wrong codes", service.ErrInvalidMFACode, http.StatusUnauthorized),
+			Entry("for disabled users", service.ErrUserDisabled, http.StatusForbidden),
+			Entry("for users outside the organization", service.ErrNotOrgMember, http.StatusForbidden),
+			Entry("for other errors", errors.New("database error"), http.StatusInternalServerError),
+		)
+
+		It("returns 429 with the seconds to wait after failed logins", func() {
+			svc.CompleteMFALoginReturns(model.AuthResponse{}, &service.LoginLockedError{RetryAfter: time.Minute})
+			login(`{"mfa_token":"mfa-token","code":"123456"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+			Expect(recorder.Header().Get("Retry-After")).To(Equal("60"))
+		})
+	})
+
+	Describe("GET /mfa", func() {
+		It("returns the MFA status of the caller", func() {
+			svc.MFAStatusReturns(model.MFAStatus{Enabled: true, RecoveryCodesLeft: 8}, nil)
+			serve(http.MethodGet, "/mfa", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, actualUserID := svc.MFAStatusArgsForCall(0)
+			Expect(actualUserID).To(Equal(userID))
+			Expect(recorder.Body.String()).To(MatchJSON(`{"enabled":true,"recovery_codes_left":8}`))
+		})
+
+		It("requires a token", func() {
+			req := httptest.NewRequest(http.MethodGet, "/mfa", nil)
+			e.ServeHTTP(recorder, req)
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	Describe("POST /mfa/enroll", func() {
+		It("returns the secret to enroll with", func() {
+			svc.EnrollMFAReturns(model.MFAEnrollment{Secret: "JBSWY3DPEHPK3PXP", URI: "otpauth://totp/x"}, nil)
+			serve(http.MethodPost, "/mfa/enroll", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(MatchJSON(`{"secret":"JBSWY3DPEHPK3PXP","otpauth_uri":"otpauth://totp/x"}`))
+		})
+
+		It("returns 409 when MFA is enabled", func() {
+			svc.EnrollMFAReturns(model.MFAEnrollment{}, service.ErrMFAEnabled)
+			serve(http.MethodPost, "/mfa/enroll", "")
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Describe("POST /mfa/verify", func() {
+		It("enables MFA and returns the recovery codes", func() {
+			svc.VerifyMFAReturns(model.RecoveryCodes{RecoveryCodes: []string{"abcdefgh-ijklmnop"}}, nil)
+			serve(http.MethodPost, "/mfa/verify", `{"code":"123456"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, actualUserID, code := svc.VerifyMFAArgsForCall(0)
+			Expect(actualUserID).To(Equal(userID))
+			Expect(code).To(Equal("123456"))
+			Expect(recorder.Body.String()).To(MatchJSON(`{"recovery_codes":["abcdefgh-ijklmnop"]}`))
+		})
+
+		DescribeTable("maps the errors",
+			func(err error, status int) {
+				svc.VerifyMFAReturns(model.RecoveryCodes{}, err)
+				serve(http.MethodPost, "/mfa/verify", `{"code":"123456"}`)
+				Expect(recorder.Code).To(Equal(status))
+			},
+			Entry("for wrong codes", service.ErrInvalidMFACode, http.StatusBadRequest),
+			Entry("without an enrolment", service.ErrMFANotEnrolled, http.StatusConflict),
+			Entry("for enabled MFA", service.ErrMFAEnabled, http.StatusConflict),
+			Entry("after failed attempts", &service.LoginLockedError{RetryAfter: time.Second}, http.StatusTooManyRequests),
+		)
+	})
+
+	Describe("POST /mfa/recovery-codes", func() {
+		It("returns the new recovery codes", func() {
+			svc.RegenerateRecoveryCodesReturns(model.RecoveryCodes{RecoveryCodes: []string{"abcdefgh-ijklmnop"}}, nil)
+			serve(http.MethodPost, "/mfa/recovery-codes", `{"code":"123456"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, _, code := svc.RegenerateRecoveryCodesArgsForCall(0)
+			Expect(code).To(Equal("123456"))
+		})
+	})
+
+	Describe("POST /mfa/disable", func() {
+		It("disables MFA", func() {
+			serve(http.MethodPost, "/mfa/disable", `{"code":"123456"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualOrgID, actualUserID, code := svc.DisableMFAArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualUserID).To(Equal(userID))
+			Expect(code).To(Equal("123456"))
+		})
+
+		It("returns 403 when the policy requires MFA", func() {
+			svc.DisableMFAReturns(service.ErrMFARequiredByRole)
+			serve(http.MethodPost, "/mfa/disable", `{"code":"123456"}`)
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("returns 400 without a code", func() {
+			serve(http.MethodPost, "/mfa/disable", `{}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(svc.DisableMFACallCount()).To(BeZero())
+		})
+	})
+
+	Describe("the MFA policy", func() {
+		BeforeEach(func() {
+			scopes = []any{"read:flags", "admin:users"}
+		})
+
+		It("returns the policy of the organization of the caller", func() {
+			svc.GetMFAPolicyReturns(model.MFAPolicy{RequiredRoles: []model.RoleType{model.RoleAdmin}}, nil)
+			serve(http.MethodGet, "/mfa/policy", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, actualOrgID := svc.GetMFAPolicyArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(recorder.Body.String()).To(MatchJSON(`{"required_roles":["admin"]}`))
+		})
+
+		It("updates the policy of the organization of the caller", func() {
+			svc.UpdateMFAPolicyReturns(model.MFAPolicy{RequiredRoles: []model.RoleType{model.RoleAdmin, model.RoleEditor}}, nil)
+			serve(http.MethodPut, "/mfa/policy", `{"required_roles":["editor","admin"]}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, actualOrgID, policy := svc.UpdateMFAPolicyArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(policy.RequiredRoles).To(Equal([]model.RoleType{model.RoleEditor, model.RoleAdmin}))
+		})
+
+		It("rejects unknown roles", func() {
+			serve(http.MethodPut, "/mfa/policy", `{"required_roles":["owner"]}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(svc.UpdateMFAPolicyCallCount()).To(BeZero())
+		})
+
+		It("requires the admin:users scope", func() {
+			scopes = []any{"read:flags"}
+			serve(http.MethodPut, "/mfa/policy", `{"required_roles":["admin"]}`)
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			Expect(svc.UpdateMFAPolicyCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("DELETE /users/:id/mfa", func() {
+		BeforeEach(func() {
+			scopes = []any{"read:flags", "admin:users"}
+		})
+
+		It("resets the MFA of the user in the organization of the caller", func() {
+			id := uuid.New()
+			serve(http.MethodDelete, "/users/"+id.String()+"/mfa", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualOrgID, actualID := svc.ResetUserMFAArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(id))
+		})
+
+		It("returns 404 when the user has no MFA", func() {
+			svc.ResetUserMFAReturns(model.ErrMFANotFound)
+			serve(http.MethodDelete, "/users/"+uuid.NewString()+"/mfa", "")
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
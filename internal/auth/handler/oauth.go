@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	oauthTokenPath      = "/oauth/token"
+	oauthIntrospectPath = "/oauth/introspect"
+	oauthRevokePath     = "/oauth/revoke"
+)
+
+// tokenHandler is the OAuth 2.0 token endpoint. Clients authenticate with HTTP Basic or with the
+// client_id and client_secret form fields.
+func (h *Handler) tokenHandler(c echo.Context) error {
+	var req model.TokenRequest
+	basicAuth, err := bindOAuthForm(c, &req, &req.ClientID, &req.ClientSecret)
+	if err != nil {
+		return oauthError(c, err, false, "invalid request")
+	}
+
+	resp, err := h.svc.OAuthToken(c.Request().Context(), req, c.RealIP())
+	if err != nil {
+		return oauthError(c, err, basicAuth, "failed to issue token")
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// introspectHandler is the token introspection endpoint of RFC 7662.
+func (h *Handler) introspectHandler(c echo.Context) error {
+	var req model.ClientTokenRequest
+	basicAuth, err := bindOAuthForm(c, &req, &req.ClientID, &req.ClientSecret)
+	if err != nil {
+		return oauthError(c, err, false, "invalid request")
+	}
+
+	resp, err := h.svc.IntrospectToken(c.Request().Context(), req)
+	if err != nil {
+		return oauthError(c, err, basicAuth, "failed to introspect token")
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// revokeTokenHandler is the token revocation endpoint of RFC 7009, which answers 200 for unknown
+// tokens as well.
+func (h *Handler) revokeTokenHandler(c echo.Context) error {
+	var req model.ClientTokenRequest
+	basicAuth, err := bindOAuthForm(c, &req, &req.ClientID, &req.ClientSecret)
+	if err != nil {
+		return oauthError(c, err, false, "invalid request")
+	}
+
+	if err := h.svc.RevokeOAuthToken(c.Request().Context(), req); err != nil {
+		return oauthError(c, err, basicAuth, "failed to revoke token")
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// bindOAuthForm binds the form-encoded request of an OAuth endpoint and takes the client
+// credentials from HTTP Basic when they are not part of the form. It reports whether the client
+// used HTTP Basic. The response must not be cached, it carries tokens or describes them.
+func bindOAuthForm(c echo.Context, req any, clientID, clientSecret *string) (bool, error) {
+	c.Response().Header().Set("Cache-Control", "no-store")
+	c.Response().Header().Set("Pragma", "no-cache")
+
+	if !strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEApplicationForm) {
+		return false, fmt.Errorf("%w: the request must be form-encoded", service.ErrInvalidTokenRequest)
+	}
+	if err := c.Bind(req); err != nil {
+		return false, fmt.Errorf("%w: invalid request body", service.ErrInvalidTokenRequest)
+	}
+	id, secret, basicAuth := c.Request().BasicAuth()
+	if basicAuth {
+		if *clientID != "" || *clientSecret != "" {
+			return false, fmt.Errorf("%w: client authenticated more than once", service.ErrInvalidTokenRequest)
+		}
+		*clientID, *clientSecret = id, secret
+	}
+	return basicAuth, nil
+}
+
+// oauthError renders err in the error format of RFC 6749. Errors without an OAuth error code are
+// internal errors reported with the given message.
+func oauthError(c echo.Context, err error, basicAuth bool, message string) error {
+	status, code := http.StatusBadRequest, ""
+	switch {
+	case errors.Is(err, service.ErrInvalidTokenRequest):
+		code = "invalid_request"
+	case errors.Is(err, service.ErrInvalidClient):
+		if basicAuth {
+			c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="oauth"`)
+		}
+		status, code = http.StatusUnauthorized, "invalid_client"
+	case errors.Is(err, service.ErrTooManyLoginAttempts):
+		if retryAfter, ok := loginRetryAfter(err); ok {
+			c.Response().Header().Set(echo.HeaderRetryAfter, retryAfter)
+		}
+		status, code = http.StatusTooManyRequests, "invalid_grant"
+	case errors.Is(err, service.ErrInvalidCredentials),
+		errors.Is(err, service.ErrUserDisabled),
+		errors.Is(err, service.ErrNotOrgMember),
+		errors.Is(err, service.ErrMFARequired):
+		code = "invalid_grant"
+	case errors.Is(err, service.ErrInvalidScope):
+		code = "invalid_scope"
+	case errors.Is(err, service.ErrUnsupportedGrant):
+		code = "unsupported_grant_type"
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, message)
+	}
+	return c.JSON(status, model.OAuthError{Error: code, ErrorDescription: err.Error()})
+}
+
+func (h *Handler) listOAuthClients(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	clients, err := h.svc.ListOAuthClients(c.Request().Context(), orgID)
+	if err != nil {
+		return oauthClientError(err)
+	}
+
+	return c.JSON(http.StatusOK, clients)
+}
+
+func (h *Handler) registerOAuthClient(c echo.Context) error {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.OAuthClientRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid OAuth client: %w", err))
+	}
+
+	client, err := h.svc.RegisterOAuthClient(c.Request().Context(), orgID, userID, req)
+	if err != nil {
+		return oauthClientError(err)
+	}
+
+	return c.JSON(http.StatusCreated, client)
+}
+
+func (h *Handler) deleteOAuthClient(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid client ID")
+	}
+
+	if err := h.svc.DeleteOAuthClient(c.Request().Context(), orgID, id); err != nil {
+		return oauthClientError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func oauthClientError(err error) error {
+	if errors.Is(err, model.ErrOAuthClientNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
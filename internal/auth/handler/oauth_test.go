@@ -0,0 +1,343 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler/handlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/labstack/echo/v4"
+)
+
+var _ = Describe("OAuth Handler", func() {
+	var (
+		e         *echo.Echo
+		recorder  *httptest.ResponseRecorder
+		svc       *handlerfakes.FakeService
+		jwtHelper *handlerfakes.FakeJWTHelper
+		userID    uuid.UUID
+		orgID     uuid.UUID
+		client    model.OAuthClient
+	)
+
+	BeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
+		e.Validator = validator.GetValidator()
+		recorder = httptest.NewRecorder()
+		svc = &handlerfakes.FakeService{}
+		jwtHelper = &handlerfakes.FakeJWTHelper{}
+		handler.NewHandler(svc, memberAuthStore(), &handlerfakes.FakeDenylist{}, jwtHelper).RegisterHandlers(e)
+
+		userID = uuid.New()
+		orgID = uuid.New()
+		client = model.OAuthClient{
+			ID:         uuid.New(),
+			OrgID:      orgID,
+			Name:       "deployer",
+			SecretHash: "hash",
+			Scopes:     []string{"read:flags"},
+			CreatedBy:  userID,
+			CreatedAt:  time.Now().UTC(),
+		}
+	})
+
+	Describe("POST /oauth/token", func() {
+		var form url.Values
+
+		BeforeEach(func() {
+			form = url.Values{"grant_type": {"client_credentials"}, "scope": {"read:flags"}}
+			svc.OAuthTokenReturns(model.TokenResponse{
+				AccessToken: "access-token",
+				TokenType:   "Bearer",
+				ExpiresIn:   900,
+				Scope:       "read:flags",
+			}, nil)
+		})
+
+		requestToken := func(modify func(*http.Request)) {
+			req := httptest.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader(form.Encode()))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+			if modify != nil {
+				modify(req)
+			}
+			e.ServeHTTP(recorder, req)
+		}
+
+		oauthError := func() model.OAuthError {
+			var body model.OAuthError
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &body)).To(Succeed())
+			return body
+		}
+
+		It("issues a token to a client authenticated with HTTP Basic", func() {
+			requestToken(func(req *http.Request) { req.SetBasicAuth(client.ID.String(), "secret") })
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Cache-Control")).To(Equal("no-store"))
+			Expect(recorder.Header().Get("Pragma")).To(Equal("no-cache"))
+			_, req, _ := svc.OAuthTokenArgsForCall(0)
+			Expect(req).To(Equal(model.TokenRequest{
+				GrantType:    model.GrantTypeClientCredentials,
+				Scope:        "read:flags",
+				ClientID:     client.ID.String(),
+				ClientSecret: "secret",
+			}))
+
+			var resp model.TokenResponse
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.AccessToken).To(Equal("access-token"))
+			Expect(resp.TokenType).To(Equal("Bearer"))
+			Expect(jwtHelper.ValidateTokenCallCount()).To(BeZero())
+		})
+
+		It("takes the client credentials from the form", func() {
+			form.Set("client_id", client.ID.String())
+			form.Set("client_secret", "secret")
+			requestToken(nil)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, req, _ := svc.OAuthTokenArgsForCall(0)
+			Expect(req.ClientID).To(Equal(client.ID.String()))
+			Expect(req.ClientSecret).To(Equal("secret"))
+		})
+
+		It("passes the credentials of the password grant", func() {
+			form = url.Values{"grant_type": {"password"}, "username": {"john"}, "password": {"secret"}}
+			requestToken(nil)
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, req, _ := svc.OAuthTokenArgsForCall(0)
+			Expect(req).To(Equal(model.TokenRequest{GrantType: model.GrantTypePassword, Username: "john", Password: "secret"}))
+		})
+
+		It("rejects clients authenticating twice", func() {
+			form.Set("client_id", client.ID.String())
+			requestToken(func(req *http.Request) { req.SetBasicAuth(client.ID.String(), "secret") })
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(oauthError().Error).To(Equal("invalid_request"))
+			Expect(svc.OAuthTokenCallCount()).To(BeZero())
+		})
+
+		It("rejects requests that are not form-encoded", func() {
+			requestToken(func(req *http.Request) { req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON) })
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(oauthError().Error).To(Equal("invalid_request"))
+			Expect(svc.OAuthTokenCallCount()).To(BeZero())
+		})
+
+		It("asks clients using HTTP Basic to authenticate again", func() {
+			svc.OAuthTokenReturns(model.TokenResponse{}, service.ErrInvalidClient)
+			requestToken(func(req *http.Request) { req.SetBasicAuth(client.ID.String(), "wrong") })
+
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			Expect(recorder.Header().Get(echo.HeaderWWWAuthenticate)).To(HavePrefix("Basic"))
+			Expect(oauthError().Error).To(Equal("invalid_client"))
+		})
+
+		DescribeTable("returns the errors of RFC 6749",
+			func(err error, status int, code string) {
+				svc.OAuthTokenReturns(model.TokenResponse{}, err)
+				requestToken(nil)
+
+				Expect(recorder.Code).To(Equal(status))
+				Expect(recorder.Header().Get("Cache-Control")).To(Equal("no-store"))
+				Expect(oauthError().Error).To(Equal(code))
+			},
+			Entry("for incomplete requests", service.ErrInvalidTokenRequest, http.StatusBadRequest, "invalid_request"),
+			Entry("for unknown clients", service.ErrInvalidClient, http.StatusUnauthorized, "invalid_client"),
+			Entry("for wrong passwords", service.ErrInvalidCredentials, http.StatusBadRequest, "invalid_grant"),
+			Entry("for disabled users", service.ErrUserDisabled, http.StatusBadRequest, "invalid_grant"),
+			Entry("for users outside the organization", service.ErrNotOrgMember, http.StatusBadRequest, "invalid_grant"),
+			Entry("for users who need MFA", service.ErrMFARequired, http.StatusBadRequest, "invalid_grant"),
+			Entry("for scopes that are not allowed", service.ErrInvalidScope, http.StatusBadRequest, "invalid_scope"),
+			Entry("for other grant types", service.ErrUnsupportedGrant, http.StatusBadRequest, "unsupported_grant_type"),
+		)
+
+		It("delays the password grant after failed logins", func() {
+			svc.OAuthTokenReturns(model.TokenResponse{}, &service.LoginLockedError{RetryAfter: time.Minute})
+			requestToken(nil)
+
+			Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+			Expect(recorder.Header().Get("Retry-After")).To(Equal("60"))
+			Expect(oauthError().Error).To(Equal("invalid_grant"))
+			_, _, ip := svc.OAuthTokenArgsForCall(0)
+			Expect(ip).To(Equal("192.0.2.1"))
+		})
+
+		It("returns 500 when the token cannot be issued", func() {
+			svc.OAuthTokenReturns(model.TokenResponse{}, errors.New("database error"))
+			requestToken(nil)
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Describe("POST /oauth/introspect and POST /oauth/revoke", func() {
+		var form url.Values
+
+		BeforeEach(func() {
+			form = url.Values{"token": {"access-token"}, "token_type_hint": {"access_token"}}
+		})
+
+		post := func(target string) {
+			req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(form.Encode()))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+			req.SetBasicAuth(client.ID.String(), "secret")
+			e.ServeHTTP(recorder, req)
+		}
+
+		expectedRequest := func() model.ClientTokenRequest {
+			return model.ClientTokenRequest{
+				Token:         "access-token",
+				TokenTypeHint: model.TokenTypeHintAccessToken,
+				ClientID:      client.ID.String(),
+				ClientSecret:  "secret",
+			}
+		}
+
+		It("describes the token", func() {
+			svc.IntrospectTokenReturns(model.IntrospectionResponse{
+				Active: true,
+				Scope:  "read:flags",
+				Sub:    userID.String(),
+				Exp:    time.Now().Add(time.Minute).Unix(),
+			}, nil)
+			post("/oauth/introspect")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Cache-Control")).To(Equal("no-store"))
+			_, req := svc.IntrospectTokenArgsForCall(0)
+			Expect(req).To(Equal(expectedRequest()))
+
+			var resp map[string]any
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp).To(HaveKeyWithValue("active", true))
+			Expect(resp).To(HaveKeyWithValue("sub", userID.String()))
+			Expect(jwtHelper.ValidateTokenCallCount()).To(BeZero())
+		})
+
+		It("describes inactive tokens by active alone", func() {
+			post("/oauth/introspect")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(MatchJSON(`{"active":false}`))
+		})
+
+		It("revokes the token", func() {
+			post("/oauth/revoke")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(BeEmpty())
+			_, req := svc.RevokeOAuthTokenArgsForCall(0)
+			Expect(req).To(Equal(expectedRequest()))
+		})
+
+		DescribeTable("returns the errors of RFC 6749",
+			func(target string, err error, status int, code string) {
+				svc.IntrospectTokenReturns(model.IntrospectionResponse{}, err)
+				svc.RevokeOAuthTokenReturns(err)
+				post(target)
+
+				Expect(recorder.Code).To(Equal(status))
+				var body model.OAuthError
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &body)).To(Succeed())
+				Expect(body.Error).To(Equal(code))
+			},
+			Entry("introspecting without a token", "/oauth/introspect", service.ErrInvalidTokenRequest, http.StatusBadRequest, "invalid_request"),
+			Entry("introspecting as an unknown client", "/oauth/introspect", service.ErrInvalidClient, http.StatusUnauthorized, "invalid_client"),
+			Entry("revoking without a token", "/oauth/revoke", service.ErrInvalidTokenRequest, http.StatusBadRequest, "invalid_request"),
+			Entry("revoking as an unknown client", "/oauth/revoke", service.ErrInvalidClient, http.StatusUnauthorized, "invalid_client"),
+		)
+
+		It("returns 500 when the token cannot be revoked", func() {
+			svc.RevokeOAuthTokenReturns(errors.New("database error"))
+			post("/oauth/revoke")
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Describe("client management", func() {
+		var scopes []any
+
+		BeforeEach(func() {
+			scopes = []any{"read:flags", "admin:clients"}
+		})
+
+		serve := func(method, target, body string) {
+			jwtHelper.ValidateTokenReturns(accessTokenClaims(userID, orgID, scopes), nil)
+			req := httptest.NewRequest(method, target, strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer token")
+			e.ServeHTTP(recorder, req)
+		}
+
+		It("requires the admin:clients scope", func() {
+			scopes = []any{"read:flags", "admin:apikeys"}
+			serve(http.MethodGet, "/oauth/clients", "")
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			Expect(svc.ListOAuthClientsCallCount()).To(BeZero())
+		})
+
+		It("lists the clients of the organization without their secrets", func() {
+			svc.ListOAuthClientsReturns([]model.OAuthClient{client}, nil)
+			serve(http.MethodGet, "/oauth/clients", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, actualOrgID := svc.ListOAuthClientsArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(recorder.Body.String()).ToNot(ContainSubstring("hash"))
+		})
+
+		It("registers a client for the caller and returns its secret once", func() {
+			svc.RegisterOAuthClientReturns(model.CreatedOAuthClient{OAuthClient: client, ClientSecret: "secret"}, nil)
+			serve(http.MethodPost, "/oauth/clients", `{"name":"deployer","scopes":["read:flags"]}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			_, actualOrgID, actualUserID, req := svc.RegisterOAuthClientArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualUserID).To(Equal(userID))
+			Expect(req).To(Equal(model.OAuthClientRequest{Name: "deployer", Scopes: []string{"read:flags"}}))
+
+			var created map[string]any
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &created)).To(Succeed())
+			Expect(created).To(HaveKeyWithValue("client_id", client.ID.String()))
+			Expect(created).To(HaveKeyWithValue("client_secret", "secret"))
+		})
+
+		It("rejects scopes clients cannot have", func() {
+			serve(http.MethodPost, "/oauth/clients", `{"name":"deployer","scopes":["admin:users"]}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(svc.RegisterOAuthClientCallCount()).To(BeZero())
+		})
+
+		It("deletes a client", func() {
+			serve(http.MethodDelete, "/oauth/clients/"+client.ID.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualOrgID, actualID := svc.DeleteOAuthClientArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(client.ID))
+		})
+
+		It("returns 404 for unknown clients", func() {
+			svc.DeleteOAuthClientReturns(model.ErrOAuthClientNotFound)
+			serve(http.MethodDelete, "/oauth/clients/"+client.ID.String(), "")
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handler) createOrg(c echo.Context) error {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.OrganizationRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
+	}
+
+	org, err := h.svc.CreateOrg(c.Request().Context(), userID, req)
+	if err != nil {
+		return orgError(err)
+	}
+
+	return c.JSON(http.StatusCreated, org)
+}
+
+func (h *Handler) listOrgs(c echo.Context) error {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	orgs, err := h.svc.ListUserOrgs(c.Request().Context(), userID)
+	if err != nil {
+		return orgError(err)
+	}
+
+	return c.JSON(http.StatusOK, orgs)
+}
+
+func (h *Handler) getOrg(c echo.Context) error {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid organization ID")
+	}
+
+	org, err := h.svc.GetOrg(c.Request().Context(), userID, orgID)
+	if err != nil {
+		return orgError(err)
+	}
+
+	return c.JSON(http.StatusOK, org)
+}
+
+func (h *Handler) listOrgMembers(c echo.Context) error {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid organization ID")
+	}
+
+	members, err := h.svc.ListOrgMembers(c.Request().Context(), userID, orgID)
+	if err != nil {
+		return orgError(err)
+	}
+
+	return c.JSON(http.StatusOK, members)
+}
+
+// inviteOrgMember invites the user to the organization, they become a member once they accept.
+func (h *Handler) inviteOrgMember(c echo.Context) error {
+	userID, orgID, memberID, err := orgMemberParams(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.OrgInvitationRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
+	}
+
+	invitation, err := h.svc.InviteOrgMember(c.Request().Context(), userID, orgID, memberID, req.Role)
+	if err != nil {
+		return orgError(err)
+	}
+
+	return c.JSON(http.StatusAccepted, invitation)
+}
+
+func (h *Handler) removeOrgMember(c echo.Context) error {
+	userID, orgID, memberID, err := orgMemberParams(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.RemoveOrgMember(c.Request().Context(), userID, orgID, memberID); err != nil {
+		return orgError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) listInvitations(c echo.Context) error {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	invitations, err := h.svc.ListInvitations(c.Request().Context(), userID)
+	if err != nil {
+		return orgError(err)
+	}
+
+	return c.JSON(http.StatusOK, invitations)
+}
+
+func (h *Handler) acceptInvitation(c echo.Context) error {
+	userID, orgID, err := invitationParams(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.AcceptOrgInvitation(c.Request().Context(), userID, orgID); err != nil {
+		return orgError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) declineInvitation(c echo.Context) error {
+	userID, orgID, err := invitationParams(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.DeclineOrgInvitation(c.Request().Context(), userID, orgID); err != nil {
+		return orgError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func invitationParams(c echo.Context) (uuid.UUID, uuid.UUID, error) {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	orgID, err := uuid.Parse(c.Param("orgID"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, echo.NewHTTPError(http.StatusBadRequest, "invalid organization ID")
+	}
+	return userID, orgID, nil
+}
+
+func orgMemberParams(c echo.Context) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, echo.NewHTTPError(http.StatusBadRequest, "invalid organization ID")
+	}
+	memberID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, echo.NewHTTPError(http.StatusBadRequest, "invalid user ID")
+	}
+	return userID, orgID, memberID, nil
+}
+
+func orgError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrOrgNotFound), errors.Is(err, model.ErrUserNotFound), errors.Is(err, model.ErrMemberNotFound),
+		errors.Is(err, model.ErrInvitationNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, model.ErrOrgExists), errors.Is(err, model.ErrMemberExists):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrNotOrgAdmin):
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,246 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler/handlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/labstack/echo/v4"
+)
+
+var _ = Describe("Organizations Handler", func() {
+	var (
+		e         *echo.Echo
+		recorder  *httptest.ResponseRecorder
+		svc       *handlerfakes.FakeService
+		jwtHelper *handlerfakes.FakeJWTHelper
+		userID    uuid.UUID
+		orgID     uuid.UUID
+		scopes    []any
+	)
+
+	BeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
+		e.Validator = validator.GetValidator()
+		recorder = httptest.NewRecorder()
+		svc = &handlerfakes.FakeService{}
+		jwtHelper = &handlerfakes.FakeJWTHelper{}
+		handler.NewHandler(svc, memberAuthStore(), &handlerfakes.FakeDenylist{}, jwtHelper).RegisterHandlers(e)
+
+		userID = uuid.New()
+		orgID = uuid.New()
+		scopes = []any{"read:flags", "admin:orgs"}
+	})
+
+	serve := func(method, target, body string) {
+		jwtHelper.ValidateTokenReturns(accessTokenClaims(userID, model.DefaultOrgID, scopes), nil)
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer token")
+		e.ServeHTTP(recorder, req)
+	}
+
+	It("rejects requests without a token", func() {
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/orgs", nil))
+		Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	Describe("POST /orgs", func() {
+		It("creates the organization for the caller", func() {
+			svc.CreateOrgReturns(model.Organization{ID: orgID, Name: "payments"}, nil)
+			serve(http.MethodPost, "/orgs", `{"name":"payments"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			_, actualUserID, req := svc.CreateOrgArgsForCall(0)
+			Expect(actualUserID).To(Equal(userID))
+			Expect(req.Name).To(Equal("payments"))
+
+			var org model.Organization
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &org)).To(Succeed())
+			Expect(org.ID).To(Equal(orgID))
+		})
+
+		It("requires the admin:orgs scope", func() {
+			scopes = []any{"read:flags"}
+			serve(http.MethodPost, "/orgs", `{"name":"payments"}`)
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			Expect(svc.CreateOrgCallCount()).To(BeZero())
+		})
+
+		It("returns 409 when the name is taken", func() {
+			svc.CreateOrgReturns(model.Organization{}, model.ErrOrgExists)
+			serve(http.MethodPost, "/orgs", `{"name":"payments"}`)
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+		})
+
+		It("returns 400 without a name", func() {
+			serve(http.MethodPost, "/orgs", `{}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("GET /orgs", func() {
+		It("lists the organizations of the caller", func() {
+			scopes = []any{"read:flags"}
+			svc.ListUserOrgsReturns([]model.Organization{{ID: orgID, Name: "payments"}}, nil)
+			serve(http.MethodGet, "/orgs", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, actualUserID := svc.ListUserOrgsArgsForCall(0)
+			Expect(actualUserID).To(Equal(userID))
+		})
+	})
+
+	Describe("GET /orgs/:id", func() {
+		It("returns 404 for organizations of others", func() {
+			svc.GetOrgReturns(model.Organization{}, model.ErrOrgNotFound)
+			serve(http.MethodGet, "/orgs/"+orgID.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			_, actualUserID, actualOrgID := svc.GetOrgArgsForCall(0)
+			Expect(actualUserID).To(Equal(userID))
+			Expect(actualOrgID).To(Equal(orgID))
+		})
+
+		It("returns 400 for invalid IDs", func() {
+			serve(http.MethodGet, "/orgs/invalid", "")
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("GET /orgs/:id/members", func() {
+		It("lists the members", func() {
+			svc.ListOrgMembersReturns([]model.OrganizationMember{
+				{UserID: userID, Username: "admin", Role: model.RoleAdmin},
+			}, nil)
+			serve(http.MethodGet, "/orgs/"+orgID.String()+"/members", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"username":"admin"`))
+		})
+	})
+
+	Describe("PUT /orgs/:id/members/:userID", func() {
+		var memberID uuid.UUID
+
+		BeforeEach(func() {
+			memberID = uuid.New()
+			svc.InviteOrgMemberStub = func(_ context.Context, userID, orgID, memberID uuid.UUID, role model.RoleType) (model.OrgInvitation, error) {
+				return model.OrgInvitation{OrgID: orgID, UserID: memberID, Role: role, InvitedBy: userID, CreatedAt: time.Now().UTC()}, nil
+			}
+		})
+
+		It("invites the member with the role", func() {
+			serve(http.MethodPut, "/orgs/"+orgID.String()+"/members/"+memberID.String(), `{"role":"editor"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusAccepted))
+			_, actualUserID, actualOrgID, actualMemberID, role := svc.InviteOrgMemberArgsForCall(0)
+			Expect(actualUserID).To(Equal(userID))
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualMemberID).To(Equal(memberID))
+			Expect(role).To(Equal(model.RoleEditor))
+
+			var invitation model.OrgInvitation
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &invitation)).To(Succeed())
+			Expect(invitation.UserID).To(Equal(memberID))
+			Expect(invitation.Role).To(Equal(model.RoleEditor))
+		})
+
+		It("requires a valid role", func() {
+			serve(http.MethodPut, "/orgs/"+orgID.String()+"/members/"+memberID.String(), `{"role":"owner"}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(svc.InviteOrgMemberCallCount()).To(BeZero())
+		})
+
+		It("returns 404 for unknown users", func() {
+			svc.InviteOrgMemberStub = nil
+			svc.InviteOrgMemberReturns(model.OrgInvitation{}, model.ErrUserNotFound)
+			serve(http.MethodPut, "/orgs/"+orgID.String()+"/members/"+memberID.String(), `{"role":"editor"}`)
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("returns 409 for members of the organization", func() {
+			svc.InviteOrgMemberStub = nil
+			svc.InviteOrgMemberReturns(model.OrgInvitation{}, model.ErrMemberExists)
+			serve(http.MethodPut, "/orgs/"+orgID.String()+"/members/"+memberID.String(), `{"role":"editor"}`)
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+		})
+
+		It("returns 403 for callers who are not admins of the organization", func() {
+			svc.InviteOrgMemberStub = nil
+			svc.InviteOrgMemberReturns(model.OrgInvitation{}, service.ErrNotOrgAdmin)
+			serve(http.MethodPut, "/orgs/"+orgID.String()+"/members/"+memberID.String(), `{"role":"editor"}`)
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("requires the admin:orgs scope", func() {
+			scopes = []any{"read:flags"}
+			serve(http.MethodPut, "/orgs/"+orgID.String()+"/members/"+memberID.String(), `{"role":"editor"}`)
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		})
+	})
+
+	Describe("DELETE /orgs/:id/members/:userID", func() {
+		It("returns 404 for users outside the organization", func() {
+			svc.RemoveOrgMemberReturns(model.ErrMemberNotFound)
+			serve(http.MethodDelete, "/orgs/"+orgID.String()+"/members/"+uuid.NewString(), "")
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("/invitations", func() {
+		BeforeEach(func() {
+			scopes = []any{"read:flags"}
+		})
+
+		It("lists the invitations of the caller", func() {
+			svc.ListInvitationsReturns([]model.OrgInvitation{{
+				OrgID: orgID, OrgName: "payments", UserID: userID, Role: model.RoleViewer, InvitedBy: uuid.New(), CreatedAt: time.Now().UTC(),
+			}}, nil)
+			serve(http.MethodGet, "/invitations", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"org_name":"payments"`))
+			_, actualUserID := svc.ListInvitationsArgsForCall(0)
+			Expect(actualUserID).To(Equal(userID))
+		})
+
+		It("accepts an invitation of the caller", func() {
+			serve(http.MethodPost, "/invitations/"+orgID.String()+"/accept", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualUserID, actualOrgID := svc.AcceptOrgInvitationArgsForCall(0)
+			Expect(actualUserID).To(Equal(userID))
+			Expect(actualOrgID).To(Equal(orgID))
+		})
+
+		It("returns 404 when accepting without an invitation", func() {
+			svc.AcceptOrgInvitationReturns(model.ErrInvitationNotFound)
+			serve(http.MethodPost, "/invitations/"+orgID.String()+"/accept", "")
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("declines an invitation of the caller", func() {
+			serve(http.MethodDelete, "/invitations/"+orgID.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualUserID, actualOrgID := svc.DeclineOrgInvitationArgsForCall(0)
+			Expect(actualUserID).To(Equal(userID))
+			Expect(actualOrgID).To(Equal(orgID))
+		})
+	})
+})
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/denylist"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handler) logoutHandler(c echo.Context) error {
+	caller, err := authn.CallerFromContext(c)
+	if err != nil {
+		return err
+	}
+	if caller.AccessToken == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, authn.ErrInvalidToken.Error())
+	}
+
+	var req model.LogoutRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if err := h.svc.Logout(c.Request().Context(), *caller.AccessToken, req.RefreshToken); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to log out")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) revokeHandler(c echo.Context) error {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.RevokeRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	ctx := c.Request().Context()
+	switch {
+	case req.Token != "" && req.UserID == uuid.Nil && req.IssuedBefore == nil:
+		token, parseErr := h.parseToken(req.Token)
+		if parseErr != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, parseErr.Error())
+		}
+		err = h.svc.RevokeToken(ctx, userID, orgID, token)
+	case req.Token == "" && req.UserID != uuid.Nil && req.IssuedBefore == nil:
+		err = h.svc.RevokeUserTokens(ctx, userID, orgID, req.UserID)
+	case req.Token == "" && req.UserID == uuid.Nil && req.IssuedBefore != nil:
+		err = h.svc.RevokeTokensIssuedBefore(ctx, userID, orgID, *req.IssuedBefore)
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, "exactly one of token, user_id or issued_before is required")
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrTokenNotFound), errors.Is(err, model.ErrMemberNotFound):
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case errors.Is(err, service.ErrRevocationInFuture):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to revoke tokens")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) parseToken(raw string) (model.AccessToken, error) {
+	claims, err := h.jwtHelper.ValidateToken(raw)
+	if err != nil {
+		return model.AccessToken{}, errors.New("invalid or expired token")
+	}
+	return denylist.AccessTokenFromClaims(claims)
+}
@@ -0,0 +1,204 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler/handlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/labstack/echo/v4"
+)
+
+var _ = Describe("Revocation Handler", func() {
+	var (
+		e         *echo.Echo
+		recorder  *httptest.ResponseRecorder
+		svc       *handlerfakes.FakeService
+		authStore *handlerfakes.FakeAuthStore
+		denylist  *handlerfakes.FakeDenylist
+		jwtHelper *handlerfakes.FakeJWTHelper
+		userID    uuid.UUID
+		orgID     uuid.UUID
+		scopes    []any
+	)
+
+	BeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
+		e.Validator = validator.GetValidator()
+		recorder = httptest.NewRecorder()
+		svc = &handlerfakes.FakeService{}
+		authStore = memberAuthStore()
+		denylist = &handlerfakes.FakeDenylist{}
+		jwtHelper = &handlerfakes.FakeJWTHelper{}
+		handler.NewHandler(svc, authStore, denylist, jwtHelper).RegisterHandlers(e)
+
+		userID = uuid.New()
+		orgID = uuid.New()
+		scopes = []any{"read:flags", "admin:tokens"}
+	})
+
+	serve := func(target, body string) {
+		jwtHelper.ValidateTokenReturnsOnCall(0, accessTokenClaims(userID, orgID, scopes), nil)
+		req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer token")
+		e.ServeHTTP(recorder, req)
+	}
+
+	Describe("POST /auth/logout", func() {
+		It("revokes the token of the caller", func() {
+			serve("/auth/logout", `{"refresh_token":"refresh-token"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, token, refreshToken := svc.LogoutArgsForCall(0)
+			Expect(token.UserID).To(Equal(userID))
+			Expect(token.OrgID).To(Equal(orgID))
+			Expect(token.ID).ToNot(BeEmpty())
+			Expect(refreshToken).To(Equal("refresh-token"))
+		})
+
+		It("does not require a body", func() {
+			serve("/auth/logout", "")
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, _, refreshToken := svc.LogoutArgsForCall(0)
+			Expect(refreshToken).To(BeEmpty())
+		})
+
+		It("rejects revoked tokens", func() {
+			denylist.IsRevokedReturns(true)
+			serve("/auth/logout", "")
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			Expect(recorder.Body.String()).To(ContainSubstring("token has been revoked"))
+			Expect(svc.LogoutCallCount()).To(BeZero())
+		})
+
+		It("checks that the caller is still a member of the organization of the token", func() {
+			serve("/auth/logout", "")
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+
+			_, actualUserID := authStore.UserExistsArgsForCall(0)
+			Expect(actualUserID).To(Equal(userID))
+			_, actualOrgID, actualUserID := authStore.IsOrgMemberArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualUserID).To(Equal(userID))
+		})
+
+		It("rejects tokens of users who were deleted or disabled", func() {
+			authStore.UserExistsReturns(false, nil)
+			serve("/auth/logout", "")
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			Expect(recorder.Body.String()).To(ContainSubstring("user not found"))
+			Expect(svc.LogoutCallCount()).To(BeZero())
+		})
+
+		It("rejects tokens of users who left the organization", func() {
+			authStore.IsOrgMemberReturns(false, nil)
+			serve("/auth/logout", "")
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			Expect(svc.LogoutCallCount()).To(BeZero())
+		})
+
+		It("returns 500 when the membership cannot be checked", func() {
+			authStore.IsOrgMemberReturns(false, errors.New("database unavailable"))
+			serve("/auth/logout", "")
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			Expect(svc.LogoutCallCount()).To(BeZero())
+		})
+
+		It("rejects tokens without an ID", func() {
+			claims := accessTokenClaims(userID, orgID, scopes)
+			delete(claims, "jti")
+			jwtHelper.ValidateTokenReturns(claims, nil)
+			req := httptest.NewRequest(http.MethodPost, "/auth/logout", nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer token")
+			e.ServeHTTP(recorder, req)
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	Describe("POST /auth/revoke", func() {
+		It("revokes a single token", func() {
+			revoked := accessTokenClaims(uuid.New(), orgID, []any{"read:flags"})
+			jwtHelper.ValidateTokenReturnsOnCall(1, revoked, nil)
+			serve("/auth/revoke", `{"token":"other-token"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			Expect(jwtHelper.ValidateTokenArgsForCall(1)).To(Equal("other-token"))
+			_, actualUserID, actualOrgID, token := svc.RevokeTokenArgsForCall(0)
+			Expect(actualUserID).To(Equal(userID))
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(token.ID).To(Equal(revoked["jti"]))
+		})
+
+		It("rejects invalid tokens", func() {
+			jwtHelper.ValidateTokenReturnsOnCall(1, nil, errors.New("expired"))
+			serve("/auth/revoke", `{"token":"other-token"}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(svc.RevokeTokenCallCount()).To(BeZero())
+		})
+
+		It("returns not found for tokens of other organizations", func() {
+			jwtHelper.ValidateTokenReturnsOnCall(1, accessTokenClaims(uuid.New(), uuid.New(), nil), nil)
+			svc.RevokeTokenReturns(service.ErrTokenNotFound)
+			serve("/auth/revoke", `{"token":"other-token"}`)
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("revokes the tokens of a user", func() {
+			memberID := uuid.New()
+			serve("/auth/revoke", `{"user_id":"`+memberID.String()+`"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, _, actualOrgID, actualMemberID := svc.RevokeUserTokensArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualMemberID).To(Equal(memberID))
+		})
+
+		It("returns not found for users outside the organization", func() {
+			svc.RevokeUserTokensReturns(model.ErrMemberNotFound)
+			serve("/auth/revoke", `{"user_id":"`+uuid.NewString()+`"}`)
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("revokes the tokens issued before a time", func() {
+			issuedBefore := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+			serve("/auth/revoke", `{"issued_before":"`+issuedBefore.Format(time.RFC3339)+`"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, _, _, actual := svc.RevokeTokensIssuedBeforeArgsForCall(0)
+			Expect(actual).To(BeTemporally("==", issuedBefore))
+		})
+
+		It("rejects times in the future", func() {
+			svc.RevokeTokensIssuedBeforeReturns(service.ErrRevocationInFuture)
+			serve("/auth/revoke", `{"issued_before":"`+time.Now().Add(time.Hour).Format(time.RFC3339)+`"}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("requires exactly one criterion", func() {
+			serve("/auth/revoke", `{"token":"other-token","user_id":"`+uuid.NewString()+`"}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(svc.RevokeTokenCallCount()).To(BeZero())
+			Expect(svc.RevokeUserTokensCallCount()).To(BeZero())
+		})
+
+		It("requires the admin:tokens scope", func() {
+			scopes = []any{"read:flags"}
+			serve("/auth/revoke", `{"user_id":"`+uuid.NewString()+`"}`)
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			Expect(svc.RevokeUserTokensCallCount()).To(BeZero())
+		})
+	})
+})
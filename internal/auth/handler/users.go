@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handler) createUser(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.CreateUserRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
+	}
+
+	user, err := h.svc.CreateUser(c.Request().Context(), orgID, req)
+	if err != nil {
+		return userError(err)
+	}
+
+	return c.JSON(http.StatusCreated, user)
+}
+
+func (h *Handler) listUsers(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	users, err := h.svc.ListUsers(c.Request().Context(), orgID)
+	if err != nil {
+		return userError(err)
+	}
+
+	return c.JSON(http.StatusOK, users)
+}
+
+func (h *Handler) getUser(c echo.Context) error {
+	orgID, _, id, err := userParams(c)
+	if err != nil {
+		return err
+	}
+
+	user, err := h.svc.GetUser(c.Request().Context(), orgID, id)
+	if err != nil {
+		return userError(err)
+	}
+
+	return c.JSON(http.StatusOK, user)
+}
+
+func (h *Handler) updateUserRole(c echo.Context) error {
+	orgID, userID, id, err := userParams(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.UserRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
+	}
+
+	if err := h.svc.UpdateUserRole(c.Request().Context(), orgID, userID, id, req.Role); err != nil {
+		return userError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) resetUserPassword(c echo.Context) error {
+	orgID, _, id, err := userParams(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.PasswordResetRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
+	}
+
+	if err := h.svc.ResetUserPassword(c.Request().Context(), orgID, id, req.Password); err != nil {
+		return userError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) disableUser(c echo.Context) error {
+	return h.setUserDisabled(c, true)
+}
+
+func (h *Handler) enableUser(c echo.Context) error {
+	return h.setUserDisabled(c, false)
+}
+
+func (h *Handler) setUserDisabled(c echo.Context, disabled bool) error {
+	orgID, userID, id, err := userParams(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.SetUserDisabled(c.Request().Context(), orgID, userID, id, disabled); err != nil {
+		return userError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// unlockUser lifts the delay or lockout of the logins of the user after failed attempts.
+func (h *Handler) unlockUser(c echo.Context) error {
+	orgID, _, id, err := userParams(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.UnlockUser(c.Request().Context(), orgID, id); err != nil {
+		return userError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) deleteUser(c echo.Context) error {
+	orgID, userID, id, err := userParams(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.DeleteUser(c.Request().Context(), orgID, userID, id); err != nil {
+		return userError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// userParams returns the organization and the user of the caller together with the ID of the
+// user the request is about.
+func userParams(c echo.Context) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, echo.NewHTTPError(http.StatusBadRequest, "invalid user ID")
+	}
+	return orgID, userID, id, nil
+}
+
+func userError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrUserNotFound), errors.Is(err, model.ErrOrgNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, model.ErrUserExists), errors.Is(err, service.ErrUserInOtherOrgs):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrSelfManagement):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
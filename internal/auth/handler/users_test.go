@@ -0,0 +1,219 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler/handlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/labstack/echo/v4"
+)
+
+var _ = Describe("Users Handler", func() {
+	var (
+		e         *echo.Echo
+		recorder  *httptest.ResponseRecorder
+		svc       *handlerfakes.FakeService
+		jwtHelper *handlerfakes.FakeJWTHelper
+		userID    uuid.UUID
+		orgID     uuid.UUID
+		id        uuid.UUID
+		scopes    []any
+	)
+
+	BeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
+		e.Validator = validator.GetValidator()
+		recorder = httptest.NewRecorder()
+		svc = &handlerfakes.FakeService{}
+		jwtHelper = &handlerfakes.FakeJWTHelper{}
+		handler.NewHandler(svc, memberAuthStore(), &handlerfakes.FakeDenylist{}, jwtHelper).RegisterHandlers(e)
+
+		userID = uuid.New()
+		orgID = uuid.New()
+		id = uuid.New()
+		scopes = []any{"read:flags", "admin:users"}
+	})
+
+	serve := func(method, target, body string) {
+		jwtHelper.ValidateTokenReturns(accessTokenClaims(userID, orgID, scopes), nil)
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer token")
+		e.ServeHTTP(recorder, req)
+	}
+
+	It("requires the admin:users scope", func() {
+		scopes = []any{"read:flags", "admin:orgs"}
+		serve(http.MethodGet, "/users", "")
+		Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		Expect(svc.ListUsersCallCount()).To(BeZero())
+	})
+
+	Describe("POST /users", func() {
+		It("creates the user in the organization of the caller", func() {
+			svc.CreateUserReturns(model.User{ID: id, Username: "jane", Password: "hash", Role: model.RoleEditor}, nil)
+			serve(http.MethodPost, "/users", `{"username":"jane","password":"correct-horse","role":"editor"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			_, actualOrgID, req := svc.CreateUserArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(req.Username).To(Equal("jane"))
+			Expect(req.Role).To(Equal(model.RoleEditor))
+
+			Expect(recorder.Body.String()).ToNot(ContainSubstring("hash"))
+			var user model.User
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &user)).To(Succeed())
+			Expect(user.ID).To(Equal(id))
+		})
+
+		It("rejects unknown roles", func() {
+			serve(http.MethodPost, "/users", `{"username":"jane","password":"correct-horse","role":"owner"}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(svc.CreateUserCallCount()).To(BeZero())
+		})
+
+		It("rejects short passwords", func() {
+			serve(http.MethodPost, "/users", `{"username":"jane","password":"short","role":"viewer"}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns conflict for taken usernames", func() {
+			svc.CreateUserReturns(model.User{}, model.ErrUserExists)
+			serve(http.MethodPost, "/users", `{"username":"jane","password":"correct-horse","role":"viewer"}`)
+			Expect(recorder.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Describe("GET /users", func() {
+		It("lists the users of the organization of the caller", func() {
+			svc.ListUsersReturns([]model.User{{ID: id, Username: "jane", Role: model.RoleViewer, Disabled: true}}, nil)
+			serve(http.MethodGet, "/users", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, actualOrgID := svc.ListUsersArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			var users []model.User
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &users)).To(Succeed())
+			Expect(users).To(HaveLen(1))
+			Expect(users[0].Disabled).To(BeTrue())
+		})
+	})
+
+	Describe("GET /users/:id", func() {
+		It("looks the user up in the organization of the caller", func() {
+			svc.GetUserReturns(model.User{ID: id, Username: "jane", Role: model.RoleViewer}, nil)
+			serve(http.MethodGet, "/users/"+id.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, actualOrgID, actualID := svc.GetUserArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(id))
+		})
+
+		It("returns not found for unknown users", func() {
+			svc.GetUserReturns(model.User{}, model.ErrUserNotFound)
+			serve(http.MethodGet, "/users/"+id.String(), "")
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("rejects invalid IDs", func() {
+			serve(http.MethodGet, "/users/not-a-uuid", "")
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("PUT /users/:id/role", func() {
+		It("changes the role", func() {
+			serve(http.MethodPut, "/users/"+id.String()+"/role", `{"role":"admin"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualOrgID, actualUserID, actualID, role := svc.UpdateUserRoleArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualUserID).To(Equal(userID))
+			Expect(actualID).To(Equal(id))
+			Expect(role).To(Equal(model.RoleAdmin))
+		})
+
+		It("rejects changing the own role", func() {
+			svc.UpdateUserRoleReturns(service.ErrSelfManagement)
+			serve(http.MethodPut, "/users/"+userID.String()+"/role", `{"role":"viewer"}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("PUT /users/:id/password", func() {
+		It("resets the password", func() {
+			serve(http.MethodPut, "/users/"+id.String()+"/password", `{"password":"correct-horse"}`)
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualOrgID, actualID, password := svc.ResetUserPasswordArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(id))
+			Expect(password).To(Equal("correct-horse"))
+		})
+	})
+
+	Describe("POST /users/:id/disable and /enable", func() {
+		It("disables and enables the user", func() {
+			serve(http.MethodPost, "/users/"+id.String()+"/disable", "")
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualOrgID, _, actualID, disabled := svc.SetUserDisabledArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(id))
+			Expect(disabled).To(BeTrue())
+
+			recorder = httptest.NewRecorder()
+			serve(http.MethodPost, "/users/"+id.String()+"/enable", "")
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, _, _, _, disabled = svc.SetUserDisabledArgsForCall(1)
+			Expect(disabled).To(BeFalse())
+		})
+	})
+
+	Describe("POST /users/:id/unlock", func() {
+		It("unlocks the logins of the user", func() {
+			serve(http.MethodPost, "/users/"+id.String()+"/unlock", "")
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualOrgID, actualID := svc.UnlockUserArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(id))
+		})
+
+		It("returns not found for unknown users", func() {
+			svc.UnlockUserReturns(model.ErrUserNotFound)
+			serve(http.MethodPost, "/users/"+id.String()+"/unlock", "")
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("DELETE /users/:id", func() {
+		It("deletes the user", func() {
+			serve(http.MethodDelete, "/users/"+id.String(), "")
+
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualOrgID, actualUserID, actualID := svc.DeleteUserArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualUserID).To(Equal(userID))
+			Expect(actualID).To(Equal(id))
+		})
+
+		It("returns not found for unknown users", func() {
+			svc.DeleteUserReturns(model.ErrUserNotFound)
+			serve(http.MethodDelete, "/users/"+id.String(), "")
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	jwksPath = "/.well-known/jwks.json"
+	// keysMaxAge lets consumers cache the keys. A new signing key is published before it is used,
+	// so it has to be published for at least this long.
+	keysMaxAge = "public, max-age=300"
+)
+
+func (h *Handler) jwks(c echo.Context) error {
+	c.Response().Header().Set("Cache-Control", keysMaxAge)
+	return c.JSON(http.StatusOK, h.jwtHelper.JWKS())
+}
+
+// discovery describes the API as the issuer of its tokens. The configured issuer is used rather
+// than the Host header, which the client controls.
+func (h *Handler) discovery(c echo.Context) error {
+	issuer := h.jwtHelper.Issuer()
+	return c.JSON(http.StatusOK, model.DiscoveryDocument{
+		Issuer:                           issuer,
+		JWKSURI:                          issuer + jwksPath,
+		TokenEndpoint:                    issuer + oauthTokenPath,
+		IntrospectionEndpoint:            issuer + oauthIntrospectPath,
+		RevocationEndpoint:               issuer + oauthRevokePath,
+		GrantTypesSupported:              []string{model.GrantTypeClientCredentials, model.GrantTypePassword},
+		ResponseTypesSupported:           []string{"token"},
+		SubjectTypesSupported:            []string{"public"},
+		IDTokenSigningAlgValuesSupported: []string{"RS256"},
+	})
+}
@@ -0,0 +1,71 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler/handlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/jwthelper"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/labstack/echo/v4"
+)
+
+var _ = Describe("Well-known Handler", func() {
+	var (
+		e         *echo.Echo
+		recorder  *httptest.ResponseRecorder
+		jwtHelper *handlerfakes.FakeJWTHelper
+		keys      jwthelper.JWKSet
+	)
+
+	BeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
+		recorder = httptest.NewRecorder()
+		jwtHelper = &handlerfakes.FakeJWTHelper{}
+		handler.NewHandler(&handlerfakes.FakeService{}, memberAuthStore(), &handlerfakes.FakeDenylist{}, jwtHelper).RegisterHandlers(e)
+
+		keys = jwthelper.JWKSet{Keys: []jwthelper.JWK{
+			{Kty: "RSA", Kid: "current", Use: "sig", Alg: "RS256", N: "n1", E: "AQAB"},
+			{Kty: "RSA", Kid: "previous", Use: "sig", Alg: "RS256", N: "n2", E: "AQAB"},
+		}}
+		jwtHelper.JWKSReturns(keys)
+		jwtHelper.IssuerReturns("https://flags.example.com")
+	})
+
+	serve := func(target string) {
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+	}
+
+	It("publishes the keys without authentication", func() {
+		serve("/.well-known/jwks.json")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Cache-Control")).To(ContainSubstring("max-age="))
+
+		var published jwthelper.JWKSet
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &published)).To(Succeed())
+		Expect(published).To(Equal(keys))
+		Expect(jwtHelper.ValidateTokenCallCount()).To(BeZero())
+	})
+
+	It("points to the keys and the OAuth endpoints of the configured issuer", func() {
+		serve("/.well-known/openid-configuration")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		var document model.DiscoveryDocument
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &document)).To(Succeed())
+		Expect(document.Issuer).To(Equal("https://flags.example.com"))
+		Expect(document.JWKSURI).To(Equal("https://flags.example.com/.well-known/jwks.json"))
+		Expect(document.TokenEndpoint).To(Equal("https://flags.example.com/oauth/token"))
+		Expect(document.IntrospectionEndpoint).To(Equal("https://flags.example.com/oauth/introspect"))
+		Expect(document.RevocationEndpoint).To(Equal("https://flags.example.com/oauth/revoke"))
+		Expect(document.GrantTypesSupported).To(ConsistOf("client_credentials", "password"))
+		Expect(document.IDTokenSigningAlgValuesSupported).To(ConsistOf("RS256"))
+	})
+})
@@ -6,8 +6,11 @@ package trace
 
 import (
 	"context"
+	"time"
 
 	_sourceHandler "github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
 
 	_codes "go.opentelemetry.io/otel/codes"
 
@@ -32,8 +35,25 @@ func NewServiceWithTracing(base _sourceHandler.Service) ServiceWithTracing {
 	return d
 }
 
+// AcceptOrgInvitation implements Service
+func (_d ServiceWithTracing) AcceptOrgInvitation(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.AcceptOrgInvitation")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.AcceptOrgInvitation(ctx, u1, u2)
+}
+
 // Authenticate implements Service
-func (_d ServiceWithTracing) Authenticate(ctx context.Context, s1 string, s2 string) (s3 string, err error) {
+func (_d ServiceWithTracing) Authenticate(ctx context.Context, s1 string, s2 string, u1 uuid.UUID, s3 string) (a1 model.AuthResponse, err error) {
 	ctx, _span := _d.tracer.Start(ctx, "Service.Authenticate")
 	defer func() {
 		if err != nil {
@@ -46,5 +66,719 @@ func (_d ServiceWithTracing) Authenticate(ctx context.Context, s1 string, s2 str
 		}
 		_span.End()
 	}()
-	return _d.Service.Authenticate(ctx, s1, s2)
+	return _d.Service.Authenticate(ctx, s1, s2, u1, s3)
+}
+
+// CompleteMFALogin implements Service
+func (_d ServiceWithTracing) CompleteMFALogin(ctx context.Context, m1 model.MFALoginRequest, s1 string) (a1 model.AuthResponse, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.CompleteMFALogin")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.CompleteMFALogin(ctx, m1, s1)
+}
+
+// CreateAPIKey implements Service
+func (_d ServiceWithTracing) CreateAPIKey(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, a1 model.APIKeyRequest) (c2 model.CreatedAPIKey, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.CreateAPIKey")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.CreateAPIKey(ctx, u1, u2, a1)
+}
+
+// CreateOrg implements Service
+func (_d ServiceWithTracing) CreateOrg(ctx context.Context, u1 uuid.UUID, o1 model.OrganizationRequest) (o2 model.Organization, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.CreateOrg")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.CreateOrg(ctx, u1, o1)
+}
+
+// CreateUser implements Service
+func (_d ServiceWithTracing) CreateUser(ctx context.Context, u1 uuid.UUID, c2 model.CreateUserRequest) (u2 model.User, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.CreateUser")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.CreateUser(ctx, u1, c2)
+}
+
+// DeclineOrgInvitation implements Service
+func (_d ServiceWithTracing) DeclineOrgInvitation(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.DeclineOrgInvitation")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.DeclineOrgInvitation(ctx, u1, u2)
+}
+
+// DeleteOAuthClient implements Service
+func (_d ServiceWithTracing) DeleteOAuthClient(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.DeleteOAuthClient")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.DeleteOAuthClient(ctx, u1, u2)
+}
+
+// DeleteUser implements Service
+func (_d ServiceWithTracing) DeleteUser(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.DeleteUser")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.DeleteUser(ctx, u1, u2, u3)
+}
+
+// DisableMFA implements Service
+func (_d ServiceWithTracing) DisableMFA(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, s1 string) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.DisableMFA")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.DisableMFA(ctx, u1, u2, s1)
+}
+
+// EnrollMFA implements Service
+func (_d ServiceWithTracing) EnrollMFA(ctx context.Context, u1 uuid.UUID) (m1 model.MFAEnrollment, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.EnrollMFA")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.EnrollMFA(ctx, u1)
+}
+
+// GetMFAPolicy implements Service
+func (_d ServiceWithTracing) GetMFAPolicy(ctx context.Context, u1 uuid.UUID) (m1 model.MFAPolicy, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.GetMFAPolicy")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.GetMFAPolicy(ctx, u1)
+}
+
+// GetOrg implements Service
+func (_d ServiceWithTracing) GetOrg(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (o1 model.Organization, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.GetOrg")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.GetOrg(ctx, u1, u2)
+}
+
+// GetUser implements Service
+func (_d ServiceWithTracing) GetUser(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (u3 model.User, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.GetUser")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.GetUser(ctx, u1, u2)
+}
+
+// IntrospectToken implements Service
+func (_d ServiceWithTracing) IntrospectToken(ctx context.Context, c2 model.ClientTokenRequest) (i1 model.IntrospectionResponse, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.IntrospectToken")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.IntrospectToken(ctx, c2)
+}
+
+// InviteOrgMember implements Service
+func (_d ServiceWithTracing) InviteOrgMember(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID, r1 model.RoleType) (o1 model.OrgInvitation, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.InviteOrgMember")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.InviteOrgMember(ctx, u1, u2, u3, r1)
+}
+
+// LinkUserIdentity implements Service
+func (_d ServiceWithTracing) LinkUserIdentity(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, e1 model.ExternalIdentityRequest) (e2 model.ExternalIdentity, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.LinkUserIdentity")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.LinkUserIdentity(ctx, u1, u2, e1)
+}
+
+// ListAPIKeys implements Service
+func (_d ServiceWithTracing) ListAPIKeys(ctx context.Context, u1 uuid.UUID) (aa1 []model.APIKey, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListAPIKeys")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListAPIKeys(ctx, u1)
+}
+
+// ListInvitations implements Service
+func (_d ServiceWithTracing) ListInvitations(ctx context.Context, u1 uuid.UUID) (oa1 []model.OrgInvitation, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListInvitations")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListInvitations(ctx, u1)
+}
+
+// ListOAuthClients implements Service
+func (_d ServiceWithTracing) ListOAuthClients(ctx context.Context, u1 uuid.UUID) (oa1 []model.OAuthClient, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListOAuthClients")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListOAuthClients(ctx, u1)
+}
+
+// ListOrgMembers implements Service
+func (_d ServiceWithTracing) ListOrgMembers(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (oa1 []model.OrganizationMember, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListOrgMembers")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListOrgMembers(ctx, u1, u2)
+}
+
+// ListUserIdentities implements Service
+func (_d ServiceWithTracing) ListUserIdentities(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (ea1 []model.ExternalIdentity, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListUserIdentities")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListUserIdentities(ctx, u1, u2)
+}
+
+// ListUserOrgs implements Service
+func (_d ServiceWithTracing) ListUserOrgs(ctx context.Context, u1 uuid.UUID) (oa1 []model.Organization, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListUserOrgs")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListUserOrgs(ctx, u1)
+}
+
+// ListUsers implements Service
+func (_d ServiceWithTracing) ListUsers(ctx context.Context, u1 uuid.UUID) (ua1 []model.User, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListUsers")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListUsers(ctx, u1)
+}
+
+// Logout implements Service
+func (_d ServiceWithTracing) Logout(ctx context.Context, a1 model.AccessToken, s1 string) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.Logout")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.Logout(ctx, a1, s1)
+}
+
+// MFAStatus implements Service
+func (_d ServiceWithTracing) MFAStatus(ctx context.Context, u1 uuid.UUID) (m1 model.MFAStatus, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.MFAStatus")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.MFAStatus(ctx, u1)
+}
+
+// OAuthToken implements Service
+func (_d ServiceWithTracing) OAuthToken(ctx context.Context, t1 model.TokenRequest, s1 string) (t2 model.TokenResponse, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.OAuthToken")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.OAuthToken(ctx, t1, s1)
+}
+
+// Refresh implements Service
+func (_d ServiceWithTracing) Refresh(ctx context.Context, s1 string) (a1 model.AuthResponse, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.Refresh")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.Refresh(ctx, s1)
+}
+
+// RegenerateRecoveryCodes implements Service
+func (_d ServiceWithTracing) RegenerateRecoveryCodes(ctx context.Context, u1 uuid.UUID, s1 string) (r1 model.RecoveryCodes, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.RegenerateRecoveryCodes")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.RegenerateRecoveryCodes(ctx, u1, s1)
+}
+
+// RegisterOAuthClient implements Service
+func (_d ServiceWithTracing) RegisterOAuthClient(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, o1 model.OAuthClientRequest) (c2 model.CreatedOAuthClient, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.RegisterOAuthClient")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.RegisterOAuthClient(ctx, u1, u2, o1)
+}
+
+// RemoveOrgMember implements Service
+func (_d ServiceWithTracing) RemoveOrgMember(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.RemoveOrgMember")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.RemoveOrgMember(ctx, u1, u2, u3)
+}
+
+// ResetUserMFA implements Service
+func (_d ServiceWithTracing) ResetUserMFA(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ResetUserMFA")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ResetUserMFA(ctx, u1, u2)
+}
+
+// ResetUserPassword implements Service
+func (_d ServiceWithTracing) ResetUserPassword(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, s1 string) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ResetUserPassword")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ResetUserPassword(ctx, u1, u2, s1)
+}
+
+// RevokeAPIKey implements Service
+func (_d ServiceWithTracing) RevokeAPIKey(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.RevokeAPIKey")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.RevokeAPIKey(ctx, u1, u2)
+}
+
+// RevokeOAuthToken implements Service
+func (_d ServiceWithTracing) RevokeOAuthToken(ctx context.Context, c2 model.ClientTokenRequest) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.RevokeOAuthToken")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.RevokeOAuthToken(ctx, c2)
+}
+
+// RevokeToken implements Service
+func (_d ServiceWithTracing) RevokeToken(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, a1 model.AccessToken) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.RevokeToken")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.RevokeToken(ctx, u1, u2, a1)
+}
+
+// RevokeTokensIssuedBefore implements Service
+func (_d ServiceWithTracing) RevokeTokensIssuedBefore(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, t1 time.Time) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.RevokeTokensIssuedBefore")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.RevokeTokensIssuedBefore(ctx, u1, u2, t1)
+}
+
+// RevokeUserTokens implements Service
+func (_d ServiceWithTracing) RevokeUserTokens(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.RevokeUserTokens")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.RevokeUserTokens(ctx, u1, u2, u3)
+}
+
+// SetUserDisabled implements Service
+func (_d ServiceWithTracing) SetUserDisabled(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID, b1 bool) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.SetUserDisabled")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.SetUserDisabled(ctx, u1, u2, u3, b1)
+}
+
+// UnlinkUserIdentity implements Service
+func (_d ServiceWithTracing) UnlinkUserIdentity(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.UnlinkUserIdentity")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.UnlinkUserIdentity(ctx, u1, u2, u3)
+}
+
+// UnlockUser implements Service
+func (_d ServiceWithTracing) UnlockUser(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.UnlockUser")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.UnlockUser(ctx, u1, u2)
+}
+
+// UpdateMFAPolicy implements Service
+func (_d ServiceWithTracing) UpdateMFAPolicy(ctx context.Context, u1 uuid.UUID, m1 model.MFAPolicy) (m2 model.MFAPolicy, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.UpdateMFAPolicy")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.UpdateMFAPolicy(ctx, u1, m1)
+}
+
+// UpdateUserRole implements Service
+func (_d ServiceWithTracing) UpdateUserRole(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID, r1 model.RoleType) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.UpdateUserRole")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.UpdateUserRole(ctx, u1, u2, u3, r1)
+}
+
+// VerifyMFA implements Service
+func (_d ServiceWithTracing) VerifyMFA(ctx context.Context, u1 uuid.UUID, s1 string) (r1 model.RecoveryCodes, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.VerifyMFA")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.VerifyMFA(ctx, u1, s1)
 }
@@ -1,28 +1,374 @@
 package model
 
 import (
+	"errors"
+	"time"
+
 	"github.com/google/uuid"
 )
 
 type AuthRequest struct {
 	Username string `json:"username" validate:"required"`
 	Password string `json:"password" validate:"required"`
+	// OrgID selects the organization the token is issued for, the user's first one by default.
+	OrgID uuid.UUID `json:"org_id,omitzero"`
 }
 
 type AuthResponse struct {
-	Token string `json:"token"`
+	Token        string    `json:"token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresIn    int       `json:"expires_in"`
+	OrgID        uuid.UUID `json:"org_id"`
+	// RecoveryCodes are only returned by the login that completes an enrolment required by the
+	// MFA policy.
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+// MFAChallenge is returned by the login of a user with MFA instead of the tokens, which are issued
+// once the code is verified. Enrollment is set when the MFA policy requires the user to enroll
+// first.
+type MFAChallenge struct {
+	MFAToken   string         `json:"mfa_token"`
+	ExpiresIn  int            `json:"expires_in"`
+	Enrollment *MFAEnrollment `json:"enrollment,omitempty"`
+}
+
+// MFAEnrollment carries the TOTP secret to add to an authenticator app, as is and as otpauth URI.
+type MFAEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+type MFALoginRequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	// Code is the TOTP code or one of the recovery codes.
+	Code string `json:"code" validate:"required,max=64"`
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required,max=64"`
+}
+
+type MFAStatus struct {
+	Enabled           bool `json:"enabled"`
+	RecoveryCodesLeft int  `json:"recovery_codes_left"`
+}
+
+// RecoveryCodes are only ever returned when they are generated, each of them logs in once.
+type RecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// UserMFA holds the TOTP secret of a user. MFA is enabled once the first code was verified,
+// LastUsedStep is the time step of the last accepted code, so that no code is accepted twice.
+type UserMFA struct {
+	UserID       uuid.UUID
+	Secret       string
+	EnabledAt    *time.Time
+	LastUsedStep int64
+	CreatedAt    time.Time
+}
+
+// ExternalIdentity links the subject of an OIDC provider, named by its issuer, to the local user
+// the tokens of the subject act as.
+type ExternalIdentity struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Issuer    string    `json:"issuer"`
+	Subject   string    `json:"subject"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ExternalIdentityRequest struct {
+	Issuer  string `json:"issuer" validate:"required,max=2048"`
+	Subject string `json:"subject" validate:"required,max=255"`
+}
+
+// MFAPolicy lists the roles whose users cannot log in without MFA.
+type MFAPolicy struct {
+	RequiredRoles []RoleType `json:"required_roles" validate:"dive,oneof=viewer editor admin"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+// RefreshToken is stored by the hash of the opaque token handed out to the client. Every token
+// rotated from the same login belongs to one family, which is revoked as a whole when a token
+// that was already used is presented again.
+type RefreshToken struct {
+	Hash      string
+	FamilyID  uuid.UUID
+	UserID    uuid.UUID
+	OrgID     uuid.UUID
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+}
+
+// LoginFailures counts the failed logins of a username or an IP address since the last successful
+// one. Key tells them apart, e.g. "user:john" or "ip:192.0.2.1".
+type LoginFailures struct {
+	Key          string
+	Count        int
+	LastFailedAt time.Time
+}
+
+type LogoutRequest struct {
+	// RefreshToken is revoked together with the access token when given.
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RevokeRequest revokes a single access token, every token of a user or every token issued before
+// a point in time, exactly one of the fields has to be set.
+type RevokeRequest struct {
+	Token        string     `json:"token"`
+	UserID       uuid.UUID  `json:"user_id,omitzero"`
+	IssuedBefore *time.Time `json:"issued_before"`
+}
+
+// AccessToken holds the claims of an issued access token.
+type AccessToken struct {
+	ID        string
+	UserID    uuid.UUID
+	OrgID     uuid.UUID
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// TokenRevocation revokes the access token with TokenID or, without one, every token of the
+// organization issued before IssuedBefore, limited to a single user when UserID is set. It is
+// kept until ExpiresAt, when the last token it revokes has expired.
+type TokenRevocation struct {
+	ID           uuid.UUID
+	TokenID      string
+	OrgID        uuid.UUID
+	UserID       uuid.UUID
+	IssuedBefore time.Time
+	ExpiresAt    time.Time
+	RevokedBy    uuid.UUID
+	CreatedAt    time.Time
+}
+
+type APIKeyKind string
+
+const (
+	// APIKeyKindServer keys are kept by backend services.
+	APIKeyKindServer APIKeyKind = "server"
+	// APIKeyKindClient keys end up in browsers and mobile apps and can only read the flags.
+	APIKeyKindClient APIKeyKind = "client"
+)
+
+// APIKey lets a service call the flag endpoints of an environment without logging in. The key acts
+// for the user who created it, limited to its scopes. Only the hash of the key is stored.
+type APIKey struct {
+	ID          uuid.UUID  `json:"id"`
+	OrgID       uuid.UUID  `json:"-"`
+	Name        string     `json:"name"`
+	Environment string     `json:"environment"`
+	Kind        APIKeyKind `json:"kind"`
+	// Prefix is the start of the key, enough to recognize it in listings.
+	Prefix     string     `json:"prefix"`
+	Hash       string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	CreatedBy  uuid.UUID  `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+type APIKeyRequest struct {
+	Name        string     `json:"name" validate:"required,max=255"`
+	Environment string     `json:"environment" validate:"required,max=64"`
+	Kind        APIKeyKind `json:"kind" validate:"required,oneof=server client"`
+	// Scopes may include sync:flags for the key of a sync tool, which may change managed flags.
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,oneof=read:flags write:flags review:flags sync:flags"`
+}
+
+// CreatedAPIKey carries the plain key, which is only ever returned on creation.
+type CreatedAPIKey struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+// OAuthClient is a machine client of the token endpoint. Like an API key it acts for the user who
+// registered it, limited to its scopes. Only the hash of its secret is stored.
+type OAuthClient struct {
+	ID         uuid.UUID `json:"client_id"`
+	OrgID      uuid.UUID `json:"-"`
+	Name       string    `json:"name"`
+	SecretHash string    `json:"-"`
+	Scopes     []string  `json:"scopes"`
+	CreatedBy  uuid.UUID `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type OAuthClientRequest struct {
+	Name   string   `json:"name" validate:"required,max=255"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,oneof=read:flags write:flags review:flags"`
+}
+
+// CreatedOAuthClient carries the plain secret, which is only ever returned on registration.
+type CreatedOAuthClient struct {
+	OAuthClient
+	ClientSecret string `json:"client_secret"`
+}
+
+const (
+	GrantTypeClientCredentials = "client_credentials"
+	GrantTypePassword          = "password"
+)
+
+// TokenRequest is the form-encoded request of the OAuth 2.0 token endpoint (RFC 6749). The client
+// credentials are taken from the Authorization header when they are not part of the form.
+type TokenRequest struct {
+	GrantType    string `form:"grant_type"`
+	Scope        string `form:"scope"`
+	Username     string `form:"username"`
+	Password     string `form:"password"`
+	ClientID     string `form:"client_id"`
+	ClientSecret string `form:"client_secret"`
+}
+
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+	Scope       string `json:"scope"`
+}
+
+const (
+	TokenTypeHintAccessToken  = "access_token"
+	TokenTypeHintRefreshToken = "refresh_token"
+)
+
+// ClientTokenRequest is the form-encoded request of the introspection (RFC 7662) and revocation
+// (RFC 7009) endpoints, sent by a client about a token.
+type ClientTokenRequest struct {
+	Token         string `form:"token"`
+	TokenTypeHint string `form:"token_type_hint"`
+	ClientID      string `form:"client_id"`
+	ClientSecret  string `form:"client_secret"`
+}
+
+// IntrospectionResponse describes an access token. Inactive tokens are described by Active alone.
+type IntrospectionResponse struct {
+	Active   bool   `json:"active"`
+	Scope    string `json:"scope,omitempty"`
+	ClientID string `json:"client_id,omitempty"`
+	Sub      string `json:"sub,omitempty"`
+	Exp      int64  `json:"exp,omitempty"`
+}
+
+// OAuthError is the error response of the token endpoint (RFC 6749, section 5.2).
+type OAuthError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
 }
 
 type User struct {
-	ID       uuid.UUID
-	Username string
-	Password string
-	Role     RoleType
+	ID       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	Password string    `json:"-"`
+	// Role is the role of the user in the organization they were loaded for. It is stored on the
+	// membership, users loaded by ID or username carry none.
+	Role RoleType `json:"role,omitempty"`
+	// Disabled users can neither log in nor use the tokens they were issued.
+	Disabled bool `json:"disabled"`
+}
+
+type CreateUserRequest struct {
+	Username string `json:"username" validate:"required,max=255"`
+	// Passwords are limited to the 72 bytes bcrypt hashes.
+	Password string   `json:"password" validate:"required,min=8,max=72"`
+	Role     RoleType `json:"role" validate:"required,oneof=viewer editor admin"`
+}
+
+type UserRoleRequest struct {
+	Role RoleType `json:"role" validate:"required,oneof=viewer editor admin"`
+}
+
+type PasswordResetRequest struct {
+	Password string `json:"password" validate:"required,min=8,max=72"`
 }
 
 type RoleType string
 
 const (
+	RoleAdmin  RoleType = "admin"
 	RoleEditor RoleType = "editor"
 	RoleViewer RoleType = "viewer"
 )
+
+// DefaultOrgID is the organization the seeded users and all data created before organizations
+// were introduced belong to.
+var DefaultOrgID = uuid.MustParse("7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f")
+
+type Organization struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type OrganizationRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+type OrganizationMember struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+	Role     RoleType  `json:"role"`
+}
+
+// OrgInvitation lets a user join an organization. The user becomes a member with the role of the
+// invitation only once they accept it.
+type OrgInvitation struct {
+	OrgID     uuid.UUID `json:"org_id"`
+	OrgName   string    `json:"org_name,omitempty"`
+	UserID    uuid.UUID `json:"user_id"`
+	Role      RoleType  `json:"role"`
+	InvitedBy uuid.UUID `json:"invited_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type OrgInvitationRequest struct {
+	Role RoleType `json:"role" validate:"required,oneof=viewer editor admin"`
+}
+
+var (
+	ErrOrgNotFound    = errors.New("organization not found")
+	ErrOrgExists      = errors.New("organization with this name already exists")
+	ErrUserNotFound   = errors.New("user not found")
+	ErrUserExists     = errors.New("user with this username already exists")
+	ErrMemberNotFound = errors.New("user is not a member of the organization")
+	ErrMemberExists   = errors.New("user is already a member of the organization")
+
+	ErrInvitationNotFound = errors.New("invitation not found")
+
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	ErrRefreshTokenUsed     = errors.New("refresh token was already used")
+
+	ErrAPIKeyNotFound = errors.New("API key not found")
+
+	ErrOAuthClientNotFound = errors.New("OAuth client not found")
+
+	ErrMFANotFound = errors.New("MFA is not set up for the user")
+
+	ErrIdentityNotFound = errors.New("external identity not found")
+	ErrIdentityExists   = errors.New("external identity is already linked to a user")
+)
+
+// DiscoveryDocument describes the token issuer in the format of OpenID Connect discovery, so that
+// consumers find the keys to verify the tokens with.
+type DiscoveryDocument struct {
+	Issuer                           string   `json:"issuer"`
+	JWKSURI                          string   `json:"jwks_uri"`
+	TokenEndpoint                    string   `json:"token_endpoint"`
+	IntrospectionEndpoint            string   `json:"introspection_endpoint"`
+	RevocationEndpoint               string   `json:"revocation_endpoint"`
+	GrantTypesSupported              []string `json:"grant_types_supported"`
+	ResponseTypesSupported           []string `json:"response_types_supported"`
+	SubjectTypesSupported            []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+}
@@ -1,30 +1,55 @@
 package auth
 
 import (
+	"time"
+
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/handler"
+	metricHandlerWrappers "github.com/georgisomnoev/feature-flag-api/internal/auth/handler/wrapped/metric"
 	handlerWrappers "github.com/georgisomnoev/feature-flag-api/internal/auth/handler/wrapped/trace"
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
 	metricWrappers "github.com/georgisomnoev/feature-flag-api/internal/auth/service/wrapped/metric"
 	traceWrappers "github.com/georgisomnoev/feature-flag-api/internal/auth/service/wrapped/trace"
-	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v4"
 )
 
+// JWTHelper issues the tokens of the service and validates those sent to its own endpoints.
+type JWTHelper interface {
+	service.JWTHelper
+	handler.JWTHelper
+}
+
+// Store persists the data of the service and answers the user and membership checks of the middleware.
+type Store interface {
+	service.Store
+	handler.AuthStore
+}
+
+// Denylist records the revocations made through the service and answers the checks of the middleware.
+type Denylist interface {
+	service.Denylist
+	handler.Denylist
+}
+
 func Process(
-	pool *pgxpool.Pool,
+	authStore Store,
+	denylist Denylist,
 	srv *echo.Echo,
-	jwtHelper service.JWTHelper,
-) *store.Store {
-	authStore := store.NewStore(pool)
+	jwtHelper JWTHelper,
+	externalTokenLifetime time.Duration,
+	middlewares ...echo.MiddlewareFunc,
+) {
 	metricWrappedAuthStore := metricWrappers.NewStoreWithMetrics(authStore)
 	wrappedAuthStore := traceWrappers.NewStoreWithTracing(metricWrappedAuthStore)
 	metricWrappedJWTHelper := metricWrappers.NewJWTHelperWithMetrics(jwtHelper)
 	wrappedJWTHelper := traceWrappers.NewJWTHelperWithTracing(metricWrappedJWTHelper)
-	authService := service.NewService(wrappedAuthStore, wrappedJWTHelper)
+	metricWrappedDenylist := metricWrappers.NewDenylistWithMetrics(denylist)
+	wrappedDenylist := traceWrappers.NewDenylistWithTracing(metricWrappedDenylist)
+	authService := service.NewService(wrappedAuthStore, wrappedDenylist, wrappedJWTHelper, externalTokenLifetime)
 	wrappedAuthService := handlerWrappers.NewServiceWithTracing(authService)
-	authHandler := handler.NewHandler(wrappedAuthService)
-	authHandler.RegisterHandlers(srv)
-
-	return authStore
+	metricWrappedValidator := metricHandlerWrappers.NewJWTHelperWithMetrics(jwtHelper)
+	wrappedValidator := handlerWrappers.NewJWTHelperWithTracing(metricWrappedValidator)
+	metricWrappedHandlerAuthStore := metricHandlerWrappers.NewAuthStoreWithMetrics(authStore)
+	wrappedHandlerAuthStore := handlerWrappers.NewAuthStoreWithTracing(metricWrappedHandlerAuthStore)
+	authHandler := handler.NewHandler(wrappedAuthService, wrappedHandlerAuthStore, denylist, wrappedValidator)
+	authHandler.RegisterHandlers(srv, middlewares...)
 }
@@ -3,12 +3,18 @@ package auth_test
 import (
 	"bytes"
 	"encoding/json"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/georgisomnoev/feature-flag-api/internal/auth"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/denylist"
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
-	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store/sqlite"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/totp"
 	"github.com/georgisomnoev/feature-flag-api/internal/jwthelper"
 	"github.com/georgisomnoev/feature-flag-api/internal/validator"
 	"github.com/google/uuid"
@@ -22,7 +28,7 @@ import (
 var _ = Describe("Auth Integration Test", Label("integration"), func() {
 	var (
 		srv       *httptest.Server
-		authStore *store.Store
+		authStore *sqlite.Store
 
 		jwtPrivateKey = "../../certs/jwt_keys/private.pem"
 		jwtPublicKey  = "../../certs/jwt_keys/public.pem"
@@ -31,11 +37,11 @@ var _ = Describe("Auth Integration Test", Label("integration"), func() {
 	BeforeEach(func() {
 		e := echo.New()
 		e.Validator = validator.GetValidator()
-		jwtHelper, err := jwthelper.NewJWTHelper(jwtPrivateKey, jwtPublicKey)
+		jwtHelper, err := jwthelper.NewJWTHelper(jwtPrivateKey, jwtPublicKey, "", "http://127.0.0.1:8080", slog.New(slog.DiscardHandler))
 		Expect(err).NotTo(HaveOccurred())
 
-		authStore = auth.Process(pool, e, jwtHelper)
-		Expect(authStore).NotTo(BeNil())
+		authStore = sqlite.NewStore(db)
+		auth.Process(authStore, denylist.New(authStore, slog.New(slog.DiscardHandler)), e, jwtHelper, 0)
 
 		srv = httptest.NewServer(e)
 	})
@@ -61,11 +67,12 @@ var _ = Describe("Auth Integration Test", Label("integration"), func() {
 				ID:       uuid.New(),
 				Username: username,
 				Password: string(hashedPassword),
-				Role:     "editor",
 			}
 
 			err = authStore.AddUser(ctx, testUser)
 			Expect(err).NotTo(HaveOccurred())
+			err = authStore.AddOrgMember(ctx, model.DefaultOrgID, testUser.ID, model.RoleEditor)
+			Expect(err).NotTo(HaveOccurred())
 		})
 
 		AfterEach(func() {
@@ -86,11 +93,570 @@ var _ = Describe("Auth Integration Test", Label("integration"), func() {
 
 				Expect(resp.StatusCode).To(Equal(http.StatusOK))
 
-				var response map[string]string
+				var response model.AuthResponse
 				err = json.NewDecoder(resp.Body).Decode(&response)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(response).To(HaveKey("token"))
-				Expect(response["token"]).NotTo(BeEmpty())
+				Expect(response.Token).NotTo(BeEmpty())
+				Expect(response.RefreshToken).NotTo(BeEmpty())
+				Expect(response.OrgID).To(Equal(model.DefaultOrgID))
+			})
+		})
+
+		Context("when refreshing the token", func() {
+			var refreshToken string
+
+			refresh := func(token string) *http.Response {
+				payload, _ := json.Marshal(map[string]string{"refresh_token": token})
+				resp, err := http.Post(srv.URL+"/auth/refresh", echo.MIMEApplicationJSON, bytes.NewBuffer(payload))
+				Expect(err).NotTo(HaveOccurred())
+				return resp
+			}
+
+			BeforeEach(func() {
+				payload, _ := json.Marshal(map[string]string{
+					"username": testUser.Username,
+					"password": "testpass",
+				})
+				resp, err := http.Post(srv.URL+"/auth", echo.MIMEApplicationJSON, bytes.NewBuffer(payload))
+				Expect(err).NotTo(HaveOccurred())
+				defer resp.Body.Close()
+
+				var response model.AuthResponse
+				Expect(json.NewDecoder(resp.Body).Decode(&response)).To(Succeed())
+				refreshToken = response.RefreshToken
+			})
+
+			It("rotates the refresh token", func() {
+				resp := refresh(refreshToken)
+				defer resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+				var response model.AuthResponse
+				Expect(json.NewDecoder(resp.Body).Decode(&response)).To(Succeed())
+				Expect(response.Token).NotTo(BeEmpty())
+				Expect(response.RefreshToken).NotTo(Equal(refreshToken))
+
+				next := refresh(response.RefreshToken)
+				defer next.Body.Close()
+				Expect(next.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("revokes the session when a used refresh token is replayed", func() {
+				resp := refresh(refreshToken)
+				var rotated model.AuthResponse
+				Expect(json.NewDecoder(resp.Body).Decode(&rotated)).To(Succeed())
+				resp.Body.Close()
+
+				replayed := refresh(refreshToken)
+				replayed.Body.Close()
+				Expect(replayed.StatusCode).To(Equal(http.StatusUnauthorized))
+
+				revoked := refresh(rotated.RefreshToken)
+				revoked.Body.Close()
+				Expect(revoked.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("rejects unknown refresh tokens", func() {
+				resp := refresh("unknown")
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when the user is not a member of the requested organization", func() {
+			It("returns a forbidden error", func() {
+				payload, _ := json.Marshal(map[string]string{
+					"username": testUser.Username,
+					"password": "testpass",
+					"org_id":   uuid.NewString(),
+				})
+
+				resp, err := http.Post(srv.URL+"/auth", echo.MIMEApplicationJSON, bytes.NewBuffer(payload))
+				Expect(err).NotTo(HaveOccurred())
+				defer resp.Body.Close()
+
+				Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when listing the organizations with the issued token", func() {
+			It("returns the organizations of the user", func() {
+				payload, _ := json.Marshal(map[string]string{
+					"username": testUser.Username,
+					"password": "testpass",
+				})
+				resp, err := http.Post(srv.URL+"/auth", echo.MIMEApplicationJSON, bytes.NewBuffer(payload))
+				Expect(err).NotTo(HaveOccurred())
+				var authResponse model.AuthResponse
+				Expect(json.NewDecoder(resp.Body).Decode(&authResponse)).To(Succeed())
+				resp.Body.Close()
+
+				req, err := http.NewRequest(http.MethodGet, srv.URL+"/orgs", nil)
+				Expect(err).NotTo(HaveOccurred())
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+authResponse.Token)
+				resp, err = http.DefaultClient.Do(req)
+				Expect(err).NotTo(HaveOccurred())
+				defer resp.Body.Close()
+
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				var orgs []model.Organization
+				Expect(json.NewDecoder(resp.Body).Decode(&orgs)).To(Succeed())
+				Expect(orgs).To(HaveLen(1))
+				Expect(orgs[0].ID).To(Equal(model.DefaultOrgID))
+			})
+		})
+
+		Context("when logging out", func() {
+			var authResponse model.AuthResponse
+
+			withToken := func(method, target string, body []byte) *http.Response {
+				req, err := http.NewRequest(method, srv.URL+target, bytes.NewBuffer(body))
+				Expect(err).NotTo(HaveOccurred())
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+authResponse.Token)
+				resp, err := http.DefaultClient.Do(req)
+				Expect(err).NotTo(HaveOccurred())
+				return resp
+			}
+
+			BeforeEach(func() {
+				payload, _ := json.Marshal(map[string]string{
+					"username": testUser.Username,
+					"password": "testpass",
+				})
+				resp, err := http.Post(srv.URL+"/auth", echo.MIMEApplicationJSON, bytes.NewBuffer(payload))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(json.NewDecoder(resp.Body).Decode(&authResponse)).To(Succeed())
+				resp.Body.Close()
+			})
+
+			It("revokes the access and the refresh token", func() {
+				payload, _ := json.Marshal(map[string]string{"refresh_token": authResponse.RefreshToken})
+				resp := withToken(http.MethodPost, "/auth/logout", payload)
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+				resp = withToken(http.MethodGet, "/orgs", nil)
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+
+				resp, err := http.Post(srv.URL+"/auth/refresh", echo.MIMEApplicationJSON, bytes.NewBuffer(payload))
+				Expect(err).NotTo(HaveOccurred())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when an admin manages users", func() {
+			var (
+				admin      model.User
+				adminToken string
+				created    model.User
+			)
+
+			withAdminToken := func(method, target string, body any) *http.Response {
+				payload, _ := json.Marshal(body)
+				req, err := http.NewRequest(method, srv.URL+target, bytes.NewBuffer(payload))
+				Expect(err).NotTo(HaveOccurred())
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+adminToken)
+				resp, err := http.DefaultClient.Do(req)
+				Expect(err).NotTo(HaveOccurred())
+				return resp
+			}
+
+			login := func(username, password string) *http.Response {
+				payload, _ := json.Marshal(map[string]string{"username": username, "password": password})
+				resp, err := http.Post(srv.URL+"/auth", echo.MIMEApplicationJSON, bytes.NewBuffer(payload))
+				Expect(err).NotTo(HaveOccurred())
+				return resp
+			}
+
+			BeforeEach(func() {
+				hashedPassword, err := bcrypt.GenerateFromPassword([]byte("adminpass"), bcrypt.DefaultCost)
+				Expect(err).NotTo(HaveOccurred())
+				admin = model.User{ID: uuid.New(), Username: "testadmin", Password: string(hashedPassword)}
+				Expect(authStore.AddUser(ctx, admin)).To(Succeed())
+				Expect(authStore.AddOrgMember(ctx, model.DefaultOrgID, admin.ID, model.RoleAdmin)).To(Succeed())
+
+				resp := login(admin.Username, "adminpass")
+				var authResponse model.AuthResponse
+				Expect(json.NewDecoder(resp.Body).Decode(&authResponse)).To(Succeed())
+				resp.Body.Close()
+				adminToken = authResponse.Token
+
+				resp = withAdminToken(http.MethodPost, "/users", map[string]string{
+					"username": "newuser",
+					"password": "correct-horse",
+					"role":     "viewer",
+				})
+				defer resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+				Expect(json.NewDecoder(resp.Body).Decode(&created)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(authStore.DeleteUserByID(ctx, created.ID)).To(Succeed())
+				Expect(authStore.DeleteUserByID(ctx, admin.ID)).To(Succeed())
+			})
+
+			It("lets the created user log in until it is disabled", func() {
+				resp := login("newuser", "correct-horse")
+				var authResponse model.AuthResponse
+				Expect(json.NewDecoder(resp.Body).Decode(&authResponse)).To(Succeed())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+				resp = withAdminToken(http.MethodPost, "/users/"+created.ID.String()+"/disable", nil)
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+				req, err := http.NewRequest(http.MethodGet, srv.URL+"/orgs", nil)
+				Expect(err).NotTo(HaveOccurred())
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+authResponse.Token)
+				resp, err = http.DefaultClient.Do(req)
+				Expect(err).NotTo(HaveOccurred())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+
+				resp = login("newuser", "correct-horse")
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("resets the password", func() {
+				resp := withAdminToken(http.MethodPut, "/users/"+created.ID.String()+"/password", map[string]string{"password": "battery-staple"})
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+				resp = login("newuser", "correct-horse")
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+
+				resp = login("newuser", "battery-staple")
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("locks the user out after failed logins until unlocked", func() {
+				DeferCleanup(func() {
+					Expect(authStore.ClearLoginFailures(ctx, "ip:127.0.0.1")).To(Succeed())
+				})
+				for range 4 {
+					resp := login("newuser", "wrong")
+					resp.Body.Close()
+					Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+				}
+
+				resp := login("newuser", "correct-horse")
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusTooManyRequests))
+				Expect(resp.Header.Get("Retry-After")).ToNot(BeEmpty())
+
+				resp = withAdminToken(http.MethodPost, "/users/"+created.ID.String()+"/unlock", nil)
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+				resp = login("newuser", "correct-horse")
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			Context("when the user belongs to another organization", func() {
+				var (
+					outsider model.User
+					otherOrg model.Organization
+				)
+
+				BeforeEach(func() {
+					hashedPassword, err := bcrypt.GenerateFromPassword([]byte("outsiderpass"), bcrypt.MinCost)
+					Expect(err).NotTo(HaveOccurred())
+					outsider = model.User{ID: uuid.New(), Username: "outsider", Password: string(hashedPassword)}
+					Expect(authStore.AddUser(ctx, outsider)).To(Succeed())
+					otherOrg = model.Organization{ID: uuid.New(), Name: "other-" + uuid.NewString(), CreatedAt: time.Now().UTC()}
+					Expect(authStore.CreateOrg(ctx, otherOrg, outsider.ID)).To(Succeed())
+
+					DeferCleanup(func() {
+						Expect(authStore.DeleteUserByID(ctx, outsider.ID)).To(Succeed())
+						Expect(authStore.DeleteOrgByID(ctx, otherOrg.ID)).To(Succeed())
+					})
+				})
+
+				It("does not list the user", func() {
+					resp := withAdminToken(http.MethodGet, "/users", nil)
+					defer resp.Body.Close()
+					Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+					var users []model.User
+					Expect(json.NewDecoder(resp.Body).Decode(&users)).To(Succeed())
+					Expect(users).ToNot(ContainElement(HaveField("ID", outsider.ID)))
+					Expect(users).To(ContainElement(HaveField("ID", created.ID)))
+				})
+
+				DescribeTable("reports the user as not found and leaves them alone",
+					func(method, suffix string, body any) {
+						resp := withAdminToken(method, "/users/"+outsider.ID.String()+suffix, body)
+						resp.Body.Close()
+						Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+						stored, err := authStore.GetByID(ctx, outsider.ID)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(stored).NotTo(BeNil())
+						Expect(stored.Disabled).To(BeFalse())
+						role, err := authStore.GetOrgMemberRole(ctx, otherOrg.ID, outsider.ID)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(role).To(Equal(model.RoleAdmin))
+						Expect(bcrypt.CompareHashAndPassword([]byte(stored.Password), []byte("outsiderpass"))).To(Succeed())
+					},
+					Entry("when getting them", http.MethodGet, "", nil),
+					Entry("when changing their role", http.MethodPut, "/role", map[string]string{"role": "viewer"}),
+					Entry("when resetting their password", http.MethodPut, "/password", map[string]string{"password": "battery-staple"}),
+					Entry("when disabling them", http.MethodPost, "/disable", nil),
+					Entry("when unlocking them", http.MethodPost, "/unlock", nil),
+					Entry("when deleting them", http.MethodDelete, "", nil),
+				)
+
+				It("does not reset the MFA of the user", func() {
+					Expect(authStore.SaveUserMFA(ctx, model.UserMFA{UserID: outsider.ID, Secret: "JBSWY3DPEHPK3PXP", CreatedAt: time.Now().UTC()})).To(Succeed())
+
+					resp := withAdminToken(http.MethodDelete, "/users/"+outsider.ID.String()+"/mfa", nil)
+					resp.Body.Close()
+					Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+					_, err := authStore.GetUserMFA(ctx, outsider.ID)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("adds the user only once they accept the invitation, with a role of their own", func() {
+					resp := withAdminToken(http.MethodPut, "/orgs/"+model.DefaultOrgID.String()+"/members/"+outsider.ID.String(), map[string]string{"role": "editor"})
+					resp.Body.Close()
+					Expect(resp.StatusCode).To(Equal(http.StatusAccepted))
+
+					isMember, err := authStore.IsOrgMember(ctx, model.DefaultOrgID, outsider.ID)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(isMember).To(BeFalse())
+
+					resp = login(outsider.Username, "outsiderpass")
+					var authResponse model.AuthResponse
+					Expect(json.NewDecoder(resp.Body).Decode(&authResponse)).To(Succeed())
+					resp.Body.Close()
+
+					req, err := http.NewRequest(http.MethodPost, srv.URL+"/invitations/"+model.DefaultOrgID.String()+"/accept", nil)
+					Expect(err).NotTo(HaveOccurred())
+					req.Header.Set(echo.HeaderAuthorization, "Bearer "+authResponse.Token)
+					resp, err = http.DefaultClient.Do(req)
+					Expect(err).NotTo(HaveOccurred())
+					resp.Body.Close()
+					Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+					role, err := authStore.GetOrgMemberRole(ctx, model.DefaultOrgID, outsider.ID)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(role).To(Equal(model.RoleEditor))
+					role, err = authStore.GetOrgMemberRole(ctx, otherOrg.ID, outsider.ID)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(role).To(Equal(model.RoleAdmin))
+				})
+
+				Context("and is a member of the organization too", func() {
+					BeforeEach(func() {
+						Expect(authStore.AddOrgMember(ctx, model.DefaultOrgID, outsider.ID, model.RoleEditor)).To(Succeed())
+					})
+
+					It("changes the role in the organization only", func() {
+						resp := withAdminToken(http.MethodPut, "/users/"+outsider.ID.String()+"/role", map[string]string{"role": "viewer"})
+						resp.Body.Close()
+						Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+						role, err := authStore.GetOrgMemberRole(ctx, model.DefaultOrgID, outsider.ID)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(role).To(Equal(model.RoleViewer))
+						role, err = authStore.GetOrgMemberRole(ctx, otherOrg.ID, outsider.ID)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(role).To(Equal(model.RoleAdmin))
+					})
+
+					DescribeTable("refuses the changes to the account",
+						func(method, suffix string, body any) {
+							Expect(authStore.SaveUserMFA(ctx, model.UserMFA{UserID: outsider.ID, Secret: "JBSWY3DPEHPK3PXP", CreatedAt: time.Now().UTC()})).To(Succeed())
+
+							resp := withAdminToken(method, "/users/"+outsider.ID.String()+suffix, body)
+							resp.Body.Close()
+							Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+							stored, err := authStore.GetByID(ctx, outsider.ID)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(stored).NotTo(BeNil())
+							Expect(stored.Disabled).To(BeFalse())
+							Expect(bcrypt.CompareHashAndPassword([]byte(stored.Password), []byte("outsiderpass"))).To(Succeed())
+							_, err = authStore.GetUserMFA(ctx, outsider.ID)
+							Expect(err).NotTo(HaveOccurred())
+						},
+						Entry("when resetting their password", http.MethodPut, "/password", map[string]string{"password": "battery-staple"}),
+						Entry("when disabling them", http.MethodPost, "/disable", nil),
+						Entry("when resetting their MFA", http.MethodDelete, "/mfa", nil),
+						Entry("when deleting them", http.MethodDelete, "", nil),
+					)
+				})
+			})
+
+			It("asks users with MFA for a code before issuing the tokens", func() {
+				DeferCleanup(func() {
+					Expect(authStore.ClearLoginFailures(ctx, "ip:127.0.0.1")).To(Succeed())
+				})
+				resp := login("newuser", "correct-horse")
+				var authResponse model.AuthResponse
+				Expect(json.NewDecoder(resp.Body).Decode(&authResponse)).To(Succeed())
+				resp.Body.Close()
+
+				withUserToken := func(target string, body any) *http.Response {
+					payload, _ := json.Marshal(body)
+					req, err := http.NewRequest(http.MethodPost, srv.URL+target, bytes.NewBuffer(payload))
+					Expect(err).NotTo(HaveOccurred())
+					req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+					req.Header.Set(echo.HeaderAuthorization, "Bearer "+authResponse.Token)
+					resp, err := http.DefaultClient.Do(req)
+					Expect(err).NotTo(HaveOccurred())
+					return resp
+				}
+
+				resp = withUserToken("/mfa/enroll", nil)
+				var enrollment model.MFAEnrollment
+				Expect(json.NewDecoder(resp.Body).Decode(&enrollment)).To(Succeed())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+				code, err := totp.Code(enrollment.Secret, totp.Step(time.Now()))
+				Expect(err).NotTo(HaveOccurred())
+				resp = withUserToken("/mfa/verify", map[string]string{"code": code})
+				var recoveryCodes model.RecoveryCodes
+				Expect(json.NewDecoder(resp.Body).Decode(&recoveryCodes)).To(Succeed())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				Expect(recoveryCodes.RecoveryCodes).ToNot(BeEmpty())
+
+				resp = login("newuser", "correct-horse")
+				var challenge model.MFAChallenge
+				Expect(json.NewDecoder(resp.Body).Decode(&challenge)).To(Succeed())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusAccepted))
+
+				payload, _ := json.Marshal(model.MFALoginRequest{MFAToken: challenge.MFAToken, Code: recoveryCodes.RecoveryCodes[0]})
+				resp, err = http.Post(srv.URL+"/auth/mfa", echo.MIMEApplicationJSON, bytes.NewBuffer(payload))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(json.NewDecoder(resp.Body).Decode(&authResponse)).To(Succeed())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				Expect(authResponse.Token).ToNot(BeEmpty())
+
+				resp, err = http.Post(srv.URL+"/auth/mfa", echo.MIMEApplicationJSON, bytes.NewBuffer(payload))
+				Expect(err).NotTo(HaveOccurred())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+
+				resp = withAdminToken(http.MethodDelete, "/users/"+created.ID.String()+"/mfa", nil)
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+				resp = login("newuser", "correct-horse")
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("lets OAuth clients introspect the tokens of the organization and revoke their own", func() {
+				resp := withAdminToken(http.MethodPost, "/oauth/clients", map[string]any{
+					"name":   "gateway",
+					"scopes": []string{"read:flags"},
+				})
+				var client model.CreatedOAuthClient
+				Expect(json.NewDecoder(resp.Body).Decode(&client)).To(Succeed())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+
+				postForm := func(target string, form url.Values) *http.Response {
+					req, err := http.NewRequest(http.MethodPost, srv.URL+target, strings.NewReader(form.Encode()))
+					Expect(err).NotTo(HaveOccurred())
+					req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+					req.SetBasicAuth(client.ID.String(), client.ClientSecret)
+					resp, err := http.DefaultClient.Do(req)
+					Expect(err).NotTo(HaveOccurred())
+					return resp
+				}
+				introspect := func(token string) model.IntrospectionResponse {
+					resp := postForm("/oauth/introspect", url.Values{"token": {token}})
+					defer resp.Body.Close()
+					Expect(resp.StatusCode).To(Equal(http.StatusOK))
+					var introspection model.IntrospectionResponse
+					Expect(json.NewDecoder(resp.Body).Decode(&introspection)).To(Succeed())
+					return introspection
+				}
+				revoke := func(token string) {
+					resp := postForm("/oauth/revoke", url.Values{"token": {token}})
+					resp.Body.Close()
+					Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				}
+
+				introspection := introspect(adminToken)
+				Expect(introspection.Active).To(BeTrue())
+				Expect(introspection.Sub).To(Equal(admin.ID.String()))
+				Expect(introspection.Scope).To(ContainSubstring("admin:clients"))
+
+				revoke(adminToken)
+				Expect(introspect(adminToken).Active).To(BeTrue())
+
+				resp = postForm("/oauth/token", url.Values{"grant_type": {"client_credentials"}})
+				var token model.TokenResponse
+				Expect(json.NewDecoder(resp.Body).Decode(&token)).To(Succeed())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				Expect(introspect(token.AccessToken).ClientID).To(Equal(client.ID.String()))
+
+				revoke(token.AccessToken)
+				Expect(introspect(token.AccessToken)).To(Equal(model.IntrospectionResponse{}))
+			})
+
+			It("registers OAuth clients that get tokens until they are deleted", func() {
+				resp := withAdminToken(http.MethodPost, "/oauth/clients", map[string]any{
+					"name":   "deployer",
+					"scopes": []string{"read:flags"},
+				})
+				var client model.CreatedOAuthClient
+				Expect(json.NewDecoder(resp.Body).Decode(&client)).To(Succeed())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+
+				requestToken := func() *http.Response {
+					form := url.Values{"grant_type": {"client_credentials"}}
+					req, err := http.NewRequest(http.MethodPost, srv.URL+"/oauth/token", strings.NewReader(form.Encode()))
+					Expect(err).NotTo(HaveOccurred())
+					req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+					req.SetBasicAuth(client.ID.String(), client.ClientSecret)
+					resp, err := http.DefaultClient.Do(req)
+					Expect(err).NotTo(HaveOccurred())
+					return resp
+				}
+
+				resp = requestToken()
+				var token model.TokenResponse
+				Expect(json.NewDecoder(resp.Body).Decode(&token)).To(Succeed())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				Expect(token.Scope).To(Equal("read:flags"))
+
+				req, err := http.NewRequest(http.MethodGet, srv.URL+"/orgs", nil)
+				Expect(err).NotTo(HaveOccurred())
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+token.AccessToken)
+				resp, err = http.DefaultClient.Do(req)
+				Expect(err).NotTo(HaveOccurred())
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+				resp = withAdminToken(http.MethodDelete, "/oauth/clients/"+client.ID.String(), nil)
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+				resp = requestToken()
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
 			})
 		})
 
@@ -0,0 +1,99 @@
+package oidc
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultScopesClaim      = "scope"
+	defaultMaxTokenLifetime = time.Hour
+)
+
+// ProviderConfig describes a provider whose access tokens are accepted. The subjects of the
+// provider act as the local users they are linked to, in the configured organization.
+// The values of the scopes and groups claims grant the local scopes they are mapped to, claims
+// holding nested objects are addressed with a dotted path such as realm_access.roles.
+type ProviderConfig struct {
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+	// JWKSURL is discovered from the openid-configuration of the issuer when empty.
+	JWKSURL string    `yaml:"jwks_url"`
+	OrgID   uuid.UUID `yaml:"org_id"`
+	// MaxTokenLifetime bounds the time between the iat and exp claims of the accepted tokens, the
+	// revocations by issue time are kept that long.
+	MaxTokenLifetime time.Duration       `yaml:"max_token_lifetime"`
+	ScopesClaim      string              `yaml:"scopes_claim"`
+	GroupsClaim      string              `yaml:"groups_claim"`
+	Scopes           map[string][]string `yaml:"scopes"`
+	Groups           map[string][]string `yaml:"groups"`
+}
+
+// LoadConfig reads the providers from the YAML file at path.
+func LoadConfig(path string) ([]ProviderConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OIDC config: %w", err)
+	}
+
+	var file struct {
+		Providers []ProviderConfig `yaml:"providers"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse OIDC config %s: %w", path, err)
+	}
+
+	issuers := make(map[string]bool)
+	for _, provider := range file.Providers {
+		if err := provider.validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if issuers[provider.Issuer] {
+			return nil, fmt.Errorf("%s: issuer %q is configured twice", path, provider.Issuer)
+		}
+		issuers[provider.Issuer] = true
+	}
+	return file.Providers, nil
+}
+
+func (c ProviderConfig) validate() error {
+	if c.Issuer == "" {
+		return errors.New("provider without an issuer")
+	}
+	if c.Audience == "" {
+		return fmt.Errorf("provider %q without an audience", c.Issuer)
+	}
+	if c.MaxTokenLifetime < 0 {
+		return fmt.Errorf("provider %q with a negative max_token_lifetime", c.Issuer)
+	}
+	return nil
+}
+
+// MaxTokenLifetime returns the longest lifetime of the tokens the providers issue, zero without
+// providers.
+func MaxTokenLifetime(configs []ProviderConfig) time.Duration {
+	var lifetime time.Duration
+	for _, config := range configs {
+		lifetime = max(lifetime, config.withDefaults().MaxTokenLifetime)
+	}
+	return lifetime
+}
+
+func (c ProviderConfig) withDefaults() ProviderConfig {
+	if c.OrgID == uuid.Nil {
+		c.OrgID = model.DefaultOrgID
+	}
+	if c.MaxTokenLifetime == 0 {
+		c.MaxTokenLifetime = defaultMaxTokenLifetime
+	}
+	if c.ScopesClaim == "" {
+		c.ScopesClaim = defaultScopesClaim
+	}
+	return c
+}
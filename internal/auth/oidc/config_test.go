@@ -0,0 +1,77 @@
+package oidc_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/oidc"
+)
+
+var _ = Describe("LoadConfig", func() {
+	var path string
+
+	write := func(content string) {
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "oidc.yaml")
+	})
+
+	It("reads the providers", func() {
+		write(`
+providers:
+  - issuer: https://keycloak.example.com/realms/flags
+    audience: feature-flag-api
+    org_id: 5a1de4a2-9f0c-4d8e-a4c7-0f5a3b2e7c11
+    max_token_lifetime: 8h
+    groups_claim: groups
+    scopes:
+      flags.read: [read:flags]
+    groups:
+      flag-admins: [read:flags, write:flags, admin:flags]
+`)
+
+		providers, err := oidc.LoadConfig(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(providers).To(Equal([]oidc.ProviderConfig{{
+			Issuer:           "https://keycloak.example.com/realms/flags",
+			Audience:         "feature-flag-api",
+			OrgID:            uuid.MustParse("5a1de4a2-9f0c-4d8e-a4c7-0f5a3b2e7c11"),
+			MaxTokenLifetime: 8 * time.Hour,
+			GroupsClaim:      "groups",
+			Scopes:           map[string][]string{"flags.read": {"read:flags"}},
+			Groups:           map[string][]string{"flag-admins": {"read:flags", "write:flags", "admin:flags"}},
+		}}))
+		Expect(oidc.MaxTokenLifetime(providers)).To(Equal(8 * time.Hour))
+	})
+
+	DescribeTable("rejects invalid providers",
+		func(content, message string) {
+			write(content)
+			_, err := oidc.LoadConfig(path)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("without an issuer", "providers:\n  - audience: api\n", "without an issuer"),
+		Entry("without an audience", "providers:\n  - issuer: https://a\n", "without an audience"),
+		Entry("with a negative lifetime", "providers:\n  - {issuer: https://a, audience: api, max_token_lifetime: -1h}\n",
+			"negative max_token_lifetime"),
+		Entry("configured twice", "providers:\n  - {issuer: https://a, audience: api}\n  - {issuer: https://a, audience: api}\n",
+			"configured twice"),
+	)
+
+	It("bounds the tokens of providers without a lifetime to an hour", func() {
+		Expect(oidc.MaxTokenLifetime([]oidc.ProviderConfig{{Issuer: "https://a"}})).To(Equal(time.Hour))
+		Expect(oidc.MaxTokenLifetime(nil)).To(BeZero())
+	})
+
+	It("returns an error for a missing file", func() {
+		_, err := oidc.LoadConfig(path)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,236 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// minRefreshInterval limits the refreshes caused by tokens signed with an unknown key.
+const minRefreshInterval = 30 * time.Second
+
+// keySet caches the signing keys of a provider by their key ID.
+type keySet struct {
+	client  *http.Client
+	issuer  string
+	jwksURL string
+
+	refreshMu   sync.Mutex
+	mu          sync.RWMutex
+	keys        map[string]any
+	refreshedAt time.Time
+}
+
+func newKeySet(client *http.Client, issuer, jwksURL string) *keySet {
+	return &keySet{client: client, issuer: issuer, jwksURL: jwksURL}
+}
+
+// key returns the key with the given ID. An unknown key is looked up again at the provider, which
+// picks up rotated keys before the next periodic refresh.
+func (s *keySet) key(ctx context.Context, kid string) (any, error) {
+	key, refreshedAt := s.lookup(kid)
+	if key != nil {
+		return key, nil
+	}
+	if time.Since(refreshedAt) < minRefreshInterval {
+		return nil, ErrUnknownKey
+	}
+
+	if err := s.refreshSince(ctx, refreshedAt); err != nil {
+		return nil, err
+	}
+	if key, _ = s.lookup(kid); key == nil {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+// lookup returns the key with the given ID, or the only key when the token does not name one.
+func (s *keySet) lookup(kid string) (any, time.Time) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, s.refreshedAt
+		}
+	}
+	return s.keys[kid], s.refreshedAt
+}
+
+func (s *keySet) refresh(ctx context.Context) error {
+	return s.refreshSince(ctx, time.Now())
+}
+
+// refreshSince fetches the keys unless another caller did so after the given time already.
+func (s *keySet) refreshSince(ctx context.Context, since time.Time) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	s.mu.RLock()
+	refreshed := s.refreshedAt.After(since)
+	s.mu.RUnlock()
+	if refreshed {
+		return nil
+	}
+
+	keys, err := s.fetch(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Failed refreshes count as well, so that a provider that is down is not asked on every request.
+	s.refreshedAt = time.Now()
+	if err != nil {
+		return err
+	}
+	s.keys = keys
+	return nil
+}
+
+func (s *keySet) fetch(ctx context.Context) (map[string]any, error) {
+	if s.jwksURL == "" {
+		jwksURL, err := s.discover(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.jwksURL = jwksURL
+	}
+
+	var document struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := s.getJSON(ctx, s.jwksURL, &document); err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS of %s: %w", s.issuer, err)
+	}
+
+	keys := make(map[string]any, len(document.Keys))
+	for _, k := range document.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKS of %s holds no usable signing keys", s.issuer)
+	}
+	return keys, nil
+}
+
+func (s *keySet) discover(ctx context.Context) (string, error) {
+	var configuration struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	url := strings.TrimSuffix(s.issuer, "/") + "/.well-known/openid-configuration"
+	if err := s.getJSON(ctx, url, &configuration); err != nil {
+		return "", fmt.Errorf("failed to discover %s: %w", s.issuer, err)
+	}
+	if configuration.Issuer != s.issuer {
+		return "", fmt.Errorf("discovery of %s returned issuer %q", s.issuer, configuration.Issuer)
+	}
+	if configuration.JWKSURI == "" {
+		return "", fmt.Errorf("discovery of %s returned no jwks_uri", s.issuer)
+	}
+	return configuration.JWKSURI, nil
+}
+
+func (s *keySet) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jwk) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 3 {
+			return nil, errors.New("invalid RSA key")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		return k.ecdsaKey()
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func (k jwk) ecdsaKey() (*ecdsa.PublicKey, error) {
+	var (
+		curve elliptic.Curve
+		check ecdh.Curve
+	)
+	switch k.Crv {
+	case "P-256":
+		curve, check = elliptic.P256(), ecdh.P256()
+	case "P-384":
+		curve, check = elliptic.P384(), ecdh.P384()
+	case "P-521":
+		curve, check = elliptic.P521(), ecdh.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := base64.RawURLEncoding.DecodeString(k.Y)
+	if err != nil {
+		return nil, err
+	}
+	size := (curve.Params().BitSize + 7) / 8
+	if len(x) != size || len(y) != size {
+		return nil, errors.New("invalid EC key")
+	}
+	// The ecdh package rejects points that are not on the curve.
+	if _, err := check.NewPublicKey(append(append([]byte{4}, x...), y...)); err != nil {
+		return nil, err
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+}
@@ -0,0 +1,227 @@
+// Package oidc accepts the access tokens of external OpenID Connect providers such as Keycloak or
+// Auth0 next to the tokens issued by the API. The tokens are verified with the published keys of
+// their provider and turned into the claims of a local token of the user their subject is linked
+// to, so that they pass the same checks.
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	requestTimeout = 10 * time.Second
+	// clockSkew is tolerated between the provider and the API when checking the token times.
+	clockSkew = 30 * time.Second
+)
+
+var (
+	ErrUnknownKey      = errors.New("token signed with an unknown key")
+	ErrUnknownUser     = errors.New("token of a subject that is not linked to an enabled user")
+	ErrMissingSubject  = errors.New("token lacks the sub claim")
+	ErrLifetimeTooLong = errors.New("token lives longer than the max_token_lifetime of its provider")
+)
+
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate . TokenValidator
+type TokenValidator interface {
+	ValidateToken(string) (jwt.MapClaims, error)
+}
+
+//counterfeiter:generate . Store
+type Store interface {
+	// GetUserByExternalIdentity returns the user the subject of the issuer is linked to, nil when
+	// it is linked to none.
+	GetUserByExternalIdentity(context.Context, string, string) (*model.User, error)
+}
+
+type provider struct {
+	config ProviderConfig
+	keys   *keySet
+}
+
+// Validator validates the tokens of the configured providers and passes every other token on to
+// the local validator.
+type Validator struct {
+	local     TokenValidator
+	store     Store
+	providers map[string]*provider
+	logger    *slog.Logger
+}
+
+func NewValidator(local TokenValidator, store Store, configs []ProviderConfig, logger *slog.Logger) *Validator {
+	client := &http.Client{Timeout: requestTimeout}
+	providers := make(map[string]*provider, len(configs))
+	for _, config := range configs {
+		config = config.withDefaults()
+		providers[config.Issuer] = &provider{
+			config: config,
+			keys:   newKeySet(client, config.Issuer, config.JWKSURL),
+		}
+	}
+
+	return &Validator{local: local, store: store, providers: providers, logger: logger}
+}
+
+func (v *Validator) ValidateToken(token string) (jwt.MapClaims, error) {
+	if len(v.providers) == 0 {
+		return v.local.ValidateToken(token)
+	}
+
+	unverified, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+	if err != nil {
+		return v.local.ValidateToken(token)
+	}
+	issuer, _ := unverified.Claims.GetIssuer()
+	p, ok := v.providers[issuer]
+	if !ok {
+		return v.local.ValidateToken(token)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	return v.validate(ctx, p, token)
+}
+
+// Start refreshes the keys of every provider right away and then on every interval until the
+// context is done.
+func (v *Validator) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for issuer, p := range v.providers {
+			if err := p.keys.refresh(ctx); err != nil {
+				v.logger.ErrorContext(ctx, "failed to refresh the keys of an OIDC provider", "issuer", issuer, "error", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (v *Validator) validate(ctx context.Context, p *provider, token string) (jwt.MapClaims, error) {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(p.config.Issuer),
+		jwt.WithAudience(p.config.Audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+	)
+	parsed, err := parser.ParseWithClaims(token, jwt.MapClaims{}, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.keys.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+	claims := parsed.Claims.(jwt.MapClaims)
+
+	// The issue time is needed to match the token against revocations, which are kept for the
+	// max_token_lifetime of the providers after their cutoff.
+	issuedAt, ok := claims["iat"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse token: %w", jwt.ErrTokenRequiredClaimMissing)
+	}
+	expiresAt, _ := claims["exp"].(float64)
+	if expiresAt-issuedAt > p.config.MaxTokenLifetime.Seconds() {
+		return nil, ErrLifetimeTooLong
+	}
+
+	// The subject is only unique within the issuer, the pair is linked to the user explicitly.
+	subject, _ := claims.GetSubject()
+	if subject == "" {
+		return nil, ErrMissingSubject
+	}
+	user, err := v.store.GetUserByExternalIdentity(ctx, p.config.Issuer, subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil || user.Disabled {
+		return nil, ErrUnknownUser
+	}
+
+	// Tokens without an ID are revoked by their hash.
+	tokenID, _ := claims["jti"].(string)
+	if tokenID == "" {
+		sum := sha256.Sum256([]byte(token))
+		tokenID = hex.EncodeToString(sum[:])
+	}
+
+	return jwt.MapClaims{
+		"jti":    tokenID,
+		"iss":    p.config.Issuer,
+		"sub":    user.ID.String(),
+		"org":    p.config.OrgID.String(),
+		"scopes": p.scopes(claims),
+		"iat":    issuedAt,
+		"exp":    expiresAt,
+	}, nil
+}
+
+// scopes returns the local scopes the scopes and groups of the token are mapped to.
+func (p *provider) scopes(claims jwt.MapClaims) []string {
+	var scopes []string
+	// The scope claim of OAuth 2.0 is a space separated list.
+	for _, value := range claimValues(claims, p.config.ScopesClaim) {
+		for _, scope := range strings.Fields(value) {
+			scopes = append(scopes, p.config.Scopes[scope]...)
+		}
+	}
+	if p.config.GroupsClaim != "" {
+		for _, group := range claimValues(claims, p.config.GroupsClaim) {
+			scopes = append(scopes, p.config.Groups[group]...)
+		}
+	}
+
+	slices.Sort(scopes)
+	return slices.Compact(scopes)
+}
+
+// claimValues returns the values of the string or string list claim at the dotted path.
+func claimValues(claims jwt.MapClaims, path string) []string {
+	var value any = map[string]any(claims)
+	for _, name := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
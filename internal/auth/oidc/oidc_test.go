@@ -0,0 +1,364 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/oidc"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/oidc/oidcfakes"
+)
+
+const audience = "feature-flag-api"
+
+// stubProvider publishes the discovery document and the keys of a provider.
+type stubProvider struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	keys         []map[string]string
+	jwksRequests atomic.Int32
+}
+
+func newStubProvider() *stubProvider {
+	p := &stubProvider{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": p.URL, "jwks_uri": p.URL + "/jwks"})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		p.jwksRequests.Add(1)
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": p.keys})
+	})
+	p.Server = httptest.NewServer(mux)
+	return p
+}
+
+func (p *stubProvider) publish(keys ...map[string]string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys = keys
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func rsaJWK(kid string, key *rsa.PrivateKey) map[string]string {
+	return map[string]string{
+		"kty": "RSA", "kid": kid, "use": "sig",
+		"n": encode(key.N.Bytes()), "e": encode(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(kid string, key *ecdsa.PrivateKey) map[string]string {
+	x, y := make([]byte, 32), make([]byte, 32)
+	key.X.FillBytes(x)
+	key.Y.FillBytes(y)
+	return map[string]string{"kty": "EC", "kid": kid, "crv": "P-256", "x": encode(x), "y": encode(y)}
+}
+
+func sign(method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	Expect(err).ToNot(HaveOccurred())
+	return signed
+}
+
+var _ = Describe("Validator", func() {
+	var (
+		provider   *stubProvider
+		rsaKey     *rsa.PrivateKey
+		ecKey      *ecdsa.PrivateKey
+		local      *oidcfakes.FakeTokenValidator
+		store      *oidcfakes.FakeStore
+		validator  *oidc.Validator
+		config     oidc.ProviderConfig
+		user       model.User
+		orgID      uuid.UUID
+		claims     jwt.MapClaims
+		token      string
+		validated  jwt.MapClaims
+		errAction  error
+		localToken = jwt.MapClaims{"sub": uuid.NewString()}
+	)
+
+	BeforeEach(func() {
+		var err error
+		rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+
+		provider = newStubProvider()
+		DeferCleanup(provider.Close)
+		provider.publish(rsaJWK("rsa-1", rsaKey), ecJWK("ec-1", ecKey))
+
+		local = &oidcfakes.FakeTokenValidator{}
+		local.ValidateTokenReturns(localToken, nil)
+		user = model.User{ID: uuid.New(), Username: "jane"}
+		store = &oidcfakes.FakeStore{}
+		store.GetUserByExternalIdentityReturns(&user, nil)
+
+		orgID = uuid.New()
+		config = oidc.ProviderConfig{
+			Issuer:      provider.URL,
+			Audience:    audience,
+			OrgID:       orgID,
+			GroupsClaim: "realm_access.roles",
+			Scopes:      map[string][]string{"flags.read": {"read:flags"}},
+			Groups:      map[string][]string{"flag-editors": {"read:flags", "write:flags"}},
+		}
+
+		now := time.Now()
+		claims = jwt.MapClaims{
+			"iss":                provider.URL,
+			"aud":                []string{audience, "account"},
+			"sub":                "f:provider:jane",
+			"jti":                "provider-token",
+			"preferred_username": "jane",
+			"scope":              "openid flags.read",
+			"realm_access":       map[string]any{"roles": []string{"flag-editors", "offline_access"}},
+			"iat":                now.Unix(),
+			"nbf":                now.Unix(),
+			"exp":                now.Add(10 * time.Minute).Unix(),
+		}
+	})
+
+	JustBeforeEach(func() {
+		validator = oidc.NewValidator(local, store, []oidc.ProviderConfig{config}, slog.New(slog.DiscardHandler))
+		if token == "" {
+			token = sign(jwt.SigningMethodRS256, "rsa-1", rsaKey, claims)
+		}
+		validated, errAction = validator.ValidateToken(token)
+	})
+
+	AfterEach(func() {
+		token = ""
+	})
+
+	It("turns the token of the provider into the claims of a local token", func() {
+		Expect(errAction).ToNot(HaveOccurred())
+		Expect(local.ValidateTokenCallCount()).To(BeZero())
+		_, issuer, subject := store.GetUserByExternalIdentityArgsForCall(0)
+		Expect(issuer).To(Equal(provider.URL))
+		Expect(subject).To(Equal("f:provider:jane"))
+
+		Expect(validated).To(Equal(jwt.MapClaims{
+			"jti":    "provider-token",
+			"iss":    provider.URL,
+			"sub":    user.ID.String(),
+			"org":    orgID.String(),
+			"scopes": []string{"read:flags", "write:flags"},
+			"iat":    float64(claims["iat"].(int64)),
+			"exp":    float64(claims["exp"].(int64)),
+		}))
+	})
+
+	It("discovers the keys of the provider once", func() {
+		Expect(errAction).ToNot(HaveOccurred())
+		_, err := validator.ValidateToken(sign(jwt.SigningMethodES256, "ec-1", ecKey, claims))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provider.jwksRequests.Load()).To(BeEquivalentTo(1))
+	})
+
+	Context("when the token is not issued by a provider", func() {
+		BeforeEach(func() {
+			claims["iss"] = "https://other.example.com"
+		})
+
+		It("is passed on to the local validator", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			Expect(validated).To(Equal(localToken))
+			Expect(local.ValidateTokenArgsForCall(0)).To(Equal(token))
+			Expect(provider.jwksRequests.Load()).To(BeZero())
+		})
+	})
+
+	Context("when the token has no ID", func() {
+		BeforeEach(func() {
+			delete(claims, "jti")
+		})
+
+		It("is identified by its hash", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			Expect(validated["jti"]).To(HaveLen(64))
+		})
+	})
+
+	Context("when the token lives longer than the local access tokens", func() {
+		BeforeEach(func() {
+			claims["iat"] = time.Now().Add(-30 * time.Minute).Unix()
+			claims["nbf"] = claims["iat"]
+			claims["exp"] = time.Now().Add(30 * time.Minute).Unix()
+		})
+
+		It("expires when the provider says", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			Expect(validated["exp"]).To(Equal(float64(claims["exp"].(int64))))
+		})
+	})
+
+	Context("when the token lives longer than the max_token_lifetime of the provider", func() {
+		BeforeEach(func() {
+			config.MaxTokenLifetime = 5 * time.Minute
+		})
+
+		It("returns an error", func() {
+			Expect(errAction).To(MatchError(oidc.ErrLifetimeTooLong))
+		})
+	})
+
+	Context("when no scope or group is mapped", func() {
+		BeforeEach(func() {
+			claims["scope"] = "openid"
+			delete(claims, "realm_access")
+		})
+
+		It("grants no scopes", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			Expect(validated["scopes"]).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("rejects invalid tokens",
+		func(modify func()) {
+			modify()
+			validated, errAction = validator.ValidateToken(sign(jwt.SigningMethodRS256, "rsa-1", rsaKey, claims))
+			Expect(errAction).To(HaveOccurred())
+			Expect(validated).To(BeNil())
+			Expect(local.ValidateTokenCallCount()).To(BeZero())
+		},
+		Entry("for another audience", func() { claims["aud"] = "other-api" }),
+		Entry("that expired", func() { claims["exp"] = time.Now().Add(-time.Hour).Unix() }),
+		Entry("without an expiry", func() { delete(claims, "exp") }),
+		Entry("that lives longer than the default max_token_lifetime", func() {
+			claims["exp"] = time.Now().Add(2 * time.Hour).Unix()
+		}),
+		Entry("that is not valid yet", func() { claims["nbf"] = time.Now().Add(time.Hour).Unix() }),
+		Entry("without an issue time", func() { delete(claims, "iat") }),
+		Entry("without a subject", func() { delete(claims, "sub") }),
+	)
+
+	Context("when the token is signed by an unknown key", func() {
+		BeforeEach(func() {
+			otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).ToNot(HaveOccurred())
+			token = sign(jwt.SigningMethodRS256, "rsa-1", otherKey, claims)
+		})
+
+		It("returns an error", func() {
+			Expect(errAction).To(MatchError(jwt.ErrTokenSignatureInvalid))
+		})
+	})
+
+	Context("when the token is not signed", func() {
+		BeforeEach(func() {
+			token = sign(jwt.SigningMethodNone, "", jwt.UnsafeAllowNoneSignatureType, claims)
+		})
+
+		It("returns an error", func() {
+			Expect(errAction).To(MatchError(jwt.ErrTokenSignatureInvalid))
+			Expect(local.ValidateTokenCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the subject is not linked to a user", func() {
+		BeforeEach(func() {
+			store.GetUserByExternalIdentityReturns(nil, nil)
+		})
+
+		It("returns an error", func() {
+			Expect(errAction).To(MatchError(oidc.ErrUnknownUser))
+		})
+	})
+
+	Context("when the user is disabled", func() {
+		BeforeEach(func() {
+			user.Disabled = true
+		})
+
+		It("returns an error", func() {
+			Expect(errAction).To(MatchError(oidc.ErrUnknownUser))
+		})
+	})
+
+	Context("when the user cannot be loaded", func() {
+		BeforeEach(func() {
+			store.GetUserByExternalIdentityReturns(nil, errors.New("database error"))
+		})
+
+		It("returns an error", func() {
+			Expect(errAction).To(MatchError(ContainSubstring("database error")))
+		})
+	})
+
+	Context("when the provider rotates its keys", func() {
+		var rotatedKey *rsa.PrivateKey
+
+		BeforeEach(func() {
+			var err error
+			rotatedKey, err = rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			provider.publish(rsaJWK("rsa-2", rotatedKey))
+		})
+
+		It("does not ask the provider again right away", func() {
+			_, err := validator.ValidateToken(sign(jwt.SigningMethodRS256, "rsa-2", rotatedKey, claims))
+			Expect(err).To(MatchError(oidc.ErrUnknownKey))
+			Expect(provider.jwksRequests.Load()).To(BeEquivalentTo(1))
+		})
+
+		It("picks up the new keys with the periodic refresh", func(ctx SpecContext) {
+			refreshCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			go validator.Start(refreshCtx, 10*time.Millisecond)
+
+			rotated := sign(jwt.SigningMethodRS256, "rsa-2", rotatedKey, claims)
+			Eventually(func() error {
+				_, err := validator.ValidateToken(rotated)
+				return err
+			}).Should(Succeed())
+
+			_, err := validator.ValidateToken(sign(jwt.SigningMethodRS256, "rsa-1", rsaKey, claims))
+			Expect(err).To(MatchError(oidc.ErrUnknownKey))
+		})
+	})
+
+	Context("when the provider cannot be reached", func() {
+		BeforeEach(func() {
+			provider.Close()
+		})
+
+		It("returns an error", func() {
+			Expect(errAction).To(MatchError(ContainSubstring("failed to discover")))
+		})
+	})
+})
@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package oidcfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/oidc"
+)
+
+type FakeStore struct {
+	GetUserByExternalIdentityStub        func(context.Context, string, string) (*model.User, error)
+	getUserByExternalIdentityMutex       sync.RWMutex
+	getUserByExternalIdentityArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	getUserByExternalIdentityReturns struct {
+		result1 *model.User
+		result2 error
+	}
+	getUserByExternalIdentityReturnsOnCall map[int]struct {
+		result1 *model.User
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStore) GetUserByExternalIdentity(arg1 context.Context, arg2 string, arg3 string) (*model.User, error) {
+	fake.getUserByExternalIdentityMutex.Lock()
+	ret, specificReturn := fake.getUserByExternalIdentityReturnsOnCall[len(fake.getUserByExternalIdentityArgsForCall)]
+	fake.getUserByExternalIdentityArgsForCall = append(fake.getUserByExternalIdentityArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetUserByExternalIdentityStub
+	fakeReturns := fake.getUserByExternalIdentityReturns
+	fake.recordInvocation("GetUserByExternalIdentity", []interface{}{arg1, arg2, arg3})
+	fake.getUserByExternalIdentityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetUserByExternalIdentityCallCount() int {
+	fake.getUserByExternalIdentityMutex.RLock()
+	defer fake.getUserByExternalIdentityMutex.RUnlock()
+	return len(fake.getUserByExternalIdentityArgsForCall)
+}
+
+func (fake *FakeStore) GetUserByExternalIdentityCalls(stub func(context.Context, string, string) (*model.User, error)) {
+	fake.getUserByExternalIdentityMutex.Lock()
+	defer fake.getUserByExternalIdentityMutex.Unlock()
+	fake.GetUserByExternalIdentityStub = stub
+}
+
+func (fake *FakeStore) GetUserByExternalIdentityArgsForCall(i int) (context.Context, string, string) {
+	fake.getUserByExternalIdentityMutex.RLock()
+	defer fake.getUserByExternalIdentityMutex.RUnlock()
+	argsForCall := fake.getUserByExternalIdentityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) GetUserByExternalIdentityReturns(result1 *model.User, result2 error) {
+	fake.getUserByExternalIdentityMutex.Lock()
+	defer fake.getUserByExternalIdentityMutex.Unlock()
+	fake.GetUserByExternalIdentityStub = nil
+	fake.getUserByExternalIdentityReturns = struct {
+		result1 *model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetUserByExternalIdentityReturnsOnCall(i int, result1 *model.User, result2 error) {
+	fake.getUserByExternalIdentityMutex.Lock()
+	defer fake.getUserByExternalIdentityMutex.Unlock()
+	fake.GetUserByExternalIdentityStub = nil
+	if fake.getUserByExternalIdentityReturnsOnCall == nil {
+		fake.getUserByExternalIdentityReturnsOnCall = make(map[int]struct {
+			result1 *model.User
+			result2 error
+		})
+	}
+	fake.getUserByExternalIdentityReturnsOnCall[i] = struct {
+		result1 *model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStore) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ oidc.Store = new(FakeStore)
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/apikey"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+// clientAPIKeyScope is the only scope of client keys, they are shipped to browsers and apps.
+const clientAPIKeyScope = "read:flags"
+
+// CreateAPIKey creates a key of the organization acting for the given user. The returned key is the
+// only time it is available in plain text.
+func (a *Service) CreateAPIKey(ctx context.Context, orgID, userID uuid.UUID, req model.APIKeyRequest) (model.CreatedAPIKey, error) {
+	if req.Kind == model.APIKeyKindClient && slices.ContainsFunc(req.Scopes, func(scope string) bool {
+		return scope != clientAPIKeyScope
+	}) {
+		return model.CreatedAPIKey{}, ErrClientAPIKeyScopes
+	}
+
+	plain, prefix, hash, err := apikey.Generate(req.Kind)
+	if err != nil {
+		return model.CreatedAPIKey{}, fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	key := model.APIKey{
+		ID:          uuid.New(),
+		OrgID:       orgID,
+		Name:        req.Name,
+		Environment: req.Environment,
+		Kind:        req.Kind,
+		Prefix:      prefix,
+		Hash:        hash,
+		Scopes:      slices.Compact(slices.Sorted(slices.Values(req.Scopes))),
+		CreatedBy:   userID,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err := a.store.CreateAPIKey(ctx, key); err != nil {
+		return model.CreatedAPIKey{}, fmt.Errorf("failed to create API key: %w", err)
+	}
+	return model.CreatedAPIKey{APIKey: key, Key: plain}, nil
+}
+
+func (a *Service) ListAPIKeys(ctx context.Context, orgID uuid.UUID) ([]model.APIKey, error) {
+	keys, err := a.store.ListAPIKeys(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (a *Service) RevokeAPIKey(ctx context.Context, orgID, id uuid.UUID) error {
+	if err := a.store.RevokeAPIKey(ctx, orgID, id, time.Now()); err != nil {
+		if errors.Is(err, model.ErrAPIKeyNotFound) {
+			return model.ErrAPIKeyNotFound
+		}
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/apikey"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service/servicefakes"
+)
+
+var _ = Describe("API keys", func() {
+	var (
+		ctx    context.Context
+		store  *servicefakes.FakeStore
+		svc    *service.Service
+		orgID  uuid.UUID
+		userID uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = &servicefakes.FakeStore{}
+		svc = service.NewService(store, &servicefakes.FakeDenylist{}, &servicefakes.FakeJWTHelper{}, 0)
+		orgID = uuid.New()
+		userID = uuid.New()
+	})
+
+	Describe("CreateAPIKey", func() {
+		var req model.APIKeyRequest
+
+		BeforeEach(func() {
+			req = model.APIKeyRequest{
+				Name:        "checkout",
+				Environment: "production",
+				Kind:        model.APIKeyKindServer,
+				Scopes:      []string{"write:flags", "read:flags", "read:flags"},
+			}
+		})
+
+		It("stores the hash of the key and returns the key once", func() {
+			created, err := svc.CreateAPIKey(ctx, orgID, userID, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created.Key).To(HavePrefix(created.Prefix))
+
+			_, stored := store.CreateAPIKeyArgsForCall(0)
+			Expect(stored).To(Equal(created.APIKey))
+			Expect(stored).To(MatchFields(IgnoreExtras, Fields{
+				"ID":          Not(Equal(uuid.Nil)),
+				"OrgID":       Equal(orgID),
+				"Name":        Equal("checkout"),
+				"Environment": Equal("production"),
+				"Kind":        Equal(model.APIKeyKindServer),
+				"Hash":        Equal(apikey.Hash(created.Key)),
+				"Scopes":      Equal([]string{"read:flags", "write:flags"}),
+				"CreatedBy":   Equal(userID),
+				"CreatedAt":   Not(BeZero()),
+			}))
+		})
+
+		Context("when a client key asks for more than reading", func() {
+			BeforeEach(func() {
+				req.Kind = model.APIKeyKindClient
+			})
+
+			It("returns ErrClientAPIKeyScopes", func() {
+				_, err := svc.CreateAPIKey(ctx, orgID, userID, req)
+				Expect(err).To(MatchError(service.ErrClientAPIKeyScopes))
+				Expect(store.CreateAPIKeyCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when a client key only reads", func() {
+			BeforeEach(func() {
+				req.Kind = model.APIKeyKindClient
+				req.Scopes = []string{"read:flags"}
+			})
+
+			It("creates the key", func() {
+				created, err := svc.CreateAPIKey(ctx, orgID, userID, req)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created.Key).To(HavePrefix("ffc_"))
+			})
+		})
+	})
+
+	Describe("RevokeAPIKey", func() {
+		It("revokes the key of the organization", func() {
+			id := uuid.New()
+			Expect(svc.RevokeAPIKey(ctx, orgID, id)).To(Succeed())
+
+			_, actualOrgID, actualID, revokedAt := store.RevokeAPIKeyArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(id))
+			Expect(revokedAt).ToNot(BeZero())
+		})
+
+		It("returns ErrAPIKeyNotFound for unknown keys", func() {
+			store.RevokeAPIKeyReturns(model.ErrAPIKeyNotFound)
+			Expect(svc.RevokeAPIKey(ctx, orgID, uuid.New())).To(MatchError(model.ErrAPIKeyNotFound))
+		})
+	})
+})
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+// ListUserIdentities returns the identities of OIDC providers linked to the user.
+func (a *Service) ListUserIdentities(ctx context.Context, orgID, id uuid.UUID) ([]model.ExternalIdentity, error) {
+	if err := a.ensureOrgUser(ctx, orgID, id); err != nil {
+		return nil, err
+	}
+	identities, err := a.store.ListExternalIdentities(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list external identities: %w", err)
+	}
+	return identities, nil
+}
+
+// LinkUserIdentity lets the tokens the issuer issues for the subject act as the user. The link
+// holds for every organization of the user, so like the password it is only managed by the admins
+// of an organization the user is the only member of.
+func (a *Service) LinkUserIdentity(ctx context.Context, orgID, id uuid.UUID, req model.ExternalIdentityRequest) (model.ExternalIdentity, error) {
+	if err := a.ensureSoleOrgUser(ctx, orgID, id); err != nil {
+		return model.ExternalIdentity{}, err
+	}
+
+	identity := model.ExternalIdentity{
+		ID:        uuid.New(),
+		UserID:    id,
+		Issuer:    req.Issuer,
+		Subject:   req.Subject,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := a.store.CreateExternalIdentity(ctx, identity); err != nil {
+		return model.ExternalIdentity{}, fmt.Errorf("failed to link external identity: %w", err)
+	}
+	return identity, nil
+}
+
+func (a *Service) UnlinkUserIdentity(ctx context.Context, orgID, id, identityID uuid.UUID) error {
+	if err := a.ensureOrgUser(ctx, orgID, id); err != nil {
+		return err
+	}
+	if err := a.store.DeleteExternalIdentity(ctx, id, identityID); err != nil {
+		return fmt.Errorf("failed to unlink external identity: %w", err)
+	}
+	return nil
+}
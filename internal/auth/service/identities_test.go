@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service/servicefakes"
+)
+
+var _ = Describe("External identities", func() {
+	var (
+		ctx   context.Context
+		store *servicefakes.FakeStore
+		svc   *service.Service
+		orgID uuid.UUID
+		id    uuid.UUID
+		req   model.ExternalIdentityRequest
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = &servicefakes.FakeStore{}
+		store.IsOrgMemberReturns(true, nil)
+		store.ListUserOrgsReturns([]model.Organization{{ID: orgID}}, nil)
+		svc = service.NewService(store, &servicefakes.FakeDenylist{}, &servicefakes.FakeJWTHelper{}, 0)
+		orgID = uuid.New()
+		id = uuid.New()
+		req = model.ExternalIdentityRequest{Issuer: "https://idp.example.com", Subject: "f:idp:jane"}
+	})
+
+	Describe("LinkUserIdentity", func() {
+		It("links the subject of the issuer to the user", func() {
+			identity, err := svc.LinkUserIdentity(ctx, orgID, id, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(identity.UserID).To(Equal(id))
+			Expect(identity.Issuer).To(Equal(req.Issuer))
+			Expect(identity.Subject).To(Equal(req.Subject))
+
+			_, stored := store.CreateExternalIdentityArgsForCall(0)
+			Expect(stored).To(Equal(identity))
+		})
+
+		It("hides the users of other organizations", func() {
+			store.IsOrgMemberReturns(false, nil)
+			_, err := svc.LinkUserIdentity(ctx, orgID, id, req)
+			Expect(err).To(MatchError(model.ErrUserNotFound))
+			Expect(store.CreateExternalIdentityCallCount()).To(BeZero())
+		})
+
+		It("refuses users who are members of other organizations too", func() {
+			store.ListUserOrgsReturns([]model.Organization{{ID: orgID}, {ID: uuid.New()}}, nil)
+			_, err := svc.LinkUserIdentity(ctx, orgID, id, req)
+			Expect(err).To(MatchError(service.ErrUserInOtherOrgs))
+			Expect(store.CreateExternalIdentityCallCount()).To(BeZero())
+		})
+
+		It("returns the errors of the store", func() {
+			store.CreateExternalIdentityReturns(model.ErrIdentityExists)
+			_, err := svc.LinkUserIdentity(ctx, orgID, id, req)
+			Expect(err).To(MatchError(model.ErrIdentityExists))
+		})
+	})
+
+	Describe("ListUserIdentities", func() {
+		It("lists the identities of the member", func() {
+			identities := []model.ExternalIdentity{{ID: uuid.New(), UserID: id, Issuer: req.Issuer, Subject: req.Subject}}
+			store.ListExternalIdentitiesReturns(identities, nil)
+
+			listed, err := svc.ListUserIdentities(ctx, orgID, id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(listed).To(Equal(identities))
+		})
+
+		It("hides the users of other organizations", func() {
+			store.IsOrgMemberReturns(false, nil)
+			_, err := svc.ListUserIdentities(ctx, orgID, id)
+			Expect(err).To(MatchError(model.ErrUserNotFound))
+		})
+	})
+
+	Describe("UnlinkUserIdentity", func() {
+		It("deletes the identity of the member", func() {
+			identityID := uuid.New()
+			Expect(svc.UnlinkUserIdentity(ctx, orgID, id, identityID)).To(Succeed())
+			_, actualUserID, actualID := store.DeleteExternalIdentityArgsForCall(0)
+			Expect(actualUserID).To(Equal(id))
+			Expect(actualID).To(Equal(identityID))
+		})
+
+		It("returns the errors of the store", func() {
+			store.DeleteExternalIdentityReturns(errors.New("database error"))
+			Expect(svc.UnlinkUserIdentity(ctx, orgID, id, uuid.New())).To(MatchError(ContainSubstring("database error")))
+		})
+	})
+})
@@ -0,0 +1,180 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service/servicefakes"
+)
+
+var _ = Describe("Introspection and revocation", func() {
+	var (
+		ctx       context.Context
+		store     *servicefakes.FakeStore
+		denylist  *servicefakes.FakeDenylist
+		jwtHelper *servicefakes.FakeJWTHelper
+		svc       *service.Service
+		orgID     uuid.UUID
+		user      model.User
+		client    model.CreatedOAuthClient
+		claims    jwt.MapClaims
+		expiresAt time.Time
+		req       model.ClientTokenRequest
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = &servicefakes.FakeStore{}
+		denylist = &servicefakes.FakeDenylist{}
+		jwtHelper = &servicefakes.FakeJWTHelper{}
+		svc = service.NewService(store, denylist, jwtHelper, 0)
+		orgID = uuid.New()
+		user = model.User{ID: uuid.New(), Username: "john"}
+
+		var err error
+		client, err = svc.RegisterOAuthClient(ctx, orgID, uuid.New(), model.OAuthClientRequest{
+			Name:   "gateway",
+			Scopes: []string{"read:flags"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		store.GetOAuthClientReturns(client.OAuthClient, nil)
+		store.GetByIDReturns(&user, nil)
+		store.IsOrgMemberReturns(true, nil)
+		store.GetRefreshTokenReturns(model.RefreshToken{}, model.ErrRefreshTokenNotFound)
+
+		// The claims as the JWT helper returns them after parsing a token.
+		now := time.Now()
+		expiresAt = now.Add(10 * time.Minute).Truncate(time.Second)
+		claims = jwt.MapClaims{
+			"jti":       "token-id",
+			"sub":       user.ID.String(),
+			"org":       orgID.String(),
+			"scopes":    []any{"read:flags", "write:flags"},
+			"client_id": "some-client",
+			"iat":       float64(now.Unix()),
+			"iat_ms":    float64(now.UnixMilli()),
+			"exp":       float64(expiresAt.Unix()),
+		}
+		jwtHelper.ValidateTokenReturns(claims, nil)
+
+		req = model.ClientTokenRequest{
+			Token:        "access-token",
+			ClientID:     client.ID.String(),
+			ClientSecret: client.ClientSecret,
+		}
+	})
+
+	Describe("IntrospectToken", func() {
+		It("describes an active token of the organization of the client", func() {
+			resp, err := svc.IntrospectToken(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(Equal(model.IntrospectionResponse{
+				Active:   true,
+				Scope:    "read:flags write:flags",
+				ClientID: "some-client",
+				Sub:      user.ID.String(),
+				Exp:      expiresAt.Unix(),
+			}))
+			Expect(jwtHelper.ValidateTokenArgsForCall(0)).To(Equal("access-token"))
+			Expect(denylist.IsRevokedArgsForCall(0)).To(Equal(claims))
+		})
+
+		DescribeTable("reports inactive tokens without details",
+			func(modify func()) {
+				modify()
+				resp, err := svc.IntrospectToken(ctx, req)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp).To(Equal(model.IntrospectionResponse{}))
+			},
+			Entry("that are invalid or expired", func() { jwtHelper.ValidateTokenReturns(nil, errors.New("token expired")) }),
+			Entry("that were revoked", func() { denylist.IsRevokedReturns(true) }),
+			Entry("of another organization", func() { claims["org"] = uuid.NewString() }),
+			Entry("without the claims of an access token", func() { delete(claims, "jti") }),
+			Entry("of a disabled user", func() { user.Disabled = true }),
+			Entry("of a deleted user", func() { store.GetByIDReturns(nil, nil) }),
+			Entry("of a user who left the organization", func() { store.IsOrgMemberReturns(false, nil) }),
+		)
+
+		It("requires the client to authenticate", func() {
+			req.ClientSecret = "wrong"
+			_, err := svc.IntrospectToken(ctx, req)
+			Expect(err).To(MatchError(service.ErrInvalidClient))
+			Expect(jwtHelper.ValidateTokenCallCount()).To(BeZero())
+		})
+
+		It("requires a token", func() {
+			req.Token = ""
+			_, err := svc.IntrospectToken(ctx, req)
+			Expect(err).To(MatchError(service.ErrInvalidTokenRequest))
+		})
+	})
+
+	Describe("RevokeOAuthToken", func() {
+		BeforeEach(func() {
+			claims["client_id"] = client.ID.String()
+		})
+
+		It("revokes an access token issued to the client", func() {
+			Expect(svc.RevokeOAuthToken(ctx, req)).To(Succeed())
+
+			Expect(denylist.RevokeCallCount()).To(Equal(1))
+			_, revocation := denylist.RevokeArgsForCall(0)
+			Expect(revocation.TokenID).To(Equal("token-id"))
+			Expect(revocation.OrgID).To(Equal(orgID))
+			Expect(revocation.UserID).To(Equal(user.ID))
+			Expect(revocation.ExpiresAt).To(BeTemporally("==", expiresAt))
+			Expect(revocation.RevokedBy).To(Equal(client.CreatedBy))
+		})
+
+		DescribeTable("treats the tokens of others like unknown ones",
+			func(modify func()) {
+				modify()
+				Expect(svc.RevokeOAuthToken(ctx, req)).To(Succeed())
+				Expect(denylist.RevokeCallCount()).To(BeZero())
+				Expect(store.RevokeRefreshTokenFamilyCallCount()).To(BeZero())
+			},
+			Entry("for access tokens of another client", func() { claims["client_id"] = uuid.NewString() }),
+			Entry("for access tokens of a login", func() { delete(claims, "client_id") }),
+			Entry("for refresh tokens", func() {
+				jwtHelper.ValidateTokenReturns(nil, errors.New("invalid token"))
+				store.GetRefreshTokenReturns(model.RefreshToken{FamilyID: uuid.New(), UserID: user.ID, OrgID: orgID}, nil)
+				req.Token = "refresh-token"
+				req.TokenTypeHint = model.TokenTypeHintRefreshToken
+			}),
+		)
+
+		It("ignores unknown tokens", func() {
+			jwtHelper.ValidateTokenReturns(nil, errors.New("invalid token"))
+			Expect(svc.RevokeOAuthToken(ctx, req)).To(Succeed())
+			Expect(denylist.RevokeCallCount()).To(BeZero())
+			Expect(store.RevokeRefreshTokenFamilyCallCount()).To(BeZero())
+		})
+
+		It("ignores access tokens of other organizations", func() {
+			claims["org"] = uuid.NewString()
+			Expect(svc.RevokeOAuthToken(ctx, req)).To(Succeed())
+			Expect(denylist.RevokeCallCount()).To(BeZero())
+		})
+
+		It("returns an error when the revocation cannot be stored", func() {
+			denylist.RevokeReturns(errors.New("database error"))
+			err := svc.RevokeOAuthToken(ctx, req)
+			Expect(err).To(MatchError(ContainSubstring("database error")))
+		})
+
+		It("requires the client to authenticate", func() {
+			req.ClientID = "gateway"
+			err := svc.RevokeOAuthToken(ctx, req)
+			Expect(err).To(MatchError(service.ErrInvalidClient))
+			Expect(denylist.RevokeCallCount()).To(BeZero())
+		})
+	})
+})
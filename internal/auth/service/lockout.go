@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	loginBackoffBase     = time.Second
+	loginLockoutDuration = 15 * time.Minute
+	// loginFailureWindow is how long the failures of a key are remembered after the last one.
+	loginFailureWindow = 24 * time.Hour
+)
+
+var ErrTooManyLoginAttempts = errors.New("too many failed login attempts")
+
+// LoginLockedError is returned while the logins of a username or an IP address are delayed or
+// locked after failed attempts.
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrTooManyLoginAttempts, e.RetryAfter.Round(time.Second))
+}
+
+func (e *LoginLockedError) Unwrap() error {
+	return ErrTooManyLoginAttempts
+}
+
+// loginPolicy delays the logins of a key exponentially once more than freeAttempts of them failed,
+// and locks it for loginLockoutDuration once lockoutThreshold failed.
+type loginPolicy struct {
+	prefix           string
+	freeAttempts     int
+	lockoutThreshold int
+}
+
+var (
+	usernameLoginPolicy = loginPolicy{prefix: "user:", freeAttempts: 3, lockoutThreshold: 10}
+	// Many users may log in from the same address, e.g. behind a NAT.
+	ipLoginPolicy = loginPolicy{prefix: "ip:", freeAttempts: 10, lockoutThreshold: 50}
+)
+
+func (p loginPolicy) key(value string) string {
+	return p.prefix + value
+}
+
+func (p loginPolicy) lockedUntil(failures model.LoginFailures) time.Time {
+	switch {
+	case failures.Count >= p.lockoutThreshold:
+		return failures.LastFailedAt.Add(loginLockoutDuration)
+	case failures.Count > p.freeAttempts:
+		delay := loginLockoutDuration
+		if shift := failures.Count - p.freeAttempts - 1; shift < 20 {
+			delay = min(loginBackoffBase<<shift, loginLockoutDuration)
+		}
+		return failures.LastFailedAt.Add(delay)
+	default:
+		return time.Time{}
+	}
+}
+
+type loginFailureKey struct {
+	policy loginPolicy
+	key    string
+}
+
+func loginFailureKeys(username, ip string) []loginFailureKey {
+	keys := []loginFailureKey{{usernameLoginPolicy, usernameLoginPolicy.key(username)}}
+	if ip != "" {
+		keys = append(keys, loginFailureKey{ipLoginPolicy, ipLoginPolicy.key(ip)})
+	}
+	return keys
+}
+
+// loginAttempt is an attempt to log in that was counted as failed before the password or code was
+// checked. It is released once the attempt succeeded.
+type loginAttempt struct {
+	key      string
+	count    int
+	previous model.LoginFailures
+}
+
+// reserveLoginAttempt counts the attempt as failed for every key before the password or code is
+// checked, so that concurrent attempts cannot all pass the check before any of them is recorded. It
+// returns a LoginLockedError when any of the keys is delayed or locked, the attempts still in
+// flight counting as failures of just now.
+func (a *Service) reserveLoginAttempt(ctx context.Context, keys []loginFailureKey, now time.Time) ([]loginAttempt, error) {
+	var (
+		attempts   []loginAttempt
+		retryAfter time.Duration
+	)
+	for _, k := range keys {
+		previous, err := a.store.GetLoginFailures(ctx, k.key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get login failures: %w", err)
+		}
+		if previous.LastFailedAt.Before(now.Add(-loginFailureWindow)) {
+			previous = model.LoginFailures{Key: k.key}
+		}
+		if until := k.policy.lockedUntil(previous); until.After(now) {
+			retryAfter = max(retryAfter, until.Sub(now))
+			continue
+		}
+
+		recorded, err := a.store.RecordLoginFailure(ctx, k.key, now, now.Add(-loginFailureWindow))
+		if err != nil {
+			return nil, fmt.Errorf("failed to record login failure: %w", err)
+		}
+		attempts = append(attempts, loginAttempt{key: k.key, count: recorded.Count, previous: previous})
+		if recorded.Count-1 > previous.Count {
+			inFlight := model.LoginFailures{Count: recorded.Count - 1, LastFailedAt: now}
+			retryAfter = max(retryAfter, k.policy.lockedUntil(inFlight).Sub(now))
+		}
+	}
+	if retryAfter > 0 {
+		if err := a.releaseLoginAttempt(ctx, attempts); err != nil {
+			return nil, err
+		}
+		return nil, &LoginLockedError{RetryAfter: retryAfter}
+	}
+	return attempts, nil
+}
+
+// releaseLoginAttempt takes back the failures counted for an attempt that succeeded or was not
+// made.
+func (a *Service) releaseLoginAttempt(ctx context.Context, attempts []loginAttempt) error {
+	for _, attempt := range attempts {
+		err := a.store.ReleaseLoginFailure(ctx, attempt.key, attempt.count, attempt.previous.LastFailedAt)
+		if err != nil {
+			return fmt.Errorf("failed to release login failure: %w", err)
+		}
+	}
+	return nil
+}
+
+func (a *Service) clearLoginFailures(ctx context.Context, username string) error {
+	if err := a.store.ClearLoginFailures(ctx, usernameLoginPolicy.key(username)); err != nil {
+		return fmt.Errorf("failed to clear login failures: %w", err)
+	}
+	return nil
+}
+
+// dummyPasswordHash is compared against the password of unknown users, so that rejecting them
+// takes as long as rejecting a wrong password.
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, err := bcrypt.GenerateFromPassword([]byte(uuid.NewString()), bcrypt.DefaultCost)
+	if err != nil {
+		panic(fmt.Sprintf("failed to hash dummy password: %v", err))
+	}
+	return hash
+})
+
+// UnlockUser forgets the failed logins of the user, which lifts the delay or lockout of the
+// username. Failures recorded for IP addresses are kept.
+func (a *Service) UnlockUser(ctx context.Context, orgID, id uuid.UUID) error {
+	user, err := a.GetUser(ctx, orgID, id)
+	if err != nil {
+		return err
+	}
+	return a.clearLoginFailures(ctx, user.Username)
+}
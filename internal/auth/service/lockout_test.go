@@ -0,0 +1,206 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service/servicefakes"
+)
+
+var _ = Describe("Login lockout", func() {
+	var (
+		ctx      context.Context
+		store    *servicefakes.FakeStore
+		svc      *service.Service
+		user     model.User
+		password string
+		failures map[string]model.LoginFailures
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = &servicefakes.FakeStore{}
+		jwtHelper := &servicefakes.FakeJWTHelper{}
+		jwtHelper.GenerateTokenReturns("token", nil)
+		svc = service.NewService(store, &servicefakes.FakeDenylist{}, jwtHelper, 0)
+
+		hash, err := bcrypt.GenerateFromPassword([]byte("correct-horse"), bcrypt.MinCost)
+		Expect(err).ToNot(HaveOccurred())
+		user = model.User{ID: uuid.New(), Username: "john", Password: string(hash)}
+		password = "correct-horse"
+		store.GetByUsernameReturns(&user, nil)
+		store.GetByIDReturns(&user, nil)
+		store.ListUserOrgsReturns([]model.Organization{{ID: uuid.New()}}, nil)
+		store.GetOrgMemberRoleReturns(model.RoleEditor, nil)
+
+		failures = map[string]model.LoginFailures{}
+		store.GetLoginFailuresStub = func(_ context.Context, key string) (model.LoginFailures, error) {
+			return failures[key], nil
+		}
+		store.RecordLoginFailureStub = func(_ context.Context, key string, failedAt, forgetBefore time.Time) (model.LoginFailures, error) {
+			recorded := model.LoginFailures{Key: key, Count: 1, LastFailedAt: failedAt}
+			if !failures[key].LastFailedAt.Before(forgetBefore) {
+				recorded.Count += failures[key].Count
+			}
+			return recorded, nil
+		}
+	})
+
+	authenticate := func() error {
+		_, err := svc.Authenticate(ctx, "john", password, uuid.Nil, "192.0.2.1")
+		return err
+	}
+
+	failedAgo := func(count int, ago time.Duration) model.LoginFailures {
+		return model.LoginFailures{Count: count, LastFailedAt: time.Now().Add(-ago)}
+	}
+
+	It("records failed logins of the username and the IP address", func() {
+		password = "wrong"
+		Expect(authenticate()).To(MatchError(service.ErrInvalidCredentials))
+
+		Expect(store.RecordLoginFailureCallCount()).To(Equal(2))
+		_, userKey, failedAt, forgetBefore := store.RecordLoginFailureArgsForCall(0)
+		Expect(userKey).To(Equal("user:john"))
+		Expect(failedAt).To(BeTemporally("~", time.Now(), time.Second))
+		Expect(forgetBefore).To(BeTemporally("~", time.Now().Add(-24*time.Hour), time.Second))
+		_, ipKey, _, _ := store.RecordLoginFailureArgsForCall(1)
+		Expect(ipKey).To(Equal("ip:192.0.2.1"))
+	})
+
+	It("counts the attempt as failed before the password is checked", func() {
+		store.GetByUsernameStub = func(context.Context, string) (*model.User, error) {
+			Expect(store.RecordLoginFailureCallCount()).To(Equal(2))
+			return &user, nil
+		}
+		Expect(authenticate()).To(Succeed())
+	})
+
+	It("takes back the failures counted for a successful login", func() {
+		previous := failedAgo(2, time.Minute)
+		failures["ip:192.0.2.1"] = previous
+		Expect(authenticate()).To(Succeed())
+
+		Expect(store.ReleaseLoginFailureCallCount()).To(Equal(2))
+		_, key, count, lastFailedAt := store.ReleaseLoginFailureArgsForCall(1)
+		Expect(key).To(Equal("ip:192.0.2.1"))
+		Expect(count).To(Equal(3))
+		Expect(lastFailedAt).To(Equal(previous.LastFailedAt))
+	})
+
+	It("keeps the failures counted for a wrong password", func() {
+		password = "wrong"
+		Expect(authenticate()).To(MatchError(service.ErrInvalidCredentials))
+		Expect(store.ReleaseLoginFailureCallCount()).To(BeZero())
+	})
+
+	It("delays logins while other attempts beyond the free ones are in flight", func() {
+		failures["user:john"] = failedAgo(3, time.Minute)
+		store.RecordLoginFailureStub = func(_ context.Context, key string, failedAt, _ time.Time) (model.LoginFailures, error) {
+			// Another attempt was counted since the failures were read.
+			return model.LoginFailures{Key: key, Count: failures[key].Count + 2, LastFailedAt: failedAt}, nil
+		}
+
+		err := authenticate()
+		Expect(err).To(MatchError(service.ErrTooManyLoginAttempts))
+		Expect(err.(*service.LoginLockedError).RetryAfter).To(BeNumerically("~", time.Second, time.Second))
+		Expect(store.GetByUsernameCallCount()).To(BeZero())
+		Expect(store.ReleaseLoginFailureCallCount()).To(Equal(2))
+	})
+
+	It("lets logins through while other attempts within the free ones are in flight", func() {
+		failures["user:john"] = failedAgo(1, time.Minute)
+		store.RecordLoginFailureStub = func(_ context.Context, key string, failedAt, _ time.Time) (model.LoginFailures, error) {
+			return model.LoginFailures{Key: key, Count: failures[key].Count + 2, LastFailedAt: failedAt}, nil
+		}
+		Expect(authenticate()).To(Succeed())
+	})
+
+	It("records failed logins of unknown users like wrong passwords", func() {
+		store.GetByUsernameReturns(nil, nil)
+		Expect(authenticate()).To(MatchError(service.ErrInvalidCredentials))
+		Expect(store.RecordLoginFailureCallCount()).To(Equal(2))
+	})
+
+	It("forgets the failures of the username after a successful login", func() {
+		failures["user:john"] = failedAgo(2, time.Minute)
+		Expect(authenticate()).To(Succeed())
+
+		Expect(store.ClearLoginFailuresCallCount()).To(Equal(1))
+		_, key := store.ClearLoginFailuresArgsForCall(0)
+		Expect(key).To(Equal("user:john"))
+	})
+
+	DescribeTable("delays logins after failed attempts",
+		func(key string, current model.LoginFailures, retryAfter time.Duration) {
+			failures[key] = current
+			err := authenticate()
+
+			var locked *service.LoginLockedError
+			Expect(err).To(BeAssignableToTypeOf(locked))
+			Expect(err).To(MatchError(service.ErrTooManyLoginAttempts))
+			locked = err.(*service.LoginLockedError)
+			Expect(locked.RetryAfter).To(BeNumerically("~", retryAfter, time.Second))
+			Expect(store.GetByUsernameCallCount()).To(BeZero())
+		},
+		Entry("by a second after the free attempts of the username", "user:john", failedAgo(4, 0), time.Second),
+		Entry("exponentially", "user:john", failedAgo(7, 0), 8*time.Second),
+		Entry("by the time left", "user:john", failedAgo(7, 3*time.Second), 5*time.Second),
+		Entry("by locking the username at the threshold", "user:john", failedAgo(10, time.Minute), 14*time.Minute),
+		Entry("by locking the IP address at its threshold", "ip:192.0.2.1", failedAgo(50, 0), 15*time.Minute),
+		Entry("by at most the lockout", "ip:192.0.2.1", failedAgo(49, 0), 15*time.Minute),
+	)
+
+	DescribeTable("lets logins through",
+		func(key string, current model.LoginFailures) {
+			failures[key] = current
+			Expect(authenticate()).To(Succeed())
+		},
+		Entry("within the free attempts of the username", "user:john", failedAgo(3, 0)),
+		Entry("within the free attempts of the IP address", "ip:192.0.2.1", failedAgo(10, 0)),
+		Entry("once the delay passed", "user:john", failedAgo(5, 3*time.Second)),
+		Entry("once the lockout passed", "user:john", failedAgo(10, 16*time.Minute)),
+		Entry("when the failures are forgotten", "user:john", failedAgo(20, 25*time.Hour)),
+	)
+
+	It("delays the password grant of the OAuth token endpoint", func() {
+		failures["ip:192.0.2.1"] = failedAgo(50, 0)
+		_, err := svc.OAuthToken(ctx, model.TokenRequest{
+			GrantType: model.GrantTypePassword,
+			Username:  "john",
+			Password:  password,
+		}, "192.0.2.1")
+		Expect(err).To(MatchError(service.ErrTooManyLoginAttempts))
+	})
+
+	Describe("UnlockUser", func() {
+		var orgID uuid.UUID
+
+		BeforeEach(func() {
+			orgID = uuid.New()
+			store.IsOrgMemberReturns(true, nil)
+		})
+
+		It("forgets the failures of the username", func() {
+			Expect(svc.UnlockUser(ctx, orgID, user.ID)).To(Succeed())
+
+			_, id := store.GetByIDArgsForCall(0)
+			Expect(id).To(Equal(user.ID))
+			_, key := store.ClearLoginFailuresArgsForCall(0)
+			Expect(key).To(Equal("user:john"))
+		})
+
+		It("returns ErrUserNotFound for unknown users", func() {
+			store.GetByIDReturns(nil, nil)
+			Expect(svc.UnlockUser(ctx, orgID, user.ID)).To(MatchError(model.ErrUserNotFound))
+			Expect(store.ClearLoginFailuresCallCount()).To(BeZero())
+		})
+	})
+})
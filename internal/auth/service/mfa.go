@@ -0,0 +1,441 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/totp"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	mfaChallengeTTL  = 5 * time.Minute
+	mfaChallengeType = "mfa_challenge"
+	mfaIssuer        = "Feature Flags API"
+
+	recoveryCodeCount = 10
+)
+
+var (
+	ErrMFARequired       = errors.New("multi-factor authentication is required")
+	ErrInvalidMFAToken   = errors.New("invalid or expired MFA token")
+	ErrInvalidMFACode    = errors.New("invalid MFA code")
+	ErrMFAEnabled        = errors.New("MFA is already enabled")
+	ErrMFANotEnabled     = errors.New("MFA is not enabled")
+	ErrMFANotEnrolled    = errors.New("MFA enrolment was not started")
+	ErrMFARequiredByRole = errors.New("MFA is required for the role of the user")
+)
+
+// MFARequiredError is returned by Authenticate instead of the tokens when the password was right
+// but the user still has to present a code.
+type MFARequiredError struct {
+	Challenge model.MFAChallenge
+}
+
+func (e *MFARequiredError) Error() string {
+	return ErrMFARequired.Error()
+}
+
+func (e *MFARequiredError) Unwrap() error {
+	return ErrMFARequired
+}
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// mfaChallenge returns the challenge the user has to answer before tokens for the organization are
+// issued, or nil when the user logs in with the password alone. Users the MFA policy requires MFA
+// from, who did not enroll yet, are sent a new secret to enroll with.
+func (a *Service) mfaChallenge(ctx context.Context, user model.User, orgID uuid.UUID) (*model.MFAChallenge, error) {
+	enabled, needed, err := a.needsMFA(ctx, user, orgID)
+	if err != nil || !needed {
+		return nil, err
+	}
+
+	challenge := &model.MFAChallenge{ExpiresIn: int(mfaChallengeTTL.Seconds())}
+	if !enabled {
+		enrollment, err := a.startEnrollment(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		challenge.Enrollment = &enrollment
+	}
+
+	now := time.Now()
+	challenge.MFAToken, err = a.jwtHelper.GenerateToken(jwt.MapClaims{
+		"iss": a.jwtHelper.Issuer(),
+		"sub": user.ID,
+		"typ": mfaChallengeType,
+		// The organization is not put into "org", the challenge must not pass as an access token.
+		"mfa_org": orgID.String(),
+		"iat":     now.Unix(),
+		"exp":     now.Add(mfaChallengeTTL).Unix(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate MFA token: %w", err)
+	}
+	return challenge, nil
+}
+
+// needsMFA reports whether the user enabled MFA and whether a login of the user to the organization
+// needs a code, because MFA is enabled or the MFA policy of the organization requires it for the role.
+func (a *Service) needsMFA(ctx context.Context, user model.User, orgID uuid.UUID) (enabled, needed bool, err error) {
+	mfa, err := a.store.GetUserMFA(ctx, user.ID)
+	if err != nil && !errors.Is(err, model.ErrMFANotFound) {
+		return false, false, fmt.Errorf("failed to get MFA: %w", err)
+	}
+	if err == nil && mfa.EnabledAt != nil {
+		return true, true, nil
+	}
+	required, err := a.mfaRequired(ctx, orgID, user.Role)
+	return false, required, err
+}
+
+func (a *Service) mfaRequired(ctx context.Context, orgID uuid.UUID, role model.RoleType) (bool, error) {
+	policy, err := a.store.GetMFAPolicy(ctx, orgID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get MFA policy: %w", err)
+	}
+	return slices.Contains(policy.RequiredRoles, role), nil
+}
+
+// CompleteMFALogin issues the tokens of a login once the code answering its challenge is verified.
+// A login that completes an enrolment enables MFA and returns the recovery codes along with the
+// tokens. Wrong codes count as failed logins.
+func (a *Service) CompleteMFALogin(ctx context.Context, req model.MFALoginRequest, ip string) (model.AuthResponse, error) {
+	userID, orgID, err := a.parseMFAToken(req.MFAToken)
+	if err != nil {
+		return model.AuthResponse{}, err
+	}
+	user, err := a.store.GetByID(ctx, userID)
+	if err != nil {
+		return model.AuthResponse{}, fmt.Errorf("failed to get user data: %w", err)
+	}
+	if user == nil {
+		return model.AuthResponse{}, ErrInvalidMFAToken
+	}
+	if user.Disabled {
+		return model.AuthResponse{}, ErrUserDisabled
+	}
+
+	mfa, err := a.store.GetUserMFA(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, model.ErrMFANotFound) {
+			return model.AuthResponse{}, ErrInvalidMFAToken
+		}
+		return model.AuthResponse{}, fmt.Errorf("failed to get MFA: %w", err)
+	}
+	if err := a.verifyMFACode(ctx, *user, mfa, req.Code, ip); err != nil {
+		return model.AuthResponse{}, err
+	}
+
+	var recoveryCodes []string
+	if mfa.EnabledAt == nil {
+		codes, err := a.enableMFA(ctx, user.ID)
+		if err != nil {
+			return model.AuthResponse{}, err
+		}
+		recoveryCodes = codes.RecoveryCodes
+	}
+
+	// The membership may have ended since the password was checked.
+	orgID, user.Role, err = a.tokenOrg(ctx, user.ID, orgID)
+	if err != nil {
+		return model.AuthResponse{}, err
+	}
+	resp, err := a.login(ctx, *user, orgID)
+	if err != nil {
+		return model.AuthResponse{}, err
+	}
+	resp.RecoveryCodes = recoveryCodes
+	return resp, nil
+}
+
+func (a *Service) parseMFAToken(token string) (uuid.UUID, uuid.UUID, error) {
+	claims, err := a.jwtHelper.ValidateToken(token)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, ErrInvalidMFAToken
+	}
+	if typ, _ := claims["typ"].(string); typ != mfaChallengeType {
+		return uuid.Nil, uuid.Nil, ErrInvalidMFAToken
+	}
+	subject, err := claims.GetSubject()
+	if err != nil {
+		return uuid.Nil, uuid.Nil, ErrInvalidMFAToken
+	}
+	userID, err := uuid.Parse(subject)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, ErrInvalidMFAToken
+	}
+	orgClaim, _ := claims["mfa_org"].(string)
+	orgID, err := uuid.Parse(orgClaim)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, ErrInvalidMFAToken
+	}
+	return userID, orgID, nil
+}
+
+// verifyMFACode accepts a TOTP code once or, when MFA is enabled, one of the recovery codes. It
+// is subject to the same delays and lockouts as the password, so that codes cannot be guessed.
+func (a *Service) verifyMFACode(ctx context.Context, user model.User, mfa model.UserMFA, code, ip string) error {
+	attempts, err := a.reserveLoginAttempt(ctx, loginFailureKeys(user.Username, ip), time.Now())
+	if err != nil {
+		return err
+	}
+
+	valid, err := a.checkMFACode(ctx, mfa, code)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return ErrInvalidMFACode
+	}
+	if err := a.releaseLoginAttempt(ctx, attempts); err != nil {
+		return err
+	}
+
+	return a.clearLoginFailures(ctx, user.Username)
+}
+
+func (a *Service) checkMFACode(ctx context.Context, mfa model.UserMFA, code string) (bool, error) {
+	code = strings.ReplaceAll(code, " ", "")
+	if step, ok := totp.Validate(mfa.Secret, code, time.Now()); ok {
+		used, err := a.store.UseMFAStep(ctx, mfa.UserID, step)
+		if err != nil {
+			return false, fmt.Errorf("failed to use MFA code: %w", err)
+		}
+		return used, nil
+	}
+	if mfa.EnabledAt == nil {
+		return false, nil
+	}
+
+	used, err := a.store.UseRecoveryCode(ctx, mfa.UserID, hashRecoveryCode(code))
+	if err != nil {
+		return false, fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	return used, nil
+}
+
+// MFAStatus tells whether the user enabled MFA and how many recovery codes are left.
+func (a *Service) MFAStatus(ctx context.Context, userID uuid.UUID) (model.MFAStatus, error) {
+	mfa, err := a.store.GetUserMFA(ctx, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrMFANotFound) {
+			return model.MFAStatus{}, nil
+		}
+		return model.MFAStatus{}, fmt.Errorf("failed to get MFA: %w", err)
+	}
+	if mfa.EnabledAt == nil {
+		return model.MFAStatus{}, nil
+	}
+
+	count, err := a.store.CountRecoveryCodes(ctx, userID)
+	if err != nil {
+		return model.MFAStatus{}, fmt.Errorf("failed to count recovery codes: %w", err)
+	}
+	return model.MFAStatus{Enabled: true, RecoveryCodesLeft: count}, nil
+}
+
+// EnrollMFA generates a new secret for the user, which enables MFA once VerifyMFA accepted a code
+// of it. Enrolling again replaces the secret of an enrolment that was not verified.
+func (a *Service) EnrollMFA(ctx context.Context, userID uuid.UUID) (model.MFAEnrollment, error) {
+	user, err := a.user(ctx, userID)
+	if err != nil {
+		return model.MFAEnrollment{}, err
+	}
+	mfa, err := a.store.GetUserMFA(ctx, userID)
+	if err != nil && !errors.Is(err, model.ErrMFANotFound) {
+		return model.MFAEnrollment{}, fmt.Errorf("failed to get MFA: %w", err)
+	}
+	if err == nil && mfa.EnabledAt != nil {
+		return model.MFAEnrollment{}, ErrMFAEnabled
+	}
+	return a.startEnrollment(ctx, user)
+}
+
+func (a *Service) startEnrollment(ctx context.Context, user model.User) (model.MFAEnrollment, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return model.MFAEnrollment{}, fmt.Errorf("failed to generate MFA secret: %w", err)
+	}
+	mfa := model.UserMFA{
+		UserID:    user.ID,
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	}
+	if err := a.store.SaveUserMFA(ctx, mfa); err != nil {
+		return model.MFAEnrollment{}, fmt.Errorf("failed to save MFA: %w", err)
+	}
+	return model.MFAEnrollment{
+		Secret: secret,
+		URI:    totp.URI(mfaIssuer, user.Username, secret),
+	}, nil
+}
+
+// VerifyMFA enables the MFA the user enrolled in once a code of the new secret is presented and
+// returns the recovery codes.
+func (a *Service) VerifyMFA(ctx context.Context, userID uuid.UUID, code string) (model.RecoveryCodes, error) {
+	user, mfa, err := a.userMFA(ctx, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrMFANotFound) {
+			return model.RecoveryCodes{}, ErrMFANotEnrolled
+		}
+		return model.RecoveryCodes{}, err
+	}
+	if mfa.EnabledAt != nil {
+		return model.RecoveryCodes{}, ErrMFAEnabled
+	}
+	if err := a.verifyMFACode(ctx, user, mfa, code, ""); err != nil {
+		return model.RecoveryCodes{}, err
+	}
+	return a.enableMFA(ctx, userID)
+}
+
+func (a *Service) enableMFA(ctx context.Context, userID uuid.UUID) (model.RecoveryCodes, error) {
+	codes, err := a.replaceRecoveryCodes(ctx, userID)
+	if err != nil {
+		return model.RecoveryCodes{}, err
+	}
+	if err := a.store.EnableUserMFA(ctx, userID, time.Now()); err != nil {
+		return model.RecoveryCodes{}, fmt.Errorf("failed to enable MFA: %w", err)
+	}
+	return codes, nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the user, the previous ones stop working.
+func (a *Service) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, code string) (model.RecoveryCodes, error) {
+	user, mfa, err := a.enabledMFA(ctx, userID)
+	if err != nil {
+		return model.RecoveryCodes{}, err
+	}
+	if err := a.verifyMFACode(ctx, user, mfa, code, ""); err != nil {
+		return model.RecoveryCodes{}, err
+	}
+	return a.replaceRecoveryCodes(ctx, userID)
+}
+
+// DisableMFA turns off the MFA of the user, unless the MFA policy of the organization requires it
+// for the role.
+func (a *Service) DisableMFA(ctx context.Context, orgID, userID uuid.UUID, code string) error {
+	user, mfa, err := a.enabledMFA(ctx, userID)
+	if err != nil {
+		return err
+	}
+	role, err := a.orgRole(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+	required, err := a.mfaRequired(ctx, orgID, role)
+	if err != nil {
+		return err
+	}
+	if required {
+		return ErrMFARequiredByRole
+	}
+	if err := a.verifyMFACode(ctx, user, mfa, code, ""); err != nil {
+		return err
+	}
+	if err := a.store.DeleteUserMFA(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete MFA: %w", err)
+	}
+	return nil
+}
+
+// ResetUserMFA deletes the MFA of a user of the organization who lost the authenticator and the
+// recovery codes. The user logs in with the password alone again or, when the MFA policy requires
+// it, enrolls anew.
+func (a *Service) ResetUserMFA(ctx context.Context, orgID, id uuid.UUID) error {
+	if err := a.ensureSoleOrgUser(ctx, orgID, id); err != nil {
+		return err
+	}
+	if err := a.store.DeleteUserMFA(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete MFA: %w", err)
+	}
+	return nil
+}
+
+func (a *Service) GetMFAPolicy(ctx context.Context, orgID uuid.UUID) (model.MFAPolicy, error) {
+	policy, err := a.store.GetMFAPolicy(ctx, orgID)
+	if err != nil {
+		return model.MFAPolicy{}, fmt.Errorf("failed to get MFA policy: %w", err)
+	}
+	return policy, nil
+}
+
+// UpdateMFAPolicy replaces the roles that require MFA in the organization. Users of those roles who
+// did not enable MFA are asked to enroll on their next login to the organization.
+func (a *Service) UpdateMFAPolicy(ctx context.Context, orgID uuid.UUID, policy model.MFAPolicy) (model.MFAPolicy, error) {
+	if err := a.store.SaveMFAPolicy(ctx, orgID, policy); err != nil {
+		return model.MFAPolicy{}, fmt.Errorf("failed to save MFA policy: %w", err)
+	}
+	return a.GetMFAPolicy(ctx, orgID)
+}
+
+func (a *Service) userMFA(ctx context.Context, userID uuid.UUID) (model.User, model.UserMFA, error) {
+	user, err := a.user(ctx, userID)
+	if err != nil {
+		return model.User{}, model.UserMFA{}, err
+	}
+	mfa, err := a.store.GetUserMFA(ctx, userID)
+	if err != nil {
+		return model.User{}, model.UserMFA{}, fmt.Errorf("failed to get MFA: %w", err)
+	}
+	return user, mfa, nil
+}
+
+func (a *Service) enabledMFA(ctx context.Context, userID uuid.UUID) (model.User, model.UserMFA, error) {
+	user, mfa, err := a.userMFA(ctx, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrMFANotFound) {
+			return model.User{}, model.UserMFA{}, ErrMFANotEnabled
+		}
+		return model.User{}, model.UserMFA{}, err
+	}
+	if mfa.EnabledAt == nil {
+		return model.User{}, model.UserMFA{}, ErrMFANotEnabled
+	}
+	return user, mfa, nil
+}
+
+func (a *Service) replaceRecoveryCodes(ctx context.Context, userID uuid.UUID) (model.RecoveryCodes, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return model.RecoveryCodes{}, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		codes[i], hashes[i] = code, hashRecoveryCode(code)
+	}
+	if err := a.store.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return model.RecoveryCodes{}, fmt.Errorf("failed to store recovery codes: %w", err)
+	}
+	return model.RecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+// newRecoveryCode returns 16 random base32 characters in two groups, e.g. "k3jd7a2m-x6q7e4tb".
+func newRecoveryCode() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))
+	return code[:8] + "-" + code[8:], nil
+}
+
+// hashRecoveryCode ignores the case and the dash, which are easily mistyped.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(code, "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
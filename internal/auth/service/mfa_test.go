@@ -0,0 +1,351 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service/servicefakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/totp"
+)
+
+const mfaSecret = "JBSWY3DPEHPK3PXP"
+
+var _ = Describe("MFA", func() {
+	var (
+		ctx       context.Context
+		store     *servicefakes.FakeStore
+		jwtHelper *servicefakes.FakeJWTHelper
+		svc       *service.Service
+		user      model.User
+		orgID     uuid.UUID
+		enabledAt time.Time
+		mfa       model.UserMFA
+	)
+
+	currentCode := func() string {
+		code, err := totp.Code(mfaSecret, totp.Step(time.Now()))
+		Expect(err).ToNot(HaveOccurred())
+		return code
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = &servicefakes.FakeStore{}
+		jwtHelper = &servicefakes.FakeJWTHelper{}
+		jwtHelper.GenerateTokenReturns("token", nil)
+		svc = service.NewService(store, &servicefakes.FakeDenylist{}, jwtHelper, 0)
+
+		hash, err := bcrypt.GenerateFromPassword([]byte("correct-horse"), bcrypt.MinCost)
+		Expect(err).ToNot(HaveOccurred())
+		user = model.User{ID: uuid.New(), Username: "john", Password: string(hash)}
+		orgID = uuid.New()
+		store.GetByUsernameReturns(&user, nil)
+		store.GetByIDReturns(&user, nil)
+		store.ListUserOrgsReturns([]model.Organization{{ID: orgID}}, nil)
+		store.IsOrgMemberReturns(true, nil)
+		store.GetOrgMemberRoleReturns(model.RoleEditor, nil)
+
+		enabledAt = time.Now().Add(-time.Hour)
+		mfa = model.UserMFA{UserID: user.ID, Secret: mfaSecret, EnabledAt: &enabledAt}
+		store.GetUserMFAReturns(mfa, nil)
+		store.UseMFAStepReturns(true, nil)
+		store.GetMFAPolicyReturns(model.MFAPolicy{RequiredRoles: []model.RoleType{}}, nil)
+	})
+
+	Describe("Authenticate", func() {
+		authenticate := func() error {
+			_, err := svc.Authenticate(ctx, "john", "correct-horse", uuid.Nil, "192.0.2.1")
+			return err
+		}
+
+		It("returns a challenge instead of the tokens when MFA is enabled", func() {
+			err := authenticate()
+
+			var mfaRequired *service.MFARequiredError
+			Expect(errors.As(err, &mfaRequired)).To(BeTrue())
+			Expect(err).To(MatchError(service.ErrMFARequired))
+			Expect(mfaRequired.Challenge.MFAToken).To(Equal("token"))
+			Expect(mfaRequired.Challenge.ExpiresIn).To(Equal(300))
+			Expect(mfaRequired.Challenge.Enrollment).To(BeNil())
+			Expect(store.CreateRefreshTokenCallCount()).To(BeZero())
+			Expect(store.ClearLoginFailuresCallCount()).To(BeZero())
+		})
+
+		It("signs a challenge that cannot pass as an access token", func() {
+			Expect(authenticate()).To(MatchError(service.ErrMFARequired))
+
+			claims, ok := jwtHelper.GenerateTokenArgsForCall(0).(jwt.MapClaims)
+			Expect(ok).To(BeTrue())
+			Expect(claims).To(HaveKeyWithValue("sub", user.ID))
+			Expect(claims).To(HaveKeyWithValue("typ", "mfa_challenge"))
+			Expect(claims).To(HaveKeyWithValue("mfa_org", orgID.String()))
+			Expect(claims).ToNot(HaveKey("jti"))
+			Expect(claims).ToNot(HaveKey("org"))
+			Expect(claims).ToNot(HaveKey("scopes"))
+		})
+
+		It("issues the tokens without MFA", func() {
+			store.GetUserMFAReturns(model.UserMFA{}, model.ErrMFANotFound)
+			Expect(authenticate()).To(Succeed())
+			Expect(store.CreateRefreshTokenCallCount()).To(Equal(1))
+		})
+
+		It("issues the tokens while the enrolment is not verified", func() {
+			mfa.EnabledAt = nil
+			store.GetUserMFAReturns(mfa, nil)
+			Expect(authenticate()).To(Succeed())
+		})
+
+		It("asks users whose role requires MFA to enroll", func() {
+			store.GetUserMFAReturns(model.UserMFA{}, model.ErrMFANotFound)
+			store.GetMFAPolicyReturns(model.MFAPolicy{RequiredRoles: []model.RoleType{model.RoleEditor}}, nil)
+
+			err := authenticate()
+
+			var mfaRequired *service.MFARequiredError
+			Expect(errors.As(err, &mfaRequired)).To(BeTrue())
+			enrollment := mfaRequired.Challenge.Enrollment
+			Expect(enrollment).ToNot(BeNil())
+			Expect(enrollment.URI).To(HavePrefix("otpauth://totp/"))
+			Expect(store.SaveUserMFACallCount()).To(Equal(1))
+			_, saved := store.SaveUserMFAArgsForCall(0)
+			Expect(saved.UserID).To(Equal(user.ID))
+			_, policyOrgID := store.GetMFAPolicyArgsForCall(0)
+			Expect(policyOrgID).To(Equal(orgID))
+			Expect(saved.Secret).To(Equal(enrollment.Secret))
+			Expect(saved.EnabledAt).To(BeNil())
+		})
+	})
+
+	Describe("CompleteMFALogin", func() {
+		var req model.MFALoginRequest
+
+		BeforeEach(func() {
+			jwtHelper.ValidateTokenReturns(jwt.MapClaims{
+				"sub":     user.ID.String(),
+				"typ":     "mfa_challenge",
+				"mfa_org": orgID.String(),
+			}, nil)
+			req = model.MFALoginRequest{MFAToken: "mfa-token", Code: currentCode()}
+		})
+
+		It("issues the tokens for the organization of the challenge", func() {
+			resp, err := svc.CompleteMFALogin(ctx, req, "192.0.2.1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.OrgID).To(Equal(orgID))
+			Expect(resp.RecoveryCodes).To(BeEmpty())
+			Expect(store.CreateRefreshTokenCallCount()).To(Equal(1))
+
+			_, userID, step := store.UseMFAStepArgsForCall(0)
+			Expect(userID).To(Equal(user.ID))
+			Expect(step).To(Equal(totp.Step(time.Now())))
+			_, key := store.ClearLoginFailuresArgsForCall(0)
+			Expect(key).To(Equal("user:john"))
+		})
+
+		It("rejects codes that were used already", func() {
+			store.UseMFAStepReturns(false, nil)
+			_, err := svc.CompleteMFALogin(ctx, req, "192.0.2.1")
+			Expect(err).To(MatchError(service.ErrInvalidMFACode))
+		})
+
+		It("records wrong codes as failed logins", func() {
+			req.Code = "wrong1"
+			_, err := svc.CompleteMFALogin(ctx, req, "192.0.2.1")
+			Expect(err).To(MatchError(service.ErrInvalidMFACode))
+			Expect(store.RecordLoginFailureCallCount()).To(Equal(2))
+			Expect(store.CreateRefreshTokenCallCount()).To(BeZero())
+		})
+
+		It("delays the codes after failed logins", func() {
+			store.GetLoginFailuresReturns(model.LoginFailures{Count: 10, LastFailedAt: time.Now()}, nil)
+			_, err := svc.CompleteMFALogin(ctx, req, "192.0.2.1")
+			Expect(err).To(MatchError(service.ErrTooManyLoginAttempts))
+			Expect(store.UseMFAStepCallCount()).To(BeZero())
+		})
+
+		It("accepts a recovery code once", func() {
+			req.Code = "ABCDEFGH-IJKLMNOP"
+			store.UseRecoveryCodeReturns(true, nil)
+
+			_, err := svc.CompleteMFALogin(ctx, req, "192.0.2.1")
+			Expect(err).ToNot(HaveOccurred())
+			_, userID, hash := store.UseRecoveryCodeArgsForCall(0)
+			Expect(userID).To(Equal(user.ID))
+			Expect(hash).To(HaveLen(64))
+
+			store.UseRecoveryCodeReturns(false, nil)
+			_, err = svc.CompleteMFALogin(ctx, req, "192.0.2.1")
+			Expect(err).To(MatchError(service.ErrInvalidMFACode))
+		})
+
+		It("enables MFA and returns the recovery codes when the login completes an enrolment", func() {
+			mfa.EnabledAt = nil
+			store.GetUserMFAReturns(mfa, nil)
+
+			resp, err := svc.CompleteMFALogin(ctx, req, "192.0.2.1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.RecoveryCodes).To(HaveLen(10))
+			Expect(store.EnableUserMFACallCount()).To(Equal(1))
+			_, userID, hashes := store.ReplaceRecoveryCodesArgsForCall(0)
+			Expect(userID).To(Equal(user.ID))
+			Expect(hashes).To(HaveLen(10))
+		})
+
+		It("does not accept recovery codes for an enrolment", func() {
+			mfa.EnabledAt = nil
+			store.GetUserMFAReturns(mfa, nil)
+			req.Code = "abcdefgh-ijklmnop"
+
+			_, err := svc.CompleteMFALogin(ctx, req, "192.0.2.1")
+			Expect(err).To(MatchError(service.ErrInvalidMFACode))
+			Expect(store.UseRecoveryCodeCallCount()).To(BeZero())
+		})
+
+		DescribeTable("rejects invalid challenges",
+			func(claims jwt.MapClaims, err error) {
+				jwtHelper.ValidateTokenReturns(claims, err)
+				_, err = svc.CompleteMFALogin(ctx, req, "192.0.2.1")
+				Expect(err).To(MatchError(service.ErrInvalidMFAToken))
+			},
+			Entry("that expired", nil, errors.New("token expired")),
+			Entry("that are access tokens", jwt.MapClaims{"sub": uuid.NewString(), "org": uuid.NewString()}, nil),
+			Entry("without organization", jwt.MapClaims{"sub": uuid.NewString(), "typ": "mfa_challenge"}, nil),
+		)
+
+		It("rejects challenges of users whose MFA was reset", func() {
+			store.GetUserMFAReturns(model.UserMFA{}, model.ErrMFANotFound)
+			_, err := svc.CompleteMFALogin(ctx, req, "192.0.2.1")
+			Expect(err).To(MatchError(service.ErrInvalidMFAToken))
+		})
+
+		It("rejects users who are no longer members of the organization", func() {
+			store.GetOrgMemberRoleReturns("", model.ErrMemberNotFound)
+			_, err := svc.CompleteMFALogin(ctx, req, "192.0.2.1")
+			Expect(err).To(MatchError(service.ErrNotOrgMember))
+		})
+	})
+
+	It("rejects the password grant of users who need MFA", func() {
+		_, err := svc.OAuthToken(ctx, model.TokenRequest{
+			GrantType: model.GrantTypePassword,
+			Username:  "john",
+			Password:  "correct-horse",
+		}, "192.0.2.1")
+		Expect(err).To(MatchError(service.ErrMFARequired))
+		Expect(store.ClearLoginFailuresCallCount()).To(BeZero())
+	})
+
+	Describe("self-service", func() {
+		It("reports the status of the MFA", func() {
+			store.CountRecoveryCodesReturns(7, nil)
+			status, err := svc.MFAStatus(ctx, user.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(Equal(model.MFAStatus{Enabled: true, RecoveryCodesLeft: 7}))
+
+			store.GetUserMFAReturns(model.UserMFA{}, model.ErrMFANotFound)
+			status, err = svc.MFAStatus(ctx, user.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(Equal(model.MFAStatus{}))
+		})
+
+		It("enrolls users without MFA", func() {
+			store.GetUserMFAReturns(model.UserMFA{}, model.ErrMFANotFound)
+			enrollment, err := svc.EnrollMFA(ctx, user.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enrollment.Secret).To(HaveLen(32))
+			Expect(enrollment.URI).To(ContainSubstring("secret=" + enrollment.Secret))
+			Expect(store.SaveUserMFACallCount()).To(Equal(1))
+		})
+
+		It("does not enroll users who enabled MFA", func() {
+			_, err := svc.EnrollMFA(ctx, user.ID)
+			Expect(err).To(MatchError(service.ErrMFAEnabled))
+			Expect(store.SaveUserMFACallCount()).To(BeZero())
+		})
+
+		It("enables MFA with a code of the enrolled secret", func() {
+			mfa.EnabledAt = nil
+			store.GetUserMFAReturns(mfa, nil)
+
+			codes, err := svc.VerifyMFA(ctx, user.ID, currentCode())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(codes.RecoveryCodes).To(HaveLen(10))
+			Expect(codes.RecoveryCodes[0]).To(MatchRegexp(`^[a-z2-7]{8}-[a-z2-7]{8}$`))
+			_, userID, _ := store.EnableUserMFAArgsForCall(0)
+			Expect(userID).To(Equal(user.ID))
+		})
+
+		It("does not verify without an enrolment", func() {
+			store.GetUserMFAReturns(model.UserMFA{}, model.ErrMFANotFound)
+			_, err := svc.VerifyMFA(ctx, user.ID, currentCode())
+			Expect(err).To(MatchError(service.ErrMFANotEnrolled))
+		})
+
+		It("replaces the recovery codes", func() {
+			codes, err := svc.RegenerateRecoveryCodes(ctx, user.ID, currentCode())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(codes.RecoveryCodes).To(HaveLen(10))
+			Expect(store.ReplaceRecoveryCodesCallCount()).To(Equal(1))
+		})
+
+		It("disables MFA with a valid code", func() {
+			Expect(svc.DisableMFA(ctx, orgID, user.ID, currentCode())).To(Succeed())
+			_, userID := store.DeleteUserMFAArgsForCall(0)
+			Expect(userID).To(Equal(user.ID))
+		})
+
+		It("does not disable MFA the policy requires for the role of the user in the organization", func() {
+			store.GetMFAPolicyReturns(model.MFAPolicy{RequiredRoles: []model.RoleType{model.RoleEditor}}, nil)
+			Expect(svc.DisableMFA(ctx, orgID, user.ID, currentCode())).To(MatchError(service.ErrMFARequiredByRole))
+			Expect(store.DeleteUserMFACallCount()).To(BeZero())
+			_, actualOrgID, actualUserID := store.GetOrgMemberRoleArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualUserID).To(Equal(user.ID))
+		})
+
+		It("does not disable MFA that is not enabled", func() {
+			store.GetUserMFAReturns(model.UserMFA{}, model.ErrMFANotFound)
+			Expect(svc.DisableMFA(ctx, orgID, user.ID, currentCode())).To(MatchError(service.ErrMFANotEnabled))
+		})
+	})
+
+	It("resets the MFA of a user", func() {
+		Expect(svc.ResetUserMFA(ctx, orgID, user.ID)).To(Succeed())
+		_, userID := store.DeleteUserMFAArgsForCall(0)
+		Expect(userID).To(Equal(user.ID))
+
+	})
+
+	It("does not reset the MFA of users of other organizations", func() {
+		store.IsOrgMemberReturns(false, nil)
+		Expect(svc.ResetUserMFA(ctx, orgID, user.ID)).To(MatchError(model.ErrUserNotFound))
+		_, actualOrgID, actualUserID := store.IsOrgMemberArgsForCall(0)
+		Expect(actualOrgID).To(Equal(orgID))
+		Expect(actualUserID).To(Equal(user.ID))
+		Expect(store.DeleteUserMFACallCount()).To(BeZero())
+	})
+
+	It("updates the MFA policy of the organization", func() {
+		policy := model.MFAPolicy{RequiredRoles: []model.RoleType{model.RoleAdmin, model.RoleEditor}}
+		store.GetMFAPolicyReturns(policy, nil)
+
+		updated, err := svc.UpdateMFAPolicy(ctx, orgID, policy)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated).To(Equal(policy))
+		_, savedOrgID, saved := store.SaveMFAPolicyArgsForCall(0)
+		Expect(savedOrgID).To(Equal(orgID))
+		Expect(saved).To(Equal(policy))
+		_, readOrgID := store.GetMFAPolicyArgsForCall(0)
+		Expect(readOrgID).To(Equal(orgID))
+	})
+})
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+const tokenTypeBearer = "Bearer"
+
+// RegisterOAuthClient registers a client of the organization acting for the given user. The returned
+// secret is the only time it is available in plain text.
+func (a *Service) RegisterOAuthClient(ctx context.Context, orgID, userID uuid.UUID, req model.OAuthClientRequest) (model.CreatedOAuthClient, error) {
+	secret, err := newClientSecret()
+	if err != nil {
+		return model.CreatedOAuthClient{}, fmt.Errorf("failed to generate client secret: %w", err)
+	}
+
+	client := model.OAuthClient{
+		ID:         uuid.New(),
+		OrgID:      orgID,
+		Name:       req.Name,
+		SecretHash: hashClientSecret(secret),
+		Scopes:     slices.Compact(slices.Sorted(slices.Values(req.Scopes))),
+		CreatedBy:  userID,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if err := a.store.CreateOAuthClient(ctx, client); err != nil {
+		return model.CreatedOAuthClient{}, fmt.Errorf("failed to create OAuth client: %w", err)
+	}
+	return model.CreatedOAuthClient{OAuthClient: client, ClientSecret: secret}, nil
+}
+
+func (a *Service) ListOAuthClients(ctx context.Context, orgID uuid.UUID) ([]model.OAuthClient, error) {
+	clients, err := a.store.ListOAuthClients(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OAuth clients: %w", err)
+	}
+	return clients, nil
+}
+
+func (a *Service) DeleteOAuthClient(ctx context.Context, orgID, id uuid.UUID) error {
+	if err := a.store.DeleteOAuthClient(ctx, orgID, id); err != nil {
+		if errors.Is(err, model.ErrOAuthClientNotFound) {
+			return model.ErrOAuthClientNotFound
+		}
+		return fmt.Errorf("failed to delete OAuth client: %w", err)
+	}
+	return nil
+}
+
+// OAuthToken issues an access token for the client credentials or the password grant. The scope of
+// the request narrows the scopes of the token, which default to everything the client or user is
+// allowed. No refresh token is issued, clients request a new access token instead. ip is the address
+// the request comes from, which counts towards the login failures of the password grant.
+func (a *Service) OAuthToken(ctx context.Context, req model.TokenRequest, ip string) (model.TokenResponse, error) {
+	switch req.GrantType {
+	case model.GrantTypeClientCredentials:
+		return a.clientCredentialsToken(ctx, req)
+	case model.GrantTypePassword:
+		return a.passwordToken(ctx, req, ip)
+	case "":
+		return model.TokenResponse{}, ErrInvalidTokenRequest
+	default:
+		return model.TokenResponse{}, ErrUnsupportedGrant
+	}
+}
+
+// clientCredentialsToken issues a token of the user who registered the client, which stops working
+// once that user is disabled or leaves the organization.
+func (a *Service) clientCredentialsToken(ctx context.Context, req model.TokenRequest) (model.TokenResponse, error) {
+	client, err := a.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+
+	user, err := a.store.GetByID(ctx, client.CreatedBy)
+	if err != nil {
+		return model.TokenResponse{}, fmt.Errorf("failed to get user data: %w", err)
+	}
+	if user == nil || user.Disabled {
+		return model.TokenResponse{}, ErrInvalidClient
+	}
+	isMember, err := a.store.IsOrgMember(ctx, client.OrgID, user.ID)
+	if err != nil {
+		return model.TokenResponse{}, fmt.Errorf("failed to check organization membership: %w", err)
+	}
+	if !isMember {
+		return model.TokenResponse{}, ErrInvalidClient
+	}
+
+	scopes, err := grantedScopes(client.Scopes, req.Scope)
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+	return a.signOAuthToken(user.ID, client.OrgID, scopes, &client)
+}
+
+// passwordToken issues a token of the user. Clients authenticate optionally, a token requested by
+// a client is limited to the scopes and the organization of the client.
+func (a *Service) passwordToken(ctx context.Context, req model.TokenRequest, ip string) (model.TokenResponse, error) {
+	if req.Username == "" || req.Password == "" {
+		return model.TokenResponse{}, ErrInvalidTokenRequest
+	}
+
+	var client *model.OAuthClient
+	if req.ClientID != "" {
+		authenticated, err := a.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+		if err != nil {
+			return model.TokenResponse{}, err
+		}
+		client = &authenticated
+	}
+
+	user, err := a.verifyCredentials(ctx, req.Username, req.Password, ip)
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+	orgID := uuid.Nil
+	if client != nil {
+		orgID = client.OrgID
+	}
+	orgID, user.Role, err = a.tokenOrg(ctx, user.ID, orgID)
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+	_, needed, err := a.needsMFA(ctx, *user, orgID)
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+	// The grant has no step for a code, users who need MFA log in on /auth.
+	if needed {
+		return model.TokenResponse{}, ErrMFARequired
+	}
+	if err := a.clearLoginFailures(ctx, user.Username); err != nil {
+		return model.TokenResponse{}, err
+	}
+
+	allowed, err := roleScopes(user.Role)
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+	if client != nil {
+		allowed = slices.DeleteFunc(allowed, func(scope string) bool {
+			return !slices.Contains(client.Scopes, scope)
+		})
+	}
+
+	scopes, err := grantedScopes(allowed, req.Scope)
+	if err != nil {
+		return model.TokenResponse{}, err
+	}
+	return a.signOAuthToken(user.ID, orgID, scopes, client)
+}
+
+func (a *Service) authenticateClient(ctx context.Context, clientID, secret string) (model.OAuthClient, error) {
+	id, err := uuid.Parse(clientID)
+	if err != nil || secret == "" {
+		return model.OAuthClient{}, ErrInvalidClient
+	}
+
+	client, err := a.store.GetOAuthClient(ctx, id)
+	if err != nil {
+		if errors.Is(err, model.ErrOAuthClientNotFound) {
+			return model.OAuthClient{}, ErrInvalidClient
+		}
+		return model.OAuthClient{}, fmt.Errorf("failed to get OAuth client: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(client.SecretHash), []byte(hashClientSecret(secret))) != 1 {
+		return model.OAuthClient{}, ErrInvalidClient
+	}
+	return client, nil
+}
+
+func (a *Service) signOAuthToken(userID, orgID uuid.UUID, scopes []string, client *model.OAuthClient) (model.TokenResponse, error) {
+	claims := accessTokenClaims(a.jwtHelper.Issuer(), userID, orgID, scopes, time.Now())
+	if client != nil {
+		claims["client_id"] = client.ID.String()
+	}
+	token, err := a.jwtHelper.GenerateToken(claims)
+	if err != nil {
+		return model.TokenResponse{}, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return model.TokenResponse{
+		AccessToken: token,
+		TokenType:   tokenTypeBearer,
+		ExpiresIn:   int(accessTokenTTL.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
+
+// grantedScopes returns the requested scopes, a space separated list, or all allowed scopes when
+// none are requested. Requesting a scope that is not allowed fails the whole request.
+func grantedScopes(allowed []string, requested string) ([]string, error) {
+	scopes := strings.Fields(requested)
+	if len(scopes) == 0 {
+		scopes = slices.Clone(allowed)
+	}
+	if len(scopes) == 0 {
+		return nil, ErrInvalidScope
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(allowed, scope) {
+			return nil, ErrInvalidScope
+		}
+	}
+	return slices.Compact(slices.Sorted(slices.Values(scopes))), nil
+}
+
+func newClientSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// The secrets are random, a plain SHA-256 is enough to keep a leaked table from being used.
+func hashClientSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
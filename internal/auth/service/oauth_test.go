@@ -0,0 +1,241 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service/servicefakes"
+)
+
+var _ = Describe("OAuth", func() {
+	var (
+		ctx       context.Context
+		store     *servicefakes.FakeStore
+		jwtHelper *servicefakes.FakeJWTHelper
+		svc       *service.Service
+		orgID     uuid.UUID
+		admin     model.User
+		client    model.CreatedOAuthClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = &servicefakes.FakeStore{}
+		jwtHelper = &servicefakes.FakeJWTHelper{}
+		jwtHelper.GenerateTokenReturns("signed-token", nil)
+		svc = service.NewService(store, &servicefakes.FakeDenylist{}, jwtHelper, 0)
+		orgID = uuid.New()
+		admin = model.User{ID: uuid.New(), Username: "admin"}
+
+		var err error
+		client, err = svc.RegisterOAuthClient(ctx, orgID, admin.ID, model.OAuthClientRequest{
+			Name:   "deployer",
+			Scopes: []string{"write:flags", "read:flags", "read:flags"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		store.GetOAuthClientReturns(client.OAuthClient, nil)
+		store.GetByIDReturns(&admin, nil)
+		store.IsOrgMemberReturns(true, nil)
+	})
+
+	issuedClaims := func() jwt.MapClaims {
+		Expect(jwtHelper.GenerateTokenCallCount()).To(Equal(1))
+		claims, ok := jwtHelper.GenerateTokenArgsForCall(0).(jwt.MapClaims)
+		Expect(ok).To(BeTrue())
+		return claims
+	}
+
+	Describe("RegisterOAuthClient", func() {
+		It("stores the hash of the secret and returns the secret once", func() {
+			Expect(client.ClientSecret).ToNot(BeEmpty())
+
+			_, stored := store.CreateOAuthClientArgsForCall(0)
+			Expect(stored).To(Equal(client.OAuthClient))
+			Expect(stored).To(MatchFields(IgnoreExtras, Fields{
+				"ID":         Not(Equal(uuid.Nil)),
+				"OrgID":      Equal(orgID),
+				"Name":       Equal("deployer"),
+				"SecretHash": HaveLen(64),
+				"Scopes":     Equal([]string{"read:flags", "write:flags"}),
+				"CreatedBy":  Equal(admin.ID),
+			}))
+			Expect(stored.SecretHash).ToNot(ContainSubstring(client.ClientSecret))
+		})
+	})
+
+	Describe("DeleteOAuthClient", func() {
+		It("returns ErrOAuthClientNotFound for unknown clients", func() {
+			store.DeleteOAuthClientReturns(model.ErrOAuthClientNotFound)
+			err := svc.DeleteOAuthClient(ctx, orgID, uuid.New())
+			Expect(err).To(MatchError(model.ErrOAuthClientNotFound))
+		})
+	})
+
+	Describe("OAuthToken", func() {
+		var req model.TokenRequest
+
+		Context("with the client credentials grant", func() {
+			BeforeEach(func() {
+				req = model.TokenRequest{
+					GrantType:    model.GrantTypeClientCredentials,
+					ClientID:     client.ID.String(),
+					ClientSecret: client.ClientSecret,
+				}
+			})
+
+			It("issues a token of the user who registered the client with the scopes of the client", func() {
+				resp, err := svc.OAuthToken(ctx, req, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp).To(Equal(model.TokenResponse{
+					AccessToken: "signed-token",
+					TokenType:   "Bearer",
+					ExpiresIn:   900,
+					Scope:       "read:flags write:flags",
+				}))
+
+				claims := issuedClaims()
+				Expect(claims).To(HaveKeyWithValue("sub", admin.ID))
+				Expect(claims).To(HaveKeyWithValue("org", orgID.String()))
+				Expect(claims).To(HaveKeyWithValue("client_id", client.ID.String()))
+				Expect(claims).To(HaveKeyWithValue("scopes", []string{"read:flags", "write:flags"}))
+				Expect(claims).To(HaveKey("jti"))
+				Expect(store.CreateRefreshTokenCallCount()).To(BeZero())
+			})
+
+			It("narrows the scopes to the requested ones", func() {
+				req.Scope = "read:flags"
+				resp, err := svc.OAuthToken(ctx, req, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Scope).To(Equal("read:flags"))
+				Expect(issuedClaims()).To(HaveKeyWithValue("scopes", []string{"read:flags"}))
+			})
+
+			It("rejects scopes the client is not allowed", func() {
+				req.Scope = "read:flags admin:flags"
+				_, err := svc.OAuthToken(ctx, req, "")
+				Expect(err).To(MatchError(service.ErrInvalidScope))
+				Expect(jwtHelper.GenerateTokenCallCount()).To(BeZero())
+			})
+
+			DescribeTable("rejects invalid client credentials",
+				func(modify func()) {
+					modify()
+					_, err := svc.OAuthToken(ctx, req, "")
+					Expect(err).To(MatchError(service.ErrInvalidClient))
+					Expect(jwtHelper.GenerateTokenCallCount()).To(BeZero())
+				},
+				Entry("with a wrong secret", func() { req.ClientSecret = "wrong" }),
+				Entry("without a secret", func() { req.ClientSecret = "" }),
+				Entry("with a malformed client ID", func() { req.ClientID = "deployer" }),
+				Entry("of an unknown client", func() {
+					store.GetOAuthClientReturns(model.OAuthClient{}, model.ErrOAuthClientNotFound)
+				}),
+				Entry("of a disabled user", func() { admin.Disabled = true }),
+				Entry("of a deleted user", func() { store.GetByIDReturns(nil, nil) }),
+				Entry("of a user who left the organization", func() { store.IsOrgMemberReturns(false, nil) }),
+			)
+
+			It("returns an error when the client cannot be loaded", func() {
+				store.GetOAuthClientReturns(model.OAuthClient{}, errors.New("database error"))
+				_, err := svc.OAuthToken(ctx, req, "")
+				Expect(err).To(MatchError(ContainSubstring("database error")))
+			})
+		})
+
+		Context("with the password grant", func() {
+			var editor model.User
+
+			BeforeEach(func() {
+				hashedPassword, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+				Expect(err).ToNot(HaveOccurred())
+				editor = model.User{ID: uuid.New(), Username: "john", Password: string(hashedPassword)}
+				store.GetByUsernameReturns(&editor, nil)
+				store.GetOrgMemberRoleReturns(model.RoleEditor, nil)
+				store.ListUserOrgsReturns([]model.Organization{{ID: model.DefaultOrgID}}, nil)
+
+				req = model.TokenRequest{GrantType: model.GrantTypePassword, Username: "john", Password: "secret"}
+			})
+
+			It("issues a token with the scopes of the user", func() {
+				resp, err := svc.OAuthToken(ctx, req, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Scope).To(Equal("read:flags review:flags write:flags"))
+
+				claims := issuedClaims()
+				Expect(claims).To(HaveKeyWithValue("sub", editor.ID))
+				Expect(claims).To(HaveKeyWithValue("org", model.DefaultOrgID.String()))
+				Expect(claims).ToNot(HaveKey("client_id"))
+			})
+
+			It("narrows the scopes to the requested ones", func() {
+				req.Scope = "read:flags"
+				resp, err := svc.OAuthToken(ctx, req, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Scope).To(Equal("read:flags"))
+			})
+
+			It("rejects scopes the user is not allowed", func() {
+				req.Scope = "admin:users"
+				_, err := svc.OAuthToken(ctx, req, "")
+				Expect(err).To(MatchError(service.ErrInvalidScope))
+			})
+
+			It("rejects wrong passwords", func() {
+				req.Password = "wrong"
+				_, err := svc.OAuthToken(ctx, req, "")
+				Expect(err).To(MatchError(service.ErrInvalidCredentials))
+			})
+
+			It("requires the username and password", func() {
+				req.Password = ""
+				_, err := svc.OAuthToken(ctx, req, "")
+				Expect(err).To(MatchError(service.ErrInvalidTokenRequest))
+			})
+
+			Context("when a client authenticates", func() {
+				BeforeEach(func() {
+					req.ClientID = client.ID.String()
+					req.ClientSecret = client.ClientSecret
+				})
+
+				It("limits the token to the scopes and organization of the client", func() {
+					resp, err := svc.OAuthToken(ctx, req, "")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resp.Scope).To(Equal("read:flags write:flags"))
+
+					_, actualOrgID, actualUserID := store.GetOrgMemberRoleArgsForCall(0)
+					Expect(actualOrgID).To(Equal(orgID))
+					Expect(actualUserID).To(Equal(editor.ID))
+					claims := issuedClaims()
+					Expect(claims).To(HaveKeyWithValue("org", orgID.String()))
+					Expect(claims).To(HaveKeyWithValue("client_id", client.ID.String()))
+				})
+
+				It("rejects a wrong client secret", func() {
+					req.ClientSecret = "wrong"
+					_, err := svc.OAuthToken(ctx, req, "")
+					Expect(err).To(MatchError(service.ErrInvalidClient))
+				})
+			})
+		})
+
+		It("rejects unsupported grant types", func() {
+			_, err := svc.OAuthToken(ctx, model.TokenRequest{GrantType: "authorization_code"}, "")
+			Expect(err).To(MatchError(service.ErrUnsupportedGrant))
+		})
+
+		It("requires a grant type", func() {
+			_, err := svc.OAuthToken(ctx, model.TokenRequest{}, "")
+			Expect(err).To(MatchError(service.ErrInvalidTokenRequest))
+		})
+	})
+})
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+// CreateOrg creates an organization with the given user as its first member.
+func (a *Service) CreateOrg(ctx context.Context, userID uuid.UUID, req model.OrganizationRequest) (model.Organization, error) {
+	org := model.Organization{
+		ID:        uuid.New(),
+		Name:      req.Name,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := a.store.CreateOrg(ctx, org, userID); err != nil {
+		return model.Organization{}, fmt.Errorf("failed to create organization: %w", err)
+	}
+	return org, nil
+}
+
+func (a *Service) ListUserOrgs(ctx context.Context, userID uuid.UUID) ([]model.Organization, error) {
+	orgs, err := a.store.ListUserOrgs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	return orgs, nil
+}
+
+func (a *Service) GetOrg(ctx context.Context, userID, orgID uuid.UUID) (model.Organization, error) {
+	if err := a.ensureOrgMember(ctx, userID, orgID); err != nil {
+		return model.Organization{}, err
+	}
+
+	org, err := a.store.GetOrgByID(ctx, orgID)
+	if err != nil {
+		return model.Organization{}, fmt.Errorf("failed to get organization: %w", err)
+	}
+	return org, nil
+}
+
+func (a *Service) ListOrgMembers(ctx context.Context, userID, orgID uuid.UUID) ([]model.OrganizationMember, error) {
+	if err := a.ensureOrgMember(ctx, userID, orgID); err != nil {
+		return nil, err
+	}
+
+	members, err := a.store.ListOrgMembers(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	return members, nil
+}
+
+// InviteOrgMember invites the member to the organization with the role. The member joins once they
+// accept the invitation, inviting them again replaces the role. Only admins of the organization
+// invite members.
+func (a *Service) InviteOrgMember(ctx context.Context, userID, orgID, memberID uuid.UUID, role model.RoleType) (model.OrgInvitation, error) {
+	if err := a.ensureOrgAdmin(ctx, userID, orgID); err != nil {
+		return model.OrgInvitation{}, err
+	}
+
+	isMember, err := a.store.IsOrgMember(ctx, orgID, memberID)
+	if err != nil {
+		return model.OrgInvitation{}, fmt.Errorf("failed to check organization membership: %w", err)
+	}
+	if isMember {
+		return model.OrgInvitation{}, model.ErrMemberExists
+	}
+
+	invitation := model.OrgInvitation{
+		OrgID:     orgID,
+		UserID:    memberID,
+		Role:      role,
+		InvitedBy: userID,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := a.store.CreateOrgInvitation(ctx, invitation); err != nil {
+		return model.OrgInvitation{}, fmt.Errorf("failed to invite organization member: %w", err)
+	}
+	return invitation, nil
+}
+
+// RemoveOrgMember removes the member from the organization. Only admins of the organization remove
+// members.
+func (a *Service) RemoveOrgMember(ctx context.Context, userID, orgID, memberID uuid.UUID) error {
+	if err := a.ensureOrgAdmin(ctx, userID, orgID); err != nil {
+		return err
+	}
+
+	if err := a.store.RemoveOrgMember(ctx, orgID, memberID); err != nil {
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+	return nil
+}
+
+// ListInvitations returns the pending invitations of the user.
+func (a *Service) ListInvitations(ctx context.Context, userID uuid.UUID) ([]model.OrgInvitation, error) {
+	invitations, err := a.store.ListUserInvitations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+	return invitations, nil
+}
+
+// AcceptOrgInvitation makes the user a member of the organization with the role of the invitation.
+func (a *Service) AcceptOrgInvitation(ctx context.Context, userID, orgID uuid.UUID) error {
+	if err := a.store.AcceptOrgInvitation(ctx, orgID, userID); err != nil {
+		return fmt.Errorf("failed to accept invitation: %w", err)
+	}
+	return nil
+}
+
+func (a *Service) DeclineOrgInvitation(ctx context.Context, userID, orgID uuid.UUID) error {
+	if err := a.store.DeleteOrgInvitation(ctx, orgID, userID); err != nil {
+		return fmt.Errorf("failed to decline invitation: %w", err)
+	}
+	return nil
+}
+
+// ensureOrgMember hides organizations the user does not belong to by reporting them as not found.
+func (a *Service) ensureOrgMember(ctx context.Context, userID, orgID uuid.UUID) error {
+	isMember, err := a.store.IsOrgMember(ctx, orgID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check organization membership: %w", err)
+	}
+	if !isMember {
+		return fmt.Errorf("failed to get organization: %w", model.ErrOrgNotFound)
+	}
+	return nil
+}
+
+// ensureOrgAdmin checks that the user is an admin of the organization, organizations the user does
+// not belong to are reported as not found.
+func (a *Service) ensureOrgAdmin(ctx context.Context, userID, orgID uuid.UUID) error {
+	role, err := a.orgRole(ctx, orgID, userID)
+	if err != nil {
+		if errors.Is(err, ErrNotOrgMember) {
+			return fmt.Errorf("failed to get organization: %w", model.ErrOrgNotFound)
+		}
+		return err
+	}
+	if role != model.RoleAdmin {
+		return ErrNotOrgAdmin
+	}
+	return nil
+}
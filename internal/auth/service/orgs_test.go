@@ -0,0 +1,184 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service/servicefakes"
+)
+
+var _ = Describe("Organizations", func() {
+	var (
+		ctx    context.Context
+		store  *servicefakes.FakeStore
+		svc    *service.Service
+		userID uuid.UUID
+		orgID  uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = &servicefakes.FakeStore{}
+		svc = service.NewService(store, &servicefakes.FakeDenylist{}, &servicefakes.FakeJWTHelper{}, 0)
+		userID = uuid.New()
+		orgID = uuid.New()
+		store.IsOrgMemberReturns(true, nil)
+	})
+
+	Describe("CreateOrg", func() {
+		It("creates the organization with the user as its member", func() {
+			org, err := svc.CreateOrg(ctx, userID, model.OrganizationRequest{Name: "payments"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(org.ID).ToNot(Equal(uuid.Nil))
+			Expect(org.Name).To(Equal("payments"))
+
+			Expect(store.CreateOrgCallCount()).To(Equal(1))
+			_, stored, ownerID := store.CreateOrgArgsForCall(0)
+			Expect(stored).To(Equal(org))
+			Expect(ownerID).To(Equal(userID))
+		})
+
+		It("returns ErrOrgExists when the name is taken", func() {
+			store.CreateOrgReturns(model.ErrOrgExists)
+			_, err := svc.CreateOrg(ctx, userID, model.OrganizationRequest{Name: "payments"})
+			Expect(err).To(MatchError(model.ErrOrgExists))
+		})
+	})
+
+	Describe("GetOrg", func() {
+		It("returns the organization", func() {
+			store.GetOrgByIDReturns(model.Organization{ID: orgID, Name: "payments"}, nil)
+			org, err := svc.GetOrg(ctx, userID, orgID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(org.ID).To(Equal(orgID))
+		})
+
+		It("hides organizations the user does not belong to", func() {
+			store.IsOrgMemberReturns(false, nil)
+			_, err := svc.GetOrg(ctx, userID, orgID)
+			Expect(err).To(MatchError(model.ErrOrgNotFound))
+			Expect(store.GetOrgByIDCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("InviteOrgMember", func() {
+		var memberID uuid.UUID
+
+		BeforeEach(func() {
+			memberID = uuid.New()
+			store.GetOrgMemberRoleReturns(model.RoleAdmin, nil)
+			store.IsOrgMemberReturns(false, nil)
+		})
+
+		It("invites the member with the role instead of adding them", func() {
+			invitation, err := svc.InviteOrgMember(ctx, userID, orgID, memberID, model.RoleEditor)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(invitation).To(MatchFields(IgnoreExtras, Fields{
+				"OrgID":     Equal(orgID),
+				"UserID":    Equal(memberID),
+				"Role":      Equal(model.RoleEditor),
+				"InvitedBy": Equal(userID),
+			}))
+
+			Expect(store.CreateOrgInvitationCallCount()).To(Equal(1))
+			_, stored := store.CreateOrgInvitationArgsForCall(0)
+			Expect(stored).To(Equal(invitation))
+			Expect(store.AddOrgMemberCallCount()).To(BeZero())
+		})
+
+		It("checks the role of the user in the organization of the invitation", func() {
+			_, err := svc.InviteOrgMember(ctx, userID, orgID, memberID, model.RoleEditor)
+			Expect(err).ToNot(HaveOccurred())
+			_, checkedOrg, checkedUser := store.GetOrgMemberRoleArgsForCall(0)
+			Expect(checkedOrg).To(Equal(orgID))
+			Expect(checkedUser).To(Equal(userID))
+		})
+
+		It("does not invite members to organizations the user does not belong to", func() {
+			store.GetOrgMemberRoleReturns("", model.ErrMemberNotFound)
+			_, err := svc.InviteOrgMember(ctx, userID, orgID, memberID, model.RoleEditor)
+			Expect(err).To(MatchError(model.ErrOrgNotFound))
+			Expect(store.CreateOrgInvitationCallCount()).To(BeZero())
+		})
+
+		It("does not let members who are not admins of the organization invite", func() {
+			store.GetOrgMemberRoleReturns(model.RoleEditor, nil)
+			_, err := svc.InviteOrgMember(ctx, userID, orgID, memberID, model.RoleViewer)
+			Expect(err).To(MatchError(service.ErrNotOrgAdmin))
+			Expect(store.CreateOrgInvitationCallCount()).To(BeZero())
+		})
+
+		It("returns ErrMemberExists for members of the organization", func() {
+			store.IsOrgMemberReturns(true, nil)
+			_, err := svc.InviteOrgMember(ctx, userID, orgID, memberID, model.RoleEditor)
+			Expect(err).To(MatchError(model.ErrMemberExists))
+			Expect(store.CreateOrgInvitationCallCount()).To(BeZero())
+		})
+
+		It("returns ErrUserNotFound for unknown users", func() {
+			store.CreateOrgInvitationReturns(model.ErrUserNotFound)
+			_, err := svc.InviteOrgMember(ctx, userID, orgID, memberID, model.RoleEditor)
+			Expect(err).To(MatchError(model.ErrUserNotFound))
+		})
+	})
+
+	Describe("RemoveOrgMember", func() {
+		BeforeEach(func() {
+			store.GetOrgMemberRoleReturns(model.RoleAdmin, nil)
+		})
+
+		It("does not remove members of organizations the user does not belong to", func() {
+			store.GetOrgMemberRoleReturns("", model.ErrMemberNotFound)
+			Expect(svc.RemoveOrgMember(ctx, userID, orgID, uuid.New())).To(MatchError(model.ErrOrgNotFound))
+			Expect(store.RemoveOrgMemberCallCount()).To(BeZero())
+		})
+
+		It("does not let members who are not admins of the organization remove members", func() {
+			store.GetOrgMemberRoleReturns(model.RoleViewer, nil)
+			Expect(svc.RemoveOrgMember(ctx, userID, orgID, uuid.New())).To(MatchError(service.ErrNotOrgAdmin))
+			Expect(store.RemoveOrgMemberCallCount()).To(BeZero())
+		})
+
+		It("returns ErrMemberNotFound for users outside the organization", func() {
+			store.RemoveOrgMemberReturns(model.ErrMemberNotFound)
+			Expect(svc.RemoveOrgMember(ctx, userID, orgID, uuid.New())).To(MatchError(model.ErrMemberNotFound))
+		})
+	})
+
+	Describe("Invitations", func() {
+		It("lists the invitations of the user", func() {
+			invitations := []model.OrgInvitation{{OrgID: orgID, UserID: userID, Role: model.RoleViewer}}
+			store.ListUserInvitationsReturns(invitations, nil)
+			listed, err := svc.ListInvitations(ctx, userID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(listed).To(Equal(invitations))
+			_, listedFor := store.ListUserInvitationsArgsForCall(0)
+			Expect(listedFor).To(Equal(userID))
+		})
+
+		It("accepts the invitation of the user", func() {
+			Expect(svc.AcceptOrgInvitation(ctx, userID, orgID)).To(Succeed())
+			_, acceptedOrg, acceptedUser := store.AcceptOrgInvitationArgsForCall(0)
+			Expect(acceptedOrg).To(Equal(orgID))
+			Expect(acceptedUser).To(Equal(userID))
+		})
+
+		It("returns ErrInvitationNotFound when accepting without an invitation", func() {
+			store.AcceptOrgInvitationReturns(model.ErrInvitationNotFound)
+			Expect(svc.AcceptOrgInvitation(ctx, userID, orgID)).To(MatchError(model.ErrInvitationNotFound))
+		})
+
+		It("declines the invitation of the user", func() {
+			Expect(svc.DeclineOrgInvitation(ctx, userID, orgID)).To(Succeed())
+			_, declinedOrg, declinedUser := store.DeleteOrgInvitationArgsForCall(0)
+			Expect(declinedOrg).To(Equal(orgID))
+			Expect(declinedUser).To(Equal(userID))
+		})
+	})
+})
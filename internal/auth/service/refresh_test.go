@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service/servicefakes"
+)
+
+var _ = Describe("Refresh", func() {
+	var (
+		ctx       context.Context
+		store     *servicefakes.FakeStore
+		jwtHelper *servicefakes.FakeJWTHelper
+		svc       *service.Service
+		user      model.User
+		current   model.RefreshToken
+		resp      model.AuthResponse
+		errAction error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = &servicefakes.FakeStore{}
+		jwtHelper = &servicefakes.FakeJWTHelper{}
+		svc = service.NewService(store, &servicefakes.FakeDenylist{}, jwtHelper, 0)
+
+		user = model.User{ID: uuid.New(), Username: "john"}
+		current = model.RefreshToken{
+			Hash:      "hash",
+			FamilyID:  uuid.New(),
+			UserID:    user.ID,
+			OrgID:     uuid.New(),
+			ExpiresAt: time.Now().Add(time.Hour),
+			CreatedAt: time.Now().Add(-time.Hour),
+		}
+
+		store.GetRefreshTokenReturns(current, nil)
+		store.GetByIDReturns(&user, nil)
+		store.GetOrgMemberRoleReturns(model.RoleViewer, nil)
+		jwtHelper.GenerateTokenReturns("new-token", nil)
+	})
+
+	JustBeforeEach(func() {
+		resp, errAction = svc.Refresh(ctx, "refresh-token")
+	})
+
+	It("issues a new token for the user and organization of the refresh token", func() {
+		Expect(errAction).ToNot(HaveOccurred())
+		Expect(resp.Token).To(Equal("new-token"))
+		Expect(resp.OrgID).To(Equal(current.OrgID))
+
+		claims := jwtHelper.GenerateTokenArgsForCall(0).(jwt.MapClaims)
+		Expect(claims).To(HaveKeyWithValue("sub", user.ID))
+		Expect(claims).To(HaveKeyWithValue("org", current.OrgID.String()))
+		Expect(claims).To(HaveKeyWithValue("scopes", []string{"read:flags"}))
+	})
+
+	It("issues the token with the current role of the user in the organization", func() {
+		_, checkedOrg, checkedUser := store.GetOrgMemberRoleArgsForCall(0)
+		Expect(checkedOrg).To(Equal(current.OrgID))
+		Expect(checkedUser).To(Equal(user.ID))
+	})
+
+	It("looks the token up by its hash", func() {
+		_, hash := store.GetRefreshTokenArgsForCall(0)
+		Expect(hash).To(HaveLen(64))
+		Expect(hash).ToNot(Equal("refresh-token"))
+	})
+
+	It("rotates the refresh token within its family", func() {
+		Expect(store.RotateRefreshTokenCallCount()).To(Equal(1))
+		_, hash, next := store.RotateRefreshTokenArgsForCall(0)
+		_, lookedUp := store.GetRefreshTokenArgsForCall(0)
+		Expect(hash).To(Equal(lookedUp))
+		Expect(next.FamilyID).To(Equal(current.FamilyID))
+		Expect(next.UserID).To(Equal(user.ID))
+		Expect(next.OrgID).To(Equal(current.OrgID))
+		Expect(next.Hash).ToNot(Equal(hash))
+
+		Expect(resp.RefreshToken).ToNot(BeEmpty())
+		Expect(resp.RefreshToken).ToNot(Equal("refresh-token"))
+	})
+
+	Context("when the refresh token is unknown", func() {
+		BeforeEach(func() {
+			store.GetRefreshTokenReturns(model.RefreshToken{}, model.ErrRefreshTokenNotFound)
+		})
+
+		It("returns ErrInvalidRefreshToken", func() {
+			Expect(errAction).To(MatchError(service.ErrInvalidRefreshToken))
+		})
+	})
+
+	Context("when the refresh token expired", func() {
+		BeforeEach(func() {
+			current.ExpiresAt = time.Now().Add(-time.Minute)
+			store.GetRefreshTokenReturns(current, nil)
+		})
+
+		It("returns ErrInvalidRefreshToken", func() {
+			Expect(errAction).To(MatchError(service.ErrInvalidRefreshToken))
+			Expect(store.RotateRefreshTokenCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the family was revoked", func() {
+		BeforeEach(func() {
+			revokedAt := time.Now().Add(-time.Minute)
+			current.RevokedAt = &revokedAt
+			store.GetRefreshTokenReturns(current, nil)
+		})
+
+		It("returns ErrInvalidRefreshToken", func() {
+			Expect(errAction).To(MatchError(service.ErrInvalidRefreshToken))
+			Expect(jwtHelper.GenerateTokenCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the refresh token was already used", func() {
+		BeforeEach(func() {
+			usedAt := time.Now().Add(-time.Minute)
+			current.UsedAt = &usedAt
+			store.GetRefreshTokenReturns(current, nil)
+		})
+
+		It("revokes the whole family", func() {
+			Expect(errAction).To(MatchError(service.ErrRefreshTokenReused))
+			Expect(store.RevokeRefreshTokenFamilyCallCount()).To(Equal(1))
+			_, familyID, _ := store.RevokeRefreshTokenFamilyArgsForCall(0)
+			Expect(familyID).To(Equal(current.FamilyID))
+			Expect(jwtHelper.GenerateTokenCallCount()).To(BeZero())
+		})
+
+		Context("and revoking fails", func() {
+			BeforeEach(func() {
+				store.RevokeRefreshTokenFamilyReturns(ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+
+	Context("when another request rotated the token first", func() {
+		BeforeEach(func() {
+			store.RotateRefreshTokenReturns(model.ErrRefreshTokenUsed)
+		})
+
+		It("revokes the whole family", func() {
+			Expect(errAction).To(MatchError(service.ErrRefreshTokenReused))
+			_, familyID, _ := store.RevokeRefreshTokenFamilyArgsForCall(0)
+			Expect(familyID).To(Equal(current.FamilyID))
+			Expect(resp.Token).To(BeEmpty())
+		})
+	})
+
+	Context("when the user no longer exists", func() {
+		BeforeEach(func() {
+			store.GetByIDReturns(nil, nil)
+		})
+
+		It("returns ErrInvalidRefreshToken", func() {
+			Expect(errAction).To(MatchError(service.ErrInvalidRefreshToken))
+		})
+	})
+
+	Context("when the user was disabled", func() {
+		BeforeEach(func() {
+			user.Disabled = true
+		})
+
+		It("returns ErrInvalidRefreshToken", func() {
+			Expect(errAction).To(MatchError(service.ErrInvalidRefreshToken))
+			Expect(store.RotateRefreshTokenCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the user left the organization", func() {
+		BeforeEach(func() {
+			store.GetOrgMemberRoleReturns("", model.ErrMemberNotFound)
+		})
+
+		It("returns ErrNotOrgMember", func() {
+			Expect(errAction).To(MatchError(service.ErrNotOrgMember))
+			Expect(store.RotateRefreshTokenCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the refresh token cannot be read", func() {
+		BeforeEach(func() {
+			store.GetRefreshTokenReturns(model.RefreshToken{}, ErrDatabaseError)
+		})
+
+		It("returns an error", func() {
+			Expect(errAction).To(MatchError(ErrDatabaseError))
+		})
+	})
+})
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+// Logout revokes the access token and, when given, the refresh token issued together with it.
+func (a *Service) Logout(ctx context.Context, token model.AccessToken, refreshToken string) error {
+	now := time.Now()
+	err := a.denylist.Revoke(ctx, model.TokenRevocation{
+		ID:        uuid.New(),
+		TokenID:   token.ID,
+		OrgID:     token.OrgID,
+		UserID:    token.UserID,
+		ExpiresAt: token.ExpiresAt,
+		RevokedBy: token.UserID,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	if refreshToken == "" {
+		return nil
+	}
+	current, err := a.store.GetRefreshToken(ctx, hashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, model.ErrRefreshTokenNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get refresh token: %w", err)
+	}
+	// Refresh tokens of other users are left alone, they cannot be logged out this way.
+	if current.UserID != token.UserID {
+		return nil
+	}
+	if err := a.store.RevokeRefreshTokenFamily(ctx, current.FamilyID, now); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+	return nil
+}
+
+// RevokeToken revokes a single access token of the organization.
+func (a *Service) RevokeToken(ctx context.Context, userID, orgID uuid.UUID, token model.AccessToken) error {
+	if token.OrgID != orgID {
+		return ErrTokenNotFound
+	}
+
+	err := a.denylist.Revoke(ctx, model.TokenRevocation{
+		ID:        uuid.New(),
+		TokenID:   token.ID,
+		OrgID:     orgID,
+		UserID:    token.UserID,
+		ExpiresAt: token.ExpiresAt,
+		RevokedBy: userID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+// RevokeUserTokens revokes every access and refresh token issued to a member of the organization
+// so far.
+func (a *Service) RevokeUserTokens(ctx context.Context, userID, orgID, memberID uuid.UUID) error {
+	isMember, err := a.store.IsOrgMember(ctx, orgID, memberID)
+	if err != nil {
+		return fmt.Errorf("failed to check organization membership: %w", err)
+	}
+	if !isMember {
+		return model.ErrMemberNotFound
+	}
+
+	return a.revokeIssuedBefore(ctx, userID, orgID, memberID, time.Now())
+}
+
+// RevokeTokensIssuedBefore revokes every access and refresh token of the organization issued before
+// the given time.
+func (a *Service) RevokeTokensIssuedBefore(ctx context.Context, userID, orgID uuid.UUID, issuedBefore time.Time) error {
+	if issuedBefore.After(time.Now()) {
+		return ErrRevocationInFuture
+	}
+
+	return a.revokeIssuedBefore(ctx, userID, orgID, uuid.Nil, issuedBefore)
+}
+
+func (a *Service) revokeIssuedBefore(ctx context.Context, userID, orgID, memberID uuid.UUID, issuedBefore time.Time) error {
+	now := time.Now()
+	if err := a.store.RevokeRefreshTokensIssuedBefore(ctx, orgID, memberID, issuedBefore, now); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
+	err := a.denylist.Revoke(ctx, model.TokenRevocation{
+		ID:           uuid.New(),
+		OrgID:        orgID,
+		UserID:       memberID,
+		IssuedBefore: issuedBefore,
+		// No access token issued before the cutoff outlives it by more than its lifetime, which is
+		// longer for the tokens of OIDC providers.
+		ExpiresAt: issuedBefore.Add(max(accessTokenTTL, a.externalTokenLifetime)),
+		RevokedBy: userID,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke tokens: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package service_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service/servicefakes"
+)
+
+var _ = Describe("Revocation", func() {
+	var (
+		ctx      context.Context
+		store    *servicefakes.FakeStore
+		denylist *servicefakes.FakeDenylist
+		svc      *service.Service
+		userID   uuid.UUID
+		orgID    uuid.UUID
+		token    model.AccessToken
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = &servicefakes.FakeStore{}
+		denylist = &servicefakes.FakeDenylist{}
+		svc = service.NewService(store, denylist, &servicefakes.FakeJWTHelper{}, 0)
+
+		userID = uuid.New()
+		orgID = uuid.New()
+		token = model.AccessToken{
+			ID:        uuid.NewString(),
+			UserID:    uuid.New(),
+			OrgID:     orgID,
+			IssuedAt:  time.Now().Add(-time.Minute),
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+	})
+
+	Describe("Logout", func() {
+		var (
+			refreshToken string
+			familyID     uuid.UUID
+			errAction    error
+		)
+
+		BeforeEach(func() {
+			refreshToken = ""
+			familyID = uuid.New()
+			store.GetRefreshTokenReturns(model.RefreshToken{FamilyID: familyID, UserID: token.UserID}, nil)
+		})
+
+		JustBeforeEach(func() {
+			errAction = svc.Logout(ctx, token, refreshToken)
+		})
+
+		It("revokes the access token until it expires", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			_, revocation := denylist.RevokeArgsForCall(0)
+			Expect(revocation.TokenID).To(Equal(token.ID))
+			Expect(revocation.OrgID).To(Equal(orgID))
+			Expect(revocation.RevokedBy).To(Equal(token.UserID))
+			Expect(revocation.ExpiresAt).To(Equal(token.ExpiresAt))
+			Expect(store.GetRefreshTokenCallCount()).To(BeZero())
+		})
+
+		Context("with the refresh token", func() {
+			BeforeEach(func() {
+				refreshToken = "refresh-token"
+			})
+
+			It("revokes its family", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				_, revokedFamilyID, _ := store.RevokeRefreshTokenFamilyArgsForCall(0)
+				Expect(revokedFamilyID).To(Equal(familyID))
+			})
+
+			Context("of another user", func() {
+				BeforeEach(func() {
+					store.GetRefreshTokenReturns(model.RefreshToken{FamilyID: uuid.New(), UserID: uuid.New()}, nil)
+				})
+
+				It("leaves it alone", func() {
+					Expect(errAction).ToNot(HaveOccurred())
+					Expect(store.RevokeRefreshTokenFamilyCallCount()).To(BeZero())
+				})
+			})
+
+			Context("that is unknown", func() {
+				BeforeEach(func() {
+					store.GetRefreshTokenReturns(model.RefreshToken{}, model.ErrRefreshTokenNotFound)
+				})
+
+				It("still logs out", func() {
+					Expect(errAction).ToNot(HaveOccurred())
+					Expect(denylist.RevokeCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the revocation cannot be stored", func() {
+			BeforeEach(func() {
+				denylist.RevokeReturns(ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+
+	Describe("RevokeToken", func() {
+		It("revokes the token on behalf of the caller", func() {
+			Expect(svc.RevokeToken(ctx, userID, orgID, token)).To(Succeed())
+			_, revocation := denylist.RevokeArgsForCall(0)
+			Expect(revocation.TokenID).To(Equal(token.ID))
+			Expect(revocation.RevokedBy).To(Equal(userID))
+		})
+
+		It("does not revoke tokens of other organizations", func() {
+			token.OrgID = uuid.New()
+			Expect(svc.RevokeToken(ctx, userID, orgID, token)).To(MatchError(service.ErrTokenNotFound))
+			Expect(denylist.RevokeCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("RevokeUserTokens", func() {
+		var memberID uuid.UUID
+
+		BeforeEach(func() {
+			memberID = uuid.New()
+			store.IsOrgMemberReturns(true, nil)
+		})
+
+		It("revokes the access and refresh tokens of the member", func() {
+			Expect(svc.RevokeUserTokens(ctx, userID, orgID, memberID)).To(Succeed())
+
+			_, actualOrgID, actualUserID, issuedBefore, _ := store.RevokeRefreshTokensIssuedBeforeArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualUserID).To(Equal(memberID))
+
+			_, revocation := denylist.RevokeArgsForCall(0)
+			Expect(revocation.TokenID).To(BeEmpty())
+			Expect(revocation.OrgID).To(Equal(orgID))
+			Expect(revocation.UserID).To(Equal(memberID))
+			Expect(revocation.IssuedBefore).To(Equal(issuedBefore))
+			Expect(revocation.ExpiresAt).To(Equal(issuedBefore.Add(15 * time.Minute)))
+		})
+
+		It("keeps the revocation until the tokens of OIDC providers expire too", func() {
+			svc = service.NewService(store, denylist, &servicefakes.FakeJWTHelper{}, time.Hour)
+			Expect(svc.RevokeUserTokens(ctx, userID, orgID, memberID)).To(Succeed())
+
+			_, _, _, issuedBefore, _ := store.RevokeRefreshTokensIssuedBeforeArgsForCall(0)
+			_, revocation := denylist.RevokeArgsForCall(0)
+			Expect(revocation.ExpiresAt).To(Equal(issuedBefore.Add(time.Hour)))
+		})
+
+		It("rejects users outside the organization", func() {
+			store.IsOrgMemberReturns(false, nil)
+			Expect(svc.RevokeUserTokens(ctx, userID, orgID, memberID)).To(MatchError(model.ErrMemberNotFound))
+			Expect(denylist.RevokeCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("RevokeTokensIssuedBefore", func() {
+		It("revokes the tokens of the whole organization", func() {
+			issuedBefore := time.Now().Add(-time.Minute)
+			Expect(svc.RevokeTokensIssuedBefore(ctx, userID, orgID, issuedBefore)).To(Succeed())
+
+			_, _, actualUserID, _, _ := store.RevokeRefreshTokensIssuedBeforeArgsForCall(0)
+			Expect(actualUserID).To(Equal(uuid.Nil))
+
+			_, revocation := denylist.RevokeArgsForCall(0)
+			Expect(revocation.UserID).To(Equal(uuid.Nil))
+			Expect(revocation.IssuedBefore).To(Equal(issuedBefore))
+		})
+
+		It("rejects times in the future", func() {
+			err := svc.RevokeTokensIssuedBefore(ctx, userID, orgID, time.Now().Add(time.Hour))
+			Expect(err).To(MatchError(service.ErrRevocationInFuture))
+			Expect(store.RevokeRefreshTokensIssuedBeforeCallCount()).To(BeZero())
+		})
+
+		It("does not record the revocation when the refresh tokens cannot be revoked", func() {
+			store.RevokeRefreshTokensIssuedBeforeReturns(ErrDatabaseError)
+			err := svc.RevokeTokensIssuedBefore(ctx, userID, orgID, time.Now())
+			Expect(err).To(MatchError(ErrDatabaseError))
+			Expect(denylist.RevokeCallCount()).To(BeZero())
+		})
+	})
+})
@@ -2,21 +2,43 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
-// TODO: Move it as an environment variable. Adjust the tests.
-const ttl = 24 * time.Hour
+// TODO: Move them as environment variables. Adjust the tests.
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 30 * 24 * time.Hour
+)
 
 var (
-	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrInvalidUserRole    = errors.New("invalid user role")
+	ErrInvalidCredentials  = errors.New("invalid credentials")
+	ErrInvalidUserRole     = errors.New("invalid user role")
+	ErrNotOrgMember        = errors.New("user is not a member of the organization")
+	ErrNotOrgAdmin         = errors.New("user is not an admin of the organization")
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token was already used, the session was revoked")
+	ErrTokenNotFound       = errors.New("token not found")
+	ErrRevocationInFuture  = errors.New("issued_before must not be in the future")
+	ErrUserDisabled        = errors.New("user is disabled")
+	ErrSelfManagement      = errors.New("users cannot change the role of, disable or delete themselves")
+	ErrUserInOtherOrgs     = errors.New("user is a member of other organizations too")
+	ErrClientAPIKeyScopes  = errors.New("client API keys can only read the flags")
+	ErrInvalidClient       = errors.New("invalid client credentials")
+	ErrInvalidScope        = errors.New("requested scope is not allowed")
+	ErrInvalidTokenRequest = errors.New("invalid token request")
+	ErrUnsupportedGrant    = errors.New("unsupported grant type")
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -25,6 +47,71 @@ var (
 //counterfeiter:generate . Store
 type Store interface {
 	GetByUsername(context.Context, string) (*model.User, error)
+	GetByID(context.Context, uuid.UUID) (*model.User, error)
+
+	ListUsers(context.Context, uuid.UUID) ([]model.User, error)
+	CreateUser(context.Context, model.User, uuid.UUID) error
+	SetUserDisabled(context.Context, uuid.UUID, bool) error
+	UpdateUserPassword(context.Context, uuid.UUID, string) error
+	DeleteUser(context.Context, uuid.UUID) error
+
+	ListUserOrgs(context.Context, uuid.UUID) ([]model.Organization, error)
+	IsOrgMember(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+	GetOrgMemberRole(context.Context, uuid.UUID, uuid.UUID) (model.RoleType, error)
+	CreateOrg(context.Context, model.Organization, uuid.UUID) error
+	GetOrgByID(context.Context, uuid.UUID) (model.Organization, error)
+	ListOrgMembers(context.Context, uuid.UUID) ([]model.OrganizationMember, error)
+	AddOrgMember(context.Context, uuid.UUID, uuid.UUID, model.RoleType) error
+	UpdateOrgMemberRole(context.Context, uuid.UUID, uuid.UUID, model.RoleType) error
+	RemoveOrgMember(context.Context, uuid.UUID, uuid.UUID) error
+
+	CreateOrgInvitation(context.Context, model.OrgInvitation) error
+	ListUserInvitations(context.Context, uuid.UUID) ([]model.OrgInvitation, error)
+	AcceptOrgInvitation(context.Context, uuid.UUID, uuid.UUID) error
+	DeleteOrgInvitation(context.Context, uuid.UUID, uuid.UUID) error
+
+	CreateRefreshToken(context.Context, model.RefreshToken) error
+	GetRefreshToken(context.Context, string) (model.RefreshToken, error)
+	RotateRefreshToken(context.Context, string, model.RefreshToken) error
+	RevokeRefreshTokenFamily(context.Context, uuid.UUID, time.Time) error
+	RevokeRefreshTokensIssuedBefore(context.Context, uuid.UUID, uuid.UUID, time.Time, time.Time) error
+
+	CreateAPIKey(context.Context, model.APIKey) error
+	ListAPIKeys(context.Context, uuid.UUID) ([]model.APIKey, error)
+	RevokeAPIKey(context.Context, uuid.UUID, uuid.UUID, time.Time) error
+
+	CreateOAuthClient(context.Context, model.OAuthClient) error
+	ListOAuthClients(context.Context, uuid.UUID) ([]model.OAuthClient, error)
+	GetOAuthClient(context.Context, uuid.UUID) (model.OAuthClient, error)
+	DeleteOAuthClient(context.Context, uuid.UUID, uuid.UUID) error
+
+	GetLoginFailures(context.Context, string) (model.LoginFailures, error)
+	RecordLoginFailure(context.Context, string, time.Time, time.Time) (model.LoginFailures, error)
+	ReleaseLoginFailure(context.Context, string, int, time.Time) error
+	ClearLoginFailures(context.Context, string) error
+
+	GetUserMFA(context.Context, uuid.UUID) (model.UserMFA, error)
+	SaveUserMFA(context.Context, model.UserMFA) error
+	EnableUserMFA(context.Context, uuid.UUID, time.Time) error
+	UseMFAStep(context.Context, uuid.UUID, int64) (bool, error)
+	DeleteUserMFA(context.Context, uuid.UUID) error
+	ReplaceRecoveryCodes(context.Context, uuid.UUID, []string) error
+	UseRecoveryCode(context.Context, uuid.UUID, string) (bool, error)
+	CountRecoveryCodes(context.Context, uuid.UUID) (int, error)
+	GetMFAPolicy(context.Context, uuid.UUID) (model.MFAPolicy, error)
+	SaveMFAPolicy(context.Context, uuid.UUID, model.MFAPolicy) error
+
+	CreateExternalIdentity(context.Context, model.ExternalIdentity) error
+	ListExternalIdentities(context.Context, uuid.UUID) ([]model.ExternalIdentity, error)
+	DeleteExternalIdentity(context.Context, uuid.UUID, uuid.UUID) error
+}
+
+//go:generate gowrap gen -g -p ./ -i Denylist -t ../../observability/templates/otel_trace.tmpl -o ./wrapped/trace/denylist.go
+//go:generate gowrap gen -g -p ./ -i Denylist -t ../../observability/templates/otel_metric.tmpl -o ./wrapped/metric/denylist.go
+//counterfeiter:generate . Denylist
+type Denylist interface {
+	Revoke(context.Context, model.TokenRevocation) error
+	IsRevoked(jwt.MapClaims) bool
 }
 
 //go:generate gowrap gen -g -p ./ -i JWTHelper -t ../../observability/templates/otel_trace.tmpl -o ./wrapped/trace/jwt_helper.go
@@ -32,51 +119,265 @@ type Store interface {
 //counterfeiter:generate . JWTHelper
 type JWTHelper interface {
 	GenerateToken(jwt.Claims) (string, error)
+	ValidateToken(string) (jwt.MapClaims, error)
+	Issuer() string
 }
 
 type Service struct {
 	store     Store
+	denylist  Denylist
 	jwtHelper JWTHelper
+	// externalTokenLifetime is the longest lifetime of the accepted tokens of OIDC providers.
+	externalTokenLifetime time.Duration
 }
 
-func NewService(store Store, jwtHelper JWTHelper) *Service {
+// NewService returns the auth service. externalTokenLifetime is the longest lifetime of the tokens
+// of OIDC providers the API accepts, zero without providers, which revocations have to outlast.
+func NewService(store Store, denylist Denylist, jwtHelper JWTHelper, externalTokenLifetime time.Duration) *Service {
 	return &Service{
-		store:     store,
-		jwtHelper: jwtHelper,
+		store:                 store,
+		denylist:              denylist,
+		jwtHelper:             jwtHelper,
+		externalTokenLifetime: externalTokenLifetime,
+	}
+}
+
+// Authenticate issues an access and a refresh token for the given organization, or for the first
+// organization of the user when orgID is nil. ip is the address the login comes from. Users who
+// need MFA get an MFARequiredError with the challenge to complete the login with instead.
+func (a *Service) Authenticate(ctx context.Context, username, password string, orgID uuid.UUID, ip string) (model.AuthResponse, error) {
+	user, err := a.verifyCredentials(ctx, username, password, ip)
+	if err != nil {
+		return model.AuthResponse{}, err
+	}
+
+	orgID, user.Role, err = a.tokenOrg(ctx, user.ID, orgID)
+	if err != nil {
+		return model.AuthResponse{}, err
+	}
+
+	challenge, err := a.mfaChallenge(ctx, *user, orgID)
+	if err != nil {
+		return model.AuthResponse{}, err
+	}
+	if challenge != nil {
+		return model.AuthResponse{}, &MFARequiredError{Challenge: *challenge}
+	}
+	if err := a.clearLoginFailures(ctx, username); err != nil {
+		return model.AuthResponse{}, err
 	}
+
+	return a.login(ctx, *user, orgID)
 }
 
-func (a *Service) Authenticate(ctx context.Context, username, password string) (string, error) {
+// login issues the tokens of a new session of the user.
+func (a *Service) login(ctx context.Context, user model.User, orgID uuid.UUID) (model.AuthResponse, error) {
+	resp, refreshToken, err := a.issueTokens(user, orgID, uuid.New())
+	if err != nil {
+		return model.AuthResponse{}, err
+	}
+	if err := a.store.CreateRefreshToken(ctx, refreshToken); err != nil {
+		return model.AuthResponse{}, fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	return resp, nil
+}
+
+// verifyCredentials checks the password of the user, unless too many logins failed for the
+// username or from the IP address lately. The attempt counts as failed until the password is found
+// to be right. Unknown usernames are treated like wrong passwords, down to the time it takes to
+// reject them. The failures are cleared by the caller once the login succeeded, a right password
+// alone must not reset the failed MFA codes.
+func (a *Service) verifyCredentials(ctx context.Context, username, password, ip string) (*model.User, error) {
+	attempts, err := a.reserveLoginAttempt(ctx, loginFailureKeys(username, ip), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := a.store.GetByUsername(ctx, username)
 	if err != nil {
-		return "", fmt.Errorf("failed to get user data: %w", err)
+		return nil, fmt.Errorf("failed to get user data: %w", err)
+	}
+	passwordHash := dummyPasswordHash()
+	if user != nil {
+		passwordHash = []byte(user.Password)
+	}
+	if err := bcrypt.CompareHashAndPassword(passwordHash, []byte(password)); err != nil || user == nil {
+		return nil, ErrInvalidCredentials
+	}
+	if err := a.releaseLoginAttempt(ctx, attempts); err != nil {
+		return nil, err
+	}
+
+	if user.Disabled {
+		return nil, ErrUserDisabled
+	}
+	return user, nil
+}
+
+// Refresh exchanges the refresh token for a new access token and rotates it. Presenting a refresh
+// token that was already rotated revokes every token issued since the login it originates from.
+func (a *Service) Refresh(ctx context.Context, refreshToken string) (model.AuthResponse, error) {
+	hash := hashRefreshToken(refreshToken)
+	current, err := a.store.GetRefreshToken(ctx, hash)
+	if err != nil {
+		if errors.Is(err, model.ErrRefreshTokenNotFound) {
+			return model.AuthResponse{}, ErrInvalidRefreshToken
+		}
+		return model.AuthResponse{}, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	if current.RevokedAt != nil || !time.Now().Before(current.ExpiresAt) {
+		return model.AuthResponse{}, ErrInvalidRefreshToken
+	}
+	if current.UsedAt != nil {
+		return model.AuthResponse{}, a.revokeFamily(ctx, current.FamilyID)
+	}
+
+	user, err := a.store.GetByID(ctx, current.UserID)
+	if err != nil {
+		return model.AuthResponse{}, fmt.Errorf("failed to get user data: %w", err)
+	}
+	if user == nil || user.Disabled {
+		return model.AuthResponse{}, ErrInvalidRefreshToken
+	}
+
+	// The role may have changed since the last refresh, the new token carries the current one.
+	user.Role, err = a.orgRole(ctx, current.OrgID, user.ID)
+	if err != nil {
+		return model.AuthResponse{}, err
+	}
+
+	resp, next, err := a.issueTokens(*user, current.OrgID, current.FamilyID)
+	if err != nil {
+		return model.AuthResponse{}, err
+	}
+	if err := a.store.RotateRefreshToken(ctx, hash, next); err != nil {
+		// Another request rotated the token first, so it was presented twice.
+		if errors.Is(err, model.ErrRefreshTokenUsed) {
+			return model.AuthResponse{}, a.revokeFamily(ctx, current.FamilyID)
+		}
+		return model.AuthResponse{}, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (a *Service) revokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	if err := a.store.RevokeRefreshTokenFamily(ctx, familyID, time.Now()); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+	return ErrRefreshTokenReused
+}
+
+// issueTokens signs an access token for the user with the scopes of their role in the organization
+// and creates the refresh token of the given family,
+// which is returned for storing while the response carries its plain value.
+func (a *Service) issueTokens(user model.User, orgID, familyID uuid.UUID) (model.AuthResponse, model.RefreshToken, error) {
+	scopes, err := roleScopes(user.Role)
+	if err != nil {
+		return model.AuthResponse{}, model.RefreshToken{}, err
 	}
-	if user == nil {
-		return "", ErrInvalidCredentials
+
+	now := time.Now()
+	token, err := a.jwtHelper.GenerateToken(accessTokenClaims(a.jwtHelper.Issuer(), user.ID, orgID, scopes, now))
+	if err != nil {
+		return model.AuthResponse{}, model.RefreshToken{}, fmt.Errorf("failed to generate token: %w", err)
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
-		return "", ErrInvalidCredentials
+	plain, err := newRefreshToken()
+	if err != nil {
+		return model.AuthResponse{}, model.RefreshToken{}, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
 
-	claims := jwt.MapClaims{
-		"sub": user.ID,
-		"exp": time.Now().Add(ttl).Unix(),
+	resp := model.AuthResponse{
+		Token:        token,
+		RefreshToken: plain,
+		ExpiresIn:    int(accessTokenTTL.Seconds()),
+		OrgID:        orgID,
+	}
+	refreshToken := model.RefreshToken{
+		Hash:      hashRefreshToken(plain),
+		FamilyID:  familyID,
+		UserID:    user.ID,
+		OrgID:     orgID,
+		ExpiresAt: now.Add(refreshTokenTTL),
+		CreatedAt: now,
 	}
+	return resp, refreshToken, nil
+}
 
-	switch user.Role {
+func accessTokenClaims(issuer string, userID, orgID uuid.UUID, scopes []string, now time.Time) jwt.MapClaims {
+	return jwt.MapClaims{
+		"jti": uuid.NewString(),
+		"iss": issuer,
+		"sub": userID,
+		"org": orgID.String(),
+		"iat": now.Unix(),
+		// Revocations by issue time are checked in milliseconds, so that a token issued right after
+		// the revocation in the same second stays valid.
+		"iat_ms": now.UnixMilli(),
+		"exp":    now.Add(accessTokenTTL).Unix(),
+		"scopes": scopes,
+	}
+}
+
+func roleScopes(role model.RoleType) ([]string, error) {
+	switch role {
+	case model.RoleAdmin:
+		return []string{"read:flags", "write:flags", "review:flags", "admin:flags", "admin:orgs", "admin:tokens", "admin:users", "admin:apikeys", "admin:clients"}, nil
 	case model.RoleEditor:
-		claims["scopes"] = []string{"read:flags", "write:flags"}
+		return []string{"read:flags", "write:flags", "review:flags"}, nil
 	case model.RoleViewer:
-		claims["scopes"] = []string{"read:flags"}
+		return []string{"read:flags"}, nil
 	default:
-		return "", ErrInvalidUserRole
+		return nil, ErrInvalidUserRole
 	}
+}
 
-	token, err := a.jwtHelper.GenerateToken(claims)
+func newRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Only the hash of a refresh token is stored, a leaked table cannot be used to refresh sessions.
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// tokenOrg returns the organization the tokens of the user are issued for, the first organization of
+// the user when orgID is nil, together with the role of the user in it.
+func (a *Service) tokenOrg(ctx context.Context, userID, orgID uuid.UUID) (uuid.UUID, model.RoleType, error) {
+	if orgID == uuid.Nil {
+		orgs, err := a.store.ListUserOrgs(ctx, userID)
+		if err != nil {
+			return uuid.Nil, "", fmt.Errorf("failed to list organizations: %w", err)
+		}
+		if len(orgs) == 0 {
+			return uuid.Nil, "", ErrNotOrgMember
+		}
+		orgID = orgs[0].ID
+	}
+
+	role, err := a.orgRole(ctx, orgID, userID)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate token: %w", err)
+		return uuid.Nil, "", err
 	}
+	return orgID, role, nil
+}
 
-	return token, nil
+// orgRole returns the role of the user in the organization.
+func (a *Service) orgRole(ctx context.Context, orgID, userID uuid.UUID) (model.RoleType, error) {
+	role, err := a.store.GetOrgMemberRole(ctx, orgID, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrMemberNotFound) {
+			return "", ErrNotOrgMember
+		}
+		return "", fmt.Errorf("failed to get organization role: %w", err)
+	}
+	return role, nil
 }
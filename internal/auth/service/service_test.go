@@ -3,7 +3,9 @@ package service_test
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -28,17 +30,19 @@ var _ = Describe("Service", func() {
 	BeforeEach(func() {
 		store = &servicefakes.FakeStore{}
 		jwtHelper = &servicefakes.FakeJWTHelper{}
-		svc = service.NewService(store, jwtHelper)
+		svc = service.NewService(store, &servicefakes.FakeDenylist{}, jwtHelper, 0)
 	})
 	Describe("Authenticate", func() {
 		var (
-			token     string
+			resp      model.AuthResponse
 			user      model.User
+			org       model.Organization
 			ctx       context.Context
 			errAction error
 
 			username string
 			password string
+			orgID    uuid.UUID
 		)
 
 		BeforeEach(func() {
@@ -54,10 +58,14 @@ var _ = Describe("Service", func() {
 				ID:       uuid.New(),
 				Username: username,
 				Password: string(hashedPassword),
-				Role:     model.RoleEditor,
 			}
 
 			store.GetByUsernameReturns(&user, nil)
+			store.GetOrgMemberRoleReturns(model.RoleEditor, nil)
+
+			org = model.Organization{ID: uuid.New(), Name: "payments"}
+			orgID = uuid.Nil
+			store.ListUserOrgsReturns([]model.Organization{org, {ID: uuid.New(), Name: "search"}}, nil)
 		})
 
 		ItSucceeds := func() {
@@ -67,34 +75,92 @@ var _ = Describe("Service", func() {
 		}
 
 		JustBeforeEach(func() {
-			token, errAction = svc.Authenticate(ctx, username, password)
+			resp, errAction = svc.Authenticate(ctx, username, password, orgID, "192.0.2.1")
 		})
 
 		Context("when authentication succeeds for RoleEditor", func() {
 			BeforeEach(func() {
 				jwtHelper.GenerateTokenReturns("valid-editor-token", nil)
+				jwtHelper.IssuerReturns("https://flags.example.com")
 			})
 
 			ItSucceeds()
 			It("returns a token with editor scopes", func() {
-				Expect(token).To(Equal("valid-editor-token"))
+				Expect(resp.Token).To(Equal("valid-editor-token"))
+
+				claims := jwtHelper.GenerateTokenArgsForCall(0)
+				Expect(claims).To(HaveKeyWithValue("sub", user.ID))
+				Expect(claims).To(HaveKeyWithValue("scopes", []string{"read:flags", "write:flags", "review:flags"}))
+			})
+
+			It("issues a short-lived token", func() {
+				Expect(resp.ExpiresIn).To(Equal(900))
+				claims := jwtHelper.GenerateTokenArgsForCall(0).(jwt.MapClaims)
+				Expect(claims["exp"]).To(BeNumerically("~", time.Now().Add(15*time.Minute).Unix(), 5))
+			})
+
+			It("identifies the token and its issue time for revocation", func() {
+				claims := jwtHelper.GenerateTokenArgsForCall(0).(jwt.MapClaims)
+				Expect(uuid.Parse(claims["jti"].(string))).ToNot(Equal(uuid.Nil))
+				Expect(claims["iat"]).To(BeNumerically("~", time.Now().Unix(), 5))
+				Expect(claims["iat_ms"]).To(BeNumerically("~", time.Now().UnixMilli(), 5000))
+				Expect(claims["iat_ms"].(int64) / 1000).To(Equal(claims["iat"]))
+			})
+
+			It("names the configured issuer", func() {
+				Expect(jwtHelper.GenerateTokenArgsForCall(0)).To(HaveKeyWithValue("iss", "https://flags.example.com"))
+			})
+
+			It("stores the hash of a new refresh token", func() {
+				Expect(resp.RefreshToken).ToNot(BeEmpty())
+				Expect(store.CreateRefreshTokenCallCount()).To(Equal(1))
+
+				_, stored := store.CreateRefreshTokenArgsForCall(0)
+				Expect(stored.Hash).ToNot(BeEmpty())
+				Expect(stored.Hash).ToNot(ContainSubstring(resp.RefreshToken))
+				Expect(stored.FamilyID).ToNot(Equal(uuid.Nil))
+				Expect(stored.UserID).To(Equal(user.ID))
+				Expect(stored.OrgID).To(Equal(org.ID))
+				Expect(stored.ExpiresAt).To(BeTemporally(">", time.Now().Add(24*time.Hour)))
+			})
+
+			Context("and storing the refresh token fails", func() {
+				BeforeEach(func() {
+					store.CreateRefreshTokenReturns(ErrDatabaseError)
+				})
+
+				It("returns an error", func() {
+					Expect(errAction).To(MatchError(ErrDatabaseError))
+					Expect(resp.Token).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when authentication succeeds for RoleAdmin", func() {
+			BeforeEach(func() {
+				store.GetOrgMemberRoleReturns(model.RoleAdmin, nil)
+				jwtHelper.GenerateTokenReturns("valid-admin-token", nil)
+			})
+
+			ItSucceeds()
+			It("returns a token with admin scopes", func() {
+				Expect(resp.Token).To(Equal("valid-admin-token"))
 
 				claims := jwtHelper.GenerateTokenArgsForCall(0)
 				Expect(claims).To(HaveKeyWithValue("sub", user.ID))
-				Expect(claims).To(HaveKeyWithValue("scopes", []string{"read:flags", "write:flags"}))
+				Expect(claims).To(HaveKeyWithValue("scopes", []string{"read:flags", "write:flags", "review:flags", "admin:flags", "admin:orgs", "admin:tokens", "admin:users", "admin:apikeys", "admin:clients"}))
 			})
 		})
 
 		Context("when authentication succeeds for RoleViewer", func() {
 			BeforeEach(func() {
-				user.Role = model.RoleViewer
-				store.GetByUsernameReturns(&user, nil)
+				store.GetOrgMemberRoleReturns(model.RoleViewer, nil)
 				jwtHelper.GenerateTokenReturns("valid-viewer-token", nil)
 			})
 
 			ItSucceeds()
 			It("returns a token with viewer scopes", func() {
-				Expect(token).To(Equal("valid-viewer-token"))
+				Expect(resp.Token).To(Equal("valid-viewer-token"))
 
 				claims := jwtHelper.GenerateTokenArgsForCall(0)
 				Expect(claims).To(HaveKeyWithValue("sub", user.ID))
@@ -102,6 +168,73 @@ var _ = Describe("Service", func() {
 			})
 		})
 
+		Context("when no organization is requested", func() {
+			BeforeEach(func() {
+				jwtHelper.GenerateTokenReturns("valid-token", nil)
+			})
+
+			ItSucceeds()
+			It("issues the token for the first organization of the user with their role in it", func() {
+				Expect(resp.OrgID).To(Equal(org.ID))
+				Expect(jwtHelper.GenerateTokenArgsForCall(0)).To(HaveKeyWithValue("org", org.ID.String()))
+
+				_, userID := store.ListUserOrgsArgsForCall(0)
+				Expect(userID).To(Equal(user.ID))
+				_, checkedOrg, checkedUser := store.GetOrgMemberRoleArgsForCall(0)
+				Expect(checkedOrg).To(Equal(org.ID))
+				Expect(checkedUser).To(Equal(user.ID))
+			})
+
+			Context("and the user belongs to no organization", func() {
+				BeforeEach(func() {
+					store.ListUserOrgsReturns(nil, nil)
+				})
+
+				It("returns ErrNotOrgMember", func() {
+					Expect(errAction).To(MatchError(service.ErrNotOrgMember))
+					Expect(resp.Token).To(BeEmpty())
+				})
+			})
+
+			Context("and listing the organizations fails", func() {
+				BeforeEach(func() {
+					store.ListUserOrgsReturns(nil, ErrDatabaseError)
+				})
+
+				It("returns an error", func() {
+					Expect(errAction).To(MatchError(ErrDatabaseError))
+				})
+			})
+		})
+
+		Context("when an organization is requested", func() {
+			BeforeEach(func() {
+				orgID = uuid.New()
+				jwtHelper.GenerateTokenReturns("valid-token", nil)
+			})
+
+			ItSucceeds()
+			It("issues the token for that organization", func() {
+				Expect(resp.OrgID).To(Equal(orgID))
+				Expect(jwtHelper.GenerateTokenArgsForCall(0)).To(HaveKeyWithValue("org", orgID.String()))
+
+				_, checkedOrg, checkedUser := store.GetOrgMemberRoleArgsForCall(0)
+				Expect(checkedOrg).To(Equal(orgID))
+				Expect(checkedUser).To(Equal(user.ID))
+			})
+
+			Context("and the user is not a member", func() {
+				BeforeEach(func() {
+					store.GetOrgMemberRoleReturns("", model.ErrMemberNotFound)
+				})
+
+				It("returns ErrNotOrgMember", func() {
+					Expect(errAction).To(MatchError(service.ErrNotOrgMember))
+					Expect(jwtHelper.GenerateTokenCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("when invalid credentials are provided", func() {
 			BeforeEach(func() {
 				password = "wrongpassword"
@@ -109,19 +242,40 @@ var _ = Describe("Service", func() {
 
 			It("returns an invalid credentials error", func() {
 				Expect(errAction).To(MatchError(service.ErrInvalidCredentials))
-				Expect(token).To(BeEmpty())
+				Expect(resp.Token).To(BeEmpty())
 			})
 		})
 
-		Context("when the user role is invalid", func() {
+		Context("when the user is disabled", func() {
 			BeforeEach(func() {
-				user.Role = "invalidRole"
+				user.Disabled = true
 				store.GetByUsernameReturns(&user, nil)
 			})
 
+			It("returns ErrUserDisabled", func() {
+				Expect(errAction).To(MatchError(service.ErrUserDisabled))
+				Expect(jwtHelper.GenerateTokenCallCount()).To(BeZero())
+			})
+
+			Context("and the password is wrong", func() {
+				BeforeEach(func() {
+					password = "wrongpassword"
+				})
+
+				It("does not reveal that the user is disabled", func() {
+					Expect(errAction).To(MatchError(service.ErrInvalidCredentials))
+				})
+			})
+		})
+
+		Context("when the user role is invalid", func() {
+			BeforeEach(func() {
+				store.GetOrgMemberRoleReturns("invalidRole", nil)
+			})
+
 			It("returns an invalid user role error", func() {
 				Expect(errAction).To(MatchError(service.ErrInvalidUserRole))
-				Expect(token).To(BeEmpty())
+				Expect(resp.Token).To(BeEmpty())
 			})
 		})
 
@@ -132,7 +286,7 @@ var _ = Describe("Service", func() {
 
 			It("returns an error", func() {
 				Expect(errAction).To(MatchError(ErrTokenGenerationFailed))
-				Expect(token).To(BeEmpty())
+				Expect(resp.Token).To(BeEmpty())
 			})
 		})
 
@@ -143,7 +297,7 @@ var _ = Describe("Service", func() {
 
 			It("returns an invalid credentials error", func() {
 				Expect(errAction).To(MatchError(service.ErrInvalidCredentials))
-				Expect(token).To(BeEmpty())
+				Expect(resp.Token).To(BeEmpty())
 			})
 		})
 
@@ -154,7 +308,7 @@ var _ = Describe("Service", func() {
 
 			It("returns an error", func() {
 				Expect(errAction).To(MatchError(ErrDatabaseError))
-				Expect(token).To(BeEmpty())
+				Expect(resp.Token).To(BeEmpty())
 			})
 		})
 	})
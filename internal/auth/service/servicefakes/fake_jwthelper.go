@@ -22,6 +22,29 @@ type FakeJWTHelper struct {
 		result1 string
 		result2 error
 	}
+	IssuerStub        func() string
+	issuerMutex       sync.RWMutex
+	issuerArgsForCall []struct {
+	}
+	issuerReturns struct {
+		result1 string
+	}
+	issuerReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ValidateTokenStub        func(string) (jwt.MapClaims, error)
+	validateTokenMutex       sync.RWMutex
+	validateTokenArgsForCall []struct {
+		arg1 string
+	}
+	validateTokenReturns struct {
+		result1 jwt.MapClaims
+		result2 error
+	}
+	validateTokenReturnsOnCall map[int]struct {
+		result1 jwt.MapClaims
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -90,6 +113,123 @@ func (fake *FakeJWTHelper) GenerateTokenReturnsOnCall(i int, result1 string, res
 	}{result1, result2}
 }
 
+func (fake *FakeJWTHelper) Issuer() string {
+	fake.issuerMutex.Lock()
+	ret, specificReturn := fake.issuerReturnsOnCall[len(fake.issuerArgsForCall)]
+	fake.issuerArgsForCall = append(fake.issuerArgsForCall, struct {
+	}{})
+	stub := fake.IssuerStub
+	fakeReturns := fake.issuerReturns
+	fake.recordInvocation("Issuer", []interface{}{})
+	fake.issuerMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeJWTHelper) IssuerCallCount() int {
+	fake.issuerMutex.RLock()
+	defer fake.issuerMutex.RUnlock()
+	return len(fake.issuerArgsForCall)
+}
+
+func (fake *FakeJWTHelper) IssuerCalls(stub func() string) {
+	fake.issuerMutex.Lock()
+	defer fake.issuerMutex.Unlock()
+	fake.IssuerStub = stub
+}
+
+func (fake *FakeJWTHelper) IssuerReturns(result1 string) {
+	fake.issuerMutex.Lock()
+	defer fake.issuerMutex.Unlock()
+	fake.IssuerStub = nil
+	fake.issuerReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeJWTHelper) IssuerReturnsOnCall(i int, result1 string) {
+	fake.issuerMutex.Lock()
+	defer fake.issuerMutex.Unlock()
+	fake.IssuerStub = nil
+	if fake.issuerReturnsOnCall == nil {
+		fake.issuerReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.issuerReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeJWTHelper) ValidateToken(arg1 string) (jwt.MapClaims, error) {
+	fake.validateTokenMutex.Lock()
+	ret, specificReturn := fake.validateTokenReturnsOnCall[len(fake.validateTokenArgsForCall)]
+	fake.validateTokenArgsForCall = append(fake.validateTokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateTokenStub
+	fakeReturns := fake.validateTokenReturns
+	fake.recordInvocation("ValidateToken", []interface{}{arg1})
+	fake.validateTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJWTHelper) ValidateTokenCallCount() int {
+	fake.validateTokenMutex.RLock()
+	defer fake.validateTokenMutex.RUnlock()
+	return len(fake.validateTokenArgsForCall)
+}
+
+func (fake *FakeJWTHelper) ValidateTokenCalls(stub func(string) (jwt.MapClaims, error)) {
+	fake.validateTokenMutex.Lock()
+	defer fake.validateTokenMutex.Unlock()
+	fake.ValidateTokenStub = stub
+}
+
+func (fake *FakeJWTHelper) ValidateTokenArgsForCall(i int) string {
+	fake.validateTokenMutex.RLock()
+	defer fake.validateTokenMutex.RUnlock()
+	argsForCall := fake.validateTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJWTHelper) ValidateTokenReturns(result1 jwt.MapClaims, result2 error) {
+	fake.validateTokenMutex.Lock()
+	defer fake.validateTokenMutex.Unlock()
+	fake.ValidateTokenStub = nil
+	fake.validateTokenReturns = struct {
+		result1 jwt.MapClaims
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJWTHelper) ValidateTokenReturnsOnCall(i int, result1 jwt.MapClaims, result2 error) {
+	fake.validateTokenMutex.Lock()
+	defer fake.validateTokenMutex.Unlock()
+	fake.ValidateTokenStub = nil
+	if fake.validateTokenReturnsOnCall == nil {
+		fake.validateTokenReturnsOnCall = make(map[int]struct {
+			result1 jwt.MapClaims
+			result2 error
+		})
+	}
+	fake.validateTokenReturnsOnCall[i] = struct {
+		result1 jwt.MapClaims
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJWTHelper) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
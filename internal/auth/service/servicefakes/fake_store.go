@@ -4,91 +4,3812 @@ package servicefakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/google/uuid"
 )
 
 type FakeStore struct {
+	AcceptOrgInvitationStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	acceptOrgInvitationMutex       sync.RWMutex
+	acceptOrgInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	acceptOrgInvitationReturns struct {
+		result1 error
+	}
+	acceptOrgInvitationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddOrgMemberStub        func(context.Context, uuid.UUID, uuid.UUID, model.RoleType) error
+	addOrgMemberMutex       sync.RWMutex
+	addOrgMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.RoleType
+	}
+	addOrgMemberReturns struct {
+		result1 error
+	}
+	addOrgMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ClearLoginFailuresStub        func(context.Context, string) error
+	clearLoginFailuresMutex       sync.RWMutex
+	clearLoginFailuresArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	clearLoginFailuresReturns struct {
+		result1 error
+	}
+	clearLoginFailuresReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CountRecoveryCodesStub        func(context.Context, uuid.UUID) (int, error)
+	countRecoveryCodesMutex       sync.RWMutex
+	countRecoveryCodesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	countRecoveryCodesReturns struct {
+		result1 int
+		result2 error
+	}
+	countRecoveryCodesReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	CreateAPIKeyStub        func(context.Context, model.APIKey) error
+	createAPIKeyMutex       sync.RWMutex
+	createAPIKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.APIKey
+	}
+	createAPIKeyReturns struct {
+		result1 error
+	}
+	createAPIKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateExternalIdentityStub        func(context.Context, model.ExternalIdentity) error
+	createExternalIdentityMutex       sync.RWMutex
+	createExternalIdentityArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.ExternalIdentity
+	}
+	createExternalIdentityReturns struct {
+		result1 error
+	}
+	createExternalIdentityReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateOAuthClientStub        func(context.Context, model.OAuthClient) error
+	createOAuthClientMutex       sync.RWMutex
+	createOAuthClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.OAuthClient
+	}
+	createOAuthClientReturns struct {
+		result1 error
+	}
+	createOAuthClientReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateOrgStub        func(context.Context, model.Organization, uuid.UUID) error
+	createOrgMutex       sync.RWMutex
+	createOrgArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.Organization
+		arg3 uuid.UUID
+	}
+	createOrgReturns struct {
+		result1 error
+	}
+	createOrgReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateOrgInvitationStub        func(context.Context, model.OrgInvitation) error
+	createOrgInvitationMutex       sync.RWMutex
+	createOrgInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.OrgInvitation
+	}
+	createOrgInvitationReturns struct {
+		result1 error
+	}
+	createOrgInvitationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateRefreshTokenStub        func(context.Context, model.RefreshToken) error
+	createRefreshTokenMutex       sync.RWMutex
+	createRefreshTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.RefreshToken
+	}
+	createRefreshTokenReturns struct {
+		result1 error
+	}
+	createRefreshTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateUserStub        func(context.Context, model.User, uuid.UUID) error
+	createUserMutex       sync.RWMutex
+	createUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.User
+		arg3 uuid.UUID
+	}
+	createUserReturns struct {
+		result1 error
+	}
+	createUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteExternalIdentityStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	deleteExternalIdentityMutex       sync.RWMutex
+	deleteExternalIdentityArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deleteExternalIdentityReturns struct {
+		result1 error
+	}
+	deleteExternalIdentityReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteOAuthClientStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	deleteOAuthClientMutex       sync.RWMutex
+	deleteOAuthClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deleteOAuthClientReturns struct {
+		result1 error
+	}
+	deleteOAuthClientReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteOrgInvitationStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	deleteOrgInvitationMutex       sync.RWMutex
+	deleteOrgInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deleteOrgInvitationReturns struct {
+		result1 error
+	}
+	deleteOrgInvitationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteUserStub        func(context.Context, uuid.UUID) error
+	deleteUserMutex       sync.RWMutex
+	deleteUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	deleteUserReturns struct {
+		result1 error
+	}
+	deleteUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteUserMFAStub        func(context.Context, uuid.UUID) error
+	deleteUserMFAMutex       sync.RWMutex
+	deleteUserMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	deleteUserMFAReturns struct {
+		result1 error
+	}
+	deleteUserMFAReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EnableUserMFAStub        func(context.Context, uuid.UUID, time.Time) error
+	enableUserMFAMutex       sync.RWMutex
+	enableUserMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	enableUserMFAReturns struct {
+		result1 error
+	}
+	enableUserMFAReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetByIDStub        func(context.Context, uuid.UUID) (*model.User, error)
+	getByIDMutex       sync.RWMutex
+	getByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getByIDReturns struct {
+		result1 *model.User
+		result2 error
+	}
+	getByIDReturnsOnCall map[int]struct {
+		result1 *model.User
+		result2 error
+	}
 	GetByUsernameStub        func(context.Context, string) (*model.User, error)
 	getByUsernameMutex       sync.RWMutex
 	getByUsernameArgsForCall []struct {
 		arg1 context.Context
-		arg2 string
+		arg2 string
+	}
+	getByUsernameReturns struct {
+		result1 *model.User
+		result2 error
+	}
+	getByUsernameReturnsOnCall map[int]struct {
+		result1 *model.User
+		result2 error
+	}
+	GetLoginFailuresStub        func(context.Context, string) (model.LoginFailures, error)
+	getLoginFailuresMutex       sync.RWMutex
+	getLoginFailuresArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getLoginFailuresReturns struct {
+		result1 model.LoginFailures
+		result2 error
+	}
+	getLoginFailuresReturnsOnCall map[int]struct {
+		result1 model.LoginFailures
+		result2 error
+	}
+	GetMFAPolicyStub        func(context.Context, uuid.UUID) (model.MFAPolicy, error)
+	getMFAPolicyMutex       sync.RWMutex
+	getMFAPolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getMFAPolicyReturns struct {
+		result1 model.MFAPolicy
+		result2 error
+	}
+	getMFAPolicyReturnsOnCall map[int]struct {
+		result1 model.MFAPolicy
+		result2 error
+	}
+	GetOAuthClientStub        func(context.Context, uuid.UUID) (model.OAuthClient, error)
+	getOAuthClientMutex       sync.RWMutex
+	getOAuthClientArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getOAuthClientReturns struct {
+		result1 model.OAuthClient
+		result2 error
+	}
+	getOAuthClientReturnsOnCall map[int]struct {
+		result1 model.OAuthClient
+		result2 error
+	}
+	GetOrgByIDStub        func(context.Context, uuid.UUID) (model.Organization, error)
+	getOrgByIDMutex       sync.RWMutex
+	getOrgByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getOrgByIDReturns struct {
+		result1 model.Organization
+		result2 error
+	}
+	getOrgByIDReturnsOnCall map[int]struct {
+		result1 model.Organization
+		result2 error
+	}
+	GetOrgMemberRoleStub        func(context.Context, uuid.UUID, uuid.UUID) (model.RoleType, error)
+	getOrgMemberRoleMutex       sync.RWMutex
+	getOrgMemberRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getOrgMemberRoleReturns struct {
+		result1 model.RoleType
+		result2 error
+	}
+	getOrgMemberRoleReturnsOnCall map[int]struct {
+		result1 model.RoleType
+		result2 error
+	}
+	GetRefreshTokenStub        func(context.Context, string) (model.RefreshToken, error)
+	getRefreshTokenMutex       sync.RWMutex
+	getRefreshTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getRefreshTokenReturns struct {
+		result1 model.RefreshToken
+		result2 error
+	}
+	getRefreshTokenReturnsOnCall map[int]struct {
+		result1 model.RefreshToken
+		result2 error
+	}
+	GetUserMFAStub        func(context.Context, uuid.UUID) (model.UserMFA, error)
+	getUserMFAMutex       sync.RWMutex
+	getUserMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getUserMFAReturns struct {
+		result1 model.UserMFA
+		result2 error
+	}
+	getUserMFAReturnsOnCall map[int]struct {
+		result1 model.UserMFA
+		result2 error
+	}
+	IsOrgMemberStub        func(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+	isOrgMemberMutex       sync.RWMutex
+	isOrgMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	isOrgMemberReturns struct {
+		result1 bool
+		result2 error
+	}
+	isOrgMemberReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ListAPIKeysStub        func(context.Context, uuid.UUID) ([]model.APIKey, error)
+	listAPIKeysMutex       sync.RWMutex
+	listAPIKeysArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listAPIKeysReturns struct {
+		result1 []model.APIKey
+		result2 error
+	}
+	listAPIKeysReturnsOnCall map[int]struct {
+		result1 []model.APIKey
+		result2 error
+	}
+	ListExternalIdentitiesStub        func(context.Context, uuid.UUID) ([]model.ExternalIdentity, error)
+	listExternalIdentitiesMutex       sync.RWMutex
+	listExternalIdentitiesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listExternalIdentitiesReturns struct {
+		result1 []model.ExternalIdentity
+		result2 error
+	}
+	listExternalIdentitiesReturnsOnCall map[int]struct {
+		result1 []model.ExternalIdentity
+		result2 error
+	}
+	ListOAuthClientsStub        func(context.Context, uuid.UUID) ([]model.OAuthClient, error)
+	listOAuthClientsMutex       sync.RWMutex
+	listOAuthClientsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listOAuthClientsReturns struct {
+		result1 []model.OAuthClient
+		result2 error
+	}
+	listOAuthClientsReturnsOnCall map[int]struct {
+		result1 []model.OAuthClient
+		result2 error
+	}
+	ListOrgMembersStub        func(context.Context, uuid.UUID) ([]model.OrganizationMember, error)
+	listOrgMembersMutex       sync.RWMutex
+	listOrgMembersArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listOrgMembersReturns struct {
+		result1 []model.OrganizationMember
+		result2 error
+	}
+	listOrgMembersReturnsOnCall map[int]struct {
+		result1 []model.OrganizationMember
+		result2 error
+	}
+	ListUserInvitationsStub        func(context.Context, uuid.UUID) ([]model.OrgInvitation, error)
+	listUserInvitationsMutex       sync.RWMutex
+	listUserInvitationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listUserInvitationsReturns struct {
+		result1 []model.OrgInvitation
+		result2 error
+	}
+	listUserInvitationsReturnsOnCall map[int]struct {
+		result1 []model.OrgInvitation
+		result2 error
+	}
+	ListUserOrgsStub        func(context.Context, uuid.UUID) ([]model.Organization, error)
+	listUserOrgsMutex       sync.RWMutex
+	listUserOrgsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listUserOrgsReturns struct {
+		result1 []model.Organization
+		result2 error
+	}
+	listUserOrgsReturnsOnCall map[int]struct {
+		result1 []model.Organization
+		result2 error
+	}
+	ListUsersStub        func(context.Context, uuid.UUID) ([]model.User, error)
+	listUsersMutex       sync.RWMutex
+	listUsersArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listUsersReturns struct {
+		result1 []model.User
+		result2 error
+	}
+	listUsersReturnsOnCall map[int]struct {
+		result1 []model.User
+		result2 error
+	}
+	RecordLoginFailureStub        func(context.Context, string, time.Time, time.Time) (model.LoginFailures, error)
+	recordLoginFailureMutex       sync.RWMutex
+	recordLoginFailureArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+		arg4 time.Time
+	}
+	recordLoginFailureReturns struct {
+		result1 model.LoginFailures
+		result2 error
+	}
+	recordLoginFailureReturnsOnCall map[int]struct {
+		result1 model.LoginFailures
+		result2 error
+	}
+	ReleaseLoginFailureStub        func(context.Context, string, int, time.Time) error
+	releaseLoginFailureMutex       sync.RWMutex
+	releaseLoginFailureArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 time.Time
+	}
+	releaseLoginFailureReturns struct {
+		result1 error
+	}
+	releaseLoginFailureReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveOrgMemberStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	removeOrgMemberMutex       sync.RWMutex
+	removeOrgMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	removeOrgMemberReturns struct {
+		result1 error
+	}
+	removeOrgMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReplaceRecoveryCodesStub        func(context.Context, uuid.UUID, []string) error
+	replaceRecoveryCodesMutex       sync.RWMutex
+	replaceRecoveryCodesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 []string
+	}
+	replaceRecoveryCodesReturns struct {
+		result1 error
+	}
+	replaceRecoveryCodesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeAPIKeyStub        func(context.Context, uuid.UUID, uuid.UUID, time.Time) error
+	revokeAPIKeyMutex       sync.RWMutex
+	revokeAPIKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 time.Time
+	}
+	revokeAPIKeyReturns struct {
+		result1 error
+	}
+	revokeAPIKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeRefreshTokenFamilyStub        func(context.Context, uuid.UUID, time.Time) error
+	revokeRefreshTokenFamilyMutex       sync.RWMutex
+	revokeRefreshTokenFamilyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	revokeRefreshTokenFamilyReturns struct {
+		result1 error
+	}
+	revokeRefreshTokenFamilyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeRefreshTokensIssuedBeforeStub        func(context.Context, uuid.UUID, uuid.UUID, time.Time, time.Time) error
+	revokeRefreshTokensIssuedBeforeMutex       sync.RWMutex
+	revokeRefreshTokensIssuedBeforeArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 time.Time
+		arg5 time.Time
+	}
+	revokeRefreshTokensIssuedBeforeReturns struct {
+		result1 error
+	}
+	revokeRefreshTokensIssuedBeforeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RotateRefreshTokenStub        func(context.Context, string, model.RefreshToken) error
+	rotateRefreshTokenMutex       sync.RWMutex
+	rotateRefreshTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 model.RefreshToken
+	}
+	rotateRefreshTokenReturns struct {
+		result1 error
+	}
+	rotateRefreshTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveMFAPolicyStub        func(context.Context, uuid.UUID, model.MFAPolicy) error
+	saveMFAPolicyMutex       sync.RWMutex
+	saveMFAPolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.MFAPolicy
+	}
+	saveMFAPolicyReturns struct {
+		result1 error
+	}
+	saveMFAPolicyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveUserMFAStub        func(context.Context, model.UserMFA) error
+	saveUserMFAMutex       sync.RWMutex
+	saveUserMFAArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.UserMFA
+	}
+	saveUserMFAReturns struct {
+		result1 error
+	}
+	saveUserMFAReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetUserDisabledStub        func(context.Context, uuid.UUID, bool) error
+	setUserDisabledMutex       sync.RWMutex
+	setUserDisabledArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 bool
+	}
+	setUserDisabledReturns struct {
+		result1 error
+	}
+	setUserDisabledReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateOrgMemberRoleStub        func(context.Context, uuid.UUID, uuid.UUID, model.RoleType) error
+	updateOrgMemberRoleMutex       sync.RWMutex
+	updateOrgMemberRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.RoleType
+	}
+	updateOrgMemberRoleReturns struct {
+		result1 error
+	}
+	updateOrgMemberRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateUserPasswordStub        func(context.Context, uuid.UUID, string) error
+	updateUserPasswordMutex       sync.RWMutex
+	updateUserPasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	updateUserPasswordReturns struct {
+		result1 error
+	}
+	updateUserPasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UseMFAStepStub        func(context.Context, uuid.UUID, int64) (bool, error)
+	useMFAStepMutex       sync.RWMutex
+	useMFAStepArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int64
+	}
+	useMFAStepReturns struct {
+		result1 bool
+		result2 error
+	}
+	useMFAStepReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	UseRecoveryCodeStub        func(context.Context, uuid.UUID, string) (bool, error)
+	useRecoveryCodeMutex       sync.RWMutex
+	useRecoveryCodeArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	useRecoveryCodeReturns struct {
+		result1 bool
+		result2 error
+	}
+	useRecoveryCodeReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStore) AcceptOrgInvitation(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.acceptOrgInvitationMutex.Lock()
+	ret, specificReturn := fake.acceptOrgInvitationReturnsOnCall[len(fake.acceptOrgInvitationArgsForCall)]
+	fake.acceptOrgInvitationArgsForCall = append(fake.acceptOrgInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.AcceptOrgInvitationStub
+	fakeReturns := fake.acceptOrgInvitationReturns
+	fake.recordInvocation("AcceptOrgInvitation", []interface{}{arg1, arg2, arg3})
+	fake.acceptOrgInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) AcceptOrgInvitationCallCount() int {
+	fake.acceptOrgInvitationMutex.RLock()
+	defer fake.acceptOrgInvitationMutex.RUnlock()
+	return len(fake.acceptOrgInvitationArgsForCall)
+}
+
+func (fake *FakeStore) AcceptOrgInvitationCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.acceptOrgInvitationMutex.Lock()
+	defer fake.acceptOrgInvitationMutex.Unlock()
+	fake.AcceptOrgInvitationStub = stub
+}
+
+func (fake *FakeStore) AcceptOrgInvitationArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.acceptOrgInvitationMutex.RLock()
+	defer fake.acceptOrgInvitationMutex.RUnlock()
+	argsForCall := fake.acceptOrgInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) AcceptOrgInvitationReturns(result1 error) {
+	fake.acceptOrgInvitationMutex.Lock()
+	defer fake.acceptOrgInvitationMutex.Unlock()
+	fake.AcceptOrgInvitationStub = nil
+	fake.acceptOrgInvitationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) AcceptOrgInvitationReturnsOnCall(i int, result1 error) {
+	fake.acceptOrgInvitationMutex.Lock()
+	defer fake.acceptOrgInvitationMutex.Unlock()
+	fake.AcceptOrgInvitationStub = nil
+	if fake.acceptOrgInvitationReturnsOnCall == nil {
+		fake.acceptOrgInvitationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.acceptOrgInvitationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) AddOrgMember(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 model.RoleType) error {
+	fake.addOrgMemberMutex.Lock()
+	ret, specificReturn := fake.addOrgMemberReturnsOnCall[len(fake.addOrgMemberArgsForCall)]
+	fake.addOrgMemberArgsForCall = append(fake.addOrgMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.RoleType
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddOrgMemberStub
+	fakeReturns := fake.addOrgMemberReturns
+	fake.recordInvocation("AddOrgMember", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addOrgMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) AddOrgMemberCallCount() int {
+	fake.addOrgMemberMutex.RLock()
+	defer fake.addOrgMemberMutex.RUnlock()
+	return len(fake.addOrgMemberArgsForCall)
+}
+
+func (fake *FakeStore) AddOrgMemberCalls(stub func(context.Context, uuid.UUID, uuid.UUID, model.RoleType) error) {
+	fake.addOrgMemberMutex.Lock()
+	defer fake.addOrgMemberMutex.Unlock()
+	fake.AddOrgMemberStub = stub
+}
+
+func (fake *FakeStore) AddOrgMemberArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, model.RoleType) {
+	fake.addOrgMemberMutex.RLock()
+	defer fake.addOrgMemberMutex.RUnlock()
+	argsForCall := fake.addOrgMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) AddOrgMemberReturns(result1 error) {
+	fake.addOrgMemberMutex.Lock()
+	defer fake.addOrgMemberMutex.Unlock()
+	fake.AddOrgMemberStub = nil
+	fake.addOrgMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) AddOrgMemberReturnsOnCall(i int, result1 error) {
+	fake.addOrgMemberMutex.Lock()
+	defer fake.addOrgMemberMutex.Unlock()
+	fake.AddOrgMemberStub = nil
+	if fake.addOrgMemberReturnsOnCall == nil {
+		fake.addOrgMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addOrgMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) ClearLoginFailures(arg1 context.Context, arg2 string) error {
+	fake.clearLoginFailuresMutex.Lock()
+	ret, specificReturn := fake.clearLoginFailuresReturnsOnCall[len(fake.clearLoginFailuresArgsForCall)]
+	fake.clearLoginFailuresArgsForCall = append(fake.clearLoginFailuresArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ClearLoginFailuresStub
+	fakeReturns := fake.clearLoginFailuresReturns
+	fake.recordInvocation("ClearLoginFailures", []interface{}{arg1, arg2})
+	fake.clearLoginFailuresMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) ClearLoginFailuresCallCount() int {
+	fake.clearLoginFailuresMutex.RLock()
+	defer fake.clearLoginFailuresMutex.RUnlock()
+	return len(fake.clearLoginFailuresArgsForCall)
+}
+
+func (fake *FakeStore) ClearLoginFailuresCalls(stub func(context.Context, string) error) {
+	fake.clearLoginFailuresMutex.Lock()
+	defer fake.clearLoginFailuresMutex.Unlock()
+	fake.ClearLoginFailuresStub = stub
+}
+
+func (fake *FakeStore) ClearLoginFailuresArgsForCall(i int) (context.Context, string) {
+	fake.clearLoginFailuresMutex.RLock()
+	defer fake.clearLoginFailuresMutex.RUnlock()
+	argsForCall := fake.clearLoginFailuresArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ClearLoginFailuresReturns(result1 error) {
+	fake.clearLoginFailuresMutex.Lock()
+	defer fake.clearLoginFailuresMutex.Unlock()
+	fake.ClearLoginFailuresStub = nil
+	fake.clearLoginFailuresReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) ClearLoginFailuresReturnsOnCall(i int, result1 error) {
+	fake.clearLoginFailuresMutex.Lock()
+	defer fake.clearLoginFailuresMutex.Unlock()
+	fake.ClearLoginFailuresStub = nil
+	if fake.clearLoginFailuresReturnsOnCall == nil {
+		fake.clearLoginFailuresReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clearLoginFailuresReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CountRecoveryCodes(arg1 context.Context, arg2 uuid.UUID) (int, error) {
+	fake.countRecoveryCodesMutex.Lock()
+	ret, specificReturn := fake.countRecoveryCodesReturnsOnCall[len(fake.countRecoveryCodesArgsForCall)]
+	fake.countRecoveryCodesArgsForCall = append(fake.countRecoveryCodesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.CountRecoveryCodesStub
+	fakeReturns := fake.countRecoveryCodesReturns
+	fake.recordInvocation("CountRecoveryCodes", []interface{}{arg1, arg2})
+	fake.countRecoveryCodesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) CountRecoveryCodesCallCount() int {
+	fake.countRecoveryCodesMutex.RLock()
+	defer fake.countRecoveryCodesMutex.RUnlock()
+	return len(fake.countRecoveryCodesArgsForCall)
+}
+
+func (fake *FakeStore) CountRecoveryCodesCalls(stub func(context.Context, uuid.UUID) (int, error)) {
+	fake.countRecoveryCodesMutex.Lock()
+	defer fake.countRecoveryCodesMutex.Unlock()
+	fake.CountRecoveryCodesStub = stub
+}
+
+func (fake *FakeStore) CountRecoveryCodesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.countRecoveryCodesMutex.RLock()
+	defer fake.countRecoveryCodesMutex.RUnlock()
+	argsForCall := fake.countRecoveryCodesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CountRecoveryCodesReturns(result1 int, result2 error) {
+	fake.countRecoveryCodesMutex.Lock()
+	defer fake.countRecoveryCodesMutex.Unlock()
+	fake.CountRecoveryCodesStub = nil
+	fake.countRecoveryCodesReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) CountRecoveryCodesReturnsOnCall(i int, result1 int, result2 error) {
+	fake.countRecoveryCodesMutex.Lock()
+	defer fake.countRecoveryCodesMutex.Unlock()
+	fake.CountRecoveryCodesStub = nil
+	if fake.countRecoveryCodesReturnsOnCall == nil {
+		fake.countRecoveryCodesReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.countRecoveryCodesReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) CreateAPIKey(arg1 context.Context, arg2 model.APIKey) error {
+	fake.createAPIKeyMutex.Lock()
+	ret, specificReturn := fake.createAPIKeyReturnsOnCall[len(fake.createAPIKeyArgsForCall)]
+	fake.createAPIKeyArgsForCall = append(fake.createAPIKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.APIKey
+	}{arg1, arg2})
+	stub := fake.CreateAPIKeyStub
+	fakeReturns := fake.createAPIKeyReturns
+	fake.recordInvocation("CreateAPIKey", []interface{}{arg1, arg2})
+	fake.createAPIKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateAPIKeyCallCount() int {
+	fake.createAPIKeyMutex.RLock()
+	defer fake.createAPIKeyMutex.RUnlock()
+	return len(fake.createAPIKeyArgsForCall)
+}
+
+func (fake *FakeStore) CreateAPIKeyCalls(stub func(context.Context, model.APIKey) error) {
+	fake.createAPIKeyMutex.Lock()
+	defer fake.createAPIKeyMutex.Unlock()
+	fake.CreateAPIKeyStub = stub
+}
+
+func (fake *FakeStore) CreateAPIKeyArgsForCall(i int) (context.Context, model.APIKey) {
+	fake.createAPIKeyMutex.RLock()
+	defer fake.createAPIKeyMutex.RUnlock()
+	argsForCall := fake.createAPIKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CreateAPIKeyReturns(result1 error) {
+	fake.createAPIKeyMutex.Lock()
+	defer fake.createAPIKeyMutex.Unlock()
+	fake.CreateAPIKeyStub = nil
+	fake.createAPIKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateAPIKeyReturnsOnCall(i int, result1 error) {
+	fake.createAPIKeyMutex.Lock()
+	defer fake.createAPIKeyMutex.Unlock()
+	fake.CreateAPIKeyStub = nil
+	if fake.createAPIKeyReturnsOnCall == nil {
+		fake.createAPIKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createAPIKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateExternalIdentity(arg1 context.Context, arg2 model.ExternalIdentity) error {
+	fake.createExternalIdentityMutex.Lock()
+	ret, specificReturn := fake.createExternalIdentityReturnsOnCall[len(fake.createExternalIdentityArgsForCall)]
+	fake.createExternalIdentityArgsForCall = append(fake.createExternalIdentityArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.ExternalIdentity
+	}{arg1, arg2})
+	stub := fake.CreateExternalIdentityStub
+	fakeReturns := fake.createExternalIdentityReturns
+	fake.recordInvocation("CreateExternalIdentity", []interface{}{arg1, arg2})
+	fake.createExternalIdentityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateExternalIdentityCallCount() int {
+	fake.createExternalIdentityMutex.RLock()
+	defer fake.createExternalIdentityMutex.RUnlock()
+	return len(fake.createExternalIdentityArgsForCall)
+}
+
+func (fake *FakeStore) CreateExternalIdentityCalls(stub func(context.Context, model.ExternalIdentity) error) {
+	fake.createExternalIdentityMutex.Lock()
+	defer fake.createExternalIdentityMutex.Unlock()
+	fake.CreateExternalIdentityStub = stub
+}
+
+func (fake *FakeStore) CreateExternalIdentityArgsForCall(i int) (context.Context, model.ExternalIdentity) {
+	fake.createExternalIdentityMutex.RLock()
+	defer fake.createExternalIdentityMutex.RUnlock()
+	argsForCall := fake.createExternalIdentityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CreateExternalIdentityReturns(result1 error) {
+	fake.createExternalIdentityMutex.Lock()
+	defer fake.createExternalIdentityMutex.Unlock()
+	fake.CreateExternalIdentityStub = nil
+	fake.createExternalIdentityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateExternalIdentityReturnsOnCall(i int, result1 error) {
+	fake.createExternalIdentityMutex.Lock()
+	defer fake.createExternalIdentityMutex.Unlock()
+	fake.CreateExternalIdentityStub = nil
+	if fake.createExternalIdentityReturnsOnCall == nil {
+		fake.createExternalIdentityReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createExternalIdentityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateOAuthClient(arg1 context.Context, arg2 model.OAuthClient) error {
+	fake.createOAuthClientMutex.Lock()
+	ret, specificReturn := fake.createOAuthClientReturnsOnCall[len(fake.createOAuthClientArgsForCall)]
+	fake.createOAuthClientArgsForCall = append(fake.createOAuthClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.OAuthClient
+	}{arg1, arg2})
+	stub := fake.CreateOAuthClientStub
+	fakeReturns := fake.createOAuthClientReturns
+	fake.recordInvocation("CreateOAuthClient", []interface{}{arg1, arg2})
+	fake.createOAuthClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateOAuthClientCallCount() int {
+	fake.createOAuthClientMutex.RLock()
+	defer fake.createOAuthClientMutex.RUnlock()
+	return len(fake.createOAuthClientArgsForCall)
+}
+
+func (fake *FakeStore) CreateOAuthClientCalls(stub func(context.Context, model.OAuthClient) error) {
+	fake.createOAuthClientMutex.Lock()
+	defer fake.createOAuthClientMutex.Unlock()
+	fake.CreateOAuthClientStub = stub
+}
+
+func (fake *FakeStore) CreateOAuthClientArgsForCall(i int) (context.Context, model.OAuthClient) {
+	fake.createOAuthClientMutex.RLock()
+	defer fake.createOAuthClientMutex.RUnlock()
+	argsForCall := fake.createOAuthClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CreateOAuthClientReturns(result1 error) {
+	fake.createOAuthClientMutex.Lock()
+	defer fake.createOAuthClientMutex.Unlock()
+	fake.CreateOAuthClientStub = nil
+	fake.createOAuthClientReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateOAuthClientReturnsOnCall(i int, result1 error) {
+	fake.createOAuthClientMutex.Lock()
+	defer fake.createOAuthClientMutex.Unlock()
+	fake.CreateOAuthClientStub = nil
+	if fake.createOAuthClientReturnsOnCall == nil {
+		fake.createOAuthClientReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createOAuthClientReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateOrg(arg1 context.Context, arg2 model.Organization, arg3 uuid.UUID) error {
+	fake.createOrgMutex.Lock()
+	ret, specificReturn := fake.createOrgReturnsOnCall[len(fake.createOrgArgsForCall)]
+	fake.createOrgArgsForCall = append(fake.createOrgArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.Organization
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.CreateOrgStub
+	fakeReturns := fake.createOrgReturns
+	fake.recordInvocation("CreateOrg", []interface{}{arg1, arg2, arg3})
+	fake.createOrgMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateOrgCallCount() int {
+	fake.createOrgMutex.RLock()
+	defer fake.createOrgMutex.RUnlock()
+	return len(fake.createOrgArgsForCall)
+}
+
+func (fake *FakeStore) CreateOrgCalls(stub func(context.Context, model.Organization, uuid.UUID) error) {
+	fake.createOrgMutex.Lock()
+	defer fake.createOrgMutex.Unlock()
+	fake.CreateOrgStub = stub
+}
+
+func (fake *FakeStore) CreateOrgArgsForCall(i int) (context.Context, model.Organization, uuid.UUID) {
+	fake.createOrgMutex.RLock()
+	defer fake.createOrgMutex.RUnlock()
+	argsForCall := fake.createOrgArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) CreateOrgReturns(result1 error) {
+	fake.createOrgMutex.Lock()
+	defer fake.createOrgMutex.Unlock()
+	fake.CreateOrgStub = nil
+	fake.createOrgReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateOrgReturnsOnCall(i int, result1 error) {
+	fake.createOrgMutex.Lock()
+	defer fake.createOrgMutex.Unlock()
+	fake.CreateOrgStub = nil
+	if fake.createOrgReturnsOnCall == nil {
+		fake.createOrgReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createOrgReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateOrgInvitation(arg1 context.Context, arg2 model.OrgInvitation) error {
+	fake.createOrgInvitationMutex.Lock()
+	ret, specificReturn := fake.createOrgInvitationReturnsOnCall[len(fake.createOrgInvitationArgsForCall)]
+	fake.createOrgInvitationArgsForCall = append(fake.createOrgInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.OrgInvitation
+	}{arg1, arg2})
+	stub := fake.CreateOrgInvitationStub
+	fakeReturns := fake.createOrgInvitationReturns
+	fake.recordInvocation("CreateOrgInvitation", []interface{}{arg1, arg2})
+	fake.createOrgInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateOrgInvitationCallCount() int {
+	fake.createOrgInvitationMutex.RLock()
+	defer fake.createOrgInvitationMutex.RUnlock()
+	return len(fake.createOrgInvitationArgsForCall)
+}
+
+func (fake *FakeStore) CreateOrgInvitationCalls(stub func(context.Context, model.OrgInvitation) error) {
+	fake.createOrgInvitationMutex.Lock()
+	defer fake.createOrgInvitationMutex.Unlock()
+	fake.CreateOrgInvitationStub = stub
+}
+
+func (fake *FakeStore) CreateOrgInvitationArgsForCall(i int) (context.Context, model.OrgInvitation) {
+	fake.createOrgInvitationMutex.RLock()
+	defer fake.createOrgInvitationMutex.RUnlock()
+	argsForCall := fake.createOrgInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CreateOrgInvitationReturns(result1 error) {
+	fake.createOrgInvitationMutex.Lock()
+	defer fake.createOrgInvitationMutex.Unlock()
+	fake.CreateOrgInvitationStub = nil
+	fake.createOrgInvitationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateOrgInvitationReturnsOnCall(i int, result1 error) {
+	fake.createOrgInvitationMutex.Lock()
+	defer fake.createOrgInvitationMutex.Unlock()
+	fake.CreateOrgInvitationStub = nil
+	if fake.createOrgInvitationReturnsOnCall == nil {
+		fake.createOrgInvitationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createOrgInvitationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateRefreshToken(arg1 context.Context, arg2 model.RefreshToken) error {
+	fake.createRefreshTokenMutex.Lock()
+	ret, specificReturn := fake.createRefreshTokenReturnsOnCall[len(fake.createRefreshTokenArgsForCall)]
+	fake.createRefreshTokenArgsForCall = append(fake.createRefreshTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.RefreshToken
+	}{arg1, arg2})
+	stub := fake.CreateRefreshTokenStub
+	fakeReturns := fake.createRefreshTokenReturns
+	fake.recordInvocation("CreateRefreshToken", []interface{}{arg1, arg2})
+	fake.createRefreshTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateRefreshTokenCallCount() int {
+	fake.createRefreshTokenMutex.RLock()
+	defer fake.createRefreshTokenMutex.RUnlock()
+	return len(fake.createRefreshTokenArgsForCall)
+}
+
+func (fake *FakeStore) CreateRefreshTokenCalls(stub func(context.Context, model.RefreshToken) error) {
+	fake.createRefreshTokenMutex.Lock()
+	defer fake.createRefreshTokenMutex.Unlock()
+	fake.CreateRefreshTokenStub = stub
+}
+
+func (fake *FakeStore) CreateRefreshTokenArgsForCall(i int) (context.Context, model.RefreshToken) {
+	fake.createRefreshTokenMutex.RLock()
+	defer fake.createRefreshTokenMutex.RUnlock()
+	argsForCall := fake.createRefreshTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CreateRefreshTokenReturns(result1 error) {
+	fake.createRefreshTokenMutex.Lock()
+	defer fake.createRefreshTokenMutex.Unlock()
+	fake.CreateRefreshTokenStub = nil
+	fake.createRefreshTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateRefreshTokenReturnsOnCall(i int, result1 error) {
+	fake.createRefreshTokenMutex.Lock()
+	defer fake.createRefreshTokenMutex.Unlock()
+	fake.CreateRefreshTokenStub = nil
+	if fake.createRefreshTokenReturnsOnCall == nil {
+		fake.createRefreshTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createRefreshTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateUser(arg1 context.Context, arg2 model.User, arg3 uuid.UUID) error {
+	fake.createUserMutex.Lock()
+	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
+	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.User
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.CreateUserStub
+	fakeReturns := fake.createUserReturns
+	fake.recordInvocation("CreateUser", []interface{}{arg1, arg2, arg3})
+	fake.createUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateUserCallCount() int {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	return len(fake.createUserArgsForCall)
+}
+
+func (fake *FakeStore) CreateUserCalls(stub func(context.Context, model.User, uuid.UUID) error) {
+	fake.createUserMutex.Lock()
+	defer fake.createUserMutex.Unlock()
+	fake.CreateUserStub = stub
+}
+
+func (fake *FakeStore) CreateUserArgsForCall(i int) (context.Context, model.User, uuid.UUID) {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	argsForCall := fake.createUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) CreateUserReturns(result1 error) {
+	fake.createUserMutex.Lock()
+	defer fake.createUserMutex.Unlock()
+	fake.CreateUserStub = nil
+	fake.createUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateUserReturnsOnCall(i int, result1 error) {
+	fake.createUserMutex.Lock()
+	defer fake.createUserMutex.Unlock()
+	fake.CreateUserStub = nil
+	if fake.createUserReturnsOnCall == nil {
+		fake.createUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteExternalIdentity(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deleteExternalIdentityMutex.Lock()
+	ret, specificReturn := fake.deleteExternalIdentityReturnsOnCall[len(fake.deleteExternalIdentityArgsForCall)]
+	fake.deleteExternalIdentityArgsForCall = append(fake.deleteExternalIdentityArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteExternalIdentityStub
+	fakeReturns := fake.deleteExternalIdentityReturns
+	fake.recordInvocation("DeleteExternalIdentity", []interface{}{arg1, arg2, arg3})
+	fake.deleteExternalIdentityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteExternalIdentityCallCount() int {
+	fake.deleteExternalIdentityMutex.RLock()
+	defer fake.deleteExternalIdentityMutex.RUnlock()
+	return len(fake.deleteExternalIdentityArgsForCall)
+}
+
+func (fake *FakeStore) DeleteExternalIdentityCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deleteExternalIdentityMutex.Lock()
+	defer fake.deleteExternalIdentityMutex.Unlock()
+	fake.DeleteExternalIdentityStub = stub
+}
+
+func (fake *FakeStore) DeleteExternalIdentityArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteExternalIdentityMutex.RLock()
+	defer fake.deleteExternalIdentityMutex.RUnlock()
+	argsForCall := fake.deleteExternalIdentityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) DeleteExternalIdentityReturns(result1 error) {
+	fake.deleteExternalIdentityMutex.Lock()
+	defer fake.deleteExternalIdentityMutex.Unlock()
+	fake.DeleteExternalIdentityStub = nil
+	fake.deleteExternalIdentityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteExternalIdentityReturnsOnCall(i int, result1 error) {
+	fake.deleteExternalIdentityMutex.Lock()
+	defer fake.deleteExternalIdentityMutex.Unlock()
+	fake.DeleteExternalIdentityStub = nil
+	if fake.deleteExternalIdentityReturnsOnCall == nil {
+		fake.deleteExternalIdentityReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteExternalIdentityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteOAuthClient(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deleteOAuthClientMutex.Lock()
+	ret, specificReturn := fake.deleteOAuthClientReturnsOnCall[len(fake.deleteOAuthClientArgsForCall)]
+	fake.deleteOAuthClientArgsForCall = append(fake.deleteOAuthClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteOAuthClientStub
+	fakeReturns := fake.deleteOAuthClientReturns
+	fake.recordInvocation("DeleteOAuthClient", []interface{}{arg1, arg2, arg3})
+	fake.deleteOAuthClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteOAuthClientCallCount() int {
+	fake.deleteOAuthClientMutex.RLock()
+	defer fake.deleteOAuthClientMutex.RUnlock()
+	return len(fake.deleteOAuthClientArgsForCall)
+}
+
+func (fake *FakeStore) DeleteOAuthClientCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deleteOAuthClientMutex.Lock()
+	defer fake.deleteOAuthClientMutex.Unlock()
+	fake.DeleteOAuthClientStub = stub
+}
+
+func (fake *FakeStore) DeleteOAuthClientArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteOAuthClientMutex.RLock()
+	defer fake.deleteOAuthClientMutex.RUnlock()
+	argsForCall := fake.deleteOAuthClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) DeleteOAuthClientReturns(result1 error) {
+	fake.deleteOAuthClientMutex.Lock()
+	defer fake.deleteOAuthClientMutex.Unlock()
+	fake.DeleteOAuthClientStub = nil
+	fake.deleteOAuthClientReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteOAuthClientReturnsOnCall(i int, result1 error) {
+	fake.deleteOAuthClientMutex.Lock()
+	defer fake.deleteOAuthClientMutex.Unlock()
+	fake.DeleteOAuthClientStub = nil
+	if fake.deleteOAuthClientReturnsOnCall == nil {
+		fake.deleteOAuthClientReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteOAuthClientReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteOrgInvitation(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deleteOrgInvitationMutex.Lock()
+	ret, specificReturn := fake.deleteOrgInvitationReturnsOnCall[len(fake.deleteOrgInvitationArgsForCall)]
+	fake.deleteOrgInvitationArgsForCall = append(fake.deleteOrgInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteOrgInvitationStub
+	fakeReturns := fake.deleteOrgInvitationReturns
+	fake.recordInvocation("DeleteOrgInvitation", []interface{}{arg1, arg2, arg3})
+	fake.deleteOrgInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteOrgInvitationCallCount() int {
+	fake.deleteOrgInvitationMutex.RLock()
+	defer fake.deleteOrgInvitationMutex.RUnlock()
+	return len(fake.deleteOrgInvitationArgsForCall)
+}
+
+func (fake *FakeStore) DeleteOrgInvitationCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deleteOrgInvitationMutex.Lock()
+	defer fake.deleteOrgInvitationMutex.Unlock()
+	fake.DeleteOrgInvitationStub = stub
+}
+
+func (fake *FakeStore) DeleteOrgInvitationArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteOrgInvitationMutex.RLock()
+	defer fake.deleteOrgInvitationMutex.RUnlock()
+	argsForCall := fake.deleteOrgInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) DeleteOrgInvitationReturns(result1 error) {
+	fake.deleteOrgInvitationMutex.Lock()
+	defer fake.deleteOrgInvitationMutex.Unlock()
+	fake.DeleteOrgInvitationStub = nil
+	fake.deleteOrgInvitationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteOrgInvitationReturnsOnCall(i int, result1 error) {
+	fake.deleteOrgInvitationMutex.Lock()
+	defer fake.deleteOrgInvitationMutex.Unlock()
+	fake.DeleteOrgInvitationStub = nil
+	if fake.deleteOrgInvitationReturnsOnCall == nil {
+		fake.deleteOrgInvitationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteOrgInvitationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteUser(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.deleteUserMutex.Lock()
+	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
+	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DeleteUserStub
+	fakeReturns := fake.deleteUserReturns
+	fake.recordInvocation("DeleteUser", []interface{}{arg1, arg2})
+	fake.deleteUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteUserCallCount() int {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return len(fake.deleteUserArgsForCall)
+}
+
+func (fake *FakeStore) DeleteUserCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = stub
+}
+
+func (fake *FakeStore) DeleteUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	argsForCall := fake.deleteUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) DeleteUserReturns(result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	fake.deleteUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteUserReturnsOnCall(i int, result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	if fake.deleteUserReturnsOnCall == nil {
+		fake.deleteUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteUserMFA(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.deleteUserMFAMutex.Lock()
+	ret, specificReturn := fake.deleteUserMFAReturnsOnCall[len(fake.deleteUserMFAArgsForCall)]
+	fake.deleteUserMFAArgsForCall = append(fake.deleteUserMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DeleteUserMFAStub
+	fakeReturns := fake.deleteUserMFAReturns
+	fake.recordInvocation("DeleteUserMFA", []interface{}{arg1, arg2})
+	fake.deleteUserMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteUserMFACallCount() int {
+	fake.deleteUserMFAMutex.RLock()
+	defer fake.deleteUserMFAMutex.RUnlock()
+	return len(fake.deleteUserMFAArgsForCall)
+}
+
+func (fake *FakeStore) DeleteUserMFACalls(stub func(context.Context, uuid.UUID) error) {
+	fake.deleteUserMFAMutex.Lock()
+	defer fake.deleteUserMFAMutex.Unlock()
+	fake.DeleteUserMFAStub = stub
+}
+
+func (fake *FakeStore) DeleteUserMFAArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.deleteUserMFAMutex.RLock()
+	defer fake.deleteUserMFAMutex.RUnlock()
+	argsForCall := fake.deleteUserMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) DeleteUserMFAReturns(result1 error) {
+	fake.deleteUserMFAMutex.Lock()
+	defer fake.deleteUserMFAMutex.Unlock()
+	fake.DeleteUserMFAStub = nil
+	fake.deleteUserMFAReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteUserMFAReturnsOnCall(i int, result1 error) {
+	fake.deleteUserMFAMutex.Lock()
+	defer fake.deleteUserMFAMutex.Unlock()
+	fake.DeleteUserMFAStub = nil
+	if fake.deleteUserMFAReturnsOnCall == nil {
+		fake.deleteUserMFAReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUserMFAReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) EnableUserMFA(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) error {
+	fake.enableUserMFAMutex.Lock()
+	ret, specificReturn := fake.enableUserMFAReturnsOnCall[len(fake.enableUserMFAArgsForCall)]
+	fake.enableUserMFAArgsForCall = append(fake.enableUserMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.EnableUserMFAStub
+	fakeReturns := fake.enableUserMFAReturns
+	fake.recordInvocation("EnableUserMFA", []interface{}{arg1, arg2, arg3})
+	fake.enableUserMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) EnableUserMFACallCount() int {
+	fake.enableUserMFAMutex.RLock()
+	defer fake.enableUserMFAMutex.RUnlock()
+	return len(fake.enableUserMFAArgsForCall)
+}
+
+func (fake *FakeStore) EnableUserMFACalls(stub func(context.Context, uuid.UUID, time.Time) error) {
+	fake.enableUserMFAMutex.Lock()
+	defer fake.enableUserMFAMutex.Unlock()
+	fake.EnableUserMFAStub = stub
+}
+
+func (fake *FakeStore) EnableUserMFAArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.enableUserMFAMutex.RLock()
+	defer fake.enableUserMFAMutex.RUnlock()
+	argsForCall := fake.enableUserMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) EnableUserMFAReturns(result1 error) {
+	fake.enableUserMFAMutex.Lock()
+	defer fake.enableUserMFAMutex.Unlock()
+	fake.EnableUserMFAStub = nil
+	fake.enableUserMFAReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) EnableUserMFAReturnsOnCall(i int, result1 error) {
+	fake.enableUserMFAMutex.Lock()
+	defer fake.enableUserMFAMutex.Unlock()
+	fake.EnableUserMFAStub = nil
+	if fake.enableUserMFAReturnsOnCall == nil {
+		fake.enableUserMFAReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.enableUserMFAReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) GetByID(arg1 context.Context, arg2 uuid.UUID) (*model.User, error) {
+	fake.getByIDMutex.Lock()
+	ret, specificReturn := fake.getByIDReturnsOnCall[len(fake.getByIDArgsForCall)]
+	fake.getByIDArgsForCall = append(fake.getByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetByIDStub
+	fakeReturns := fake.getByIDReturns
+	fake.recordInvocation("GetByID", []interface{}{arg1, arg2})
+	fake.getByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetByIDCallCount() int {
+	fake.getByIDMutex.RLock()
+	defer fake.getByIDMutex.RUnlock()
+	return len(fake.getByIDArgsForCall)
+}
+
+func (fake *FakeStore) GetByIDCalls(stub func(context.Context, uuid.UUID) (*model.User, error)) {
+	fake.getByIDMutex.Lock()
+	defer fake.getByIDMutex.Unlock()
+	fake.GetByIDStub = stub
+}
+
+func (fake *FakeStore) GetByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getByIDMutex.RLock()
+	defer fake.getByIDMutex.RUnlock()
+	argsForCall := fake.getByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetByIDReturns(result1 *model.User, result2 error) {
+	fake.getByIDMutex.Lock()
+	defer fake.getByIDMutex.Unlock()
+	fake.GetByIDStub = nil
+	fake.getByIDReturns = struct {
+		result1 *model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetByIDReturnsOnCall(i int, result1 *model.User, result2 error) {
+	fake.getByIDMutex.Lock()
+	defer fake.getByIDMutex.Unlock()
+	fake.GetByIDStub = nil
+	if fake.getByIDReturnsOnCall == nil {
+		fake.getByIDReturnsOnCall = make(map[int]struct {
+			result1 *model.User
+			result2 error
+		})
+	}
+	fake.getByIDReturnsOnCall[i] = struct {
+		result1 *model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetByUsername(arg1 context.Context, arg2 string) (*model.User, error) {
+	fake.getByUsernameMutex.Lock()
+	ret, specificReturn := fake.getByUsernameReturnsOnCall[len(fake.getByUsernameArgsForCall)]
+	fake.getByUsernameArgsForCall = append(fake.getByUsernameArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetByUsernameStub
+	fakeReturns := fake.getByUsernameReturns
+	fake.recordInvocation("GetByUsername", []interface{}{arg1, arg2})
+	fake.getByUsernameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetByUsernameCallCount() int {
+	fake.getByUsernameMutex.RLock()
+	defer fake.getByUsernameMutex.RUnlock()
+	return len(fake.getByUsernameArgsForCall)
+}
+
+func (fake *FakeStore) GetByUsernameCalls(stub func(context.Context, string) (*model.User, error)) {
+	fake.getByUsernameMutex.Lock()
+	defer fake.getByUsernameMutex.Unlock()
+	fake.GetByUsernameStub = stub
+}
+
+func (fake *FakeStore) GetByUsernameArgsForCall(i int) (context.Context, string) {
+	fake.getByUsernameMutex.RLock()
+	defer fake.getByUsernameMutex.RUnlock()
+	argsForCall := fake.getByUsernameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetByUsernameReturns(result1 *model.User, result2 error) {
+	fake.getByUsernameMutex.Lock()
+	defer fake.getByUsernameMutex.Unlock()
+	fake.GetByUsernameStub = nil
+	fake.getByUsernameReturns = struct {
+		result1 *model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetByUsernameReturnsOnCall(i int, result1 *model.User, result2 error) {
+	fake.getByUsernameMutex.Lock()
+	defer fake.getByUsernameMutex.Unlock()
+	fake.GetByUsernameStub = nil
+	if fake.getByUsernameReturnsOnCall == nil {
+		fake.getByUsernameReturnsOnCall = make(map[int]struct {
+			result1 *model.User
+			result2 error
+		})
+	}
+	fake.getByUsernameReturnsOnCall[i] = struct {
+		result1 *model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetLoginFailures(arg1 context.Context, arg2 string) (model.LoginFailures, error) {
+	fake.getLoginFailuresMutex.Lock()
+	ret, specificReturn := fake.getLoginFailuresReturnsOnCall[len(fake.getLoginFailuresArgsForCall)]
+	fake.getLoginFailuresArgsForCall = append(fake.getLoginFailuresArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetLoginFailuresStub
+	fakeReturns := fake.getLoginFailuresReturns
+	fake.recordInvocation("GetLoginFailures", []interface{}{arg1, arg2})
+	fake.getLoginFailuresMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetLoginFailuresCallCount() int {
+	fake.getLoginFailuresMutex.RLock()
+	defer fake.getLoginFailuresMutex.RUnlock()
+	return len(fake.getLoginFailuresArgsForCall)
+}
+
+func (fake *FakeStore) GetLoginFailuresCalls(stub func(context.Context, string) (model.LoginFailures, error)) {
+	fake.getLoginFailuresMutex.Lock()
+	defer fake.getLoginFailuresMutex.Unlock()
+	fake.GetLoginFailuresStub = stub
+}
+
+func (fake *FakeStore) GetLoginFailuresArgsForCall(i int) (context.Context, string) {
+	fake.getLoginFailuresMutex.RLock()
+	defer fake.getLoginFailuresMutex.RUnlock()
+	argsForCall := fake.getLoginFailuresArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetLoginFailuresReturns(result1 model.LoginFailures, result2 error) {
+	fake.getLoginFailuresMutex.Lock()
+	defer fake.getLoginFailuresMutex.Unlock()
+	fake.GetLoginFailuresStub = nil
+	fake.getLoginFailuresReturns = struct {
+		result1 model.LoginFailures
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetLoginFailuresReturnsOnCall(i int, result1 model.LoginFailures, result2 error) {
+	fake.getLoginFailuresMutex.Lock()
+	defer fake.getLoginFailuresMutex.Unlock()
+	fake.GetLoginFailuresStub = nil
+	if fake.getLoginFailuresReturnsOnCall == nil {
+		fake.getLoginFailuresReturnsOnCall = make(map[int]struct {
+			result1 model.LoginFailures
+			result2 error
+		})
+	}
+	fake.getLoginFailuresReturnsOnCall[i] = struct {
+		result1 model.LoginFailures
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetMFAPolicy(arg1 context.Context, arg2 uuid.UUID) (model.MFAPolicy, error) {
+	fake.getMFAPolicyMutex.Lock()
+	ret, specificReturn := fake.getMFAPolicyReturnsOnCall[len(fake.getMFAPolicyArgsForCall)]
+	fake.getMFAPolicyArgsForCall = append(fake.getMFAPolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetMFAPolicyStub
+	fakeReturns := fake.getMFAPolicyReturns
+	fake.recordInvocation("GetMFAPolicy", []interface{}{arg1, arg2})
+	fake.getMFAPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetMFAPolicyCallCount() int {
+	fake.getMFAPolicyMutex.RLock()
+	defer fake.getMFAPolicyMutex.RUnlock()
+	return len(fake.getMFAPolicyArgsForCall)
+}
+
+func (fake *FakeStore) GetMFAPolicyCalls(stub func(context.Context, uuid.UUID) (model.MFAPolicy, error)) {
+	fake.getMFAPolicyMutex.Lock()
+	defer fake.getMFAPolicyMutex.Unlock()
+	fake.GetMFAPolicyStub = stub
+}
+
+func (fake *FakeStore) GetMFAPolicyArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getMFAPolicyMutex.RLock()
+	defer fake.getMFAPolicyMutex.RUnlock()
+	argsForCall := fake.getMFAPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetMFAPolicyReturns(result1 model.MFAPolicy, result2 error) {
+	fake.getMFAPolicyMutex.Lock()
+	defer fake.getMFAPolicyMutex.Unlock()
+	fake.GetMFAPolicyStub = nil
+	fake.getMFAPolicyReturns = struct {
+		result1 model.MFAPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetMFAPolicyReturnsOnCall(i int, result1 model.MFAPolicy, result2 error) {
+	fake.getMFAPolicyMutex.Lock()
+	defer fake.getMFAPolicyMutex.Unlock()
+	fake.GetMFAPolicyStub = nil
+	if fake.getMFAPolicyReturnsOnCall == nil {
+		fake.getMFAPolicyReturnsOnCall = make(map[int]struct {
+			result1 model.MFAPolicy
+			result2 error
+		})
+	}
+	fake.getMFAPolicyReturnsOnCall[i] = struct {
+		result1 model.MFAPolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetOAuthClient(arg1 context.Context, arg2 uuid.UUID) (model.OAuthClient, error) {
+	fake.getOAuthClientMutex.Lock()
+	ret, specificReturn := fake.getOAuthClientReturnsOnCall[len(fake.getOAuthClientArgsForCall)]
+	fake.getOAuthClientArgsForCall = append(fake.getOAuthClientArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetOAuthClientStub
+	fakeReturns := fake.getOAuthClientReturns
+	fake.recordInvocation("GetOAuthClient", []interface{}{arg1, arg2})
+	fake.getOAuthClientMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetOAuthClientCallCount() int {
+	fake.getOAuthClientMutex.RLock()
+	defer fake.getOAuthClientMutex.RUnlock()
+	return len(fake.getOAuthClientArgsForCall)
+}
+
+func (fake *FakeStore) GetOAuthClientCalls(stub func(context.Context, uuid.UUID) (model.OAuthClient, error)) {
+	fake.getOAuthClientMutex.Lock()
+	defer fake.getOAuthClientMutex.Unlock()
+	fake.GetOAuthClientStub = stub
+}
+
+func (fake *FakeStore) GetOAuthClientArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getOAuthClientMutex.RLock()
+	defer fake.getOAuthClientMutex.RUnlock()
+	argsForCall := fake.getOAuthClientArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetOAuthClientReturns(result1 model.OAuthClient, result2 error) {
+	fake.getOAuthClientMutex.Lock()
+	defer fake.getOAuthClientMutex.Unlock()
+	fake.GetOAuthClientStub = nil
+	fake.getOAuthClientReturns = struct {
+		result1 model.OAuthClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetOAuthClientReturnsOnCall(i int, result1 model.OAuthClient, result2 error) {
+	fake.getOAuthClientMutex.Lock()
+	defer fake.getOAuthClientMutex.Unlock()
+	fake.GetOAuthClientStub = nil
+	if fake.getOAuthClientReturnsOnCall == nil {
+		fake.getOAuthClientReturnsOnCall = make(map[int]struct {
+			result1 model.OAuthClient
+			result2 error
+		})
+	}
+	fake.getOAuthClientReturnsOnCall[i] = struct {
+		result1 model.OAuthClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetOrgByID(arg1 context.Context, arg2 uuid.UUID) (model.Organization, error) {
+	fake.getOrgByIDMutex.Lock()
+	ret, specificReturn := fake.getOrgByIDReturnsOnCall[len(fake.getOrgByIDArgsForCall)]
+	fake.getOrgByIDArgsForCall = append(fake.getOrgByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetOrgByIDStub
+	fakeReturns := fake.getOrgByIDReturns
+	fake.recordInvocation("GetOrgByID", []interface{}{arg1, arg2})
+	fake.getOrgByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetOrgByIDCallCount() int {
+	fake.getOrgByIDMutex.RLock()
+	defer fake.getOrgByIDMutex.RUnlock()
+	return len(fake.getOrgByIDArgsForCall)
+}
+
+func (fake *FakeStore) GetOrgByIDCalls(stub func(context.Context, uuid.UUID) (model.Organization, error)) {
+	fake.getOrgByIDMutex.Lock()
+	defer fake.getOrgByIDMutex.Unlock()
+	fake.GetOrgByIDStub = stub
+}
+
+func (fake *FakeStore) GetOrgByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getOrgByIDMutex.RLock()
+	defer fake.getOrgByIDMutex.RUnlock()
+	argsForCall := fake.getOrgByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetOrgByIDReturns(result1 model.Organization, result2 error) {
+	fake.getOrgByIDMutex.Lock()
+	defer fake.getOrgByIDMutex.Unlock()
+	fake.GetOrgByIDStub = nil
+	fake.getOrgByIDReturns = struct {
+		result1 model.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetOrgByIDReturnsOnCall(i int, result1 model.Organization, result2 error) {
+	fake.getOrgByIDMutex.Lock()
+	defer fake.getOrgByIDMutex.Unlock()
+	fake.GetOrgByIDStub = nil
+	if fake.getOrgByIDReturnsOnCall == nil {
+		fake.getOrgByIDReturnsOnCall = make(map[int]struct {
+			result1 model.Organization
+			result2 error
+		})
+	}
+	fake.getOrgByIDReturnsOnCall[i] = struct {
+		result1 model.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetOrgMemberRole(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (model.RoleType, error) {
+	fake.getOrgMemberRoleMutex.Lock()
+	ret, specificReturn := fake.getOrgMemberRoleReturnsOnCall[len(fake.getOrgMemberRoleArgsForCall)]
+	fake.getOrgMemberRoleArgsForCall = append(fake.getOrgMemberRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetOrgMemberRoleStub
+	fakeReturns := fake.getOrgMemberRoleReturns
+	fake.recordInvocation("GetOrgMemberRole", []interface{}{arg1, arg2, arg3})
+	fake.getOrgMemberRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetOrgMemberRoleCallCount() int {
+	fake.getOrgMemberRoleMutex.RLock()
+	defer fake.getOrgMemberRoleMutex.RUnlock()
+	return len(fake.getOrgMemberRoleArgsForCall)
+}
+
+func (fake *FakeStore) GetOrgMemberRoleCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (model.RoleType, error)) {
+	fake.getOrgMemberRoleMutex.Lock()
+	defer fake.getOrgMemberRoleMutex.Unlock()
+	fake.GetOrgMemberRoleStub = stub
+}
+
+func (fake *FakeStore) GetOrgMemberRoleArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getOrgMemberRoleMutex.RLock()
+	defer fake.getOrgMemberRoleMutex.RUnlock()
+	argsForCall := fake.getOrgMemberRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) GetOrgMemberRoleReturns(result1 model.RoleType, result2 error) {
+	fake.getOrgMemberRoleMutex.Lock()
+	defer fake.getOrgMemberRoleMutex.Unlock()
+	fake.GetOrgMemberRoleStub = nil
+	fake.getOrgMemberRoleReturns = struct {
+		result1 model.RoleType
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetOrgMemberRoleReturnsOnCall(i int, result1 model.RoleType, result2 error) {
+	fake.getOrgMemberRoleMutex.Lock()
+	defer fake.getOrgMemberRoleMutex.Unlock()
+	fake.GetOrgMemberRoleStub = nil
+	if fake.getOrgMemberRoleReturnsOnCall == nil {
+		fake.getOrgMemberRoleReturnsOnCall = make(map[int]struct {
+			result1 model.RoleType
+			result2 error
+		})
+	}
+	fake.getOrgMemberRoleReturnsOnCall[i] = struct {
+		result1 model.RoleType
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetRefreshToken(arg1 context.Context, arg2 string) (model.RefreshToken, error) {
+	fake.getRefreshTokenMutex.Lock()
+	ret, specificReturn := fake.getRefreshTokenReturnsOnCall[len(fake.getRefreshTokenArgsForCall)]
+	fake.getRefreshTokenArgsForCall = append(fake.getRefreshTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetRefreshTokenStub
+	fakeReturns := fake.getRefreshTokenReturns
+	fake.recordInvocation("GetRefreshToken", []interface{}{arg1, arg2})
+	fake.getRefreshTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetRefreshTokenCallCount() int {
+	fake.getRefreshTokenMutex.RLock()
+	defer fake.getRefreshTokenMutex.RUnlock()
+	return len(fake.getRefreshTokenArgsForCall)
+}
+
+func (fake *FakeStore) GetRefreshTokenCalls(stub func(context.Context, string) (model.RefreshToken, error)) {
+	fake.getRefreshTokenMutex.Lock()
+	defer fake.getRefreshTokenMutex.Unlock()
+	fake.GetRefreshTokenStub = stub
+}
+
+func (fake *FakeStore) GetRefreshTokenArgsForCall(i int) (context.Context, string) {
+	fake.getRefreshTokenMutex.RLock()
+	defer fake.getRefreshTokenMutex.RUnlock()
+	argsForCall := fake.getRefreshTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetRefreshTokenReturns(result1 model.RefreshToken, result2 error) {
+	fake.getRefreshTokenMutex.Lock()
+	defer fake.getRefreshTokenMutex.Unlock()
+	fake.GetRefreshTokenStub = nil
+	fake.getRefreshTokenReturns = struct {
+		result1 model.RefreshToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetRefreshTokenReturnsOnCall(i int, result1 model.RefreshToken, result2 error) {
+	fake.getRefreshTokenMutex.Lock()
+	defer fake.getRefreshTokenMutex.Unlock()
+	fake.GetRefreshTokenStub = nil
+	if fake.getRefreshTokenReturnsOnCall == nil {
+		fake.getRefreshTokenReturnsOnCall = make(map[int]struct {
+			result1 model.RefreshToken
+			result2 error
+		})
+	}
+	fake.getRefreshTokenReturnsOnCall[i] = struct {
+		result1 model.RefreshToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetUserMFA(arg1 context.Context, arg2 uuid.UUID) (model.UserMFA, error) {
+	fake.getUserMFAMutex.Lock()
+	ret, specificReturn := fake.getUserMFAReturnsOnCall[len(fake.getUserMFAArgsForCall)]
+	fake.getUserMFAArgsForCall = append(fake.getUserMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetUserMFAStub
+	fakeReturns := fake.getUserMFAReturns
+	fake.recordInvocation("GetUserMFA", []interface{}{arg1, arg2})
+	fake.getUserMFAMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetUserMFACallCount() int {
+	fake.getUserMFAMutex.RLock()
+	defer fake.getUserMFAMutex.RUnlock()
+	return len(fake.getUserMFAArgsForCall)
+}
+
+func (fake *FakeStore) GetUserMFACalls(stub func(context.Context, uuid.UUID) (model.UserMFA, error)) {
+	fake.getUserMFAMutex.Lock()
+	defer fake.getUserMFAMutex.Unlock()
+	fake.GetUserMFAStub = stub
+}
+
+func (fake *FakeStore) GetUserMFAArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getUserMFAMutex.RLock()
+	defer fake.getUserMFAMutex.RUnlock()
+	argsForCall := fake.getUserMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetUserMFAReturns(result1 model.UserMFA, result2 error) {
+	fake.getUserMFAMutex.Lock()
+	defer fake.getUserMFAMutex.Unlock()
+	fake.GetUserMFAStub = nil
+	fake.getUserMFAReturns = struct {
+		result1 model.UserMFA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetUserMFAReturnsOnCall(i int, result1 model.UserMFA, result2 error) {
+	fake.getUserMFAMutex.Lock()
+	defer fake.getUserMFAMutex.Unlock()
+	fake.GetUserMFAStub = nil
+	if fake.getUserMFAReturnsOnCall == nil {
+		fake.getUserMFAReturnsOnCall = make(map[int]struct {
+			result1 model.UserMFA
+			result2 error
+		})
+	}
+	fake.getUserMFAReturnsOnCall[i] = struct {
+		result1 model.UserMFA
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) IsOrgMember(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (bool, error) {
+	fake.isOrgMemberMutex.Lock()
+	ret, specificReturn := fake.isOrgMemberReturnsOnCall[len(fake.isOrgMemberArgsForCall)]
+	fake.isOrgMemberArgsForCall = append(fake.isOrgMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.IsOrgMemberStub
+	fakeReturns := fake.isOrgMemberReturns
+	fake.recordInvocation("IsOrgMember", []interface{}{arg1, arg2, arg3})
+	fake.isOrgMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) IsOrgMemberCallCount() int {
+	fake.isOrgMemberMutex.RLock()
+	defer fake.isOrgMemberMutex.RUnlock()
+	return len(fake.isOrgMemberArgsForCall)
+}
+
+func (fake *FakeStore) IsOrgMemberCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) {
+	fake.isOrgMemberMutex.Lock()
+	defer fake.isOrgMemberMutex.Unlock()
+	fake.IsOrgMemberStub = stub
+}
+
+func (fake *FakeStore) IsOrgMemberArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.isOrgMemberMutex.RLock()
+	defer fake.isOrgMemberMutex.RUnlock()
+	argsForCall := fake.isOrgMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) IsOrgMemberReturns(result1 bool, result2 error) {
+	fake.isOrgMemberMutex.Lock()
+	defer fake.isOrgMemberMutex.Unlock()
+	fake.IsOrgMemberStub = nil
+	fake.isOrgMemberReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) IsOrgMemberReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isOrgMemberMutex.Lock()
+	defer fake.isOrgMemberMutex.Unlock()
+	fake.IsOrgMemberStub = nil
+	if fake.isOrgMemberReturnsOnCall == nil {
+		fake.isOrgMemberReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isOrgMemberReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListAPIKeys(arg1 context.Context, arg2 uuid.UUID) ([]model.APIKey, error) {
+	fake.listAPIKeysMutex.Lock()
+	ret, specificReturn := fake.listAPIKeysReturnsOnCall[len(fake.listAPIKeysArgsForCall)]
+	fake.listAPIKeysArgsForCall = append(fake.listAPIKeysArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListAPIKeysStub
+	fakeReturns := fake.listAPIKeysReturns
+	fake.recordInvocation("ListAPIKeys", []interface{}{arg1, arg2})
+	fake.listAPIKeysMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListAPIKeysCallCount() int {
+	fake.listAPIKeysMutex.RLock()
+	defer fake.listAPIKeysMutex.RUnlock()
+	return len(fake.listAPIKeysArgsForCall)
+}
+
+func (fake *FakeStore) ListAPIKeysCalls(stub func(context.Context, uuid.UUID) ([]model.APIKey, error)) {
+	fake.listAPIKeysMutex.Lock()
+	defer fake.listAPIKeysMutex.Unlock()
+	fake.ListAPIKeysStub = stub
+}
+
+func (fake *FakeStore) ListAPIKeysArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listAPIKeysMutex.RLock()
+	defer fake.listAPIKeysMutex.RUnlock()
+	argsForCall := fake.listAPIKeysArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListAPIKeysReturns(result1 []model.APIKey, result2 error) {
+	fake.listAPIKeysMutex.Lock()
+	defer fake.listAPIKeysMutex.Unlock()
+	fake.ListAPIKeysStub = nil
+	fake.listAPIKeysReturns = struct {
+		result1 []model.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListAPIKeysReturnsOnCall(i int, result1 []model.APIKey, result2 error) {
+	fake.listAPIKeysMutex.Lock()
+	defer fake.listAPIKeysMutex.Unlock()
+	fake.ListAPIKeysStub = nil
+	if fake.listAPIKeysReturnsOnCall == nil {
+		fake.listAPIKeysReturnsOnCall = make(map[int]struct {
+			result1 []model.APIKey
+			result2 error
+		})
+	}
+	fake.listAPIKeysReturnsOnCall[i] = struct {
+		result1 []model.APIKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListExternalIdentities(arg1 context.Context, arg2 uuid.UUID) ([]model.ExternalIdentity, error) {
+	fake.listExternalIdentitiesMutex.Lock()
+	ret, specificReturn := fake.listExternalIdentitiesReturnsOnCall[len(fake.listExternalIdentitiesArgsForCall)]
+	fake.listExternalIdentitiesArgsForCall = append(fake.listExternalIdentitiesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListExternalIdentitiesStub
+	fakeReturns := fake.listExternalIdentitiesReturns
+	fake.recordInvocation("ListExternalIdentities", []interface{}{arg1, arg2})
+	fake.listExternalIdentitiesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListExternalIdentitiesCallCount() int {
+	fake.listExternalIdentitiesMutex.RLock()
+	defer fake.listExternalIdentitiesMutex.RUnlock()
+	return len(fake.listExternalIdentitiesArgsForCall)
+}
+
+func (fake *FakeStore) ListExternalIdentitiesCalls(stub func(context.Context, uuid.UUID) ([]model.ExternalIdentity, error)) {
+	fake.listExternalIdentitiesMutex.Lock()
+	defer fake.listExternalIdentitiesMutex.Unlock()
+	fake.ListExternalIdentitiesStub = stub
+}
+
+func (fake *FakeStore) ListExternalIdentitiesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listExternalIdentitiesMutex.RLock()
+	defer fake.listExternalIdentitiesMutex.RUnlock()
+	argsForCall := fake.listExternalIdentitiesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListExternalIdentitiesReturns(result1 []model.ExternalIdentity, result2 error) {
+	fake.listExternalIdentitiesMutex.Lock()
+	defer fake.listExternalIdentitiesMutex.Unlock()
+	fake.ListExternalIdentitiesStub = nil
+	fake.listExternalIdentitiesReturns = struct {
+		result1 []model.ExternalIdentity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListExternalIdentitiesReturnsOnCall(i int, result1 []model.ExternalIdentity, result2 error) {
+	fake.listExternalIdentitiesMutex.Lock()
+	defer fake.listExternalIdentitiesMutex.Unlock()
+	fake.ListExternalIdentitiesStub = nil
+	if fake.listExternalIdentitiesReturnsOnCall == nil {
+		fake.listExternalIdentitiesReturnsOnCall = make(map[int]struct {
+			result1 []model.ExternalIdentity
+			result2 error
+		})
+	}
+	fake.listExternalIdentitiesReturnsOnCall[i] = struct {
+		result1 []model.ExternalIdentity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListOAuthClients(arg1 context.Context, arg2 uuid.UUID) ([]model.OAuthClient, error) {
+	fake.listOAuthClientsMutex.Lock()
+	ret, specificReturn := fake.listOAuthClientsReturnsOnCall[len(fake.listOAuthClientsArgsForCall)]
+	fake.listOAuthClientsArgsForCall = append(fake.listOAuthClientsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListOAuthClientsStub
+	fakeReturns := fake.listOAuthClientsReturns
+	fake.recordInvocation("ListOAuthClients", []interface{}{arg1, arg2})
+	fake.listOAuthClientsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListOAuthClientsCallCount() int {
+	fake.listOAuthClientsMutex.RLock()
+	defer fake.listOAuthClientsMutex.RUnlock()
+	return len(fake.listOAuthClientsArgsForCall)
+}
+
+func (fake *FakeStore) ListOAuthClientsCalls(stub func(context.Context, uuid.UUID) ([]model.OAuthClient, error)) {
+	fake.listOAuthClientsMutex.Lock()
+	defer fake.listOAuthClientsMutex.Unlock()
+	fake.ListOAuthClientsStub = stub
+}
+
+func (fake *FakeStore) ListOAuthClientsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listOAuthClientsMutex.RLock()
+	defer fake.listOAuthClientsMutex.RUnlock()
+	argsForCall := fake.listOAuthClientsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListOAuthClientsReturns(result1 []model.OAuthClient, result2 error) {
+	fake.listOAuthClientsMutex.Lock()
+	defer fake.listOAuthClientsMutex.Unlock()
+	fake.ListOAuthClientsStub = nil
+	fake.listOAuthClientsReturns = struct {
+		result1 []model.OAuthClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListOAuthClientsReturnsOnCall(i int, result1 []model.OAuthClient, result2 error) {
+	fake.listOAuthClientsMutex.Lock()
+	defer fake.listOAuthClientsMutex.Unlock()
+	fake.ListOAuthClientsStub = nil
+	if fake.listOAuthClientsReturnsOnCall == nil {
+		fake.listOAuthClientsReturnsOnCall = make(map[int]struct {
+			result1 []model.OAuthClient
+			result2 error
+		})
+	}
+	fake.listOAuthClientsReturnsOnCall[i] = struct {
+		result1 []model.OAuthClient
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListOrgMembers(arg1 context.Context, arg2 uuid.UUID) ([]model.OrganizationMember, error) {
+	fake.listOrgMembersMutex.Lock()
+	ret, specificReturn := fake.listOrgMembersReturnsOnCall[len(fake.listOrgMembersArgsForCall)]
+	fake.listOrgMembersArgsForCall = append(fake.listOrgMembersArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListOrgMembersStub
+	fakeReturns := fake.listOrgMembersReturns
+	fake.recordInvocation("ListOrgMembers", []interface{}{arg1, arg2})
+	fake.listOrgMembersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListOrgMembersCallCount() int {
+	fake.listOrgMembersMutex.RLock()
+	defer fake.listOrgMembersMutex.RUnlock()
+	return len(fake.listOrgMembersArgsForCall)
+}
+
+func (fake *FakeStore) ListOrgMembersCalls(stub func(context.Context, uuid.UUID) ([]model.OrganizationMember, error)) {
+	fake.listOrgMembersMutex.Lock()
+	defer fake.listOrgMembersMutex.Unlock()
+	fake.ListOrgMembersStub = stub
+}
+
+func (fake *FakeStore) ListOrgMembersArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listOrgMembersMutex.RLock()
+	defer fake.listOrgMembersMutex.RUnlock()
+	argsForCall := fake.listOrgMembersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListOrgMembersReturns(result1 []model.OrganizationMember, result2 error) {
+	fake.listOrgMembersMutex.Lock()
+	defer fake.listOrgMembersMutex.Unlock()
+	fake.ListOrgMembersStub = nil
+	fake.listOrgMembersReturns = struct {
+		result1 []model.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListOrgMembersReturnsOnCall(i int, result1 []model.OrganizationMember, result2 error) {
+	fake.listOrgMembersMutex.Lock()
+	defer fake.listOrgMembersMutex.Unlock()
+	fake.ListOrgMembersStub = nil
+	if fake.listOrgMembersReturnsOnCall == nil {
+		fake.listOrgMembersReturnsOnCall = make(map[int]struct {
+			result1 []model.OrganizationMember
+			result2 error
+		})
+	}
+	fake.listOrgMembersReturnsOnCall[i] = struct {
+		result1 []model.OrganizationMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListUserInvitations(arg1 context.Context, arg2 uuid.UUID) ([]model.OrgInvitation, error) {
+	fake.listUserInvitationsMutex.Lock()
+	ret, specificReturn := fake.listUserInvitationsReturnsOnCall[len(fake.listUserInvitationsArgsForCall)]
+	fake.listUserInvitationsArgsForCall = append(fake.listUserInvitationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListUserInvitationsStub
+	fakeReturns := fake.listUserInvitationsReturns
+	fake.recordInvocation("ListUserInvitations", []interface{}{arg1, arg2})
+	fake.listUserInvitationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListUserInvitationsCallCount() int {
+	fake.listUserInvitationsMutex.RLock()
+	defer fake.listUserInvitationsMutex.RUnlock()
+	return len(fake.listUserInvitationsArgsForCall)
+}
+
+func (fake *FakeStore) ListUserInvitationsCalls(stub func(context.Context, uuid.UUID) ([]model.OrgInvitation, error)) {
+	fake.listUserInvitationsMutex.Lock()
+	defer fake.listUserInvitationsMutex.Unlock()
+	fake.ListUserInvitationsStub = stub
+}
+
+func (fake *FakeStore) ListUserInvitationsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listUserInvitationsMutex.RLock()
+	defer fake.listUserInvitationsMutex.RUnlock()
+	argsForCall := fake.listUserInvitationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListUserInvitationsReturns(result1 []model.OrgInvitation, result2 error) {
+	fake.listUserInvitationsMutex.Lock()
+	defer fake.listUserInvitationsMutex.Unlock()
+	fake.ListUserInvitationsStub = nil
+	fake.listUserInvitationsReturns = struct {
+		result1 []model.OrgInvitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListUserInvitationsReturnsOnCall(i int, result1 []model.OrgInvitation, result2 error) {
+	fake.listUserInvitationsMutex.Lock()
+	defer fake.listUserInvitationsMutex.Unlock()
+	fake.ListUserInvitationsStub = nil
+	if fake.listUserInvitationsReturnsOnCall == nil {
+		fake.listUserInvitationsReturnsOnCall = make(map[int]struct {
+			result1 []model.OrgInvitation
+			result2 error
+		})
+	}
+	fake.listUserInvitationsReturnsOnCall[i] = struct {
+		result1 []model.OrgInvitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListUserOrgs(arg1 context.Context, arg2 uuid.UUID) ([]model.Organization, error) {
+	fake.listUserOrgsMutex.Lock()
+	ret, specificReturn := fake.listUserOrgsReturnsOnCall[len(fake.listUserOrgsArgsForCall)]
+	fake.listUserOrgsArgsForCall = append(fake.listUserOrgsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListUserOrgsStub
+	fakeReturns := fake.listUserOrgsReturns
+	fake.recordInvocation("ListUserOrgs", []interface{}{arg1, arg2})
+	fake.listUserOrgsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListUserOrgsCallCount() int {
+	fake.listUserOrgsMutex.RLock()
+	defer fake.listUserOrgsMutex.RUnlock()
+	return len(fake.listUserOrgsArgsForCall)
+}
+
+func (fake *FakeStore) ListUserOrgsCalls(stub func(context.Context, uuid.UUID) ([]model.Organization, error)) {
+	fake.listUserOrgsMutex.Lock()
+	defer fake.listUserOrgsMutex.Unlock()
+	fake.ListUserOrgsStub = stub
+}
+
+func (fake *FakeStore) ListUserOrgsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listUserOrgsMutex.RLock()
+	defer fake.listUserOrgsMutex.RUnlock()
+	argsForCall := fake.listUserOrgsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListUserOrgsReturns(result1 []model.Organization, result2 error) {
+	fake.listUserOrgsMutex.Lock()
+	defer fake.listUserOrgsMutex.Unlock()
+	fake.ListUserOrgsStub = nil
+	fake.listUserOrgsReturns = struct {
+		result1 []model.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListUserOrgsReturnsOnCall(i int, result1 []model.Organization, result2 error) {
+	fake.listUserOrgsMutex.Lock()
+	defer fake.listUserOrgsMutex.Unlock()
+	fake.ListUserOrgsStub = nil
+	if fake.listUserOrgsReturnsOnCall == nil {
+		fake.listUserOrgsReturnsOnCall = make(map[int]struct {
+			result1 []model.Organization
+			result2 error
+		})
+	}
+	fake.listUserOrgsReturnsOnCall[i] = struct {
+		result1 []model.Organization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListUsers(arg1 context.Context, arg2 uuid.UUID) ([]model.User, error) {
+	fake.listUsersMutex.Lock()
+	ret, specificReturn := fake.listUsersReturnsOnCall[len(fake.listUsersArgsForCall)]
+	fake.listUsersArgsForCall = append(fake.listUsersArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListUsersStub
+	fakeReturns := fake.listUsersReturns
+	fake.recordInvocation("ListUsers", []interface{}{arg1, arg2})
+	fake.listUsersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListUsersCallCount() int {
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	return len(fake.listUsersArgsForCall)
+}
+
+func (fake *FakeStore) ListUsersCalls(stub func(context.Context, uuid.UUID) ([]model.User, error)) {
+	fake.listUsersMutex.Lock()
+	defer fake.listUsersMutex.Unlock()
+	fake.ListUsersStub = stub
+}
+
+func (fake *FakeStore) ListUsersArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	argsForCall := fake.listUsersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListUsersReturns(result1 []model.User, result2 error) {
+	fake.listUsersMutex.Lock()
+	defer fake.listUsersMutex.Unlock()
+	fake.ListUsersStub = nil
+	fake.listUsersReturns = struct {
+		result1 []model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListUsersReturnsOnCall(i int, result1 []model.User, result2 error) {
+	fake.listUsersMutex.Lock()
+	defer fake.listUsersMutex.Unlock()
+	fake.ListUsersStub = nil
+	if fake.listUsersReturnsOnCall == nil {
+		fake.listUsersReturnsOnCall = make(map[int]struct {
+			result1 []model.User
+			result2 error
+		})
+	}
+	fake.listUsersReturnsOnCall[i] = struct {
+		result1 []model.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) RecordLoginFailure(arg1 context.Context, arg2 string, arg3 time.Time, arg4 time.Time) (model.LoginFailures, error) {
+	fake.recordLoginFailureMutex.Lock()
+	ret, specificReturn := fake.recordLoginFailureReturnsOnCall[len(fake.recordLoginFailureArgsForCall)]
+	fake.recordLoginFailureArgsForCall = append(fake.recordLoginFailureArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RecordLoginFailureStub
+	fakeReturns := fake.recordLoginFailureReturns
+	fake.recordInvocation("RecordLoginFailure", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordLoginFailureMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) RecordLoginFailureCallCount() int {
+	fake.recordLoginFailureMutex.RLock()
+	defer fake.recordLoginFailureMutex.RUnlock()
+	return len(fake.recordLoginFailureArgsForCall)
+}
+
+func (fake *FakeStore) RecordLoginFailureCalls(stub func(context.Context, string, time.Time, time.Time) (model.LoginFailures, error)) {
+	fake.recordLoginFailureMutex.Lock()
+	defer fake.recordLoginFailureMutex.Unlock()
+	fake.RecordLoginFailureStub = stub
+}
+
+func (fake *FakeStore) RecordLoginFailureArgsForCall(i int) (context.Context, string, time.Time, time.Time) {
+	fake.recordLoginFailureMutex.RLock()
+	defer fake.recordLoginFailureMutex.RUnlock()
+	argsForCall := fake.recordLoginFailureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) RecordLoginFailureReturns(result1 model.LoginFailures, result2 error) {
+	fake.recordLoginFailureMutex.Lock()
+	defer fake.recordLoginFailureMutex.Unlock()
+	fake.RecordLoginFailureStub = nil
+	fake.recordLoginFailureReturns = struct {
+		result1 model.LoginFailures
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) RecordLoginFailureReturnsOnCall(i int, result1 model.LoginFailures, result2 error) {
+	fake.recordLoginFailureMutex.Lock()
+	defer fake.recordLoginFailureMutex.Unlock()
+	fake.RecordLoginFailureStub = nil
+	if fake.recordLoginFailureReturnsOnCall == nil {
+		fake.recordLoginFailureReturnsOnCall = make(map[int]struct {
+			result1 model.LoginFailures
+			result2 error
+		})
+	}
+	fake.recordLoginFailureReturnsOnCall[i] = struct {
+		result1 model.LoginFailures
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ReleaseLoginFailure(arg1 context.Context, arg2 string, arg3 int, arg4 time.Time) error {
+	fake.releaseLoginFailureMutex.Lock()
+	ret, specificReturn := fake.releaseLoginFailureReturnsOnCall[len(fake.releaseLoginFailureArgsForCall)]
+	fake.releaseLoginFailureArgsForCall = append(fake.releaseLoginFailureArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ReleaseLoginFailureStub
+	fakeReturns := fake.releaseLoginFailureReturns
+	fake.recordInvocation("ReleaseLoginFailure", []interface{}{arg1, arg2, arg3, arg4})
+	fake.releaseLoginFailureMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) ReleaseLoginFailureCallCount() int {
+	fake.releaseLoginFailureMutex.RLock()
+	defer fake.releaseLoginFailureMutex.RUnlock()
+	return len(fake.releaseLoginFailureArgsForCall)
+}
+
+func (fake *FakeStore) ReleaseLoginFailureCalls(stub func(context.Context, string, int, time.Time) error) {
+	fake.releaseLoginFailureMutex.Lock()
+	defer fake.releaseLoginFailureMutex.Unlock()
+	fake.ReleaseLoginFailureStub = stub
+}
+
+func (fake *FakeStore) ReleaseLoginFailureArgsForCall(i int) (context.Context, string, int, time.Time) {
+	fake.releaseLoginFailureMutex.RLock()
+	defer fake.releaseLoginFailureMutex.RUnlock()
+	argsForCall := fake.releaseLoginFailureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) ReleaseLoginFailureReturns(result1 error) {
+	fake.releaseLoginFailureMutex.Lock()
+	defer fake.releaseLoginFailureMutex.Unlock()
+	fake.ReleaseLoginFailureStub = nil
+	fake.releaseLoginFailureReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) ReleaseLoginFailureReturnsOnCall(i int, result1 error) {
+	fake.releaseLoginFailureMutex.Lock()
+	defer fake.releaseLoginFailureMutex.Unlock()
+	fake.ReleaseLoginFailureStub = nil
+	if fake.releaseLoginFailureReturnsOnCall == nil {
+		fake.releaseLoginFailureReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseLoginFailureReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) RemoveOrgMember(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.removeOrgMemberMutex.Lock()
+	ret, specificReturn := fake.removeOrgMemberReturnsOnCall[len(fake.removeOrgMemberArgsForCall)]
+	fake.removeOrgMemberArgsForCall = append(fake.removeOrgMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveOrgMemberStub
+	fakeReturns := fake.removeOrgMemberReturns
+	fake.recordInvocation("RemoveOrgMember", []interface{}{arg1, arg2, arg3})
+	fake.removeOrgMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) RemoveOrgMemberCallCount() int {
+	fake.removeOrgMemberMutex.RLock()
+	defer fake.removeOrgMemberMutex.RUnlock()
+	return len(fake.removeOrgMemberArgsForCall)
+}
+
+func (fake *FakeStore) RemoveOrgMemberCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.removeOrgMemberMutex.Lock()
+	defer fake.removeOrgMemberMutex.Unlock()
+	fake.RemoveOrgMemberStub = stub
+}
+
+func (fake *FakeStore) RemoveOrgMemberArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.removeOrgMemberMutex.RLock()
+	defer fake.removeOrgMemberMutex.RUnlock()
+	argsForCall := fake.removeOrgMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) RemoveOrgMemberReturns(result1 error) {
+	fake.removeOrgMemberMutex.Lock()
+	defer fake.removeOrgMemberMutex.Unlock()
+	fake.RemoveOrgMemberStub = nil
+	fake.removeOrgMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) RemoveOrgMemberReturnsOnCall(i int, result1 error) {
+	fake.removeOrgMemberMutex.Lock()
+	defer fake.removeOrgMemberMutex.Unlock()
+	fake.RemoveOrgMemberStub = nil
+	if fake.removeOrgMemberReturnsOnCall == nil {
+		fake.removeOrgMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeOrgMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) ReplaceRecoveryCodes(arg1 context.Context, arg2 uuid.UUID, arg3 []string) error {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.replaceRecoveryCodesMutex.Lock()
+	ret, specificReturn := fake.replaceRecoveryCodesReturnsOnCall[len(fake.replaceRecoveryCodesArgsForCall)]
+	fake.replaceRecoveryCodesArgsForCall = append(fake.replaceRecoveryCodesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.ReplaceRecoveryCodesStub
+	fakeReturns := fake.replaceRecoveryCodesReturns
+	fake.recordInvocation("ReplaceRecoveryCodes", []interface{}{arg1, arg2, arg3Copy})
+	fake.replaceRecoveryCodesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
-	getByUsernameReturns struct {
-		result1 *model.User
-		result2 error
+	if specificReturn {
+		return ret.result1
 	}
-	getByUsernameReturnsOnCall map[int]struct {
-		result1 *model.User
-		result2 error
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) ReplaceRecoveryCodesCallCount() int {
+	fake.replaceRecoveryCodesMutex.RLock()
+	defer fake.replaceRecoveryCodesMutex.RUnlock()
+	return len(fake.replaceRecoveryCodesArgsForCall)
+}
+
+func (fake *FakeStore) ReplaceRecoveryCodesCalls(stub func(context.Context, uuid.UUID, []string) error) {
+	fake.replaceRecoveryCodesMutex.Lock()
+	defer fake.replaceRecoveryCodesMutex.Unlock()
+	fake.ReplaceRecoveryCodesStub = stub
+}
+
+func (fake *FakeStore) ReplaceRecoveryCodesArgsForCall(i int) (context.Context, uuid.UUID, []string) {
+	fake.replaceRecoveryCodesMutex.RLock()
+	defer fake.replaceRecoveryCodesMutex.RUnlock()
+	argsForCall := fake.replaceRecoveryCodesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) ReplaceRecoveryCodesReturns(result1 error) {
+	fake.replaceRecoveryCodesMutex.Lock()
+	defer fake.replaceRecoveryCodesMutex.Unlock()
+	fake.ReplaceRecoveryCodesStub = nil
+	fake.replaceRecoveryCodesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) ReplaceRecoveryCodesReturnsOnCall(i int, result1 error) {
+	fake.replaceRecoveryCodesMutex.Lock()
+	defer fake.replaceRecoveryCodesMutex.Unlock()
+	fake.ReplaceRecoveryCodesStub = nil
+	if fake.replaceRecoveryCodesReturnsOnCall == nil {
+		fake.replaceRecoveryCodesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
+	fake.replaceRecoveryCodesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStore) GetByUsername(arg1 context.Context, arg2 string) (*model.User, error) {
-	fake.getByUsernameMutex.Lock()
-	ret, specificReturn := fake.getByUsernameReturnsOnCall[len(fake.getByUsernameArgsForCall)]
-	fake.getByUsernameArgsForCall = append(fake.getByUsernameArgsForCall, struct {
+func (fake *FakeStore) RevokeAPIKey(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 time.Time) error {
+	fake.revokeAPIKeyMutex.Lock()
+	ret, specificReturn := fake.revokeAPIKeyReturnsOnCall[len(fake.revokeAPIKeyArgsForCall)]
+	fake.revokeAPIKeyArgsForCall = append(fake.revokeAPIKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RevokeAPIKeyStub
+	fakeReturns := fake.revokeAPIKeyReturns
+	fake.recordInvocation("RevokeAPIKey", []interface{}{arg1, arg2, arg3, arg4})
+	fake.revokeAPIKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) RevokeAPIKeyCallCount() int {
+	fake.revokeAPIKeyMutex.RLock()
+	defer fake.revokeAPIKeyMutex.RUnlock()
+	return len(fake.revokeAPIKeyArgsForCall)
+}
+
+func (fake *FakeStore) RevokeAPIKeyCalls(stub func(context.Context, uuid.UUID, uuid.UUID, time.Time) error) {
+	fake.revokeAPIKeyMutex.Lock()
+	defer fake.revokeAPIKeyMutex.Unlock()
+	fake.RevokeAPIKeyStub = stub
+}
+
+func (fake *FakeStore) RevokeAPIKeyArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, time.Time) {
+	fake.revokeAPIKeyMutex.RLock()
+	defer fake.revokeAPIKeyMutex.RUnlock()
+	argsForCall := fake.revokeAPIKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) RevokeAPIKeyReturns(result1 error) {
+	fake.revokeAPIKeyMutex.Lock()
+	defer fake.revokeAPIKeyMutex.Unlock()
+	fake.RevokeAPIKeyStub = nil
+	fake.revokeAPIKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) RevokeAPIKeyReturnsOnCall(i int, result1 error) {
+	fake.revokeAPIKeyMutex.Lock()
+	defer fake.revokeAPIKeyMutex.Unlock()
+	fake.RevokeAPIKeyStub = nil
+	if fake.revokeAPIKeyReturnsOnCall == nil {
+		fake.revokeAPIKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeAPIKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) RevokeRefreshTokenFamily(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) error {
+	fake.revokeRefreshTokenFamilyMutex.Lock()
+	ret, specificReturn := fake.revokeRefreshTokenFamilyReturnsOnCall[len(fake.revokeRefreshTokenFamilyArgsForCall)]
+	fake.revokeRefreshTokenFamilyArgsForCall = append(fake.revokeRefreshTokenFamilyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.RevokeRefreshTokenFamilyStub
+	fakeReturns := fake.revokeRefreshTokenFamilyReturns
+	fake.recordInvocation("RevokeRefreshTokenFamily", []interface{}{arg1, arg2, arg3})
+	fake.revokeRefreshTokenFamilyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) RevokeRefreshTokenFamilyCallCount() int {
+	fake.revokeRefreshTokenFamilyMutex.RLock()
+	defer fake.revokeRefreshTokenFamilyMutex.RUnlock()
+	return len(fake.revokeRefreshTokenFamilyArgsForCall)
+}
+
+func (fake *FakeStore) RevokeRefreshTokenFamilyCalls(stub func(context.Context, uuid.UUID, time.Time) error) {
+	fake.revokeRefreshTokenFamilyMutex.Lock()
+	defer fake.revokeRefreshTokenFamilyMutex.Unlock()
+	fake.RevokeRefreshTokenFamilyStub = stub
+}
+
+func (fake *FakeStore) RevokeRefreshTokenFamilyArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.revokeRefreshTokenFamilyMutex.RLock()
+	defer fake.revokeRefreshTokenFamilyMutex.RUnlock()
+	argsForCall := fake.revokeRefreshTokenFamilyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) RevokeRefreshTokenFamilyReturns(result1 error) {
+	fake.revokeRefreshTokenFamilyMutex.Lock()
+	defer fake.revokeRefreshTokenFamilyMutex.Unlock()
+	fake.RevokeRefreshTokenFamilyStub = nil
+	fake.revokeRefreshTokenFamilyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) RevokeRefreshTokenFamilyReturnsOnCall(i int, result1 error) {
+	fake.revokeRefreshTokenFamilyMutex.Lock()
+	defer fake.revokeRefreshTokenFamilyMutex.Unlock()
+	fake.RevokeRefreshTokenFamilyStub = nil
+	if fake.revokeRefreshTokenFamilyReturnsOnCall == nil {
+		fake.revokeRefreshTokenFamilyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeRefreshTokenFamilyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) RevokeRefreshTokensIssuedBefore(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 time.Time, arg5 time.Time) error {
+	fake.revokeRefreshTokensIssuedBeforeMutex.Lock()
+	ret, specificReturn := fake.revokeRefreshTokensIssuedBeforeReturnsOnCall[len(fake.revokeRefreshTokensIssuedBeforeArgsForCall)]
+	fake.revokeRefreshTokensIssuedBeforeArgsForCall = append(fake.revokeRefreshTokensIssuedBeforeArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 time.Time
+		arg5 time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.RevokeRefreshTokensIssuedBeforeStub
+	fakeReturns := fake.revokeRefreshTokensIssuedBeforeReturns
+	fake.recordInvocation("RevokeRefreshTokensIssuedBefore", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.revokeRefreshTokensIssuedBeforeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) RevokeRefreshTokensIssuedBeforeCallCount() int {
+	fake.revokeRefreshTokensIssuedBeforeMutex.RLock()
+	defer fake.revokeRefreshTokensIssuedBeforeMutex.RUnlock()
+	return len(fake.revokeRefreshTokensIssuedBeforeArgsForCall)
+}
+
+func (fake *FakeStore) RevokeRefreshTokensIssuedBeforeCalls(stub func(context.Context, uuid.UUID, uuid.UUID, time.Time, time.Time) error) {
+	fake.revokeRefreshTokensIssuedBeforeMutex.Lock()
+	defer fake.revokeRefreshTokensIssuedBeforeMutex.Unlock()
+	fake.RevokeRefreshTokensIssuedBeforeStub = stub
+}
+
+func (fake *FakeStore) RevokeRefreshTokensIssuedBeforeArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, time.Time, time.Time) {
+	fake.revokeRefreshTokensIssuedBeforeMutex.RLock()
+	defer fake.revokeRefreshTokensIssuedBeforeMutex.RUnlock()
+	argsForCall := fake.revokeRefreshTokensIssuedBeforeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeStore) RevokeRefreshTokensIssuedBeforeReturns(result1 error) {
+	fake.revokeRefreshTokensIssuedBeforeMutex.Lock()
+	defer fake.revokeRefreshTokensIssuedBeforeMutex.Unlock()
+	fake.RevokeRefreshTokensIssuedBeforeStub = nil
+	fake.revokeRefreshTokensIssuedBeforeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) RevokeRefreshTokensIssuedBeforeReturnsOnCall(i int, result1 error) {
+	fake.revokeRefreshTokensIssuedBeforeMutex.Lock()
+	defer fake.revokeRefreshTokensIssuedBeforeMutex.Unlock()
+	fake.RevokeRefreshTokensIssuedBeforeStub = nil
+	if fake.revokeRefreshTokensIssuedBeforeReturnsOnCall == nil {
+		fake.revokeRefreshTokensIssuedBeforeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeRefreshTokensIssuedBeforeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) RotateRefreshToken(arg1 context.Context, arg2 string, arg3 model.RefreshToken) error {
+	fake.rotateRefreshTokenMutex.Lock()
+	ret, specificReturn := fake.rotateRefreshTokenReturnsOnCall[len(fake.rotateRefreshTokenArgsForCall)]
+	fake.rotateRefreshTokenArgsForCall = append(fake.rotateRefreshTokenArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
+		arg3 model.RefreshToken
+	}{arg1, arg2, arg3})
+	stub := fake.RotateRefreshTokenStub
+	fakeReturns := fake.rotateRefreshTokenReturns
+	fake.recordInvocation("RotateRefreshToken", []interface{}{arg1, arg2, arg3})
+	fake.rotateRefreshTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) RotateRefreshTokenCallCount() int {
+	fake.rotateRefreshTokenMutex.RLock()
+	defer fake.rotateRefreshTokenMutex.RUnlock()
+	return len(fake.rotateRefreshTokenArgsForCall)
+}
+
+func (fake *FakeStore) RotateRefreshTokenCalls(stub func(context.Context, string, model.RefreshToken) error) {
+	fake.rotateRefreshTokenMutex.Lock()
+	defer fake.rotateRefreshTokenMutex.Unlock()
+	fake.RotateRefreshTokenStub = stub
+}
+
+func (fake *FakeStore) RotateRefreshTokenArgsForCall(i int) (context.Context, string, model.RefreshToken) {
+	fake.rotateRefreshTokenMutex.RLock()
+	defer fake.rotateRefreshTokenMutex.RUnlock()
+	argsForCall := fake.rotateRefreshTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) RotateRefreshTokenReturns(result1 error) {
+	fake.rotateRefreshTokenMutex.Lock()
+	defer fake.rotateRefreshTokenMutex.Unlock()
+	fake.RotateRefreshTokenStub = nil
+	fake.rotateRefreshTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) RotateRefreshTokenReturnsOnCall(i int, result1 error) {
+	fake.rotateRefreshTokenMutex.Lock()
+	defer fake.rotateRefreshTokenMutex.Unlock()
+	fake.RotateRefreshTokenStub = nil
+	if fake.rotateRefreshTokenReturnsOnCall == nil {
+		fake.rotateRefreshTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rotateRefreshTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) SaveMFAPolicy(arg1 context.Context, arg2 uuid.UUID, arg3 model.MFAPolicy) error {
+	fake.saveMFAPolicyMutex.Lock()
+	ret, specificReturn := fake.saveMFAPolicyReturnsOnCall[len(fake.saveMFAPolicyArgsForCall)]
+	fake.saveMFAPolicyArgsForCall = append(fake.saveMFAPolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.MFAPolicy
+	}{arg1, arg2, arg3})
+	stub := fake.SaveMFAPolicyStub
+	fakeReturns := fake.saveMFAPolicyReturns
+	fake.recordInvocation("SaveMFAPolicy", []interface{}{arg1, arg2, arg3})
+	fake.saveMFAPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) SaveMFAPolicyCallCount() int {
+	fake.saveMFAPolicyMutex.RLock()
+	defer fake.saveMFAPolicyMutex.RUnlock()
+	return len(fake.saveMFAPolicyArgsForCall)
+}
+
+func (fake *FakeStore) SaveMFAPolicyCalls(stub func(context.Context, uuid.UUID, model.MFAPolicy) error) {
+	fake.saveMFAPolicyMutex.Lock()
+	defer fake.saveMFAPolicyMutex.Unlock()
+	fake.SaveMFAPolicyStub = stub
+}
+
+func (fake *FakeStore) SaveMFAPolicyArgsForCall(i int) (context.Context, uuid.UUID, model.MFAPolicy) {
+	fake.saveMFAPolicyMutex.RLock()
+	defer fake.saveMFAPolicyMutex.RUnlock()
+	argsForCall := fake.saveMFAPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) SaveMFAPolicyReturns(result1 error) {
+	fake.saveMFAPolicyMutex.Lock()
+	defer fake.saveMFAPolicyMutex.Unlock()
+	fake.SaveMFAPolicyStub = nil
+	fake.saveMFAPolicyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) SaveMFAPolicyReturnsOnCall(i int, result1 error) {
+	fake.saveMFAPolicyMutex.Lock()
+	defer fake.saveMFAPolicyMutex.Unlock()
+	fake.SaveMFAPolicyStub = nil
+	if fake.saveMFAPolicyReturnsOnCall == nil {
+		fake.saveMFAPolicyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveMFAPolicyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) SaveUserMFA(arg1 context.Context, arg2 model.UserMFA) error {
+	fake.saveUserMFAMutex.Lock()
+	ret, specificReturn := fake.saveUserMFAReturnsOnCall[len(fake.saveUserMFAArgsForCall)]
+	fake.saveUserMFAArgsForCall = append(fake.saveUserMFAArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.UserMFA
 	}{arg1, arg2})
-	stub := fake.GetByUsernameStub
-	fakeReturns := fake.getByUsernameReturns
-	fake.recordInvocation("GetByUsername", []interface{}{arg1, arg2})
-	fake.getByUsernameMutex.Unlock()
+	stub := fake.SaveUserMFAStub
+	fakeReturns := fake.saveUserMFAReturns
+	fake.recordInvocation("SaveUserMFA", []interface{}{arg1, arg2})
+	fake.saveUserMFAMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) SaveUserMFACallCount() int {
+	fake.saveUserMFAMutex.RLock()
+	defer fake.saveUserMFAMutex.RUnlock()
+	return len(fake.saveUserMFAArgsForCall)
+}
+
+func (fake *FakeStore) SaveUserMFACalls(stub func(context.Context, model.UserMFA) error) {
+	fake.saveUserMFAMutex.Lock()
+	defer fake.saveUserMFAMutex.Unlock()
+	fake.SaveUserMFAStub = stub
+}
+
+func (fake *FakeStore) SaveUserMFAArgsForCall(i int) (context.Context, model.UserMFA) {
+	fake.saveUserMFAMutex.RLock()
+	defer fake.saveUserMFAMutex.RUnlock()
+	argsForCall := fake.saveUserMFAArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) SaveUserMFAReturns(result1 error) {
+	fake.saveUserMFAMutex.Lock()
+	defer fake.saveUserMFAMutex.Unlock()
+	fake.SaveUserMFAStub = nil
+	fake.saveUserMFAReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) SaveUserMFAReturnsOnCall(i int, result1 error) {
+	fake.saveUserMFAMutex.Lock()
+	defer fake.saveUserMFAMutex.Unlock()
+	fake.SaveUserMFAStub = nil
+	if fake.saveUserMFAReturnsOnCall == nil {
+		fake.saveUserMFAReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveUserMFAReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) SetUserDisabled(arg1 context.Context, arg2 uuid.UUID, arg3 bool) error {
+	fake.setUserDisabledMutex.Lock()
+	ret, specificReturn := fake.setUserDisabledReturnsOnCall[len(fake.setUserDisabledArgsForCall)]
+	fake.setUserDisabledArgsForCall = append(fake.setUserDisabledArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.SetUserDisabledStub
+	fakeReturns := fake.setUserDisabledReturns
+	fake.recordInvocation("SetUserDisabled", []interface{}{arg1, arg2, arg3})
+	fake.setUserDisabledMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) SetUserDisabledCallCount() int {
+	fake.setUserDisabledMutex.RLock()
+	defer fake.setUserDisabledMutex.RUnlock()
+	return len(fake.setUserDisabledArgsForCall)
+}
+
+func (fake *FakeStore) SetUserDisabledCalls(stub func(context.Context, uuid.UUID, bool) error) {
+	fake.setUserDisabledMutex.Lock()
+	defer fake.setUserDisabledMutex.Unlock()
+	fake.SetUserDisabledStub = stub
+}
+
+func (fake *FakeStore) SetUserDisabledArgsForCall(i int) (context.Context, uuid.UUID, bool) {
+	fake.setUserDisabledMutex.RLock()
+	defer fake.setUserDisabledMutex.RUnlock()
+	argsForCall := fake.setUserDisabledArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) SetUserDisabledReturns(result1 error) {
+	fake.setUserDisabledMutex.Lock()
+	defer fake.setUserDisabledMutex.Unlock()
+	fake.SetUserDisabledStub = nil
+	fake.setUserDisabledReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) SetUserDisabledReturnsOnCall(i int, result1 error) {
+	fake.setUserDisabledMutex.Lock()
+	defer fake.setUserDisabledMutex.Unlock()
+	fake.SetUserDisabledStub = nil
+	if fake.setUserDisabledReturnsOnCall == nil {
+		fake.setUserDisabledReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setUserDisabledReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) UpdateOrgMemberRole(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 model.RoleType) error {
+	fake.updateOrgMemberRoleMutex.Lock()
+	ret, specificReturn := fake.updateOrgMemberRoleReturnsOnCall[len(fake.updateOrgMemberRoleArgsForCall)]
+	fake.updateOrgMemberRoleArgsForCall = append(fake.updateOrgMemberRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.RoleType
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateOrgMemberRoleStub
+	fakeReturns := fake.updateOrgMemberRoleReturns
+	fake.recordInvocation("UpdateOrgMemberRole", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateOrgMemberRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) UpdateOrgMemberRoleCallCount() int {
+	fake.updateOrgMemberRoleMutex.RLock()
+	defer fake.updateOrgMemberRoleMutex.RUnlock()
+	return len(fake.updateOrgMemberRoleArgsForCall)
+}
+
+func (fake *FakeStore) UpdateOrgMemberRoleCalls(stub func(context.Context, uuid.UUID, uuid.UUID, model.RoleType) error) {
+	fake.updateOrgMemberRoleMutex.Lock()
+	defer fake.updateOrgMemberRoleMutex.Unlock()
+	fake.UpdateOrgMemberRoleStub = stub
+}
+
+func (fake *FakeStore) UpdateOrgMemberRoleArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, model.RoleType) {
+	fake.updateOrgMemberRoleMutex.RLock()
+	defer fake.updateOrgMemberRoleMutex.RUnlock()
+	argsForCall := fake.updateOrgMemberRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) UpdateOrgMemberRoleReturns(result1 error) {
+	fake.updateOrgMemberRoleMutex.Lock()
+	defer fake.updateOrgMemberRoleMutex.Unlock()
+	fake.UpdateOrgMemberRoleStub = nil
+	fake.updateOrgMemberRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) UpdateOrgMemberRoleReturnsOnCall(i int, result1 error) {
+	fake.updateOrgMemberRoleMutex.Lock()
+	defer fake.updateOrgMemberRoleMutex.Unlock()
+	fake.UpdateOrgMemberRoleStub = nil
+	if fake.updateOrgMemberRoleReturnsOnCall == nil {
+		fake.updateOrgMemberRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateOrgMemberRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) UpdateUserPassword(arg1 context.Context, arg2 uuid.UUID, arg3 string) error {
+	fake.updateUserPasswordMutex.Lock()
+	ret, specificReturn := fake.updateUserPasswordReturnsOnCall[len(fake.updateUserPasswordArgsForCall)]
+	fake.updateUserPasswordArgsForCall = append(fake.updateUserPasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateUserPasswordStub
+	fakeReturns := fake.updateUserPasswordReturns
+	fake.recordInvocation("UpdateUserPassword", []interface{}{arg1, arg2, arg3})
+	fake.updateUserPasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) UpdateUserPasswordCallCount() int {
+	fake.updateUserPasswordMutex.RLock()
+	defer fake.updateUserPasswordMutex.RUnlock()
+	return len(fake.updateUserPasswordArgsForCall)
+}
+
+func (fake *FakeStore) UpdateUserPasswordCalls(stub func(context.Context, uuid.UUID, string) error) {
+	fake.updateUserPasswordMutex.Lock()
+	defer fake.updateUserPasswordMutex.Unlock()
+	fake.UpdateUserPasswordStub = stub
+}
+
+func (fake *FakeStore) UpdateUserPasswordArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.updateUserPasswordMutex.RLock()
+	defer fake.updateUserPasswordMutex.RUnlock()
+	argsForCall := fake.updateUserPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) UpdateUserPasswordReturns(result1 error) {
+	fake.updateUserPasswordMutex.Lock()
+	defer fake.updateUserPasswordMutex.Unlock()
+	fake.UpdateUserPasswordStub = nil
+	fake.updateUserPasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) UpdateUserPasswordReturnsOnCall(i int, result1 error) {
+	fake.updateUserPasswordMutex.Lock()
+	defer fake.updateUserPasswordMutex.Unlock()
+	fake.UpdateUserPasswordStub = nil
+	if fake.updateUserPasswordReturnsOnCall == nil {
+		fake.updateUserPasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateUserPasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) UseMFAStep(arg1 context.Context, arg2 uuid.UUID, arg3 int64) (bool, error) {
+	fake.useMFAStepMutex.Lock()
+	ret, specificReturn := fake.useMFAStepReturnsOnCall[len(fake.useMFAStepArgsForCall)]
+	fake.useMFAStepArgsForCall = append(fake.useMFAStepArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int64
+	}{arg1, arg2, arg3})
+	stub := fake.UseMFAStepStub
+	fakeReturns := fake.useMFAStepReturns
+	fake.recordInvocation("UseMFAStep", []interface{}{arg1, arg2, arg3})
+	fake.useMFAStepMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStore) GetByUsernameCallCount() int {
-	fake.getByUsernameMutex.RLock()
-	defer fake.getByUsernameMutex.RUnlock()
-	return len(fake.getByUsernameArgsForCall)
+func (fake *FakeStore) UseMFAStepCallCount() int {
+	fake.useMFAStepMutex.RLock()
+	defer fake.useMFAStepMutex.RUnlock()
+	return len(fake.useMFAStepArgsForCall)
 }
 
-func (fake *FakeStore) GetByUsernameCalls(stub func(context.Context, string) (*model.User, error)) {
-	fake.getByUsernameMutex.Lock()
-	defer fake.getByUsernameMutex.Unlock()
-	fake.GetByUsernameStub = stub
+func (fake *FakeStore) UseMFAStepCalls(stub func(context.Context, uuid.UUID, int64) (bool, error)) {
+	fake.useMFAStepMutex.Lock()
+	defer fake.useMFAStepMutex.Unlock()
+	fake.UseMFAStepStub = stub
 }
 
-func (fake *FakeStore) GetByUsernameArgsForCall(i int) (context.Context, string) {
-	fake.getByUsernameMutex.RLock()
-	defer fake.getByUsernameMutex.RUnlock()
-	argsForCall := fake.getByUsernameArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStore) UseMFAStepArgsForCall(i int) (context.Context, uuid.UUID, int64) {
+	fake.useMFAStepMutex.RLock()
+	defer fake.useMFAStepMutex.RUnlock()
+	argsForCall := fake.useMFAStepArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStore) GetByUsernameReturns(result1 *model.User, result2 error) {
-	fake.getByUsernameMutex.Lock()
-	defer fake.getByUsernameMutex.Unlock()
-	fake.GetByUsernameStub = nil
-	fake.getByUsernameReturns = struct {
-		result1 *model.User
+func (fake *FakeStore) UseMFAStepReturns(result1 bool, result2 error) {
+	fake.useMFAStepMutex.Lock()
+	defer fake.useMFAStepMutex.Unlock()
+	fake.UseMFAStepStub = nil
+	fake.useMFAStepReturns = struct {
+		result1 bool
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStore) GetByUsernameReturnsOnCall(i int, result1 *model.User, result2 error) {
-	fake.getByUsernameMutex.Lock()
-	defer fake.getByUsernameMutex.Unlock()
-	fake.GetByUsernameStub = nil
-	if fake.getByUsernameReturnsOnCall == nil {
-		fake.getByUsernameReturnsOnCall = make(map[int]struct {
-			result1 *model.User
+func (fake *FakeStore) UseMFAStepReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.useMFAStepMutex.Lock()
+	defer fake.useMFAStepMutex.Unlock()
+	fake.UseMFAStepStub = nil
+	if fake.useMFAStepReturnsOnCall == nil {
+		fake.useMFAStepReturnsOnCall = make(map[int]struct {
+			result1 bool
 			result2 error
 		})
 	}
-	fake.getByUsernameReturnsOnCall[i] = struct {
-		result1 *model.User
+	fake.useMFAStepReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) UseRecoveryCode(arg1 context.Context, arg2 uuid.UUID, arg3 string) (bool, error) {
+	fake.useRecoveryCodeMutex.Lock()
+	ret, specificReturn := fake.useRecoveryCodeReturnsOnCall[len(fake.useRecoveryCodeArgsForCall)]
+	fake.useRecoveryCodeArgsForCall = append(fake.useRecoveryCodeArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UseRecoveryCodeStub
+	fakeReturns := fake.useRecoveryCodeReturns
+	fake.recordInvocation("UseRecoveryCode", []interface{}{arg1, arg2, arg3})
+	fake.useRecoveryCodeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) UseRecoveryCodeCallCount() int {
+	fake.useRecoveryCodeMutex.RLock()
+	defer fake.useRecoveryCodeMutex.RUnlock()
+	return len(fake.useRecoveryCodeArgsForCall)
+}
+
+func (fake *FakeStore) UseRecoveryCodeCalls(stub func(context.Context, uuid.UUID, string) (bool, error)) {
+	fake.useRecoveryCodeMutex.Lock()
+	defer fake.useRecoveryCodeMutex.Unlock()
+	fake.UseRecoveryCodeStub = stub
+}
+
+func (fake *FakeStore) UseRecoveryCodeArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.useRecoveryCodeMutex.RLock()
+	defer fake.useRecoveryCodeMutex.RUnlock()
+	argsForCall := fake.useRecoveryCodeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) UseRecoveryCodeReturns(result1 bool, result2 error) {
+	fake.useRecoveryCodeMutex.Lock()
+	defer fake.useRecoveryCodeMutex.Unlock()
+	fake.UseRecoveryCodeStub = nil
+	fake.useRecoveryCodeReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) UseRecoveryCodeReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.useRecoveryCodeMutex.Lock()
+	defer fake.useRecoveryCodeMutex.Unlock()
+	fake.UseRecoveryCodeStub = nil
+	if fake.useRecoveryCodeReturnsOnCall == nil {
+		fake.useRecoveryCodeReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.useRecoveryCodeReturnsOnCall[i] = struct {
+		result1 bool
 		result2 error
 	}{result1, result2}
 }
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// CreateUser creates a user as a member of the given organization.
+func (a *Service) CreateUser(ctx context.Context, orgID uuid.UUID, req model.CreateUserRequest) (model.User, error) {
+	hash, err := hashPassword(req.Password)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	user := model.User{
+		ID:       uuid.New(),
+		Username: req.Username,
+		Password: hash,
+		Role:     req.Role,
+	}
+	if err := a.store.CreateUser(ctx, user, orgID); err != nil {
+		return model.User{}, fmt.Errorf("failed to create user: %w", err)
+	}
+	return user, nil
+}
+
+// ListUsers returns the members of the organization.
+func (a *Service) ListUsers(ctx context.Context, orgID uuid.UUID) ([]model.User, error) {
+	users, err := a.store.ListUsers(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser returns the user with their role in the organization if they are a member of it. Users
+// of other organizations are reported as not found, like the ones that do not exist.
+func (a *Service) GetUser(ctx context.Context, orgID, id uuid.UUID) (model.User, error) {
+	role, err := a.store.GetOrgMemberRole(ctx, orgID, id)
+	if err != nil {
+		if errors.Is(err, model.ErrMemberNotFound) {
+			return model.User{}, model.ErrUserNotFound
+		}
+		return model.User{}, fmt.Errorf("failed to get organization role: %w", err)
+	}
+	user, err := a.user(ctx, id)
+	if err != nil {
+		return model.User{}, err
+	}
+	user.Role = role
+	return user, nil
+}
+
+func (a *Service) user(ctx context.Context, id uuid.UUID) (model.User, error) {
+	user, err := a.store.GetByID(ctx, id)
+	if err != nil {
+		return model.User{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return model.User{}, model.ErrUserNotFound
+	}
+	return *user, nil
+}
+
+// UpdateUserRole changes the role of the user in the organization, which applies to the tokens
+// issued from then on.
+func (a *Service) UpdateUserRole(ctx context.Context, orgID, userID, id uuid.UUID, role model.RoleType) error {
+	if userID == id {
+		return ErrSelfManagement
+	}
+	if err := a.store.UpdateOrgMemberRole(ctx, orgID, id, role); err != nil {
+		if errors.Is(err, model.ErrMemberNotFound) {
+			return model.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to update user role: %w", err)
+	}
+	return nil
+}
+
+func (a *Service) SetUserDisabled(ctx context.Context, orgID, userID, id uuid.UUID, disabled bool) error {
+	if userID == id {
+		return ErrSelfManagement
+	}
+	if err := a.ensureSoleOrgUser(ctx, orgID, id); err != nil {
+		return err
+	}
+	if err := a.store.SetUserDisabled(ctx, id, disabled); err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+	return nil
+}
+
+func (a *Service) ResetUserPassword(ctx context.Context, orgID, id uuid.UUID, password string) error {
+	if err := a.ensureSoleOrgUser(ctx, orgID, id); err != nil {
+		return err
+	}
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	if err := a.store.UpdateUserPassword(ctx, id, hash); err != nil {
+		return fmt.Errorf("failed to reset password: %w", err)
+	}
+	return nil
+}
+
+func (a *Service) DeleteUser(ctx context.Context, orgID, userID, id uuid.UUID) error {
+	if userID == id {
+		return ErrSelfManagement
+	}
+	if err := a.ensureSoleOrgUser(ctx, orgID, id); err != nil {
+		return err
+	}
+	if err := a.store.DeleteUser(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	return nil
+}
+
+// ensureOrgUser hides users who are not members of the organization by reporting them as not
+// found, which keeps the admins of one organization from managing the users of another.
+func (a *Service) ensureOrgUser(ctx context.Context, orgID, id uuid.UUID) error {
+	isMember, err := a.store.IsOrgMember(ctx, orgID, id)
+	if err != nil {
+		return fmt.Errorf("failed to check organization membership: %w", err)
+	}
+	if !isMember {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
+
+// ensureSoleOrgUser is ensureOrgUser for changes to the account itself. The password, the MFA and
+// the disabled state are shared by all the organizations of the user, so the admins of one
+// organization may only change them, or delete the user, when the user is a member of no other.
+func (a *Service) ensureSoleOrgUser(ctx context.Context, orgID, id uuid.UUID) error {
+	if err := a.ensureOrgUser(ctx, orgID, id); err != nil {
+		return err
+	}
+	orgs, err := a.store.ListUserOrgs(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to list organizations: %w", err)
+	}
+	if len(orgs) > 1 {
+		return ErrUserInOtherOrgs
+	}
+	return nil
+}
+
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
@@ -0,0 +1,186 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/service/servicefakes"
+)
+
+var _ = Describe("Users", func() {
+	var (
+		ctx    context.Context
+		store  *servicefakes.FakeStore
+		svc    *service.Service
+		orgID  uuid.UUID
+		userID uuid.UUID
+		id     uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = &servicefakes.FakeStore{}
+		store.IsOrgMemberReturns(true, nil)
+		store.GetOrgMemberRoleReturns(model.RoleEditor, nil)
+		svc = service.NewService(store, &servicefakes.FakeDenylist{}, &servicefakes.FakeJWTHelper{}, 0)
+		orgID = uuid.New()
+		userID = uuid.New()
+		id = uuid.New()
+	})
+
+	Describe("CreateUser", func() {
+		var req model.CreateUserRequest
+
+		BeforeEach(func() {
+			req = model.CreateUserRequest{Username: "jane", Password: "correct-horse", Role: model.RoleEditor}
+		})
+
+		It("stores the user with a hashed password in the organization", func() {
+			user, err := svc.CreateUser(ctx, orgID, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, stored, actualOrgID := store.CreateUserArgsForCall(0)
+			Expect(stored).To(Equal(user))
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(stored.Username).To(Equal("jane"))
+			Expect(stored.Role).To(Equal(model.RoleEditor))
+			Expect(bcrypt.CompareHashAndPassword([]byte(stored.Password), []byte("correct-horse"))).To(Succeed())
+		})
+
+		It("returns the errors of the store", func() {
+			store.CreateUserReturns(model.ErrUserExists)
+			_, err := svc.CreateUser(ctx, orgID, req)
+			Expect(err).To(MatchError(model.ErrUserExists))
+		})
+	})
+
+	Describe("ListUsers", func() {
+		It("lists the members of the organization", func() {
+			store.ListUsersReturns([]model.User{{ID: id, Username: "jane"}}, nil)
+
+			users, err := svc.ListUsers(ctx, orgID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users).To(ConsistOf(model.User{ID: id, Username: "jane"}))
+			_, actualOrgID := store.ListUsersArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+		})
+	})
+
+	Describe("GetUser", func() {
+		It("returns the member of the organization with their role in it", func() {
+			store.GetByIDReturns(&model.User{ID: id, Username: "jane"}, nil)
+
+			user, err := svc.GetUser(ctx, orgID, id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(user.Username).To(Equal("jane"))
+			Expect(user.Role).To(Equal(model.RoleEditor))
+			_, actualOrgID, actualID := store.GetOrgMemberRoleArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(id))
+		})
+
+		It("returns ErrUserNotFound for unknown users", func() {
+			store.GetByIDReturns(nil, nil)
+			_, err := svc.GetUser(ctx, orgID, id)
+			Expect(err).To(MatchError(model.ErrUserNotFound))
+		})
+	})
+
+	Describe("ResetUserPassword", func() {
+		It("stores the hash of the new password", func() {
+			Expect(svc.ResetUserPassword(ctx, orgID, id, "correct-horse")).To(Succeed())
+
+			_, actualID, hash := store.UpdateUserPasswordArgsForCall(0)
+			Expect(actualID).To(Equal(id))
+			Expect(bcrypt.CompareHashAndPassword([]byte(hash), []byte("correct-horse"))).To(Succeed())
+		})
+	})
+
+	Describe("managing other users", func() {
+		It("changes their role in the organization", func() {
+			Expect(svc.UpdateUserRole(ctx, orgID, userID, id, model.RoleAdmin)).To(Succeed())
+			_, actualOrgID, actualID, role := store.UpdateOrgMemberRoleArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(id))
+			Expect(role).To(Equal(model.RoleAdmin))
+		})
+
+		It("disables them", func() {
+			Expect(svc.SetUserDisabled(ctx, orgID, userID, id, true)).To(Succeed())
+			_, actualID, disabled := store.SetUserDisabledArgsForCall(0)
+			Expect(actualID).To(Equal(id))
+			Expect(disabled).To(BeTrue())
+		})
+
+		It("deletes them", func() {
+			Expect(svc.DeleteUser(ctx, orgID, userID, id)).To(Succeed())
+			_, actualID := store.DeleteUserArgsForCall(0)
+			Expect(actualID).To(Equal(id))
+		})
+	})
+
+	Describe("managing the users of other organizations", func() {
+		BeforeEach(func() {
+			store.IsOrgMemberReturns(false, nil)
+			store.GetOrgMemberRoleReturns("", model.ErrMemberNotFound)
+			store.UpdateOrgMemberRoleReturns(model.ErrMemberNotFound)
+			store.GetByIDReturns(&model.User{ID: id, Username: "jane"}, nil)
+		})
+
+		It("reports them as not found", func() {
+			_, err := svc.GetUser(ctx, orgID, id)
+			Expect(err).To(MatchError(model.ErrUserNotFound))
+			Expect(svc.UpdateUserRole(ctx, orgID, userID, id, model.RoleAdmin)).To(MatchError(model.ErrUserNotFound))
+			Expect(svc.SetUserDisabled(ctx, orgID, userID, id, true)).To(MatchError(model.ErrUserNotFound))
+			Expect(svc.ResetUserPassword(ctx, orgID, id, "correct-horse")).To(MatchError(model.ErrUserNotFound))
+			Expect(svc.UnlockUser(ctx, orgID, id)).To(MatchError(model.ErrUserNotFound))
+			Expect(svc.DeleteUser(ctx, orgID, userID, id)).To(MatchError(model.ErrUserNotFound))
+
+			Expect(store.SetUserDisabledCallCount()).To(BeZero())
+			Expect(store.UpdateUserPasswordCallCount()).To(BeZero())
+			Expect(store.ClearLoginFailuresCallCount()).To(BeZero())
+			Expect(store.DeleteUserCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("managing the users who are members of other organizations too", func() {
+		BeforeEach(func() {
+			store.ListUserOrgsReturns([]model.Organization{{ID: orgID}, {ID: uuid.New()}}, nil)
+		})
+
+		It("changes their role in the organization", func() {
+			Expect(svc.UpdateUserRole(ctx, orgID, userID, id, model.RoleViewer)).To(Succeed())
+			Expect(store.UpdateOrgMemberRoleCallCount()).To(Equal(1))
+		})
+
+		It("refuses the changes to the account", func() {
+			Expect(svc.SetUserDisabled(ctx, orgID, userID, id, true)).To(MatchError(service.ErrUserInOtherOrgs))
+			Expect(svc.ResetUserPassword(ctx, orgID, id, "correct-horse")).To(MatchError(service.ErrUserInOtherOrgs))
+			Expect(svc.ResetUserMFA(ctx, orgID, id)).To(MatchError(service.ErrUserInOtherOrgs))
+			Expect(svc.DeleteUser(ctx, orgID, userID, id)).To(MatchError(service.ErrUserInOtherOrgs))
+
+			Expect(store.SetUserDisabledCallCount()).To(BeZero())
+			Expect(store.UpdateUserPasswordCallCount()).To(BeZero())
+			Expect(store.DeleteUserMFACallCount()).To(BeZero())
+			Expect(store.DeleteUserCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("managing oneself", func() {
+		It("is rejected", func() {
+			Expect(svc.UpdateUserRole(ctx, orgID, userID, userID, model.RoleViewer)).To(MatchError(service.ErrSelfManagement))
+			Expect(svc.SetUserDisabled(ctx, orgID, userID, userID, true)).To(MatchError(service.ErrSelfManagement))
+			Expect(svc.DeleteUser(ctx, orgID, userID, userID)).To(MatchError(service.ErrSelfManagement))
+
+			Expect(store.UpdateOrgMemberRoleCallCount()).To(BeZero())
+			Expect(store.SetUserDisabledCallCount()).To(BeZero())
+			Expect(store.DeleteUserCallCount()).To(BeZero())
+		})
+	})
+})
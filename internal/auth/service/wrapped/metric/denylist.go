@@ -0,0 +1,95 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../../../../observability/templates/otel_metric.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package metric
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	_sourceService "github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type DenylistMetrics struct {
+	RequestCounter  metric.Int64Counter
+	RequestDuration metric.Float64Histogram
+}
+
+type DenylistWithMetrics struct {
+	base    _sourceService.Denylist
+	metrics *DenylistMetrics
+}
+
+func NewDenylistWithMetrics(base _sourceService.Denylist) *DenylistWithMetrics {
+	meter := otel.GetMeterProvider().Meter("")
+
+	requestCounter, _ := meter.Int64Counter("Denylist_requests_total", metric.WithDescription("Total number of Denylist method calls"))
+	durationHistogram, _ := meter.Float64Histogram("Denylist_request_duration_ms", metric.WithDescription("Duration of Denylist method calls in milliseconds"))
+
+	return &DenylistWithMetrics{
+		base: base,
+		metrics: &DenylistMetrics{
+			RequestCounter:  requestCounter,
+			RequestDuration: durationHistogram,
+		},
+	}
+}
+
+func (_d *DenylistWithMetrics) IsRevoked(m1 jwt.MapClaims) (b1 bool) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "IsRevoked"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "IsRevoked")))
+	}()
+	return _d.base.IsRevoked(m1)
+}
+
+func (_d *DenylistWithMetrics) Revoke(ctx context.Context, t1 model.TokenRevocation) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "Revoke"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "Revoke")))
+	}()
+	return _d.base.Revoke(ctx, t1)
+}
@@ -66,3 +66,54 @@ func (_d *JWTHelperWithMetrics) GenerateToken(c1 jwt.Claims) (s1 string, err err
 	}()
 	return _d.base.GenerateToken(c1)
 }
+
+func (_d *JWTHelperWithMetrics) Issuer() (s1 string) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "Issuer"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "Issuer")))
+	}()
+	return _d.base.Issuer()
+}
+
+func (_d *JWTHelperWithMetrics) ValidateToken(s1 string) (m1 jwt.MapClaims, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ValidateToken"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ValidateToken")))
+	}()
+	return _d.base.ValidateToken(s1)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
 	_sourceService "github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -40,6 +41,528 @@ func NewStoreWithMetrics(base _sourceService.Store) *StoreWithMetrics {
 	}
 }
 
+func (_d *StoreWithMetrics) AcceptOrgInvitation(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "AcceptOrgInvitation"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "AcceptOrgInvitation")))
+	}()
+	return _d.base.AcceptOrgInvitation(ctx, u1, u2)
+}
+
+func (_d *StoreWithMetrics) AddOrgMember(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, r1 model.RoleType) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "AddOrgMember"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "AddOrgMember")))
+	}()
+	return _d.base.AddOrgMember(ctx, u1, u2, r1)
+}
+
+func (_d *StoreWithMetrics) ClearLoginFailures(ctx context.Context, s1 string) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ClearLoginFailures"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ClearLoginFailures")))
+	}()
+	return _d.base.ClearLoginFailures(ctx, s1)
+}
+
+func (_d *StoreWithMetrics) CountRecoveryCodes(ctx context.Context, u1 uuid.UUID) (i1 int, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CountRecoveryCodes"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CountRecoveryCodes")))
+	}()
+	return _d.base.CountRecoveryCodes(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) CreateAPIKey(ctx context.Context, a1 model.APIKey) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CreateAPIKey"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateAPIKey")))
+	}()
+	return _d.base.CreateAPIKey(ctx, a1)
+}
+
+func (_d *StoreWithMetrics) CreateExternalIdentity(ctx context.Context, e1 model.ExternalIdentity) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CreateExternalIdentity"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateExternalIdentity")))
+	}()
+	return _d.base.CreateExternalIdentity(ctx, e1)
+}
+
+func (_d *StoreWithMetrics) CreateOAuthClient(ctx context.Context, o1 model.OAuthClient) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CreateOAuthClient"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateOAuthClient")))
+	}()
+	return _d.base.CreateOAuthClient(ctx, o1)
+}
+
+func (_d *StoreWithMetrics) CreateOrg(ctx context.Context, o1 model.Organization, u1 uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CreateOrg"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateOrg")))
+	}()
+	return _d.base.CreateOrg(ctx, o1, u1)
+}
+
+func (_d *StoreWithMetrics) CreateOrgInvitation(ctx context.Context, o1 model.OrgInvitation) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CreateOrgInvitation"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateOrgInvitation")))
+	}()
+	return _d.base.CreateOrgInvitation(ctx, o1)
+}
+
+func (_d *StoreWithMetrics) CreateRefreshToken(ctx context.Context, r1 model.RefreshToken) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CreateRefreshToken"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateRefreshToken")))
+	}()
+	return _d.base.CreateRefreshToken(ctx, r1)
+}
+
+func (_d *StoreWithMetrics) CreateUser(ctx context.Context, u1 model.User, u2 uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CreateUser"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateUser")))
+	}()
+	return _d.base.CreateUser(ctx, u1, u2)
+}
+
+func (_d *StoreWithMetrics) DeleteExternalIdentity(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "DeleteExternalIdentity"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "DeleteExternalIdentity")))
+	}()
+	return _d.base.DeleteExternalIdentity(ctx, u1, u2)
+}
+
+func (_d *StoreWithMetrics) DeleteOAuthClient(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "DeleteOAuthClient"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "DeleteOAuthClient")))
+	}()
+	return _d.base.DeleteOAuthClient(ctx, u1, u2)
+}
+
+func (_d *StoreWithMetrics) DeleteOrgInvitation(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "DeleteOrgInvitation"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "DeleteOrgInvitation")))
+	}()
+	return _d.base.DeleteOrgInvitation(ctx, u1, u2)
+}
+
+func (_d *StoreWithMetrics) DeleteUser(ctx context.Context, u1 uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "DeleteUser"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "DeleteUser")))
+	}()
+	return _d.base.DeleteUser(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) DeleteUserMFA(ctx context.Context, u1 uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "DeleteUserMFA"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "DeleteUserMFA")))
+	}()
+	return _d.base.DeleteUserMFA(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) EnableUserMFA(ctx context.Context, u1 uuid.UUID, t1 time.Time) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "EnableUserMFA"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "EnableUserMFA")))
+	}()
+	return _d.base.EnableUserMFA(ctx, u1, t1)
+}
+
+func (_d *StoreWithMetrics) GetByID(ctx context.Context, u1 uuid.UUID) (up1 *model.User, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetByID"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetByID")))
+	}()
+	return _d.base.GetByID(ctx, u1)
+}
+
 func (_d *StoreWithMetrics) GetByUsername(ctx context.Context, s1 string) (up1 *model.User, err error) {
 	startTime := time.Now()
 
@@ -59,12 +582,882 @@ func (_d *StoreWithMetrics) GetByUsername(ctx context.Context, s1 string) (up1 *
 
 		_d.metrics.RequestCounter.Add(metricCtx, 1,
 			metric.WithAttributes(
-				attribute.String("method", "GetByUsername"),
+				attribute.String("method", "GetByUsername"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetByUsername")))
+	}()
+	return _d.base.GetByUsername(ctx, s1)
+}
+
+func (_d *StoreWithMetrics) GetLoginFailures(ctx context.Context, s1 string) (l1 model.LoginFailures, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetLoginFailures"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetLoginFailures")))
+	}()
+	return _d.base.GetLoginFailures(ctx, s1)
+}
+
+func (_d *StoreWithMetrics) GetMFAPolicy(ctx context.Context, u1 uuid.UUID) (m1 model.MFAPolicy, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetMFAPolicy"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetMFAPolicy")))
+	}()
+	return _d.base.GetMFAPolicy(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) GetOAuthClient(ctx context.Context, u1 uuid.UUID) (o1 model.OAuthClient, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetOAuthClient"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetOAuthClient")))
+	}()
+	return _d.base.GetOAuthClient(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) GetOrgByID(ctx context.Context, u1 uuid.UUID) (o1 model.Organization, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetOrgByID"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetOrgByID")))
+	}()
+	return _d.base.GetOrgByID(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) GetOrgMemberRole(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (r1 model.RoleType, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetOrgMemberRole"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetOrgMemberRole")))
+	}()
+	return _d.base.GetOrgMemberRole(ctx, u1, u2)
+}
+
+func (_d *StoreWithMetrics) GetRefreshToken(ctx context.Context, s1 string) (r1 model.RefreshToken, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetRefreshToken"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetRefreshToken")))
+	}()
+	return _d.base.GetRefreshToken(ctx, s1)
+}
+
+func (_d *StoreWithMetrics) GetUserMFA(ctx context.Context, u1 uuid.UUID) (u2 model.UserMFA, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetUserMFA"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetUserMFA")))
+	}()
+	return _d.base.GetUserMFA(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) IsOrgMember(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (b1 bool, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "IsOrgMember"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "IsOrgMember")))
+	}()
+	return _d.base.IsOrgMember(ctx, u1, u2)
+}
+
+func (_d *StoreWithMetrics) ListAPIKeys(ctx context.Context, u1 uuid.UUID) (aa1 []model.APIKey, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListAPIKeys"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListAPIKeys")))
+	}()
+	return _d.base.ListAPIKeys(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) ListExternalIdentities(ctx context.Context, u1 uuid.UUID) (ea1 []model.ExternalIdentity, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListExternalIdentities"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListExternalIdentities")))
+	}()
+	return _d.base.ListExternalIdentities(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) ListOAuthClients(ctx context.Context, u1 uuid.UUID) (oa1 []model.OAuthClient, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListOAuthClients"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListOAuthClients")))
+	}()
+	return _d.base.ListOAuthClients(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) ListOrgMembers(ctx context.Context, u1 uuid.UUID) (oa1 []model.OrganizationMember, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListOrgMembers"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListOrgMembers")))
+	}()
+	return _d.base.ListOrgMembers(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) ListUserInvitations(ctx context.Context, u1 uuid.UUID) (oa1 []model.OrgInvitation, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListUserInvitations"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListUserInvitations")))
+	}()
+	return _d.base.ListUserInvitations(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) ListUserOrgs(ctx context.Context, u1 uuid.UUID) (oa1 []model.Organization, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListUserOrgs"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListUserOrgs")))
+	}()
+	return _d.base.ListUserOrgs(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) ListUsers(ctx context.Context, u1 uuid.UUID) (ua1 []model.User, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListUsers"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListUsers")))
+	}()
+	return _d.base.ListUsers(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) RecordLoginFailure(ctx context.Context, s1 string, t1 time.Time, t2 time.Time) (l1 model.LoginFailures, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "RecordLoginFailure"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "RecordLoginFailure")))
+	}()
+	return _d.base.RecordLoginFailure(ctx, s1, t1, t2)
+}
+
+func (_d *StoreWithMetrics) ReleaseLoginFailure(ctx context.Context, s1 string, i1 int, t1 time.Time) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ReleaseLoginFailure"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ReleaseLoginFailure")))
+	}()
+	return _d.base.ReleaseLoginFailure(ctx, s1, i1, t1)
+}
+
+func (_d *StoreWithMetrics) RemoveOrgMember(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "RemoveOrgMember"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "RemoveOrgMember")))
+	}()
+	return _d.base.RemoveOrgMember(ctx, u1, u2)
+}
+
+func (_d *StoreWithMetrics) ReplaceRecoveryCodes(ctx context.Context, u1 uuid.UUID, sa1 []string) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ReplaceRecoveryCodes"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ReplaceRecoveryCodes")))
+	}()
+	return _d.base.ReplaceRecoveryCodes(ctx, u1, sa1)
+}
+
+func (_d *StoreWithMetrics) RevokeAPIKey(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, t1 time.Time) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "RevokeAPIKey"),
 				attribute.String("status", result),
 			),
 		)
 		duration := float64(time.Since(startTime).Milliseconds())
-		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetByUsername")))
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "RevokeAPIKey")))
 	}()
-	return _d.base.GetByUsername(ctx, s1)
+	return _d.base.RevokeAPIKey(ctx, u1, u2, t1)
+}
+
+func (_d *StoreWithMetrics) RevokeRefreshTokenFamily(ctx context.Context, u1 uuid.UUID, t1 time.Time) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "RevokeRefreshTokenFamily"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "RevokeRefreshTokenFamily")))
+	}()
+	return _d.base.RevokeRefreshTokenFamily(ctx, u1, t1)
+}
+
+func (_d *StoreWithMetrics) RevokeRefreshTokensIssuedBefore(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, t1 time.Time, t2 time.Time) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "RevokeRefreshTokensIssuedBefore"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "RevokeRefreshTokensIssuedBefore")))
+	}()
+	return _d.base.RevokeRefreshTokensIssuedBefore(ctx, u1, u2, t1, t2)
+}
+
+func (_d *StoreWithMetrics) RotateRefreshToken(ctx context.Context, s1 string, r1 model.RefreshToken) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "RotateRefreshToken"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "RotateRefreshToken")))
+	}()
+	return _d.base.RotateRefreshToken(ctx, s1, r1)
+}
+
+func (_d *StoreWithMetrics) SaveMFAPolicy(ctx context.Context, u1 uuid.UUID, m1 model.MFAPolicy) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "SaveMFAPolicy"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "SaveMFAPolicy")))
+	}()
+	return _d.base.SaveMFAPolicy(ctx, u1, m1)
+}
+
+func (_d *StoreWithMetrics) SaveUserMFA(ctx context.Context, u1 model.UserMFA) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "SaveUserMFA"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "SaveUserMFA")))
+	}()
+	return _d.base.SaveUserMFA(ctx, u1)
+}
+
+func (_d *StoreWithMetrics) SetUserDisabled(ctx context.Context, u1 uuid.UUID, b1 bool) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "SetUserDisabled"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "SetUserDisabled")))
+	}()
+	return _d.base.SetUserDisabled(ctx, u1, b1)
+}
+
+func (_d *StoreWithMetrics) UpdateOrgMemberRole(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, r1 model.RoleType) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "UpdateOrgMemberRole"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "UpdateOrgMemberRole")))
+	}()
+	return _d.base.UpdateOrgMemberRole(ctx, u1, u2, r1)
+}
+
+func (_d *StoreWithMetrics) UpdateUserPassword(ctx context.Context, u1 uuid.UUID, s1 string) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "UpdateUserPassword"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "UpdateUserPassword")))
+	}()
+	return _d.base.UpdateUserPassword(ctx, u1, s1)
+}
+
+func (_d *StoreWithMetrics) UseMFAStep(ctx context.Context, u1 uuid.UUID, i1 int64) (b1 bool, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "UseMFAStep"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "UseMFAStep")))
+	}()
+	return _d.base.UseMFAStep(ctx, u1, i1)
+}
+
+func (_d *StoreWithMetrics) UseRecoveryCode(ctx context.Context, u1 uuid.UUID, s1 string) (b1 bool, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "UseRecoveryCode"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "UseRecoveryCode")))
+	}()
+	return _d.base.UseRecoveryCode(ctx, u1, s1)
 }
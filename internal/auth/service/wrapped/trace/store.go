@@ -6,9 +6,11 @@ package trace
 
 import (
 	"context"
+	"time"
 
 	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
 	_sourceService "github.com/georgisomnoev/feature-flag-api/internal/auth/service"
+	"github.com/google/uuid"
 
 	_codes "go.opentelemetry.io/otel/codes"
 
@@ -33,6 +35,312 @@ func NewStoreWithTracing(base _sourceService.Store) StoreWithTracing {
 	return d
 }
 
+// AcceptOrgInvitation implements Store
+func (_d StoreWithTracing) AcceptOrgInvitation(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.AcceptOrgInvitation")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.AcceptOrgInvitation(ctx, u1, u2)
+}
+
+// AddOrgMember implements Store
+func (_d StoreWithTracing) AddOrgMember(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, r1 model.RoleType) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.AddOrgMember")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.AddOrgMember(ctx, u1, u2, r1)
+}
+
+// ClearLoginFailures implements Store
+func (_d StoreWithTracing) ClearLoginFailures(ctx context.Context, s1 string) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ClearLoginFailures")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ClearLoginFailures(ctx, s1)
+}
+
+// CountRecoveryCodes implements Store
+func (_d StoreWithTracing) CountRecoveryCodes(ctx context.Context, u1 uuid.UUID) (i1 int, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CountRecoveryCodes")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CountRecoveryCodes(ctx, u1)
+}
+
+// CreateAPIKey implements Store
+func (_d StoreWithTracing) CreateAPIKey(ctx context.Context, a1 model.APIKey) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CreateAPIKey")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CreateAPIKey(ctx, a1)
+}
+
+// CreateExternalIdentity implements Store
+func (_d StoreWithTracing) CreateExternalIdentity(ctx context.Context, e1 model.ExternalIdentity) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CreateExternalIdentity")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CreateExternalIdentity(ctx, e1)
+}
+
+// CreateOAuthClient implements Store
+func (_d StoreWithTracing) CreateOAuthClient(ctx context.Context, o1 model.OAuthClient) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CreateOAuthClient")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CreateOAuthClient(ctx, o1)
+}
+
+// CreateOrg implements Store
+func (_d StoreWithTracing) CreateOrg(ctx context.Context, o1 model.Organization, u1 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CreateOrg")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CreateOrg(ctx, o1, u1)
+}
+
+// CreateOrgInvitation implements Store
+func (_d StoreWithTracing) CreateOrgInvitation(ctx context.Context, o1 model.OrgInvitation) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CreateOrgInvitation")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CreateOrgInvitation(ctx, o1)
+}
+
+// CreateRefreshToken implements Store
+func (_d StoreWithTracing) CreateRefreshToken(ctx context.Context, r1 model.RefreshToken) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CreateRefreshToken")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CreateRefreshToken(ctx, r1)
+}
+
+// CreateUser implements Store
+func (_d StoreWithTracing) CreateUser(ctx context.Context, u1 model.User, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CreateUser")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CreateUser(ctx, u1, u2)
+}
+
+// DeleteExternalIdentity implements Store
+func (_d StoreWithTracing) DeleteExternalIdentity(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.DeleteExternalIdentity")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.DeleteExternalIdentity(ctx, u1, u2)
+}
+
+// DeleteOAuthClient implements Store
+func (_d StoreWithTracing) DeleteOAuthClient(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.DeleteOAuthClient")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.DeleteOAuthClient(ctx, u1, u2)
+}
+
+// DeleteOrgInvitation implements Store
+func (_d StoreWithTracing) DeleteOrgInvitation(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.DeleteOrgInvitation")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.DeleteOrgInvitation(ctx, u1, u2)
+}
+
+// DeleteUser implements Store
+func (_d StoreWithTracing) DeleteUser(ctx context.Context, u1 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.DeleteUser")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.DeleteUser(ctx, u1)
+}
+
+// DeleteUserMFA implements Store
+func (_d StoreWithTracing) DeleteUserMFA(ctx context.Context, u1 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.DeleteUserMFA")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.DeleteUserMFA(ctx, u1)
+}
+
+// EnableUserMFA implements Store
+func (_d StoreWithTracing) EnableUserMFA(ctx context.Context, u1 uuid.UUID, t1 time.Time) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.EnableUserMFA")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.EnableUserMFA(ctx, u1, t1)
+}
+
+// GetByID implements Store
+func (_d StoreWithTracing) GetByID(ctx context.Context, u1 uuid.UUID) (up1 *model.User, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.GetByID")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.GetByID(ctx, u1)
+}
+
 // GetByUsername implements Store
 func (_d StoreWithTracing) GetByUsername(ctx context.Context, s1 string) (up1 *model.User, err error) {
 	ctx, _span := _d.tracer.Start(ctx, "Store.GetByUsername")
@@ -49,3 +357,513 @@ func (_d StoreWithTracing) GetByUsername(ctx context.Context, s1 string) (up1 *m
 	}()
 	return _d.Store.GetByUsername(ctx, s1)
 }
+
+// GetLoginFailures implements Store
+func (_d StoreWithTracing) GetLoginFailures(ctx context.Context, s1 string) (l1 model.LoginFailures, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.GetLoginFailures")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.GetLoginFailures(ctx, s1)
+}
+
+// GetMFAPolicy implements Store
+func (_d StoreWithTracing) GetMFAPolicy(ctx context.Context, u1 uuid.UUID) (m1 model.MFAPolicy, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.GetMFAPolicy")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.GetMFAPolicy(ctx, u1)
+}
+
+// GetOAuthClient implements Store
+func (_d StoreWithTracing) GetOAuthClient(ctx context.Context, u1 uuid.UUID) (o1 model.OAuthClient, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.GetOAuthClient")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.GetOAuthClient(ctx, u1)
+}
+
+// GetOrgByID implements Store
+func (_d StoreWithTracing) GetOrgByID(ctx context.Context, u1 uuid.UUID) (o1 model.Organization, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.GetOrgByID")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.GetOrgByID(ctx, u1)
+}
+
+// GetOrgMemberRole implements Store
+func (_d StoreWithTracing) GetOrgMemberRole(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (r1 model.RoleType, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.GetOrgMemberRole")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.GetOrgMemberRole(ctx, u1, u2)
+}
+
+// GetRefreshToken implements Store
+func (_d StoreWithTracing) GetRefreshToken(ctx context.Context, s1 string) (r1 model.RefreshToken, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.GetRefreshToken")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.GetRefreshToken(ctx, s1)
+}
+
+// GetUserMFA implements Store
+func (_d StoreWithTracing) GetUserMFA(ctx context.Context, u1 uuid.UUID) (u2 model.UserMFA, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.GetUserMFA")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.GetUserMFA(ctx, u1)
+}
+
+// IsOrgMember implements Store
+func (_d StoreWithTracing) IsOrgMember(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (b1 bool, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.IsOrgMember")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.IsOrgMember(ctx, u1, u2)
+}
+
+// ListAPIKeys implements Store
+func (_d StoreWithTracing) ListAPIKeys(ctx context.Context, u1 uuid.UUID) (aa1 []model.APIKey, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListAPIKeys")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListAPIKeys(ctx, u1)
+}
+
+// ListExternalIdentities implements Store
+func (_d StoreWithTracing) ListExternalIdentities(ctx context.Context, u1 uuid.UUID) (ea1 []model.ExternalIdentity, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListExternalIdentities")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListExternalIdentities(ctx, u1)
+}
+
+// ListOAuthClients implements Store
+func (_d StoreWithTracing) ListOAuthClients(ctx context.Context, u1 uuid.UUID) (oa1 []model.OAuthClient, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListOAuthClients")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListOAuthClients(ctx, u1)
+}
+
+// ListOrgMembers implements Store
+func (_d StoreWithTracing) ListOrgMembers(ctx context.Context, u1 uuid.UUID) (oa1 []model.OrganizationMember, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListOrgMembers")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListOrgMembers(ctx, u1)
+}
+
+// ListUserInvitations implements Store
+func (_d StoreWithTracing) ListUserInvitations(ctx context.Context, u1 uuid.UUID) (oa1 []model.OrgInvitation, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListUserInvitations")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListUserInvitations(ctx, u1)
+}
+
+// ListUserOrgs implements Store
+func (_d StoreWithTracing) ListUserOrgs(ctx context.Context, u1 uuid.UUID) (oa1 []model.Organization, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListUserOrgs")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListUserOrgs(ctx, u1)
+}
+
+// ListUsers implements Store
+func (_d StoreWithTracing) ListUsers(ctx context.Context, u1 uuid.UUID) (ua1 []model.User, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListUsers")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListUsers(ctx, u1)
+}
+
+// RecordLoginFailure implements Store
+func (_d StoreWithTracing) RecordLoginFailure(ctx context.Context, s1 string, t1 time.Time, t2 time.Time) (l1 model.LoginFailures, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.RecordLoginFailure")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.RecordLoginFailure(ctx, s1, t1, t2)
+}
+
+// ReleaseLoginFailure implements Store
+func (_d StoreWithTracing) ReleaseLoginFailure(ctx context.Context, s1 string, i1 int, t1 time.Time) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ReleaseLoginFailure")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ReleaseLoginFailure(ctx, s1, i1, t1)
+}
+
+// RemoveOrgMember implements Store
+func (_d StoreWithTracing) RemoveOrgMember(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.RemoveOrgMember")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.RemoveOrgMember(ctx, u1, u2)
+}
+
+// ReplaceRecoveryCodes implements Store
+func (_d StoreWithTracing) ReplaceRecoveryCodes(ctx context.Context, u1 uuid.UUID, sa1 []string) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ReplaceRecoveryCodes")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ReplaceRecoveryCodes(ctx, u1, sa1)
+}
+
+// RevokeAPIKey implements Store
+func (_d StoreWithTracing) RevokeAPIKey(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, t1 time.Time) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.RevokeAPIKey")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.RevokeAPIKey(ctx, u1, u2, t1)
+}
+
+// RevokeRefreshTokenFamily implements Store
+func (_d StoreWithTracing) RevokeRefreshTokenFamily(ctx context.Context, u1 uuid.UUID, t1 time.Time) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.RevokeRefreshTokenFamily")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.RevokeRefreshTokenFamily(ctx, u1, t1)
+}
+
+// RevokeRefreshTokensIssuedBefore implements Store
+func (_d StoreWithTracing) RevokeRefreshTokensIssuedBefore(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, t1 time.Time, t2 time.Time) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.RevokeRefreshTokensIssuedBefore")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.RevokeRefreshTokensIssuedBefore(ctx, u1, u2, t1, t2)
+}
+
+// RotateRefreshToken implements Store
+func (_d StoreWithTracing) RotateRefreshToken(ctx context.Context, s1 string, r1 model.RefreshToken) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.RotateRefreshToken")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.RotateRefreshToken(ctx, s1, r1)
+}
+
+// SaveMFAPolicy implements Store
+func (_d StoreWithTracing) SaveMFAPolicy(ctx context.Context, u1 uuid.UUID, m1 model.MFAPolicy) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.SaveMFAPolicy")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.SaveMFAPolicy(ctx, u1, m1)
+}
+
+// SaveUserMFA implements Store
+func (_d StoreWithTracing) SaveUserMFA(ctx context.Context, u1 model.UserMFA) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.SaveUserMFA")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.SaveUserMFA(ctx, u1)
+}
+
+// SetUserDisabled implements Store
+func (_d StoreWithTracing) SetUserDisabled(ctx context.Context, u1 uuid.UUID, b1 bool) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.SetUserDisabled")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.SetUserDisabled(ctx, u1, b1)
+}
+
+// UpdateOrgMemberRole implements Store
+func (_d StoreWithTracing) UpdateOrgMemberRole(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, r1 model.RoleType) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.UpdateOrgMemberRole")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.UpdateOrgMemberRole(ctx, u1, u2, r1)
+}
+
+// UpdateUserPassword implements Store
+func (_d StoreWithTracing) UpdateUserPassword(ctx context.Context, u1 uuid.UUID, s1 string) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.UpdateUserPassword")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.UpdateUserPassword(ctx, u1, s1)
+}
+
+// UseMFAStep implements Store
+func (_d StoreWithTracing) UseMFAStep(ctx context.Context, u1 uuid.UUID, i1 int64) (b1 bool, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.UseMFAStep")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.UseMFAStep(ctx, u1, i1)
+}
+
+// UseRecoveryCode implements Store
+func (_d StoreWithTracing) UseRecoveryCode(ctx context.Context, u1 uuid.UUID, s1 string) (b1 bool, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.UseRecoveryCode")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.UseRecoveryCode(ctx, u1, s1)
+}
@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const APIKeysTable = "api_keys"
+
+const apiKeyColumns = `id, org_id, name, environment, kind, prefix, key_hash, scopes, created_by, created_at, last_used_at,
+	revoked_at`
+
+func (s *Store) CreateAPIKey(ctx context.Context, key model.APIKey) error {
+	query := fmt.Sprintf(`INSERT INTO %s (id, org_id, name, environment, kind, prefix, key_hash, scopes, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`, APIKeysTable)
+	_, err := s.pool.Exec(ctx, query,
+		key.ID, key.OrgID, key.Name, key.Environment, key.Kind, key.Prefix, key.Hash, key.Scopes, key.CreatedBy, key.CreatedAt)
+	return err
+}
+
+func (s *Store) ListAPIKeys(ctx context.Context, orgID uuid.UUID) ([]model.APIKey, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE org_id = $1 ORDER BY created_at, name`, apiKeyColumns, APIKeysTable)
+	rows, err := s.pool.Query(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []model.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (s *Store) GetAPIKeyByHash(ctx context.Context, hash string) (model.APIKey, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE key_hash = $1`, apiKeyColumns, APIKeysTable)
+	key, err := scanAPIKey(s.pool.QueryRow(ctx, query, hash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.APIKey{}, model.ErrAPIKeyNotFound
+		}
+		return model.APIKey{}, err
+	}
+	return key, nil
+}
+
+// RevokeAPIKey revokes the key of the organization, keys that are already revoked keep their time.
+func (s *Store) RevokeAPIKey(ctx context.Context, orgID, id uuid.UUID, revokedAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET revoked_at = COALESCE(revoked_at, $1) WHERE org_id = $2 AND id = $3`, APIKeysTable)
+	result, err := s.pool.Exec(ctx, query, revokedAt, orgID, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (s *Store) TouchAPIKey(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET last_used_at = $1 WHERE id = $2`, APIKeysTable)
+	_, err := s.pool.Exec(ctx, query, usedAt, id)
+	return err
+}
+
+func scanAPIKey(row pgx.Row) (model.APIKey, error) {
+	var key model.APIKey
+	err := row.Scan(
+		&key.ID,
+		&key.OrgID,
+		&key.Name,
+		&key.Environment,
+		&key.Kind,
+		&key.Prefix,
+		&key.Hash,
+		&key.Scopes,
+		&key.CreatedBy,
+		&key.CreatedAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+	)
+	return key, err
+}
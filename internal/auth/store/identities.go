@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const ExternalIdentitiesTable = "external_identities"
+
+func (s *Store) CreateExternalIdentity(ctx context.Context, identity model.ExternalIdentity) error {
+	query := fmt.Sprintf(`INSERT INTO %s (id, user_id, issuer, subject, created_at) VALUES ($1, $2, $3, $4, $5)`,
+		ExternalIdentitiesTable)
+	_, err := s.pool.Exec(ctx, query, identity.ID, identity.UserID, identity.Issuer, identity.Subject, identity.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case uniqueViolationCode:
+				return model.ErrIdentityExists
+			case foreignKeyViolationCode:
+				return model.ErrUserNotFound
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Store) ListExternalIdentities(ctx context.Context, userID uuid.UUID) ([]model.ExternalIdentity, error) {
+	query := fmt.Sprintf(`SELECT id, user_id, issuer, subject, created_at FROM %s WHERE user_id = $1
+		ORDER BY created_at, issuer, subject`, ExternalIdentitiesTable)
+	rows, err := s.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	identities := []model.ExternalIdentity{}
+	for rows.Next() {
+		var identity model.ExternalIdentity
+		if err := rows.Scan(&identity.ID, &identity.UserID, &identity.Issuer, &identity.Subject, &identity.CreatedAt); err != nil {
+			return nil, err
+		}
+		identities = append(identities, identity)
+	}
+	return identities, rows.Err()
+}
+
+func (s *Store) DeleteExternalIdentity(ctx context.Context, userID, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE user_id = $1 AND id = $2`, ExternalIdentitiesTable)
+	result, err := s.pool.Exec(ctx, query, userID, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrIdentityNotFound
+	}
+	return nil
+}
+
+// GetUserByExternalIdentity returns the user the subject of the issuer is linked to, nil when it is
+// linked to none.
+func (s *Store) GetUserByExternalIdentity(ctx context.Context, issuer, subject string) (*model.User, error) {
+	var user model.User
+	query := fmt.Sprintf(`SELECT u.id, u.username, u.password, u.disabled FROM %s u
+		JOIN %s i ON i.user_id = u.id WHERE i.issuer = $1 AND i.subject = $2`, UserTable, ExternalIdentitiesTable)
+	err := s.pool.QueryRow(ctx, query, issuer, subject).Scan(&user.ID, &user.Username, &user.Password, &user.Disabled)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// CreateOrgInvitation stores the invitation. Inviting a user again replaces the pending invitation.
+func (s *Store) CreateOrgInvitation(ctx context.Context, invitation model.OrgInvitation) error {
+	query := fmt.Sprintf(`INSERT INTO %s (org_id, user_id, role, invited_by, created_at) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (org_id, user_id) DO UPDATE
+		SET role = EXCLUDED.role, invited_by = EXCLUDED.invited_by, created_at = EXCLUDED.created_at`,
+		OrganizationInvitationsTable)
+	_, err := s.pool.Exec(ctx, query,
+		invitation.OrgID, invitation.UserID, invitation.Role, invitation.InvitedBy, invitation.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ListUserInvitations returns the pending invitations of the user together with the names of the
+// organizations, oldest first.
+func (s *Store) ListUserInvitations(ctx context.Context, userID uuid.UUID) ([]model.OrgInvitation, error) {
+	query := fmt.Sprintf(`SELECT i.org_id, o.name, i.user_id, i.role, i.invited_by, i.created_at
+		FROM %s i JOIN %s o ON o.id = i.org_id WHERE i.user_id = $1 ORDER BY i.created_at, o.name`,
+		OrganizationInvitationsTable, OrganizationsTable)
+	rows, err := s.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	invitations := []model.OrgInvitation{}
+	for rows.Next() {
+		var invitation model.OrgInvitation
+		if err := rows.Scan(
+			&invitation.OrgID,
+			&invitation.OrgName,
+			&invitation.UserID,
+			&invitation.Role,
+			&invitation.InvitedBy,
+			&invitation.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, invitation)
+	}
+
+	return invitations, rows.Err()
+}
+
+// AcceptOrgInvitation turns the invitation into a membership with its role in a single transaction.
+func (s *Store) AcceptOrgInvitation(ctx context.Context, orgID, userID uuid.UUID) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var role model.RoleType
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = $1 AND user_id = $2 RETURNING role`, OrganizationInvitationsTable)
+	if err := tx.QueryRow(ctx, query, orgID, userID).Scan(&role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ErrInvitationNotFound
+		}
+		return err
+	}
+
+	query = fmt.Sprintf(`INSERT INTO %s (org_id, user_id, role) VALUES ($1, $2, $3) ON CONFLICT (org_id, user_id) DO NOTHING`,
+		OrganizationMembersTable)
+	if _, err := tx.Exec(ctx, query, orgID, userID, role); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *Store) DeleteOrgInvitation(ctx context.Context, orgID, userID uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = $1 AND user_id = $2`, OrganizationInvitationsTable)
+	result, err := s.pool.Exec(ctx, query, orgID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrInvitationNotFound
+	}
+	return nil
+}
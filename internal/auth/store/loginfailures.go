@@ -0,0 +1,58 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/jackc/pgx/v5"
+)
+
+const LoginFailuresTable = "login_failures"
+
+func (s *Store) GetLoginFailures(ctx context.Context, key string) (model.LoginFailures, error) {
+	failures := model.LoginFailures{Key: key}
+	query := fmt.Sprintf(`SELECT count, last_failed_at FROM %s WHERE key = $1`, LoginFailuresTable)
+	err := s.pool.QueryRow(ctx, query, key).Scan(&failures.Count, &failures.LastFailedAt)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return model.LoginFailures{}, err
+	}
+	return failures, nil
+}
+
+// RecordLoginFailure counts a failed login of the key. Failures of every key last recorded before
+// forgetBefore are forgotten first, which keeps the table from growing with guessed usernames.
+func (s *Store) RecordLoginFailure(ctx context.Context, key string, failedAt, forgetBefore time.Time) (model.LoginFailures, error) {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE last_failed_at < $1`, LoginFailuresTable)
+	if _, err := s.pool.Exec(ctx, query, forgetBefore); err != nil {
+		return model.LoginFailures{}, err
+	}
+
+	failures := model.LoginFailures{Key: key}
+	query = fmt.Sprintf(`INSERT INTO %s (key, count, last_failed_at) VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE SET count = %[1]s.count + 1, last_failed_at = EXCLUDED.last_failed_at
+		RETURNING count, last_failed_at`, LoginFailuresTable)
+	if err := s.pool.QueryRow(ctx, query, key, failedAt).Scan(&failures.Count, &failures.LastFailedAt); err != nil {
+		return model.LoginFailures{}, err
+	}
+	return failures, nil
+}
+
+// ReleaseLoginFailure takes back the count-th failure of the key, which was recorded for an attempt
+// that succeeded. The time of the last failure is restored to lastFailedAt unless more failures were
+// recorded since.
+func (s *Store) ReleaseLoginFailure(ctx context.Context, key string, count int, lastFailedAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s
+		SET count = count - 1, last_failed_at = CASE WHEN count = $2 THEN $3 ELSE last_failed_at END
+		WHERE key = $1 AND count > 0`, LoginFailuresTable)
+	_, err := s.pool.Exec(ctx, query, key, count, lastFailedAt)
+	return err
+}
+
+func (s *Store) ClearLoginFailures(ctx context.Context, key string) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE key = $1`, LoginFailuresTable)
+	_, err := s.pool.Exec(ctx, query, key)
+	return err
+}
@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) CreateAPIKey(_ context.Context, key model.APIKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key.Scopes = slices.Clone(key.Scopes)
+	key.CreatedAt = key.CreatedAt.UTC()
+	key.LastUsedAt = nil
+	key.RevokedAt = nil
+	s.apiKeys[key.ID] = key
+	return nil
+}
+
+func (s *Store) ListAPIKeys(_ context.Context, orgID uuid.UUID) ([]model.APIKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := []model.APIKey{}
+	for _, key := range s.apiKeys {
+		if key.OrgID == orgID {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortFunc(keys, func(a, b model.APIKey) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return keys, nil
+}
+
+func (s *Store) GetAPIKeyByHash(_ context.Context, hash string) (model.APIKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, key := range s.apiKeys {
+		if key.Hash == hash {
+			return key, nil
+		}
+	}
+	return model.APIKey{}, model.ErrAPIKeyNotFound
+}
+
+func (s *Store) RevokeAPIKey(_ context.Context, orgID, id uuid.UUID, revokedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.apiKeys[id]
+	if !ok || key.OrgID != orgID {
+		return model.ErrAPIKeyNotFound
+	}
+	if key.RevokedAt == nil {
+		revokedAt = revokedAt.UTC()
+		key.RevokedAt = &revokedAt
+		s.apiKeys[id] = key
+	}
+	return nil
+}
+
+func (s *Store) TouchAPIKey(_ context.Context, id uuid.UUID, usedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.apiKeys[id]; ok {
+		usedAt = usedAt.UTC()
+		key.LastUsedAt = &usedAt
+		s.apiKeys[id] = key
+	}
+	return nil
+}
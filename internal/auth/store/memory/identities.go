@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) CreateExternalIdentity(_ context.Context, identity model.ExternalIdentity) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[identity.UserID]; !ok {
+		return model.ErrUserNotFound
+	}
+	for _, existing := range s.identities {
+		if existing.Issuer == identity.Issuer && existing.Subject == identity.Subject {
+			return model.ErrIdentityExists
+		}
+	}
+	identity.CreatedAt = identity.CreatedAt.UTC()
+	s.identities[identity.ID] = identity
+	return nil
+}
+
+func (s *Store) ListExternalIdentities(_ context.Context, userID uuid.UUID) ([]model.ExternalIdentity, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	identities := []model.ExternalIdentity{}
+	for _, identity := range s.identities {
+		if identity.UserID == userID {
+			identities = append(identities, identity)
+		}
+	}
+	slices.SortFunc(identities, func(a, b model.ExternalIdentity) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Issuer, b.Issuer); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Subject, b.Subject)
+	})
+	return identities, nil
+}
+
+func (s *Store) DeleteExternalIdentity(_ context.Context, userID, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	identity, ok := s.identities[id]
+	if !ok || identity.UserID != userID {
+		return model.ErrIdentityNotFound
+	}
+	delete(s.identities, id)
+	return nil
+}
+
+func (s *Store) GetUserByExternalIdentity(_ context.Context, issuer, subject string) (*model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, identity := range s.identities {
+		if identity.Issuer == issuer && identity.Subject == subject {
+			user, ok := s.users[identity.UserID]
+			if !ok {
+				return nil, nil
+			}
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) CreateOrgInvitation(_ context.Context, invitation model.OrgInvitation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[invitation.UserID]; !ok {
+		return model.ErrUserNotFound
+	}
+	if _, ok := s.orgs[invitation.OrgID]; !ok {
+		return model.ErrOrgNotFound
+	}
+
+	invitation.OrgName = ""
+	invitation.CreatedAt = invitation.CreatedAt.UTC()
+	if s.invitations[invitation.OrgID] == nil {
+		s.invitations[invitation.OrgID] = map[uuid.UUID]model.OrgInvitation{}
+	}
+	s.invitations[invitation.OrgID][invitation.UserID] = invitation
+	return nil
+}
+
+func (s *Store) ListUserInvitations(_ context.Context, userID uuid.UUID) ([]model.OrgInvitation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	invitations := []model.OrgInvitation{}
+	for orgID, orgInvitations := range s.invitations {
+		invitation, ok := orgInvitations[userID]
+		if !ok {
+			continue
+		}
+		invitation.OrgName = s.orgs[orgID].Name
+		invitations = append(invitations, invitation)
+	}
+	sort.Slice(invitations, func(i, j int) bool {
+		if !invitations[i].CreatedAt.Equal(invitations[j].CreatedAt) {
+			return invitations[i].CreatedAt.Before(invitations[j].CreatedAt)
+		}
+		return invitations[i].OrgName < invitations[j].OrgName
+	})
+	return invitations, nil
+}
+
+func (s *Store) AcceptOrgInvitation(_ context.Context, orgID, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	invitation, ok := s.invitations[orgID][userID]
+	if !ok {
+		return model.ErrInvitationNotFound
+	}
+	delete(s.invitations[orgID], userID)
+	if _, ok := s.members[orgID][userID]; !ok {
+		s.members[orgID][userID] = invitation.Role
+	}
+	return nil
+}
+
+func (s *Store) DeleteOrgInvitation(_ context.Context, orgID, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.invitations[orgID][userID]; !ok {
+		return model.ErrInvitationNotFound
+	}
+	delete(s.invitations[orgID], userID)
+	return nil
+}
@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+func (s *Store) GetLoginFailures(_ context.Context, key string) (model.LoginFailures, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	failures, ok := s.loginFailures[key]
+	if !ok {
+		return model.LoginFailures{Key: key}, nil
+	}
+	return failures, nil
+}
+
+func (s *Store) RecordLoginFailure(_ context.Context, key string, failedAt, forgetBefore time.Time) (model.LoginFailures, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, failures := range s.loginFailures {
+		if failures.LastFailedAt.Before(forgetBefore) {
+			delete(s.loginFailures, k)
+		}
+	}
+	failures := s.loginFailures[key]
+	failures.Key = key
+	failures.Count++
+	failures.LastFailedAt = failedAt.UTC()
+	s.loginFailures[key] = failures
+	return failures, nil
+}
+
+func (s *Store) ReleaseLoginFailure(_ context.Context, key string, count int, lastFailedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	failures, ok := s.loginFailures[key]
+	if !ok || failures.Count == 0 {
+		return nil
+	}
+	if failures.Count == count {
+		failures.LastFailedAt = lastFailedAt.UTC()
+	}
+	failures.Count--
+	s.loginFailures[key] = failures
+	return nil
+}
+
+func (s *Store) ClearLoginFailures(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.loginFailures, key)
+	return nil
+}
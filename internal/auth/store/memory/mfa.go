@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) GetUserMFA(_ context.Context, userID uuid.UUID) (model.UserMFA, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	mfa, ok := s.mfa[userID]
+	if !ok {
+		return model.UserMFA{}, model.ErrMFANotFound
+	}
+	return mfa, nil
+}
+
+func (s *Store) SaveUserMFA(_ context.Context, mfa model.UserMFA) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[mfa.UserID]; !ok {
+		return model.ErrUserNotFound
+	}
+	mfa.CreatedAt = mfa.CreatedAt.UTC()
+	if mfa.EnabledAt != nil {
+		enabledAt := mfa.EnabledAt.UTC()
+		mfa.EnabledAt = &enabledAt
+	}
+	s.mfa[mfa.UserID] = mfa
+	return nil
+}
+
+func (s *Store) EnableUserMFA(_ context.Context, userID uuid.UUID, enabledAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mfa, ok := s.mfa[userID]
+	if !ok {
+		return model.ErrMFANotFound
+	}
+	enabledAt = enabledAt.UTC()
+	mfa.EnabledAt = &enabledAt
+	s.mfa[userID] = mfa
+	return nil
+}
+
+func (s *Store) UseMFAStep(_ context.Context, userID uuid.UUID, step int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mfa, ok := s.mfa[userID]
+	if !ok || mfa.LastUsedStep >= step {
+		return false, nil
+	}
+	mfa.LastUsedStep = step
+	s.mfa[userID] = mfa
+	return true, nil
+}
+
+// DeleteUserMFA deletes the MFA of the user together with the recovery codes.
+func (s *Store) DeleteUserMFA(_ context.Context, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.mfa[userID]; !ok {
+		return model.ErrMFANotFound
+	}
+	delete(s.mfa, userID)
+	delete(s.recoveryCodes, userID)
+	return nil
+}
+
+func (s *Store) ReplaceRecoveryCodes(_ context.Context, userID uuid.UUID, hashes []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.mfa[userID]; !ok {
+		return model.ErrMFANotFound
+	}
+	codes := make(map[string]bool, len(hashes))
+	for _, hash := range hashes {
+		codes[hash] = true
+	}
+	s.recoveryCodes[userID] = codes
+	return nil
+}
+
+func (s *Store) UseRecoveryCode(_ context.Context, userID uuid.UUID, hash string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.recoveryCodes[userID][hash] {
+		return false, nil
+	}
+	delete(s.recoveryCodes[userID], hash)
+	return true, nil
+}
+
+func (s *Store) CountRecoveryCodes(_ context.Context, userID uuid.UUID) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.recoveryCodes[userID]), nil
+}
+
+func (s *Store) GetMFAPolicy(_ context.Context, orgID uuid.UUID) (model.MFAPolicy, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	roles := slices.Clone(s.mfaPolicies[orgID].RequiredRoles)
+	if roles == nil {
+		roles = []model.RoleType{}
+	}
+	return model.MFAPolicy{RequiredRoles: roles}, nil
+}
+
+func (s *Store) SaveMFAPolicy(_ context.Context, orgID uuid.UUID, policy model.MFAPolicy) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	roles := slices.Clone(policy.RequiredRoles)
+	slices.Sort(roles)
+	s.mfaPolicies[orgID] = model.MFAPolicy{RequiredRoles: slices.Compact(roles)}
+	return nil
+}
@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) CreateOAuthClient(_ context.Context, client model.OAuthClient) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	client.Scopes = slices.Clone(client.Scopes)
+	client.CreatedAt = client.CreatedAt.UTC()
+	s.oauthClients[client.ID] = client
+	return nil
+}
+
+func (s *Store) ListOAuthClients(_ context.Context, orgID uuid.UUID) ([]model.OAuthClient, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	clients := []model.OAuthClient{}
+	for _, client := range s.oauthClients {
+		if client.OrgID == orgID {
+			clients = append(clients, client)
+		}
+	}
+	slices.SortFunc(clients, func(a, b model.OAuthClient) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return clients, nil
+}
+
+func (s *Store) GetOAuthClient(_ context.Context, id uuid.UUID) (model.OAuthClient, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	client, ok := s.oauthClients[id]
+	if !ok {
+		return model.OAuthClient{}, model.ErrOAuthClientNotFound
+	}
+	return client, nil
+}
+
+func (s *Store) DeleteOAuthClient(_ context.Context, orgID, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	client, ok := s.oauthClients[id]
+	if !ok || client.OrgID != orgID {
+		return model.ErrOAuthClientNotFound
+	}
+	delete(s.oauthClients, id)
+	return nil
+}
@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) ListUserOrgs(_ context.Context, userID uuid.UUID) ([]model.Organization, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	orgs := []model.Organization{}
+	for orgID, members := range s.members {
+		if _, ok := members[userID]; ok {
+			orgs = append(orgs, s.orgs[orgID])
+		}
+	}
+	sort.Slice(orgs, func(i, j int) bool {
+		if !orgs[i].CreatedAt.Equal(orgs[j].CreatedAt) {
+			return orgs[i].CreatedAt.Before(orgs[j].CreatedAt)
+		}
+		return orgs[i].Name < orgs[j].Name
+	})
+	return orgs, nil
+}
+
+func (s *Store) IsOrgMember(_ context.Context, orgID, userID uuid.UUID) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.members[orgID][userID]
+	return ok, nil
+}
+
+func (s *Store) GetOrgMemberRole(_ context.Context, orgID, userID uuid.UUID) (model.RoleType, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	role, ok := s.members[orgID][userID]
+	if !ok {
+		return "", model.ErrMemberNotFound
+	}
+	return role, nil
+}
+
+func (s *Store) CreateOrg(_ context.Context, org model.Organization, ownerID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.orgs {
+		if existing.ID == org.ID || existing.Name == org.Name {
+			return model.ErrOrgExists
+		}
+	}
+	if _, ok := s.users[ownerID]; !ok {
+		return model.ErrUserNotFound
+	}
+
+	org.CreatedAt = org.CreatedAt.UTC()
+	s.orgs[org.ID] = org
+	s.members[org.ID] = map[uuid.UUID]model.RoleType{ownerID: model.RoleAdmin}
+	return nil
+}
+
+func (s *Store) GetOrgByID(_ context.Context, id uuid.UUID) (model.Organization, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	org, ok := s.orgs[id]
+	if !ok {
+		return model.Organization{}, model.ErrOrgNotFound
+	}
+	return org, nil
+}
+
+func (s *Store) ListOrgMembers(_ context.Context, orgID uuid.UUID) ([]model.OrganizationMember, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	members := []model.OrganizationMember{}
+	for userID, role := range s.members[orgID] {
+		user := s.users[userID]
+		members = append(members, model.OrganizationMember{UserID: user.ID, Username: user.Username, Role: role})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Username < members[j].Username
+	})
+	return members, nil
+}
+
+func (s *Store) AddOrgMember(_ context.Context, orgID, userID uuid.UUID, role model.RoleType) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[userID]; !ok {
+		return model.ErrUserNotFound
+	}
+	members, ok := s.members[orgID]
+	if !ok {
+		return model.ErrOrgNotFound
+	}
+	if _, ok := members[userID]; !ok {
+		members[userID] = role
+	}
+	return nil
+}
+
+func (s *Store) UpdateOrgMemberRole(_ context.Context, orgID, userID uuid.UUID, role model.RoleType) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.members[orgID][userID]; !ok {
+		return model.ErrMemberNotFound
+	}
+	s.members[orgID][userID] = role
+	return nil
+}
+
+func (s *Store) RemoveOrgMember(_ context.Context, orgID, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.members[orgID][userID]; !ok {
+		return model.ErrMemberNotFound
+	}
+	delete(s.members[orgID], userID)
+	return nil
+}
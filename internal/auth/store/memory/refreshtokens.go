@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) CreateRefreshToken(_ context.Context, token model.RefreshToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.refreshTokens[token.Hash] = normalizeRefreshToken(token)
+	return nil
+}
+
+func (s *Store) GetRefreshToken(_ context.Context, hash string) (model.RefreshToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	token, ok := s.refreshTokens[hash]
+	if !ok {
+		return model.RefreshToken{}, model.ErrRefreshTokenNotFound
+	}
+	return token, nil
+}
+
+func (s *Store) RotateRefreshToken(_ context.Context, hash string, next model.RefreshToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.refreshTokens[hash]
+	if !ok || token.UsedAt != nil || token.RevokedAt != nil {
+		return model.ErrRefreshTokenUsed
+	}
+
+	usedAt := next.CreatedAt.UTC()
+	token.UsedAt = &usedAt
+	s.refreshTokens[hash] = token
+	s.refreshTokens[next.Hash] = normalizeRefreshToken(next)
+	return nil
+}
+
+func (s *Store) RevokeRefreshTokenFamily(_ context.Context, familyID uuid.UUID, revokedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	revokedAt = revokedAt.UTC()
+	for hash, token := range s.refreshTokens {
+		if token.FamilyID == familyID && token.RevokedAt == nil {
+			token.RevokedAt = &revokedAt
+			s.refreshTokens[hash] = token
+		}
+	}
+	return nil
+}
+
+func normalizeRefreshToken(token model.RefreshToken) model.RefreshToken {
+	token.ExpiresAt = token.ExpiresAt.UTC()
+	token.CreatedAt = token.CreatedAt.UTC()
+	token.UsedAt = nil
+	token.RevokedAt = nil
+	return token
+}
+
+func (s *Store) RevokeRefreshTokensIssuedBefore(
+	_ context.Context,
+	orgID, userID uuid.UUID,
+	issuedBefore, revokedAt time.Time,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	families := map[uuid.UUID]bool{}
+	for _, token := range s.refreshTokens {
+		if token.OrgID == orgID && (userID == uuid.Nil || token.UserID == userID) && token.CreatedAt.Before(issuedBefore) {
+			families[token.FamilyID] = true
+		}
+	}
+
+	revokedAt = revokedAt.UTC()
+	for hash, token := range s.refreshTokens {
+		if families[token.FamilyID] && token.RevokedAt == nil {
+			token.RevokedAt = &revokedAt
+			s.refreshTokens[hash] = token
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+// Store keeps the users, organizations and issued tokens in memory, they are lost on restart.
+type Store struct {
+	mu               sync.RWMutex
+	users            map[uuid.UUID]model.User
+	orgs             map[uuid.UUID]model.Organization
+	members          map[uuid.UUID]map[uuid.UUID]model.RoleType
+	invitations      map[uuid.UUID]map[uuid.UUID]model.OrgInvitation
+	refreshTokens    map[string]model.RefreshToken
+	tokenRevocations []model.TokenRevocation
+	apiKeys          map[uuid.UUID]model.APIKey
+	oauthClients     map[uuid.UUID]model.OAuthClient
+	loginFailures    map[string]model.LoginFailures
+	mfa              map[uuid.UUID]model.UserMFA
+	recoveryCodes    map[uuid.UUID]map[string]bool
+	mfaPolicies      map[uuid.UUID]model.MFAPolicy
+	identities       map[uuid.UUID]model.ExternalIdentity
+}
+
+func NewStore(users ...model.User) *Store {
+	s := &Store{
+		users:            make(map[uuid.UUID]model.User, len(users)),
+		orgs:             map[uuid.UUID]model.Organization{},
+		members:          map[uuid.UUID]map[uuid.UUID]model.RoleType{},
+		invitations:      map[uuid.UUID]map[uuid.UUID]model.OrgInvitation{},
+		refreshTokens:    map[string]model.RefreshToken{},
+		tokenRevocations: []model.TokenRevocation{},
+		apiKeys:          map[uuid.UUID]model.APIKey{},
+		oauthClients:     map[uuid.UUID]model.OAuthClient{},
+		loginFailures:    map[string]model.LoginFailures{},
+		mfa:              map[uuid.UUID]model.UserMFA{},
+		recoveryCodes:    map[uuid.UUID]map[string]bool{},
+		mfaPolicies:      map[uuid.UUID]model.MFAPolicy{},
+		identities:       map[uuid.UUID]model.ExternalIdentity{},
+	}
+	for _, user := range users {
+		user.Role = ""
+		s.users[user.ID] = user
+	}
+	return s
+}
+
+func (s *Store) GetByUsername(_ context.Context, username string) (*model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, user := range s.users {
+		if user.Username == username {
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *Store) GetByID(_ context.Context, id uuid.UUID) (*model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, ok := s.users[id]
+	if !ok {
+		return nil, nil
+	}
+	return &user, nil
+}
+
+// UserExists reports whether the user exists and is not disabled.
+func (s *Store) UserExists(_ context.Context, id uuid.UUID) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, ok := s.users[id]
+	return ok && !user.Disabled, nil
+}
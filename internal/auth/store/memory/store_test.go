@@ -0,0 +1,14 @@
+package memory_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store/memory"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store/storetest"
+)
+
+var _ = Describe("Memory Store", func() {
+	storetest.DescribeStore(func() storetest.Store {
+		return memory.NewStore()
+	})
+})
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) AddUser(_ context.Context, user model.User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.users {
+		if existing.ID == user.ID || existing.Username == user.Username {
+			return fmt.Errorf("user %s already exists", user.Username)
+		}
+	}
+	user.Role = ""
+	s.users[user.ID] = user
+	return nil
+}
+
+func (s *Store) DeleteUserByID(_ context.Context, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deleteUser(userID)
+	return nil
+}
+
+func (s *Store) DeleteOrgByID(_ context.Context, orgID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.orgs, orgID)
+	delete(s.members, orgID)
+	delete(s.invitations, orgID)
+	delete(s.mfaPolicies, orgID)
+	for hash, token := range s.refreshTokens {
+		if token.OrgID == orgID {
+			delete(s.refreshTokens, hash)
+		}
+	}
+	for keyID, key := range s.apiKeys {
+		if key.OrgID == orgID {
+			delete(s.apiKeys, keyID)
+		}
+	}
+	for clientID, client := range s.oauthClients {
+		if client.OrgID == orgID {
+			delete(s.oauthClients, clientID)
+		}
+	}
+	kept := s.tokenRevocations[:0]
+	for _, revocation := range s.tokenRevocations {
+		if revocation.OrgID != orgID {
+			kept = append(kept, revocation)
+		}
+	}
+	s.tokenRevocations = kept
+	return nil
+}
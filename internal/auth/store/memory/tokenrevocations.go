@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+func (s *Store) CreateTokenRevocation(_ context.Context, revocation model.TokenRevocation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	revocation.ExpiresAt = revocation.ExpiresAt.UTC()
+	revocation.CreatedAt = revocation.CreatedAt.UTC()
+	if !revocation.IssuedBefore.IsZero() {
+		revocation.IssuedBefore = revocation.IssuedBefore.UTC()
+	}
+	s.tokenRevocations = append(s.tokenRevocations, revocation)
+	return nil
+}
+
+func (s *Store) ListTokenRevocations(_ context.Context, now time.Time) ([]model.TokenRevocation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	revocations := []model.TokenRevocation{}
+	for _, revocation := range s.tokenRevocations {
+		if revocation.ExpiresAt.After(now) {
+			revocations = append(revocations, revocation)
+		}
+	}
+	return revocations, nil
+}
+
+func (s *Store) DeleteExpiredTokenRevocations(_ context.Context, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.tokenRevocations[:0]
+	for _, revocation := range s.tokenRevocations {
+		if revocation.ExpiresAt.After(now) {
+			kept = append(kept, revocation)
+		}
+	}
+	s.tokenRevocations = kept
+	return nil
+}
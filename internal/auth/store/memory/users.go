@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) ListUsers(_ context.Context, orgID uuid.UUID) ([]model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	users := make([]model.User, 0, len(s.members[orgID]))
+	for _, user := range s.users {
+		role, ok := s.members[orgID][user.ID]
+		if !ok {
+			continue
+		}
+		user.Password = ""
+		user.Role = role
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Username < users[j].Username
+	})
+	return users, nil
+}
+
+func (s *Store) CreateUser(_ context.Context, user model.User, orgID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.users {
+		if existing.ID == user.ID || existing.Username == user.Username {
+			return model.ErrUserExists
+		}
+	}
+	if _, ok := s.orgs[orgID]; !ok {
+		return model.ErrOrgNotFound
+	}
+
+	role := user.Role
+	user.Role = ""
+	s.users[user.ID] = user
+	s.members[orgID][user.ID] = role
+	return nil
+}
+
+func (s *Store) SetUserDisabled(_ context.Context, id uuid.UUID, disabled bool) error {
+	return s.updateUser(id, func(user *model.User) {
+		user.Disabled = disabled
+	})
+}
+
+func (s *Store) UpdateUserPassword(_ context.Context, id uuid.UUID, password string) error {
+	return s.updateUser(id, func(user *model.User) {
+		user.Password = password
+	})
+}
+
+// DeleteUser deletes the user together with its memberships and refresh tokens.
+func (s *Store) DeleteUser(_ context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[id]; !ok {
+		return model.ErrUserNotFound
+	}
+	s.deleteUser(id)
+	return nil
+}
+
+func (s *Store) deleteUser(id uuid.UUID) {
+	delete(s.users, id)
+	for _, members := range s.members {
+		delete(members, id)
+	}
+	for _, invitations := range s.invitations {
+		delete(invitations, id)
+	}
+	for hash, token := range s.refreshTokens {
+		if token.UserID == id {
+			delete(s.refreshTokens, hash)
+		}
+	}
+	for keyID, key := range s.apiKeys {
+		if key.CreatedBy == id {
+			delete(s.apiKeys, keyID)
+		}
+	}
+	for clientID, client := range s.oauthClients {
+		if client.CreatedBy == id {
+			delete(s.oauthClients, clientID)
+		}
+	}
+	delete(s.mfa, id)
+	delete(s.recoveryCodes, id)
+	for identityID, identity := range s.identities {
+		if identity.UserID == id {
+			delete(s.identities, identityID)
+		}
+	}
+}
+
+func (s *Store) updateUser(id uuid.UUID, update func(*model.User)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[id]
+	if !ok {
+		return model.ErrUserNotFound
+	}
+	update(&user)
+	s.users[id] = user
+	return nil
+}
@@ -0,0 +1,171 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	UserMFATable          = "user_mfa"
+	MFARecoveryCodesTable = "mfa_recovery_codes"
+	MFARequiredRolesTable = "mfa_required_roles"
+)
+
+func (s *Store) GetUserMFA(ctx context.Context, userID uuid.UUID) (model.UserMFA, error) {
+	var mfa model.UserMFA
+	query := fmt.Sprintf(`SELECT user_id, secret, enabled_at, last_used_step, created_at FROM %s WHERE user_id = $1`, UserMFATable)
+	err := s.pool.QueryRow(ctx, query, userID).Scan(&mfa.UserID, &mfa.Secret, &mfa.EnabledAt, &mfa.LastUsedStep, &mfa.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.UserMFA{}, model.ErrMFANotFound
+		}
+		return model.UserMFA{}, err
+	}
+	return mfa, nil
+}
+
+// SaveUserMFA stores the MFA of the user, replacing the one set up before.
+func (s *Store) SaveUserMFA(ctx context.Context, mfa model.UserMFA) error {
+	query := fmt.Sprintf(`INSERT INTO %s (user_id, secret, enabled_at, last_used_step, created_at) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE SET secret = EXCLUDED.secret, enabled_at = EXCLUDED.enabled_at,
+			last_used_step = EXCLUDED.last_used_step, created_at = EXCLUDED.created_at`, UserMFATable)
+	_, err := s.pool.Exec(ctx, query, mfa.UserID, mfa.Secret, mfa.EnabledAt, mfa.LastUsedStep, mfa.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Store) EnableUserMFA(ctx context.Context, userID uuid.UUID, enabledAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET enabled_at = $2 WHERE user_id = $1`, UserMFATable)
+	result, err := s.pool.Exec(ctx, query, userID, enabledAt)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrMFANotFound
+	}
+	return nil
+}
+
+// UseMFAStep records the time step of an accepted code. It reports false when a code of the same
+// or a later step was accepted already, so that concurrent logins cannot use the same code.
+func (s *Store) UseMFAStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	query := fmt.Sprintf(`UPDATE %s SET last_used_step = $2 WHERE user_id = $1 AND last_used_step < $2`, UserMFATable)
+	result, err := s.pool.Exec(ctx, query, userID, step)
+	if err != nil {
+		return false, err
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+func (s *Store) DeleteUserMFA(ctx context.Context, userID uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE user_id = $1`, UserMFATable)
+	result, err := s.pool.Exec(ctx, query, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrMFANotFound
+	}
+	return nil
+}
+
+// ReplaceRecoveryCodes stores the hashes of new recovery codes in a single transaction, the codes
+// generated before stop working.
+func (s *Store) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, hashes []string) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE user_id = $1`, MFARecoveryCodesTable)
+	if _, err := tx.Exec(ctx, query, userID); err != nil {
+		return err
+	}
+	query = fmt.Sprintf(`INSERT INTO %s (user_id, hash) SELECT $1, unnest($2::TEXT[])`, MFARecoveryCodesTable)
+	if _, err := tx.Exec(ctx, query, userID, hashes); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return model.ErrMFANotFound
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// UseRecoveryCode deletes the recovery code and reports whether it existed.
+func (s *Store) UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string) (bool, error) {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE user_id = $1 AND hash = $2`, MFARecoveryCodesTable)
+	result, err := s.pool.Exec(ctx, query, userID, hash)
+	if err != nil {
+		return false, err
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+func (s *Store) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error) {
+	var count int
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE user_id = $1`, MFARecoveryCodesTable)
+	if err := s.pool.QueryRow(ctx, query, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *Store) GetMFAPolicy(ctx context.Context, orgID uuid.UUID) (model.MFAPolicy, error) {
+	query := fmt.Sprintf(`SELECT role FROM %s WHERE org_id = $1 ORDER BY role`, MFARequiredRolesTable)
+	rows, err := s.pool.Query(ctx, query, orgID)
+	if err != nil {
+		return model.MFAPolicy{}, err
+	}
+	defer rows.Close()
+
+	policy := model.MFAPolicy{RequiredRoles: []model.RoleType{}}
+	for rows.Next() {
+		var role model.RoleType
+		if err := rows.Scan(&role); err != nil {
+			return model.MFAPolicy{}, err
+		}
+		policy.RequiredRoles = append(policy.RequiredRoles, role)
+	}
+	return policy, rows.Err()
+}
+
+// SaveMFAPolicy replaces the roles that require MFA in the organization in a single transaction.
+func (s *Store) SaveMFAPolicy(ctx context.Context, orgID uuid.UUID, policy model.MFAPolicy) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE org_id = $1`, MFARequiredRolesTable), orgID); err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`INSERT INTO %s (org_id, role) VALUES ($1, $2) ON CONFLICT DO NOTHING`, MFARequiredRolesTable)
+	for _, role := range policy.RequiredRoles {
+		if _, err := tx.Exec(ctx, query, orgID, role); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
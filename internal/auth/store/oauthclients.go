@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const OAuthClientsTable = "oauth_clients"
+
+const oauthClientColumns = `id, org_id, name, secret_hash, scopes, created_by, created_at`
+
+func (s *Store) CreateOAuthClient(ctx context.Context, client model.OAuthClient) error {
+	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES ($1, $2, $3, $4, $5, $6, $7)`, OAuthClientsTable, oauthClientColumns)
+	_, err := s.pool.Exec(ctx, query,
+		client.ID, client.OrgID, client.Name, client.SecretHash, client.Scopes, client.CreatedBy, client.CreatedAt)
+	return err
+}
+
+func (s *Store) ListOAuthClients(ctx context.Context, orgID uuid.UUID) ([]model.OAuthClient, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE org_id = $1 ORDER BY created_at, name`, oauthClientColumns, OAuthClientsTable)
+	rows, err := s.pool.Query(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	clients := []model.OAuthClient{}
+	for rows.Next() {
+		client, err := scanOAuthClient(rows)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	return clients, rows.Err()
+}
+
+func (s *Store) GetOAuthClient(ctx context.Context, id uuid.UUID) (model.OAuthClient, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE id = $1`, oauthClientColumns, OAuthClientsTable)
+	client, err := scanOAuthClient(s.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.OAuthClient{}, model.ErrOAuthClientNotFound
+		}
+		return model.OAuthClient{}, err
+	}
+	return client, nil
+}
+
+func (s *Store) DeleteOAuthClient(ctx context.Context, orgID, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = $1 AND id = $2`, OAuthClientsTable)
+	result, err := s.pool.Exec(ctx, query, orgID, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrOAuthClientNotFound
+	}
+	return nil
+}
+
+func scanOAuthClient(row pgx.Row) (model.OAuthClient, error) {
+	var client model.OAuthClient
+	err := row.Scan(
+		&client.ID,
+		&client.OrgID,
+		&client.Name,
+		&client.SecretHash,
+		&client.Scopes,
+		&client.CreatedBy,
+		&client.CreatedAt,
+	)
+	return client, err
+}
@@ -0,0 +1,175 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	OrganizationsTable           = "organizations"
+	OrganizationMembersTable     = "organization_members"
+	OrganizationInvitationsTable = "organization_invitations"
+)
+
+const (
+	uniqueViolationCode     = "23505"
+	foreignKeyViolationCode = "23503"
+)
+
+// ListUserOrgs returns the organizations the user is a member of, oldest first.
+func (s *Store) ListUserOrgs(ctx context.Context, userID uuid.UUID) ([]model.Organization, error) {
+	query := fmt.Sprintf(`SELECT o.id, o.name, o.created_at FROM %s o JOIN %s m ON m.org_id = o.id
+		WHERE m.user_id = $1 ORDER BY o.created_at, o.name`, OrganizationsTable, OrganizationMembersTable)
+	rows, err := s.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orgs := []model.Organization{}
+	for rows.Next() {
+		var org model.Organization
+		if err := rows.Scan(&org.ID, &org.Name, &org.CreatedAt); err != nil {
+			return nil, err
+		}
+		orgs = append(orgs, org)
+	}
+
+	return orgs, rows.Err()
+}
+
+func (s *Store) IsOrgMember(ctx context.Context, orgID, userID uuid.UUID) (bool, error) {
+	var exists bool
+	query := fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM %s WHERE org_id = $1 AND user_id = $2)`, OrganizationMembersTable)
+	err := s.pool.QueryRow(ctx, query, orgID, userID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// GetOrgMemberRole returns the role of the user in the organization.
+func (s *Store) GetOrgMemberRole(ctx context.Context, orgID, userID uuid.UUID) (model.RoleType, error) {
+	var role model.RoleType
+	query := fmt.Sprintf(`SELECT role FROM %s WHERE org_id = $1 AND user_id = $2`, OrganizationMembersTable)
+	err := s.pool.QueryRow(ctx, query, orgID, userID).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", model.ErrMemberNotFound
+		}
+		return "", err
+	}
+	return role, nil
+}
+
+// CreateOrg stores the organization together with its first member, who becomes its admin, in a
+// single transaction.
+func (s *Store) CreateOrg(ctx context.Context, org model.Organization, ownerID uuid.UUID) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, name, created_at) VALUES ($1, $2, $3)`, OrganizationsTable)
+	if _, err := tx.Exec(ctx, query, org.ID, org.Name, org.CreatedAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return model.ErrOrgExists
+		}
+		return err
+	}
+
+	query = fmt.Sprintf(`INSERT INTO %s (org_id, user_id, role) VALUES ($1, $2, $3)`, OrganizationMembersTable)
+	if _, err := tx.Exec(ctx, query, org.ID, ownerID, model.RoleAdmin); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *Store) GetOrgByID(ctx context.Context, id uuid.UUID) (model.Organization, error) {
+	var org model.Organization
+	query := fmt.Sprintf(`SELECT id, name, created_at FROM %s WHERE id = $1`, OrganizationsTable)
+	err := s.pool.QueryRow(ctx, query, id).Scan(&org.ID, &org.Name, &org.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Organization{}, model.ErrOrgNotFound
+		}
+		return model.Organization{}, err
+	}
+	return org, nil
+}
+
+func (s *Store) ListOrgMembers(ctx context.Context, orgID uuid.UUID) ([]model.OrganizationMember, error) {
+	query := fmt.Sprintf(`SELECT u.id, u.username, m.role FROM %s u JOIN %s m ON m.user_id = u.id
+		WHERE m.org_id = $1 ORDER BY u.username`, UserTable, OrganizationMembersTable)
+	rows, err := s.pool.Query(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := []model.OrganizationMember{}
+	for rows.Next() {
+		var member model.OrganizationMember
+		if err := rows.Scan(&member.UserID, &member.Username, &member.Role); err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+// AddOrgMember adds the user to the organization with the role, adding an existing member again
+// keeps their role.
+func (s *Store) AddOrgMember(ctx context.Context, orgID, userID uuid.UUID, role model.RoleType) error {
+	query := fmt.Sprintf(`INSERT INTO %s (org_id, user_id, role) VALUES ($1, $2, $3) ON CONFLICT (org_id, user_id) DO NOTHING`,
+		OrganizationMembersTable)
+	_, err := s.pool.Exec(ctx, query, orgID, userID, role)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Store) UpdateOrgMemberRole(ctx context.Context, orgID, userID uuid.UUID, role model.RoleType) error {
+	query := fmt.Sprintf(`UPDATE %s SET role = $3 WHERE org_id = $1 AND user_id = $2`, OrganizationMembersTable)
+	result, err := s.pool.Exec(ctx, query, orgID, userID, role)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrMemberNotFound
+	}
+	return nil
+}
+
+func (s *Store) RemoveOrgMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = $1 AND user_id = $2`, OrganizationMembersTable)
+	result, err := s.pool.Exec(ctx, query, orgID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrMemberNotFound
+	}
+	return nil
+}
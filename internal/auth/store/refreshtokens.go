@@ -0,0 +1,96 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const RefreshTokensTable = "refresh_tokens"
+
+func (s *Store) CreateRefreshToken(ctx context.Context, token model.RefreshToken) error {
+	query := fmt.Sprintf(`INSERT INTO %s (token_hash, family_id, user_id, org_id, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`, RefreshTokensTable)
+	_, err := s.pool.Exec(ctx, query,
+		token.Hash, token.FamilyID, token.UserID, token.OrgID, token.ExpiresAt, token.CreatedAt)
+	return err
+}
+
+func (s *Store) GetRefreshToken(ctx context.Context, hash string) (model.RefreshToken, error) {
+	var token model.RefreshToken
+	query := fmt.Sprintf(`SELECT token_hash, family_id, user_id, org_id, expires_at, created_at, used_at, revoked_at
+		FROM %s WHERE token_hash = $1`, RefreshTokensTable)
+	err := s.pool.QueryRow(ctx, query, hash).Scan(
+		&token.Hash,
+		&token.FamilyID,
+		&token.UserID,
+		&token.OrgID,
+		&token.ExpiresAt,
+		&token.CreatedAt,
+		&token.UsedAt,
+		&token.RevokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.RefreshToken{}, model.ErrRefreshTokenNotFound
+		}
+		return model.RefreshToken{}, err
+	}
+	return token, nil
+}
+
+// RotateRefreshToken marks the token as used and stores its successor in a single transaction.
+// It returns ErrRefreshTokenUsed when the token was used in the meantime.
+func (s *Store) RotateRefreshToken(ctx context.Context, hash string, next model.RefreshToken) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	query := fmt.Sprintf(`UPDATE %s SET used_at = $1 WHERE token_hash = $2 AND used_at IS NULL AND revoked_at IS NULL`,
+		RefreshTokensTable)
+	result, err := tx.Exec(ctx, query, next.CreatedAt, hash)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrRefreshTokenUsed
+	}
+
+	query = fmt.Sprintf(`INSERT INTO %s (token_hash, family_id, user_id, org_id, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`, RefreshTokensTable)
+	if _, err := tx.Exec(ctx, query,
+		next.Hash, next.FamilyID, next.UserID, next.OrgID, next.ExpiresAt, next.CreatedAt); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *Store) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID, revokedAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET revoked_at = $1 WHERE family_id = $2 AND revoked_at IS NULL`, RefreshTokensTable)
+	_, err := s.pool.Exec(ctx, query, revokedAt, familyID)
+	return err
+}
+
+// RevokeRefreshTokensIssuedBefore revokes the families of the organization that were started by a
+// login before issuedBefore, of all users when userID is nil.
+func (s *Store) RevokeRefreshTokensIssuedBefore(
+	ctx context.Context,
+	orgID, userID uuid.UUID,
+	issuedBefore, revokedAt time.Time,
+) error {
+	query := fmt.Sprintf(`UPDATE %[1]s SET revoked_at = $1 WHERE revoked_at IS NULL AND family_id IN (
+		SELECT family_id FROM %[1]s WHERE org_id = $2 AND ($3::uuid IS NULL OR user_id = $3) AND created_at < $4
+	)`, RefreshTokensTable)
+	_, err := s.pool.Exec(ctx, query, revokedAt, orgID, nullUUID(userID), issuedBefore)
+	return err
+}
@@ -0,0 +1,116 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	"github.com/google/uuid"
+)
+
+const apiKeyColumns = `id, org_id, name, environment, kind, prefix, key_hash, scopes, created_by, created_at, last_used_at,
+	revoked_at`
+
+func (s *Store) CreateAPIKey(ctx context.Context, key model.APIKey) error {
+	scopes, err := json.Marshal(key.Scopes)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, org_id, name, environment, kind, prefix, key_hash, scopes, created_by, created_at)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10)`, store.APIKeysTable)
+	_, err = s.db.ExecContext(ctx, query,
+		key.ID, key.OrgID, key.Name, key.Environment, key.Kind, key.Prefix, key.Hash, string(scopes), key.CreatedBy,
+		key.CreatedAt.UTC())
+	return err
+}
+
+func (s *Store) ListAPIKeys(ctx context.Context, orgID uuid.UUID) ([]model.APIKey, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE org_id = ?1 ORDER BY created_at, name`, apiKeyColumns, store.APIKeysTable)
+	rows, err := s.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []model.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (s *Store) GetAPIKeyByHash(ctx context.Context, hash string) (model.APIKey, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE key_hash = ?1`, apiKeyColumns, store.APIKeysTable)
+	key, err := scanAPIKey(s.db.QueryRowContext(ctx, query, hash))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.APIKey{}, model.ErrAPIKeyNotFound
+		}
+		return model.APIKey{}, err
+	}
+	return key, nil
+}
+
+func (s *Store) RevokeAPIKey(ctx context.Context, orgID, id uuid.UUID, revokedAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET revoked_at = COALESCE(revoked_at, ?1) WHERE org_id = ?2 AND id = ?3`,
+		store.APIKeysTable)
+	result, err := s.db.ExecContext(ctx, query, revokedAt.UTC(), orgID, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return model.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (s *Store) TouchAPIKey(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET last_used_at = ?1 WHERE id = ?2`, store.APIKeysTable)
+	_, err := s.db.ExecContext(ctx, query, usedAt.UTC(), id)
+	return err
+}
+
+type row interface {
+	Scan(dest ...any) error
+}
+
+func scanAPIKey(r row) (model.APIKey, error) {
+	var (
+		key    model.APIKey
+		scopes string
+	)
+	if err := r.Scan(
+		&key.ID,
+		&key.OrgID,
+		&key.Name,
+		&key.Environment,
+		&key.Kind,
+		&key.Prefix,
+		&key.Hash,
+		&scopes,
+		&key.CreatedBy,
+		&key.CreatedAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+	); err != nil {
+		return model.APIKey{}, err
+	}
+	if err := json.Unmarshal([]byte(scopes), &key.Scopes); err != nil {
+		return model.APIKey{}, fmt.Errorf("failed to decode API key scopes: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,80 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	sqlitedb "github.com/georgisomnoev/feature-flag-api/internal/sqlite"
+	"github.com/google/uuid"
+)
+
+func (s *Store) CreateExternalIdentity(ctx context.Context, identity model.ExternalIdentity) error {
+	query := fmt.Sprintf(`INSERT INTO %s (id, user_id, issuer, subject, created_at) VALUES (?1, ?2, ?3, ?4, ?5)`,
+		store.ExternalIdentitiesTable)
+	_, err := s.db.ExecContext(ctx, query, identity.ID, identity.UserID, identity.Issuer, identity.Subject,
+		identity.CreatedAt.UTC())
+	if err != nil {
+		switch {
+		case sqlitedb.IsUniqueViolation(err):
+			return model.ErrIdentityExists
+		case sqlitedb.IsForeignKeyViolation(err):
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Store) ListExternalIdentities(ctx context.Context, userID uuid.UUID) ([]model.ExternalIdentity, error) {
+	query := fmt.Sprintf(`SELECT id, user_id, issuer, subject, created_at FROM %s WHERE user_id = ?1
+		ORDER BY created_at, issuer, subject`, store.ExternalIdentitiesTable)
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	identities := []model.ExternalIdentity{}
+	for rows.Next() {
+		var identity model.ExternalIdentity
+		if err := rows.Scan(&identity.ID, &identity.UserID, &identity.Issuer, &identity.Subject, &identity.CreatedAt); err != nil {
+			return nil, err
+		}
+		identities = append(identities, identity)
+	}
+	return identities, rows.Err()
+}
+
+func (s *Store) DeleteExternalIdentity(ctx context.Context, userID, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE user_id = ?1 AND id = ?2`, store.ExternalIdentitiesTable)
+	result, err := s.db.ExecContext(ctx, query, userID, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return model.ErrIdentityNotFound
+	}
+	return nil
+}
+
+func (s *Store) GetUserByExternalIdentity(ctx context.Context, issuer, subject string) (*model.User, error) {
+	var user model.User
+	query := fmt.Sprintf(`SELECT u.id, u.username, u.password, u.disabled FROM %s u
+		JOIN %s i ON i.user_id = u.id WHERE i.issuer = ?1 AND i.subject = ?2`, store.UserTable, store.ExternalIdentitiesTable)
+	err := s.db.QueryRowContext(ctx, query, issuer, subject).Scan(&user.ID, &user.Username, &user.Password, &user.Disabled)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
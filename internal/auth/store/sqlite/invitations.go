@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	sqlitedb "github.com/georgisomnoev/feature-flag-api/internal/sqlite"
+	"github.com/google/uuid"
+)
+
+func (s *Store) CreateOrgInvitation(ctx context.Context, invitation model.OrgInvitation) error {
+	query := fmt.Sprintf(`INSERT INTO %s (org_id, user_id, role, invited_by, created_at) VALUES (?1, ?2, ?3, ?4, ?5)
+		ON CONFLICT (org_id, user_id) DO UPDATE
+		SET role = excluded.role, invited_by = excluded.invited_by, created_at = excluded.created_at`,
+		store.OrganizationInvitationsTable)
+	_, err := s.db.ExecContext(ctx, query,
+		invitation.OrgID, invitation.UserID, invitation.Role, invitation.InvitedBy, invitation.CreatedAt.UTC())
+	if err != nil {
+		if sqlitedb.IsForeignKeyViolation(err) {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Store) ListUserInvitations(ctx context.Context, userID uuid.UUID) ([]model.OrgInvitation, error) {
+	query := fmt.Sprintf(`SELECT i.org_id, o.name, i.user_id, i.role, i.invited_by, i.created_at
+		FROM %s i JOIN %s o ON o.id = i.org_id WHERE i.user_id = ?1 ORDER BY i.created_at, o.name`,
+		store.OrganizationInvitationsTable, store.OrganizationsTable)
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	invitations := []model.OrgInvitation{}
+	for rows.Next() {
+		var invitation model.OrgInvitation
+		if err := rows.Scan(
+			&invitation.OrgID,
+			&invitation.OrgName,
+			&invitation.UserID,
+			&invitation.Role,
+			&invitation.InvitedBy,
+			&invitation.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, invitation)
+	}
+
+	return invitations, rows.Err()
+}
+
+func (s *Store) AcceptOrgInvitation(ctx context.Context, orgID, userID uuid.UUID) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var role model.RoleType
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = ?1 AND user_id = ?2 RETURNING role`, store.OrganizationInvitationsTable)
+	if err := tx.QueryRowContext(ctx, query, orgID, userID).Scan(&role); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ErrInvitationNotFound
+		}
+		return err
+	}
+
+	query = fmt.Sprintf(`INSERT INTO %s (org_id, user_id, role, created_at) VALUES (?1, ?2, ?3, ?4)
+		ON CONFLICT (org_id, user_id) DO NOTHING`, store.OrganizationMembersTable)
+	if _, err := tx.ExecContext(ctx, query, orgID, userID, role, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *Store) DeleteOrgInvitation(ctx context.Context, orgID, userID uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = ?1 AND user_id = ?2`, store.OrganizationInvitationsTable)
+	result, err := s.db.ExecContext(ctx, query, orgID, userID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return model.ErrInvitationNotFound
+	}
+	return nil
+}
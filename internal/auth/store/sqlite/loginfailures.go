@@ -0,0 +1,52 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+)
+
+func (s *Store) GetLoginFailures(ctx context.Context, key string) (model.LoginFailures, error) {
+	failures := model.LoginFailures{Key: key}
+	query := fmt.Sprintf(`SELECT count, last_failed_at FROM %s WHERE key = ?1`, store.LoginFailuresTable)
+	err := s.db.QueryRowContext(ctx, query, key).Scan(&failures.Count, &failures.LastFailedAt)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return model.LoginFailures{}, err
+	}
+	return failures, nil
+}
+
+func (s *Store) RecordLoginFailure(ctx context.Context, key string, failedAt, forgetBefore time.Time) (model.LoginFailures, error) {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE last_failed_at < ?1`, store.LoginFailuresTable)
+	if _, err := s.db.ExecContext(ctx, query, forgetBefore.UTC()); err != nil {
+		return model.LoginFailures{}, err
+	}
+
+	failures := model.LoginFailures{Key: key}
+	query = fmt.Sprintf(`INSERT INTO %s (key, count, last_failed_at) VALUES (?1, 1, ?2)
+		ON CONFLICT (key) DO UPDATE SET count = count + 1, last_failed_at = excluded.last_failed_at
+		RETURNING count, last_failed_at`, store.LoginFailuresTable)
+	if err := s.db.QueryRowContext(ctx, query, key, failedAt.UTC()).Scan(&failures.Count, &failures.LastFailedAt); err != nil {
+		return model.LoginFailures{}, err
+	}
+	return failures, nil
+}
+
+func (s *Store) ReleaseLoginFailure(ctx context.Context, key string, count int, lastFailedAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s
+		SET count = count - 1, last_failed_at = CASE WHEN count = ?2 THEN ?3 ELSE last_failed_at END
+		WHERE key = ?1 AND count > 0`, store.LoginFailuresTable)
+	_, err := s.db.ExecContext(ctx, query, key, count, lastFailedAt.UTC())
+	return err
+}
+
+func (s *Store) ClearLoginFailures(ctx context.Context, key string) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE key = ?1`, store.LoginFailuresTable)
+	_, err := s.db.ExecContext(ctx, query, key)
+	return err
+}
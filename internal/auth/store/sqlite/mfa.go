@@ -0,0 +1,168 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	sqlitedb "github.com/georgisomnoev/feature-flag-api/internal/sqlite"
+	"github.com/google/uuid"
+)
+
+func (s *Store) GetUserMFA(ctx context.Context, userID uuid.UUID) (model.UserMFA, error) {
+	var mfa model.UserMFA
+	query := fmt.Sprintf(`SELECT user_id, secret, enabled_at, last_used_step, created_at FROM %s WHERE user_id = ?1`,
+		store.UserMFATable)
+	err := s.db.QueryRowContext(ctx, query, userID).Scan(&mfa.UserID, &mfa.Secret, &mfa.EnabledAt, &mfa.LastUsedStep, &mfa.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.UserMFA{}, model.ErrMFANotFound
+		}
+		return model.UserMFA{}, err
+	}
+	return mfa, nil
+}
+
+func (s *Store) SaveUserMFA(ctx context.Context, mfa model.UserMFA) error {
+	var enabledAt *time.Time
+	if mfa.EnabledAt != nil {
+		utc := mfa.EnabledAt.UTC()
+		enabledAt = &utc
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (user_id, secret, enabled_at, last_used_step, created_at) VALUES (?1, ?2, ?3, ?4, ?5)
+		ON CONFLICT (user_id) DO UPDATE SET secret = excluded.secret, enabled_at = excluded.enabled_at,
+			last_used_step = excluded.last_used_step, created_at = excluded.created_at`, store.UserMFATable)
+	_, err := s.db.ExecContext(ctx, query, mfa.UserID, mfa.Secret, enabledAt, mfa.LastUsedStep, mfa.CreatedAt.UTC())
+	if err != nil {
+		if sqlitedb.IsForeignKeyViolation(err) {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Store) EnableUserMFA(ctx context.Context, userID uuid.UUID, enabledAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET enabled_at = ?2 WHERE user_id = ?1`, store.UserMFATable)
+	return s.execMFA(ctx, query, userID, enabledAt.UTC())
+}
+
+func (s *Store) UseMFAStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	query := fmt.Sprintf(`UPDATE %s SET last_used_step = ?2 WHERE user_id = ?1 AND last_used_step < ?2`, store.UserMFATable)
+	return s.execAffected(ctx, query, userID, step)
+}
+
+func (s *Store) DeleteUserMFA(ctx context.Context, userID uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE user_id = ?1`, store.UserMFATable)
+	return s.execMFA(ctx, query, userID)
+}
+
+func (s *Store) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, hashes []string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE user_id = ?1`, store.MFARecoveryCodesTable)
+	if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+		return err
+	}
+	query = fmt.Sprintf(`INSERT INTO %s (user_id, hash) VALUES (?1, ?2)`, store.MFARecoveryCodesTable)
+	for _, hash := range hashes {
+		if _, err := tx.ExecContext(ctx, query, userID, hash); err != nil {
+			if sqlitedb.IsForeignKeyViolation(err) {
+				return model.ErrMFANotFound
+			}
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *Store) UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string) (bool, error) {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE user_id = ?1 AND hash = ?2`, store.MFARecoveryCodesTable)
+	return s.execAffected(ctx, query, userID, hash)
+}
+
+func (s *Store) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error) {
+	var count int
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE user_id = ?1`, store.MFARecoveryCodesTable)
+	if err := s.db.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *Store) GetMFAPolicy(ctx context.Context, orgID uuid.UUID) (model.MFAPolicy, error) {
+	query := fmt.Sprintf(`SELECT role FROM %s WHERE org_id = ?1 ORDER BY role`, store.MFARequiredRolesTable)
+	rows, err := s.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return model.MFAPolicy{}, err
+	}
+	defer rows.Close()
+
+	policy := model.MFAPolicy{RequiredRoles: []model.RoleType{}}
+	for rows.Next() {
+		var role model.RoleType
+		if err := rows.Scan(&role); err != nil {
+			return model.MFAPolicy{}, err
+		}
+		policy.RequiredRoles = append(policy.RequiredRoles, role)
+	}
+	return policy, rows.Err()
+}
+
+func (s *Store) SaveMFAPolicy(ctx context.Context, orgID uuid.UUID, policy model.MFAPolicy) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s WHERE org_id = ?1`, store.MFARequiredRolesTable), orgID); err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`INSERT INTO %s (org_id, role) VALUES (?1, ?2) ON CONFLICT DO NOTHING`, store.MFARequiredRolesTable)
+	for _, role := range policy.RequiredRoles {
+		if _, err := tx.ExecContext(ctx, query, orgID, role); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// execMFA runs a statement on the MFA of a user and returns ErrMFANotFound when there is none.
+func (s *Store) execMFA(ctx context.Context, query string, args ...any) error {
+	ok, err := s.execAffected(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return model.ErrMFANotFound
+	}
+	return nil
+}
+
+func (s *Store) execAffected(ctx context.Context, query string, args ...any) (bool, error) {
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
@@ -0,0 +1,97 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	"github.com/google/uuid"
+)
+
+const oauthClientColumns = `id, org_id, name, secret_hash, scopes, created_by, created_at`
+
+func (s *Store) CreateOAuthClient(ctx context.Context, client model.OAuthClient) error {
+	scopes, err := json.Marshal(client.Scopes)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7)`, store.OAuthClientsTable, oauthClientColumns)
+	_, err = s.db.ExecContext(ctx, query,
+		client.ID, client.OrgID, client.Name, client.SecretHash, string(scopes), client.CreatedBy, client.CreatedAt.UTC())
+	return err
+}
+
+func (s *Store) ListOAuthClients(ctx context.Context, orgID uuid.UUID) ([]model.OAuthClient, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE org_id = ?1 ORDER BY created_at, name`,
+		oauthClientColumns, store.OAuthClientsTable)
+	rows, err := s.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	clients := []model.OAuthClient{}
+	for rows.Next() {
+		client, err := scanOAuthClient(rows)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	return clients, rows.Err()
+}
+
+func (s *Store) GetOAuthClient(ctx context.Context, id uuid.UUID) (model.OAuthClient, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE id = ?1`, oauthClientColumns, store.OAuthClientsTable)
+	client, err := scanOAuthClient(s.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.OAuthClient{}, model.ErrOAuthClientNotFound
+		}
+		return model.OAuthClient{}, err
+	}
+	return client, nil
+}
+
+func (s *Store) DeleteOAuthClient(ctx context.Context, orgID, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = ?1 AND id = ?2`, store.OAuthClientsTable)
+	result, err := s.db.ExecContext(ctx, query, orgID, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return model.ErrOAuthClientNotFound
+	}
+	return nil
+}
+
+func scanOAuthClient(r row) (model.OAuthClient, error) {
+	var (
+		client model.OAuthClient
+		scopes string
+	)
+	if err := r.Scan(
+		&client.ID,
+		&client.OrgID,
+		&client.Name,
+		&client.SecretHash,
+		&scopes,
+		&client.CreatedBy,
+		&client.CreatedAt,
+	); err != nil {
+		return model.OAuthClient{}, err
+	}
+	if err := json.Unmarshal([]byte(scopes), &client.Scopes); err != nil {
+		return model.OAuthClient{}, fmt.Errorf("failed to decode OAuth client scopes: %w", err)
+	}
+	return client, nil
+}
@@ -0,0 +1,165 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	sqlitedb "github.com/georgisomnoev/feature-flag-api/internal/sqlite"
+	"github.com/google/uuid"
+)
+
+func (s *Store) ListUserOrgs(ctx context.Context, userID uuid.UUID) ([]model.Organization, error) {
+	query := fmt.Sprintf(`SELECT o.id, o.name, o.created_at FROM %s o JOIN %s m ON m.org_id = o.id
+		WHERE m.user_id = ?1 ORDER BY o.created_at, o.name`, store.OrganizationsTable, store.OrganizationMembersTable)
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orgs := []model.Organization{}
+	for rows.Next() {
+		var org model.Organization
+		if err := rows.Scan(&org.ID, &org.Name, &org.CreatedAt); err != nil {
+			return nil, err
+		}
+		orgs = append(orgs, org)
+	}
+
+	return orgs, rows.Err()
+}
+
+func (s *Store) IsOrgMember(ctx context.Context, orgID, userID uuid.UUID) (bool, error) {
+	var exists bool
+	query := fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM %s WHERE org_id = ?1 AND user_id = ?2)`, store.OrganizationMembersTable)
+	err := s.db.QueryRowContext(ctx, query, orgID, userID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (s *Store) GetOrgMemberRole(ctx context.Context, orgID, userID uuid.UUID) (model.RoleType, error) {
+	var role model.RoleType
+	query := fmt.Sprintf(`SELECT role FROM %s WHERE org_id = ?1 AND user_id = ?2`, store.OrganizationMembersTable)
+	err := s.db.QueryRowContext(ctx, query, orgID, userID).Scan(&role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", model.ErrMemberNotFound
+		}
+		return "", err
+	}
+	return role, nil
+}
+
+func (s *Store) CreateOrg(ctx context.Context, org model.Organization, ownerID uuid.UUID) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, name, created_at) VALUES (?1, ?2, ?3)`, store.OrganizationsTable)
+	if _, err := tx.ExecContext(ctx, query, org.ID, org.Name, org.CreatedAt.UTC()); err != nil {
+		if sqlitedb.IsUniqueViolation(err) {
+			return model.ErrOrgExists
+		}
+		return err
+	}
+
+	query = fmt.Sprintf(`INSERT INTO %s (org_id, user_id, role, created_at) VALUES (?1, ?2, ?3, ?4)`, store.OrganizationMembersTable)
+	if _, err := tx.ExecContext(ctx, query, org.ID, ownerID, model.RoleAdmin, time.Now().UTC()); err != nil {
+		if sqlitedb.IsForeignKeyViolation(err) {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *Store) GetOrgByID(ctx context.Context, id uuid.UUID) (model.Organization, error) {
+	var org model.Organization
+	query := fmt.Sprintf(`SELECT id, name, created_at FROM %s WHERE id = ?1`, store.OrganizationsTable)
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&org.ID, &org.Name, &org.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Organization{}, model.ErrOrgNotFound
+		}
+		return model.Organization{}, err
+	}
+	return org, nil
+}
+
+func (s *Store) ListOrgMembers(ctx context.Context, orgID uuid.UUID) ([]model.OrganizationMember, error) {
+	query := fmt.Sprintf(`SELECT u.id, u.username, m.role FROM %s u JOIN %s m ON m.user_id = u.id
+		WHERE m.org_id = ?1 ORDER BY u.username`, store.UserTable, store.OrganizationMembersTable)
+	rows, err := s.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := []model.OrganizationMember{}
+	for rows.Next() {
+		var member model.OrganizationMember
+		if err := rows.Scan(&member.UserID, &member.Username, &member.Role); err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+func (s *Store) AddOrgMember(ctx context.Context, orgID, userID uuid.UUID, role model.RoleType) error {
+	query := fmt.Sprintf(`INSERT INTO %s (org_id, user_id, role, created_at) VALUES (?1, ?2, ?3, ?4)
+		ON CONFLICT (org_id, user_id) DO NOTHING`, store.OrganizationMembersTable)
+	_, err := s.db.ExecContext(ctx, query, orgID, userID, role, time.Now().UTC())
+	if err != nil {
+		if sqlitedb.IsForeignKeyViolation(err) {
+			return model.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Store) UpdateOrgMemberRole(ctx context.Context, orgID, userID uuid.UUID, role model.RoleType) error {
+	query := fmt.Sprintf(`UPDATE %s SET role = ?3 WHERE org_id = ?1 AND user_id = ?2`, store.OrganizationMembersTable)
+	result, err := s.db.ExecContext(ctx, query, orgID, userID, role)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return model.ErrMemberNotFound
+	}
+	return nil
+}
+
+func (s *Store) RemoveOrgMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = ?1 AND user_id = ?2`, store.OrganizationMembersTable)
+	result, err := s.db.ExecContext(ctx, query, orgID, userID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return model.ErrMemberNotFound
+	}
+	return nil
+}
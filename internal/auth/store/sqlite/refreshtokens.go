@@ -0,0 +1,101 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	"github.com/google/uuid"
+)
+
+func (s *Store) CreateRefreshToken(ctx context.Context, token model.RefreshToken) error {
+	query := fmt.Sprintf(`INSERT INTO %s (token_hash, family_id, user_id, org_id, expires_at, created_at)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6)`, store.RefreshTokensTable)
+	_, err := s.db.ExecContext(ctx, query,
+		token.Hash, token.FamilyID, token.UserID, token.OrgID, token.ExpiresAt.UTC(), token.CreatedAt.UTC())
+	return err
+}
+
+func (s *Store) GetRefreshToken(ctx context.Context, hash string) (model.RefreshToken, error) {
+	var token model.RefreshToken
+	query := fmt.Sprintf(`SELECT token_hash, family_id, user_id, org_id, expires_at, created_at, used_at, revoked_at
+		FROM %s WHERE token_hash = ?1`, store.RefreshTokensTable)
+	err := s.db.QueryRowContext(ctx, query, hash).Scan(
+		&token.Hash,
+		&token.FamilyID,
+		&token.UserID,
+		&token.OrgID,
+		&token.ExpiresAt,
+		&token.CreatedAt,
+		&token.UsedAt,
+		&token.RevokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.RefreshToken{}, model.ErrRefreshTokenNotFound
+		}
+		return model.RefreshToken{}, err
+	}
+	return token, nil
+}
+
+func (s *Store) RotateRefreshToken(ctx context.Context, hash string, next model.RefreshToken) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := fmt.Sprintf(`UPDATE %s SET used_at = ?1 WHERE token_hash = ?2 AND used_at IS NULL AND revoked_at IS NULL`,
+		store.RefreshTokensTable)
+	result, err := tx.ExecContext(ctx, query, next.CreatedAt.UTC(), hash)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrRefreshTokenUsed
+	}
+
+	query = fmt.Sprintf(`INSERT INTO %s (token_hash, family_id, user_id, org_id, expires_at, created_at)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6)`, store.RefreshTokensTable)
+	if _, err := tx.ExecContext(ctx, query,
+		next.Hash, next.FamilyID, next.UserID, next.OrgID, next.ExpiresAt.UTC(), next.CreatedAt.UTC()); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *Store) RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID, revokedAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET revoked_at = ?1 WHERE family_id = ?2 AND revoked_at IS NULL`,
+		store.RefreshTokensTable)
+	_, err := s.db.ExecContext(ctx, query, revokedAt.UTC(), familyID)
+	return err
+}
+
+func (s *Store) RevokeRefreshTokensIssuedBefore(
+	ctx context.Context,
+	orgID, userID uuid.UUID,
+	issuedBefore, revokedAt time.Time,
+) error {
+	var user *uuid.UUID
+	if userID != uuid.Nil {
+		user = &userID
+	}
+
+	query := fmt.Sprintf(`UPDATE %[1]s SET revoked_at = ?1 WHERE revoked_at IS NULL AND family_id IN (
+		SELECT family_id FROM %[1]s WHERE org_id = ?2 AND (?3 IS NULL OR user_id = ?3) AND created_at < ?4
+	)`, store.RefreshTokensTable)
+	_, err := s.db.ExecContext(ctx, query, revokedAt.UTC(), orgID, user, issuedBefore.UTC())
+	return err
+}
@@ -0,0 +1,57 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	"github.com/google/uuid"
+)
+
+type Store struct {
+	db *sql.DB
+}
+
+func NewStore(db *sql.DB) *Store {
+	return &Store{db: db}
+}
+
+func (s *Store) GetByUsername(ctx context.Context, username string) (*model.User, error) {
+	var user model.User
+	query := fmt.Sprintf("SELECT id, username, password, disabled FROM %s WHERE username = ?1", store.UserTable)
+	err := s.db.QueryRowContext(ctx, query, username).Scan(&user.ID, &user.Username, &user.Password, &user.Disabled)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (s *Store) GetByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	var user model.User
+	query := fmt.Sprintf("SELECT id, username, password, disabled FROM %s WHERE id = ?1", store.UserTable)
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&user.ID, &user.Username, &user.Password, &user.Disabled)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+// UserExists reports whether the user exists and is not disabled.
+func (s *Store) UserExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	var exists bool
+	query := fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE id = ?1 AND NOT disabled)", store.UserTable)
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
@@ -0,0 +1,14 @@
+package sqlite_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store/sqlite"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store/storetest"
+)
+
+var _ = Describe("SQLite Store", func() {
+	storetest.DescribeStore(func() storetest.Store {
+		return sqlite.NewStore(db)
+	})
+})
@@ -0,0 +1,43 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	"github.com/google/uuid"
+)
+
+func (s *Store) AddUser(ctx context.Context, user model.User) error {
+	query := fmt.Sprintf(`INSERT INTO %s (id, username, password) VALUES (?1, ?2, ?3)`, store.UserTable)
+
+	_, err := s.db.ExecContext(ctx, query, user.ID, user.Username, user.Password)
+	if err != nil {
+		return fmt.Errorf("failed to insert user into the DB: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) DeleteUserByID(ctx context.Context, userID uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = ?1`, store.UserTable)
+
+	_, err := s.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete user from the DB: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) DeleteOrgByID(ctx context.Context, orgID uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = ?1`, store.OrganizationsTable)
+
+	_, err := s.db.ExecContext(ctx, query, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to delete organization from the DB: %w", err)
+	}
+
+	return nil
+}
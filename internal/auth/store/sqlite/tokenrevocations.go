@@ -0,0 +1,94 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	"github.com/google/uuid"
+)
+
+func (s *Store) CreateTokenRevocation(ctx context.Context, revocation model.TokenRevocation) error {
+	query := fmt.Sprintf(`INSERT INTO %s (id, token_id, org_id, user_id, issued_before, expires_at, revoked_by, created_at)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8)`, store.TokenRevocationsTable)
+
+	var (
+		tokenID      *string
+		userID       *uuid.UUID
+		issuedBefore *time.Time
+	)
+	if revocation.TokenID != "" {
+		tokenID = &revocation.TokenID
+	}
+	if revocation.UserID != uuid.Nil {
+		userID = &revocation.UserID
+	}
+	if !revocation.IssuedBefore.IsZero() {
+		utc := revocation.IssuedBefore.UTC()
+		issuedBefore = &utc
+	}
+
+	_, err := s.db.ExecContext(ctx, query,
+		revocation.ID,
+		tokenID,
+		revocation.OrgID,
+		userID,
+		issuedBefore,
+		revocation.ExpiresAt.UTC(),
+		revocation.RevokedBy,
+		revocation.CreatedAt.UTC(),
+	)
+	return err
+}
+
+func (s *Store) ListTokenRevocations(ctx context.Context, now time.Time) ([]model.TokenRevocation, error) {
+	query := fmt.Sprintf(`SELECT id, token_id, org_id, user_id, issued_before, expires_at, revoked_by, created_at
+		FROM %s WHERE expires_at > ?1 ORDER BY created_at`, store.TokenRevocationsTable)
+	rows, err := s.db.QueryContext(ctx, query, now.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revocations := []model.TokenRevocation{}
+	for rows.Next() {
+		var (
+			revocation   model.TokenRevocation
+			tokenID      *string
+			userID       *uuid.UUID
+			issuedBefore *time.Time
+		)
+		if err := rows.Scan(
+			&revocation.ID,
+			&tokenID,
+			&revocation.OrgID,
+			&userID,
+			&issuedBefore,
+			&revocation.ExpiresAt,
+			&revocation.RevokedBy,
+			&revocation.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if tokenID != nil {
+			revocation.TokenID = *tokenID
+		}
+		if userID != nil {
+			revocation.UserID = *userID
+		}
+		if issuedBefore != nil {
+			revocation.IssuedBefore = *issuedBefore
+		}
+		revocations = append(revocations, revocation)
+	}
+
+	return revocations, rows.Err()
+}
+
+func (s *Store) DeleteExpiredTokenRevocations(ctx context.Context, now time.Time) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE expires_at <= ?1`, store.TokenRevocationsTable)
+	_, err := s.db.ExecContext(ctx, query, now.UTC())
+	return err
+}
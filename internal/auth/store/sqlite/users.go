@@ -0,0 +1,92 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	sqlitedb "github.com/georgisomnoev/feature-flag-api/internal/sqlite"
+	"github.com/google/uuid"
+)
+
+func (s *Store) ListUsers(ctx context.Context, orgID uuid.UUID) ([]model.User, error) {
+	query := fmt.Sprintf(`SELECT u.id, u.username, m.role, u.disabled FROM %s u JOIN %s m ON m.user_id = u.id
+		WHERE m.org_id = ?1 ORDER BY u.username`, store.UserTable, store.OrganizationMembersTable)
+	rows, err := s.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []model.User{}
+	for rows.Next() {
+		var user model.User
+		if err := rows.Scan(&user.ID, &user.Username, &user.Role, &user.Disabled); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+func (s *Store) CreateUser(ctx context.Context, user model.User, orgID uuid.UUID) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, username, password, disabled) VALUES (?1, ?2, ?3, ?4)`, store.UserTable)
+	if _, err := tx.ExecContext(ctx, query, user.ID, user.Username, user.Password, user.Disabled); err != nil {
+		if sqlitedb.IsUniqueViolation(err) {
+			return model.ErrUserExists
+		}
+		return err
+	}
+
+	query = fmt.Sprintf(`INSERT INTO %s (org_id, user_id, role, created_at) VALUES (?1, ?2, ?3, ?4)`, store.OrganizationMembersTable)
+	if _, err := tx.ExecContext(ctx, query, orgID, user.ID, user.Role, time.Now().UTC()); err != nil {
+		if sqlitedb.IsForeignKeyViolation(err) {
+			return model.ErrOrgNotFound
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *Store) SetUserDisabled(ctx context.Context, id uuid.UUID, disabled bool) error {
+	query := fmt.Sprintf(`UPDATE %s SET disabled = ?2 WHERE id = ?1`, store.UserTable)
+	return s.updateUser(ctx, query, id, disabled)
+}
+
+func (s *Store) UpdateUserPassword(ctx context.Context, id uuid.UUID, password string) error {
+	query := fmt.Sprintf(`UPDATE %s SET password = ?2 WHERE id = ?1`, store.UserTable)
+	return s.updateUser(ctx, query, id, password)
+}
+
+func (s *Store) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = ?1`, store.UserTable)
+	return s.updateUser(ctx, query, id)
+}
+
+// updateUser runs a statement on the user with the given ID and reports when there is none.
+func (s *Store) updateUser(ctx context.Context, query string, id uuid.UUID, args ...any) error {
+	result, err := s.db.ExecContext(ctx, query, append([]any{id}, args...)...)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
@@ -23,8 +23,8 @@ func NewStore(pool *pgxpool.Pool) *Store {
 }
 func (s *Store) GetByUsername(ctx context.Context, username string) (*model.User, error) {
 	var user model.User
-	query := fmt.Sprintf("SELECT id, username, password, role FROM %s WHERE username = $1", UserTable)
-	err := s.pool.QueryRow(ctx, query, username).Scan(&user.ID, &user.Username, &user.Password, &user.Role)
+	query := fmt.Sprintf("SELECT id, username, password, disabled FROM %s WHERE username = $1", UserTable)
+	err := s.pool.QueryRow(ctx, query, username).Scan(&user.ID, &user.Username, &user.Password, &user.Disabled)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -34,9 +34,23 @@ func (s *Store) GetByUsername(ctx context.Context, username string) (*model.User
 	return &user, nil
 }
 
+func (s *Store) GetByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	var user model.User
+	query := fmt.Sprintf("SELECT id, username, password, disabled FROM %s WHERE id = $1", UserTable)
+	err := s.pool.QueryRow(ctx, query, id).Scan(&user.ID, &user.Username, &user.Password, &user.Disabled)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+// UserExists reports whether the user exists and is not disabled.
 func (s *Store) UserExists(ctx context.Context, id uuid.UUID) (bool, error) {
 	var exists bool
-	query := fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE id = $1)", UserTable)
+	query := fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE id = $1 AND NOT disabled)", UserTable)
 	err := s.pool.QueryRow(ctx, query, id).Scan(&exists)
 	if err != nil {
 		return false, err
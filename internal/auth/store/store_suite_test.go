@@ -22,10 +22,15 @@ func TestStore(t *testing.T) {
 }
 
 var _ = BeforeSuite(func() {
+	if !testdb.Enabled() {
+		Skip(testdb.DBConnectionURLEnv + " is not set")
+	}
 	ctx = context.Background()
 	pool = testdb.MustInitDBPool(ctx)
 })
 
 var _ = AfterSuite(func() {
-	pool.Close()
+	if pool != nil {
+		pool.Close()
+	}
 })
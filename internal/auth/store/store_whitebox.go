@@ -9,9 +9,9 @@ import (
 )
 
 func (s *Store) AddUser(ctx context.Context, user model.User) error {
-	query := fmt.Sprintf(`INSERT INTO %s (id, username, password, role) VALUES ($1, $2, $3, $4)`, UserTable)
+	query := fmt.Sprintf(`INSERT INTO %s (id, username, password) VALUES ($1, $2, $3)`, UserTable)
 
-	_, err := s.pool.Exec(ctx, query, user.ID, user.Username, user.Password, user.Role)
+	_, err := s.pool.Exec(ctx, query, user.ID, user.Username, user.Password)
 	if err != nil {
 		return fmt.Errorf("failed to insert user into the DB: %w", err)
 	}
@@ -29,3 +29,14 @@ func (s *Store) DeleteUserByID(ctx context.Context, userID uuid.UUID) error {
 
 	return nil
 }
+
+func (s *Store) DeleteOrgByID(ctx context.Context, orgID uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, OrganizationsTable)
+
+	_, err := s.pool.Exec(ctx, query, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to delete organization from the DB: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+func describeAPIKeys(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("API keys", func() {
+		var (
+			s    Store
+			user model.User
+			org  model.Organization
+			key  model.APIKey
+		)
+
+		newKey := func(name string) model.APIKey {
+			return model.APIKey{
+				ID:          uuid.New(),
+				OrgID:       org.ID,
+				Name:        name,
+				Environment: "production",
+				Kind:        model.APIKeyKindServer,
+				Prefix:      "ffs_abcdefgh",
+				Hash:        uuid.NewString(),
+				Scopes:      []string{"read:flags", "write:flags"},
+				CreatedBy:   user.ID,
+				CreatedAt:   time.Now().UTC().Truncate(time.Millisecond),
+			}
+		}
+
+		BeforeEach(func() {
+			s = newStore()
+			user = model.User{
+				ID:       uuid.New(),
+				Username: "apikey-" + uuid.NewString()[:8],
+				Password: "testpassword",
+			}
+			Expect(s.AddUser(ctx, user)).To(Succeed())
+			org = model.Organization{ID: uuid.New(), Name: "org-" + uuid.NewString(), CreatedAt: time.Now().UTC()}
+			Expect(s.CreateOrg(ctx, org, user.ID)).To(Succeed())
+
+			key = newKey("backend")
+			Expect(s.CreateAPIKey(ctx, key)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(s.DeleteOrgByID(ctx, org.ID)).To(Succeed())
+			Expect(s.DeleteUserByID(ctx, user.ID)).To(Succeed())
+		})
+
+		Describe("GetAPIKeyByHash", func() {
+			It("returns the stored key", func() {
+				stored, err := s.GetAPIKeyByHash(ctx, key.Hash)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored).To(MatchFields(IgnoreExtras, Fields{
+					"ID":          Equal(key.ID),
+					"OrgID":       Equal(org.ID),
+					"Name":        Equal("backend"),
+					"Environment": Equal("production"),
+					"Kind":        Equal(model.APIKeyKindServer),
+					"Prefix":      Equal(key.Prefix),
+					"Hash":        Equal(key.Hash),
+					"Scopes":      Equal([]string{"read:flags", "write:flags"}),
+					"CreatedBy":   Equal(user.ID),
+					"CreatedAt":   BeTemporally("~", key.CreatedAt, time.Second),
+					"LastUsedAt":  BeNil(),
+					"RevokedAt":   BeNil(),
+				}))
+			})
+
+			It("returns ErrAPIKeyNotFound for unknown keys", func() {
+				_, err := s.GetAPIKeyByHash(ctx, uuid.NewString())
+				Expect(err).To(MatchError(model.ErrAPIKeyNotFound))
+			})
+		})
+
+		Describe("ListAPIKeys", func() {
+			It("returns the keys of the organization, oldest first", func() {
+				later := newKey("frontend")
+				later.CreatedAt = key.CreatedAt.Add(time.Second)
+				Expect(s.CreateAPIKey(ctx, later)).To(Succeed())
+
+				keys, err := s.ListAPIKeys(ctx, org.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(keys).To(HaveExactElements(
+					MatchFields(IgnoreExtras, Fields{"ID": Equal(key.ID)}),
+					MatchFields(IgnoreExtras, Fields{"ID": Equal(later.ID)}),
+				))
+			})
+
+			It("leaves out the keys of other organizations", func() {
+				keys, err := s.ListAPIKeys(ctx, uuid.New())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(keys).To(BeEmpty())
+			})
+		})
+
+		Describe("RevokeAPIKey", func() {
+			It("records the revocation time once", func() {
+				revokedAt := time.Now().UTC()
+				Expect(s.RevokeAPIKey(ctx, org.ID, key.ID, revokedAt)).To(Succeed())
+				Expect(s.RevokeAPIKey(ctx, org.ID, key.ID, revokedAt.Add(time.Hour))).To(Succeed())
+
+				stored, err := s.GetAPIKeyByHash(ctx, key.Hash)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored.RevokedAt).To(PointTo(BeTemporally("~", revokedAt, time.Second)))
+			})
+
+			It("returns ErrAPIKeyNotFound for keys of other organizations", func() {
+				err := s.RevokeAPIKey(ctx, uuid.New(), key.ID, time.Now())
+				Expect(err).To(MatchError(model.ErrAPIKeyNotFound))
+			})
+		})
+
+		Describe("TouchAPIKey", func() {
+			It("records the last use", func() {
+				usedAt := time.Now().UTC()
+				Expect(s.TouchAPIKey(ctx, key.ID, usedAt)).To(Succeed())
+
+				stored, err := s.GetAPIKeyByHash(ctx, key.Hash)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored.LastUsedAt).To(PointTo(BeTemporally("~", usedAt, time.Second)))
+			})
+		})
+
+		It("deletes the keys together with the user who created them", func() {
+			Expect(s.DeleteUser(ctx, user.ID)).To(Succeed())
+
+			_, err := s.GetAPIKeyByHash(ctx, key.Hash)
+			Expect(err).To(MatchError(model.ErrAPIKeyNotFound))
+		})
+	})
+}
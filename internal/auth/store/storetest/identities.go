@@ -0,0 +1,104 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+func describeExternalIdentities(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("External identities", func() {
+		var (
+			s        Store
+			user     model.User
+			identity model.ExternalIdentity
+		)
+
+		BeforeEach(func() {
+			s = newStore()
+			user = model.User{
+				ID:       uuid.New(),
+				Username: "oidc-" + uuid.NewString()[:8],
+				Password: "testpassword",
+			}
+			Expect(s.AddUser(ctx, user)).To(Succeed())
+			identity = model.ExternalIdentity{
+				ID:        uuid.New(),
+				UserID:    user.ID,
+				Issuer:    "https://idp.example.com",
+				Subject:   uuid.NewString(),
+				CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+			}
+			Expect(s.CreateExternalIdentity(ctx, identity)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(s.DeleteUserByID(ctx, user.ID)).To(Succeed())
+		})
+
+		It("returns the user the subject of the issuer is linked to", func() {
+			linked, err := s.GetUserByExternalIdentity(ctx, identity.Issuer, identity.Subject)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(linked).ToNot(BeNil())
+			Expect(linked.ID).To(Equal(user.ID))
+		})
+
+		It("does not match the subject under another issuer", func() {
+			linked, err := s.GetUserByExternalIdentity(ctx, "https://other.example.com", identity.Subject)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(linked).To(BeNil())
+		})
+
+		It("lists the identities of the user", func() {
+			identities, err := s.ListExternalIdentities(ctx, user.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(identities).To(HaveLen(1))
+			Expect(identities[0].ID).To(Equal(identity.ID))
+			Expect(identities[0].Issuer).To(Equal(identity.Issuer))
+			Expect(identities[0].Subject).To(Equal(identity.Subject))
+			Expect(identities[0].CreatedAt).To(BeTemporally("~", identity.CreatedAt, time.Millisecond))
+		})
+
+		It("links a subject of an issuer to one user only", func() {
+			other := model.User{ID: uuid.New(), Username: "oidc-" + uuid.NewString()[:8], Password: "testpassword"}
+			Expect(s.AddUser(ctx, other)).To(Succeed())
+			DeferCleanup(s.DeleteUserByID, ctx, other.ID)
+
+			duplicate := identity
+			duplicate.ID = uuid.New()
+			duplicate.UserID = other.ID
+			Expect(s.CreateExternalIdentity(ctx, duplicate)).To(MatchError(model.ErrIdentityExists))
+		})
+
+		It("rejects identities of unknown users", func() {
+			identity.ID = uuid.New()
+			identity.UserID = uuid.New()
+			identity.Subject = uuid.NewString()
+			Expect(s.CreateExternalIdentity(ctx, identity)).To(MatchError(model.ErrUserNotFound))
+		})
+
+		It("deletes the identity of the user", func() {
+			Expect(s.DeleteExternalIdentity(ctx, uuid.New(), identity.ID)).To(MatchError(model.ErrIdentityNotFound))
+			Expect(s.DeleteExternalIdentity(ctx, user.ID, identity.ID)).To(Succeed())
+			Expect(s.DeleteExternalIdentity(ctx, user.ID, identity.ID)).To(MatchError(model.ErrIdentityNotFound))
+
+			linked, err := s.GetUserByExternalIdentity(ctx, identity.Issuer, identity.Subject)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(linked).To(BeNil())
+		})
+
+		It("deletes the identities together with the user", func() {
+			Expect(s.DeleteUserByID(ctx, user.ID)).To(Succeed())
+			linked, err := s.GetUserByExternalIdentity(ctx, identity.Issuer, identity.Subject)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(linked).To(BeNil())
+		})
+	})
+}
@@ -0,0 +1,123 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+func describeInvitations(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("Invitations", func() {
+		var (
+			s          Store
+			owner      model.User
+			invitee    model.User
+			org        model.Organization
+			invitation model.OrgInvitation
+		)
+
+		BeforeEach(func() {
+			s = newStore()
+			owner = model.User{ID: uuid.New(), Username: "owner-" + uuid.NewString()[:8], Password: "testpassword"}
+			invitee = model.User{ID: uuid.New(), Username: "invitee-" + uuid.NewString()[:8], Password: "testpassword"}
+			Expect(s.AddUser(ctx, owner)).To(Succeed())
+			Expect(s.AddUser(ctx, invitee)).To(Succeed())
+			org = model.Organization{ID: uuid.New(), Name: "org-" + uuid.NewString(), CreatedAt: time.Now().UTC()}
+			Expect(s.CreateOrg(ctx, org, owner.ID)).To(Succeed())
+
+			invitation = model.OrgInvitation{
+				OrgID:     org.ID,
+				UserID:    invitee.ID,
+				Role:      model.RoleEditor,
+				InvitedBy: owner.ID,
+				CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+			}
+		})
+
+		AfterEach(func() {
+			Expect(s.DeleteOrgByID(ctx, org.ID)).To(Succeed())
+			Expect(s.DeleteUserByID(ctx, invitee.ID)).To(Succeed())
+			Expect(s.DeleteUserByID(ctx, owner.ID)).To(Succeed())
+		})
+
+		It("returns ErrUserNotFound when inviting unknown users", func() {
+			invitation.UserID = uuid.New()
+			Expect(s.CreateOrgInvitation(ctx, invitation)).To(MatchError(model.ErrUserNotFound))
+		})
+
+		Context("with an invitation", func() {
+			BeforeEach(func() {
+				Expect(s.CreateOrgInvitation(ctx, invitation)).To(Succeed())
+			})
+
+			It("lists it with the name of the organization", func() {
+				invitations, err := s.ListUserInvitations(ctx, invitee.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(invitations).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"OrgID":     Equal(org.ID),
+					"OrgName":   Equal(org.Name),
+					"UserID":    Equal(invitee.ID),
+					"Role":      Equal(model.RoleEditor),
+					"InvitedBy": Equal(owner.ID),
+					"CreatedAt": BeTemporally("~", invitation.CreatedAt, time.Second),
+				})))
+			})
+
+			It("does not make the user a member", func() {
+				isMember, err := s.IsOrgMember(ctx, org.ID, invitee.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(isMember).To(BeFalse())
+			})
+
+			It("replaces the role when inviting again", func() {
+				invitation.Role = model.RoleAdmin
+				Expect(s.CreateOrgInvitation(ctx, invitation)).To(Succeed())
+
+				invitations, err := s.ListUserInvitations(ctx, invitee.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(invitations).To(ConsistOf(MatchFields(IgnoreExtras, Fields{"Role": Equal(model.RoleAdmin)})))
+			})
+
+			Describe("AcceptOrgInvitation", func() {
+				It("adds the member with the role of the invitation", func() {
+					Expect(s.AcceptOrgInvitation(ctx, org.ID, invitee.ID)).To(Succeed())
+
+					role, err := s.GetOrgMemberRole(ctx, org.ID, invitee.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(role).To(Equal(model.RoleEditor))
+
+					invitations, err := s.ListUserInvitations(ctx, invitee.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(invitations).To(BeEmpty())
+				})
+
+				It("returns ErrInvitationNotFound for users who were not invited", func() {
+					Expect(s.AcceptOrgInvitation(ctx, org.ID, owner.ID)).To(MatchError(model.ErrInvitationNotFound))
+				})
+			})
+
+			Describe("DeleteOrgInvitation", func() {
+				It("deletes the invitation without adding the member", func() {
+					Expect(s.DeleteOrgInvitation(ctx, org.ID, invitee.ID)).To(Succeed())
+
+					invitations, err := s.ListUserInvitations(ctx, invitee.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(invitations).To(BeEmpty())
+					Expect(s.AcceptOrgInvitation(ctx, org.ID, invitee.ID)).To(MatchError(model.ErrInvitationNotFound))
+				})
+
+				It("returns ErrInvitationNotFound for users who were not invited", func() {
+					Expect(s.DeleteOrgInvitation(ctx, org.ID, owner.ID)).To(MatchError(model.ErrInvitationNotFound))
+				})
+			})
+		})
+	})
+}
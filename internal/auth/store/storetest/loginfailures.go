@@ -0,0 +1,121 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+func describeLoginFailures(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("Login failures", func() {
+		var (
+			s   Store
+			key string
+			now time.Time
+		)
+
+		BeforeEach(func() {
+			s = newStore()
+			key = "user:" + uuid.NewString()
+			now = time.Now().UTC().Truncate(time.Millisecond)
+		})
+
+		AfterEach(func() {
+			Expect(s.ClearLoginFailures(ctx, key)).To(Succeed())
+		})
+
+		It("counts the failures of the key", func() {
+			_, err := s.RecordLoginFailure(ctx, key, now.Add(-time.Second), now.Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			recorded, err := s.RecordLoginFailure(ctx, key, now, now.Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recorded.Count).To(Equal(2))
+			Expect(recorded.LastFailedAt).To(BeTemporally("~", now, time.Millisecond))
+
+			failures, err := s.GetLoginFailures(ctx, key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failures.Key).To(Equal(key))
+			Expect(failures.Count).To(Equal(2))
+			Expect(failures.LastFailedAt).To(BeTemporally("~", now, time.Millisecond))
+		})
+
+		It("returns no failures for unknown keys", func() {
+			failures, err := s.GetLoginFailures(ctx, key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failures).To(Equal(model.LoginFailures{Key: key}))
+		})
+
+		It("forgets failures recorded before the given time", func() {
+			otherKey := "ip:" + uuid.NewString()
+			DeferCleanup(s.ClearLoginFailures, ctx, otherKey)
+			_, err := s.RecordLoginFailure(ctx, otherKey, now.Add(-2*time.Hour), now.Add(-3*time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = s.RecordLoginFailure(ctx, key, now.Add(-2*time.Hour), now.Add(-3*time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+
+			recorded, err := s.RecordLoginFailure(ctx, key, now, now.Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recorded.Count).To(Equal(1))
+
+			failures, err := s.GetLoginFailures(ctx, otherKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failures.Count).To(BeZero())
+		})
+
+		It("takes back a failure", func() {
+			_, err := s.RecordLoginFailure(ctx, key, now.Add(-time.Minute), now.Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			recorded, err := s.RecordLoginFailure(ctx, key, now, now.Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(s.ReleaseLoginFailure(ctx, key, recorded.Count, now.Add(-time.Minute))).To(Succeed())
+
+			failures, err := s.GetLoginFailures(ctx, key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failures.Count).To(Equal(1))
+			Expect(failures.LastFailedAt).To(BeTemporally("~", now.Add(-time.Minute), time.Millisecond))
+		})
+
+		It("keeps the time of failures recorded after the one taken back", func() {
+			recorded, err := s.RecordLoginFailure(ctx, key, now.Add(-time.Minute), now.Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = s.RecordLoginFailure(ctx, key, now, now.Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(s.ReleaseLoginFailure(ctx, key, recorded.Count, time.Time{})).To(Succeed())
+
+			failures, err := s.GetLoginFailures(ctx, key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failures.Count).To(Equal(1))
+			Expect(failures.LastFailedAt).To(BeTemporally("~", now, time.Millisecond))
+		})
+
+		It("takes back the only failure of the key", func() {
+			recorded, err := s.RecordLoginFailure(ctx, key, now, now.Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.ReleaseLoginFailure(ctx, key, recorded.Count, time.Time{})).To(Succeed())
+			Expect(s.ReleaseLoginFailure(ctx, key, recorded.Count, time.Time{})).To(Succeed())
+
+			failures, err := s.GetLoginFailures(ctx, key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failures.Count).To(BeZero())
+		})
+
+		It("clears the failures of the key", func() {
+			_, err := s.RecordLoginFailure(ctx, key, now, now.Add(-time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.ClearLoginFailures(ctx, key)).To(Succeed())
+
+			failures, err := s.GetLoginFailures(ctx, key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failures.Count).To(BeZero())
+		})
+	})
+}
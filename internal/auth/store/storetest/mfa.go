@@ -0,0 +1,191 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+func describeMFA(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("MFA", func() {
+		var (
+			s    Store
+			user model.User
+			mfa  model.UserMFA
+		)
+
+		BeforeEach(func() {
+			s = newStore()
+			user = model.User{
+				ID:       uuid.New(),
+				Username: "mfa-" + uuid.NewString()[:8],
+				Password: "testpassword",
+			}
+			Expect(s.AddUser(ctx, user)).To(Succeed())
+			mfa = model.UserMFA{
+				UserID:    user.ID,
+				Secret:    "JBSWY3DPEHPK3PXP",
+				CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+			}
+			Expect(s.SaveUserMFA(ctx, mfa)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(s.DeleteUserByID(ctx, user.ID)).To(Succeed())
+		})
+
+		It("returns the saved MFA", func() {
+			stored, err := s.GetUserMFA(ctx, user.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored.UserID).To(Equal(user.ID))
+			Expect(stored.Secret).To(Equal(mfa.Secret))
+			Expect(stored.EnabledAt).To(BeNil())
+			Expect(stored.LastUsedStep).To(BeZero())
+			Expect(stored.CreatedAt).To(BeTemporally("~", mfa.CreatedAt, time.Millisecond))
+		})
+
+		It("replaces the MFA set up before", func() {
+			mfa.Secret = "KRSXG5CTMVRXEZLU"
+			Expect(s.SaveUserMFA(ctx, mfa)).To(Succeed())
+
+			stored, err := s.GetUserMFA(ctx, user.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored.Secret).To(Equal("KRSXG5CTMVRXEZLU"))
+		})
+
+		It("rejects the MFA of unknown users", func() {
+			mfa.UserID = uuid.New()
+			Expect(s.SaveUserMFA(ctx, mfa)).To(MatchError(model.ErrUserNotFound))
+		})
+
+		It("enables the MFA", func() {
+			enabledAt := time.Now().UTC().Truncate(time.Millisecond)
+			Expect(s.EnableUserMFA(ctx, user.ID, enabledAt)).To(Succeed())
+
+			stored, err := s.GetUserMFA(ctx, user.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored.EnabledAt).ToNot(BeNil())
+			Expect(*stored.EnabledAt).To(BeTemporally("~", enabledAt, time.Millisecond))
+		})
+
+		It("accepts every time step once", func() {
+			used, err := s.UseMFAStep(ctx, user.ID, 100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(used).To(BeTrue())
+
+			used, err = s.UseMFAStep(ctx, user.ID, 100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(used).To(BeFalse())
+
+			used, err = s.UseMFAStep(ctx, user.ID, 99)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(used).To(BeFalse())
+
+			stored, err := s.GetUserMFA(ctx, user.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored.LastUsedStep).To(BeEquivalentTo(100))
+		})
+
+		It("uses every recovery code once", func() {
+			Expect(s.ReplaceRecoveryCodes(ctx, user.ID, []string{"hash-1", "hash-2"})).To(Succeed())
+
+			used, err := s.UseRecoveryCode(ctx, user.ID, "hash-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(used).To(BeTrue())
+			used, err = s.UseRecoveryCode(ctx, user.ID, "hash-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(used).To(BeFalse())
+
+			count, err := s.CountRecoveryCodes(ctx, user.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+
+		It("replaces the recovery codes", func() {
+			Expect(s.ReplaceRecoveryCodes(ctx, user.ID, []string{"hash-1", "hash-2"})).To(Succeed())
+			Expect(s.ReplaceRecoveryCodes(ctx, user.ID, []string{"hash-3"})).To(Succeed())
+
+			used, err := s.UseRecoveryCode(ctx, user.ID, "hash-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(used).To(BeFalse())
+			count, err := s.CountRecoveryCodes(ctx, user.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+
+		It("deletes the MFA together with the recovery codes", func() {
+			Expect(s.ReplaceRecoveryCodes(ctx, user.ID, []string{"hash-1"})).To(Succeed())
+			Expect(s.DeleteUserMFA(ctx, user.ID)).To(Succeed())
+
+			_, err := s.GetUserMFA(ctx, user.ID)
+			Expect(err).To(MatchError(model.ErrMFANotFound))
+			count, err := s.CountRecoveryCodes(ctx, user.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+
+			Expect(s.DeleteUserMFA(ctx, user.ID)).To(MatchError(model.ErrMFANotFound))
+			Expect(s.EnableUserMFA(ctx, user.ID, time.Now())).To(MatchError(model.ErrMFANotFound))
+		})
+
+		It("deletes the MFA of deleted users", func() {
+			Expect(s.DeleteUser(ctx, user.ID)).To(Succeed())
+			Expect(s.AddUser(ctx, user)).To(Succeed())
+
+			_, err := s.GetUserMFA(ctx, user.ID)
+			Expect(err).To(MatchError(model.ErrMFANotFound))
+		})
+
+		Describe("MFA policy", func() {
+			var org, otherOrg model.Organization
+
+			BeforeEach(func() {
+				org = model.Organization{ID: uuid.New(), Name: "org-" + uuid.NewString(), CreatedAt: time.Now().UTC()}
+				Expect(s.CreateOrg(ctx, org, user.ID)).To(Succeed())
+				otherOrg = model.Organization{ID: uuid.New(), Name: "org-" + uuid.NewString(), CreatedAt: time.Now().UTC()}
+				Expect(s.CreateOrg(ctx, otherOrg, user.ID)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(s.DeleteOrgByID(ctx, org.ID)).To(Succeed())
+				Expect(s.DeleteOrgByID(ctx, otherOrg.ID)).To(Succeed())
+			})
+
+			It("requires MFA for no role by default", func() {
+				policy, err := s.GetMFAPolicy(ctx, org.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(policy.RequiredRoles).To(BeEmpty())
+			})
+
+			It("replaces the roles that require MFA", func() {
+				Expect(s.SaveMFAPolicy(ctx, org.ID, model.MFAPolicy{RequiredRoles: []model.RoleType{model.RoleAdmin}})).To(Succeed())
+				Expect(s.SaveMFAPolicy(ctx, org.ID, model.MFAPolicy{
+					RequiredRoles: []model.RoleType{model.RoleEditor, model.RoleAdmin, model.RoleEditor},
+				})).To(Succeed())
+
+				policy, err := s.GetMFAPolicy(ctx, org.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(policy.RequiredRoles).To(Equal([]model.RoleType{model.RoleAdmin, model.RoleEditor}))
+			})
+
+			It("keeps the policies of the organizations apart", func() {
+				Expect(s.SaveMFAPolicy(ctx, org.ID, model.MFAPolicy{RequiredRoles: []model.RoleType{model.RoleAdmin}})).To(Succeed())
+				Expect(s.SaveMFAPolicy(ctx, otherOrg.ID, model.MFAPolicy{RequiredRoles: []model.RoleType{model.RoleViewer}})).To(Succeed())
+				Expect(s.SaveMFAPolicy(ctx, otherOrg.ID, model.MFAPolicy{})).To(Succeed())
+
+				policy, err := s.GetMFAPolicy(ctx, org.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(policy.RequiredRoles).To(Equal([]model.RoleType{model.RoleAdmin}))
+				policy, err = s.GetMFAPolicy(ctx, otherOrg.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(policy.RequiredRoles).To(BeEmpty())
+			})
+		})
+	})
+}
@@ -0,0 +1,121 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+func describeOAuthClients(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("OAuth clients", func() {
+		var (
+			s      Store
+			user   model.User
+			org    model.Organization
+			client model.OAuthClient
+		)
+
+		newClient := func(name string) model.OAuthClient {
+			return model.OAuthClient{
+				ID:         uuid.New(),
+				OrgID:      org.ID,
+				Name:       name,
+				SecretHash: uuid.NewString(),
+				Scopes:     []string{"read:flags", "write:flags"},
+				CreatedBy:  user.ID,
+				CreatedAt:  time.Now().UTC().Truncate(time.Millisecond),
+			}
+		}
+
+		BeforeEach(func() {
+			s = newStore()
+			user = model.User{
+				ID:       uuid.New(),
+				Username: "oauth-" + uuid.NewString()[:8],
+				Password: "testpassword",
+			}
+			Expect(s.AddUser(ctx, user)).To(Succeed())
+			org = model.Organization{ID: uuid.New(), Name: "org-" + uuid.NewString(), CreatedAt: time.Now().UTC()}
+			Expect(s.CreateOrg(ctx, org, user.ID)).To(Succeed())
+
+			client = newClient("deployer")
+			Expect(s.CreateOAuthClient(ctx, client)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(s.DeleteOrgByID(ctx, org.ID)).To(Succeed())
+			Expect(s.DeleteUserByID(ctx, user.ID)).To(Succeed())
+		})
+
+		Describe("GetOAuthClient", func() {
+			It("returns the stored client", func() {
+				stored, err := s.GetOAuthClient(ctx, client.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored).To(MatchAllFields(Fields{
+					"ID":         Equal(client.ID),
+					"OrgID":      Equal(org.ID),
+					"Name":       Equal("deployer"),
+					"SecretHash": Equal(client.SecretHash),
+					"Scopes":     Equal([]string{"read:flags", "write:flags"}),
+					"CreatedBy":  Equal(user.ID),
+					"CreatedAt":  BeTemporally("~", client.CreatedAt, time.Second),
+				}))
+			})
+
+			It("returns ErrOAuthClientNotFound for unknown clients", func() {
+				_, err := s.GetOAuthClient(ctx, uuid.New())
+				Expect(err).To(MatchError(model.ErrOAuthClientNotFound))
+			})
+		})
+
+		Describe("ListOAuthClients", func() {
+			It("returns the clients of the organization, oldest first", func() {
+				later := newClient("ci")
+				later.CreatedAt = client.CreatedAt.Add(time.Second)
+				Expect(s.CreateOAuthClient(ctx, later)).To(Succeed())
+
+				clients, err := s.ListOAuthClients(ctx, org.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(clients).To(HaveExactElements(
+					MatchFields(IgnoreExtras, Fields{"ID": Equal(client.ID)}),
+					MatchFields(IgnoreExtras, Fields{"ID": Equal(later.ID)}),
+				))
+			})
+
+			It("leaves out the clients of other organizations", func() {
+				clients, err := s.ListOAuthClients(ctx, uuid.New())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(clients).To(BeEmpty())
+			})
+		})
+
+		Describe("DeleteOAuthClient", func() {
+			It("deletes the client", func() {
+				Expect(s.DeleteOAuthClient(ctx, org.ID, client.ID)).To(Succeed())
+
+				_, err := s.GetOAuthClient(ctx, client.ID)
+				Expect(err).To(MatchError(model.ErrOAuthClientNotFound))
+			})
+
+			It("returns ErrOAuthClientNotFound for clients of other organizations", func() {
+				err := s.DeleteOAuthClient(ctx, uuid.New(), client.ID)
+				Expect(err).To(MatchError(model.ErrOAuthClientNotFound))
+			})
+		})
+
+		It("deletes the clients together with the user who registered them", func() {
+			Expect(s.DeleteUser(ctx, user.ID)).To(Succeed())
+
+			_, err := s.GetOAuthClient(ctx, client.ID)
+			Expect(err).To(MatchError(model.ErrOAuthClientNotFound))
+		})
+	})
+}
@@ -0,0 +1,271 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+func describeOrgs(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("Organizations", func() {
+		var (
+			s         Store
+			owner     model.User
+			member    model.User
+			org       model.Organization
+			errAction error
+		)
+
+		newUser := func(username string) model.User {
+			return model.User{
+				ID:       uuid.New(),
+				Username: username + "-" + uuid.NewString()[:8],
+				Password: "testpassword",
+			}
+		}
+
+		BeforeEach(func() {
+			s = newStore()
+			owner = newUser("owner")
+			member = newUser("member")
+			Expect(s.AddUser(ctx, owner)).To(Succeed())
+			Expect(s.AddUser(ctx, member)).To(Succeed())
+
+			org = model.Organization{
+				ID:        uuid.New(),
+				Name:      "org-" + uuid.NewString(),
+				CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+			}
+		})
+
+		AfterEach(func() {
+			Expect(s.DeleteOrgByID(ctx, org.ID)).To(Succeed())
+			Expect(s.DeleteUserByID(ctx, owner.ID)).To(Succeed())
+			Expect(s.DeleteUserByID(ctx, member.ID)).To(Succeed())
+		})
+
+		Describe("CreateOrg", func() {
+			JustBeforeEach(func() {
+				errAction = s.CreateOrg(ctx, org, owner.ID)
+			})
+
+			It("succeeds", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+			})
+
+			It("stores the organization", func() {
+				stored, err := s.GetOrgByID(ctx, org.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored).To(MatchFields(IgnoreExtras, Fields{
+					"ID":        Equal(org.ID),
+					"Name":      Equal(org.Name),
+					"CreatedAt": BeTemporally("~", org.CreatedAt, time.Second),
+				}))
+			})
+
+			It("makes the owner an admin", func() {
+				role, err := s.GetOrgMemberRole(ctx, org.ID, owner.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(role).To(Equal(model.RoleAdmin))
+			})
+
+			Context("when the name is taken", func() {
+				var taken model.Organization
+
+				BeforeEach(func() {
+					taken = model.Organization{ID: uuid.New(), Name: org.Name, CreatedAt: time.Now().UTC()}
+					Expect(s.CreateOrg(ctx, taken, member.ID)).To(Succeed())
+				})
+
+				AfterEach(func() {
+					Expect(s.DeleteOrgByID(ctx, taken.ID)).To(Succeed())
+				})
+
+				It("returns ErrOrgExists", func() {
+					Expect(errAction).To(MatchError(model.ErrOrgExists))
+				})
+			})
+		})
+
+		Describe("GetOrgByID", func() {
+			It("returns ErrOrgNotFound for unknown organizations", func() {
+				_, err := s.GetOrgByID(ctx, uuid.New())
+				Expect(err).To(MatchError(model.ErrOrgNotFound))
+			})
+		})
+
+		Context("with an organization", func() {
+			BeforeEach(func() {
+				Expect(s.CreateOrg(ctx, org, owner.ID)).To(Succeed())
+			})
+
+			Describe("AddOrgMember", func() {
+				var userID uuid.UUID
+
+				BeforeEach(func() {
+					userID = member.ID
+				})
+
+				JustBeforeEach(func() {
+					errAction = s.AddOrgMember(ctx, org.ID, userID, model.RoleViewer)
+				})
+
+				It("adds the user to the organization with the role", func() {
+					Expect(errAction).ToNot(HaveOccurred())
+					role, err := s.GetOrgMemberRole(ctx, org.ID, member.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(role).To(Equal(model.RoleViewer))
+				})
+
+				It("keeps the role of existing members", func() {
+					Expect(s.AddOrgMember(ctx, org.ID, member.ID, model.RoleAdmin)).To(Succeed())
+					members, err := s.ListOrgMembers(ctx, org.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(members).To(HaveLen(2))
+					Expect(members).To(ContainElement(model.OrganizationMember{
+						UserID: member.ID, Username: member.Username, Role: model.RoleViewer,
+					}))
+				})
+
+				Context("when the user does not exist", func() {
+					BeforeEach(func() {
+						userID = uuid.New()
+					})
+
+					It("returns ErrUserNotFound", func() {
+						Expect(errAction).To(MatchError(model.ErrUserNotFound))
+					})
+				})
+			})
+
+			Describe("ListOrgMembers", func() {
+				BeforeEach(func() {
+					Expect(s.AddOrgMember(ctx, org.ID, member.ID, model.RoleEditor)).To(Succeed())
+				})
+
+				It("lists the members with their roles ordered by username", func() {
+					members, err := s.ListOrgMembers(ctx, org.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(members).To(Equal([]model.OrganizationMember{
+						{UserID: member.ID, Username: member.Username, Role: model.RoleEditor},
+						{UserID: owner.ID, Username: owner.Username, Role: model.RoleAdmin},
+					}))
+				})
+			})
+
+			Describe("UpdateOrgMemberRole", func() {
+				var other model.Organization
+
+				BeforeEach(func() {
+					other = model.Organization{ID: uuid.New(), Name: "other-" + uuid.NewString(), CreatedAt: time.Now().UTC()}
+					Expect(s.CreateOrg(ctx, other, owner.ID)).To(Succeed())
+					Expect(s.AddOrgMember(ctx, org.ID, member.ID, model.RoleViewer)).To(Succeed())
+					Expect(s.AddOrgMember(ctx, other.ID, member.ID, model.RoleViewer)).To(Succeed())
+				})
+
+				AfterEach(func() {
+					Expect(s.DeleteOrgByID(ctx, other.ID)).To(Succeed())
+				})
+
+				It("changes the role only in the organization", func() {
+					Expect(s.UpdateOrgMemberRole(ctx, org.ID, member.ID, model.RoleAdmin)).To(Succeed())
+
+					role, err := s.GetOrgMemberRole(ctx, org.ID, member.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(role).To(Equal(model.RoleAdmin))
+					role, err = s.GetOrgMemberRole(ctx, other.ID, member.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(role).To(Equal(model.RoleViewer))
+				})
+
+				It("returns ErrMemberNotFound for users outside the organization", func() {
+					Expect(s.UpdateOrgMemberRole(ctx, org.ID, uuid.New(), model.RoleAdmin)).To(MatchError(model.ErrMemberNotFound))
+				})
+			})
+
+			Describe("ListUserOrgs", func() {
+				var other model.Organization
+
+				BeforeEach(func() {
+					other = model.Organization{
+						ID:        uuid.New(),
+						Name:      "other-" + uuid.NewString(),
+						CreatedAt: org.CreatedAt.Add(time.Hour),
+					}
+					Expect(s.CreateOrg(ctx, other, member.ID)).To(Succeed())
+				})
+
+				AfterEach(func() {
+					Expect(s.DeleteOrgByID(ctx, other.ID)).To(Succeed())
+				})
+
+				It("returns only the organizations of the user", func() {
+					orgs, err := s.ListUserOrgs(ctx, owner.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(orgs).To(HaveLen(1))
+					Expect(orgs[0].ID).To(Equal(org.ID))
+				})
+
+				It("orders them by creation time", func() {
+					Expect(s.AddOrgMember(ctx, org.ID, member.ID, model.RoleEditor)).To(Succeed())
+					orgs, err := s.ListUserOrgs(ctx, member.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(orgs).To(HaveLen(2))
+					Expect(orgs[0].ID).To(Equal(org.ID))
+					Expect(orgs[1].ID).To(Equal(other.ID))
+				})
+			})
+
+			Describe("IsOrgMember", func() {
+				It("returns false for users outside the organization", func() {
+					isMember, err := s.IsOrgMember(ctx, org.ID, member.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(isMember).To(BeFalse())
+				})
+			})
+
+			Describe("GetOrgMemberRole", func() {
+				It("returns ErrMemberNotFound for users outside the organization", func() {
+					_, err := s.GetOrgMemberRole(ctx, org.ID, member.ID)
+					Expect(err).To(MatchError(model.ErrMemberNotFound))
+				})
+			})
+
+			Describe("RemoveOrgMember", func() {
+				BeforeEach(func() {
+					Expect(s.AddOrgMember(ctx, org.ID, member.ID, model.RoleEditor)).To(Succeed())
+				})
+
+				It("removes the membership", func() {
+					Expect(s.RemoveOrgMember(ctx, org.ID, member.ID)).To(Succeed())
+					isMember, err := s.IsOrgMember(ctx, org.ID, member.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(isMember).To(BeFalse())
+				})
+
+				It("returns ErrMemberNotFound for users outside the organization", func() {
+					Expect(s.RemoveOrgMember(ctx, org.ID, uuid.New())).To(MatchError(model.ErrMemberNotFound))
+				})
+			})
+
+			Describe("DeleteUserByID", func() {
+				It("removes the memberships of the user", func() {
+					Expect(s.AddOrgMember(ctx, org.ID, member.ID, model.RoleEditor)).To(Succeed())
+					Expect(s.DeleteUserByID(ctx, member.ID)).To(Succeed())
+
+					members, err := s.ListOrgMembers(ctx, org.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(members).To(HaveLen(1))
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,233 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+func describeRefreshTokens(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("Refresh tokens", func() {
+		var (
+			s         Store
+			user      model.User
+			org       model.Organization
+			token     model.RefreshToken
+			errAction error
+		)
+
+		newToken := func(familyID uuid.UUID) model.RefreshToken {
+			now := time.Now().UTC().Truncate(time.Millisecond)
+			return model.RefreshToken{
+				Hash:      uuid.NewString(),
+				FamilyID:  familyID,
+				UserID:    user.ID,
+				OrgID:     org.ID,
+				ExpiresAt: now.Add(time.Hour),
+				CreatedAt: now,
+			}
+		}
+
+		BeforeEach(func() {
+			s = newStore()
+			user = model.User{
+				ID:       uuid.New(),
+				Username: "refresh-" + uuid.NewString()[:8],
+				Password: "testpassword",
+			}
+			Expect(s.AddUser(ctx, user)).To(Succeed())
+			org = model.Organization{ID: uuid.New(), Name: "org-" + uuid.NewString(), CreatedAt: time.Now().UTC()}
+			Expect(s.CreateOrg(ctx, org, user.ID)).To(Succeed())
+
+			token = newToken(uuid.New())
+			Expect(s.CreateRefreshToken(ctx, token)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(s.DeleteOrgByID(ctx, org.ID)).To(Succeed())
+			Expect(s.DeleteUserByID(ctx, user.ID)).To(Succeed())
+		})
+
+		Describe("GetRefreshToken", func() {
+			It("returns the stored token", func() {
+				stored, err := s.GetRefreshToken(ctx, token.Hash)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored).To(MatchFields(IgnoreExtras, Fields{
+					"Hash":      Equal(token.Hash),
+					"FamilyID":  Equal(token.FamilyID),
+					"UserID":    Equal(user.ID),
+					"OrgID":     Equal(org.ID),
+					"ExpiresAt": BeTemporally("~", token.ExpiresAt, time.Second),
+					"CreatedAt": BeTemporally("~", token.CreatedAt, time.Second),
+					"UsedAt":    BeNil(),
+					"RevokedAt": BeNil(),
+				}))
+			})
+
+			It("returns ErrRefreshTokenNotFound for unknown tokens", func() {
+				_, err := s.GetRefreshToken(ctx, uuid.NewString())
+				Expect(err).To(MatchError(model.ErrRefreshTokenNotFound))
+			})
+		})
+
+		Describe("RotateRefreshToken", func() {
+			var next model.RefreshToken
+
+			BeforeEach(func() {
+				next = newToken(token.FamilyID)
+			})
+
+			JustBeforeEach(func() {
+				errAction = s.RotateRefreshToken(ctx, token.Hash, next)
+			})
+
+			It("marks the token as used and stores its successor", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+
+				used, err := s.GetRefreshToken(ctx, token.Hash)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(used.UsedAt).To(PointTo(BeTemporally("~", next.CreatedAt, time.Second)))
+
+				stored, err := s.GetRefreshToken(ctx, next.Hash)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored.FamilyID).To(Equal(token.FamilyID))
+				Expect(stored.UsedAt).To(BeNil())
+			})
+
+			It("returns ErrRefreshTokenUsed when the token is rotated again", func() {
+				Expect(s.RotateRefreshToken(ctx, token.Hash, newToken(token.FamilyID))).
+					To(MatchError(model.ErrRefreshTokenUsed))
+			})
+
+			Context("when the family was revoked", func() {
+				BeforeEach(func() {
+					Expect(s.RevokeRefreshTokenFamily(ctx, token.FamilyID, time.Now())).To(Succeed())
+				})
+
+				It("returns ErrRefreshTokenUsed without storing the successor", func() {
+					Expect(errAction).To(MatchError(model.ErrRefreshTokenUsed))
+					_, err := s.GetRefreshToken(ctx, next.Hash)
+					Expect(err).To(MatchError(model.ErrRefreshTokenNotFound))
+				})
+			})
+		})
+
+		Describe("RevokeRefreshTokenFamily", func() {
+			var (
+				sibling model.RefreshToken
+				other   model.RefreshToken
+			)
+
+			BeforeEach(func() {
+				sibling = newToken(token.FamilyID)
+				Expect(s.RotateRefreshToken(ctx, token.Hash, sibling)).To(Succeed())
+				other = newToken(uuid.New())
+				Expect(s.CreateRefreshToken(ctx, other)).To(Succeed())
+			})
+
+			JustBeforeEach(func() {
+				errAction = s.RevokeRefreshTokenFamily(ctx, token.FamilyID, time.Now())
+			})
+
+			It("revokes every token of the family", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				for _, hash := range []string{token.Hash, sibling.Hash} {
+					stored, err := s.GetRefreshToken(ctx, hash)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(stored.RevokedAt).ToNot(BeNil())
+				}
+			})
+
+			It("leaves other families alone", func() {
+				stored, err := s.GetRefreshToken(ctx, other.Hash)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored.RevokedAt).To(BeNil())
+			})
+		})
+
+		Describe("RevokeRefreshTokensIssuedBefore", func() {
+			var (
+				old     model.RefreshToken
+				rotated model.RefreshToken
+				other   model.User
+				foreign model.RefreshToken
+				cutoff  time.Time
+				userID  uuid.UUID
+			)
+
+			BeforeEach(func() {
+				old = token
+				rotated = newToken(token.FamilyID)
+				rotated.CreatedAt = old.CreatedAt.Add(time.Hour)
+				Expect(s.RotateRefreshToken(ctx, old.Hash, rotated)).To(Succeed())
+
+				other = model.User{
+					ID:       uuid.New(),
+					Username: "refresh-" + uuid.NewString()[:8],
+					Password: "testpassword",
+				}
+				Expect(s.AddUser(ctx, other)).To(Succeed())
+				Expect(s.AddOrgMember(ctx, org.ID, other.ID, model.RoleEditor)).To(Succeed())
+				foreign = newToken(uuid.New())
+				foreign.UserID = other.ID
+				Expect(s.CreateRefreshToken(ctx, foreign)).To(Succeed())
+
+				cutoff = old.CreatedAt.Add(time.Minute)
+				userID = user.ID
+			})
+
+			AfterEach(func() {
+				Expect(s.DeleteUserByID(ctx, other.ID)).To(Succeed())
+			})
+
+			JustBeforeEach(func() {
+				errAction = s.RevokeRefreshTokensIssuedBefore(ctx, org.ID, userID, cutoff, time.Now())
+			})
+
+			It("revokes the whole family of logins before the cutoff", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				stored, err := s.GetRefreshToken(ctx, rotated.Hash)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored.RevokedAt).ToNot(BeNil())
+			})
+
+			It("leaves the tokens of other users alone", func() {
+				stored, err := s.GetRefreshToken(ctx, foreign.Hash)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored.RevokedAt).To(BeNil())
+			})
+
+			Context("without a user", func() {
+				BeforeEach(func() {
+					userID = uuid.Nil
+				})
+
+				It("revokes the tokens of every user of the organization", func() {
+					stored, err := s.GetRefreshToken(ctx, foreign.Hash)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(stored.RevokedAt).ToNot(BeNil())
+				})
+			})
+
+			Context("when the logins happened after the cutoff", func() {
+				BeforeEach(func() {
+					cutoff = old.CreatedAt.Add(-time.Minute)
+				})
+
+				It("revokes nothing", func() {
+					stored, err := s.GetRefreshToken(ctx, rotated.Hash)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(stored.RevokedAt).To(BeNil())
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,257 @@
+// Package storetest holds the specs every auth store backend has to pass.
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+// Store is an auth backend plus the helpers for adding and removing test users and organizations.
+type Store interface {
+	GetByUsername(ctx context.Context, username string) (*model.User, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*model.User, error)
+	UserExists(ctx context.Context, id uuid.UUID) (bool, error)
+
+	ListUsers(ctx context.Context, orgID uuid.UUID) ([]model.User, error)
+	CreateUser(ctx context.Context, user model.User, orgID uuid.UUID) error
+	SetUserDisabled(ctx context.Context, id uuid.UUID, disabled bool) error
+	UpdateUserPassword(ctx context.Context, id uuid.UUID, password string) error
+	DeleteUser(ctx context.Context, id uuid.UUID) error
+
+	ListUserOrgs(ctx context.Context, userID uuid.UUID) ([]model.Organization, error)
+	IsOrgMember(ctx context.Context, orgID, userID uuid.UUID) (bool, error)
+	GetOrgMemberRole(ctx context.Context, orgID, userID uuid.UUID) (model.RoleType, error)
+	CreateOrg(ctx context.Context, org model.Organization, ownerID uuid.UUID) error
+	GetOrgByID(ctx context.Context, id uuid.UUID) (model.Organization, error)
+	ListOrgMembers(ctx context.Context, orgID uuid.UUID) ([]model.OrganizationMember, error)
+	AddOrgMember(ctx context.Context, orgID, userID uuid.UUID, role model.RoleType) error
+	UpdateOrgMemberRole(ctx context.Context, orgID, userID uuid.UUID, role model.RoleType) error
+	RemoveOrgMember(ctx context.Context, orgID, userID uuid.UUID) error
+
+	CreateOrgInvitation(ctx context.Context, invitation model.OrgInvitation) error
+	ListUserInvitations(ctx context.Context, userID uuid.UUID) ([]model.OrgInvitation, error)
+	AcceptOrgInvitation(ctx context.Context, orgID, userID uuid.UUID) error
+	DeleteOrgInvitation(ctx context.Context, orgID, userID uuid.UUID) error
+
+	CreateRefreshToken(ctx context.Context, token model.RefreshToken) error
+	GetRefreshToken(ctx context.Context, hash string) (model.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, hash string, next model.RefreshToken) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID, revokedAt time.Time) error
+	RevokeRefreshTokensIssuedBefore(ctx context.Context, orgID, userID uuid.UUID, issuedBefore, revokedAt time.Time) error
+
+	CreateTokenRevocation(ctx context.Context, revocation model.TokenRevocation) error
+	ListTokenRevocations(ctx context.Context, now time.Time) ([]model.TokenRevocation, error)
+	DeleteExpiredTokenRevocations(ctx context.Context, now time.Time) error
+
+	CreateAPIKey(ctx context.Context, key model.APIKey) error
+	ListAPIKeys(ctx context.Context, orgID uuid.UUID) ([]model.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, hash string) (model.APIKey, error)
+	RevokeAPIKey(ctx context.Context, orgID, id uuid.UUID, revokedAt time.Time) error
+	TouchAPIKey(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+
+	CreateOAuthClient(ctx context.Context, client model.OAuthClient) error
+	ListOAuthClients(ctx context.Context, orgID uuid.UUID) ([]model.OAuthClient, error)
+	GetOAuthClient(ctx context.Context, id uuid.UUID) (model.OAuthClient, error)
+	DeleteOAuthClient(ctx context.Context, orgID, id uuid.UUID) error
+
+	GetLoginFailures(ctx context.Context, key string) (model.LoginFailures, error)
+	RecordLoginFailure(ctx context.Context, key string, failedAt, forgetBefore time.Time) (model.LoginFailures, error)
+	ReleaseLoginFailure(ctx context.Context, key string, count int, lastFailedAt time.Time) error
+	ClearLoginFailures(ctx context.Context, key string) error
+
+	GetUserMFA(ctx context.Context, userID uuid.UUID) (model.UserMFA, error)
+	SaveUserMFA(ctx context.Context, mfa model.UserMFA) error
+	EnableUserMFA(ctx context.Context, userID uuid.UUID, enabledAt time.Time) error
+	UseMFAStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	DeleteUserMFA(ctx context.Context, userID uuid.UUID) error
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, hashes []string) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string) (bool, error)
+	CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error)
+	GetMFAPolicy(ctx context.Context, orgID uuid.UUID) (model.MFAPolicy, error)
+	SaveMFAPolicy(ctx context.Context, orgID uuid.UUID, policy model.MFAPolicy) error
+
+	CreateExternalIdentity(ctx context.Context, identity model.ExternalIdentity) error
+	ListExternalIdentities(ctx context.Context, userID uuid.UUID) ([]model.ExternalIdentity, error)
+	DeleteExternalIdentity(ctx context.Context, userID, id uuid.UUID) error
+	GetUserByExternalIdentity(ctx context.Context, issuer, subject string) (*model.User, error)
+
+	AddUser(ctx context.Context, user model.User) error
+	DeleteUserByID(ctx context.Context, userID uuid.UUID) error
+	DeleteOrgByID(ctx context.Context, orgID uuid.UUID) error
+}
+
+// DescribeStore registers the auth store specs, newStore is called before each of them.
+func DescribeStore(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("Store", func() {
+		var (
+			s         Store
+			userID    uuid.UUID
+			testUser  model.User
+			errAction error
+		)
+
+		ItSucceeds := func() {
+			It("succeeds", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+			})
+		}
+
+		BeforeEach(func() {
+			s = newStore()
+			userID = uuid.New()
+			testUser = model.User{
+				ID:       userID,
+				Username: "testuser",
+				Password: "testpassword",
+			}
+		})
+
+		Describe("GetByUsername", func() {
+			var (
+				result *model.User
+			)
+
+			BeforeEach(func() {
+				err := s.AddUser(ctx, testUser)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				err := s.DeleteUserByID(ctx, testUser.ID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			JustBeforeEach(func() {
+				result, errAction = s.GetByUsername(ctx, testUser.Username)
+			})
+
+			ItSucceeds()
+			It("retrieves the correct user", func() {
+				Expect(*result).To(MatchFields(IgnoreExtras, Fields{
+					"ID":       Equal(testUser.ID),
+					"Username": Equal(testUser.Username),
+					"Password": Equal(testUser.Password),
+					"Role":     BeEmpty(),
+				}))
+			})
+
+			Context("when the user does not exist", func() {
+				BeforeEach(func() {
+					testUser.Username = "nonexistentuser"
+				})
+
+				ItSucceeds()
+				It("returns nil", func() {
+					Expect(result).To(BeNil())
+				})
+			})
+		})
+
+		Describe("GetByID", func() {
+			var (
+				result *model.User
+			)
+
+			BeforeEach(func() {
+				err := s.AddUser(ctx, testUser)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				err := s.DeleteUserByID(ctx, testUser.ID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			JustBeforeEach(func() {
+				result, errAction = s.GetByID(ctx, userID)
+			})
+
+			ItSucceeds()
+			It("retrieves the correct user", func() {
+				Expect(*result).To(MatchFields(IgnoreExtras, Fields{
+					"ID":       Equal(testUser.ID),
+					"Username": Equal(testUser.Username),
+					"Role":     BeEmpty(),
+				}))
+			})
+
+			Context("when the user does not exist", func() {
+				BeforeEach(func() {
+					userID = uuid.New()
+				})
+
+				ItSucceeds()
+				It("returns nil", func() {
+					Expect(result).To(BeNil())
+				})
+			})
+		})
+
+		Describe("UserExists", func() {
+			var (
+				result bool
+			)
+
+			BeforeEach(func() {
+				err := s.AddUser(ctx, testUser)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				err := s.DeleteUserByID(ctx, testUser.ID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			JustBeforeEach(func() {
+				result, errAction = s.UserExists(ctx, userID)
+			})
+
+			ItSucceeds()
+			It("returns true for existing users", func() {
+				Expect(result).To(BeTrue())
+			})
+
+			Context("when the user does not exist", func() {
+				BeforeEach(func() {
+					userID = uuid.New()
+				})
+
+				ItSucceeds()
+				It("returns false without errors", func() {
+					Expect(result).To(BeFalse())
+				})
+			})
+
+			Context("when the user is disabled", func() {
+				BeforeEach(func() {
+					Expect(s.SetUserDisabled(ctx, userID, true)).To(Succeed())
+				})
+
+				ItSucceeds()
+				It("returns false", func() {
+					Expect(result).To(BeFalse())
+				})
+			})
+		})
+	})
+
+	describeUsers(newStore)
+	describeOrgs(newStore)
+	describeInvitations(newStore)
+	describeRefreshTokens(newStore)
+	describeTokenRevocations(newStore)
+	describeAPIKeys(newStore)
+	describeOAuthClients(newStore)
+	describeLoginFailures(newStore)
+	describeMFA(newStore)
+	describeExternalIdentities(newStore)
+}
@@ -0,0 +1,116 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+func describeTokenRevocations(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("Token revocations", func() {
+		var (
+			s        Store
+			user     model.User
+			org      model.Organization
+			now      time.Time
+			byToken  model.TokenRevocation
+			byCutoff model.TokenRevocation
+		)
+
+		// The other specs share the database, only the revocations of this organization count.
+		listOwn := func(at time.Time) []model.TokenRevocation {
+			revocations, err := s.ListTokenRevocations(ctx, at)
+			Expect(err).ToNot(HaveOccurred())
+			own := []model.TokenRevocation{}
+			for _, revocation := range revocations {
+				if revocation.OrgID == org.ID {
+					own = append(own, revocation)
+				}
+			}
+			return own
+		}
+
+		BeforeEach(func() {
+			s = newStore()
+			user = model.User{
+				ID:       uuid.New(),
+				Username: "revoke-" + uuid.NewString()[:8],
+				Password: "testpassword",
+			}
+			Expect(s.AddUser(ctx, user)).To(Succeed())
+			org = model.Organization{ID: uuid.New(), Name: "org-" + uuid.NewString(), CreatedAt: time.Now().UTC()}
+			Expect(s.CreateOrg(ctx, org, user.ID)).To(Succeed())
+
+			now = time.Now().UTC().Truncate(time.Millisecond)
+			byToken = model.TokenRevocation{
+				ID:        uuid.New(),
+				TokenID:   uuid.NewString(),
+				OrgID:     org.ID,
+				UserID:    user.ID,
+				ExpiresAt: now.Add(time.Minute),
+				RevokedBy: user.ID,
+				CreatedAt: now,
+			}
+			byCutoff = model.TokenRevocation{
+				ID:           uuid.New(),
+				OrgID:        org.ID,
+				IssuedBefore: now,
+				ExpiresAt:    now.Add(time.Hour),
+				RevokedBy:    user.ID,
+				CreatedAt:    now.Add(time.Millisecond),
+			}
+			Expect(s.CreateTokenRevocation(ctx, byToken)).To(Succeed())
+			Expect(s.CreateTokenRevocation(ctx, byCutoff)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(s.DeleteOrgByID(ctx, org.ID)).To(Succeed())
+			Expect(s.DeleteUserByID(ctx, user.ID)).To(Succeed())
+		})
+
+		Describe("ListTokenRevocations", func() {
+			It("returns the stored revocations", func() {
+				Expect(listOwn(now)).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{
+						"ID":           Equal(byToken.ID),
+						"TokenID":      Equal(byToken.TokenID),
+						"UserID":       Equal(user.ID),
+						"IssuedBefore": BeZero(),
+						"ExpiresAt":    BeTemporally("~", byToken.ExpiresAt, time.Second),
+						"RevokedBy":    Equal(user.ID),
+					}),
+					MatchFields(IgnoreExtras, Fields{
+						"ID":           Equal(byCutoff.ID),
+						"TokenID":      BeEmpty(),
+						"UserID":       Equal(uuid.Nil),
+						"IssuedBefore": BeTemporally("~", byCutoff.IssuedBefore, time.Second),
+						"ExpiresAt":    BeTemporally("~", byCutoff.ExpiresAt, time.Second),
+					}),
+				))
+			})
+
+			It("leaves out expired revocations", func() {
+				revocations := listOwn(now.Add(2 * time.Minute))
+				Expect(revocations).To(HaveLen(1))
+				Expect(revocations[0].ID).To(Equal(byCutoff.ID))
+			})
+		})
+
+		Describe("DeleteExpiredTokenRevocations", func() {
+			It("deletes the expired revocations", func() {
+				Expect(s.DeleteExpiredTokenRevocations(ctx, now.Add(2*time.Minute))).To(Succeed())
+				revocations := listOwn(now)
+				Expect(revocations).To(HaveLen(1))
+				Expect(revocations[0].ID).To(Equal(byCutoff.ID))
+			})
+		})
+	})
+}
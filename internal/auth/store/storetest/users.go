@@ -0,0 +1,145 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+)
+
+func describeUsers(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("Users", func() {
+		var (
+			s     Store
+			owner model.User
+			user  model.User
+			org   model.Organization
+		)
+
+		BeforeEach(func() {
+			s = newStore()
+			owner = model.User{ID: uuid.New(), Username: "owner-" + uuid.NewString()[:8], Password: "testpassword"}
+			Expect(s.AddUser(ctx, owner)).To(Succeed())
+			org = model.Organization{ID: uuid.New(), Name: "org-" + uuid.NewString(), CreatedAt: time.Now().UTC()}
+			Expect(s.CreateOrg(ctx, org, owner.ID)).To(Succeed())
+
+			user = model.User{
+				ID:       uuid.New(),
+				Username: "user-" + uuid.NewString()[:8],
+				Password: "hash",
+				Role:     model.RoleViewer,
+			}
+		})
+
+		AfterEach(func() {
+			Expect(s.DeleteUserByID(ctx, user.ID)).To(Succeed())
+			Expect(s.DeleteOrgByID(ctx, org.ID)).To(Succeed())
+			Expect(s.DeleteUserByID(ctx, owner.ID)).To(Succeed())
+		})
+
+		Describe("CreateUser", func() {
+			It("stores the user as a member of the organization with the role", func() {
+				Expect(s.CreateUser(ctx, user, org.ID)).To(Succeed())
+
+				stored, err := s.GetByID(ctx, user.ID)
+				Expect(err).ToNot(HaveOccurred())
+				expected := user
+				expected.Role = ""
+				Expect(*stored).To(Equal(expected))
+
+				role, err := s.GetOrgMemberRole(ctx, org.ID, user.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(role).To(Equal(model.RoleViewer))
+			})
+
+			It("rejects taken usernames", func() {
+				user.Username = owner.Username
+				Expect(s.CreateUser(ctx, user, org.ID)).To(MatchError(model.ErrUserExists))
+			})
+
+			It("rejects unknown organizations", func() {
+				Expect(s.CreateUser(ctx, user, uuid.New())).To(MatchError(model.ErrOrgNotFound))
+
+				stored, err := s.GetByID(ctx, user.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored).To(BeNil())
+			})
+		})
+
+		Describe("ListUsers", func() {
+			It("returns the members of the organization without their passwords", func() {
+				Expect(s.CreateUser(ctx, user, org.ID)).To(Succeed())
+
+				users, err := s.ListUsers(ctx, org.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(users).To(HaveLen(2))
+				Expect(users).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"ID":       Equal(user.ID),
+					"Username": Equal(user.Username),
+					"Password": BeEmpty(),
+					"Role":     Equal(model.RoleViewer),
+				})))
+			})
+
+			It("leaves out the users of other organizations", func() {
+				Expect(s.AddUser(ctx, user)).To(Succeed())
+
+				users, err := s.ListUsers(ctx, org.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(users).To(ConsistOf(MatchFields(IgnoreExtras, Fields{"ID": Equal(owner.ID)})))
+			})
+		})
+
+		Context("with an existing user", func() {
+			BeforeEach(func() {
+				Expect(s.CreateUser(ctx, user, org.ID)).To(Succeed())
+			})
+
+			It("disables and enables the user", func() {
+				Expect(s.SetUserDisabled(ctx, user.ID, true)).To(Succeed())
+				stored, err := s.GetByUsername(ctx, user.Username)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored.Disabled).To(BeTrue())
+
+				Expect(s.SetUserDisabled(ctx, user.ID, false)).To(Succeed())
+				exists, err := s.UserExists(ctx, user.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(exists).To(BeTrue())
+			})
+
+			It("replaces the password", func() {
+				Expect(s.UpdateUserPassword(ctx, user.ID, "new-hash")).To(Succeed())
+				stored, err := s.GetByID(ctx, user.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored.Password).To(Equal("new-hash"))
+			})
+
+			It("deletes the user with its memberships", func() {
+				Expect(s.DeleteUser(ctx, user.ID)).To(Succeed())
+
+				stored, err := s.GetByID(ctx, user.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored).To(BeNil())
+
+				isMember, err := s.IsOrgMember(ctx, org.ID, user.ID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(isMember).To(BeFalse())
+			})
+		})
+
+		Context("when the user does not exist", func() {
+			It("reports it", func() {
+				Expect(s.SetUserDisabled(ctx, user.ID, true)).To(MatchError(model.ErrUserNotFound))
+				Expect(s.UpdateUserPassword(ctx, user.ID, "new-hash")).To(MatchError(model.ErrUserNotFound))
+				Expect(s.DeleteUser(ctx, user.ID)).To(MatchError(model.ErrUserNotFound))
+			})
+		})
+	})
+}
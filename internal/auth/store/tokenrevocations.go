@@ -0,0 +1,100 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+)
+
+const TokenRevocationsTable = "token_revocations"
+
+func (s *Store) CreateTokenRevocation(ctx context.Context, revocation model.TokenRevocation) error {
+	query := fmt.Sprintf(`INSERT INTO %s (id, token_id, org_id, user_id, issued_before, expires_at, revoked_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`, TokenRevocationsTable)
+	_, err := s.pool.Exec(ctx, query,
+		revocation.ID,
+		nullString(revocation.TokenID),
+		revocation.OrgID,
+		nullUUID(revocation.UserID),
+		nullTime(revocation.IssuedBefore),
+		revocation.ExpiresAt,
+		revocation.RevokedBy,
+		revocation.CreatedAt,
+	)
+	return err
+}
+
+// ListTokenRevocations returns the revocations that still revoke tokens at the given time.
+func (s *Store) ListTokenRevocations(ctx context.Context, now time.Time) ([]model.TokenRevocation, error) {
+	query := fmt.Sprintf(`SELECT id, token_id, org_id, user_id, issued_before, expires_at, revoked_by, created_at
+		FROM %s WHERE expires_at > $1 ORDER BY created_at`, TokenRevocationsTable)
+	rows, err := s.pool.Query(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	revocations := []model.TokenRevocation{}
+	for rows.Next() {
+		var (
+			revocation   model.TokenRevocation
+			tokenID      *string
+			userID       *uuid.UUID
+			issuedBefore *time.Time
+		)
+		if err := rows.Scan(
+			&revocation.ID,
+			&tokenID,
+			&revocation.OrgID,
+			&userID,
+			&issuedBefore,
+			&revocation.ExpiresAt,
+			&revocation.RevokedBy,
+			&revocation.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if tokenID != nil {
+			revocation.TokenID = *tokenID
+		}
+		if userID != nil {
+			revocation.UserID = *userID
+		}
+		if issuedBefore != nil {
+			revocation.IssuedBefore = *issuedBefore
+		}
+		revocations = append(revocations, revocation)
+	}
+
+	return revocations, rows.Err()
+}
+
+func (s *Store) DeleteExpiredTokenRevocations(ctx context.Context, now time.Time) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE expires_at <= $1`, TokenRevocationsTable)
+	_, err := s.pool.Exec(ctx, query, now)
+	return err
+}
+
+func nullString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func nullUUID(value uuid.UUID) *uuid.UUID {
+	if value == uuid.Nil {
+		return nil
+	}
+	return &value
+}
+
+func nullTime(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ListUsers returns the members of the organization ordered by username.
+func (s *Store) ListUsers(ctx context.Context, orgID uuid.UUID) ([]model.User, error) {
+	query := fmt.Sprintf(`SELECT u.id, u.username, m.role, u.disabled FROM %s u JOIN %s m ON m.user_id = u.id
+		WHERE m.org_id = $1 ORDER BY u.username`, UserTable, OrganizationMembersTable)
+	rows, err := s.pool.Query(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []model.User{}
+	for rows.Next() {
+		var user model.User
+		if err := rows.Scan(&user.ID, &user.Username, &user.Role, &user.Disabled); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// CreateUser stores the user together with its membership in the organization, with the role of the
+// user, in a single transaction.
+func (s *Store) CreateUser(ctx context.Context, user model.User, orgID uuid.UUID) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, username, password, disabled) VALUES ($1, $2, $3, $4)`, UserTable)
+	if _, err := tx.Exec(ctx, query, user.ID, user.Username, user.Password, user.Disabled); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return model.ErrUserExists
+		}
+		return err
+	}
+
+	query = fmt.Sprintf(`INSERT INTO %s (org_id, user_id, role) VALUES ($1, $2, $3)`, OrganizationMembersTable)
+	if _, err := tx.Exec(ctx, query, orgID, user.ID, user.Role); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return model.ErrOrgNotFound
+		}
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *Store) SetUserDisabled(ctx context.Context, id uuid.UUID, disabled bool) error {
+	query := fmt.Sprintf(`UPDATE %s SET disabled = $2 WHERE id = $1`, UserTable)
+	return s.updateUser(ctx, query, id, disabled)
+}
+
+// UpdateUserPassword replaces the password hash of the user.
+func (s *Store) UpdateUserPassword(ctx context.Context, id uuid.UUID, password string) error {
+	query := fmt.Sprintf(`UPDATE %s SET password = $2 WHERE id = $1`, UserTable)
+	return s.updateUser(ctx, query, id, password)
+}
+
+// DeleteUser deletes the user together with its memberships and refresh tokens.
+func (s *Store) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, UserTable)
+	return s.updateUser(ctx, query, id)
+}
+
+// updateUser runs a statement on the user with the given ID and reports when there is none.
+func (s *Store) updateUser(ctx context.Context, query string, id uuid.UUID, args ...any) error {
+	result, err := s.pool.Exec(ctx, query, append([]any{id}, args...)...)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
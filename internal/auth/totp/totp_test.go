@@ -0,0 +1,86 @@
+package totp_test
+
+import (
+	"net/url"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/totp"
+)
+
+// rfcSecret is the base32 encoding of the SHA-1 key of the RFC 6238 test vectors.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+var _ = Describe("TOTP", func() {
+	DescribeTable("generates the codes of the RFC 6238 test vectors",
+		func(unix int64, expected string) {
+			code, err := totp.Code(rfcSecret, totp.Step(time.Unix(unix, 0)))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(code).To(Equal(expected))
+		},
+		Entry("at 59", int64(59), "287082"),
+		Entry("at 1111111109", int64(1111111109), "081804"),
+		Entry("at 1234567890", int64(1234567890), "005924"),
+		Entry("at 2000000000", int64(2000000000), "279037"),
+	)
+
+	It("rejects invalid secrets", func() {
+		_, err := totp.Code("not base32!", 1)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("Validate", func() {
+		now := time.Unix(1234567890, 0)
+
+		It("accepts the code of the current step", func() {
+			step, ok := totp.Validate(rfcSecret, "005924", now)
+			Expect(ok).To(BeTrue())
+			Expect(step).To(Equal(totp.Step(now)))
+		})
+
+		It("accepts the codes of the adjacent steps", func() {
+			previous, err := totp.Code(rfcSecret, totp.Step(now)-1)
+			Expect(err).ToNot(HaveOccurred())
+			step, ok := totp.Validate(rfcSecret, previous, now)
+			Expect(ok).To(BeTrue())
+			Expect(step).To(Equal(totp.Step(now) - 1))
+		})
+
+		It("rejects codes from further away", func() {
+			old, err := totp.Code(rfcSecret, totp.Step(now)-2)
+			Expect(err).ToNot(HaveOccurred())
+			_, ok := totp.Validate(rfcSecret, old, now)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("rejects codes of the wrong length", func() {
+			_, ok := totp.Validate(rfcSecret, "5924", now)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	It("generates secrets the codes can be computed from", func() {
+		secret, err := totp.GenerateSecret()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret).To(HaveLen(32))
+
+		code, err := totp.Code(secret, totp.Step(time.Now()))
+		Expect(err).ToNot(HaveOccurred())
+		_, ok := totp.Validate(secret, code, time.Now())
+		Expect(ok).To(BeTrue())
+	})
+
+	It("builds the otpauth URI of the secret", func() {
+		uri, err := url.Parse(totp.URI("Feature Flags API", "john", rfcSecret))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(uri.Scheme).To(Equal("otpauth"))
+		Expect(uri.Host).To(Equal("totp"))
+		Expect(uri.Path).To(Equal("/Feature Flags API:john"))
+		Expect(uri.Query().Get("secret")).To(Equal(rfcSecret))
+		Expect(uri.Query().Get("issuer")).To(Equal("Feature Flags API"))
+		Expect(uri.Query().Get("digits")).To(Equal("6"))
+		Expect(uri.Query().Get("period")).To(Equal("30"))
+	})
+})
@@ -0,0 +1,13 @@
+package coderefs_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCodeRefs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Code References Suite")
+}
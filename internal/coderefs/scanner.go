@@ -0,0 +1,197 @@
+package coderefs
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// KeyPlaceholder is replaced with the quoted flag key in custom patterns,
+// e.g. `IsEnabled\("{{key}}"\)`.
+const KeyPlaceholder = "{{key}}"
+
+type Language string
+
+const (
+	LanguageGo         Language = "go"
+	LanguageJavaScript Language = "javascript"
+	LanguagePython     Language = "python"
+)
+
+var languageExtensions = map[string]Language{
+	".go":  LanguageGo,
+	".js":  LanguageJavaScript,
+	".jsx": LanguageJavaScript,
+	".mjs": LanguageJavaScript,
+	".cjs": LanguageJavaScript,
+	".ts":  LanguageJavaScript,
+	".tsx": LanguageJavaScript,
+	".py":  LanguagePython,
+}
+
+// defaultPatterns match the key as a complete string literal in each language.
+var defaultPatterns = map[Language][]string{
+	LanguageGo:         {`"{{key}}"`, "`{{key}}`"},
+	LanguageJavaScript: {`"{{key}}"`, `'{{key}}'`, "`{{key}}`"},
+	LanguagePython:     {`"{{key}}"`, `'{{key}}'`},
+}
+
+var lineCommentPrefixes = map[Language]string{
+	LanguageGo:         "//",
+	LanguageJavaScript: "//",
+	LanguagePython:     "#",
+}
+
+var DefaultExcludedDirs = []string{".git", "vendor", "node_modules"}
+
+type Reference struct {
+	Key  string `json:"key"`
+	Path string `json:"path"`
+	Line int    `json:"line"`
+}
+
+type Result struct {
+	References   []Reference `json:"references"`
+	Unreferenced []string    `json:"unreferenced"`
+}
+
+type Scanner struct {
+	keys         []string
+	matchers     map[Language][]keyMatcher
+	excludedDirs []string
+}
+
+type keyMatcher struct {
+	key     string
+	pattern *regexp.Regexp
+}
+
+// NewScanner prepares a scanner for the given flag keys. When no custom patterns are given,
+// the language defaults are used; custom patterns apply to every supported language.
+func NewScanner(keys []string, patterns []string, excludedDirs []string) (*Scanner, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no flag keys to scan for")
+	}
+	keys = slices.Compact(slices.Sorted(slices.Values(keys)))
+
+	matchers := map[Language][]keyMatcher{}
+	for language, languagePatterns := range defaultPatterns {
+		if len(patterns) > 0 {
+			languagePatterns = patterns
+		}
+		for _, pattern := range languagePatterns {
+			for _, key := range keys {
+				expr := strings.ReplaceAll(pattern, KeyPlaceholder, regexp.QuoteMeta(key))
+				compiled, err := regexp.Compile(expr)
+				if err != nil {
+					return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+				}
+				matchers[language] = append(matchers[language], keyMatcher{key: key, pattern: compiled})
+			}
+		}
+	}
+
+	return &Scanner{keys: keys, matchers: matchers, excludedDirs: excludedDirs}, nil
+}
+
+// Scan walks the source tree under root and returns every line that references a flag key.
+// Paths in the result are relative to root.
+func (s *Scanner) Scan(root string) (Result, error) {
+	var references []Reference
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path != root && slices.Contains(s.excludedDirs, entry.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		language, ok := languageExtensions[filepath.Ext(path)]
+		if !ok {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		fileReferences, err := s.scanFile(path, filepath.ToSlash(relPath), language)
+		if err != nil {
+			return err
+		}
+		references = append(references, fileReferences...)
+		return nil
+	})
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to scan %s: %w", root, err)
+	}
+
+	sort.SliceStable(references, func(i, j int) bool {
+		if references[i].Key != references[j].Key {
+			return references[i].Key < references[j].Key
+		}
+		if references[i].Path != references[j].Path {
+			return references[i].Path < references[j].Path
+		}
+		return references[i].Line < references[j].Line
+	})
+
+	return Result{References: references, Unreferenced: s.unreferencedKeys(references)}, nil
+}
+
+func (s *Scanner) scanFile(path, relPath string, language Language) ([]Reference, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var references []Reference
+	fileScanner := bufio.NewScanner(file)
+	fileScanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	commentPrefix := lineCommentPrefixes[language]
+	for lineNumber := 1; fileScanner.Scan(); lineNumber++ {
+		line := fileScanner.Text()
+		if strings.HasPrefix(strings.TrimSpace(line), commentPrefix) {
+			continue
+		}
+
+		for _, matcher := range s.matchers[language] {
+			if !matcher.pattern.MatchString(line) {
+				continue
+			}
+			reference := Reference{Key: matcher.key, Path: relPath, Line: lineNumber}
+			// Several patterns can match the same key on one line; report it once.
+			if !slices.Contains(references, reference) {
+				references = append(references, reference)
+			}
+		}
+	}
+
+	return references, fileScanner.Err()
+}
+
+func (s *Scanner) unreferencedKeys(references []Reference) []string {
+	referenced := map[string]bool{}
+	for _, reference := range references {
+		referenced[reference.Key] = true
+	}
+
+	unreferenced := []string{}
+	for _, key := range s.keys {
+		if !referenced[key] {
+			unreferenced = append(unreferenced, key)
+		}
+	}
+	sort.Strings(unreferenced)
+	return unreferenced
+}
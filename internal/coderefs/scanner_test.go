@@ -0,0 +1,96 @@
+package coderefs_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/coderefs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scanner", func() {
+	var (
+		root      string
+		keys      []string
+		patterns  []string
+		result    coderefs.Result
+		errAction error
+	)
+
+	writeFile := func(path, content string) {
+		fullPath := filepath.Join(root, path)
+		Expect(os.MkdirAll(filepath.Dir(fullPath), 0o755)).To(Succeed())
+		Expect(os.WriteFile(fullPath, []byte(content), 0o644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+		keys = []string{"new-checkout", "dark-mode", "unused-flag"}
+		patterns = nil
+
+		writeFile("main.go", "package main\n\n// \"dark-mode\" is mentioned in a comment\nvar enabled = client.IsEnabled(\"new-checkout\")\n")
+		writeFile("web/app.tsx", "const a = flags['dark-mode'];\nconst b = flags[`new-checkout`];\n")
+		writeFile("scripts/job.py", "# 'new-checkout'\nif client.enabled('dark-mode'):\n    pass\n")
+		writeFile("README.md", "\"unused-flag\"\n")
+		writeFile("vendor/lib/lib.go", "var x = \"unused-flag\"\n")
+		writeFile("web/node_modules/dep/index.js", "var x = 'unused-flag'\n")
+	})
+
+	JustBeforeEach(func() {
+		scanner, err := coderefs.NewScanner(keys, patterns, coderefs.DefaultExcludedDirs)
+		Expect(err).NotTo(HaveOccurred())
+		result, errAction = scanner.Scan(root)
+	})
+
+	It("reports the references per key in Go, JavaScript and Python sources", func() {
+		Expect(errAction).NotTo(HaveOccurred())
+		Expect(result.References).To(Equal([]coderefs.Reference{
+			{Key: "dark-mode", Path: "scripts/job.py", Line: 2},
+			{Key: "dark-mode", Path: "web/app.tsx", Line: 1},
+			{Key: "new-checkout", Path: "main.go", Line: 4},
+			{Key: "new-checkout", Path: "web/app.tsx", Line: 2},
+		}))
+	})
+
+	It("reports keys without references, ignoring excluded directories and unsupported files", func() {
+		Expect(result.Unreferenced).To(Equal([]string{"unused-flag"}))
+	})
+
+	Context("when custom patterns are given", func() {
+		BeforeEach(func() {
+			patterns = []string{`IsEnabled\("{{key}}"\)`}
+		})
+
+		It("only reports matches of those patterns", func() {
+			Expect(errAction).NotTo(HaveOccurred())
+			Expect(result.References).To(Equal([]coderefs.Reference{
+				{Key: "new-checkout", Path: "main.go", Line: 4},
+			}))
+			Expect(result.Unreferenced).To(Equal([]string{"dark-mode", "unused-flag"}))
+		})
+	})
+
+	Context("when a key is a prefix of another literal", func() {
+		BeforeEach(func() {
+			keys = []string{"dark"}
+		})
+
+		It("does not report partial matches", func() {
+			Expect(result.References).To(BeEmpty())
+			Expect(result.Unreferenced).To(Equal([]string{"dark"}))
+		})
+	})
+})
+
+var _ = Describe("NewScanner", func() {
+	It("rejects an empty key list", func() {
+		_, err := coderefs.NewScanner(nil, nil, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects invalid patterns", func() {
+		_, err := coderefs.NewScanner([]string{"flag"}, []string{"("}, nil)
+		Expect(err).To(MatchError(ContainSubstring("invalid pattern")))
+	})
+})
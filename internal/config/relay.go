@@ -0,0 +1,42 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+type RelayConfig struct {
+	APIPort              string
+	OtelCollectorEnabled bool
+	OtelCollectorHost    string
+	UpstreamGRPCAddr     string
+	UpstreamTLS          bool
+	UpstreamAPIKey       string
+	Environment          string
+	APIKeys              []string
+	CacheFile            string
+}
+
+func LoadRelay() *RelayConfig {
+	return &RelayConfig{
+		APIPort:              getEnv("RELAY_API_PORT", "8090"),
+		OtelCollectorEnabled: getStatus("OTEL_COLLECTOR_ENABLED", false),
+		OtelCollectorHost:    getEnv("OTEL_COLLECTOR_HOST", "otel-collector:4317"),
+		UpstreamGRPCAddr:     getEnv("RELAY_UPSTREAM_GRPC_ADDR", "featureflagsapi:50051"),
+		UpstreamTLS:          getStatus("RELAY_UPSTREAM_TLS", false),
+		UpstreamAPIKey:       os.Getenv("RELAY_UPSTREAM_API_KEY"),
+		Environment:          getEnv("RELAY_ENVIRONMENT", "production"),
+		APIKeys:              getList("RELAY_API_KEYS"),
+		CacheFile:            os.Getenv("RELAY_CACHE_FILE"),
+	}
+}
+
+func getList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -2,17 +2,18 @@ package featureflags_test
 
 import (
 	"context"
+	"database/sql"
+	"path/filepath"
 	"testing"
 
-	testdb "github.com/georgisomnoev/feature-flag-api/test/pg"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/georgisomnoev/feature-flag-api/internal/sqlite"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
 var (
-	ctx  context.Context
-	pool *pgxpool.Pool
+	ctx context.Context
+	db  *sql.DB
 )
 
 func TestFeatureFlags(t *testing.T) {
@@ -22,9 +23,11 @@ func TestFeatureFlags(t *testing.T) {
 
 var _ = BeforeSuite(func() {
 	ctx = context.Background()
-	pool = testdb.MustInitDBPool(ctx)
+	var err error
+	db, err = sqlite.Open(ctx, filepath.Join(GinkgoT().TempDir(), "featureflags.db"))
+	Expect(err).NotTo(HaveOccurred())
 })
 
 var _ = AfterSuite(func() {
-	pool.Close()
+	Expect(db.Close()).To(Succeed())
 })
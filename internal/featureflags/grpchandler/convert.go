@@ -0,0 +1,110 @@
+package grpchandler
+
+import (
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	featureflagsv1 "github.com/georgisomnoev/feature-flag-api/internal/gen/featureflags/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var flagKinds = map[model.FlagKind]featureflagsv1.FlagKind{
+	model.FlagKindRelease:    featureflagsv1.FlagKind_FLAG_KIND_RELEASE,
+	model.FlagKindExperiment: featureflagsv1.FlagKind_FLAG_KIND_EXPERIMENT,
+	model.FlagKindOps:        featureflagsv1.FlagKind_FLAG_KIND_OPS,
+	model.FlagKindPermission: featureflagsv1.FlagKind_FLAG_KIND_PERMISSION,
+	model.FlagKindKillSwitch: featureflagsv1.FlagKind_FLAG_KIND_KILL_SWITCH,
+}
+
+var ownerTypes = map[model.OwnerType]featureflagsv1.OwnerType{
+	model.OwnerTypeUser: featureflagsv1.OwnerType_OWNER_TYPE_USER,
+	model.OwnerTypeTeam: featureflagsv1.OwnerType_OWNER_TYPE_TEAM,
+}
+
+var flagEventTypes = map[model.FlagChangeType]featureflagsv1.FlagEventType{
+	model.FlagChangeCreated:    featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_CREATED,
+	model.FlagChangeUpdated:    featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_UPDATED,
+	model.FlagChangeDeleted:    featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_DELETED,
+	model.FlagChangeKillSwitch: featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_KILL_SWITCH,
+}
+
+var evaluationReasons = map[model.EvaluationReason]featureflagsv1.EvaluationReason{
+	model.EvaluationReasonStatic:     featureflagsv1.EvaluationReason_EVALUATION_REASON_STATIC,
+	model.EvaluationReasonKillSwitch: featureflagsv1.EvaluationReason_EVALUATION_REASON_KILL_SWITCH,
+}
+
+func flagToProto(flag model.FeatureFlag) *featureflagsv1.Flag {
+	var expiresAt *timestamppb.Timestamp
+	if flag.ExpiresAt != nil {
+		expiresAt = timestamppb.New(*flag.ExpiresAt)
+	}
+
+	return &featureflagsv1.Flag{
+		Id:          flag.ID.String(),
+		Key:         flag.Key,
+		Description: flag.Description,
+		Enabled:     flag.Enabled,
+		Permanent:   flag.Permanent,
+		Protected:   flag.Protected,
+		Owner:       flag.Owner,
+		OwnerType:   ownerTypes[flag.OwnerType],
+		Maintainers: flag.Maintainers,
+		Tags:        flag.Tags,
+		Kind:        flagKinds[flag.Kind],
+		IssueUrls:   flag.IssueURLs,
+		Metadata:    flag.Metadata,
+		ManagedBy:   flag.ManagedBy,
+		ExpiresAt:   expiresAt,
+		CreatedAt:   timestamppb.New(flag.CreatedAt),
+		UpdatedAt:   timestamppb.New(flag.UpdatedAt),
+	}
+}
+
+func flagRequestFromProto(input *featureflagsv1.FlagInput) model.FeatureFlagRequest {
+	req := model.FeatureFlagRequest{
+		Key:         input.GetKey(),
+		Description: input.GetDescription(),
+		Enabled:     input.GetEnabled(),
+		Permanent:   input.GetPermanent(),
+		Protected:   input.GetProtected(),
+		Owner:       input.GetOwner(),
+		OwnerType:   ownerTypeFromProto(input.GetOwnerType()),
+		Maintainers: input.GetMaintainers(),
+		Tags:        input.GetTags(),
+		Kind:        flagKindFromProto(input.GetKind()),
+		IssueURLs:   input.GetIssueUrls(),
+		Metadata:    input.GetMetadata(),
+		ManagedBy:   input.GetManagedBy(),
+	}
+	if input.GetExpiresAt() != nil {
+		expiresAt := input.GetExpiresAt().AsTime()
+		req.ExpiresAt = &expiresAt
+	}
+	return req
+}
+
+// flagKindFromProto maps the unspecified kind to an empty one, which the service defaults
+// and the list filter ignores.
+func flagKindFromProto(kind featureflagsv1.FlagKind) model.FlagKind {
+	for modelKind, protoKind := range flagKinds {
+		if protoKind == kind {
+			return modelKind
+		}
+	}
+	return ""
+}
+
+func ownerTypeFromProto(ownerType featureflagsv1.OwnerType) model.OwnerType {
+	for modelType, protoType := range ownerTypes {
+		if protoType == ownerType {
+			return modelType
+		}
+	}
+	return ""
+}
+
+func flagEventTypeToProto(changeType model.FlagChangeType) featureflagsv1.FlagEventType {
+	return flagEventTypes[changeType]
+}
+
+func evaluationReasonToProto(reason model.EvaluationReason) featureflagsv1.EvaluationReason {
+	return evaluationReasons[reason]
+}
@@ -0,0 +1,757 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package grpchandlerfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/grpchandler"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+type FakeService struct {
+	CreateFlagStub        func(context.Context, uuid.UUID, model.FeatureFlagRequest) (uuid.UUID, error)
+	createFlagMutex       sync.RWMutex
+	createFlagArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.FeatureFlagRequest
+	}
+	createFlagReturns struct {
+		result1 uuid.UUID
+		result2 error
+	}
+	createFlagReturnsOnCall map[int]struct {
+		result1 uuid.UUID
+		result2 error
+	}
+	DeleteFlagStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	deleteFlagMutex       sync.RWMutex
+	deleteFlagArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deleteFlagReturns struct {
+		result1 error
+	}
+	deleteFlagReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EvaluateFlagStub        func(context.Context, uuid.UUID, string) (model.FlagEvaluation, error)
+	evaluateFlagMutex       sync.RWMutex
+	evaluateFlagArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	evaluateFlagReturns struct {
+		result1 model.FlagEvaluation
+		result2 error
+	}
+	evaluateFlagReturnsOnCall map[int]struct {
+		result1 model.FlagEvaluation
+		result2 error
+	}
+	GetFlagByIDStub        func(context.Context, uuid.UUID, uuid.UUID) (model.FeatureFlag, error)
+	getFlagByIDMutex       sync.RWMutex
+	getFlagByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getFlagByIDReturns struct {
+		result1 model.FeatureFlag
+		result2 error
+	}
+	getFlagByIDReturnsOnCall map[int]struct {
+		result1 model.FeatureFlag
+		result2 error
+	}
+	GetKillSwitchStatusStub        func(context.Context, uuid.UUID) (model.KillSwitchStatus, error)
+	getKillSwitchStatusMutex       sync.RWMutex
+	getKillSwitchStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getKillSwitchStatusReturns struct {
+		result1 model.KillSwitchStatus
+		result2 error
+	}
+	getKillSwitchStatusReturnsOnCall map[int]struct {
+		result1 model.KillSwitchStatus
+		result2 error
+	}
+	ListFlagsStub        func(context.Context, uuid.UUID, model.FlagFilter) ([]model.FeatureFlag, error)
+	listFlagsMutex       sync.RWMutex
+	listFlagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.FlagFilter
+	}
+	listFlagsReturns struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}
+	listFlagsReturnsOnCall map[int]struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}
+	ListUserGroupIDsStub        func(context.Context, uuid.UUID, uuid.UUID) ([]uuid.UUID, error)
+	listUserGroupIDsMutex       sync.RWMutex
+	listUserGroupIDsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	listUserGroupIDsReturns struct {
+		result1 []uuid.UUID
+		result2 error
+	}
+	listUserGroupIDsReturnsOnCall map[int]struct {
+		result1 []uuid.UUID
+		result2 error
+	}
+	UpdateFlagStub        func(context.Context, uuid.UUID, uuid.UUID, model.FeatureFlagRequest) error
+	updateFlagMutex       sync.RWMutex
+	updateFlagArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.FeatureFlagRequest
+	}
+	updateFlagReturns struct {
+		result1 error
+	}
+	updateFlagReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WatchFlagsStub        func(context.Context, uuid.UUID) (<-chan model.FlagChange, error)
+	watchFlagsMutex       sync.RWMutex
+	watchFlagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	watchFlagsReturns struct {
+		result1 <-chan model.FlagChange
+		result2 error
+	}
+	watchFlagsReturnsOnCall map[int]struct {
+		result1 <-chan model.FlagChange
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeService) CreateFlag(arg1 context.Context, arg2 uuid.UUID, arg3 model.FeatureFlagRequest) (uuid.UUID, error) {
+	fake.createFlagMutex.Lock()
+	ret, specificReturn := fake.createFlagReturnsOnCall[len(fake.createFlagArgsForCall)]
+	fake.createFlagArgsForCall = append(fake.createFlagArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.FeatureFlagRequest
+	}{arg1, arg2, arg3})
+	stub := fake.CreateFlagStub
+	fakeReturns := fake.createFlagReturns
+	fake.recordInvocation("CreateFlag", []interface{}{arg1, arg2, arg3})
+	fake.createFlagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) CreateFlagCallCount() int {
+	fake.createFlagMutex.RLock()
+	defer fake.createFlagMutex.RUnlock()
+	return len(fake.createFlagArgsForCall)
+}
+
+func (fake *FakeService) CreateFlagCalls(stub func(context.Context, uuid.UUID, model.FeatureFlagRequest) (uuid.UUID, error)) {
+	fake.createFlagMutex.Lock()
+	defer fake.createFlagMutex.Unlock()
+	fake.CreateFlagStub = stub
+}
+
+func (fake *FakeService) CreateFlagArgsForCall(i int) (context.Context, uuid.UUID, model.FeatureFlagRequest) {
+	fake.createFlagMutex.RLock()
+	defer fake.createFlagMutex.RUnlock()
+	argsForCall := fake.createFlagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) CreateFlagReturns(result1 uuid.UUID, result2 error) {
+	fake.createFlagMutex.Lock()
+	defer fake.createFlagMutex.Unlock()
+	fake.CreateFlagStub = nil
+	fake.createFlagReturns = struct {
+		result1 uuid.UUID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateFlagReturnsOnCall(i int, result1 uuid.UUID, result2 error) {
+	fake.createFlagMutex.Lock()
+	defer fake.createFlagMutex.Unlock()
+	fake.CreateFlagStub = nil
+	if fake.createFlagReturnsOnCall == nil {
+		fake.createFlagReturnsOnCall = make(map[int]struct {
+			result1 uuid.UUID
+			result2 error
+		})
+	}
+	fake.createFlagReturnsOnCall[i] = struct {
+		result1 uuid.UUID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) DeleteFlag(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deleteFlagMutex.Lock()
+	ret, specificReturn := fake.deleteFlagReturnsOnCall[len(fake.deleteFlagArgsForCall)]
+	fake.deleteFlagArgsForCall = append(fake.deleteFlagArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteFlagStub
+	fakeReturns := fake.deleteFlagReturns
+	fake.recordInvocation("DeleteFlag", []interface{}{arg1, arg2, arg3})
+	fake.deleteFlagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) DeleteFlagCallCount() int {
+	fake.deleteFlagMutex.RLock()
+	defer fake.deleteFlagMutex.RUnlock()
+	return len(fake.deleteFlagArgsForCall)
+}
+
+func (fake *FakeService) DeleteFlagCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deleteFlagMutex.Lock()
+	defer fake.deleteFlagMutex.Unlock()
+	fake.DeleteFlagStub = stub
+}
+
+func (fake *FakeService) DeleteFlagArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteFlagMutex.RLock()
+	defer fake.deleteFlagMutex.RUnlock()
+	argsForCall := fake.deleteFlagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) DeleteFlagReturns(result1 error) {
+	fake.deleteFlagMutex.Lock()
+	defer fake.deleteFlagMutex.Unlock()
+	fake.DeleteFlagStub = nil
+	fake.deleteFlagReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DeleteFlagReturnsOnCall(i int, result1 error) {
+	fake.deleteFlagMutex.Lock()
+	defer fake.deleteFlagMutex.Unlock()
+	fake.DeleteFlagStub = nil
+	if fake.deleteFlagReturnsOnCall == nil {
+		fake.deleteFlagReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteFlagReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) EvaluateFlag(arg1 context.Context, arg2 uuid.UUID, arg3 string) (model.FlagEvaluation, error) {
+	fake.evaluateFlagMutex.Lock()
+	ret, specificReturn := fake.evaluateFlagReturnsOnCall[len(fake.evaluateFlagArgsForCall)]
+	fake.evaluateFlagArgsForCall = append(fake.evaluateFlagArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.EvaluateFlagStub
+	fakeReturns := fake.evaluateFlagReturns
+	fake.recordInvocation("EvaluateFlag", []interface{}{arg1, arg2, arg3})
+	fake.evaluateFlagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) EvaluateFlagCallCount() int {
+	fake.evaluateFlagMutex.RLock()
+	defer fake.evaluateFlagMutex.RUnlock()
+	return len(fake.evaluateFlagArgsForCall)
+}
+
+func (fake *FakeService) EvaluateFlagCalls(stub func(context.Context, uuid.UUID, string) (model.FlagEvaluation, error)) {
+	fake.evaluateFlagMutex.Lock()
+	defer fake.evaluateFlagMutex.Unlock()
+	fake.EvaluateFlagStub = stub
+}
+
+func (fake *FakeService) EvaluateFlagArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.evaluateFlagMutex.RLock()
+	defer fake.evaluateFlagMutex.RUnlock()
+	argsForCall := fake.evaluateFlagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) EvaluateFlagReturns(result1 model.FlagEvaluation, result2 error) {
+	fake.evaluateFlagMutex.Lock()
+	defer fake.evaluateFlagMutex.Unlock()
+	fake.EvaluateFlagStub = nil
+	fake.evaluateFlagReturns = struct {
+		result1 model.FlagEvaluation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) EvaluateFlagReturnsOnCall(i int, result1 model.FlagEvaluation, result2 error) {
+	fake.evaluateFlagMutex.Lock()
+	defer fake.evaluateFlagMutex.Unlock()
+	fake.EvaluateFlagStub = nil
+	if fake.evaluateFlagReturnsOnCall == nil {
+		fake.evaluateFlagReturnsOnCall = make(map[int]struct {
+			result1 model.FlagEvaluation
+			result2 error
+		})
+	}
+	fake.evaluateFlagReturnsOnCall[i] = struct {
+		result1 model.FlagEvaluation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetFlagByID(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (model.FeatureFlag, error) {
+	fake.getFlagByIDMutex.Lock()
+	ret, specificReturn := fake.getFlagByIDReturnsOnCall[len(fake.getFlagByIDArgsForCall)]
+	fake.getFlagByIDArgsForCall = append(fake.getFlagByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetFlagByIDStub
+	fakeReturns := fake.getFlagByIDReturns
+	fake.recordInvocation("GetFlagByID", []interface{}{arg1, arg2, arg3})
+	fake.getFlagByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) GetFlagByIDCallCount() int {
+	fake.getFlagByIDMutex.RLock()
+	defer fake.getFlagByIDMutex.RUnlock()
+	return len(fake.getFlagByIDArgsForCall)
+}
+
+func (fake *FakeService) GetFlagByIDCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (model.FeatureFlag, error)) {
+	fake.getFlagByIDMutex.Lock()
+	defer fake.getFlagByIDMutex.Unlock()
+	fake.GetFlagByIDStub = stub
+}
+
+func (fake *FakeService) GetFlagByIDArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getFlagByIDMutex.RLock()
+	defer fake.getFlagByIDMutex.RUnlock()
+	argsForCall := fake.getFlagByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) GetFlagByIDReturns(result1 model.FeatureFlag, result2 error) {
+	fake.getFlagByIDMutex.Lock()
+	defer fake.getFlagByIDMutex.Unlock()
+	fake.GetFlagByIDStub = nil
+	fake.getFlagByIDReturns = struct {
+		result1 model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetFlagByIDReturnsOnCall(i int, result1 model.FeatureFlag, result2 error) {
+	fake.getFlagByIDMutex.Lock()
+	defer fake.getFlagByIDMutex.Unlock()
+	fake.GetFlagByIDStub = nil
+	if fake.getFlagByIDReturnsOnCall == nil {
+		fake.getFlagByIDReturnsOnCall = make(map[int]struct {
+			result1 model.FeatureFlag
+			result2 error
+		})
+	}
+	fake.getFlagByIDReturnsOnCall[i] = struct {
+		result1 model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetKillSwitchStatus(arg1 context.Context, arg2 uuid.UUID) (model.KillSwitchStatus, error) {
+	fake.getKillSwitchStatusMutex.Lock()
+	ret, specificReturn := fake.getKillSwitchStatusReturnsOnCall[len(fake.getKillSwitchStatusArgsForCall)]
+	fake.getKillSwitchStatusArgsForCall = append(fake.getKillSwitchStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetKillSwitchStatusStub
+	fakeReturns := fake.getKillSwitchStatusReturns
+	fake.recordInvocation("GetKillSwitchStatus", []interface{}{arg1, arg2})
+	fake.getKillSwitchStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) GetKillSwitchStatusCallCount() int {
+	fake.getKillSwitchStatusMutex.RLock()
+	defer fake.getKillSwitchStatusMutex.RUnlock()
+	return len(fake.getKillSwitchStatusArgsForCall)
+}
+
+func (fake *FakeService) GetKillSwitchStatusCalls(stub func(context.Context, uuid.UUID) (model.KillSwitchStatus, error)) {
+	fake.getKillSwitchStatusMutex.Lock()
+	defer fake.getKillSwitchStatusMutex.Unlock()
+	fake.GetKillSwitchStatusStub = stub
+}
+
+func (fake *FakeService) GetKillSwitchStatusArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getKillSwitchStatusMutex.RLock()
+	defer fake.getKillSwitchStatusMutex.RUnlock()
+	argsForCall := fake.getKillSwitchStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) GetKillSwitchStatusReturns(result1 model.KillSwitchStatus, result2 error) {
+	fake.getKillSwitchStatusMutex.Lock()
+	defer fake.getKillSwitchStatusMutex.Unlock()
+	fake.GetKillSwitchStatusStub = nil
+	fake.getKillSwitchStatusReturns = struct {
+		result1 model.KillSwitchStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetKillSwitchStatusReturnsOnCall(i int, result1 model.KillSwitchStatus, result2 error) {
+	fake.getKillSwitchStatusMutex.Lock()
+	defer fake.getKillSwitchStatusMutex.Unlock()
+	fake.GetKillSwitchStatusStub = nil
+	if fake.getKillSwitchStatusReturnsOnCall == nil {
+		fake.getKillSwitchStatusReturnsOnCall = make(map[int]struct {
+			result1 model.KillSwitchStatus
+			result2 error
+		})
+	}
+	fake.getKillSwitchStatusReturnsOnCall[i] = struct {
+		result1 model.KillSwitchStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListFlags(arg1 context.Context, arg2 uuid.UUID, arg3 model.FlagFilter) ([]model.FeatureFlag, error) {
+	fake.listFlagsMutex.Lock()
+	ret, specificReturn := fake.listFlagsReturnsOnCall[len(fake.listFlagsArgsForCall)]
+	fake.listFlagsArgsForCall = append(fake.listFlagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.FlagFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListFlagsStub
+	fakeReturns := fake.listFlagsReturns
+	fake.recordInvocation("ListFlags", []interface{}{arg1, arg2, arg3})
+	fake.listFlagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListFlagsCallCount() int {
+	fake.listFlagsMutex.RLock()
+	defer fake.listFlagsMutex.RUnlock()
+	return len(fake.listFlagsArgsForCall)
+}
+
+func (fake *FakeService) ListFlagsCalls(stub func(context.Context, uuid.UUID, model.FlagFilter) ([]model.FeatureFlag, error)) {
+	fake.listFlagsMutex.Lock()
+	defer fake.listFlagsMutex.Unlock()
+	fake.ListFlagsStub = stub
+}
+
+func (fake *FakeService) ListFlagsArgsForCall(i int) (context.Context, uuid.UUID, model.FlagFilter) {
+	fake.listFlagsMutex.RLock()
+	defer fake.listFlagsMutex.RUnlock()
+	argsForCall := fake.listFlagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) ListFlagsReturns(result1 []model.FeatureFlag, result2 error) {
+	fake.listFlagsMutex.Lock()
+	defer fake.listFlagsMutex.Unlock()
+	fake.ListFlagsStub = nil
+	fake.listFlagsReturns = struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListFlagsReturnsOnCall(i int, result1 []model.FeatureFlag, result2 error) {
+	fake.listFlagsMutex.Lock()
+	defer fake.listFlagsMutex.Unlock()
+	fake.ListFlagsStub = nil
+	if fake.listFlagsReturnsOnCall == nil {
+		fake.listFlagsReturnsOnCall = make(map[int]struct {
+			result1 []model.FeatureFlag
+			result2 error
+		})
+	}
+	fake.listFlagsReturnsOnCall[i] = struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListUserGroupIDs(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]uuid.UUID, error) {
+	fake.listUserGroupIDsMutex.Lock()
+	ret, specificReturn := fake.listUserGroupIDsReturnsOnCall[len(fake.listUserGroupIDsArgsForCall)]
+	fake.listUserGroupIDsArgsForCall = append(fake.listUserGroupIDsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListUserGroupIDsStub
+	fakeReturns := fake.listUserGroupIDsReturns
+	fake.recordInvocation("ListUserGroupIDs", []interface{}{arg1, arg2, arg3})
+	fake.listUserGroupIDsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListUserGroupIDsCallCount() int {
+	fake.listUserGroupIDsMutex.RLock()
+	defer fake.listUserGroupIDsMutex.RUnlock()
+	return len(fake.listUserGroupIDsArgsForCall)
+}
+
+func (fake *FakeService) ListUserGroupIDsCalls(stub func(context.Context, uuid.UUID, uuid.UUID) ([]uuid.UUID, error)) {
+	fake.listUserGroupIDsMutex.Lock()
+	defer fake.listUserGroupIDsMutex.Unlock()
+	fake.ListUserGroupIDsStub = stub
+}
+
+func (fake *FakeService) ListUserGroupIDsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.listUserGroupIDsMutex.RLock()
+	defer fake.listUserGroupIDsMutex.RUnlock()
+	argsForCall := fake.listUserGroupIDsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) ListUserGroupIDsReturns(result1 []uuid.UUID, result2 error) {
+	fake.listUserGroupIDsMutex.Lock()
+	defer fake.listUserGroupIDsMutex.Unlock()
+	fake.ListUserGroupIDsStub = nil
+	fake.listUserGroupIDsReturns = struct {
+		result1 []uuid.UUID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListUserGroupIDsReturnsOnCall(i int, result1 []uuid.UUID, result2 error) {
+	fake.listUserGroupIDsMutex.Lock()
+	defer fake.listUserGroupIDsMutex.Unlock()
+	fake.ListUserGroupIDsStub = nil
+	if fake.listUserGroupIDsReturnsOnCall == nil {
+		fake.listUserGroupIDsReturnsOnCall = make(map[int]struct {
+			result1 []uuid.UUID
+			result2 error
+		})
+	}
+	fake.listUserGroupIDsReturnsOnCall[i] = struct {
+		result1 []uuid.UUID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) UpdateFlag(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 model.FeatureFlagRequest) error {
+	fake.updateFlagMutex.Lock()
+	ret, specificReturn := fake.updateFlagReturnsOnCall[len(fake.updateFlagArgsForCall)]
+	fake.updateFlagArgsForCall = append(fake.updateFlagArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.FeatureFlagRequest
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateFlagStub
+	fakeReturns := fake.updateFlagReturns
+	fake.recordInvocation("UpdateFlag", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateFlagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) UpdateFlagCallCount() int {
+	fake.updateFlagMutex.RLock()
+	defer fake.updateFlagMutex.RUnlock()
+	return len(fake.updateFlagArgsForCall)
+}
+
+func (fake *FakeService) UpdateFlagCalls(stub func(context.Context, uuid.UUID, uuid.UUID, model.FeatureFlagRequest) error) {
+	fake.updateFlagMutex.Lock()
+	defer fake.updateFlagMutex.Unlock()
+	fake.UpdateFlagStub = stub
+}
+
+func (fake *FakeService) UpdateFlagArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, model.FeatureFlagRequest) {
+	fake.updateFlagMutex.RLock()
+	defer fake.updateFlagMutex.RUnlock()
+	argsForCall := fake.updateFlagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) UpdateFlagReturns(result1 error) {
+	fake.updateFlagMutex.Lock()
+	defer fake.updateFlagMutex.Unlock()
+	fake.UpdateFlagStub = nil
+	fake.updateFlagReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) UpdateFlagReturnsOnCall(i int, result1 error) {
+	fake.updateFlagMutex.Lock()
+	defer fake.updateFlagMutex.Unlock()
+	fake.UpdateFlagStub = nil
+	if fake.updateFlagReturnsOnCall == nil {
+		fake.updateFlagReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateFlagReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) WatchFlags(arg1 context.Context, arg2 uuid.UUID) (<-chan model.FlagChange, error) {
+	fake.watchFlagsMutex.Lock()
+	ret, specificReturn := fake.watchFlagsReturnsOnCall[len(fake.watchFlagsArgsForCall)]
+	fake.watchFlagsArgsForCall = append(fake.watchFlagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.WatchFlagsStub
+	fakeReturns := fake.watchFlagsReturns
+	fake.recordInvocation("WatchFlags", []interface{}{arg1, arg2})
+	fake.watchFlagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) WatchFlagsCallCount() int {
+	fake.watchFlagsMutex.RLock()
+	defer fake.watchFlagsMutex.RUnlock()
+	return len(fake.watchFlagsArgsForCall)
+}
+
+func (fake *FakeService) WatchFlagsCalls(stub func(context.Context, uuid.UUID) (<-chan model.FlagChange, error)) {
+	fake.watchFlagsMutex.Lock()
+	defer fake.watchFlagsMutex.Unlock()
+	fake.WatchFlagsStub = stub
+}
+
+func (fake *FakeService) WatchFlagsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.watchFlagsMutex.RLock()
+	defer fake.watchFlagsMutex.RUnlock()
+	argsForCall := fake.watchFlagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) WatchFlagsReturns(result1 <-chan model.FlagChange, result2 error) {
+	fake.watchFlagsMutex.Lock()
+	defer fake.watchFlagsMutex.Unlock()
+	fake.WatchFlagsStub = nil
+	fake.watchFlagsReturns = struct {
+		result1 <-chan model.FlagChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) WatchFlagsReturnsOnCall(i int, result1 <-chan model.FlagChange, result2 error) {
+	fake.watchFlagsMutex.Lock()
+	defer fake.watchFlagsMutex.Unlock()
+	fake.WatchFlagsStub = nil
+	if fake.watchFlagsReturnsOnCall == nil {
+		fake.watchFlagsReturnsOnCall = make(map[int]struct {
+			result1 <-chan model.FlagChange
+			result2 error
+		})
+	}
+	fake.watchFlagsReturnsOnCall[i] = struct {
+		result1 <-chan model.FlagChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ grpchandler.Service = new(FakeService)
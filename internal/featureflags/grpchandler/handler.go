@@ -0,0 +1,313 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	featureflagsv1 "github.com/georgisomnoev/feature-flag-api/internal/gen/featureflags/v1"
+	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate . AuthStore
+type AuthStore interface {
+	UserExists(context.Context, uuid.UUID) (bool, error)
+	IsOrgMember(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+}
+
+//counterfeiter:generate . Denylist
+type Denylist interface {
+	IsRevoked(jwt.MapClaims) bool
+}
+
+//counterfeiter:generate . JWTHelper
+type JWTHelper interface {
+	ValidateToken(string) (jwt.MapClaims, error)
+}
+
+//counterfeiter:generate . APIKeyAuthenticator
+type APIKeyAuthenticator interface {
+	// Authenticate returns the claims the API key stands for, in the form of access token claims.
+	Authenticate(context.Context, string) (jwt.MapClaims, error)
+}
+
+//go:generate gowrap gen -g -p ./ -i Service -t ../../observability/templates/otel_trace.tmpl -o ./wrapped/trace/service.go
+//counterfeiter:generate . Service
+type Service interface {
+	ListFlags(context.Context, uuid.UUID, model.FlagFilter) ([]model.FeatureFlag, error)
+	GetFlagByID(context.Context, uuid.UUID, uuid.UUID) (model.FeatureFlag, error)
+
+	CreateFlag(context.Context, uuid.UUID, model.FeatureFlagRequest) (uuid.UUID, error)
+	UpdateFlag(context.Context, uuid.UUID, uuid.UUID, model.FeatureFlagRequest) error
+	DeleteFlag(context.Context, uuid.UUID, uuid.UUID) error
+
+	EvaluateFlag(context.Context, uuid.UUID, string) (model.FlagEvaluation, error)
+	GetKillSwitchStatus(context.Context, uuid.UUID) (model.KillSwitchStatus, error)
+	WatchFlags(context.Context, uuid.UUID) (<-chan model.FlagChange, error)
+	ListUserGroupIDs(context.Context, uuid.UUID, uuid.UUID) ([]uuid.UUID, error)
+}
+
+// Registrar is the gRPC server the handler registers its service and interceptors on.
+type Registrar interface {
+	grpc.ServiceRegistrar
+	UseUnary(...grpc.UnaryServerInterceptor)
+	UseStream(...grpc.StreamServerInterceptor)
+}
+
+type Handler struct {
+	featureflagsv1.UnimplementedFeatureFlagServiceServer
+
+	svc       Service
+	auth      *authn.Authenticator
+	validator *validator.CustomValidator
+}
+
+// NewHandler returns the handler of the gRPC API, which takes bearer tokens and the API keys of the
+// environment the API serves.
+func NewHandler(
+	svc Service,
+	authStore AuthStore,
+	denylist Denylist,
+	jwtHelper JWTHelper,
+	apiKeys APIKeyAuthenticator,
+	environment string,
+) *Handler {
+	return &Handler{
+		svc:       svc,
+		auth:      authn.NewAuthenticator(authStore, denylist, jwtHelper, apiKeys, environment),
+		validator: validator.GetValidator(),
+	}
+}
+
+func (h *Handler) RegisterHandlers(srv Registrar) {
+	srv.UseUnary(h.unaryAuthInterceptor)
+	srv.UseStream(h.streamAuthInterceptor)
+	featureflagsv1.RegisterFeatureFlagServiceServer(srv, h)
+}
+
+func (h *Handler) ListFlags(ctx context.Context, req *featureflagsv1.ListFlagsRequest) (*featureflagsv1.ListFlagsResponse, error) {
+	orgID, err := orgIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter := model.FlagFilter{
+		Owner:      req.GetOwner(),
+		Maintainer: req.GetMaintainer(),
+		Kind:       flagKindFromProto(req.GetKind()),
+		Tags:       req.GetTags(),
+		Metadata:   req.GetMetadata(),
+	}
+	if err := h.validator.Validate(&filter); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+
+	flags, err := h.svc.ListFlags(ctx, orgID, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &featureflagsv1.ListFlagsResponse{Flags: make([]*featureflagsv1.Flag, len(flags))}
+	for i, flag := range flags {
+		resp.Flags[i] = flagToProto(flag)
+	}
+	return resp, nil
+}
+
+func (h *Handler) GetFlag(ctx context.Context, req *featureflagsv1.GetFlagRequest) (*featureflagsv1.GetFlagResponse, error) {
+	flagID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid flag ID")
+	}
+	orgID, err := orgIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	flag, err := h.svc.GetFlagByID(ctx, orgID, flagID)
+	if err != nil {
+		return nil, flagError(err)
+	}
+
+	return &featureflagsv1.GetFlagResponse{Flag: flagToProto(flag)}, nil
+}
+
+func (h *Handler) CreateFlag(ctx context.Context, req *featureflagsv1.CreateFlagRequest) (*featureflagsv1.CreateFlagResponse, error) {
+	orgID, err := orgIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	flagReq, err := h.flagRequest(req.GetFlag())
+	if err != nil {
+		return nil, err
+	}
+
+	flagID, err := h.svc.CreateFlag(ctx, orgID, flagReq)
+	if err != nil {
+		return nil, flagError(err)
+	}
+
+	return &featureflagsv1.CreateFlagResponse{Id: flagID.String()}, nil
+}
+
+func (h *Handler) UpdateFlag(ctx context.Context, req *featureflagsv1.UpdateFlagRequest) (*featureflagsv1.UpdateFlagResponse, error) {
+	flagID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid flag ID")
+	}
+	flagReq, err := h.flagRequest(req.GetFlag())
+	if err != nil {
+		return nil, err
+	}
+
+	orgID, err := orgIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.svc.UpdateFlag(ctx, orgID, flagID, flagReq); err != nil {
+		return nil, flagError(err)
+	}
+
+	return &featureflagsv1.UpdateFlagResponse{}, nil
+}
+
+func (h *Handler) DeleteFlag(ctx context.Context, req *featureflagsv1.DeleteFlagRequest) (*featureflagsv1.DeleteFlagResponse, error) {
+	flagID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid flag ID")
+	}
+
+	orgID, err := orgIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.svc.DeleteFlag(ctx, orgID, flagID); err != nil {
+		return nil, flagError(err)
+	}
+
+	return &featureflagsv1.DeleteFlagResponse{}, nil
+}
+
+func (h *Handler) EvaluateFlag(
+	ctx context.Context,
+	req *featureflagsv1.EvaluateFlagRequest,
+) (*featureflagsv1.EvaluateFlagResponse, error) {
+	orgID, err := orgIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluation, err := h.svc.EvaluateFlag(ctx, orgID, req.GetKey())
+	if err != nil {
+		return nil, flagError(err)
+	}
+
+	return &featureflagsv1.EvaluateFlagResponse{
+		Key:    evaluation.Key,
+		Value:  evaluation.Value,
+		Reason: evaluationReasonToProto(evaluation.Reason),
+	}, nil
+}
+
+func (h *Handler) WatchFlags(
+	_ *featureflagsv1.WatchFlagsRequest,
+	stream grpc.ServerStreamingServer[featureflagsv1.WatchFlagsResponse],
+) error {
+	ctx := stream.Context()
+	orgID, err := orgIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Subscribe before taking the snapshot so that no change in between is missed.
+	changes, err := h.svc.WatchFlags(ctx, orgID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	flags, err := h.svc.ListFlags(ctx, orgID, model.FlagFilter{})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	for _, flag := range flags {
+		event := &featureflagsv1.WatchFlagsResponse{
+			Type: featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_SNAPSHOT,
+			Flag: flagToProto(flag),
+		}
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+
+	killSwitch, err := h.svc.GetKillSwitchStatus(ctx, orgID)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	complete := &featureflagsv1.WatchFlagsResponse{
+		Type:             featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_SNAPSHOT_COMPLETE,
+		KillSwitchActive: killSwitch.Active,
+	}
+	if killSwitch.KillSwitch != nil {
+		complete.KillSwitchTag = killSwitch.KillSwitch.Tag
+	}
+	if err := stream.Send(complete); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return status.Error(codes.Aborted, "watcher fell behind, reconnect to receive a new snapshot")
+			}
+			event := &featureflagsv1.WatchFlagsResponse{
+				Type:             flagEventTypeToProto(change.Type),
+				KillSwitchActive: change.KillSwitchActive,
+				KillSwitchTag:    change.KillSwitchTag,
+			}
+			if change.Type != model.FlagChangeKillSwitch {
+				event.Flag = flagToProto(change.Flag)
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (h *Handler) flagRequest(input *featureflagsv1.FlagInput) (model.FeatureFlagRequest, error) {
+	if input == nil {
+		return model.FeatureFlagRequest{}, status.Error(codes.InvalidArgument, "flag is required")
+	}
+
+	req := flagRequestFromProto(input)
+	if err := h.validator.Validate(&req); err != nil {
+		return model.FeatureFlagRequest{}, status.Errorf(codes.InvalidArgument, "missing required request fields: %v", err)
+	}
+	return req, nil
+}
+
+func flagError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		return status.Error(codes.NotFound, "feature flag not found")
+	case errors.Is(err, model.ErrFlagProtected), errors.Is(err, model.ErrFlagManaged):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrFlagAccessDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,555 @@
+package grpchandler_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/grpchandler"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/grpchandler/grpchandlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	featureflagsv1 "github.com/georgisomnoev/feature-flag-api/internal/gen/featureflags/v1"
+	"github.com/georgisomnoev/feature-flag-api/internal/webapi"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var ErrServiceError = errors.New("service error")
+
+var _ = Describe("Handler", func() {
+	var (
+		ctx       context.Context
+		authStore *grpchandlerfakes.FakeAuthStore
+		denylist  *grpchandlerfakes.FakeDenylist
+		jwtHelper *grpchandlerfakes.FakeJWTHelper
+		apiKeys   *grpchandlerfakes.FakeAPIKeyAuthenticator
+		svc       *grpchandlerfakes.FakeService
+		grpcSrv   *webapi.GRPCServer
+		conn      *grpc.ClientConn
+		client    featureflagsv1.FeatureFlagServiceClient
+
+		validUserID = "c9c15117-ca25-49c6-b857-3eb640a61234"
+		validOrgID  = "5a1de4a2-9f0c-4d8e-a4c7-0f5a3b2e7c11"
+		scopes      []string
+	)
+
+	BeforeEach(func() {
+		authStore = &grpchandlerfakes.FakeAuthStore{}
+		denylist = &grpchandlerfakes.FakeDenylist{}
+		jwtHelper = &grpchandlerfakes.FakeJWTHelper{}
+		apiKeys = &grpchandlerfakes.FakeAPIKeyAuthenticator{}
+		svc = &grpchandlerfakes.FakeService{}
+
+		grpcSrv = webapi.NewGRPCServer(slog.New(slog.DiscardHandler))
+		grpchandler.NewHandler(svc, authStore, denylist, jwtHelper, apiKeys, "production").RegisterHandlers(grpcSrv)
+
+		listener := bufconn.Listen(1024 * 1024)
+		go func() {
+			defer GinkgoRecover()
+			Expect(grpcSrv.Server().Serve(listener)).To(Succeed())
+		}()
+
+		var err error
+		conn, err = grpc.NewClient(
+			"passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		client = featureflagsv1.NewFeatureFlagServiceClient(conn)
+
+		scopes = []string{"read:flags", "write:flags"}
+		authStore.UserExistsReturns(true, nil)
+		authStore.IsOrgMemberReturns(true, nil)
+		svc.GetKillSwitchStatusReturns(model.KillSwitchStatus{Active: false}, nil)
+	})
+
+	JustBeforeEach(func() {
+		jwtHelper.ValidateTokenReturns(accessTokenClaims(jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": scopes}), nil)
+		ctx = metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer validToken")
+	})
+
+	AfterEach(func() {
+		Expect(conn.Close()).To(Succeed())
+		grpcSrv.Server().Stop()
+	})
+
+	Describe("authorization", func() {
+		It("rejects calls without a token", func() {
+			_, err := client.ListFlags(context.Background(), &featureflagsv1.ListFlagsRequest{})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+			Expect(svc.ListFlagsCallCount()).To(BeZero())
+		})
+
+		It("rejects invalid tokens", func() {
+			jwtHelper.ValidateTokenReturns(nil, errors.New("expired"))
+			_, err := client.ListFlags(metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer expired"),
+				&featureflagsv1.ListFlagsRequest{})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		Context("when the token was revoked", func() {
+			BeforeEach(func() {
+				denylist.IsRevokedReturns(true)
+			})
+
+			It("rejects the call", func() {
+				_, err := client.ListFlags(ctx, &featureflagsv1.ListFlagsRequest{})
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+				Expect(status.Convert(err).Message()).To(Equal("token has been revoked"))
+				Expect(svc.ListFlagsCallCount()).To(BeZero())
+			})
+		})
+
+		When("an API key is provided", func() {
+			var keyCtx context.Context
+
+			BeforeEach(func() {
+				apiKeys.AuthenticateReturns(jwt.MapClaims{
+					"sub":     validUserID,
+					"org":     validOrgID,
+					"scopes":  []string{"read:flags"},
+					"api_key": uuid.NewString(),
+					"env":     "production",
+				}, nil)
+				keyCtx = metadata.AppendToOutgoingContext(context.Background(), "x-api-key", "ffk_key")
+			})
+
+			It("authorizes the call with the claims of the key", func() {
+				_, err := client.ListFlags(keyCtx, &featureflagsv1.ListFlagsRequest{})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, key := apiKeys.AuthenticateArgsForCall(0)
+				Expect(key).To(Equal("ffk_key"))
+				Expect(jwtHelper.ValidateTokenCallCount()).To(BeZero())
+				_, orgID, _ := svc.ListFlagsArgsForCall(0)
+				Expect(orgID.String()).To(Equal(validOrgID))
+			})
+
+			It("enforces the scopes of the key", func() {
+				_, err := client.DeleteFlag(keyCtx, &featureflagsv1.DeleteFlagRequest{Id: uuid.NewString()})
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(svc.DeleteFlagCallCount()).To(BeZero())
+			})
+
+			It("rejects keys of another environment", func() {
+				apiKeys.AuthenticateReturns(jwt.MapClaims{
+					"sub":     validUserID,
+					"org":     validOrgID,
+					"scopes":  []string{"read:flags"},
+					"api_key": uuid.NewString(),
+					"env":     "staging",
+				}, nil)
+				_, err := client.ListFlags(keyCtx, &featureflagsv1.ListFlagsRequest{})
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(status.Convert(err).Message()).To(Equal("credentials are for another environment"))
+				Expect(svc.ListFlagsCallCount()).To(BeZero())
+			})
+
+			It("rejects callers that declare another environment", func() {
+				_, err := client.ListFlags(metadata.AppendToOutgoingContext(keyCtx, "x-environment", "staging"),
+					&featureflagsv1.ListFlagsRequest{})
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(svc.ListFlagsCallCount()).To(BeZero())
+			})
+
+			It("rejects invalid or revoked keys", func() {
+				apiKeys.AuthenticateReturns(nil, errors.New("invalid API key"))
+				_, err := client.ListFlags(keyCtx, &featureflagsv1.ListFlagsRequest{})
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+				Expect(status.Convert(err).Message()).To(Equal("invalid or revoked API key"))
+			})
+		})
+
+		Context("when the user no longer exists", func() {
+			BeforeEach(func() {
+				authStore.UserExistsReturns(false, nil)
+			})
+
+			It("rejects the call", func() {
+				_, err := client.ListFlags(ctx, &featureflagsv1.ListFlagsRequest{})
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+			})
+		})
+
+		Context("when the user is not a member of the organization", func() {
+			BeforeEach(func() {
+				authStore.IsOrgMemberReturns(false, nil)
+			})
+
+			It("rejects the call", func() {
+				_, err := client.ListFlags(ctx, &featureflagsv1.ListFlagsRequest{})
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(svc.ListFlagsCallCount()).To(BeZero())
+
+				_, orgID, userID := authStore.IsOrgMemberArgsForCall(0)
+				Expect(orgID.String()).To(Equal(validOrgID))
+				Expect(userID.String()).To(Equal(validUserID))
+			})
+		})
+
+		It("passes the groups of the caller to the service", func() {
+			groupID := uuid.New()
+			svc.ListUserGroupIDsReturns([]uuid.UUID{groupID}, nil)
+
+			_, err := client.ListFlags(ctx, &featureflagsv1.ListFlagsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, orgID, userID := svc.ListUserGroupIDsArgsForCall(0)
+			Expect(orgID.String()).To(Equal(validOrgID))
+			Expect(userID.String()).To(Equal(validUserID))
+
+			callCtx, _, _ := svc.ListFlagsArgsForCall(0)
+			principal, ok := model.PrincipalFromContext(callCtx)
+			Expect(ok).To(BeTrue())
+			Expect(principal.GroupIDs).To(Equal([]uuid.UUID{groupID}))
+			Expect(principal.Unrestricted).To(BeFalse())
+		})
+
+		Context("when the caller is a flag admin", func() {
+			BeforeEach(func() {
+				scopes = append(scopes, "admin:flags")
+			})
+
+			It("does not restrict the caller", func() {
+				_, err := client.ListFlags(ctx, &featureflagsv1.ListFlagsRequest{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(svc.ListUserGroupIDsCallCount()).To(BeZero())
+
+				callCtx, _, _ := svc.ListFlagsArgsForCall(0)
+				principal, ok := model.PrincipalFromContext(callCtx)
+				Expect(ok).To(BeTrue())
+				Expect(principal.Unrestricted).To(BeTrue())
+			})
+		})
+
+		Context("when the token lacks the required scope", func() {
+			BeforeEach(func() {
+				scopes = []string{"read:flags"}
+			})
+
+			It("rejects writes", func() {
+				_, err := client.DeleteFlag(ctx, &featureflagsv1.DeleteFlagRequest{Id: uuid.NewString()})
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(svc.DeleteFlagCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the token has no read scope", func() {
+			BeforeEach(func() {
+				scopes = []string{"write:flags"}
+			})
+
+			It("rejects watching with a stream error", func() {
+				stream, err := client.WatchFlags(ctx, &featureflagsv1.WatchFlagsRequest{})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = stream.Recv()
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			})
+		})
+
+		Context("when a kill switch is active", func() {
+			BeforeEach(func() {
+				svc.GetKillSwitchStatusReturns(model.KillSwitchStatus{Active: true}, nil)
+			})
+
+			It("freezes writes", func() {
+				_, err := client.DeleteFlag(ctx, &featureflagsv1.DeleteFlagRequest{Id: uuid.NewString()})
+				Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+				Expect(svc.DeleteFlagCallCount()).To(BeZero())
+			})
+
+			It("lets reads through", func() {
+				_, err := client.ListFlags(ctx, &featureflagsv1.ListFlagsRequest{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("and the caller is an admin", func() {
+				BeforeEach(func() {
+					scopes = append(scopes, "admin:flags")
+				})
+
+				It("lets writes through", func() {
+					_, err := client.DeleteFlag(ctx, &featureflagsv1.DeleteFlagRequest{Id: uuid.NewString()})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(svc.DeleteFlagCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	Describe("ListFlags", func() {
+		var flag model.FeatureFlag
+
+		BeforeEach(func() {
+			flag = model.FeatureFlag{ID: uuid.New(), Key: "dark_mode", Kind: model.FlagKindOps, OwnerType: model.OwnerTypeTeam}
+			svc.ListFlagsReturns([]model.FeatureFlag{flag}, nil)
+		})
+
+		It("returns the flags matching the filter", func() {
+			resp, err := client.ListFlags(ctx, &featureflagsv1.ListFlagsRequest{
+				Owner: "payments",
+				Kind:  featureflagsv1.FlagKind_FLAG_KIND_OPS,
+				Tags:  []string{"checkout"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetFlags()).To(HaveLen(1))
+			Expect(resp.GetFlags()[0].GetId()).To(Equal(flag.ID.String()))
+			Expect(resp.GetFlags()[0].GetKind()).To(Equal(featureflagsv1.FlagKind_FLAG_KIND_OPS))
+			Expect(resp.GetFlags()[0].GetOwnerType()).To(Equal(featureflagsv1.OwnerType_OWNER_TYPE_TEAM))
+
+			_, orgID, filter := svc.ListFlagsArgsForCall(0)
+			Expect(orgID.String()).To(Equal(validOrgID))
+			Expect(filter.Owner).To(Equal("payments"))
+			Expect(filter.Kind).To(Equal(model.FlagKindOps))
+			Expect(filter.Tags).To(Equal([]string{"checkout"}))
+		})
+
+		Context("when the service fails", func() {
+			BeforeEach(func() {
+				svc.ListFlagsReturns(nil, ErrServiceError)
+			})
+
+			It("returns an internal error", func() {
+				_, err := client.ListFlags(ctx, &featureflagsv1.ListFlagsRequest{})
+				Expect(status.Code(err)).To(Equal(codes.Internal))
+			})
+		})
+	})
+
+	Describe("GetFlag", func() {
+		It("rejects invalid IDs", func() {
+			_, err := client.GetFlag(ctx, &featureflagsv1.GetFlagRequest{Id: "invalid"})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		Context("when the flag does not exist", func() {
+			BeforeEach(func() {
+				svc.GetFlagByIDReturns(model.FeatureFlag{}, model.ErrNotFound)
+			})
+
+			It("returns not found", func() {
+				_, err := client.GetFlag(ctx, &featureflagsv1.GetFlagRequest{Id: uuid.NewString()})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Describe("CreateFlag", func() {
+		var flagID uuid.UUID
+
+		BeforeEach(func() {
+			flagID = uuid.New()
+			svc.CreateFlagReturns(flagID, nil)
+		})
+
+		It("creates the flag", func() {
+			resp, err := client.CreateFlag(ctx, &featureflagsv1.CreateFlagRequest{Flag: &featureflagsv1.FlagInput{
+				Key:         "new_checkout",
+				Description: "New checkout",
+				Enabled:     true,
+				Kind:        featureflagsv1.FlagKind_FLAG_KIND_EXPERIMENT,
+			}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetId()).To(Equal(flagID.String()))
+
+			_, orgID, req := svc.CreateFlagArgsForCall(0)
+			Expect(orgID.String()).To(Equal(validOrgID))
+			Expect(req.Key).To(Equal("new_checkout"))
+			Expect(req.Enabled).To(BeTrue())
+			Expect(req.Kind).To(Equal(model.FlagKindExperiment))
+		})
+
+		It("rejects invalid flags", func() {
+			_, err := client.CreateFlag(ctx, &featureflagsv1.CreateFlagRequest{Flag: &featureflagsv1.FlagInput{Key: "no_description"}})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(svc.CreateFlagCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("UpdateFlag", func() {
+		Context("when the flag is protected", func() {
+			BeforeEach(func() {
+				svc.UpdateFlagReturns(model.ErrFlagProtected)
+			})
+
+			It("returns a failed precondition", func() {
+				_, err := client.UpdateFlag(ctx, &featureflagsv1.UpdateFlagRequest{
+					Id:   uuid.NewString(),
+					Flag: &featureflagsv1.FlagInput{Key: "flag", Description: "description"},
+				})
+				Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			})
+		})
+
+		Context("when the caller has no write access to the flag", func() {
+			BeforeEach(func() {
+				svc.UpdateFlagReturns(model.ErrFlagAccessDenied)
+			})
+
+			It("returns permission denied", func() {
+				_, err := client.UpdateFlag(ctx, &featureflagsv1.UpdateFlagRequest{
+					Id:   uuid.NewString(),
+					Flag: &featureflagsv1.FlagInput{Key: "flag", Description: "description"},
+				})
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			})
+		})
+
+		Context("when the flag is managed by another tool", func() {
+			var req *featureflagsv1.UpdateFlagRequest
+
+			BeforeEach(func() {
+				svc.UpdateFlagReturns(model.ErrFlagManaged)
+				req = &featureflagsv1.UpdateFlagRequest{
+					Id:   uuid.NewString(),
+					Flag: &featureflagsv1.FlagInput{Key: "flag", Description: "description"},
+				}
+			})
+
+			It("rejects the change", func() {
+				_, err := client.UpdateFlag(ctx, req)
+				Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			})
+		})
+
+		It("does not let metadata mark the caller as the sync", func() {
+			_, err := client.UpdateFlag(metadata.AppendToOutgoingContext(ctx, "x-managed-by", model.ManagedByGit), &featureflagsv1.UpdateFlagRequest{
+				Id:   uuid.NewString(),
+				Flag: &featureflagsv1.FlagInput{Key: "flag", Description: "description"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			callCtx, _, _, _ := svc.UpdateFlagArgsForCall(0)
+			principal, _ := model.PrincipalFromContext(callCtx)
+			Expect(principal.Sync).To(BeFalse())
+		})
+
+		Context("when the token carries the sync scope", func() {
+			BeforeEach(func() {
+				scopes = append(scopes, model.SyncScope)
+			})
+
+			It("marks the caller as the sync", func() {
+				_, err := client.UpdateFlag(ctx, &featureflagsv1.UpdateFlagRequest{
+					Id:   uuid.NewString(),
+					Flag: &featureflagsv1.FlagInput{Key: "flag", Description: "description"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				callCtx, _, _, _ := svc.UpdateFlagArgsForCall(0)
+				principal, _ := model.PrincipalFromContext(callCtx)
+				Expect(principal.Sync).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("EvaluateFlag", func() {
+		BeforeEach(func() {
+			svc.EvaluateFlagReturns(model.FlagEvaluation{Key: "dark_mode", Value: false, Reason: model.EvaluationReasonKillSwitch}, nil)
+		})
+
+		It("returns the evaluation", func() {
+			resp, err := client.EvaluateFlag(ctx, &featureflagsv1.EvaluateFlagRequest{Key: "dark_mode"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetValue()).To(BeFalse())
+			Expect(resp.GetReason()).To(Equal(featureflagsv1.EvaluationReason_EVALUATION_REASON_KILL_SWITCH))
+		})
+	})
+
+	Describe("WatchFlags", func() {
+		var (
+			changes      chan model.FlagChange
+			existingFlag model.FeatureFlag
+		)
+
+		BeforeEach(func() {
+			changes = make(chan model.FlagChange, 1)
+			existingFlag = model.FeatureFlag{ID: uuid.New(), Key: "dark_mode"}
+			svc.WatchFlagsReturns(changes, nil)
+			svc.ListFlagsReturns([]model.FeatureFlag{existingFlag}, nil)
+		})
+
+		It("sends a snapshot followed by the changes", func() {
+			stream, err := client.WatchFlags(ctx, &featureflagsv1.WatchFlagsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+
+			event, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			_, watchedOrgID := svc.WatchFlagsArgsForCall(0)
+			Expect(watchedOrgID.String()).To(Equal(validOrgID))
+			Expect(event.GetType()).To(Equal(featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_SNAPSHOT))
+			Expect(event.GetFlag().GetKey()).To(Equal("dark_mode"))
+
+			event, err = stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.GetType()).To(Equal(featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_SNAPSHOT_COMPLETE))
+			Expect(event.GetKillSwitchActive()).To(BeFalse())
+
+			changes <- model.FlagChange{Type: model.FlagChangeDeleted, Flag: existingFlag}
+			event, err = stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.GetType()).To(Equal(featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_DELETED))
+			Expect(event.GetFlag().GetId()).To(Equal(existingFlag.ID.String()))
+		})
+
+		It("sends kill switch changes without a flag", func() {
+			svc.GetKillSwitchStatusReturns(model.KillSwitchStatus{Active: true, KillSwitch: &model.KillSwitch{Tag: "payments"}}, nil)
+			stream, err := client.WatchFlags(ctx, &featureflagsv1.WatchFlagsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			event, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.GetKillSwitchActive()).To(BeTrue())
+			Expect(event.GetKillSwitchTag()).To(Equal("payments"))
+
+			changes <- model.FlagChange{Type: model.FlagChangeKillSwitch}
+			event, err = stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.GetType()).To(Equal(featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_KILL_SWITCH))
+			Expect(event.GetKillSwitchActive()).To(BeFalse())
+			Expect(event.GetKillSwitchTag()).To(BeEmpty())
+			Expect(event.GetFlag()).To(BeNil())
+		})
+
+		It("aborts the stream when the watcher falls behind", func() {
+			stream, err := client.WatchFlags(ctx, &featureflagsv1.WatchFlagsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			for range 2 {
+				_, err = stream.Recv()
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			close(changes)
+			_, err = stream.Recv()
+			Expect(status.Code(err)).To(Equal(codes.Aborted))
+		})
+
+		Context("when the snapshot cannot be listed", func() {
+			BeforeEach(func() {
+				svc.ListFlagsReturns(nil, ErrServiceError)
+			})
+
+			It("ends the stream with an internal error", func() {
+				stream, err := client.WatchFlags(ctx, &featureflagsv1.WatchFlagsRequest{})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = stream.Recv()
+				Expect(err).ToNot(Equal(io.EOF))
+				Expect(status.Code(err)).To(Equal(codes.Internal))
+			})
+		})
+	})
+})
@@ -0,0 +1,157 @@
+package grpchandler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	featureflagsv1 "github.com/georgisomnoev/feature-flag-api/internal/gen/featureflags/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+	// apiKeyMetadataKey carries the API key of services calling instead of a bearer token.
+	apiKeyMetadataKey = "x-api-key"
+	// environmentMetadataKey carries the environment the caller serves, see authn.Credentials.
+	environmentMetadataKey = "x-environment"
+)
+
+type methodPolicy struct {
+	scope string
+	// frozen methods are rejected while a kill switch is active, unless the caller is an admin.
+	frozen bool
+}
+
+var methodPolicies = map[string]methodPolicy{
+	featureflagsv1.FeatureFlagService_ListFlags_FullMethodName:    {scope: authn.ReadFlagsScope},
+	featureflagsv1.FeatureFlagService_GetFlag_FullMethodName:      {scope: authn.ReadFlagsScope},
+	featureflagsv1.FeatureFlagService_CreateFlag_FullMethodName:   {scope: authn.WriteFlagsScope, frozen: true},
+	featureflagsv1.FeatureFlagService_UpdateFlag_FullMethodName:   {scope: authn.WriteFlagsScope, frozen: true},
+	featureflagsv1.FeatureFlagService_DeleteFlag_FullMethodName:   {scope: authn.WriteFlagsScope, frozen: true},
+	featureflagsv1.FeatureFlagService_EvaluateFlag_FullMethodName: {scope: authn.ReadFlagsScope},
+	featureflagsv1.FeatureFlagService_WatchFlags_FullMethodName:   {scope: authn.ReadFlagsScope},
+}
+
+func (h *Handler) unaryAuthInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	ctx, err := h.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (h *Handler) streamAuthInterceptor(
+	srv any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := h.authorize(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authorizedStream{ServerStream: stream, ctx: ctx})
+}
+
+// authorizedStream carries the context returned by authorize into stream handlers.
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorize validates the API key or bearer token of the call against the scope the method requires and
+// returns the context with the organization of the token and the caller. Methods of other services
+// are let through.
+func (h *Handler) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	if !strings.HasPrefix(fullMethod, "/"+featureflagsv1.FeatureFlagService_ServiceDesc.ServiceName+"/") {
+		return ctx, nil
+	}
+	policy, ok := methodPolicies[fullMethod]
+	if !ok {
+		return nil, status.Error(codes.Internal, "required scope not set")
+	}
+
+	caller, err := h.auth.Authenticate(ctx, callCredentials(ctx), policy.scope)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	if policy.frozen && !caller.HasScope(authn.AdminFlagsScope) {
+		killSwitch, err := h.svc.GetKillSwitchStatus(ctx, caller.OrgID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if killSwitch.Active {
+			return nil, status.Error(codes.FailedPrecondition, model.ErrWritesFrozen.Error())
+		}
+	}
+
+	// The groups of the caller are loaded once per call, the service checks the flag access rules with them.
+	principal := model.Principal{
+		UserID:       caller.UserID,
+		Unrestricted: caller.HasScope(authn.AdminFlagsScope),
+		Sync:         caller.HasScope(model.SyncScope),
+	}
+	if !principal.Unrestricted {
+		principal.GroupIDs, err = h.svc.ListUserGroupIDs(ctx, caller.OrgID, caller.UserID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	ctx = model.ContextWithPrincipal(ctx, principal)
+
+	return context.WithValue(ctx, orgIDKey{}, caller.OrgID), nil
+}
+
+// callCredentials returns the API key, the bearer token and the environment in the metadata of the call.
+func callCredentials(ctx context.Context) authn.Credentials {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var credentials authn.Credentials
+	if keys := md.Get(apiKeyMetadataKey); len(keys) > 0 {
+		credentials.APIKey = keys[0]
+	}
+	if values := md.Get(authorizationMetadataKey); len(values) > 0 {
+		credentials.BearerToken = strings.TrimPrefix(values[0], "Bearer ")
+	}
+	if values := md.Get(environmentMetadataKey); len(values) > 0 {
+		credentials.Environment = values[0]
+	}
+	return credentials
+}
+
+func statusError(err error) error {
+	switch {
+	case authn.IsUnauthenticated(err):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case authn.IsPermissionDenied(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+type orgIDKey struct{}
+
+// orgIDFromContext returns the organization authorize stored in the context.
+func orgIDFromContext(ctx context.Context) (uuid.UUID, error) {
+	orgID, ok := ctx.Value(orgIDKey{}).(uuid.UUID)
+	if !ok || orgID == uuid.Nil {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "invalid organization in token")
+	}
+	return orgID, nil
+}
@@ -0,0 +1,188 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../../../../observability/templates/otel_trace.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package trace
+
+import (
+	"context"
+
+	_sourceGrpchandler "github.com/georgisomnoev/feature-flag-api/internal/featureflags/grpchandler"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+
+	_codes "go.opentelemetry.io/otel/codes"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ServiceWithTracing implements Service interface instrumented with open telemetry spans
+type ServiceWithTracing struct {
+	_sourceGrpchandler.Service
+	tracer trace.Tracer
+}
+
+// NewServiceWithTracing returns ServiceWithTracing
+func NewServiceWithTracing(base _sourceGrpchandler.Service) ServiceWithTracing {
+	d := ServiceWithTracing{
+		Service: base,
+		tracer:  otel.GetTracerProvider().Tracer(""),
+	}
+
+	return d
+}
+
+// CreateFlag implements Service
+func (_d ServiceWithTracing) CreateFlag(ctx context.Context, u1 uuid.UUID, f1 model.FeatureFlagRequest) (u2 uuid.UUID, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.CreateFlag")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.CreateFlag(ctx, u1, f1)
+}
+
+// DeleteFlag implements Service
+func (_d ServiceWithTracing) DeleteFlag(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.DeleteFlag")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.DeleteFlag(ctx, u1, u2)
+}
+
+// EvaluateFlag implements Service
+func (_d ServiceWithTracing) EvaluateFlag(ctx context.Context, u1 uuid.UUID, s1 string) (f1 model.FlagEvaluation, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.EvaluateFlag")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.EvaluateFlag(ctx, u1, s1)
+}
+
+// GetFlagByID implements Service
+func (_d ServiceWithTracing) GetFlagByID(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (f1 model.FeatureFlag, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.GetFlagByID")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.GetFlagByID(ctx, u1, u2)
+}
+
+// GetKillSwitchStatus implements Service
+func (_d ServiceWithTracing) GetKillSwitchStatus(ctx context.Context, u1 uuid.UUID) (k1 model.KillSwitchStatus, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.GetKillSwitchStatus")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.GetKillSwitchStatus(ctx, u1)
+}
+
+// ListFlags implements Service
+func (_d ServiceWithTracing) ListFlags(ctx context.Context, u1 uuid.UUID, f1 model.FlagFilter) (fa1 []model.FeatureFlag, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListFlags")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListFlags(ctx, u1, f1)
+}
+
+// ListUserGroupIDs implements Service
+func (_d ServiceWithTracing) ListUserGroupIDs(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (ua1 []uuid.UUID, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListUserGroupIDs")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListUserGroupIDs(ctx, u1, u2)
+}
+
+// UpdateFlag implements Service
+func (_d ServiceWithTracing) UpdateFlag(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, f1 model.FeatureFlagRequest) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.UpdateFlag")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.UpdateFlag(ctx, u1, u2, f1)
+}
+
+// WatchFlags implements Service
+func (_d ServiceWithTracing) WatchFlags(ctx context.Context, u1 uuid.UUID) (ch1 <-chan model.FlagChange, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.WatchFlags")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.WatchFlags(ctx, u1)
+}
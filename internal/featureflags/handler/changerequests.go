@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handler) createChangeRequest(c echo.Context) error {
+	flagID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid flag ID")
+	}
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.ChangeRequestRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
+	}
+
+	changeRequestID, err := h.svc.CreateChangeRequest(c.Request().Context(), orgID, flagID, userID, req)
+	if err != nil {
+		return changeRequestError(err)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"id": changeRequestID,
+	})
+}
+
+func (h *Handler) listChangeRequests(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	status := model.ChangeRequestStatus(c.QueryParam("status"))
+
+	changeRequests, err := h.svc.ListChangeRequests(c.Request().Context(), orgID, status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, changeRequests)
+}
+
+func (h *Handler) getChangeRequest(c echo.Context) error {
+	changeRequestID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid change request ID")
+	}
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	changeRequest, err := h.svc.GetChangeRequest(c.Request().Context(), orgID, changeRequestID)
+	if err != nil {
+		return changeRequestError(err)
+	}
+
+	return c.JSON(http.StatusOK, changeRequest)
+}
+
+func (h *Handler) approveChangeRequest(c echo.Context) error {
+	return h.reviewChangeRequest(c, h.svc.ApproveChangeRequest)
+}
+
+func (h *Handler) rejectChangeRequest(c echo.Context) error {
+	return h.reviewChangeRequest(c, h.svc.RejectChangeRequest)
+}
+
+func (h *Handler) reviewChangeRequest(
+	c echo.Context,
+	review func(ctx context.Context, orgID, id uuid.UUID, reviewerID uuid.UUID, comment string) error,
+) error {
+	changeRequestID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid change request ID")
+	}
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.ReviewRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+
+	if err := review(c.Request().Context(), orgID, changeRequestID, userID, req.Comment); err != nil {
+		return changeRequestError(err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *Handler) cancelChangeRequest(c echo.Context) error {
+	changeRequestID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid change request ID")
+	}
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.CancelChangeRequest(c.Request().Context(), orgID, changeRequestID, userID); err != nil {
+		return changeRequestError(err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *Handler) applyChangeRequest(c echo.Context) error {
+	changeRequestID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid change request ID")
+	}
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.ApplyChangeRequest(c.Request().Context(), orgID, changeRequestID, userID); err != nil {
+		return changeRequestError(err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func changeRequestError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrNotFound), errors.Is(err, model.ErrChangeRequestNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, model.ErrSelfReview), errors.Is(err, model.ErrNotChangeRequestAuthor),
+		errors.Is(err, model.ErrFlagAccessDenied):
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	case errors.Is(err, model.ErrChangeRequestState), errors.Is(err, model.ErrChangeRequestScheduled),
+		errors.Is(err, model.ErrFlagManaged):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,257 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/handler/handlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/labstack/echo/v4"
+)
+
+var _ = Describe("Change Requests Handler", func() {
+	var (
+		e           *echo.Echo
+		recorder    *httptest.ResponseRecorder
+		authStore   *handlerfakes.FakeAuthStore
+		jwtHelper   *handlerfakes.FakeJWTHelper
+		svc         *handlerfakes.FakeService
+		flagHandler *handler.Handler
+		request     *http.Request
+
+		validUserID     = "c9c15117-ca25-49c6-b857-3eb640a61234"
+		validOrgID      = "5a1de4a2-9f0c-4d8e-a4c7-0f5a3b2e7c11"
+		changeRequestID = "4f2a7d53-36a3-4c1e-8f5f-3a0dd0f0b001"
+		scopes          []string
+	)
+
+	BeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
+		e.Validator = validator.GetValidator()
+		recorder = httptest.NewRecorder()
+		authStore = &handlerfakes.FakeAuthStore{}
+		jwtHelper = &handlerfakes.FakeJWTHelper{}
+		svc = &handlerfakes.FakeService{}
+		flagHandler = handler.NewHandler(svc, authStore, &handlerfakes.FakeDenylist{}, jwtHelper, &handlerfakes.FakeAPIKeyAuthenticator{}, "production")
+		flagHandler.RegisterHandlers(e)
+
+		scopes = []string{"read:flags", "write:flags", "review:flags"}
+		authStore.UserExistsReturns(true, nil)
+		authStore.IsOrgMemberReturns(true, nil)
+	})
+
+	JustBeforeEach(func() {
+		claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": scopes}
+		jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
+		request.Header.Set(echo.HeaderAuthorization, "Bearer validToken")
+		e.ServeHTTP(recorder, request)
+	})
+
+	Describe("POST /flags/:id/change-requests", func() {
+		var (
+			flagID  string
+			payload string
+		)
+
+		BeforeEach(func() {
+			flagID = "123e4567-e89b-12d3-a456-426655440000"
+			payload = `{"action":"update","flag":{"key":"flag","description":"desc","enabled":false},"comment":"disable it"}`
+			svc.CreateChangeRequestReturns(uuid.MustParse(changeRequestID), nil)
+
+			request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/flags/%s/change-requests", flagID), strings.NewReader(payload))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		})
+
+		It("creates the change request for the authenticated user", func() {
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+
+			var response map[string]string
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &response)).To(Succeed())
+			Expect(response).To(HaveKeyWithValue("id", changeRequestID))
+
+			Expect(svc.CreateChangeRequestCallCount()).To(Equal(1))
+			_, _, actualFlagID, actualAuthorID, actualReq := svc.CreateChangeRequestArgsForCall(0)
+			Expect(actualFlagID).To(Equal(uuid.MustParse(flagID)))
+			Expect(actualAuthorID).To(Equal(uuid.MustParse(validUserID)))
+			Expect(actualReq.Action).To(Equal(model.ChangeRequestActionUpdate))
+			Expect(actualReq.Flag.Enabled).To(BeFalse())
+		})
+
+		Context("when an update has no flag payload", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/flags/%s/change-requests", flagID), strings.NewReader(`{"action":"update"}`))
+				request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			})
+
+			It("returns a bad request error", func() {
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(svc.CreateChangeRequestCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the flag does not exist", func() {
+			BeforeEach(func() {
+				svc.CreateChangeRequestReturns(uuid.Nil, model.ErrNotFound)
+			})
+
+			It("returns a not found error", func() {
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the user cannot write flags", func() {
+			BeforeEach(func() {
+				scopes = []string{"read:flags"}
+			})
+
+			It("returns forbidden error", func() {
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("GET /change-requests", func() {
+		BeforeEach(func() {
+			svc.ListChangeRequestsReturns([]model.ChangeRequest{{
+				ID:     uuid.MustParse(changeRequestID),
+				Action: model.ChangeRequestActionDelete,
+				Status: model.ChangeRequestStatusPending,
+			}}, nil)
+			request = httptest.NewRequest(http.MethodGet, "/change-requests?status=pending", nil)
+		})
+
+		It("returns the change requests with the requested status", func() {
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(changeRequestID))
+			_, _, actualStatus := svc.ListChangeRequestsArgsForCall(0)
+			Expect(actualStatus).To(Equal(model.ChangeRequestStatusPending))
+		})
+	})
+
+	Describe("GET /change-requests/:id", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodGet, "/change-requests/"+changeRequestID, nil)
+		})
+
+		Context("when the change request does not exist", func() {
+			BeforeEach(func() {
+				svc.GetChangeRequestReturns(model.ChangeRequest{}, model.ErrChangeRequestNotFound)
+			})
+
+			It("returns a not found error", func() {
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("POST /change-requests/:id/approve", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/change-requests/%s/approve", changeRequestID), strings.NewReader(`{"comment":"lgtm"}`))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		})
+
+		It("approves the change request as the authenticated user", func() {
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(svc.ApproveChangeRequestCallCount()).To(Equal(1))
+			_, _, actualID, actualReviewerID, actualComment := svc.ApproveChangeRequestArgsForCall(0)
+			Expect(actualID).To(Equal(uuid.MustParse(changeRequestID)))
+			Expect(actualReviewerID).To(Equal(uuid.MustParse(validUserID)))
+			Expect(actualComment).To(Equal("lgtm"))
+		})
+
+		Context("when the reviewer is the author", func() {
+			BeforeEach(func() {
+				svc.ApproveChangeRequestReturns(model.ErrSelfReview)
+			})
+
+			It("returns forbidden error", func() {
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+				Expect(recorder.Body.String()).To(ContainSubstring(model.ErrSelfReview.Error()))
+			})
+		})
+
+		Context("when the change request was already reviewed", func() {
+			BeforeEach(func() {
+				svc.ApproveChangeRequestReturns(model.ErrChangeRequestState)
+			})
+
+			It("returns conflict error", func() {
+				Expect(recorder.Code).To(Equal(http.StatusConflict))
+			})
+		})
+
+		Context("when the user does not have the reviewer permission", func() {
+			BeforeEach(func() {
+				scopes = []string{"read:flags", "write:flags"}
+			})
+
+			It("returns forbidden error", func() {
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+				Expect(recorder.Body.String()).To(ContainSubstring("insufficient permissions"))
+				Expect(svc.ApproveChangeRequestCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("POST /change-requests/:id/reject", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/change-requests/%s/reject", changeRequestID), nil)
+		})
+
+		It("rejects the change request", func() {
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(svc.RejectChangeRequestCallCount()).To(Equal(1))
+		})
+	})
+
+	Describe("POST /change-requests/:id/cancel", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/change-requests/%s/cancel", changeRequestID), nil)
+		})
+
+		Context("when the user is not the author", func() {
+			BeforeEach(func() {
+				svc.CancelChangeRequestReturns(model.ErrNotChangeRequestAuthor)
+			})
+
+			It("returns forbidden error", func() {
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("POST /change-requests/:id/apply", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/change-requests/%s/apply", changeRequestID), nil)
+		})
+
+		It("applies the change request as the authenticated user", func() {
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			_, _, actualID, actualUserID := svc.ApplyChangeRequestArgsForCall(0)
+			Expect(actualID).To(Equal(uuid.MustParse(changeRequestID)))
+			Expect(actualUserID).To(Equal(uuid.MustParse(validUserID)))
+		})
+
+		Context("when the change request is scheduled for later", func() {
+			BeforeEach(func() {
+				svc.ApplyChangeRequestReturns(model.ErrChangeRequestScheduled)
+			})
+
+			It("returns conflict error", func() {
+				Expect(recorder.Code).To(Equal(http.StatusConflict))
+			})
+		})
+	})
+})
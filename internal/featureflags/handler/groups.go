@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handler) listGroups(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	groups, err := h.svc.ListGroups(c.Request().Context(), orgID)
+	if err != nil {
+		return groupError(err)
+	}
+
+	return c.JSON(http.StatusOK, groups)
+}
+
+func (h *Handler) createGroup(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	req, err := groupRequest(c)
+	if err != nil {
+		return err
+	}
+
+	group, err := h.svc.CreateGroup(c.Request().Context(), orgID, req)
+	if err != nil {
+		return groupError(err)
+	}
+
+	return c.JSON(http.StatusCreated, group)
+}
+
+func (h *Handler) getGroup(c echo.Context) error {
+	orgID, groupID, err := groupParams(c)
+	if err != nil {
+		return err
+	}
+
+	group, err := h.svc.GetGroup(c.Request().Context(), orgID, groupID)
+	if err != nil {
+		return groupError(err)
+	}
+
+	return c.JSON(http.StatusOK, group)
+}
+
+func (h *Handler) updateGroup(c echo.Context) error {
+	orgID, groupID, err := groupParams(c)
+	if err != nil {
+		return err
+	}
+	req, err := groupRequest(c)
+	if err != nil {
+		return err
+	}
+
+	group, err := h.svc.UpdateGroup(c.Request().Context(), orgID, groupID, req)
+	if err != nil {
+		return groupError(err)
+	}
+
+	return c.JSON(http.StatusOK, group)
+}
+
+func (h *Handler) deleteGroup(c echo.Context) error {
+	orgID, groupID, err := groupParams(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.DeleteGroup(c.Request().Context(), orgID, groupID); err != nil {
+		return groupError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) listGroupMembers(c echo.Context) error {
+	orgID, groupID, err := groupParams(c)
+	if err != nil {
+		return err
+	}
+
+	members, err := h.svc.ListGroupMembers(c.Request().Context(), orgID, groupID)
+	if err != nil {
+		return groupError(err)
+	}
+
+	return c.JSON(http.StatusOK, members)
+}
+
+// addGroupMember only accepts members of the organization, groups cannot grant access to outsiders.
+func (h *Handler) addGroupMember(c echo.Context) error {
+	orgID, groupID, memberID, err := groupMemberParams(c)
+	if err != nil {
+		return err
+	}
+
+	isMember, err := h.authStore.IsOrgMember(c.Request().Context(), orgID, memberID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if !isMember {
+		return echo.NewHTTPError(http.StatusNotFound, "user is not a member of the organization")
+	}
+
+	if err := h.svc.AddGroupMember(c.Request().Context(), orgID, groupID, memberID); err != nil {
+		return groupError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) removeGroupMember(c echo.Context) error {
+	orgID, groupID, memberID, err := groupMemberParams(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.RemoveGroupMember(c.Request().Context(), orgID, groupID, memberID); err != nil {
+		return groupError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) listAccessRules(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	rules, err := h.svc.ListAccessRules(c.Request().Context(), orgID)
+	if err != nil {
+		return groupError(err)
+	}
+
+	return c.JSON(http.StatusOK, rules)
+}
+
+func (h *Handler) createAccessRule(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.AccessRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid access rule: %w", err))
+	}
+
+	rule, err := h.svc.CreateAccessRule(c.Request().Context(), orgID, req)
+	if err != nil {
+		return groupError(err)
+	}
+
+	return c.JSON(http.StatusCreated, rule)
+}
+
+func (h *Handler) deleteAccessRule(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	ruleID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid access rule ID")
+	}
+
+	if err := h.svc.DeleteAccessRule(c.Request().Context(), orgID, ruleID); err != nil {
+		return groupError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func groupRequest(c echo.Context) (model.GroupRequest, error) {
+	var req model.GroupRequest
+	if err := c.Bind(&req); err != nil {
+		return model.GroupRequest{}, echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return model.GroupRequest{}, echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
+	}
+	return req, nil
+}
+
+func groupParams(c echo.Context) (uuid.UUID, uuid.UUID, error) {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	groupID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, echo.NewHTTPError(http.StatusBadRequest, "invalid group ID")
+	}
+	return orgID, groupID, nil
+}
+
+func groupMemberParams(c echo.Context) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	orgID, groupID, err := groupParams(c)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	memberID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, echo.NewHTTPError(http.StatusBadRequest, "invalid user ID")
+	}
+	return orgID, groupID, memberID, nil
+}
+
+func groupError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrGroupNotFound), errors.Is(err, model.ErrGroupMemberNotFound),
+		errors.Is(err, model.ErrAccessRuleNotFound), errors.Is(err, model.ErrNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, model.ErrGroupExists):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	default:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+}
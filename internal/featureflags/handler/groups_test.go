@@ -0,0 +1,237 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/handler/handlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/labstack/echo/v4"
+)
+
+var _ = Describe("Groups Handler", func() {
+	var (
+		e           *echo.Echo
+		recorder    *httptest.ResponseRecorder
+		authStore   *handlerfakes.FakeAuthStore
+		jwtHelper   *handlerfakes.FakeJWTHelper
+		svc         *handlerfakes.FakeService
+		flagHandler *handler.Handler
+		request     *http.Request
+
+		validUserID = "c9c15117-ca25-49c6-b857-3eb640a61234"
+		validOrgID  = "5a1de4a2-9f0c-4d8e-a4c7-0f5a3b2e7c11"
+		groupID     = uuid.MustParse("0f3cc8a1-54a4-4d8e-9b1e-2c6a9a1f7d42")
+		scopes      []string
+	)
+
+	BeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
+		e.Validator = validator.GetValidator()
+		recorder = httptest.NewRecorder()
+		authStore = &handlerfakes.FakeAuthStore{}
+		jwtHelper = &handlerfakes.FakeJWTHelper{}
+		svc = &handlerfakes.FakeService{}
+		flagHandler = handler.NewHandler(svc, authStore, &handlerfakes.FakeDenylist{}, jwtHelper, &handlerfakes.FakeAPIKeyAuthenticator{}, "production")
+		flagHandler.RegisterHandlers(e)
+
+		scopes = []string{"read:flags", "write:flags", "admin:flags"}
+		authStore.UserExistsReturns(true, nil)
+		authStore.IsOrgMemberReturns(true, nil)
+	})
+
+	JustBeforeEach(func() {
+		claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": scopes}
+		jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
+		request.Header.Set(echo.HeaderAuthorization, "Bearer validToken")
+		e.ServeHTTP(recorder, request)
+	})
+
+	Describe("the caller's groups", func() {
+		var memberGroupID uuid.UUID
+
+		BeforeEach(func() {
+			memberGroupID = uuid.New()
+			svc.ListUserGroupIDsReturns([]uuid.UUID{memberGroupID}, nil)
+			request = httptest.NewRequest(http.MethodGet, "/flags", nil)
+		})
+
+		principal := func() model.Principal {
+			Expect(svc.ListFlagsCallCount()).To(Equal(1))
+			ctx, _, _ := svc.ListFlagsArgsForCall(0)
+			principal, ok := model.PrincipalFromContext(ctx)
+			Expect(ok).To(BeTrue())
+			return principal
+		}
+
+		It("does not restrict flag admins", func() {
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(svc.ListUserGroupIDsCallCount()).To(BeZero())
+			Expect(principal().Unrestricted).To(BeTrue())
+		})
+
+		Context("when the token lacks the admin scope", func() {
+			BeforeEach(func() {
+				scopes = []string{"read:flags"}
+			})
+
+			It("passes the groups of the caller to the service", func() {
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				_, actualOrgID, actualUserID := svc.ListUserGroupIDsArgsForCall(0)
+				Expect(actualOrgID).To(Equal(uuid.MustParse(validOrgID)))
+				Expect(actualUserID).To(Equal(uuid.MustParse(validUserID)))
+				Expect(principal()).To(Equal(model.Principal{
+					UserID:   uuid.MustParse(validUserID),
+					GroupIDs: []uuid.UUID{memberGroupID},
+				}))
+			})
+
+			Context("and the groups cannot be loaded", func() {
+				BeforeEach(func() {
+					svc.ListUserGroupIDsReturns(nil, context.DeadlineExceeded)
+				})
+
+				It("returns 500", func() {
+					Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+					Expect(svc.ListFlagsCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+
+	Describe("PUT /flags/:id", func() {
+		BeforeEach(func() {
+			svc.UpdateFlagReturns(model.ErrFlagAccessDenied)
+			request = httptest.NewRequest(http.MethodPut, "/flags/"+uuid.NewString(),
+				strings.NewReader(`{"key":"flag","description":"description","enabled":true}`))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		})
+
+		It("returns 403 when the caller has no write access to the flag", func() {
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		})
+	})
+
+	Describe("POST /groups", func() {
+		BeforeEach(func() {
+			svc.CreateGroupReturns(model.Group{ID: groupID, Name: "payments"}, nil)
+			request = httptest.NewRequest(http.MethodPost, "/groups", strings.NewReader(`{"name":"payments"}`))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		})
+
+		It("creates the group in the organization of the token", func() {
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			_, actualOrgID, req := svc.CreateGroupArgsForCall(0)
+			Expect(actualOrgID).To(Equal(uuid.MustParse(validOrgID)))
+			Expect(req.Name).To(Equal("payments"))
+
+			var group model.Group
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &group)).To(Succeed())
+			Expect(group.ID).To(Equal(groupID))
+		})
+
+		Context("when the token lacks the admin scope", func() {
+			BeforeEach(func() {
+				scopes = []string{"read:flags", "write:flags"}
+			})
+
+			It("returns 403", func() {
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+				Expect(svc.CreateGroupCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the name is taken", func() {
+			BeforeEach(func() {
+				svc.CreateGroupReturns(model.Group{}, model.ErrGroupExists)
+			})
+
+			It("returns 409", func() {
+				Expect(recorder.Code).To(Equal(http.StatusConflict))
+			})
+		})
+	})
+
+	Describe("DELETE /groups/:id", func() {
+		BeforeEach(func() {
+			svc.DeleteGroupReturns(model.ErrGroupNotFound)
+			request = httptest.NewRequest(http.MethodDelete, "/groups/"+groupID.String(), nil)
+		})
+
+		It("returns 404 for unknown groups", func() {
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("PUT /groups/:id/members/:userID", func() {
+		var memberID uuid.UUID
+
+		BeforeEach(func() {
+			memberID = uuid.New()
+			request = httptest.NewRequest(http.MethodPut, "/groups/"+groupID.String()+"/members/"+memberID.String(), nil)
+		})
+
+		It("adds the user to the group", func() {
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			_, actualOrgID, actualGroupID, actualUserID := svc.AddGroupMemberArgsForCall(0)
+			Expect(actualOrgID).To(Equal(uuid.MustParse(validOrgID)))
+			Expect(actualGroupID).To(Equal(groupID))
+			Expect(actualUserID).To(Equal(memberID))
+		})
+
+		Context("when the user is not a member of the organization", func() {
+			BeforeEach(func() {
+				authStore.IsOrgMemberStub = func(_ context.Context, _, userID uuid.UUID) (bool, error) {
+					return userID != memberID, nil
+				}
+			})
+
+			It("returns 404", func() {
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+				Expect(svc.AddGroupMemberCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("POST /access-rules", func() {
+		BeforeEach(func() {
+			svc.CreateAccessRuleReturns(model.AccessRule{
+				ID: uuid.New(), GroupID: groupID, Tag: "payments", Permission: model.PermissionRead,
+			}, nil)
+			request = httptest.NewRequest(http.MethodPost, "/access-rules",
+				strings.NewReader(`{"group_id":"`+groupID.String()+`","tag":"payments","permission":"read"}`))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		})
+
+		It("creates the rule", func() {
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			_, _, req := svc.CreateAccessRuleArgsForCall(0)
+			Expect(req).To(Equal(model.AccessRuleRequest{GroupID: groupID, Tag: "payments", Permission: model.PermissionRead}))
+		})
+
+		Context("when both a flag and a tag are given", func() {
+			BeforeEach(func() {
+				request = httptest.NewRequest(http.MethodPost, "/access-rules",
+					strings.NewReader(`{"group_id":"`+groupID.String()+`","flag_id":"`+uuid.NewString()+`","tag":"payments","permission":"read"}`))
+				request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			})
+
+			It("returns 400", func() {
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(svc.CreateAccessRuleCallCount()).To(BeZero())
+			})
+		})
+	})
+})
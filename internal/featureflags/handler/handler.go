@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -18,6 +21,12 @@ import (
 //counterfeiter:generate . AuthStore
 type AuthStore interface {
 	UserExists(context.Context, uuid.UUID) (bool, error)
+	IsOrgMember(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+}
+
+//counterfeiter:generate . Denylist
+type Denylist interface {
+	IsRevoked(jwt.MapClaims) bool
 }
 
 //go:generate gowrap gen -g -p ./ -i JWTHelper -t ../../observability/templates/otel_trace.tmpl -o ./wrapped/trace/jwt_helper.go
@@ -27,58 +36,162 @@ type JWTHelper interface {
 	ValidateToken(string) (jwt.MapClaims, error)
 }
 
+//go:generate gowrap gen -g -p ./ -i APIKeyAuthenticator -t ../../observability/templates/otel_trace.tmpl -o ./wrapped/trace/api_key_authenticator.go
+//go:generate gowrap gen -g -p ./ -i APIKeyAuthenticator -t ../../observability/templates/otel_metric.tmpl -o ./wrapped/metric/api_key_authenticator.go
+//counterfeiter:generate . APIKeyAuthenticator
+type APIKeyAuthenticator interface {
+	// Authenticate returns the claims the API key stands for, in the form of access token claims.
+	Authenticate(context.Context, string) (jwt.MapClaims, error)
+}
+
 //go:generate gowrap gen -g -p ./ -i Service -t ../../observability/templates/otel_trace.tmpl -o ./wrapped/trace/service.go
 //counterfeiter:generate . Service
 type Service interface {
-	ListFlags(context.Context) ([]model.FeatureFlag, error)
-	GetFlagByID(context.Context, uuid.UUID) (model.FeatureFlag, error)
+	ListFlags(context.Context, uuid.UUID, model.FlagFilter) ([]model.FeatureFlag, error)
+	GetFlagByID(context.Context, uuid.UUID, uuid.UUID) (model.FeatureFlag, error)
+
+	CreateFlag(context.Context, uuid.UUID, model.FeatureFlagRequest) (uuid.UUID, error)
+	UpdateFlag(context.Context, uuid.UUID, uuid.UUID, model.FeatureFlagRequest) error
+	DeleteFlag(context.Context, uuid.UUID, uuid.UUID) error
+
+	GenerateReport(context.Context, uuid.UUID, int) (model.FlagReport, error)
+	ListOverdueFlags(context.Context, uuid.UUID, string) ([]model.OwnerOverdueFlags, error)
+
+	UploadCodeReferences(context.Context, uuid.UUID, uuid.UUID, model.CodeReferencesUpload) (int, error)
+	ListCodeReferences(context.Context, uuid.UUID, string) ([]model.CodeReference, error)
+
+	CreateChangeRequest(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, model.ChangeRequestRequest) (uuid.UUID, error)
+	ListChangeRequests(context.Context, uuid.UUID, model.ChangeRequestStatus) ([]model.ChangeRequest, error)
+	GetChangeRequest(context.Context, uuid.UUID, uuid.UUID) (model.ChangeRequest, error)
+	ApproveChangeRequest(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) error
+	RejectChangeRequest(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) error
+	CancelChangeRequest(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	ApplyChangeRequest(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+
+	EvaluateFlag(context.Context, uuid.UUID, string) (model.FlagEvaluation, error)
+	GetKillSwitchStatus(context.Context, uuid.UUID) (model.KillSwitchStatus, error)
+	ActivateKillSwitch(context.Context, uuid.UUID, uuid.UUID, model.KillSwitchRequest) (model.KillSwitch, error)
+	ReleaseKillSwitch(context.Context, uuid.UUID, uuid.UUID) error
+
+	ListGroups(context.Context, uuid.UUID) ([]model.Group, error)
+	GetGroup(context.Context, uuid.UUID, uuid.UUID) (model.Group, error)
+	CreateGroup(context.Context, uuid.UUID, model.GroupRequest) (model.Group, error)
+	UpdateGroup(context.Context, uuid.UUID, uuid.UUID, model.GroupRequest) (model.Group, error)
+	DeleteGroup(context.Context, uuid.UUID, uuid.UUID) error
+	ListGroupMembers(context.Context, uuid.UUID, uuid.UUID) ([]model.GroupMember, error)
+	AddGroupMember(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	RemoveGroupMember(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	ListUserGroupIDs(context.Context, uuid.UUID, uuid.UUID) ([]uuid.UUID, error)
 
-	CreateFlag(context.Context, model.FeatureFlagRequest) (uuid.UUID, error)
-	UpdateFlag(context.Context, uuid.UUID, model.FeatureFlagRequest) error
-	DeleteFlag(context.Context, uuid.UUID) error
+	ListAccessRules(context.Context, uuid.UUID) ([]model.AccessRule, error)
+	CreateAccessRule(context.Context, uuid.UUID, model.AccessRuleRequest) (model.AccessRule, error)
+	DeleteAccessRule(context.Context, uuid.UUID, uuid.UUID) error
 }
 
+const (
+	defaultStaleAfterDays = 30
+	metadataQueryPrefix   = "metadata."
+)
+
 type Handler struct {
 	svc       Service
 	authStore AuthStore
-	jwtHelper JWTHelper
+	auth      *authn.Authenticator
 }
 
-func NewHandler(svc Service, authStore AuthStore, jwtHelper JWTHelper) *Handler {
+// NewHandler returns the handler of the flag endpoints, which take bearer tokens and the API keys
+// of the environment the API serves.
+func NewHandler(
+	svc Service,
+	authStore AuthStore,
+	denylist Denylist,
+	jwtHelper JWTHelper,
+	apiKeys APIKeyAuthenticator,
+	environment string,
+) *Handler {
 	return &Handler{
 		svc:       svc,
 		authStore: authStore,
-		jwtHelper: jwtHelper,
+		auth:      authn.NewAuthenticator(authStore, denylist, jwtHelper, apiKeys, environment),
 	}
 }
 
-func (h *Handler) RegisterHandlers(srv *echo.Echo) {
-	authMiddleware := createAuthMiddleware(h.authStore, h.jwtHelper)
+// RegisterHandlers registers the routes of the handler. The middlewares run on every route after
+// the authentication.
+func (h *Handler) RegisterHandlers(srv *echo.Echo, middlewares ...echo.MiddlewareFunc) {
+	scopedGroup := func(prefix, scope string) *echo.Group {
+		group := srv.Group(prefix, h.auth.Middleware(scope))
+		group.Use(middlewares...)
+		group.Use(h.principalMiddleware)
+		return group
+	}
 
-	editorGroup := srv.Group("/flags")
-	editorGroup.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			c.Set("required_scope", "write:flags")
-			return authMiddleware(next)(c)
-		}
-	})
+	editorGroup := scopedGroup("/flags", authn.WriteFlagsScope)
+	editorGroup.Use(h.writeFreezeMiddleware)
 	editorGroup.POST("", h.createFlag)
 	editorGroup.PUT("/:id", h.updateFlag)
 	editorGroup.DELETE("/:id", h.deleteFlag)
+	editorGroup.POST("/:id/change-requests", h.createChangeRequest)
 
-	viewerGroup := srv.Group("/flags")
-	viewerGroup.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			c.Set("required_scope", "read:flags")
-			return authMiddleware(next)(c)
-		}
-	})
+	viewerGroup := scopedGroup("/flags", authn.ReadFlagsScope)
 	viewerGroup.GET("", h.listFlags)
+	viewerGroup.GET("/report", h.flagsReport)
+	viewerGroup.GET("/overdue", h.overdueFlags)
+	viewerGroup.GET("/evaluate/:key", h.evaluateFlag)
 	viewerGroup.GET("/:id", h.getFlagByID)
+
+	changeRequestEditorGroup := scopedGroup("/change-requests", authn.WriteFlagsScope)
+	changeRequestEditorGroup.Use(h.writeFreezeMiddleware)
+	changeRequestEditorGroup.POST("/:id/cancel", h.cancelChangeRequest)
+	changeRequestEditorGroup.POST("/:id/apply", h.applyChangeRequest)
+
+	changeRequestReviewerGroup := scopedGroup("/change-requests", authn.ReviewFlagsScope)
+	changeRequestReviewerGroup.Use(h.writeFreezeMiddleware)
+	changeRequestReviewerGroup.POST("/:id/approve", h.approveChangeRequest)
+	changeRequestReviewerGroup.POST("/:id/reject", h.rejectChangeRequest)
+
+	changeRequestViewerGroup := scopedGroup("/change-requests", authn.ReadFlagsScope)
+	changeRequestViewerGroup.GET("", h.listChangeRequests)
+	changeRequestViewerGroup.GET("/:id", h.getChangeRequest)
+
+	codeReferencesGroup := scopedGroup("/code-references", authn.WriteFlagsScope)
+	codeReferencesGroup.Use(h.writeFreezeMiddleware)
+	codeReferencesGroup.PUT("", h.uploadCodeReferences)
+
+	killSwitchAdminGroup := scopedGroup("/kill-switch", authn.AdminFlagsScope)
+	killSwitchAdminGroup.POST("", h.activateKillSwitch)
+	killSwitchAdminGroup.DELETE("", h.releaseKillSwitch)
+
+	killSwitchViewerGroup := scopedGroup("/kill-switch", authn.ReadFlagsScope)
+	killSwitchViewerGroup.GET("", h.getKillSwitch)
+
+	groupsAdminGroup := scopedGroup("/groups", authn.AdminFlagsScope)
+	groupsAdminGroup.GET("", h.listGroups)
+	groupsAdminGroup.POST("", h.createGroup)
+	groupsAdminGroup.GET("/:id", h.getGroup)
+	groupsAdminGroup.PUT("/:id", h.updateGroup)
+	groupsAdminGroup.DELETE("/:id", h.deleteGroup)
+	groupsAdminGroup.GET("/:id/members", h.listGroupMembers)
+	groupsAdminGroup.PUT("/:id/members/:userID", h.addGroupMember)
+	groupsAdminGroup.DELETE("/:id/members/:userID", h.removeGroupMember)
+
+	accessRulesAdminGroup := scopedGroup("/access-rules", authn.AdminFlagsScope)
+	accessRulesAdminGroup.GET("", h.listAccessRules)
+	accessRulesAdminGroup.POST("", h.createAccessRule)
+	accessRulesAdminGroup.DELETE("/:id", h.deleteAccessRule)
 }
 
 func (h *Handler) listFlags(c echo.Context) error {
-	flags, err := h.svc.ListFlags(c.Request().Context())
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	filter := flagFilterFromQuery(c)
+	if err := c.Validate(&filter); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid filter: %w", err))
+	}
+
+	flags, err := h.svc.ListFlags(c.Request().Context(), orgID, filter)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -86,13 +199,40 @@ func (h *Handler) listFlags(c echo.Context) error {
 	return c.JSON(http.StatusOK, flags)
 }
 
+// flagFilterFromQuery reads the list filter from the query string. Tags may be repeated and
+// metadata is matched with parameters like metadata.team=payments.
+func flagFilterFromQuery(c echo.Context) model.FlagFilter {
+	params := c.QueryParams()
+	filter := model.FlagFilter{
+		Owner:      params.Get("owner"),
+		Maintainer: params.Get("maintainer"),
+		Kind:       model.FlagKind(params.Get("kind")),
+		Tags:       params["tag"],
+	}
+	for name, values := range params {
+		key, ok := strings.CutPrefix(name, metadataQueryPrefix)
+		if !ok || len(values) == 0 {
+			continue
+		}
+		if filter.Metadata == nil {
+			filter.Metadata = map[string]string{}
+		}
+		filter.Metadata[key] = values[0]
+	}
+	return filter
+}
+
 func (h *Handler) getFlagByID(c echo.Context) error {
 	flagID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid flag ID")
 	}
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
 
-	flag, err := h.svc.GetFlagByID(c.Request().Context(), flagID)
+	flag, err := h.svc.GetFlagByID(c.Request().Context(), orgID, flagID)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, "feature flag not found")
@@ -100,10 +240,20 @@ func (h *Handler) getFlagByID(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	return c.JSON(http.StatusOK, flag)
+	references, err := h.svc.ListCodeReferences(c.Request().Context(), orgID, flag.Key)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, model.FlagDetails{FeatureFlag: flag, CodeReferences: references})
 }
 
 func (h *Handler) createFlag(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
 	var req model.FeatureFlagRequest
 	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
@@ -112,8 +262,14 @@ func (h *Handler) createFlag(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
 	}
 
-	flagID, err := h.svc.CreateFlag(c.Request().Context(), req)
+	flagID, err := h.svc.CreateFlag(c.Request().Context(), orgID, req)
 	if err != nil {
+		if errors.Is(err, model.ErrFlagAccessDenied) {
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+		if errors.Is(err, model.ErrFlagManaged) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
@@ -136,10 +292,21 @@ func (h *Handler) updateFlag(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
 	}
 
-	if err := h.svc.UpdateFlag(c.Request().Context(), flagID, req); err != nil {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.UpdateFlag(c.Request().Context(), orgID, flagID, req); err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, "feature flag not found")
 		}
+		if errors.Is(err, model.ErrFlagAccessDenied) {
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+		if errors.Is(err, model.ErrFlagProtected) || errors.Is(err, model.ErrFlagManaged) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
@@ -152,12 +319,63 @@ func (h *Handler) deleteFlag(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid flag ID")
 	}
 
-	if err := h.svc.DeleteFlag(c.Request().Context(), flagID); err != nil {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.DeleteFlag(c.Request().Context(), orgID, flagID); err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, "feature flag not found")
 		}
+		if errors.Is(err, model.ErrFlagAccessDenied) {
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+		if errors.Is(err, model.ErrFlagProtected) || errors.Is(err, model.ErrFlagManaged) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
 	return c.NoContent(http.StatusNoContent)
 }
+
+func (h *Handler) flagsReport(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	staleAfterDays := defaultStaleAfterDays
+	if value := c.QueryParam("stale_days"); value != "" {
+		days, err := strconv.Atoi(value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid stale_days parameter")
+		}
+		staleAfterDays = days
+	}
+
+	report, err := h.svc.GenerateReport(c.Request().Context(), orgID, staleAfterDays)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidReportRange) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, report)
+}
+
+func (h *Handler) overdueFlags(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	overdue, err := h.svc.ListOverdueFlags(c.Request().Context(), orgID, c.QueryParam("owner"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, overdue)
+}
@@ -2,6 +2,10 @@ package handler_test
 
 import (
 	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -11,3 +15,14 @@ func TestHandler(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Feature Flags Handler Suite")
 }
+
+// accessTokenClaims adds the ID and the times of an access token to the claims, as the JWT helper
+// decodes them.
+func accessTokenClaims(claims jwt.MapClaims) jwt.MapClaims {
+	now := time.Now()
+	claims["jti"] = uuid.NewString()
+	claims["iat"] = float64(now.Unix())
+	claims["iat_ms"] = float64(now.UnixMilli())
+	claims["exp"] = float64(now.Add(time.Hour).Unix())
+	return claims
+}
@@ -13,9 +13,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/handler"
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/handler/handlerfakes"
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
 	"github.com/georgisomnoev/feature-flag-api/internal/validator"
 	"github.com/labstack/echo/v4"
 )
@@ -30,22 +32,28 @@ var _ = Describe("Handler", func() {
 		e           *echo.Echo
 		recorder    *httptest.ResponseRecorder
 		authStore   *handlerfakes.FakeAuthStore
+		denylist    *handlerfakes.FakeDenylist
 		jwtHelper   *handlerfakes.FakeJWTHelper
+		apiKeys     *handlerfakes.FakeAPIKeyAuthenticator
 		svc         *handlerfakes.FakeService
 		flagHandler *handler.Handler
 		request     *http.Request
 
 		validUserID = "c9c15117-ca25-49c6-b857-3eb640a61234"
+		validOrgID  = "5a1de4a2-9f0c-4d8e-a4c7-0f5a3b2e7c11"
 	)
 
 	BeforeEach(func() {
 		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
 		e.Validator = validator.GetValidator()
 		recorder = httptest.NewRecorder()
 		authStore = &handlerfakes.FakeAuthStore{}
+		denylist = &handlerfakes.FakeDenylist{}
 		jwtHelper = &handlerfakes.FakeJWTHelper{}
+		apiKeys = &handlerfakes.FakeAPIKeyAuthenticator{}
 		svc = &handlerfakes.FakeService{}
-		flagHandler = handler.NewHandler(svc, authStore, jwtHelper)
+		flagHandler = handler.NewHandler(svc, authStore, denylist, jwtHelper, apiKeys, "production")
 		flagHandler.RegisterHandlers(e)
 	})
 
@@ -78,15 +86,125 @@ var _ = Describe("Handler", func() {
 		})
 	})
 
+	When("an API key is provided", func() {
+		JustBeforeEach(func() {
+			request.Header.Set("X-API-Key", "ffs_key")
+		})
+
+		BeforeEach(func() {
+			apiKeys.AuthenticateReturns(jwt.MapClaims{
+				"sub":     validUserID,
+				"org":     validOrgID,
+				"scopes":  []string{"read:flags"},
+				"api_key": uuid.NewString(),
+				"env":     "production",
+			}, nil)
+			authStore.UserExistsReturns(true, nil)
+			authStore.IsOrgMemberReturns(true, nil)
+		})
+
+		It("authenticates the request with the claims of the key", func() {
+			e.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			_, key := apiKeys.AuthenticateArgsForCall(0)
+			Expect(key).To(Equal("ffs_key"))
+			Expect(jwtHelper.ValidateTokenCallCount()).To(BeZero())
+			Expect(denylist.IsRevokedCallCount()).To(BeZero())
+
+			_, actualOrgID, _ := svc.ListFlagsArgsForCall(0)
+			Expect(actualOrgID.String()).To(Equal(validOrgID))
+		})
+
+		It("runs the middlewares of the routes with the caller of the key", func() {
+			var callers []authn.Caller
+			e = echo.New()
+			e.Validator = validator.GetValidator()
+			flagHandler.RegisterHandlers(e, func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error {
+					caller, err := authn.CallerFromContext(c)
+					Expect(err).ToNot(HaveOccurred())
+					callers = append(callers, caller)
+					return next(c)
+				}
+			})
+
+			e.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(callers).To(HaveLen(1))
+			Expect(callers[0].UserID.String()).To(Equal(validUserID))
+			Expect(callers[0].APIKeyID).ToNot(Equal(uuid.Nil))
+		})
+
+		Context("that was revoked", func() {
+			BeforeEach(func() {
+				apiKeys.AuthenticateReturns(nil, ErrInvalidOrExpiredToken)
+			})
+
+			It("returns unauthorized error", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+				Expect(recorder.Body.String()).To(ContainSubstring("invalid or revoked API key"))
+				Expect(svc.ListFlagsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("that is for another environment", func() {
+			BeforeEach(func() {
+				apiKeys.AuthenticateReturns(jwt.MapClaims{
+					"sub":     validUserID,
+					"org":     validOrgID,
+					"scopes":  []string{"read:flags"},
+					"api_key": uuid.NewString(),
+					"env":     "staging",
+				}, nil)
+			})
+
+			It("returns forbidden error", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+				Expect(recorder.Body.String()).To(ContainSubstring("credentials are for another environment"))
+				Expect(svc.ListFlagsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("that lacks the required scope", func() {
+			JustBeforeEach(func() {
+				request = httptest.NewRequest(http.MethodDelete, "/flags/"+validUserID, nil)
+				request.Header.Set("X-API-Key", "ffs_key")
+			})
+
+			It("returns forbidden error", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+				Expect(svc.DeleteFlagCallCount()).To(BeZero())
+			})
+		})
+	})
+
 	When("a token is provided ", func() {
 		JustBeforeEach(func() {
 			request.Header.Set(echo.HeaderAuthorization, "Bearer validToken")
 		})
 
+		Context("that was revoked", func() {
+			BeforeEach(func() {
+				jwtHelper.ValidateTokenReturns(accessTokenClaims(jwt.MapClaims{"sub": validUserID, "org": validOrgID}), nil)
+				denylist.IsRevokedReturns(true)
+			})
+
+			It("returns unauthorized error", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+				Expect(recorder.Body.String()).To(ContainSubstring("token has been revoked"))
+				Expect(authStore.UserExistsCallCount()).To(BeZero())
+			})
+		})
+
 		Context("contains an invalid or missing user ID", func() {
 			BeforeEach(func() {
 				claims := jwt.MapClaims{"sub": ""}
-				jwtHelper.ValidateTokenReturns(claims, nil)
+				jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
 			})
 
 			It("returns unauthorized error", func() {
@@ -98,8 +216,8 @@ var _ = Describe("Handler", func() {
 
 		Context("contains valid claims", func() {
 			BeforeEach(func() {
-				claims := jwt.MapClaims{"sub": validUserID}
-				jwtHelper.ValidateTokenReturns(claims, nil)
+				claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID}
+				jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
 			})
 
 			Context("the user does not exist", func() {
@@ -114,34 +232,65 @@ var _ = Describe("Handler", func() {
 				})
 			})
 
-			Context("the user exists but the scope is not set", func() {
+			Context("the organization claim is missing", func() {
+				BeforeEach(func() {
+					jwtHelper.ValidateTokenReturns(accessTokenClaims(jwt.MapClaims{"sub": validUserID}), nil)
+					authStore.UserExistsReturns(true, nil)
+				})
+
+				It("returns unauthorized error", func() {
+					e.ServeHTTP(recorder, request)
+					Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+					Expect(recorder.Body.String()).To(ContainSubstring("invalid organization in token"))
+				})
+			})
+
+			Context("the user is not a member of the organization", func() {
 				BeforeEach(func() {
 					authStore.UserExistsReturns(true, nil)
+					authStore.IsOrgMemberReturns(false, nil)
 				})
 
-				It("returns internal server error", func() {
+				It("returns forbidden error", func() {
 					e.ServeHTTP(recorder, request)
 					Expect(recorder.Code).To(Equal(http.StatusForbidden))
-					Expect(recorder.Body.String()).To(ContainSubstring("no scopes found in token"))
+					Expect(recorder.Body.String()).To(ContainSubstring("not a member of the organization"))
+
+					_, actualOrgID, actualUserID := authStore.IsOrgMemberArgsForCall(0)
+					Expect(actualOrgID.String()).To(Equal(validOrgID))
+					Expect(actualUserID.String()).To(Equal(validUserID))
+				})
+			})
+
+			Context("the token grants no scopes", func() {
+				BeforeEach(func() {
+					authStore.UserExistsReturns(true, nil)
+					authStore.IsOrgMemberReturns(true, nil)
 				})
 
-				Context("the scope is set but it is invalid format", func() {
+				It("returns forbidden error", func() {
+					e.ServeHTTP(recorder, request)
+					Expect(recorder.Code).To(Equal(http.StatusForbidden))
+					Expect(recorder.Body.String()).To(ContainSubstring("insufficient permissions"))
+				})
+
+				Context("the scopes are malformed", func() {
 					BeforeEach(func() {
-						claims := jwt.MapClaims{"sub": validUserID, "scopes": []int{1, 2, 3}}
-						jwtHelper.ValidateTokenReturns(claims, nil)
+						claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": []int{1, 2, 3}}
+						jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
 					})
 
-					It("returns internal server error", func() {
+					It("returns unauthorized error", func() {
 						e.ServeHTTP(recorder, request)
-						Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
-						Expect(recorder.Body.String()).To(ContainSubstring("invalid scope format"))
+						Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+						Expect(recorder.Body.String()).To(ContainSubstring("invalid or expired token"))
 					})
 				})
 
 				Context("the scope is set but does not have the required scopes", func() {
 					BeforeEach(func() {
-						claims := jwt.MapClaims{"sub": validUserID, "scopes": []string{"other:string"}}
-						jwtHelper.ValidateTokenReturns(claims, nil)
+						claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": []string{"other:string"}}
+						jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
 					})
 
 					It("returns forbidden error", func() {
@@ -156,9 +305,10 @@ var _ = Describe("Handler", func() {
 
 	Describe("GET /flags", func() {
 		BeforeEach(func() {
-			claims := jwt.MapClaims{"sub": validUserID, "scopes": []string{"read:flags"}}
-			jwtHelper.ValidateTokenReturns(claims, nil)
+			claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": []string{"read:flags"}}
+			jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
 			authStore.UserExistsReturns(true, nil)
+			authStore.IsOrgMemberReturns(true, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -168,7 +318,7 @@ var _ = Describe("Handler", func() {
 		Context("when the request is successful", func() {
 			var featureFlag model.FeatureFlag
 			BeforeEach(func() {
-				featureFlag = model.FeatureFlag{ID: uuid.New(), Key: "flag1", Description: "desc1", Enabled: true}
+				featureFlag = model.FeatureFlag{ID: uuid.New(), Key: "flag1", Description: "desc1", Enabled: true, Kind: model.FlagKindRelease}
 				svc.ListFlagsReturns([]model.FeatureFlag{featureFlag}, nil)
 			})
 
@@ -179,6 +329,35 @@ var _ = Describe("Handler", func() {
 			})
 		})
 
+		Context("when filters are provided", func() {
+			It("passes them to the service", func() {
+				request = httptest.NewRequest(http.MethodGet,
+					"/flags?owner=payments&maintainer=john&kind=ops&tag=checkout&tag=web&metadata.cost_center=42", nil)
+				request.Header.Set(echo.HeaderAuthorization, "Bearer validToken")
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				Expect(svc.ListFlagsCallCount()).To(Equal(1))
+				_, actualOrgID, actualFilter := svc.ListFlagsArgsForCall(0)
+				Expect(actualOrgID.String()).To(Equal(validOrgID))
+				Expect(actualFilter).To(Equal(model.FlagFilter{
+					Owner:      "payments",
+					Maintainer: "john",
+					Kind:       model.FlagKindOps,
+					Tags:       []string{"checkout", "web"},
+					Metadata:   map[string]string{"cost_center": "42"},
+				}))
+			})
+
+			It("rejects an unknown kind", func() {
+				request = httptest.NewRequest(http.MethodGet, "/flags?kind=unknown", nil)
+				request.Header.Set(echo.HeaderAuthorization, "Bearer validToken")
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(svc.ListFlagsCallCount()).To(BeZero())
+			})
+		})
+
 		Context("when the service returns an error", func() {
 			BeforeEach(func() {
 				svc.ListFlagsReturns(nil, ErrInternalError)
@@ -199,9 +378,10 @@ var _ = Describe("Handler", func() {
 		)
 
 		BeforeEach(func() {
-			claims := jwt.MapClaims{"sub": validUserID, "scopes": []string{"read:flags"}}
-			jwtHelper.ValidateTokenReturns(claims, nil)
+			claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": []string{"read:flags"}}
+			jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
 			authStore.UserExistsReturns(true, nil)
+			authStore.IsOrgMemberReturns(true, nil)
 			flagIDStr = "123e4567-e89b-12d3-a456-426655440000"
 			flagID = uuid.MustParse(flagIDStr)
 		})
@@ -213,7 +393,7 @@ var _ = Describe("Handler", func() {
 
 		Context("when the request is successful", func() {
 			BeforeEach(func() {
-				svc.GetFlagByIDReturns(model.FeatureFlag{ID: flagID, Key: "flag1", Description: "desc1", Enabled: true}, nil)
+				svc.GetFlagByIDReturns(model.FeatureFlag{ID: flagID, Key: "flag1", Description: "desc1", Enabled: true, Kind: model.FlagKindRelease}, nil)
 			})
 
 			It("returns the feature flag", func() {
@@ -223,6 +403,30 @@ var _ = Describe("Handler", func() {
 			})
 		})
 
+		Context("when the flag is referenced in code", func() {
+			BeforeEach(func() {
+				svc.GetFlagByIDReturns(model.FeatureFlag{ID: flagID, Key: "flag1", Kind: model.FlagKindRelease}, nil)
+				svc.ListCodeReferencesReturns([]model.CodeReference{
+					{Repository: "github.com/acme/shop", Key: "flag1", Path: "main.go", Line: 4},
+				}, nil)
+			})
+
+			It("returns the code references with the feature flag", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var details model.FlagDetails
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &details)).To(Succeed())
+				Expect(details.Key).To(Equal("flag1"))
+				Expect(details.CodeReferences).To(ConsistOf(model.CodeReference{
+					Repository: "github.com/acme/shop", Key: "flag1", Path: "main.go", Line: 4,
+				}))
+
+				_, _, actualKey := svc.ListCodeReferencesArgsForCall(0)
+				Expect(actualKey).To(Equal("flag1"))
+			})
+		})
+
 		Context("when the feature flag is not found", func() {
 			BeforeEach(func() {
 				svc.GetFlagByIDReturns(model.FeatureFlag{}, model.ErrNotFound)
@@ -251,9 +455,10 @@ var _ = Describe("Handler", func() {
 	Describe("POST /flags", func() {
 		var payload string
 		BeforeEach(func() {
-			claims := jwt.MapClaims{"sub": validUserID, "scopes": []string{"write:flags"}}
-			jwtHelper.ValidateTokenReturns(claims, nil)
+			claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": []string{"write:flags"}}
+			jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
 			authStore.UserExistsReturns(true, nil)
+			authStore.IsOrgMemberReturns(true, nil)
 
 			payload = `{"key":"new-flag", "description":"new flag description", "enabled":true}`
 		})
@@ -291,6 +496,42 @@ var _ = Describe("Handler", func() {
 				Expect(response["message"]).To(ContainSubstring("missing required request fields"))
 			})
 		})
+
+		Context("when the payload has ownership and metadata", func() {
+			BeforeEach(func() {
+				payload = `{"key":"new-flag","description":"desc","owner":"payments","owner_type":"team",
+					"maintainers":["john"],"tags":["checkout"],"kind":"experiment",
+					"issue_urls":["https://issues.example.com/PAY-1"],"metadata":{"cost_center":"42"}}`
+			})
+
+			It("passes them to the service", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusCreated))
+
+				_, _, actualRequest := svc.CreateFlagArgsForCall(0)
+				Expect(actualRequest.Owner).To(Equal("payments"))
+				Expect(actualRequest.OwnerType).To(Equal(model.OwnerTypeTeam))
+				Expect(actualRequest.Kind).To(Equal(model.FlagKindExperiment))
+				Expect(actualRequest.Metadata).To(HaveKeyWithValue("cost_center", "42"))
+			})
+		})
+
+		DescribeTable("when the metadata is invalid",
+			func(invalidPayload string) {
+				request = httptest.NewRequest(http.MethodPost, "/flags", strings.NewReader(invalidPayload))
+				request.Header.Set(echo.HeaderAuthorization, "Bearer validToken")
+				request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(svc.CreateFlagCallCount()).To(BeZero())
+			},
+			Entry("unknown kind", `{"key":"k","description":"d","kind":"unknown"}`),
+			Entry("owner without owner type", `{"key":"k","description":"d","owner":"payments"}`),
+			Entry("unknown owner type", `{"key":"k","description":"d","owner":"payments","owner_type":"org"}`),
+			Entry("invalid issue URL", `{"key":"k","description":"d","issue_urls":["not a url"]}`),
+			Entry("empty tag", `{"key":"k","description":"d","tags":[""]}`),
+			Entry("empty metadata key", `{"key":"k","description":"d","metadata":{"":"v"}}`),
+		)
 	})
 
 	Describe("PUT /flags/:id", func() {
@@ -298,9 +539,10 @@ var _ = Describe("Handler", func() {
 			payload string
 		)
 		BeforeEach(func() {
-			claims := jwt.MapClaims{"sub": validUserID, "scopes": []string{"write:flags"}}
-			jwtHelper.ValidateTokenReturns(claims, nil)
+			claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": []string{"write:flags"}}
+			jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
 			authStore.UserExistsReturns(true, nil)
+			authStore.IsOrgMemberReturns(true, nil)
 
 			payload = `{"key":"updated-flag", "description":"updated desc", "enabled":false}`
 		})
@@ -328,6 +570,58 @@ var _ = Describe("Handler", func() {
 			})
 		})
 
+		Context("when the flag is protected", func() {
+			BeforeEach(func() {
+				svc.UpdateFlagReturns(model.ErrFlagProtected)
+			})
+
+			It("returns conflict error", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusConflict))
+				Expect(recorder.Body.String()).To(ContainSubstring(model.ErrFlagProtected.Error()))
+			})
+		})
+
+		Context("when the flag is managed by another tool", func() {
+			BeforeEach(func() {
+				svc.UpdateFlagReturns(model.ErrFlagManaged)
+			})
+
+			It("returns conflict error", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusConflict))
+				Expect(recorder.Body.String()).To(ContainSubstring(model.ErrFlagManaged.Error()))
+			})
+		})
+
+		It("does not let a header mark the caller as the sync", func() {
+			request.Header.Set("X-Managed-By", model.ManagedByGit)
+			e.ServeHTTP(recorder, request)
+
+			Expect(svc.UpdateFlagCallCount()).To(Equal(1))
+			ctx, _, _, _ := svc.UpdateFlagArgsForCall(0)
+			principal, ok := model.PrincipalFromContext(ctx)
+			Expect(ok).To(BeTrue())
+			Expect(principal.Sync).To(BeFalse())
+		})
+
+		Context("when the token carries the sync scope", func() {
+			BeforeEach(func() {
+				claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": []string{"write:flags", model.SyncScope}}
+				jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
+			})
+
+			It("marks the caller as the sync", func() {
+				e.ServeHTTP(recorder, request)
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				ctx, _, _, _ := svc.UpdateFlagArgsForCall(0)
+				principal, ok := model.PrincipalFromContext(ctx)
+				Expect(ok).To(BeTrue())
+				Expect(principal.Sync).To(BeTrue())
+			})
+		})
+
 		Context("when the payload is invalid", func() {
 			BeforeEach(func() {
 				payload = `{"invalid_field":"value"}`
@@ -349,9 +643,10 @@ var _ = Describe("Handler", func() {
 		var flagIDStr string
 		BeforeEach(func() {
 			flagIDStr = "123e4567-e89b-12d3-a456-426655440000"
-			claims := jwt.MapClaims{"sub": validUserID, "scopes": []string{"write:flags"}}
-			jwtHelper.ValidateTokenReturns(claims, nil)
+			claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": []string{"write:flags"}}
+			jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
 			authStore.UserExistsReturns(true, nil)
+			authStore.IsOrgMemberReturns(true, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -376,6 +671,17 @@ var _ = Describe("Handler", func() {
 			})
 		})
 
+		Context("when the flag is managed by another tool", func() {
+			BeforeEach(func() {
+				svc.DeleteFlagReturns(model.ErrFlagManaged)
+			})
+
+			It("returns conflict error", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusConflict))
+			})
+		})
+
 		Context("when the ID is invalid", func() {
 			BeforeEach(func() {
 				flagIDStr = "invalid-id"
@@ -387,4 +693,169 @@ var _ = Describe("Handler", func() {
 			})
 		})
 	})
+
+	Describe("GET /flags/report", func() {
+		var query string
+
+		BeforeEach(func() {
+			claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": []string{"read:flags"}}
+			jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
+			authStore.UserExistsReturns(true, nil)
+			authStore.IsOrgMemberReturns(true, nil)
+			query = ""
+
+			svc.GenerateReportReturns(model.FlagReport{
+				StaleAfterDays: 30,
+				Flags: []model.FlagReportEntry{
+					{ID: uuid.New(), Key: "old-flag", Categories: []model.FlagCategory{model.FlagCategoryStale}},
+				},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request = httptest.NewRequest(http.MethodGet, "/flags/report"+query, nil)
+			request.Header.Set(echo.HeaderAuthorization, "Bearer validToken")
+		})
+
+		It("returns the report using the default stale period", func() {
+			e.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring("old-flag"))
+
+			Expect(svc.GenerateReportCallCount()).To(Equal(1))
+			_, _, staleAfterDays := svc.GenerateReportArgsForCall(0)
+			Expect(staleAfterDays).To(Equal(30))
+		})
+
+		Context("when the stale period is provided", func() {
+			BeforeEach(func() {
+				query = "?stale_days=90"
+			})
+
+			It("passes it to the service", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				_, _, staleAfterDays := svc.GenerateReportArgsForCall(0)
+				Expect(staleAfterDays).To(Equal(90))
+			})
+		})
+
+		Context("when the stale period is not a number", func() {
+			BeforeEach(func() {
+				query = "?stale_days=abc"
+			})
+
+			It("returns a bad request error", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(svc.GenerateReportCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the service rejects the stale period", func() {
+			BeforeEach(func() {
+				svc.GenerateReportReturns(model.FlagReport{}, model.ErrInvalidReportRange)
+			})
+
+			It("returns a bad request error", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(recorder.Body.String()).To(ContainSubstring(model.ErrInvalidReportRange.Error()))
+			})
+		})
+	})
+
+	Describe("GET /flags/overdue", func() {
+		BeforeEach(func() {
+			claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": []string{"read:flags"}}
+			jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
+			authStore.UserExistsReturns(true, nil)
+			authStore.IsOrgMemberReturns(true, nil)
+
+			svc.ListOverdueFlagsReturns([]model.OwnerOverdueFlags{
+				{Owner: "payments", OwnerType: model.OwnerTypeTeam, Flags: []model.FeatureFlag{{ID: uuid.New(), Key: "old-flag", Kind: model.FlagKindRelease}}},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request = httptest.NewRequest(http.MethodGet, "/flags/overdue?owner=payments", nil)
+			request.Header.Set(echo.HeaderAuthorization, "Bearer validToken")
+		})
+
+		It("returns the overdue flags of the owner", func() {
+			e.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring("old-flag"))
+
+			_, _, actualOwner := svc.ListOverdueFlagsArgsForCall(0)
+			Expect(actualOwner).To(Equal("payments"))
+		})
+
+		Context("when the service returns an error", func() {
+			BeforeEach(func() {
+				svc.ListOverdueFlagsReturns(nil, ErrInternalError)
+			})
+
+			It("returns an internal server error", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("PUT /code-references", func() {
+		var payload string
+
+		BeforeEach(func() {
+			claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": []string{"write:flags"}}
+			jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
+			authStore.UserExistsReturns(true, nil)
+			authStore.IsOrgMemberReturns(true, nil)
+			svc.UploadCodeReferencesReturns(1, nil)
+
+			payload = `{"repository":"github.com/acme/shop","references":[{"key":"flag1","path":"main.go","line":4}]}`
+		})
+
+		JustBeforeEach(func() {
+			request = httptest.NewRequest(http.MethodPut, "/code-references", strings.NewReader(payload))
+			request.Header.Set(echo.HeaderAuthorization, "Bearer validToken")
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		})
+
+		It("uploads the code references for the authenticated user", func() {
+			e.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"references":1`))
+
+			_, _, actualUserID, actualUpload := svc.UploadCodeReferencesArgsForCall(0)
+			Expect(actualUserID).To(Equal(uuid.MustParse(validUserID)))
+			Expect(actualUpload).To(Equal(model.CodeReferencesUpload{
+				Repository: "github.com/acme/shop",
+				References: []model.CodeReferenceEntry{{Key: "flag1", Path: "main.go", Line: 4}},
+			}))
+		})
+
+		Context("when a reference has no line", func() {
+			BeforeEach(func() {
+				payload = `{"repository":"github.com/acme/shop","references":[{"key":"flag1","path":"main.go"}]}`
+			})
+
+			It("returns a bad request error", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+				Expect(svc.UploadCodeReferencesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the service returns an error", func() {
+			BeforeEach(func() {
+				svc.UploadCodeReferencesReturns(0, ErrInternalError)
+			})
+
+			It("returns an internal server error", func() {
+				e.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
 })
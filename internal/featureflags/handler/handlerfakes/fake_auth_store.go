@@ -10,6 +10,21 @@ import (
 )
 
 type FakeAuthStore struct {
+	IsOrgMemberStub        func(context.Context, uuid.UUID, uuid.UUID) (bool, error)
+	isOrgMemberMutex       sync.RWMutex
+	isOrgMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	isOrgMemberReturns struct {
+		result1 bool
+		result2 error
+	}
+	isOrgMemberReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	UserExistsStub        func(context.Context, uuid.UUID) (bool, error)
 	userExistsMutex       sync.RWMutex
 	userExistsArgsForCall []struct {
@@ -28,6 +43,72 @@ type FakeAuthStore struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeAuthStore) IsOrgMember(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (bool, error) {
+	fake.isOrgMemberMutex.Lock()
+	ret, specificReturn := fake.isOrgMemberReturnsOnCall[len(fake.isOrgMemberArgsForCall)]
+	fake.isOrgMemberArgsForCall = append(fake.isOrgMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.IsOrgMemberStub
+	fakeReturns := fake.isOrgMemberReturns
+	fake.recordInvocation("IsOrgMember", []interface{}{arg1, arg2, arg3})
+	fake.isOrgMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthStore) IsOrgMemberCallCount() int {
+	fake.isOrgMemberMutex.RLock()
+	defer fake.isOrgMemberMutex.RUnlock()
+	return len(fake.isOrgMemberArgsForCall)
+}
+
+func (fake *FakeAuthStore) IsOrgMemberCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) {
+	fake.isOrgMemberMutex.Lock()
+	defer fake.isOrgMemberMutex.Unlock()
+	fake.IsOrgMemberStub = stub
+}
+
+func (fake *FakeAuthStore) IsOrgMemberArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.isOrgMemberMutex.RLock()
+	defer fake.isOrgMemberMutex.RUnlock()
+	argsForCall := fake.isOrgMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuthStore) IsOrgMemberReturns(result1 bool, result2 error) {
+	fake.isOrgMemberMutex.Lock()
+	defer fake.isOrgMemberMutex.Unlock()
+	fake.IsOrgMemberStub = nil
+	fake.isOrgMemberReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthStore) IsOrgMemberReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isOrgMemberMutex.Lock()
+	defer fake.isOrgMemberMutex.Unlock()
+	fake.IsOrgMemberStub = nil
+	if fake.isOrgMemberReturnsOnCall == nil {
+		fake.isOrgMemberReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isOrgMemberReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAuthStore) UserExists(arg1 context.Context, arg2 uuid.UUID) (bool, error) {
 	fake.userExistsMutex.Lock()
 	ret, specificReturn := fake.userExistsReturnsOnCall[len(fake.userExistsArgsForCall)]
@@ -11,11 +11,117 @@ import (
 )
 
 type FakeService struct {
-	CreateFlagStub        func(context.Context, model.FeatureFlagRequest) (uuid.UUID, error)
+	ActivateKillSwitchStub        func(context.Context, uuid.UUID, uuid.UUID, model.KillSwitchRequest) (model.KillSwitch, error)
+	activateKillSwitchMutex       sync.RWMutex
+	activateKillSwitchArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.KillSwitchRequest
+	}
+	activateKillSwitchReturns struct {
+		result1 model.KillSwitch
+		result2 error
+	}
+	activateKillSwitchReturnsOnCall map[int]struct {
+		result1 model.KillSwitch
+		result2 error
+	}
+	AddGroupMemberStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	addGroupMemberMutex       sync.RWMutex
+	addGroupMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	addGroupMemberReturns struct {
+		result1 error
+	}
+	addGroupMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ApplyChangeRequestStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	applyChangeRequestMutex       sync.RWMutex
+	applyChangeRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	applyChangeRequestReturns struct {
+		result1 error
+	}
+	applyChangeRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ApproveChangeRequestStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) error
+	approveChangeRequestMutex       sync.RWMutex
+	approveChangeRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 string
+	}
+	approveChangeRequestReturns struct {
+		result1 error
+	}
+	approveChangeRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CancelChangeRequestStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	cancelChangeRequestMutex       sync.RWMutex
+	cancelChangeRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	cancelChangeRequestReturns struct {
+		result1 error
+	}
+	cancelChangeRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateAccessRuleStub        func(context.Context, uuid.UUID, model.AccessRuleRequest) (model.AccessRule, error)
+	createAccessRuleMutex       sync.RWMutex
+	createAccessRuleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.AccessRuleRequest
+	}
+	createAccessRuleReturns struct {
+		result1 model.AccessRule
+		result2 error
+	}
+	createAccessRuleReturnsOnCall map[int]struct {
+		result1 model.AccessRule
+		result2 error
+	}
+	CreateChangeRequestStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, model.ChangeRequestRequest) (uuid.UUID, error)
+	createChangeRequestMutex       sync.RWMutex
+	createChangeRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 model.ChangeRequestRequest
+	}
+	createChangeRequestReturns struct {
+		result1 uuid.UUID
+		result2 error
+	}
+	createChangeRequestReturnsOnCall map[int]struct {
+		result1 uuid.UUID
+		result2 error
+	}
+	CreateFlagStub        func(context.Context, uuid.UUID, model.FeatureFlagRequest) (uuid.UUID, error)
 	createFlagMutex       sync.RWMutex
 	createFlagArgsForCall []struct {
 		arg1 context.Context
-		arg2 model.FeatureFlagRequest
+		arg2 uuid.UUID
+		arg3 model.FeatureFlagRequest
 	}
 	createFlagReturns struct {
 		result1 uuid.UUID
@@ -25,11 +131,40 @@ type FakeService struct {
 		result1 uuid.UUID
 		result2 error
 	}
-	DeleteFlagStub        func(context.Context, uuid.UUID) error
+	CreateGroupStub        func(context.Context, uuid.UUID, model.GroupRequest) (model.Group, error)
+	createGroupMutex       sync.RWMutex
+	createGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.GroupRequest
+	}
+	createGroupReturns struct {
+		result1 model.Group
+		result2 error
+	}
+	createGroupReturnsOnCall map[int]struct {
+		result1 model.Group
+		result2 error
+	}
+	DeleteAccessRuleStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	deleteAccessRuleMutex       sync.RWMutex
+	deleteAccessRuleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deleteAccessRuleReturns struct {
+		result1 error
+	}
+	deleteAccessRuleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteFlagStub        func(context.Context, uuid.UUID, uuid.UUID) error
 	deleteFlagMutex       sync.RWMutex
 	deleteFlagArgsForCall []struct {
 		arg1 context.Context
 		arg2 uuid.UUID
+		arg3 uuid.UUID
 	}
 	deleteFlagReturns struct {
 		result1 error
@@ -37,11 +172,70 @@ type FakeService struct {
 	deleteFlagReturnsOnCall map[int]struct {
 		result1 error
 	}
-	GetFlagByIDStub        func(context.Context, uuid.UUID) (model.FeatureFlag, error)
+	DeleteGroupStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	deleteGroupMutex       sync.RWMutex
+	deleteGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deleteGroupReturns struct {
+		result1 error
+	}
+	deleteGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EvaluateFlagStub        func(context.Context, uuid.UUID, string) (model.FlagEvaluation, error)
+	evaluateFlagMutex       sync.RWMutex
+	evaluateFlagArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	evaluateFlagReturns struct {
+		result1 model.FlagEvaluation
+		result2 error
+	}
+	evaluateFlagReturnsOnCall map[int]struct {
+		result1 model.FlagEvaluation
+		result2 error
+	}
+	GenerateReportStub        func(context.Context, uuid.UUID, int) (model.FlagReport, error)
+	generateReportMutex       sync.RWMutex
+	generateReportArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+	}
+	generateReportReturns struct {
+		result1 model.FlagReport
+		result2 error
+	}
+	generateReportReturnsOnCall map[int]struct {
+		result1 model.FlagReport
+		result2 error
+	}
+	GetChangeRequestStub        func(context.Context, uuid.UUID, uuid.UUID) (model.ChangeRequest, error)
+	getChangeRequestMutex       sync.RWMutex
+	getChangeRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getChangeRequestReturns struct {
+		result1 model.ChangeRequest
+		result2 error
+	}
+	getChangeRequestReturnsOnCall map[int]struct {
+		result1 model.ChangeRequest
+		result2 error
+	}
+	GetFlagByIDStub        func(context.Context, uuid.UUID, uuid.UUID) (model.FeatureFlag, error)
 	getFlagByIDMutex       sync.RWMutex
 	getFlagByIDArgsForCall []struct {
 		arg1 context.Context
 		arg2 uuid.UUID
+		arg3 uuid.UUID
 	}
 	getFlagByIDReturns struct {
 		result1 model.FeatureFlag
@@ -51,10 +245,85 @@ type FakeService struct {
 		result1 model.FeatureFlag
 		result2 error
 	}
-	ListFlagsStub        func(context.Context) ([]model.FeatureFlag, error)
+	GetGroupStub        func(context.Context, uuid.UUID, uuid.UUID) (model.Group, error)
+	getGroupMutex       sync.RWMutex
+	getGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getGroupReturns struct {
+		result1 model.Group
+		result2 error
+	}
+	getGroupReturnsOnCall map[int]struct {
+		result1 model.Group
+		result2 error
+	}
+	GetKillSwitchStatusStub        func(context.Context, uuid.UUID) (model.KillSwitchStatus, error)
+	getKillSwitchStatusMutex       sync.RWMutex
+	getKillSwitchStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getKillSwitchStatusReturns struct {
+		result1 model.KillSwitchStatus
+		result2 error
+	}
+	getKillSwitchStatusReturnsOnCall map[int]struct {
+		result1 model.KillSwitchStatus
+		result2 error
+	}
+	ListAccessRulesStub        func(context.Context, uuid.UUID) ([]model.AccessRule, error)
+	listAccessRulesMutex       sync.RWMutex
+	listAccessRulesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listAccessRulesReturns struct {
+		result1 []model.AccessRule
+		result2 error
+	}
+	listAccessRulesReturnsOnCall map[int]struct {
+		result1 []model.AccessRule
+		result2 error
+	}
+	ListChangeRequestsStub        func(context.Context, uuid.UUID, model.ChangeRequestStatus) ([]model.ChangeRequest, error)
+	listChangeRequestsMutex       sync.RWMutex
+	listChangeRequestsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.ChangeRequestStatus
+	}
+	listChangeRequestsReturns struct {
+		result1 []model.ChangeRequest
+		result2 error
+	}
+	listChangeRequestsReturnsOnCall map[int]struct {
+		result1 []model.ChangeRequest
+		result2 error
+	}
+	ListCodeReferencesStub        func(context.Context, uuid.UUID, string) ([]model.CodeReference, error)
+	listCodeReferencesMutex       sync.RWMutex
+	listCodeReferencesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	listCodeReferencesReturns struct {
+		result1 []model.CodeReference
+		result2 error
+	}
+	listCodeReferencesReturnsOnCall map[int]struct {
+		result1 []model.CodeReference
+		result2 error
+	}
+	ListFlagsStub        func(context.Context, uuid.UUID, model.FlagFilter) ([]model.FeatureFlag, error)
 	listFlagsMutex       sync.RWMutex
 	listFlagsArgsForCall []struct {
 		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.FlagFilter
 	}
 	listFlagsReturns struct {
 		result1 []model.FeatureFlag
@@ -64,36 +333,1805 @@ type FakeService struct {
 		result1 []model.FeatureFlag
 		result2 error
 	}
-	UpdateFlagStub        func(context.Context, uuid.UUID, model.FeatureFlagRequest) error
+	ListGroupMembersStub        func(context.Context, uuid.UUID, uuid.UUID) ([]model.GroupMember, error)
+	listGroupMembersMutex       sync.RWMutex
+	listGroupMembersArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	listGroupMembersReturns struct {
+		result1 []model.GroupMember
+		result2 error
+	}
+	listGroupMembersReturnsOnCall map[int]struct {
+		result1 []model.GroupMember
+		result2 error
+	}
+	ListGroupsStub        func(context.Context, uuid.UUID) ([]model.Group, error)
+	listGroupsMutex       sync.RWMutex
+	listGroupsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listGroupsReturns struct {
+		result1 []model.Group
+		result2 error
+	}
+	listGroupsReturnsOnCall map[int]struct {
+		result1 []model.Group
+		result2 error
+	}
+	ListOverdueFlagsStub        func(context.Context, uuid.UUID, string) ([]model.OwnerOverdueFlags, error)
+	listOverdueFlagsMutex       sync.RWMutex
+	listOverdueFlagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	listOverdueFlagsReturns struct {
+		result1 []model.OwnerOverdueFlags
+		result2 error
+	}
+	listOverdueFlagsReturnsOnCall map[int]struct {
+		result1 []model.OwnerOverdueFlags
+		result2 error
+	}
+	ListUserGroupIDsStub        func(context.Context, uuid.UUID, uuid.UUID) ([]uuid.UUID, error)
+	listUserGroupIDsMutex       sync.RWMutex
+	listUserGroupIDsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	listUserGroupIDsReturns struct {
+		result1 []uuid.UUID
+		result2 error
+	}
+	listUserGroupIDsReturnsOnCall map[int]struct {
+		result1 []uuid.UUID
+		result2 error
+	}
+	RejectChangeRequestStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) error
+	rejectChangeRequestMutex       sync.RWMutex
+	rejectChangeRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 string
+	}
+	rejectChangeRequestReturns struct {
+		result1 error
+	}
+	rejectChangeRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReleaseKillSwitchStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	releaseKillSwitchMutex       sync.RWMutex
+	releaseKillSwitchArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	releaseKillSwitchReturns struct {
+		result1 error
+	}
+	releaseKillSwitchReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveGroupMemberStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	removeGroupMemberMutex       sync.RWMutex
+	removeGroupMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	removeGroupMemberReturns struct {
+		result1 error
+	}
+	removeGroupMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateFlagStub        func(context.Context, uuid.UUID, uuid.UUID, model.FeatureFlagRequest) error
 	updateFlagMutex       sync.RWMutex
 	updateFlagArgsForCall []struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-		arg3 model.FeatureFlagRequest
+		arg3 uuid.UUID
+		arg4 model.FeatureFlagRequest
+	}
+	updateFlagReturns struct {
+		result1 error
+	}
+	updateFlagReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateGroupStub        func(context.Context, uuid.UUID, uuid.UUID, model.GroupRequest) (model.Group, error)
+	updateGroupMutex       sync.RWMutex
+	updateGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.GroupRequest
+	}
+	updateGroupReturns struct {
+		result1 model.Group
+		result2 error
+	}
+	updateGroupReturnsOnCall map[int]struct {
+		result1 model.Group
+		result2 error
+	}
+	UploadCodeReferencesStub        func(context.Context, uuid.UUID, uuid.UUID, model.CodeReferencesUpload) (int, error)
+	uploadCodeReferencesMutex       sync.RWMutex
+	uploadCodeReferencesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.CodeReferencesUpload
+	}
+	uploadCodeReferencesReturns struct {
+		result1 int
+		result2 error
+	}
+	uploadCodeReferencesReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeService) ActivateKillSwitch(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 model.KillSwitchRequest) (model.KillSwitch, error) {
+	fake.activateKillSwitchMutex.Lock()
+	ret, specificReturn := fake.activateKillSwitchReturnsOnCall[len(fake.activateKillSwitchArgsForCall)]
+	fake.activateKillSwitchArgsForCall = append(fake.activateKillSwitchArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.KillSwitchRequest
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActivateKillSwitchStub
+	fakeReturns := fake.activateKillSwitchReturns
+	fake.recordInvocation("ActivateKillSwitch", []interface{}{arg1, arg2, arg3, arg4})
+	fake.activateKillSwitchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ActivateKillSwitchCallCount() int {
+	fake.activateKillSwitchMutex.RLock()
+	defer fake.activateKillSwitchMutex.RUnlock()
+	return len(fake.activateKillSwitchArgsForCall)
+}
+
+func (fake *FakeService) ActivateKillSwitchCalls(stub func(context.Context, uuid.UUID, uuid.UUID, model.KillSwitchRequest) (model.KillSwitch, error)) {
+	fake.activateKillSwitchMutex.Lock()
+	defer fake.activateKillSwitchMutex.Unlock()
+	fake.ActivateKillSwitchStub = stub
+}
+
+func (fake *FakeService) ActivateKillSwitchArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, model.KillSwitchRequest) {
+	fake.activateKillSwitchMutex.RLock()
+	defer fake.activateKillSwitchMutex.RUnlock()
+	argsForCall := fake.activateKillSwitchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) ActivateKillSwitchReturns(result1 model.KillSwitch, result2 error) {
+	fake.activateKillSwitchMutex.Lock()
+	defer fake.activateKillSwitchMutex.Unlock()
+	fake.ActivateKillSwitchStub = nil
+	fake.activateKillSwitchReturns = struct {
+		result1 model.KillSwitch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ActivateKillSwitchReturnsOnCall(i int, result1 model.KillSwitch, result2 error) {
+	fake.activateKillSwitchMutex.Lock()
+	defer fake.activateKillSwitchMutex.Unlock()
+	fake.ActivateKillSwitchStub = nil
+	if fake.activateKillSwitchReturnsOnCall == nil {
+		fake.activateKillSwitchReturnsOnCall = make(map[int]struct {
+			result1 model.KillSwitch
+			result2 error
+		})
+	}
+	fake.activateKillSwitchReturnsOnCall[i] = struct {
+		result1 model.KillSwitch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) AddGroupMember(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) error {
+	fake.addGroupMemberMutex.Lock()
+	ret, specificReturn := fake.addGroupMemberReturnsOnCall[len(fake.addGroupMemberArgsForCall)]
+	fake.addGroupMemberArgsForCall = append(fake.addGroupMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AddGroupMemberStub
+	fakeReturns := fake.addGroupMemberReturns
+	fake.recordInvocation("AddGroupMember", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addGroupMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) AddGroupMemberCallCount() int {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	return len(fake.addGroupMemberArgsForCall)
+}
+
+func (fake *FakeService) AddGroupMemberCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) {
+	fake.addGroupMemberMutex.Lock()
+	defer fake.addGroupMemberMutex.Unlock()
+	fake.AddGroupMemberStub = stub
+}
+
+func (fake *FakeService) AddGroupMemberArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	argsForCall := fake.addGroupMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) AddGroupMemberReturns(result1 error) {
+	fake.addGroupMemberMutex.Lock()
+	defer fake.addGroupMemberMutex.Unlock()
+	fake.AddGroupMemberStub = nil
+	fake.addGroupMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) AddGroupMemberReturnsOnCall(i int, result1 error) {
+	fake.addGroupMemberMutex.Lock()
+	defer fake.addGroupMemberMutex.Unlock()
+	fake.AddGroupMemberStub = nil
+	if fake.addGroupMemberReturnsOnCall == nil {
+		fake.addGroupMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addGroupMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) ApplyChangeRequest(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) error {
+	fake.applyChangeRequestMutex.Lock()
+	ret, specificReturn := fake.applyChangeRequestReturnsOnCall[len(fake.applyChangeRequestArgsForCall)]
+	fake.applyChangeRequestArgsForCall = append(fake.applyChangeRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ApplyChangeRequestStub
+	fakeReturns := fake.applyChangeRequestReturns
+	fake.recordInvocation("ApplyChangeRequest", []interface{}{arg1, arg2, arg3, arg4})
+	fake.applyChangeRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) ApplyChangeRequestCallCount() int {
+	fake.applyChangeRequestMutex.RLock()
+	defer fake.applyChangeRequestMutex.RUnlock()
+	return len(fake.applyChangeRequestArgsForCall)
+}
+
+func (fake *FakeService) ApplyChangeRequestCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) {
+	fake.applyChangeRequestMutex.Lock()
+	defer fake.applyChangeRequestMutex.Unlock()
+	fake.ApplyChangeRequestStub = stub
+}
+
+func (fake *FakeService) ApplyChangeRequestArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.applyChangeRequestMutex.RLock()
+	defer fake.applyChangeRequestMutex.RUnlock()
+	argsForCall := fake.applyChangeRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) ApplyChangeRequestReturns(result1 error) {
+	fake.applyChangeRequestMutex.Lock()
+	defer fake.applyChangeRequestMutex.Unlock()
+	fake.ApplyChangeRequestStub = nil
+	fake.applyChangeRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) ApplyChangeRequestReturnsOnCall(i int, result1 error) {
+	fake.applyChangeRequestMutex.Lock()
+	defer fake.applyChangeRequestMutex.Unlock()
+	fake.ApplyChangeRequestStub = nil
+	if fake.applyChangeRequestReturnsOnCall == nil {
+		fake.applyChangeRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.applyChangeRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) ApproveChangeRequest(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 string) error {
+	fake.approveChangeRequestMutex.Lock()
+	ret, specificReturn := fake.approveChangeRequestReturnsOnCall[len(fake.approveChangeRequestArgsForCall)]
+	fake.approveChangeRequestArgsForCall = append(fake.approveChangeRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ApproveChangeRequestStub
+	fakeReturns := fake.approveChangeRequestReturns
+	fake.recordInvocation("ApproveChangeRequest", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.approveChangeRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) ApproveChangeRequestCallCount() int {
+	fake.approveChangeRequestMutex.RLock()
+	defer fake.approveChangeRequestMutex.RUnlock()
+	return len(fake.approveChangeRequestArgsForCall)
+}
+
+func (fake *FakeService) ApproveChangeRequestCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) error) {
+	fake.approveChangeRequestMutex.Lock()
+	defer fake.approveChangeRequestMutex.Unlock()
+	fake.ApproveChangeRequestStub = stub
+}
+
+func (fake *FakeService) ApproveChangeRequestArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) {
+	fake.approveChangeRequestMutex.RLock()
+	defer fake.approveChangeRequestMutex.RUnlock()
+	argsForCall := fake.approveChangeRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeService) ApproveChangeRequestReturns(result1 error) {
+	fake.approveChangeRequestMutex.Lock()
+	defer fake.approveChangeRequestMutex.Unlock()
+	fake.ApproveChangeRequestStub = nil
+	fake.approveChangeRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) ApproveChangeRequestReturnsOnCall(i int, result1 error) {
+	fake.approveChangeRequestMutex.Lock()
+	defer fake.approveChangeRequestMutex.Unlock()
+	fake.ApproveChangeRequestStub = nil
+	if fake.approveChangeRequestReturnsOnCall == nil {
+		fake.approveChangeRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.approveChangeRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) CancelChangeRequest(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) error {
+	fake.cancelChangeRequestMutex.Lock()
+	ret, specificReturn := fake.cancelChangeRequestReturnsOnCall[len(fake.cancelChangeRequestArgsForCall)]
+	fake.cancelChangeRequestArgsForCall = append(fake.cancelChangeRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CancelChangeRequestStub
+	fakeReturns := fake.cancelChangeRequestReturns
+	fake.recordInvocation("CancelChangeRequest", []interface{}{arg1, arg2, arg3, arg4})
+	fake.cancelChangeRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) CancelChangeRequestCallCount() int {
+	fake.cancelChangeRequestMutex.RLock()
+	defer fake.cancelChangeRequestMutex.RUnlock()
+	return len(fake.cancelChangeRequestArgsForCall)
+}
+
+func (fake *FakeService) CancelChangeRequestCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) {
+	fake.cancelChangeRequestMutex.Lock()
+	defer fake.cancelChangeRequestMutex.Unlock()
+	fake.CancelChangeRequestStub = stub
+}
+
+func (fake *FakeService) CancelChangeRequestArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.cancelChangeRequestMutex.RLock()
+	defer fake.cancelChangeRequestMutex.RUnlock()
+	argsForCall := fake.cancelChangeRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) CancelChangeRequestReturns(result1 error) {
+	fake.cancelChangeRequestMutex.Lock()
+	defer fake.cancelChangeRequestMutex.Unlock()
+	fake.CancelChangeRequestStub = nil
+	fake.cancelChangeRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) CancelChangeRequestReturnsOnCall(i int, result1 error) {
+	fake.cancelChangeRequestMutex.Lock()
+	defer fake.cancelChangeRequestMutex.Unlock()
+	fake.CancelChangeRequestStub = nil
+	if fake.cancelChangeRequestReturnsOnCall == nil {
+		fake.cancelChangeRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cancelChangeRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) CreateAccessRule(arg1 context.Context, arg2 uuid.UUID, arg3 model.AccessRuleRequest) (model.AccessRule, error) {
+	fake.createAccessRuleMutex.Lock()
+	ret, specificReturn := fake.createAccessRuleReturnsOnCall[len(fake.createAccessRuleArgsForCall)]
+	fake.createAccessRuleArgsForCall = append(fake.createAccessRuleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.AccessRuleRequest
+	}{arg1, arg2, arg3})
+	stub := fake.CreateAccessRuleStub
+	fakeReturns := fake.createAccessRuleReturns
+	fake.recordInvocation("CreateAccessRule", []interface{}{arg1, arg2, arg3})
+	fake.createAccessRuleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) CreateAccessRuleCallCount() int {
+	fake.createAccessRuleMutex.RLock()
+	defer fake.createAccessRuleMutex.RUnlock()
+	return len(fake.createAccessRuleArgsForCall)
+}
+
+func (fake *FakeService) CreateAccessRuleCalls(stub func(context.Context, uuid.UUID, model.AccessRuleRequest) (model.AccessRule, error)) {
+	fake.createAccessRuleMutex.Lock()
+	defer fake.createAccessRuleMutex.Unlock()
+	fake.CreateAccessRuleStub = stub
+}
+
+func (fake *FakeService) CreateAccessRuleArgsForCall(i int) (context.Context, uuid.UUID, model.AccessRuleRequest) {
+	fake.createAccessRuleMutex.RLock()
+	defer fake.createAccessRuleMutex.RUnlock()
+	argsForCall := fake.createAccessRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) CreateAccessRuleReturns(result1 model.AccessRule, result2 error) {
+	fake.createAccessRuleMutex.Lock()
+	defer fake.createAccessRuleMutex.Unlock()
+	fake.CreateAccessRuleStub = nil
+	fake.createAccessRuleReturns = struct {
+		result1 model.AccessRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateAccessRuleReturnsOnCall(i int, result1 model.AccessRule, result2 error) {
+	fake.createAccessRuleMutex.Lock()
+	defer fake.createAccessRuleMutex.Unlock()
+	fake.CreateAccessRuleStub = nil
+	if fake.createAccessRuleReturnsOnCall == nil {
+		fake.createAccessRuleReturnsOnCall = make(map[int]struct {
+			result1 model.AccessRule
+			result2 error
+		})
+	}
+	fake.createAccessRuleReturnsOnCall[i] = struct {
+		result1 model.AccessRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateChangeRequest(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 model.ChangeRequestRequest) (uuid.UUID, error) {
+	fake.createChangeRequestMutex.Lock()
+	ret, specificReturn := fake.createChangeRequestReturnsOnCall[len(fake.createChangeRequestArgsForCall)]
+	fake.createChangeRequestArgsForCall = append(fake.createChangeRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 model.ChangeRequestRequest
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.CreateChangeRequestStub
+	fakeReturns := fake.createChangeRequestReturns
+	fake.recordInvocation("CreateChangeRequest", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.createChangeRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) CreateChangeRequestCallCount() int {
+	fake.createChangeRequestMutex.RLock()
+	defer fake.createChangeRequestMutex.RUnlock()
+	return len(fake.createChangeRequestArgsForCall)
+}
+
+func (fake *FakeService) CreateChangeRequestCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, model.ChangeRequestRequest) (uuid.UUID, error)) {
+	fake.createChangeRequestMutex.Lock()
+	defer fake.createChangeRequestMutex.Unlock()
+	fake.CreateChangeRequestStub = stub
+}
+
+func (fake *FakeService) CreateChangeRequestArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, model.ChangeRequestRequest) {
+	fake.createChangeRequestMutex.RLock()
+	defer fake.createChangeRequestMutex.RUnlock()
+	argsForCall := fake.createChangeRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeService) CreateChangeRequestReturns(result1 uuid.UUID, result2 error) {
+	fake.createChangeRequestMutex.Lock()
+	defer fake.createChangeRequestMutex.Unlock()
+	fake.CreateChangeRequestStub = nil
+	fake.createChangeRequestReturns = struct {
+		result1 uuid.UUID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateChangeRequestReturnsOnCall(i int, result1 uuid.UUID, result2 error) {
+	fake.createChangeRequestMutex.Lock()
+	defer fake.createChangeRequestMutex.Unlock()
+	fake.CreateChangeRequestStub = nil
+	if fake.createChangeRequestReturnsOnCall == nil {
+		fake.createChangeRequestReturnsOnCall = make(map[int]struct {
+			result1 uuid.UUID
+			result2 error
+		})
+	}
+	fake.createChangeRequestReturnsOnCall[i] = struct {
+		result1 uuid.UUID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateFlag(arg1 context.Context, arg2 uuid.UUID, arg3 model.FeatureFlagRequest) (uuid.UUID, error) {
+	fake.createFlagMutex.Lock()
+	ret, specificReturn := fake.createFlagReturnsOnCall[len(fake.createFlagArgsForCall)]
+	fake.createFlagArgsForCall = append(fake.createFlagArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.FeatureFlagRequest
+	}{arg1, arg2, arg3})
+	stub := fake.CreateFlagStub
+	fakeReturns := fake.createFlagReturns
+	fake.recordInvocation("CreateFlag", []interface{}{arg1, arg2, arg3})
+	fake.createFlagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) CreateFlagCallCount() int {
+	fake.createFlagMutex.RLock()
+	defer fake.createFlagMutex.RUnlock()
+	return len(fake.createFlagArgsForCall)
+}
+
+func (fake *FakeService) CreateFlagCalls(stub func(context.Context, uuid.UUID, model.FeatureFlagRequest) (uuid.UUID, error)) {
+	fake.createFlagMutex.Lock()
+	defer fake.createFlagMutex.Unlock()
+	fake.CreateFlagStub = stub
+}
+
+func (fake *FakeService) CreateFlagArgsForCall(i int) (context.Context, uuid.UUID, model.FeatureFlagRequest) {
+	fake.createFlagMutex.RLock()
+	defer fake.createFlagMutex.RUnlock()
+	argsForCall := fake.createFlagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) CreateFlagReturns(result1 uuid.UUID, result2 error) {
+	fake.createFlagMutex.Lock()
+	defer fake.createFlagMutex.Unlock()
+	fake.CreateFlagStub = nil
+	fake.createFlagReturns = struct {
+		result1 uuid.UUID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateFlagReturnsOnCall(i int, result1 uuid.UUID, result2 error) {
+	fake.createFlagMutex.Lock()
+	defer fake.createFlagMutex.Unlock()
+	fake.CreateFlagStub = nil
+	if fake.createFlagReturnsOnCall == nil {
+		fake.createFlagReturnsOnCall = make(map[int]struct {
+			result1 uuid.UUID
+			result2 error
+		})
+	}
+	fake.createFlagReturnsOnCall[i] = struct {
+		result1 uuid.UUID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateGroup(arg1 context.Context, arg2 uuid.UUID, arg3 model.GroupRequest) (model.Group, error) {
+	fake.createGroupMutex.Lock()
+	ret, specificReturn := fake.createGroupReturnsOnCall[len(fake.createGroupArgsForCall)]
+	fake.createGroupArgsForCall = append(fake.createGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.GroupRequest
+	}{arg1, arg2, arg3})
+	stub := fake.CreateGroupStub
+	fakeReturns := fake.createGroupReturns
+	fake.recordInvocation("CreateGroup", []interface{}{arg1, arg2, arg3})
+	fake.createGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) CreateGroupCallCount() int {
+	fake.createGroupMutex.RLock()
+	defer fake.createGroupMutex.RUnlock()
+	return len(fake.createGroupArgsForCall)
+}
+
+func (fake *FakeService) CreateGroupCalls(stub func(context.Context, uuid.UUID, model.GroupRequest) (model.Group, error)) {
+	fake.createGroupMutex.Lock()
+	defer fake.createGroupMutex.Unlock()
+	fake.CreateGroupStub = stub
+}
+
+func (fake *FakeService) CreateGroupArgsForCall(i int) (context.Context, uuid.UUID, model.GroupRequest) {
+	fake.createGroupMutex.RLock()
+	defer fake.createGroupMutex.RUnlock()
+	argsForCall := fake.createGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) CreateGroupReturns(result1 model.Group, result2 error) {
+	fake.createGroupMutex.Lock()
+	defer fake.createGroupMutex.Unlock()
+	fake.CreateGroupStub = nil
+	fake.createGroupReturns = struct {
+		result1 model.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) CreateGroupReturnsOnCall(i int, result1 model.Group, result2 error) {
+	fake.createGroupMutex.Lock()
+	defer fake.createGroupMutex.Unlock()
+	fake.CreateGroupStub = nil
+	if fake.createGroupReturnsOnCall == nil {
+		fake.createGroupReturnsOnCall = make(map[int]struct {
+			result1 model.Group
+			result2 error
+		})
+	}
+	fake.createGroupReturnsOnCall[i] = struct {
+		result1 model.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) DeleteAccessRule(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deleteAccessRuleMutex.Lock()
+	ret, specificReturn := fake.deleteAccessRuleReturnsOnCall[len(fake.deleteAccessRuleArgsForCall)]
+	fake.deleteAccessRuleArgsForCall = append(fake.deleteAccessRuleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteAccessRuleStub
+	fakeReturns := fake.deleteAccessRuleReturns
+	fake.recordInvocation("DeleteAccessRule", []interface{}{arg1, arg2, arg3})
+	fake.deleteAccessRuleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) DeleteAccessRuleCallCount() int {
+	fake.deleteAccessRuleMutex.RLock()
+	defer fake.deleteAccessRuleMutex.RUnlock()
+	return len(fake.deleteAccessRuleArgsForCall)
+}
+
+func (fake *FakeService) DeleteAccessRuleCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deleteAccessRuleMutex.Lock()
+	defer fake.deleteAccessRuleMutex.Unlock()
+	fake.DeleteAccessRuleStub = stub
+}
+
+func (fake *FakeService) DeleteAccessRuleArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteAccessRuleMutex.RLock()
+	defer fake.deleteAccessRuleMutex.RUnlock()
+	argsForCall := fake.deleteAccessRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) DeleteAccessRuleReturns(result1 error) {
+	fake.deleteAccessRuleMutex.Lock()
+	defer fake.deleteAccessRuleMutex.Unlock()
+	fake.DeleteAccessRuleStub = nil
+	fake.deleteAccessRuleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DeleteAccessRuleReturnsOnCall(i int, result1 error) {
+	fake.deleteAccessRuleMutex.Lock()
+	defer fake.deleteAccessRuleMutex.Unlock()
+	fake.DeleteAccessRuleStub = nil
+	if fake.deleteAccessRuleReturnsOnCall == nil {
+		fake.deleteAccessRuleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAccessRuleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DeleteFlag(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deleteFlagMutex.Lock()
+	ret, specificReturn := fake.deleteFlagReturnsOnCall[len(fake.deleteFlagArgsForCall)]
+	fake.deleteFlagArgsForCall = append(fake.deleteFlagArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteFlagStub
+	fakeReturns := fake.deleteFlagReturns
+	fake.recordInvocation("DeleteFlag", []interface{}{arg1, arg2, arg3})
+	fake.deleteFlagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) DeleteFlagCallCount() int {
+	fake.deleteFlagMutex.RLock()
+	defer fake.deleteFlagMutex.RUnlock()
+	return len(fake.deleteFlagArgsForCall)
+}
+
+func (fake *FakeService) DeleteFlagCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deleteFlagMutex.Lock()
+	defer fake.deleteFlagMutex.Unlock()
+	fake.DeleteFlagStub = stub
+}
+
+func (fake *FakeService) DeleteFlagArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteFlagMutex.RLock()
+	defer fake.deleteFlagMutex.RUnlock()
+	argsForCall := fake.deleteFlagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) DeleteFlagReturns(result1 error) {
+	fake.deleteFlagMutex.Lock()
+	defer fake.deleteFlagMutex.Unlock()
+	fake.DeleteFlagStub = nil
+	fake.deleteFlagReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DeleteFlagReturnsOnCall(i int, result1 error) {
+	fake.deleteFlagMutex.Lock()
+	defer fake.deleteFlagMutex.Unlock()
+	fake.DeleteFlagStub = nil
+	if fake.deleteFlagReturnsOnCall == nil {
+		fake.deleteFlagReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteFlagReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DeleteGroup(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deleteGroupMutex.Lock()
+	ret, specificReturn := fake.deleteGroupReturnsOnCall[len(fake.deleteGroupArgsForCall)]
+	fake.deleteGroupArgsForCall = append(fake.deleteGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteGroupStub
+	fakeReturns := fake.deleteGroupReturns
+	fake.recordInvocation("DeleteGroup", []interface{}{arg1, arg2, arg3})
+	fake.deleteGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeService) DeleteGroupCallCount() int {
+	fake.deleteGroupMutex.RLock()
+	defer fake.deleteGroupMutex.RUnlock()
+	return len(fake.deleteGroupArgsForCall)
+}
+
+func (fake *FakeService) DeleteGroupCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deleteGroupMutex.Lock()
+	defer fake.deleteGroupMutex.Unlock()
+	fake.DeleteGroupStub = stub
+}
+
+func (fake *FakeService) DeleteGroupArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteGroupMutex.RLock()
+	defer fake.deleteGroupMutex.RUnlock()
+	argsForCall := fake.deleteGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) DeleteGroupReturns(result1 error) {
+	fake.deleteGroupMutex.Lock()
+	defer fake.deleteGroupMutex.Unlock()
+	fake.DeleteGroupStub = nil
+	fake.deleteGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) DeleteGroupReturnsOnCall(i int, result1 error) {
+	fake.deleteGroupMutex.Lock()
+	defer fake.deleteGroupMutex.Unlock()
+	fake.DeleteGroupStub = nil
+	if fake.deleteGroupReturnsOnCall == nil {
+		fake.deleteGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeService) EvaluateFlag(arg1 context.Context, arg2 uuid.UUID, arg3 string) (model.FlagEvaluation, error) {
+	fake.evaluateFlagMutex.Lock()
+	ret, specificReturn := fake.evaluateFlagReturnsOnCall[len(fake.evaluateFlagArgsForCall)]
+	fake.evaluateFlagArgsForCall = append(fake.evaluateFlagArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.EvaluateFlagStub
+	fakeReturns := fake.evaluateFlagReturns
+	fake.recordInvocation("EvaluateFlag", []interface{}{arg1, arg2, arg3})
+	fake.evaluateFlagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) EvaluateFlagCallCount() int {
+	fake.evaluateFlagMutex.RLock()
+	defer fake.evaluateFlagMutex.RUnlock()
+	return len(fake.evaluateFlagArgsForCall)
+}
+
+func (fake *FakeService) EvaluateFlagCalls(stub func(context.Context, uuid.UUID, string) (model.FlagEvaluation, error)) {
+	fake.evaluateFlagMutex.Lock()
+	defer fake.evaluateFlagMutex.Unlock()
+	fake.EvaluateFlagStub = stub
+}
+
+func (fake *FakeService) EvaluateFlagArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.evaluateFlagMutex.RLock()
+	defer fake.evaluateFlagMutex.RUnlock()
+	argsForCall := fake.evaluateFlagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) EvaluateFlagReturns(result1 model.FlagEvaluation, result2 error) {
+	fake.evaluateFlagMutex.Lock()
+	defer fake.evaluateFlagMutex.Unlock()
+	fake.EvaluateFlagStub = nil
+	fake.evaluateFlagReturns = struct {
+		result1 model.FlagEvaluation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) EvaluateFlagReturnsOnCall(i int, result1 model.FlagEvaluation, result2 error) {
+	fake.evaluateFlagMutex.Lock()
+	defer fake.evaluateFlagMutex.Unlock()
+	fake.EvaluateFlagStub = nil
+	if fake.evaluateFlagReturnsOnCall == nil {
+		fake.evaluateFlagReturnsOnCall = make(map[int]struct {
+			result1 model.FlagEvaluation
+			result2 error
+		})
+	}
+	fake.evaluateFlagReturnsOnCall[i] = struct {
+		result1 model.FlagEvaluation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GenerateReport(arg1 context.Context, arg2 uuid.UUID, arg3 int) (model.FlagReport, error) {
+	fake.generateReportMutex.Lock()
+	ret, specificReturn := fake.generateReportReturnsOnCall[len(fake.generateReportArgsForCall)]
+	fake.generateReportArgsForCall = append(fake.generateReportArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GenerateReportStub
+	fakeReturns := fake.generateReportReturns
+	fake.recordInvocation("GenerateReport", []interface{}{arg1, arg2, arg3})
+	fake.generateReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) GenerateReportCallCount() int {
+	fake.generateReportMutex.RLock()
+	defer fake.generateReportMutex.RUnlock()
+	return len(fake.generateReportArgsForCall)
+}
+
+func (fake *FakeService) GenerateReportCalls(stub func(context.Context, uuid.UUID, int) (model.FlagReport, error)) {
+	fake.generateReportMutex.Lock()
+	defer fake.generateReportMutex.Unlock()
+	fake.GenerateReportStub = stub
+}
+
+func (fake *FakeService) GenerateReportArgsForCall(i int) (context.Context, uuid.UUID, int) {
+	fake.generateReportMutex.RLock()
+	defer fake.generateReportMutex.RUnlock()
+	argsForCall := fake.generateReportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) GenerateReportReturns(result1 model.FlagReport, result2 error) {
+	fake.generateReportMutex.Lock()
+	defer fake.generateReportMutex.Unlock()
+	fake.GenerateReportStub = nil
+	fake.generateReportReturns = struct {
+		result1 model.FlagReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GenerateReportReturnsOnCall(i int, result1 model.FlagReport, result2 error) {
+	fake.generateReportMutex.Lock()
+	defer fake.generateReportMutex.Unlock()
+	fake.GenerateReportStub = nil
+	if fake.generateReportReturnsOnCall == nil {
+		fake.generateReportReturnsOnCall = make(map[int]struct {
+			result1 model.FlagReport
+			result2 error
+		})
+	}
+	fake.generateReportReturnsOnCall[i] = struct {
+		result1 model.FlagReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetChangeRequest(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (model.ChangeRequest, error) {
+	fake.getChangeRequestMutex.Lock()
+	ret, specificReturn := fake.getChangeRequestReturnsOnCall[len(fake.getChangeRequestArgsForCall)]
+	fake.getChangeRequestArgsForCall = append(fake.getChangeRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetChangeRequestStub
+	fakeReturns := fake.getChangeRequestReturns
+	fake.recordInvocation("GetChangeRequest", []interface{}{arg1, arg2, arg3})
+	fake.getChangeRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) GetChangeRequestCallCount() int {
+	fake.getChangeRequestMutex.RLock()
+	defer fake.getChangeRequestMutex.RUnlock()
+	return len(fake.getChangeRequestArgsForCall)
+}
+
+func (fake *FakeService) GetChangeRequestCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (model.ChangeRequest, error)) {
+	fake.getChangeRequestMutex.Lock()
+	defer fake.getChangeRequestMutex.Unlock()
+	fake.GetChangeRequestStub = stub
+}
+
+func (fake *FakeService) GetChangeRequestArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getChangeRequestMutex.RLock()
+	defer fake.getChangeRequestMutex.RUnlock()
+	argsForCall := fake.getChangeRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) GetChangeRequestReturns(result1 model.ChangeRequest, result2 error) {
+	fake.getChangeRequestMutex.Lock()
+	defer fake.getChangeRequestMutex.Unlock()
+	fake.GetChangeRequestStub = nil
+	fake.getChangeRequestReturns = struct {
+		result1 model.ChangeRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetChangeRequestReturnsOnCall(i int, result1 model.ChangeRequest, result2 error) {
+	fake.getChangeRequestMutex.Lock()
+	defer fake.getChangeRequestMutex.Unlock()
+	fake.GetChangeRequestStub = nil
+	if fake.getChangeRequestReturnsOnCall == nil {
+		fake.getChangeRequestReturnsOnCall = make(map[int]struct {
+			result1 model.ChangeRequest
+			result2 error
+		})
+	}
+	fake.getChangeRequestReturnsOnCall[i] = struct {
+		result1 model.ChangeRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetFlagByID(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (model.FeatureFlag, error) {
+	fake.getFlagByIDMutex.Lock()
+	ret, specificReturn := fake.getFlagByIDReturnsOnCall[len(fake.getFlagByIDArgsForCall)]
+	fake.getFlagByIDArgsForCall = append(fake.getFlagByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetFlagByIDStub
+	fakeReturns := fake.getFlagByIDReturns
+	fake.recordInvocation("GetFlagByID", []interface{}{arg1, arg2, arg3})
+	fake.getFlagByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) GetFlagByIDCallCount() int {
+	fake.getFlagByIDMutex.RLock()
+	defer fake.getFlagByIDMutex.RUnlock()
+	return len(fake.getFlagByIDArgsForCall)
+}
+
+func (fake *FakeService) GetFlagByIDCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (model.FeatureFlag, error)) {
+	fake.getFlagByIDMutex.Lock()
+	defer fake.getFlagByIDMutex.Unlock()
+	fake.GetFlagByIDStub = stub
+}
+
+func (fake *FakeService) GetFlagByIDArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getFlagByIDMutex.RLock()
+	defer fake.getFlagByIDMutex.RUnlock()
+	argsForCall := fake.getFlagByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) GetFlagByIDReturns(result1 model.FeatureFlag, result2 error) {
+	fake.getFlagByIDMutex.Lock()
+	defer fake.getFlagByIDMutex.Unlock()
+	fake.GetFlagByIDStub = nil
+	fake.getFlagByIDReturns = struct {
+		result1 model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetFlagByIDReturnsOnCall(i int, result1 model.FeatureFlag, result2 error) {
+	fake.getFlagByIDMutex.Lock()
+	defer fake.getFlagByIDMutex.Unlock()
+	fake.GetFlagByIDStub = nil
+	if fake.getFlagByIDReturnsOnCall == nil {
+		fake.getFlagByIDReturnsOnCall = make(map[int]struct {
+			result1 model.FeatureFlag
+			result2 error
+		})
+	}
+	fake.getFlagByIDReturnsOnCall[i] = struct {
+		result1 model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetGroup(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (model.Group, error) {
+	fake.getGroupMutex.Lock()
+	ret, specificReturn := fake.getGroupReturnsOnCall[len(fake.getGroupArgsForCall)]
+	fake.getGroupArgsForCall = append(fake.getGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetGroupStub
+	fakeReturns := fake.getGroupReturns
+	fake.recordInvocation("GetGroup", []interface{}{arg1, arg2, arg3})
+	fake.getGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) GetGroupCallCount() int {
+	fake.getGroupMutex.RLock()
+	defer fake.getGroupMutex.RUnlock()
+	return len(fake.getGroupArgsForCall)
+}
+
+func (fake *FakeService) GetGroupCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (model.Group, error)) {
+	fake.getGroupMutex.Lock()
+	defer fake.getGroupMutex.Unlock()
+	fake.GetGroupStub = stub
+}
+
+func (fake *FakeService) GetGroupArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getGroupMutex.RLock()
+	defer fake.getGroupMutex.RUnlock()
+	argsForCall := fake.getGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) GetGroupReturns(result1 model.Group, result2 error) {
+	fake.getGroupMutex.Lock()
+	defer fake.getGroupMutex.Unlock()
+	fake.GetGroupStub = nil
+	fake.getGroupReturns = struct {
+		result1 model.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetGroupReturnsOnCall(i int, result1 model.Group, result2 error) {
+	fake.getGroupMutex.Lock()
+	defer fake.getGroupMutex.Unlock()
+	fake.GetGroupStub = nil
+	if fake.getGroupReturnsOnCall == nil {
+		fake.getGroupReturnsOnCall = make(map[int]struct {
+			result1 model.Group
+			result2 error
+		})
+	}
+	fake.getGroupReturnsOnCall[i] = struct {
+		result1 model.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetKillSwitchStatus(arg1 context.Context, arg2 uuid.UUID) (model.KillSwitchStatus, error) {
+	fake.getKillSwitchStatusMutex.Lock()
+	ret, specificReturn := fake.getKillSwitchStatusReturnsOnCall[len(fake.getKillSwitchStatusArgsForCall)]
+	fake.getKillSwitchStatusArgsForCall = append(fake.getKillSwitchStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetKillSwitchStatusStub
+	fakeReturns := fake.getKillSwitchStatusReturns
+	fake.recordInvocation("GetKillSwitchStatus", []interface{}{arg1, arg2})
+	fake.getKillSwitchStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) GetKillSwitchStatusCallCount() int {
+	fake.getKillSwitchStatusMutex.RLock()
+	defer fake.getKillSwitchStatusMutex.RUnlock()
+	return len(fake.getKillSwitchStatusArgsForCall)
+}
+
+func (fake *FakeService) GetKillSwitchStatusCalls(stub func(context.Context, uuid.UUID) (model.KillSwitchStatus, error)) {
+	fake.getKillSwitchStatusMutex.Lock()
+	defer fake.getKillSwitchStatusMutex.Unlock()
+	fake.GetKillSwitchStatusStub = stub
+}
+
+func (fake *FakeService) GetKillSwitchStatusArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getKillSwitchStatusMutex.RLock()
+	defer fake.getKillSwitchStatusMutex.RUnlock()
+	argsForCall := fake.getKillSwitchStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) GetKillSwitchStatusReturns(result1 model.KillSwitchStatus, result2 error) {
+	fake.getKillSwitchStatusMutex.Lock()
+	defer fake.getKillSwitchStatusMutex.Unlock()
+	fake.GetKillSwitchStatusStub = nil
+	fake.getKillSwitchStatusReturns = struct {
+		result1 model.KillSwitchStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) GetKillSwitchStatusReturnsOnCall(i int, result1 model.KillSwitchStatus, result2 error) {
+	fake.getKillSwitchStatusMutex.Lock()
+	defer fake.getKillSwitchStatusMutex.Unlock()
+	fake.GetKillSwitchStatusStub = nil
+	if fake.getKillSwitchStatusReturnsOnCall == nil {
+		fake.getKillSwitchStatusReturnsOnCall = make(map[int]struct {
+			result1 model.KillSwitchStatus
+			result2 error
+		})
+	}
+	fake.getKillSwitchStatusReturnsOnCall[i] = struct {
+		result1 model.KillSwitchStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListAccessRules(arg1 context.Context, arg2 uuid.UUID) ([]model.AccessRule, error) {
+	fake.listAccessRulesMutex.Lock()
+	ret, specificReturn := fake.listAccessRulesReturnsOnCall[len(fake.listAccessRulesArgsForCall)]
+	fake.listAccessRulesArgsForCall = append(fake.listAccessRulesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListAccessRulesStub
+	fakeReturns := fake.listAccessRulesReturns
+	fake.recordInvocation("ListAccessRules", []interface{}{arg1, arg2})
+	fake.listAccessRulesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListAccessRulesCallCount() int {
+	fake.listAccessRulesMutex.RLock()
+	defer fake.listAccessRulesMutex.RUnlock()
+	return len(fake.listAccessRulesArgsForCall)
+}
+
+func (fake *FakeService) ListAccessRulesCalls(stub func(context.Context, uuid.UUID) ([]model.AccessRule, error)) {
+	fake.listAccessRulesMutex.Lock()
+	defer fake.listAccessRulesMutex.Unlock()
+	fake.ListAccessRulesStub = stub
+}
+
+func (fake *FakeService) ListAccessRulesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listAccessRulesMutex.RLock()
+	defer fake.listAccessRulesMutex.RUnlock()
+	argsForCall := fake.listAccessRulesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) ListAccessRulesReturns(result1 []model.AccessRule, result2 error) {
+	fake.listAccessRulesMutex.Lock()
+	defer fake.listAccessRulesMutex.Unlock()
+	fake.ListAccessRulesStub = nil
+	fake.listAccessRulesReturns = struct {
+		result1 []model.AccessRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListAccessRulesReturnsOnCall(i int, result1 []model.AccessRule, result2 error) {
+	fake.listAccessRulesMutex.Lock()
+	defer fake.listAccessRulesMutex.Unlock()
+	fake.ListAccessRulesStub = nil
+	if fake.listAccessRulesReturnsOnCall == nil {
+		fake.listAccessRulesReturnsOnCall = make(map[int]struct {
+			result1 []model.AccessRule
+			result2 error
+		})
+	}
+	fake.listAccessRulesReturnsOnCall[i] = struct {
+		result1 []model.AccessRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListChangeRequests(arg1 context.Context, arg2 uuid.UUID, arg3 model.ChangeRequestStatus) ([]model.ChangeRequest, error) {
+	fake.listChangeRequestsMutex.Lock()
+	ret, specificReturn := fake.listChangeRequestsReturnsOnCall[len(fake.listChangeRequestsArgsForCall)]
+	fake.listChangeRequestsArgsForCall = append(fake.listChangeRequestsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.ChangeRequestStatus
+	}{arg1, arg2, arg3})
+	stub := fake.ListChangeRequestsStub
+	fakeReturns := fake.listChangeRequestsReturns
+	fake.recordInvocation("ListChangeRequests", []interface{}{arg1, arg2, arg3})
+	fake.listChangeRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListChangeRequestsCallCount() int {
+	fake.listChangeRequestsMutex.RLock()
+	defer fake.listChangeRequestsMutex.RUnlock()
+	return len(fake.listChangeRequestsArgsForCall)
+}
+
+func (fake *FakeService) ListChangeRequestsCalls(stub func(context.Context, uuid.UUID, model.ChangeRequestStatus) ([]model.ChangeRequest, error)) {
+	fake.listChangeRequestsMutex.Lock()
+	defer fake.listChangeRequestsMutex.Unlock()
+	fake.ListChangeRequestsStub = stub
+}
+
+func (fake *FakeService) ListChangeRequestsArgsForCall(i int) (context.Context, uuid.UUID, model.ChangeRequestStatus) {
+	fake.listChangeRequestsMutex.RLock()
+	defer fake.listChangeRequestsMutex.RUnlock()
+	argsForCall := fake.listChangeRequestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) ListChangeRequestsReturns(result1 []model.ChangeRequest, result2 error) {
+	fake.listChangeRequestsMutex.Lock()
+	defer fake.listChangeRequestsMutex.Unlock()
+	fake.ListChangeRequestsStub = nil
+	fake.listChangeRequestsReturns = struct {
+		result1 []model.ChangeRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListChangeRequestsReturnsOnCall(i int, result1 []model.ChangeRequest, result2 error) {
+	fake.listChangeRequestsMutex.Lock()
+	defer fake.listChangeRequestsMutex.Unlock()
+	fake.ListChangeRequestsStub = nil
+	if fake.listChangeRequestsReturnsOnCall == nil {
+		fake.listChangeRequestsReturnsOnCall = make(map[int]struct {
+			result1 []model.ChangeRequest
+			result2 error
+		})
+	}
+	fake.listChangeRequestsReturnsOnCall[i] = struct {
+		result1 []model.ChangeRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListCodeReferences(arg1 context.Context, arg2 uuid.UUID, arg3 string) ([]model.CodeReference, error) {
+	fake.listCodeReferencesMutex.Lock()
+	ret, specificReturn := fake.listCodeReferencesReturnsOnCall[len(fake.listCodeReferencesArgsForCall)]
+	fake.listCodeReferencesArgsForCall = append(fake.listCodeReferencesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ListCodeReferencesStub
+	fakeReturns := fake.listCodeReferencesReturns
+	fake.recordInvocation("ListCodeReferences", []interface{}{arg1, arg2, arg3})
+	fake.listCodeReferencesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListCodeReferencesCallCount() int {
+	fake.listCodeReferencesMutex.RLock()
+	defer fake.listCodeReferencesMutex.RUnlock()
+	return len(fake.listCodeReferencesArgsForCall)
+}
+
+func (fake *FakeService) ListCodeReferencesCalls(stub func(context.Context, uuid.UUID, string) ([]model.CodeReference, error)) {
+	fake.listCodeReferencesMutex.Lock()
+	defer fake.listCodeReferencesMutex.Unlock()
+	fake.ListCodeReferencesStub = stub
+}
+
+func (fake *FakeService) ListCodeReferencesArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.listCodeReferencesMutex.RLock()
+	defer fake.listCodeReferencesMutex.RUnlock()
+	argsForCall := fake.listCodeReferencesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) ListCodeReferencesReturns(result1 []model.CodeReference, result2 error) {
+	fake.listCodeReferencesMutex.Lock()
+	defer fake.listCodeReferencesMutex.Unlock()
+	fake.ListCodeReferencesStub = nil
+	fake.listCodeReferencesReturns = struct {
+		result1 []model.CodeReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListCodeReferencesReturnsOnCall(i int, result1 []model.CodeReference, result2 error) {
+	fake.listCodeReferencesMutex.Lock()
+	defer fake.listCodeReferencesMutex.Unlock()
+	fake.ListCodeReferencesStub = nil
+	if fake.listCodeReferencesReturnsOnCall == nil {
+		fake.listCodeReferencesReturnsOnCall = make(map[int]struct {
+			result1 []model.CodeReference
+			result2 error
+		})
+	}
+	fake.listCodeReferencesReturnsOnCall[i] = struct {
+		result1 []model.CodeReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListFlags(arg1 context.Context, arg2 uuid.UUID, arg3 model.FlagFilter) ([]model.FeatureFlag, error) {
+	fake.listFlagsMutex.Lock()
+	ret, specificReturn := fake.listFlagsReturnsOnCall[len(fake.listFlagsArgsForCall)]
+	fake.listFlagsArgsForCall = append(fake.listFlagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.FlagFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListFlagsStub
+	fakeReturns := fake.listFlagsReturns
+	fake.recordInvocation("ListFlags", []interface{}{arg1, arg2, arg3})
+	fake.listFlagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListFlagsCallCount() int {
+	fake.listFlagsMutex.RLock()
+	defer fake.listFlagsMutex.RUnlock()
+	return len(fake.listFlagsArgsForCall)
+}
+
+func (fake *FakeService) ListFlagsCalls(stub func(context.Context, uuid.UUID, model.FlagFilter) ([]model.FeatureFlag, error)) {
+	fake.listFlagsMutex.Lock()
+	defer fake.listFlagsMutex.Unlock()
+	fake.ListFlagsStub = stub
+}
+
+func (fake *FakeService) ListFlagsArgsForCall(i int) (context.Context, uuid.UUID, model.FlagFilter) {
+	fake.listFlagsMutex.RLock()
+	defer fake.listFlagsMutex.RUnlock()
+	argsForCall := fake.listFlagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) ListFlagsReturns(result1 []model.FeatureFlag, result2 error) {
+	fake.listFlagsMutex.Lock()
+	defer fake.listFlagsMutex.Unlock()
+	fake.ListFlagsStub = nil
+	fake.listFlagsReturns = struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListFlagsReturnsOnCall(i int, result1 []model.FeatureFlag, result2 error) {
+	fake.listFlagsMutex.Lock()
+	defer fake.listFlagsMutex.Unlock()
+	fake.ListFlagsStub = nil
+	if fake.listFlagsReturnsOnCall == nil {
+		fake.listFlagsReturnsOnCall = make(map[int]struct {
+			result1 []model.FeatureFlag
+			result2 error
+		})
+	}
+	fake.listFlagsReturnsOnCall[i] = struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListGroupMembers(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]model.GroupMember, error) {
+	fake.listGroupMembersMutex.Lock()
+	ret, specificReturn := fake.listGroupMembersReturnsOnCall[len(fake.listGroupMembersArgsForCall)]
+	fake.listGroupMembersArgsForCall = append(fake.listGroupMembersArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListGroupMembersStub
+	fakeReturns := fake.listGroupMembersReturns
+	fake.recordInvocation("ListGroupMembers", []interface{}{arg1, arg2, arg3})
+	fake.listGroupMembersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListGroupMembersCallCount() int {
+	fake.listGroupMembersMutex.RLock()
+	defer fake.listGroupMembersMutex.RUnlock()
+	return len(fake.listGroupMembersArgsForCall)
+}
+
+func (fake *FakeService) ListGroupMembersCalls(stub func(context.Context, uuid.UUID, uuid.UUID) ([]model.GroupMember, error)) {
+	fake.listGroupMembersMutex.Lock()
+	defer fake.listGroupMembersMutex.Unlock()
+	fake.ListGroupMembersStub = stub
+}
+
+func (fake *FakeService) ListGroupMembersArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.listGroupMembersMutex.RLock()
+	defer fake.listGroupMembersMutex.RUnlock()
+	argsForCall := fake.listGroupMembersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) ListGroupMembersReturns(result1 []model.GroupMember, result2 error) {
+	fake.listGroupMembersMutex.Lock()
+	defer fake.listGroupMembersMutex.Unlock()
+	fake.ListGroupMembersStub = nil
+	fake.listGroupMembersReturns = struct {
+		result1 []model.GroupMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListGroupMembersReturnsOnCall(i int, result1 []model.GroupMember, result2 error) {
+	fake.listGroupMembersMutex.Lock()
+	defer fake.listGroupMembersMutex.Unlock()
+	fake.ListGroupMembersStub = nil
+	if fake.listGroupMembersReturnsOnCall == nil {
+		fake.listGroupMembersReturnsOnCall = make(map[int]struct {
+			result1 []model.GroupMember
+			result2 error
+		})
+	}
+	fake.listGroupMembersReturnsOnCall[i] = struct {
+		result1 []model.GroupMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListGroups(arg1 context.Context, arg2 uuid.UUID) ([]model.Group, error) {
+	fake.listGroupsMutex.Lock()
+	ret, specificReturn := fake.listGroupsReturnsOnCall[len(fake.listGroupsArgsForCall)]
+	fake.listGroupsArgsForCall = append(fake.listGroupsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListGroupsStub
+	fakeReturns := fake.listGroupsReturns
+	fake.recordInvocation("ListGroups", []interface{}{arg1, arg2})
+	fake.listGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListGroupsCallCount() int {
+	fake.listGroupsMutex.RLock()
+	defer fake.listGroupsMutex.RUnlock()
+	return len(fake.listGroupsArgsForCall)
+}
+
+func (fake *FakeService) ListGroupsCalls(stub func(context.Context, uuid.UUID) ([]model.Group, error)) {
+	fake.listGroupsMutex.Lock()
+	defer fake.listGroupsMutex.Unlock()
+	fake.ListGroupsStub = stub
+}
+
+func (fake *FakeService) ListGroupsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listGroupsMutex.RLock()
+	defer fake.listGroupsMutex.RUnlock()
+	argsForCall := fake.listGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeService) ListGroupsReturns(result1 []model.Group, result2 error) {
+	fake.listGroupsMutex.Lock()
+	defer fake.listGroupsMutex.Unlock()
+	fake.ListGroupsStub = nil
+	fake.listGroupsReturns = struct {
+		result1 []model.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListGroupsReturnsOnCall(i int, result1 []model.Group, result2 error) {
+	fake.listGroupsMutex.Lock()
+	defer fake.listGroupsMutex.Unlock()
+	fake.ListGroupsStub = nil
+	if fake.listGroupsReturnsOnCall == nil {
+		fake.listGroupsReturnsOnCall = make(map[int]struct {
+			result1 []model.Group
+			result2 error
+		})
+	}
+	fake.listGroupsReturnsOnCall[i] = struct {
+		result1 []model.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListOverdueFlags(arg1 context.Context, arg2 uuid.UUID, arg3 string) ([]model.OwnerOverdueFlags, error) {
+	fake.listOverdueFlagsMutex.Lock()
+	ret, specificReturn := fake.listOverdueFlagsReturnsOnCall[len(fake.listOverdueFlagsArgsForCall)]
+	fake.listOverdueFlagsArgsForCall = append(fake.listOverdueFlagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ListOverdueFlagsStub
+	fakeReturns := fake.listOverdueFlagsReturns
+	fake.recordInvocation("ListOverdueFlags", []interface{}{arg1, arg2, arg3})
+	fake.listOverdueFlagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
-	updateFlagReturns struct {
-		result1 error
+	if specificReturn {
+		return ret.result1, ret.result2
 	}
-	updateFlagReturnsOnCall map[int]struct {
-		result1 error
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) ListOverdueFlagsCallCount() int {
+	fake.listOverdueFlagsMutex.RLock()
+	defer fake.listOverdueFlagsMutex.RUnlock()
+	return len(fake.listOverdueFlagsArgsForCall)
+}
+
+func (fake *FakeService) ListOverdueFlagsCalls(stub func(context.Context, uuid.UUID, string) ([]model.OwnerOverdueFlags, error)) {
+	fake.listOverdueFlagsMutex.Lock()
+	defer fake.listOverdueFlagsMutex.Unlock()
+	fake.ListOverdueFlagsStub = stub
+}
+
+func (fake *FakeService) ListOverdueFlagsArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.listOverdueFlagsMutex.RLock()
+	defer fake.listOverdueFlagsMutex.RUnlock()
+	argsForCall := fake.listOverdueFlagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeService) ListOverdueFlagsReturns(result1 []model.OwnerOverdueFlags, result2 error) {
+	fake.listOverdueFlagsMutex.Lock()
+	defer fake.listOverdueFlagsMutex.Unlock()
+	fake.ListOverdueFlagsStub = nil
+	fake.listOverdueFlagsReturns = struct {
+		result1 []model.OwnerOverdueFlags
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) ListOverdueFlagsReturnsOnCall(i int, result1 []model.OwnerOverdueFlags, result2 error) {
+	fake.listOverdueFlagsMutex.Lock()
+	defer fake.listOverdueFlagsMutex.Unlock()
+	fake.ListOverdueFlagsStub = nil
+	if fake.listOverdueFlagsReturnsOnCall == nil {
+		fake.listOverdueFlagsReturnsOnCall = make(map[int]struct {
+			result1 []model.OwnerOverdueFlags
+			result2 error
+		})
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
+	fake.listOverdueFlagsReturnsOnCall[i] = struct {
+		result1 []model.OwnerOverdueFlags
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeService) CreateFlag(arg1 context.Context, arg2 model.FeatureFlagRequest) (uuid.UUID, error) {
-	fake.createFlagMutex.Lock()
-	ret, specificReturn := fake.createFlagReturnsOnCall[len(fake.createFlagArgsForCall)]
-	fake.createFlagArgsForCall = append(fake.createFlagArgsForCall, struct {
+func (fake *FakeService) ListUserGroupIDs(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]uuid.UUID, error) {
+	fake.listUserGroupIDsMutex.Lock()
+	ret, specificReturn := fake.listUserGroupIDsReturnsOnCall[len(fake.listUserGroupIDsArgsForCall)]
+	fake.listUserGroupIDsArgsForCall = append(fake.listUserGroupIDsArgsForCall, struct {
 		arg1 context.Context
-		arg2 model.FeatureFlagRequest
-	}{arg1, arg2})
-	stub := fake.CreateFlagStub
-	fakeReturns := fake.createFlagReturns
-	fake.recordInvocation("CreateFlag", []interface{}{arg1, arg2})
-	fake.createFlagMutex.Unlock()
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListUserGroupIDsStub
+	fakeReturns := fake.listUserGroupIDsReturns
+	fake.recordInvocation("ListUserGroupIDs", []interface{}{arg1, arg2, arg3})
+	fake.listUserGroupIDsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -101,64 +2139,67 @@ func (fake *FakeService) CreateFlag(arg1 context.Context, arg2 model.FeatureFlag
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeService) CreateFlagCallCount() int {
-	fake.createFlagMutex.RLock()
-	defer fake.createFlagMutex.RUnlock()
-	return len(fake.createFlagArgsForCall)
+func (fake *FakeService) ListUserGroupIDsCallCount() int {
+	fake.listUserGroupIDsMutex.RLock()
+	defer fake.listUserGroupIDsMutex.RUnlock()
+	return len(fake.listUserGroupIDsArgsForCall)
 }
 
-func (fake *FakeService) CreateFlagCalls(stub func(context.Context, model.FeatureFlagRequest) (uuid.UUID, error)) {
-	fake.createFlagMutex.Lock()
-	defer fake.createFlagMutex.Unlock()
-	fake.CreateFlagStub = stub
+func (fake *FakeService) ListUserGroupIDsCalls(stub func(context.Context, uuid.UUID, uuid.UUID) ([]uuid.UUID, error)) {
+	fake.listUserGroupIDsMutex.Lock()
+	defer fake.listUserGroupIDsMutex.Unlock()
+	fake.ListUserGroupIDsStub = stub
 }
 
-func (fake *FakeService) CreateFlagArgsForCall(i int) (context.Context, model.FeatureFlagRequest) {
-	fake.createFlagMutex.RLock()
-	defer fake.createFlagMutex.RUnlock()
-	argsForCall := fake.createFlagArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeService) ListUserGroupIDsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.listUserGroupIDsMutex.RLock()
+	defer fake.listUserGroupIDsMutex.RUnlock()
+	argsForCall := fake.listUserGroupIDsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeService) CreateFlagReturns(result1 uuid.UUID, result2 error) {
-	fake.createFlagMutex.Lock()
-	defer fake.createFlagMutex.Unlock()
-	fake.CreateFlagStub = nil
-	fake.createFlagReturns = struct {
-		result1 uuid.UUID
+func (fake *FakeService) ListUserGroupIDsReturns(result1 []uuid.UUID, result2 error) {
+	fake.listUserGroupIDsMutex.Lock()
+	defer fake.listUserGroupIDsMutex.Unlock()
+	fake.ListUserGroupIDsStub = nil
+	fake.listUserGroupIDsReturns = struct {
+		result1 []uuid.UUID
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeService) CreateFlagReturnsOnCall(i int, result1 uuid.UUID, result2 error) {
-	fake.createFlagMutex.Lock()
-	defer fake.createFlagMutex.Unlock()
-	fake.CreateFlagStub = nil
-	if fake.createFlagReturnsOnCall == nil {
-		fake.createFlagReturnsOnCall = make(map[int]struct {
-			result1 uuid.UUID
+func (fake *FakeService) ListUserGroupIDsReturnsOnCall(i int, result1 []uuid.UUID, result2 error) {
+	fake.listUserGroupIDsMutex.Lock()
+	defer fake.listUserGroupIDsMutex.Unlock()
+	fake.ListUserGroupIDsStub = nil
+	if fake.listUserGroupIDsReturnsOnCall == nil {
+		fake.listUserGroupIDsReturnsOnCall = make(map[int]struct {
+			result1 []uuid.UUID
 			result2 error
 		})
 	}
-	fake.createFlagReturnsOnCall[i] = struct {
-		result1 uuid.UUID
+	fake.listUserGroupIDsReturnsOnCall[i] = struct {
+		result1 []uuid.UUID
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeService) DeleteFlag(arg1 context.Context, arg2 uuid.UUID) error {
-	fake.deleteFlagMutex.Lock()
-	ret, specificReturn := fake.deleteFlagReturnsOnCall[len(fake.deleteFlagArgsForCall)]
-	fake.deleteFlagArgsForCall = append(fake.deleteFlagArgsForCall, struct {
+func (fake *FakeService) RejectChangeRequest(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID, arg5 string) error {
+	fake.rejectChangeRequestMutex.Lock()
+	ret, specificReturn := fake.rejectChangeRequestReturnsOnCall[len(fake.rejectChangeRequestArgsForCall)]
+	fake.rejectChangeRequestArgsForCall = append(fake.rejectChangeRequestArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-	}{arg1, arg2})
-	stub := fake.DeleteFlagStub
-	fakeReturns := fake.deleteFlagReturns
-	fake.recordInvocation("DeleteFlag", []interface{}{arg1, arg2})
-	fake.deleteFlagMutex.Unlock()
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.RejectChangeRequestStub
+	fakeReturns := fake.rejectChangeRequestReturns
+	fake.recordInvocation("RejectChangeRequest", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.rejectChangeRequestMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1
@@ -166,191 +2207,190 @@ func (fake *FakeService) DeleteFlag(arg1 context.Context, arg2 uuid.UUID) error
 	return fakeReturns.result1
 }
 
-func (fake *FakeService) DeleteFlagCallCount() int {
-	fake.deleteFlagMutex.RLock()
-	defer fake.deleteFlagMutex.RUnlock()
-	return len(fake.deleteFlagArgsForCall)
+func (fake *FakeService) RejectChangeRequestCallCount() int {
+	fake.rejectChangeRequestMutex.RLock()
+	defer fake.rejectChangeRequestMutex.RUnlock()
+	return len(fake.rejectChangeRequestArgsForCall)
 }
 
-func (fake *FakeService) DeleteFlagCalls(stub func(context.Context, uuid.UUID) error) {
-	fake.deleteFlagMutex.Lock()
-	defer fake.deleteFlagMutex.Unlock()
-	fake.DeleteFlagStub = stub
+func (fake *FakeService) RejectChangeRequestCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) error) {
+	fake.rejectChangeRequestMutex.Lock()
+	defer fake.rejectChangeRequestMutex.Unlock()
+	fake.RejectChangeRequestStub = stub
 }
 
-func (fake *FakeService) DeleteFlagArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.deleteFlagMutex.RLock()
-	defer fake.deleteFlagMutex.RUnlock()
-	argsForCall := fake.deleteFlagArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeService) RejectChangeRequestArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) {
+	fake.rejectChangeRequestMutex.RLock()
+	defer fake.rejectChangeRequestMutex.RUnlock()
+	argsForCall := fake.rejectChangeRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakeService) DeleteFlagReturns(result1 error) {
-	fake.deleteFlagMutex.Lock()
-	defer fake.deleteFlagMutex.Unlock()
-	fake.DeleteFlagStub = nil
-	fake.deleteFlagReturns = struct {
+func (fake *FakeService) RejectChangeRequestReturns(result1 error) {
+	fake.rejectChangeRequestMutex.Lock()
+	defer fake.rejectChangeRequestMutex.Unlock()
+	fake.RejectChangeRequestStub = nil
+	fake.rejectChangeRequestReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeService) DeleteFlagReturnsOnCall(i int, result1 error) {
-	fake.deleteFlagMutex.Lock()
-	defer fake.deleteFlagMutex.Unlock()
-	fake.DeleteFlagStub = nil
-	if fake.deleteFlagReturnsOnCall == nil {
-		fake.deleteFlagReturnsOnCall = make(map[int]struct {
+func (fake *FakeService) RejectChangeRequestReturnsOnCall(i int, result1 error) {
+	fake.rejectChangeRequestMutex.Lock()
+	defer fake.rejectChangeRequestMutex.Unlock()
+	fake.RejectChangeRequestStub = nil
+	if fake.rejectChangeRequestReturnsOnCall == nil {
+		fake.rejectChangeRequestReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.deleteFlagReturnsOnCall[i] = struct {
+	fake.rejectChangeRequestReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeService) GetFlagByID(arg1 context.Context, arg2 uuid.UUID) (model.FeatureFlag, error) {
-	fake.getFlagByIDMutex.Lock()
-	ret, specificReturn := fake.getFlagByIDReturnsOnCall[len(fake.getFlagByIDArgsForCall)]
-	fake.getFlagByIDArgsForCall = append(fake.getFlagByIDArgsForCall, struct {
+func (fake *FakeService) ReleaseKillSwitch(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.releaseKillSwitchMutex.Lock()
+	ret, specificReturn := fake.releaseKillSwitchReturnsOnCall[len(fake.releaseKillSwitchArgsForCall)]
+	fake.releaseKillSwitchArgsForCall = append(fake.releaseKillSwitchArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-	}{arg1, arg2})
-	stub := fake.GetFlagByIDStub
-	fakeReturns := fake.getFlagByIDReturns
-	fake.recordInvocation("GetFlagByID", []interface{}{arg1, arg2})
-	fake.getFlagByIDMutex.Unlock()
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ReleaseKillSwitchStub
+	fakeReturns := fake.releaseKillSwitchReturns
+	fake.recordInvocation("ReleaseKillSwitch", []interface{}{arg1, arg2, arg3})
+	fake.releaseKillSwitchMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeService) GetFlagByIDCallCount() int {
-	fake.getFlagByIDMutex.RLock()
-	defer fake.getFlagByIDMutex.RUnlock()
-	return len(fake.getFlagByIDArgsForCall)
+func (fake *FakeService) ReleaseKillSwitchCallCount() int {
+	fake.releaseKillSwitchMutex.RLock()
+	defer fake.releaseKillSwitchMutex.RUnlock()
+	return len(fake.releaseKillSwitchArgsForCall)
 }
 
-func (fake *FakeService) GetFlagByIDCalls(stub func(context.Context, uuid.UUID) (model.FeatureFlag, error)) {
-	fake.getFlagByIDMutex.Lock()
-	defer fake.getFlagByIDMutex.Unlock()
-	fake.GetFlagByIDStub = stub
+func (fake *FakeService) ReleaseKillSwitchCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.releaseKillSwitchMutex.Lock()
+	defer fake.releaseKillSwitchMutex.Unlock()
+	fake.ReleaseKillSwitchStub = stub
 }
 
-func (fake *FakeService) GetFlagByIDArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.getFlagByIDMutex.RLock()
-	defer fake.getFlagByIDMutex.RUnlock()
-	argsForCall := fake.getFlagByIDArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeService) ReleaseKillSwitchArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.releaseKillSwitchMutex.RLock()
+	defer fake.releaseKillSwitchMutex.RUnlock()
+	argsForCall := fake.releaseKillSwitchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeService) GetFlagByIDReturns(result1 model.FeatureFlag, result2 error) {
-	fake.getFlagByIDMutex.Lock()
-	defer fake.getFlagByIDMutex.Unlock()
-	fake.GetFlagByIDStub = nil
-	fake.getFlagByIDReturns = struct {
-		result1 model.FeatureFlag
-		result2 error
-	}{result1, result2}
+func (fake *FakeService) ReleaseKillSwitchReturns(result1 error) {
+	fake.releaseKillSwitchMutex.Lock()
+	defer fake.releaseKillSwitchMutex.Unlock()
+	fake.ReleaseKillSwitchStub = nil
+	fake.releaseKillSwitchReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeService) GetFlagByIDReturnsOnCall(i int, result1 model.FeatureFlag, result2 error) {
-	fake.getFlagByIDMutex.Lock()
-	defer fake.getFlagByIDMutex.Unlock()
-	fake.GetFlagByIDStub = nil
-	if fake.getFlagByIDReturnsOnCall == nil {
-		fake.getFlagByIDReturnsOnCall = make(map[int]struct {
-			result1 model.FeatureFlag
-			result2 error
+func (fake *FakeService) ReleaseKillSwitchReturnsOnCall(i int, result1 error) {
+	fake.releaseKillSwitchMutex.Lock()
+	defer fake.releaseKillSwitchMutex.Unlock()
+	fake.ReleaseKillSwitchStub = nil
+	if fake.releaseKillSwitchReturnsOnCall == nil {
+		fake.releaseKillSwitchReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.getFlagByIDReturnsOnCall[i] = struct {
-		result1 model.FeatureFlag
-		result2 error
-	}{result1, result2}
+	fake.releaseKillSwitchReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeService) ListFlags(arg1 context.Context) ([]model.FeatureFlag, error) {
-	fake.listFlagsMutex.Lock()
-	ret, specificReturn := fake.listFlagsReturnsOnCall[len(fake.listFlagsArgsForCall)]
-	fake.listFlagsArgsForCall = append(fake.listFlagsArgsForCall, struct {
+func (fake *FakeService) RemoveGroupMember(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) error {
+	fake.removeGroupMemberMutex.Lock()
+	ret, specificReturn := fake.removeGroupMemberReturnsOnCall[len(fake.removeGroupMemberArgsForCall)]
+	fake.removeGroupMemberArgsForCall = append(fake.removeGroupMemberArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.ListFlagsStub
-	fakeReturns := fake.listFlagsReturns
-	fake.recordInvocation("ListFlags", []interface{}{arg1})
-	fake.listFlagsMutex.Unlock()
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveGroupMemberStub
+	fakeReturns := fake.removeGroupMemberReturns
+	fake.recordInvocation("RemoveGroupMember", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeGroupMemberMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeService) ListFlagsCallCount() int {
-	fake.listFlagsMutex.RLock()
-	defer fake.listFlagsMutex.RUnlock()
-	return len(fake.listFlagsArgsForCall)
+func (fake *FakeService) RemoveGroupMemberCallCount() int {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	return len(fake.removeGroupMemberArgsForCall)
 }
 
-func (fake *FakeService) ListFlagsCalls(stub func(context.Context) ([]model.FeatureFlag, error)) {
-	fake.listFlagsMutex.Lock()
-	defer fake.listFlagsMutex.Unlock()
-	fake.ListFlagsStub = stub
+func (fake *FakeService) RemoveGroupMemberCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) {
+	fake.removeGroupMemberMutex.Lock()
+	defer fake.removeGroupMemberMutex.Unlock()
+	fake.RemoveGroupMemberStub = stub
 }
 
-func (fake *FakeService) ListFlagsArgsForCall(i int) context.Context {
-	fake.listFlagsMutex.RLock()
-	defer fake.listFlagsMutex.RUnlock()
-	argsForCall := fake.listFlagsArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeService) RemoveGroupMemberArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	argsForCall := fake.removeGroupMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeService) ListFlagsReturns(result1 []model.FeatureFlag, result2 error) {
-	fake.listFlagsMutex.Lock()
-	defer fake.listFlagsMutex.Unlock()
-	fake.ListFlagsStub = nil
-	fake.listFlagsReturns = struct {
-		result1 []model.FeatureFlag
-		result2 error
-	}{result1, result2}
+func (fake *FakeService) RemoveGroupMemberReturns(result1 error) {
+	fake.removeGroupMemberMutex.Lock()
+	defer fake.removeGroupMemberMutex.Unlock()
+	fake.RemoveGroupMemberStub = nil
+	fake.removeGroupMemberReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeService) ListFlagsReturnsOnCall(i int, result1 []model.FeatureFlag, result2 error) {
-	fake.listFlagsMutex.Lock()
-	defer fake.listFlagsMutex.Unlock()
-	fake.ListFlagsStub = nil
-	if fake.listFlagsReturnsOnCall == nil {
-		fake.listFlagsReturnsOnCall = make(map[int]struct {
-			result1 []model.FeatureFlag
-			result2 error
+func (fake *FakeService) RemoveGroupMemberReturnsOnCall(i int, result1 error) {
+	fake.removeGroupMemberMutex.Lock()
+	defer fake.removeGroupMemberMutex.Unlock()
+	fake.RemoveGroupMemberStub = nil
+	if fake.removeGroupMemberReturnsOnCall == nil {
+		fake.removeGroupMemberReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.listFlagsReturnsOnCall[i] = struct {
-		result1 []model.FeatureFlag
-		result2 error
-	}{result1, result2}
+	fake.removeGroupMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeService) UpdateFlag(arg1 context.Context, arg2 uuid.UUID, arg3 model.FeatureFlagRequest) error {
+func (fake *FakeService) UpdateFlag(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 model.FeatureFlagRequest) error {
 	fake.updateFlagMutex.Lock()
 	ret, specificReturn := fake.updateFlagReturnsOnCall[len(fake.updateFlagArgsForCall)]
 	fake.updateFlagArgsForCall = append(fake.updateFlagArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-		arg3 model.FeatureFlagRequest
-	}{arg1, arg2, arg3})
+		arg3 uuid.UUID
+		arg4 model.FeatureFlagRequest
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.UpdateFlagStub
 	fakeReturns := fake.updateFlagReturns
-	fake.recordInvocation("UpdateFlag", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateFlag", []interface{}{arg1, arg2, arg3, arg4})
 	fake.updateFlagMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -364,17 +2404,17 @@ func (fake *FakeService) UpdateFlagCallCount() int {
 	return len(fake.updateFlagArgsForCall)
 }
 
-func (fake *FakeService) UpdateFlagCalls(stub func(context.Context, uuid.UUID, model.FeatureFlagRequest) error) {
+func (fake *FakeService) UpdateFlagCalls(stub func(context.Context, uuid.UUID, uuid.UUID, model.FeatureFlagRequest) error) {
 	fake.updateFlagMutex.Lock()
 	defer fake.updateFlagMutex.Unlock()
 	fake.UpdateFlagStub = stub
 }
 
-func (fake *FakeService) UpdateFlagArgsForCall(i int) (context.Context, uuid.UUID, model.FeatureFlagRequest) {
+func (fake *FakeService) UpdateFlagArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, model.FeatureFlagRequest) {
 	fake.updateFlagMutex.RLock()
 	defer fake.updateFlagMutex.RUnlock()
 	argsForCall := fake.updateFlagArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeService) UpdateFlagReturns(result1 error) {
@@ -400,6 +2440,140 @@ func (fake *FakeService) UpdateFlagReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeService) UpdateGroup(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 model.GroupRequest) (model.Group, error) {
+	fake.updateGroupMutex.Lock()
+	ret, specificReturn := fake.updateGroupReturnsOnCall[len(fake.updateGroupArgsForCall)]
+	fake.updateGroupArgsForCall = append(fake.updateGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.GroupRequest
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateGroupStub
+	fakeReturns := fake.updateGroupReturns
+	fake.recordInvocation("UpdateGroup", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) UpdateGroupCallCount() int {
+	fake.updateGroupMutex.RLock()
+	defer fake.updateGroupMutex.RUnlock()
+	return len(fake.updateGroupArgsForCall)
+}
+
+func (fake *FakeService) UpdateGroupCalls(stub func(context.Context, uuid.UUID, uuid.UUID, model.GroupRequest) (model.Group, error)) {
+	fake.updateGroupMutex.Lock()
+	defer fake.updateGroupMutex.Unlock()
+	fake.UpdateGroupStub = stub
+}
+
+func (fake *FakeService) UpdateGroupArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, model.GroupRequest) {
+	fake.updateGroupMutex.RLock()
+	defer fake.updateGroupMutex.RUnlock()
+	argsForCall := fake.updateGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) UpdateGroupReturns(result1 model.Group, result2 error) {
+	fake.updateGroupMutex.Lock()
+	defer fake.updateGroupMutex.Unlock()
+	fake.UpdateGroupStub = nil
+	fake.updateGroupReturns = struct {
+		result1 model.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) UpdateGroupReturnsOnCall(i int, result1 model.Group, result2 error) {
+	fake.updateGroupMutex.Lock()
+	defer fake.updateGroupMutex.Unlock()
+	fake.UpdateGroupStub = nil
+	if fake.updateGroupReturnsOnCall == nil {
+		fake.updateGroupReturnsOnCall = make(map[int]struct {
+			result1 model.Group
+			result2 error
+		})
+	}
+	fake.updateGroupReturnsOnCall[i] = struct {
+		result1 model.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) UploadCodeReferences(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 model.CodeReferencesUpload) (int, error) {
+	fake.uploadCodeReferencesMutex.Lock()
+	ret, specificReturn := fake.uploadCodeReferencesReturnsOnCall[len(fake.uploadCodeReferencesArgsForCall)]
+	fake.uploadCodeReferencesArgsForCall = append(fake.uploadCodeReferencesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.CodeReferencesUpload
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UploadCodeReferencesStub
+	fakeReturns := fake.uploadCodeReferencesReturns
+	fake.recordInvocation("UploadCodeReferences", []interface{}{arg1, arg2, arg3, arg4})
+	fake.uploadCodeReferencesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeService) UploadCodeReferencesCallCount() int {
+	fake.uploadCodeReferencesMutex.RLock()
+	defer fake.uploadCodeReferencesMutex.RUnlock()
+	return len(fake.uploadCodeReferencesArgsForCall)
+}
+
+func (fake *FakeService) UploadCodeReferencesCalls(stub func(context.Context, uuid.UUID, uuid.UUID, model.CodeReferencesUpload) (int, error)) {
+	fake.uploadCodeReferencesMutex.Lock()
+	defer fake.uploadCodeReferencesMutex.Unlock()
+	fake.UploadCodeReferencesStub = stub
+}
+
+func (fake *FakeService) UploadCodeReferencesArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, model.CodeReferencesUpload) {
+	fake.uploadCodeReferencesMutex.RLock()
+	defer fake.uploadCodeReferencesMutex.RUnlock()
+	argsForCall := fake.uploadCodeReferencesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeService) UploadCodeReferencesReturns(result1 int, result2 error) {
+	fake.uploadCodeReferencesMutex.Lock()
+	defer fake.uploadCodeReferencesMutex.Unlock()
+	fake.UploadCodeReferencesStub = nil
+	fake.uploadCodeReferencesReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeService) UploadCodeReferencesReturnsOnCall(i int, result1 int, result2 error) {
+	fake.uploadCodeReferencesMutex.Lock()
+	defer fake.uploadCodeReferencesMutex.Unlock()
+	fake.UploadCodeReferencesStub = nil
+	if fake.uploadCodeReferencesReturnsOnCall == nil {
+		fake.uploadCodeReferencesReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.uploadCodeReferencesReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeService) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
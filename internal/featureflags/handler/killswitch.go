@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/labstack/echo/v4"
+)
+
+// writeFreezeMiddleware rejects mutating requests while a kill switch is active. Tokens with the
+// admin scope are let through so that incidents can still be remediated.
+func (h *Handler) writeFreezeMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		caller, err := authn.CallerFromContext(c)
+		if err != nil {
+			return err
+		}
+		if caller.HasScope(authn.AdminFlagsScope) {
+			return next(c)
+		}
+
+		status, err := h.svc.GetKillSwitchStatus(c.Request().Context(), caller.OrgID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		if status.Active {
+			return echo.NewHTTPError(http.StatusLocked, model.ErrWritesFrozen.Error())
+		}
+
+		return next(c)
+	}
+}
+
+func (h *Handler) getKillSwitch(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := h.svc.GetKillSwitchStatus(c.Request().Context(), orgID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, status)
+}
+
+func (h *Handler) activateKillSwitch(c echo.Context) error {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	var req model.KillSwitchRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request format")
+	}
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("missing required request fields: %w", err))
+	}
+
+	killSwitch, err := h.svc.ActivateKillSwitch(c.Request().Context(), orgID, userID, req)
+	if err != nil {
+		if errors.Is(err, model.ErrKillSwitchActive) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusCreated, killSwitch)
+}
+
+func (h *Handler) releaseKillSwitch(c echo.Context) error {
+	userID, err := authn.UserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.svc.ReleaseKillSwitch(c.Request().Context(), orgID, userID); err != nil {
+		if errors.Is(err, model.ErrKillSwitchNotActive) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *Handler) evaluateFlag(c echo.Context) error {
+	orgID, err := authn.OrgIDFromContext(c)
+	if err != nil {
+		return err
+	}
+
+	evaluation, err := h.svc.EvaluateFlag(c.Request().Context(), orgID, c.Param("key"))
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "feature flag not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, evaluation)
+}
@@ -0,0 +1,223 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/handler"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/handler/handlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/labstack/echo/v4"
+)
+
+var _ = Describe("Kill Switch Handler", func() {
+	var (
+		e           *echo.Echo
+		recorder    *httptest.ResponseRecorder
+		authStore   *handlerfakes.FakeAuthStore
+		jwtHelper   *handlerfakes.FakeJWTHelper
+		svc         *handlerfakes.FakeService
+		flagHandler *handler.Handler
+		request     *http.Request
+
+		validUserID = "c9c15117-ca25-49c6-b857-3eb640a61234"
+		validOrgID  = "5a1de4a2-9f0c-4d8e-a4c7-0f5a3b2e7c11"
+		scopes      []string
+	)
+
+	BeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
+		e.Validator = validator.GetValidator()
+		recorder = httptest.NewRecorder()
+		authStore = &handlerfakes.FakeAuthStore{}
+		jwtHelper = &handlerfakes.FakeJWTHelper{}
+		svc = &handlerfakes.FakeService{}
+		flagHandler = handler.NewHandler(svc, authStore, &handlerfakes.FakeDenylist{}, jwtHelper, &handlerfakes.FakeAPIKeyAuthenticator{}, "production")
+		flagHandler.RegisterHandlers(e)
+
+		scopes = []string{"read:flags", "write:flags", "review:flags", "admin:flags"}
+		authStore.UserExistsReturns(true, nil)
+		authStore.IsOrgMemberReturns(true, nil)
+	})
+
+	JustBeforeEach(func() {
+		claims := jwt.MapClaims{"sub": validUserID, "org": validOrgID, "scopes": scopes}
+		jwtHelper.ValidateTokenReturns(accessTokenClaims(claims), nil)
+		request.Header.Set(echo.HeaderAuthorization, "Bearer validToken")
+		e.ServeHTTP(recorder, request)
+	})
+
+	Describe("GET /kill-switch", func() {
+		BeforeEach(func() {
+			scopes = []string{"read:flags"}
+			svc.GetKillSwitchStatusReturns(model.KillSwitchStatus{
+				Active:     true,
+				KillSwitch: &model.KillSwitch{Reason: "incident"},
+			}, nil)
+			request = httptest.NewRequest(http.MethodGet, "/kill-switch", nil)
+		})
+
+		It("returns the kill switch status", func() {
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			var status model.KillSwitchStatus
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &status)).To(Succeed())
+			Expect(status.Active).To(BeTrue())
+			Expect(status.KillSwitch.Reason).To(Equal("incident"))
+		})
+	})
+
+	Describe("POST /kill-switch", func() {
+		BeforeEach(func() {
+			svc.ActivateKillSwitchReturns(model.KillSwitch{ID: uuid.New(), Reason: "incident"}, nil)
+			request = httptest.NewRequest(http.MethodPost, "/kill-switch", strings.NewReader(`{"reason":"incident","tag":"payments"}`))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		})
+
+		It("activates the kill switch for the authenticated user", func() {
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+			Expect(svc.ActivateKillSwitchCallCount()).To(Equal(1))
+			_, _, actualUserID, actualReq := svc.ActivateKillSwitchArgsForCall(0)
+			Expect(actualUserID).To(Equal(uuid.MustParse(validUserID)))
+			Expect(actualReq).To(Equal(model.KillSwitchRequest{Reason: "incident", Tag: "payments"}))
+		})
+
+		Context("when the token lacks the admin scope", func() {
+			BeforeEach(func() {
+				scopes = []string{"read:flags", "write:flags"}
+			})
+
+			It("returns 403", func() {
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+				Expect(svc.ActivateKillSwitchCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when a kill switch is already active", func() {
+			BeforeEach(func() {
+				svc.ActivateKillSwitchReturns(model.KillSwitch{}, model.ErrKillSwitchActive)
+			})
+
+			It("returns 409", func() {
+				Expect(recorder.Code).To(Equal(http.StatusConflict))
+			})
+		})
+	})
+
+	Describe("DELETE /kill-switch", func() {
+		BeforeEach(func() {
+			request = httptest.NewRequest(http.MethodDelete, "/kill-switch", nil)
+		})
+
+		It("releases the kill switch", func() {
+			Expect(recorder.Code).To(Equal(http.StatusNoContent))
+			Expect(svc.ReleaseKillSwitchCallCount()).To(Equal(1))
+		})
+
+		Context("when no kill switch is active", func() {
+			BeforeEach(func() {
+				svc.ReleaseKillSwitchReturns(model.ErrKillSwitchNotActive)
+			})
+
+			It("returns 409", func() {
+				Expect(recorder.Code).To(Equal(http.StatusConflict))
+			})
+		})
+	})
+
+	Describe("GET /flags/evaluate/:key", func() {
+		BeforeEach(func() {
+			scopes = []string{"read:flags"}
+			svc.EvaluateFlagReturns(model.FlagEvaluation{Key: "flag", Value: false, Reason: model.EvaluationReasonKillSwitch}, nil)
+			request = httptest.NewRequest(http.MethodGet, "/flags/evaluate/flag", nil)
+		})
+
+		It("returns the evaluation", func() {
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			var evaluation model.FlagEvaluation
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &evaluation)).To(Succeed())
+			Expect(evaluation.Reason).To(Equal(model.EvaluationReasonKillSwitch))
+
+			_, _, actualKey := svc.EvaluateFlagArgsForCall(0)
+			Expect(actualKey).To(Equal("flag"))
+		})
+
+		Context("when the flag does not exist", func() {
+			BeforeEach(func() {
+				svc.EvaluateFlagReturns(model.FlagEvaluation{}, model.ErrNotFound)
+			})
+
+			It("returns 404", func() {
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("write freeze", func() {
+		BeforeEach(func() {
+			svc.GetKillSwitchStatusReturns(model.KillSwitchStatus{Active: true}, nil)
+			request = httptest.NewRequest(http.MethodDelete, "/flags/123e4567-e89b-12d3-a456-426655440000", nil)
+		})
+
+		Context("when the token lacks the admin scope", func() {
+			BeforeEach(func() {
+				scopes = []string{"read:flags", "write:flags"}
+			})
+
+			It("rejects the write with 423", func() {
+				Expect(recorder.Code).To(Equal(http.StatusLocked))
+				Expect(svc.DeleteFlagCallCount()).To(BeZero())
+			})
+
+			It("rejects code reference uploads with 423", func() {
+				uploadRecorder := httptest.NewRecorder()
+				uploadRequest := httptest.NewRequest(http.MethodPut, "/code-references",
+					strings.NewReader(`{"repository":"github.com/acme/web","references":[]}`))
+				uploadRequest.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				uploadRequest.Header.Set(echo.HeaderAuthorization, "Bearer validToken")
+				e.ServeHTTP(uploadRecorder, uploadRequest)
+				Expect(uploadRecorder.Code).To(Equal(http.StatusLocked))
+				Expect(svc.UploadCodeReferencesCallCount()).To(BeZero())
+			})
+
+			It("still serves reads", func() {
+				readRecorder := httptest.NewRecorder()
+				readRequest := httptest.NewRequest(http.MethodGet, "/flags", nil)
+				readRequest.Header.Set(echo.HeaderAuthorization, "Bearer validToken")
+				e.ServeHTTP(readRecorder, readRequest)
+				Expect(readRecorder.Code).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when the token has the admin scope", func() {
+			It("lets the write through", func() {
+				Expect(recorder.Code).To(Equal(http.StatusNoContent))
+				Expect(svc.DeleteFlagCallCount()).To(Equal(1))
+				Expect(svc.GetKillSwitchStatusCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the kill switch status cannot be fetched", func() {
+			BeforeEach(func() {
+				scopes = []string{"write:flags"}
+				svc.GetKillSwitchStatusReturns(model.KillSwitchStatus{}, errors.New("db down"))
+			})
+
+			It("returns 500", func() {
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+})
@@ -0,0 +1,70 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../../../../observability/templates/otel_metric.tmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package metric
+
+import (
+	"context"
+	"time"
+
+	_sourceHandler "github.com/georgisomnoev/feature-flag-api/internal/featureflags/handler"
+	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type APIKeyAuthenticatorMetrics struct {
+	RequestCounter  metric.Int64Counter
+	RequestDuration metric.Float64Histogram
+}
+
+type APIKeyAuthenticatorWithMetrics struct {
+	base    _sourceHandler.APIKeyAuthenticator
+	metrics *APIKeyAuthenticatorMetrics
+}
+
+func NewAPIKeyAuthenticatorWithMetrics(base _sourceHandler.APIKeyAuthenticator) *APIKeyAuthenticatorWithMetrics {
+	meter := otel.GetMeterProvider().Meter("")
+
+	requestCounter, _ := meter.Int64Counter("APIKeyAuthenticator_requests_total", metric.WithDescription("Total number of APIKeyAuthenticator method calls"))
+	durationHistogram, _ := meter.Float64Histogram("APIKeyAuthenticator_request_duration_ms", metric.WithDescription("Duration of APIKeyAuthenticator method calls in milliseconds"))
+
+	return &APIKeyAuthenticatorWithMetrics{
+		base: base,
+		metrics: &APIKeyAuthenticatorMetrics{
+			RequestCounter:  requestCounter,
+			RequestDuration: durationHistogram,
+		},
+	}
+}
+
+func (_d *APIKeyAuthenticatorWithMetrics) Authenticate(ctx context.Context, s1 string) (m1 jwt.MapClaims, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "Authenticate"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "Authenticate")))
+	}()
+	return _d.base.Authenticate(ctx, s1)
+}
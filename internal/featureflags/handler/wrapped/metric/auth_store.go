@@ -40,6 +40,35 @@ func NewAuthStoreWithMetrics(base _sourceHandler.AuthStore) *AuthStoreWithMetric
 	}
 }
 
+func (_d *AuthStoreWithMetrics) IsOrgMember(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (b1 bool, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "IsOrgMember"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "IsOrgMember")))
+	}()
+	return _d.base.IsOrgMember(ctx, u1, u2)
+}
+
 func (_d *AuthStoreWithMetrics) UserExists(ctx context.Context, id uuid.UUID) (b1 bool, err error) {
 	startTime := time.Now()
 
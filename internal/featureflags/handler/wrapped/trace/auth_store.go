@@ -33,6 +33,23 @@ func NewAuthStoreWithTracing(base _sourceHandler.AuthStore) AuthStoreWithTracing
 	return d
 }
 
+// IsOrgMember implements AuthStore
+func (_d AuthStoreWithTracing) IsOrgMember(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (b1 bool, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "AuthStore.IsOrgMember")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.AuthStore.IsOrgMember(ctx, u1, u2)
+}
+
 // UserExists implements AuthStore
 func (_d AuthStoreWithTracing) UserExists(ctx context.Context, id uuid.UUID) (b1 bool, err error) {
 	ctx, _span := _d.tracer.Start(ctx, "AuthStore.UserExists")
@@ -34,8 +34,127 @@ func NewServiceWithTracing(base _sourceHandler.Service) ServiceWithTracing {
 	return d
 }
 
+// ActivateKillSwitch implements Service
+func (_d ServiceWithTracing) ActivateKillSwitch(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, k1 model.KillSwitchRequest) (k2 model.KillSwitch, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ActivateKillSwitch")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ActivateKillSwitch(ctx, u1, u2, k1)
+}
+
+// AddGroupMember implements Service
+func (_d ServiceWithTracing) AddGroupMember(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.AddGroupMember")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.AddGroupMember(ctx, u1, u2, u3)
+}
+
+// ApplyChangeRequest implements Service
+func (_d ServiceWithTracing) ApplyChangeRequest(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ApplyChangeRequest")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ApplyChangeRequest(ctx, u1, u2, u3)
+}
+
+// ApproveChangeRequest implements Service
+func (_d ServiceWithTracing) ApproveChangeRequest(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID, s1 string) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ApproveChangeRequest")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ApproveChangeRequest(ctx, u1, u2, u3, s1)
+}
+
+// CancelChangeRequest implements Service
+func (_d ServiceWithTracing) CancelChangeRequest(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.CancelChangeRequest")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.CancelChangeRequest(ctx, u1, u2, u3)
+}
+
+// CreateAccessRule implements Service
+func (_d ServiceWithTracing) CreateAccessRule(ctx context.Context, u1 uuid.UUID, a1 model.AccessRuleRequest) (a2 model.AccessRule, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.CreateAccessRule")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.CreateAccessRule(ctx, u1, a1)
+}
+
+// CreateChangeRequest implements Service
+func (_d ServiceWithTracing) CreateChangeRequest(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID, c2 model.ChangeRequestRequest) (u4 uuid.UUID, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.CreateChangeRequest")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.CreateChangeRequest(ctx, u1, u2, u3, c2)
+}
+
 // CreateFlag implements Service
-func (_d ServiceWithTracing) CreateFlag(ctx context.Context, f1 model.FeatureFlagRequest) (u1 uuid.UUID, err error) {
+func (_d ServiceWithTracing) CreateFlag(ctx context.Context, u1 uuid.UUID, f1 model.FeatureFlagRequest) (u2 uuid.UUID, err error) {
 	ctx, _span := _d.tracer.Start(ctx, "Service.CreateFlag")
 	defer func() {
 		if err != nil {
@@ -48,11 +167,45 @@ func (_d ServiceWithTracing) CreateFlag(ctx context.Context, f1 model.FeatureFla
 		}
 		_span.End()
 	}()
-	return _d.Service.CreateFlag(ctx, f1)
+	return _d.Service.CreateFlag(ctx, u1, f1)
+}
+
+// CreateGroup implements Service
+func (_d ServiceWithTracing) CreateGroup(ctx context.Context, u1 uuid.UUID, g1 model.GroupRequest) (g2 model.Group, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.CreateGroup")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.CreateGroup(ctx, u1, g1)
+}
+
+// DeleteAccessRule implements Service
+func (_d ServiceWithTracing) DeleteAccessRule(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.DeleteAccessRule")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.DeleteAccessRule(ctx, u1, u2)
 }
 
 // DeleteFlag implements Service
-func (_d ServiceWithTracing) DeleteFlag(ctx context.Context, u1 uuid.UUID) (err error) {
+func (_d ServiceWithTracing) DeleteFlag(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
 	ctx, _span := _d.tracer.Start(ctx, "Service.DeleteFlag")
 	defer func() {
 		if err != nil {
@@ -65,11 +218,79 @@ func (_d ServiceWithTracing) DeleteFlag(ctx context.Context, u1 uuid.UUID) (err
 		}
 		_span.End()
 	}()
-	return _d.Service.DeleteFlag(ctx, u1)
+	return _d.Service.DeleteFlag(ctx, u1, u2)
+}
+
+// DeleteGroup implements Service
+func (_d ServiceWithTracing) DeleteGroup(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.DeleteGroup")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.DeleteGroup(ctx, u1, u2)
+}
+
+// EvaluateFlag implements Service
+func (_d ServiceWithTracing) EvaluateFlag(ctx context.Context, u1 uuid.UUID, s1 string) (f1 model.FlagEvaluation, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.EvaluateFlag")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.EvaluateFlag(ctx, u1, s1)
+}
+
+// GenerateReport implements Service
+func (_d ServiceWithTracing) GenerateReport(ctx context.Context, u1 uuid.UUID, i1 int) (f1 model.FlagReport, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.GenerateReport")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.GenerateReport(ctx, u1, i1)
+}
+
+// GetChangeRequest implements Service
+func (_d ServiceWithTracing) GetChangeRequest(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (c2 model.ChangeRequest, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.GetChangeRequest")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.GetChangeRequest(ctx, u1, u2)
 }
 
 // GetFlagByID implements Service
-func (_d ServiceWithTracing) GetFlagByID(ctx context.Context, u1 uuid.UUID) (f1 model.FeatureFlag, err error) {
+func (_d ServiceWithTracing) GetFlagByID(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (f1 model.FeatureFlag, err error) {
 	ctx, _span := _d.tracer.Start(ctx, "Service.GetFlagByID")
 	defer func() {
 		if err != nil {
@@ -82,11 +303,96 @@ func (_d ServiceWithTracing) GetFlagByID(ctx context.Context, u1 uuid.UUID) (f1
 		}
 		_span.End()
 	}()
-	return _d.Service.GetFlagByID(ctx, u1)
+	return _d.Service.GetFlagByID(ctx, u1, u2)
+}
+
+// GetGroup implements Service
+func (_d ServiceWithTracing) GetGroup(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (g1 model.Group, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.GetGroup")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.GetGroup(ctx, u1, u2)
+}
+
+// GetKillSwitchStatus implements Service
+func (_d ServiceWithTracing) GetKillSwitchStatus(ctx context.Context, u1 uuid.UUID) (k1 model.KillSwitchStatus, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.GetKillSwitchStatus")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.GetKillSwitchStatus(ctx, u1)
+}
+
+// ListAccessRules implements Service
+func (_d ServiceWithTracing) ListAccessRules(ctx context.Context, u1 uuid.UUID) (aa1 []model.AccessRule, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListAccessRules")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListAccessRules(ctx, u1)
+}
+
+// ListChangeRequests implements Service
+func (_d ServiceWithTracing) ListChangeRequests(ctx context.Context, u1 uuid.UUID, c2 model.ChangeRequestStatus) (ca1 []model.ChangeRequest, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListChangeRequests")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListChangeRequests(ctx, u1, c2)
+}
+
+// ListCodeReferences implements Service
+func (_d ServiceWithTracing) ListCodeReferences(ctx context.Context, u1 uuid.UUID, s1 string) (ca1 []model.CodeReference, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListCodeReferences")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListCodeReferences(ctx, u1, s1)
 }
 
 // ListFlags implements Service
-func (_d ServiceWithTracing) ListFlags(ctx context.Context) (fa1 []model.FeatureFlag, err error) {
+func (_d ServiceWithTracing) ListFlags(ctx context.Context, u1 uuid.UUID, f1 model.FlagFilter) (fa1 []model.FeatureFlag, err error) {
 	ctx, _span := _d.tracer.Start(ctx, "Service.ListFlags")
 	defer func() {
 		if err != nil {
@@ -99,11 +405,130 @@ func (_d ServiceWithTracing) ListFlags(ctx context.Context) (fa1 []model.Feature
 		}
 		_span.End()
 	}()
-	return _d.Service.ListFlags(ctx)
+	return _d.Service.ListFlags(ctx, u1, f1)
+}
+
+// ListGroupMembers implements Service
+func (_d ServiceWithTracing) ListGroupMembers(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (ga1 []model.GroupMember, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListGroupMembers")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListGroupMembers(ctx, u1, u2)
+}
+
+// ListGroups implements Service
+func (_d ServiceWithTracing) ListGroups(ctx context.Context, u1 uuid.UUID) (ga1 []model.Group, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListGroups")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListGroups(ctx, u1)
+}
+
+// ListOverdueFlags implements Service
+func (_d ServiceWithTracing) ListOverdueFlags(ctx context.Context, u1 uuid.UUID, s1 string) (oa1 []model.OwnerOverdueFlags, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListOverdueFlags")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListOverdueFlags(ctx, u1, s1)
+}
+
+// ListUserGroupIDs implements Service
+func (_d ServiceWithTracing) ListUserGroupIDs(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (ua1 []uuid.UUID, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ListUserGroupIDs")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ListUserGroupIDs(ctx, u1, u2)
+}
+
+// RejectChangeRequest implements Service
+func (_d ServiceWithTracing) RejectChangeRequest(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID, s1 string) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.RejectChangeRequest")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.RejectChangeRequest(ctx, u1, u2, u3, s1)
+}
+
+// ReleaseKillSwitch implements Service
+func (_d ServiceWithTracing) ReleaseKillSwitch(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.ReleaseKillSwitch")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.ReleaseKillSwitch(ctx, u1, u2)
+}
+
+// RemoveGroupMember implements Service
+func (_d ServiceWithTracing) RemoveGroupMember(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, u3 uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.RemoveGroupMember")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.RemoveGroupMember(ctx, u1, u2, u3)
 }
 
 // UpdateFlag implements Service
-func (_d ServiceWithTracing) UpdateFlag(ctx context.Context, u1 uuid.UUID, f1 model.FeatureFlagRequest) (err error) {
+func (_d ServiceWithTracing) UpdateFlag(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, f1 model.FeatureFlagRequest) (err error) {
 	ctx, _span := _d.tracer.Start(ctx, "Service.UpdateFlag")
 	defer func() {
 		if err != nil {
@@ -116,5 +541,39 @@ func (_d ServiceWithTracing) UpdateFlag(ctx context.Context, u1 uuid.UUID, f1 mo
 		}
 		_span.End()
 	}()
-	return _d.Service.UpdateFlag(ctx, u1, f1)
+	return _d.Service.UpdateFlag(ctx, u1, u2, f1)
+}
+
+// UpdateGroup implements Service
+func (_d ServiceWithTracing) UpdateGroup(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, g1 model.GroupRequest) (g2 model.Group, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.UpdateGroup")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.UpdateGroup(ctx, u1, u2, g1)
+}
+
+// UploadCodeReferences implements Service
+func (_d ServiceWithTracing) UploadCodeReferences(ctx context.Context, u1 uuid.UUID, u2 uuid.UUID, c2 model.CodeReferencesUpload) (i1 int, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Service.UploadCodeReferences")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Service.UploadCodeReferences(ctx, u1, u2, c2)
 }
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Group struct {
+	ID          uuid.UUID `json:"id"`
+	OrgID       uuid.UUID `json:"-"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type GroupRequest struct {
+	Name        string `json:"name" validate:"required,max=255"`
+	Description string `json:"description" validate:"max=1024"`
+}
+
+type GroupMember struct {
+	UserID  uuid.UUID `json:"user_id"`
+	AddedAt time.Time `json:"added_at"`
+}
+
+type Permission string
+
+const (
+	PermissionRead  Permission = "read"
+	PermissionWrite Permission = "write"
+)
+
+// AccessRule grants a group access to a single flag or to every flag carrying a tag. Write access
+// includes read access.
+type AccessRule struct {
+	ID         uuid.UUID  `json:"id"`
+	OrgID      uuid.UUID  `json:"-"`
+	GroupID    uuid.UUID  `json:"group_id"`
+	FlagID     *uuid.UUID `json:"flag_id,omitempty"`
+	Tag        string     `json:"tag,omitempty"`
+	Permission Permission `json:"permission"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type AccessRuleRequest struct {
+	GroupID    uuid.UUID  `json:"group_id" validate:"required"`
+	FlagID     *uuid.UUID `json:"flag_id,omitempty" validate:"required_without=Tag,excluded_with=Tag"`
+	Tag        string     `json:"tag,omitempty" validate:"required_without=FlagID,max=64"`
+	Permission Permission `json:"permission" validate:"required,oneof=read write"`
+}
+
+// Covers reports whether the rule applies to the flag.
+func (r AccessRule) Covers(flag FeatureFlag) bool {
+	if r.FlagID != nil {
+		return *r.FlagID == flag.ID
+	}
+	return slices.Contains(flag.Tags, r.Tag)
+}
+
+// Principal is the caller the flag access rules are checked for.
+type Principal struct {
+	UserID   uuid.UUID
+	GroupIDs []uuid.UUID
+	// Unrestricted principals, like flag admins, are not subject to the access rules.
+	Unrestricted bool
+	// Sync principals authenticate with the sync scope and may change managed flags.
+	Sync bool
+}
+
+// SystemPrincipal is the principal of the background jobs of the service, like the change request
+// scheduler. It is not subject to the access rules but may not change managed flags.
+var SystemPrincipal = Principal{Unrestricted: true}
+
+type principalKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the caller stored in the context. Background jobs run as the
+// SystemPrincipal.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+var (
+	ErrGroupNotFound       = errors.New("group not found")
+	ErrGroupExists         = errors.New("group with this name already exists")
+	ErrGroupMemberNotFound = errors.New("user is not a member of the group")
+	ErrAccessRuleNotFound  = errors.New("access rule not found")
+	ErrFlagAccessDenied    = errors.New("no write access to the feature flag")
+	ErrNoPrincipal         = errors.New("no principal to check the flag access rules for")
+)
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChangeRequestAction string
+
+const (
+	ChangeRequestActionUpdate ChangeRequestAction = "update"
+	ChangeRequestActionDelete ChangeRequestAction = "delete"
+)
+
+type ChangeRequestStatus string
+
+const (
+	ChangeRequestStatusPending   ChangeRequestStatus = "pending"
+	ChangeRequestStatusApproved  ChangeRequestStatus = "approved"
+	ChangeRequestStatusRejected  ChangeRequestStatus = "rejected"
+	ChangeRequestStatusApplied   ChangeRequestStatus = "applied"
+	ChangeRequestStatusCancelled ChangeRequestStatus = "cancelled"
+)
+
+type ChangeRequest struct {
+	ID            uuid.UUID           `json:"id"`
+	OrgID         uuid.UUID           `json:"-"`
+	FlagID        uuid.UUID           `json:"flag_id"`
+	Action        ChangeRequestAction `json:"action"`
+	Flag          *FeatureFlagRequest `json:"flag,omitempty"`
+	Status        ChangeRequestStatus `json:"status"`
+	Comment       string              `json:"comment"`
+	AuthorID      uuid.UUID           `json:"author_id"`
+	ReviewerID    *uuid.UUID          `json:"reviewer_id,omitempty"`
+	ReviewComment string              `json:"review_comment"`
+	ReviewedAt    *time.Time          `json:"reviewed_at,omitempty"`
+	ScheduledAt   *time.Time          `json:"scheduled_at,omitempty"`
+	AppliedBy     *uuid.UUID          `json:"applied_by,omitempty"`
+	AppliedAt     *time.Time          `json:"applied_at,omitempty"`
+	CancelledBy   *uuid.UUID          `json:"cancelled_by,omitempty"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+}
+
+type ChangeRequestRequest struct {
+	Action      ChangeRequestAction `json:"action" validate:"required,oneof=update delete"`
+	Flag        *FeatureFlagRequest `json:"flag" validate:"required_if=Action update"`
+	Comment     string              `json:"comment"`
+	ScheduledAt *time.Time          `json:"scheduled_at"`
+}
+
+type ReviewRequest struct {
+	Comment string `json:"comment"`
+}
+
+var (
+	ErrFlagProtected          = errors.New("feature flag is protected, submit a change request instead")
+	ErrChangeRequestNotFound  = errors.New("change request not found")
+	ErrChangeRequestState     = errors.New("change request is not in a valid state for this action")
+	ErrChangeRequestScheduled = errors.New("change request is scheduled for a later time")
+	ErrSelfReview             = errors.New("authors cannot review their own change requests")
+	ErrNotChangeRequestAuthor = errors.New("only the author can cancel a change request")
+)
@@ -0,0 +1,26 @@
+package model
+
+type CodeReference struct {
+	Repository string `json:"repository"`
+	Key        string `json:"key"`
+	Path       string `json:"path"`
+	Line       int    `json:"line"`
+}
+
+type CodeReferenceEntry struct {
+	Key  string `json:"key" validate:"required"`
+	Path string `json:"path" validate:"required"`
+	Line int    `json:"line" validate:"min=1"`
+}
+
+// CodeReferencesUpload replaces every stored reference of a repository with the ones
+// found by the latest scan.
+type CodeReferencesUpload struct {
+	Repository string               `json:"repository" validate:"required,max=255"`
+	References []CodeReferenceEntry `json:"references" validate:"dive"`
+}
+
+type FlagDetails struct {
+	FeatureFlag
+	CodeReferences []CodeReference `json:"code_references"`
+}
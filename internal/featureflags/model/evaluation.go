@@ -0,0 +1,16 @@
+package model
+
+type EvaluationReason string
+
+const (
+	// EvaluationReasonStatic is returned when the flag serves its configured value.
+	EvaluationReasonStatic EvaluationReason = "STATIC"
+	// EvaluationReasonKillSwitch is returned when an active kill switch forces the flag off.
+	EvaluationReasonKillSwitch EvaluationReason = "KILL_SWITCH"
+)
+
+type FlagEvaluation struct {
+	Key    string           `json:"key"`
+	Value  bool             `json:"value"`
+	Reason EvaluationReason `json:"reason"`
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ExpiryStatus string
+
+const (
+	ExpiryStatusExpired      ExpiryStatus = "expired"
+	ExpiryStatusExpiringSoon ExpiryStatus = "expiring_soon"
+)
+
+type ExpiryReminder struct {
+	OrgID       uuid.UUID    `json:"org_id"`
+	FlagID      uuid.UUID    `json:"flag_id"`
+	Key         string       `json:"key"`
+	Owner       string       `json:"owner"`
+	OwnerType   OwnerType    `json:"owner_type"`
+	Maintainers []string     `json:"maintainers"`
+	ExpiresAt   time.Time    `json:"expires_at"`
+	Status      ExpiryStatus `json:"status"`
+}
+
+type OwnerOverdueFlags struct {
+	Owner     string        `json:"owner"`
+	OwnerType OwnerType     `json:"owner_type"`
+	Flags     []FeatureFlag `json:"flags"`
+}
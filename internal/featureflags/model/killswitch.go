@@ -0,0 +1,42 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type KillSwitch struct {
+	ID          uuid.UUID  `json:"id"`
+	OrgID       uuid.UUID  `json:"-"`
+	Reason      string     `json:"reason"`
+	Tag         string     `json:"tag,omitempty"`
+	ActivatedBy uuid.UUID  `json:"activated_by"`
+	ActivatedAt time.Time  `json:"activated_at"`
+	ReleasedBy  *uuid.UUID `json:"released_by,omitempty"`
+	ReleasedAt  *time.Time `json:"released_at,omitempty"`
+}
+
+// Covers reports whether the kill switch forces the flag off. A kill switch with a tag covers only
+// the flags with the tag, one without covers every flag.
+func (k KillSwitch) Covers(flag FeatureFlag) bool {
+	return k.Tag == "" || slices.Contains(flag.Tags, k.Tag)
+}
+
+type KillSwitchStatus struct {
+	Active     bool        `json:"active"`
+	KillSwitch *KillSwitch `json:"kill_switch,omitempty"`
+}
+
+type KillSwitchRequest struct {
+	Reason string `json:"reason" validate:"required"`
+	Tag    string `json:"tag,omitempty" validate:"max=64"`
+}
+
+var (
+	ErrKillSwitchActive    = errors.New("kill switch is already active")
+	ErrKillSwitchNotActive = errors.New("kill switch is not active")
+	ErrWritesFrozen        = errors.New("writes are frozen by an active kill switch")
+)
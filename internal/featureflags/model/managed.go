@@ -0,0 +1,12 @@
+package model
+
+import "errors"
+
+// ManagedByGit marks the flags whose definition lives in a git repository and is applied by ffctl sync.
+const ManagedByGit = "git"
+
+// SyncScope is granted to the API keys of tools like ffctl sync. Only callers with it may change
+// managed flags or mark flags as managed.
+const SyncScope = "sync:flags"
+
+var ErrFlagManaged = errors.New("feature flag is managed by another tool, change its definition there instead")
@@ -2,33 +2,226 @@ package model
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type FlagKind string
+
+const (
+	FlagKindRelease    FlagKind = "release"
+	FlagKindExperiment FlagKind = "experiment"
+	FlagKindOps        FlagKind = "ops"
+	FlagKindPermission FlagKind = "permission"
+	FlagKindKillSwitch FlagKind = "kill-switch"
+)
+
+type OwnerType string
+
+const (
+	OwnerTypeUser OwnerType = "user"
+	OwnerTypeTeam OwnerType = "team"
+)
+
 type FeatureFlag struct {
-	ID          uuid.UUID `json:"id"`
-	Key         string    `json:"key"`
-	Description string    `json:"description"`
-	Enabled     bool      `json:"enabled"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          uuid.UUID         `json:"id"`
+	OrgID       uuid.UUID         `json:"-"`
+	Key         string            `json:"key"`
+	Description string            `json:"description"`
+	Enabled     bool              `json:"enabled"`
+	Permanent   bool              `json:"permanent"`
+	Protected   bool              `json:"protected"`
+	Owner       string            `json:"owner"`
+	OwnerType   OwnerType         `json:"owner_type"`
+	Maintainers []string          `json:"maintainers"`
+	Tags        []string          `json:"tags"`
+	Kind        FlagKind          `json:"kind"`
+	IssueURLs   []string          `json:"issue_urls"`
+	Metadata    map[string]string `json:"metadata"`
+	ManagedBy   string            `json:"managed_by"`
+	ExpiresAt   *time.Time        `json:"expires_at,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	// LastEvaluatedAt is recorded by the evaluations, at most once per EvaluationRecordInterval.
+	LastEvaluatedAt *time.Time `json:"last_evaluated_at,omitempty"`
 }
 
+// EvaluationRecordInterval is how often the evaluation time of a flag is written at most, so that
+// frequent evaluations do not turn into a write each.
+const EvaluationRecordInterval = time.Hour
+
 type FeatureFlagRequest struct {
-	Key         string `json:"key" validate:"required"`
-	Description string `json:"description" validate:"required"`
-	Enabled     bool   `json:"enabled"`
+	Key         string            `json:"key" validate:"required"`
+	Description string            `json:"description" validate:"required"`
+	Enabled     bool              `json:"enabled"`
+	Permanent   bool              `json:"permanent"`
+	Protected   bool              `json:"protected"`
+	Owner       string            `json:"owner,omitempty" validate:"max=255"`
+	OwnerType   OwnerType         `json:"owner_type,omitempty" validate:"required_with=Owner,omitempty,oneof=user team"`
+	Maintainers []string          `json:"maintainers,omitempty" validate:"dive,required,max=255"`
+	Tags        []string          `json:"tags,omitempty" validate:"dive,required,max=64"`
+	Kind        FlagKind          `json:"kind,omitempty" validate:"omitempty,oneof=release experiment ops permission kill-switch"`
+	IssueURLs   []string          `json:"issue_urls,omitempty" validate:"dive,url"`
+	Metadata    map[string]string `json:"metadata,omitempty" validate:"dive,keys,required,max=64,endkeys,max=1024"`
+	ManagedBy   string            `json:"managed_by,omitempty" validate:"max=64"`
+	ExpiresAt   *time.Time        `json:"expires_at,omitempty"`
+}
+
+// WithDefaults returns a copy of the request with an unset kind defaulted to release
+// and empty collections instead of nil ones.
+func (r FeatureFlagRequest) WithDefaults() FeatureFlagRequest {
+	if r.Kind == "" {
+		r.Kind = FlagKindRelease
+	}
+	if r.Maintainers == nil {
+		r.Maintainers = []string{}
+	}
+	if r.Tags == nil {
+		r.Tags = []string{}
+	}
+	if r.IssueURLs == nil {
+		r.IssueURLs = []string{}
+	}
+	if r.Metadata == nil {
+		r.Metadata = map[string]string{}
+	}
+	return r
+}
+
+// Flag returns a flag with the given ID and the requested fields, defaulted like WithDefaults.
+func (r FeatureFlagRequest) Flag(id uuid.UUID) FeatureFlag {
+	r = r.WithDefaults()
+	return FeatureFlag{
+		ID:          id,
+		Key:         r.Key,
+		Description: r.Description,
+		Enabled:     r.Enabled,
+		Permanent:   r.Permanent,
+		Protected:   r.Protected,
+		Owner:       r.Owner,
+		OwnerType:   r.OwnerType,
+		Maintainers: r.Maintainers,
+		Tags:        r.Tags,
+		Kind:        r.Kind,
+		IssueURLs:   r.IssueURLs,
+		Metadata:    r.Metadata,
+		ManagedBy:   r.ManagedBy,
+		ExpiresAt:   r.ExpiresAt,
+	}
+}
+
+// Request returns the writable fields of the flag.
+func (f FeatureFlag) Request() FeatureFlagRequest {
+	return FeatureFlagRequest{
+		Key:         f.Key,
+		Description: f.Description,
+		Enabled:     f.Enabled,
+		Permanent:   f.Permanent,
+		Protected:   f.Protected,
+		Owner:       f.Owner,
+		OwnerType:   f.OwnerType,
+		Maintainers: f.Maintainers,
+		Tags:        f.Tags,
+		Kind:        f.Kind,
+		IssueURLs:   f.IssueURLs,
+		Metadata:    f.Metadata,
+		ManagedBy:   f.ManagedBy,
+		ExpiresAt:   f.ExpiresAt,
+	}
 }
 
 type FeatureFlagResponse struct {
-	ID          string    `json:"id"`
-	Key         string    `json:"key"`
-	Description string    `json:"description"`
-	Enabled     bool      `json:"enabled"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string            `json:"id"`
+	Key         string            `json:"key"`
+	Description string            `json:"description"`
+	Enabled     bool              `json:"enabled"`
+	Permanent   bool              `json:"permanent"`
+	Protected   bool              `json:"protected"`
+	Owner       string            `json:"owner"`
+	OwnerType   OwnerType         `json:"owner_type"`
+	Maintainers []string          `json:"maintainers"`
+	Tags        []string          `json:"tags"`
+	Kind        FlagKind          `json:"kind"`
+	IssueURLs   []string          `json:"issue_urls"`
+	Metadata    map[string]string `json:"metadata"`
+	ManagedBy   string            `json:"managed_by"`
+	ExpiresAt   *time.Time        `json:"expires_at,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
 }
 
-var ErrNotFound = errors.New("feature flag not found")
+// FlagFilter narrows down the listed flags. Empty fields match every flag; every tag and
+// metadata entry must be present on a flag for it to match.
+type FlagFilter struct {
+	Owner      string            `validate:"max=255"`
+	Maintainer string            `validate:"max=255"`
+	Kind       FlagKind          `validate:"omitempty,oneof=release experiment ops permission kill-switch"`
+	Tags       []string          `validate:"dive,required"`
+	Metadata   map[string]string `validate:"dive,keys,required,endkeys"`
+}
+
+// Matches reports whether the flag passes the filter.
+func (f FlagFilter) Matches(flag FeatureFlag) bool {
+	if f.Owner != "" && flag.Owner != f.Owner {
+		return false
+	}
+	if f.Maintainer != "" && !slices.Contains(flag.Maintainers, f.Maintainer) {
+		return false
+	}
+	if f.Kind != "" && flag.Kind != f.Kind {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !slices.Contains(flag.Tags, tag) {
+			return false
+		}
+	}
+	for key, value := range f.Metadata {
+		if v, ok := flag.Metadata[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+type FlagCategory string
+
+const (
+	// FlagCategoryStale marks flags that have not been changed within the report window.
+	FlagCategoryStale FlagCategory = "stale"
+	// FlagCategoryFullyRolledOut marks enabled flags that have been serving true to everyone
+	// for the whole report window, so the flag can be removed and the code path kept.
+	FlagCategoryFullyRolledOut FlagCategory = "fully_rolled_out"
+	// FlagCategoryPermanent marks flags that are meant to stay and are exempt from cleanup.
+	FlagCategoryPermanent FlagCategory = "permanent"
+	// FlagCategoryUnreferenced marks flags that no scanned repository uses anymore, so they are
+	// safe to remove.
+	FlagCategoryUnreferenced FlagCategory = "unreferenced"
+	// FlagCategoryUnused marks flags that have not been evaluated within the report window, or
+	// never since they were created.
+	FlagCategoryUnused FlagCategory = "unused"
+)
+
+type FlagReportEntry struct {
+	ID              uuid.UUID      `json:"id"`
+	Key             string         `json:"key"`
+	Enabled         bool           `json:"enabled"`
+	Categories      []FlagCategory `json:"categories"`
+	DaysSinceUpdate int            `json:"days_since_update"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	LastEvaluatedAt *time.Time     `json:"last_evaluated_at,omitempty"`
+}
+
+type FlagReport struct {
+	GeneratedAt    time.Time         `json:"generated_at"`
+	StaleAfterDays int               `json:"stale_after_days"`
+	Flags          []FlagReportEntry `json:"flags"`
+}
+
+var (
+	ErrNotFound           = errors.New("feature flag not found")
+	ErrInvalidReportRange = errors.New("stale after days must be a positive number")
+)
@@ -0,0 +1,22 @@
+package model
+
+import "github.com/google/uuid"
+
+type FlagChangeType string
+
+const (
+	FlagChangeCreated FlagChangeType = "created"
+	FlagChangeUpdated FlagChangeType = "updated"
+	FlagChangeDeleted FlagChangeType = "deleted"
+	// FlagChangeKillSwitch is sent when the kill switch is activated or released, it carries no flag.
+	FlagChangeKillSwitch FlagChangeType = "kill_switch"
+)
+
+// FlagChange is sent to flag watchers after a flag was written. Deleted flags may only carry their ID.
+type FlagChange struct {
+	Type             FlagChangeType `json:"type"`
+	OrgID            uuid.UUID      `json:"-"`
+	Flag             FeatureFlag    `json:"flag"`
+	KillSwitchActive bool           `json:"kill_switch_active,omitempty"`
+	KillSwitchTag    string         `json:"kill_switch_tag,omitempty"`
+}
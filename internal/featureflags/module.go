@@ -1,32 +1,60 @@
 package featureflags
 
 import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/grpchandler"
+	traceGRPCHandlerWrappers "github.com/georgisomnoev/feature-flag-api/internal/featureflags/grpchandler/wrapped/trace"
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/handler"
 	metricHandlerWrappers "github.com/georgisomnoev/feature-flag-api/internal/featureflags/handler/wrapped/metric"
 	traceHandlerWrappers "github.com/georgisomnoev/feature-flag-api/internal/featureflags/handler/wrapped/trace"
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
 	metricServiceWrappers "github.com/georgisomnoev/feature-flag-api/internal/featureflags/service/wrapped/metric"
 	traceServiceWrappers "github.com/georgisomnoev/feature-flag-api/internal/featureflags/service/wrapped/trace"
-	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/georgisomnoev/feature-flag-api/internal/webapi"
 	"github.com/labstack/echo/v4"
 )
 
+const (
+	changeRequestSchedulerInterval = time.Minute
+	expiryReminderInterval         = 24 * time.Hour
+	expiryReminderWindow           = 7 * 24 * time.Hour
+)
+
 func Process(
-	pool *pgxpool.Pool,
+	ctx context.Context,
+	ffStore service.Store,
 	srv *echo.Echo,
+	grpcSrv *webapi.GRPCServer,
 	authStore handler.AuthStore,
+	denylist handler.Denylist,
 	jwtHelper handler.JWTHelper,
+	apiKeys handler.APIKeyAuthenticator,
+	environment string,
+	logger *slog.Logger,
+	expiryNotifier service.Notifier,
+	middlewares ...echo.MiddlewareFunc,
 ) {
-	featureFlagStore := store.NewStore(pool)
-	metricWrappedFFStore := metricServiceWrappers.NewStoreWithMetrics(featureFlagStore)
+	metricWrappedFFStore := metricServiceWrappers.NewStoreWithMetrics(ffStore)
 	wrappedFFStore := traceServiceWrappers.NewStoreWithTracing(metricWrappedFFStore)
-	featureFlagService := service.NewService(wrappedFFStore)
+	featureFlagService := service.NewService(wrappedFFStore, logger)
 	wrappedFFService := traceHandlerWrappers.NewServiceWithTracing(featureFlagService)
 	metricWrappedAuthStore := metricHandlerWrappers.NewAuthStoreWithMetrics(authStore)
 	wrappedAuthStore := traceHandlerWrappers.NewAuthStoreWithTracing(metricWrappedAuthStore)
 	metricWrappedJWTHelper := metricHandlerWrappers.NewJWTHelperWithMetrics(jwtHelper)
 	wrappedJWTHelper := traceHandlerWrappers.NewJWTHelperWithTracing(metricWrappedJWTHelper)
-	featureFlagHandler := handler.NewHandler(wrappedFFService, wrappedAuthStore, wrappedJWTHelper)
-	featureFlagHandler.RegisterHandlers(srv)
+	metricWrappedAPIKeys := metricHandlerWrappers.NewAPIKeyAuthenticatorWithMetrics(apiKeys)
+	wrappedAPIKeys := traceHandlerWrappers.NewAPIKeyAuthenticatorWithTracing(metricWrappedAPIKeys)
+	featureFlagHandler := handler.NewHandler(wrappedFFService, wrappedAuthStore, denylist, wrappedJWTHelper, wrappedAPIKeys, environment)
+	featureFlagHandler.RegisterHandlers(srv, middlewares...)
+
+	grpcWrappedFFService := traceGRPCHandlerWrappers.NewServiceWithTracing(featureFlagService)
+	featureFlagGRPCHandler := grpchandler.NewHandler(grpcWrappedFFService, wrappedAuthStore, denylist, wrappedJWTHelper, wrappedAPIKeys,
+		environment)
+	featureFlagGRPCHandler.RegisterHandlers(grpcSrv)
+
+	go featureFlagService.StartChangeRequestScheduler(ctx, changeRequestSchedulerInterval)
+	go featureFlagService.StartExpiryReminderJob(ctx, expiryReminderInterval, expiryReminderWindow, expiryNotifier)
 }
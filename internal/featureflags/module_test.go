@@ -4,17 +4,22 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"time"
 
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/apikey"
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/denylist"
 	authModel "github.com/georgisomnoev/feature-flag-api/internal/auth/model"
-	authStore "github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	authStore "github.com/georgisomnoev/feature-flag-api/internal/auth/store/sqlite"
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags"
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
-	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/notifier"
+	store "github.com/georgisomnoev/feature-flag-api/internal/featureflags/store/sqlite"
 	"github.com/georgisomnoev/feature-flag-api/internal/jwthelper"
 	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/georgisomnoev/feature-flag-api/internal/webapi"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -23,10 +28,13 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 )
 
+const tokenIssuer = "http://127.0.0.1:8080"
+
 var _ = Describe("Feature Flags Integration Test", Label("integration"), func() {
 	var (
 		token               string
 		userID              uuid.UUID
+		orgID               = authModel.DefaultOrgID
 		srv                 *httptest.Server
 		authenticationStore *authStore.Store
 		featureFlagStore    *store.Store
@@ -36,30 +44,38 @@ var _ = Describe("Feature Flags Integration Test", Label("integration"), func()
 	)
 
 	BeforeEach(func() {
+		logger := slog.New(slog.DiscardHandler)
 		e := echo.New()
 		e.Validator = validator.GetValidator()
-		authenticationStore = authStore.NewStore(pool)
-		jwtHelper, err := jwthelper.NewJWTHelper(jwtPrivateKey, jwtPublicKey)
+		authenticationStore = authStore.NewStore(db)
+		jwtHelper, err := jwthelper.NewJWTHelper(jwtPrivateKey, jwtPublicKey, "", tokenIssuer, logger)
 		Expect(err).ToNot(HaveOccurred())
 
-		featureFlagStore = store.NewStore(pool)
+		featureFlagStore = store.NewStore(db)
 
-		featureflags.Process(pool, e, authenticationStore, jwtHelper)
+		featureflags.Process(ctx, featureFlagStore, e, webapi.NewGRPCServer(logger), authenticationStore, denylist.New(authenticationStore, logger), jwtHelper,
+			apikey.NewAuthenticator(authenticationStore, logger), "production", logger, notifier.NewLog(logger))
 
 		srv = httptest.NewServer(e)
 
 		userID = uuid.New()
 		claims := jwt.MapClaims{
+			"jti":    uuid.NewString(),
+			"iss":    tokenIssuer,
 			"sub":    userID,
+			"org":    orgID.String(),
 			"scopes": []string{"read:flags", "write:flags"},
+			"iat":    time.Now().Unix(),
 			"exp":    time.Now().Add(1 * time.Hour).Unix(),
 		}
 		token, err = jwtHelper.GenerateToken(claims)
 		Expect(err).NotTo(HaveOccurred())
 
-		user := authModel.User{ID: userID, Role: authModel.RoleEditor}
+		user := authModel.User{ID: userID, Username: "user-" + userID.String()}
 		err = authenticationStore.AddUser(ctx, user)
 		Expect(err).NotTo(HaveOccurred())
+		err = authenticationStore.AddOrgMember(ctx, orgID, userID, authModel.RoleEditor)
+		Expect(err).NotTo(HaveOccurred())
 	})
 
 	AfterEach(func() {
@@ -80,9 +96,11 @@ var _ = Describe("Feature Flags Integration Test", Label("integration"), func()
 		BeforeEach(func() {
 			testFlag = model.FeatureFlag{
 				ID:          uuid.New(),
+				OrgID:       orgID,
 				Key:         "test-flag",
 				Description: "test description",
 				Enabled:     true,
+				Kind:        model.FlagKindRelease,
 				CreatedAt:   time.Now().UTC(),
 				UpdatedAt:   time.Now().UTC(),
 			}
@@ -92,7 +110,7 @@ var _ = Describe("Feature Flags Integration Test", Label("integration"), func()
 		})
 
 		AfterEach(func() {
-			err := featureFlagStore.DeleteFlag(ctx, testFlag.ID)
+			err := featureFlagStore.DeleteFlag(ctx, orgID, testFlag.ID)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -139,6 +157,16 @@ var _ = Describe("Feature Flags Integration Test", Label("integration"), func()
 					"UpdatedAt":   BeTemporally("~", time.Now().UTC(), time.Second),
 				})))
 			})
+
+			Context("when the user was disabled", func() {
+				BeforeEach(func() {
+					Expect(authenticationStore.SetUserDisabled(ctx, userID, true)).To(Succeed())
+				})
+
+				It("rejects the token", func() {
+					Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
 		})
 
 		Context("Get Feature Flag By ID", func() {
@@ -203,7 +231,7 @@ var _ = Describe("Feature Flags Integration Test", Label("integration"), func()
 			})
 
 			JustAfterEach(func() {
-				err := featureFlagStore.DeleteFlag(ctx, generateFlagID)
+				err := featureFlagStore.DeleteFlag(ctx, orgID, generateFlagID)
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -212,7 +240,7 @@ var _ = Describe("Feature Flags Integration Test", Label("integration"), func()
 				Expect(resp.StatusCode).To(Equal(http.StatusCreated))
 			})
 			It("creates the flag", func() {
-				storedFlag, err := featureFlagStore.GetFlagByID(ctx, generateFlagID)
+				storedFlag, err := featureFlagStore.GetFlagByID(ctx, orgID, generateFlagID)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(storedFlag).To((MatchFields(IgnoreExtras, Fields{
 					"ID":          Equal(generateFlagID),
@@ -235,9 +263,11 @@ var _ = Describe("Feature Flags Integration Test", Label("integration"), func()
 			BeforeEach(func() {
 				anotherFlag = model.FeatureFlag{
 					ID:          uuid.New(),
+					OrgID:       orgID,
 					Key:         testFlag.Key,
 					Description: testFlag.Description,
 					Enabled:     true,
+					Kind:        model.FlagKindRelease,
 				}
 
 				err := featureFlagStore.CreateFlag(ctx, anotherFlag)
@@ -258,7 +288,7 @@ var _ = Describe("Feature Flags Integration Test", Label("integration"), func()
 			})
 
 			AfterEach(func() {
-				err := featureFlagStore.DeleteFlag(ctx, anotherFlag.ID)
+				err := featureFlagStore.DeleteFlag(ctx, orgID, anotherFlag.ID)
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -267,7 +297,7 @@ var _ = Describe("Feature Flags Integration Test", Label("integration"), func()
 				Expect(resp.StatusCode).To(Equal(http.StatusOK))
 			})
 			It("updates the flag", func() {
-				storedFlag, err := featureFlagStore.GetFlagByID(ctx, anotherFlag.ID)
+				storedFlag, err := featureFlagStore.GetFlagByID(ctx, orgID, anotherFlag.ID)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(storedFlag).To((MatchFields(IgnoreExtras, Fields{
 					"ID":          Equal(anotherFlag.ID),
@@ -280,6 +310,47 @@ var _ = Describe("Feature Flags Integration Test", Label("integration"), func()
 			})
 		})
 
+		Context("Update Protected Feature Flag", func() {
+			var protectedFlag model.FeatureFlag
+
+			BeforeEach(func() {
+				protectedFlag = model.FeatureFlag{
+					ID:          uuid.New(),
+					OrgID:       orgID,
+					Key:         testFlag.Key,
+					Description: testFlag.Description,
+					Enabled:     true,
+					Kind:        model.FlagKindRelease,
+					Protected:   true,
+				}
+
+				err := featureFlagStore.CreateFlag(ctx, protectedFlag)
+				Expect(err).ToNot(HaveOccurred())
+
+				payload, err := json.Marshal(model.FeatureFlagRequest{Key: "updated-flag", Description: "updated description"})
+				Expect(err).ToNot(HaveOccurred())
+
+				req, err = http.NewRequest(http.MethodPut, fmt.Sprintf("%s/flags/%s", srv.URL, protectedFlag.ID), bytes.NewBuffer(payload))
+				Expect(err).ToNot(HaveOccurred())
+				req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", token))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			})
+
+			AfterEach(func() {
+				err := featureFlagStore.DeleteFlag(ctx, orgID, protectedFlag.ID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			ItSucceeds()
+			It("rejects the direct change", func() {
+				Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+				storedFlag, err := featureFlagStore.GetFlagByID(ctx, orgID, protectedFlag.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(storedFlag.Key).To(Equal(protectedFlag.Key))
+			})
+		})
+
 		Context("Delete Feature Flag", func() {
 			var (
 				anotherFlag model.FeatureFlag
@@ -288,9 +359,11 @@ var _ = Describe("Feature Flags Integration Test", Label("integration"), func()
 			BeforeEach(func() {
 				anotherFlag = model.FeatureFlag{
 					ID:          uuid.New(),
+					OrgID:       orgID,
 					Key:         testFlag.Key,
 					Description: testFlag.Description,
 					Enabled:     true,
+					Kind:        model.FlagKindRelease,
 				}
 
 				err := featureFlagStore.CreateFlag(ctx, anotherFlag)
@@ -306,9 +379,236 @@ var _ = Describe("Feature Flags Integration Test", Label("integration"), func()
 				Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
 			})
 			It("deletes the flag", func() {
-				_, err := featureFlagStore.GetFlagByID(ctx, anotherFlag.ID)
+				_, err := featureFlagStore.GetFlagByID(ctx, orgID, anotherFlag.ID)
 				Expect(err).To(MatchError(model.ErrNotFound))
 			})
 		})
+
+		Context("Flags restricted to a group", func() {
+			var group model.Group
+
+			BeforeEach(func() {
+				group = model.Group{ID: uuid.New(), OrgID: orgID, Name: "group-" + uuid.NewString(), CreatedAt: time.Now().UTC()}
+				Expect(featureFlagStore.CreateGroup(ctx, group)).To(Succeed())
+				Expect(featureFlagStore.CreateAccessRule(ctx, model.AccessRule{
+					ID:         uuid.New(),
+					OrgID:      orgID,
+					GroupID:    group.ID,
+					FlagID:     &testFlag.ID,
+					Permission: model.PermissionWrite,
+					CreatedAt:  time.Now().UTC(),
+				})).To(Succeed())
+
+				var err error
+				req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("%s/flags/%s", srv.URL, testFlag.ID), nil)
+				Expect(err).ToNot(HaveOccurred())
+				req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", token))
+			})
+
+			AfterEach(func() {
+				Expect(featureFlagStore.DeleteGroup(ctx, orgID, group.ID)).To(Succeed())
+			})
+
+			It("are hidden from users outside the group", func() {
+				Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+			})
+
+			Context("when the user joins the group", func() {
+				BeforeEach(func() {
+					Expect(featureFlagStore.AddGroupMember(ctx, group.ID, userID)).To(Succeed())
+				})
+
+				It("returns the flag", func() {
+					Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+
+			Context("when a change is proposed for the flag", func() {
+				BeforeEach(func() {
+					changeRequest := model.ChangeRequest{
+						ID:       uuid.New(),
+						OrgID:    orgID,
+						FlagID:   testFlag.ID,
+						Action:   model.ChangeRequestActionDelete,
+						Status:   model.ChangeRequestStatusPending,
+						AuthorID: uuid.New(),
+					}
+					Expect(featureFlagStore.CreateChangeRequest(ctx, changeRequest)).To(Succeed())
+
+					var err error
+					req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("%s/change-requests/%s", srv.URL, changeRequest.ID), nil)
+					Expect(err).ToNot(HaveOccurred())
+					req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", token))
+				})
+
+				It("hides the change request from users outside the group", func() {
+					Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("Authenticated with an API key", func() {
+			var apiKey authModel.APIKey
+
+			BeforeEach(func() {
+				key, prefix, hash, err := apikey.Generate(authModel.APIKeyKindClient)
+				Expect(err).ToNot(HaveOccurred())
+				apiKey = authModel.APIKey{
+					ID:          uuid.New(),
+					OrgID:       orgID,
+					Name:        "sdk",
+					Environment: "production",
+					Kind:        authModel.APIKeyKindClient,
+					Prefix:      prefix,
+					Hash:        hash,
+					Scopes:      []string{"read:flags"},
+					CreatedBy:   userID,
+					CreatedAt:   time.Now().UTC(),
+				}
+				Expect(authenticationStore.CreateAPIKey(ctx, apiKey)).To(Succeed())
+
+				req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("%s/flags/%s", srv.URL, testFlag.ID), nil)
+				Expect(err).ToNot(HaveOccurred())
+				req.Header.Set("X-API-Key", key)
+			})
+
+			It("returns the flag", func() {
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			Context("when the key is for another environment", func() {
+				BeforeEach(func() {
+					key, prefix, hash, err := apikey.Generate(authModel.APIKeyKindClient)
+					Expect(err).ToNot(HaveOccurred())
+					stagingKey := apiKey
+					stagingKey.ID = uuid.New()
+					stagingKey.Environment = "staging"
+					stagingKey.Prefix = prefix
+					stagingKey.Hash = hash
+					Expect(authenticationStore.CreateAPIKey(ctx, stagingKey)).To(Succeed())
+					req.Header.Set("X-API-Key", key)
+				})
+
+				It("returns 403", func() {
+					Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when the key was revoked", func() {
+				BeforeEach(func() {
+					Expect(authenticationStore.RevokeAPIKey(ctx, orgID, apiKey.ID, time.Now().UTC())).To(Succeed())
+				})
+
+				It("returns 401", func() {
+					Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+		})
+
+		Context("Flags of another organization", func() {
+			var (
+				otherOrg  authModel.Organization
+				otherFlag model.FeatureFlag
+			)
+
+			BeforeEach(func() {
+				otherOrg = authModel.Organization{ID: uuid.New(), Name: "other-" + uuid.NewString(), CreatedAt: time.Now().UTC()}
+				err := authenticationStore.CreateOrg(ctx, otherOrg, userID)
+				Expect(err).ToNot(HaveOccurred())
+				err = authenticationStore.RemoveOrgMember(ctx, otherOrg.ID, userID)
+				Expect(err).ToNot(HaveOccurred())
+
+				otherFlag = model.FeatureFlag{
+					ID:          uuid.New(),
+					OrgID:       otherOrg.ID,
+					Key:         testFlag.Key,
+					Description: testFlag.Description,
+					Enabled:     true,
+					Kind:        model.FlagKindRelease,
+				}
+				err = featureFlagStore.CreateFlag(ctx, otherFlag)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				err := featureFlagStore.DeleteFlag(ctx, otherOrg.ID, otherFlag.ID)
+				Expect(err).ToNot(HaveOccurred())
+				err = authenticationStore.DeleteOrgByID(ctx, otherOrg.ID)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when listed", func() {
+				BeforeEach(func() {
+					var err error
+					req, err = http.NewRequest(http.MethodGet, srv.URL+"/flags", nil)
+					Expect(err).ToNot(HaveOccurred())
+					req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", token))
+				})
+
+				It("are left out", func() {
+					Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+					var flags []model.FeatureFlag
+					Expect(json.NewDecoder(resp.Body).Decode(&flags)).To(Succeed())
+					Expect(flags).To(ContainElement(MatchFields(IgnoreExtras, Fields{"ID": Equal(testFlag.ID)})))
+					Expect(flags).ToNot(ContainElement(MatchFields(IgnoreExtras, Fields{"ID": Equal(otherFlag.ID)})))
+				})
+			})
+
+			ItIsNotFound := func(method, body string) {
+				BeforeEach(func() {
+					var err error
+					req, err = http.NewRequest(method, fmt.Sprintf("%s/flags/%s", srv.URL, otherFlag.ID), bytes.NewBufferString(body))
+					Expect(err).ToNot(HaveOccurred())
+					req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", token))
+					req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				})
+
+				It("returns 404 and leaves the flag alone", func() {
+					Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+					storedFlag, err := featureFlagStore.GetFlagByID(ctx, otherOrg.ID, otherFlag.ID)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(storedFlag.Key).To(Equal(otherFlag.Key))
+				})
+			}
+
+			Context("when fetched", func() {
+				ItIsNotFound(http.MethodGet, "")
+			})
+
+			Context("when updated", func() {
+				ItIsNotFound(http.MethodPut, `{"key":"hijacked","description":"hijacked"}`)
+			})
+
+			Context("when deleted", func() {
+				ItIsNotFound(http.MethodDelete, "")
+			})
+
+			Context("when the token is issued for that organization", func() {
+				BeforeEach(func() {
+					jwtHelper, err := jwthelper.NewJWTHelper(jwtPrivateKey, jwtPublicKey, "", tokenIssuer, slog.New(slog.DiscardHandler))
+					Expect(err).ToNot(HaveOccurred())
+					otherToken, err := jwtHelper.GenerateToken(jwt.MapClaims{
+						"jti":    uuid.NewString(),
+						"iss":    tokenIssuer,
+						"sub":    userID,
+						"org":    otherOrg.ID.String(),
+						"scopes": []string{"read:flags"},
+						"iat":    time.Now().Unix(),
+						"exp":    time.Now().Add(time.Hour).Unix(),
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					req, err = http.NewRequest(http.MethodGet, srv.URL+"/flags", nil)
+					Expect(err).ToNot(HaveOccurred())
+					req.Header.Set(echo.HeaderAuthorization, fmt.Sprintf("Bearer %s", otherToken))
+				})
+
+				It("rejects users outside the organization", func() {
+					Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
 	})
 })
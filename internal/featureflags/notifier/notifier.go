@@ -0,0 +1,81 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+)
+
+// EventFlagExpiry is the type of the events sent for the expiry reminders.
+const EventFlagExpiry = "flag.expiry"
+
+type Event struct {
+	Type     string               `json:"type"`
+	Reminder model.ExpiryReminder `json:"reminder"`
+}
+
+// Webhook posts every reminder as an Event to a URL. Any status other than 2xx is a failed delivery.
+type Webhook struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhook(url string, client *http.Client) *Webhook {
+	return &Webhook{url: url, client: client}
+}
+
+func (w *Webhook) NotifyExpiry(ctx context.Context, reminder model.ExpiryReminder) error {
+	body, err := json.Marshal(Event{Type: EventFlagExpiry, Reminder: reminder})
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Log writes every reminder to the logger, for deployments without a webhook.
+type Log struct {
+	logger *slog.Logger
+}
+
+func NewLog(logger *slog.Logger) *Log {
+	return &Log{logger: logger}
+}
+
+func (l *Log) NotifyExpiry(ctx context.Context, reminder model.ExpiryReminder) error {
+	owner := "unowned"
+	if reminder.Owner != "" {
+		owner = fmt.Sprintf("%s %s", reminder.OwnerType, reminder.Owner)
+	}
+	l.logger.InfoContext(ctx, "flag expiry reminder",
+		"org_id", reminder.OrgID,
+		"key", reminder.Key,
+		"status", reminder.Status,
+		"expires_at", reminder.ExpiresAt.Format(time.RFC3339),
+		"owner", owner,
+		"maintainers", strings.Join(reminder.Maintainers, ", "),
+	)
+	return nil
+}
@@ -0,0 +1,78 @@
+package notifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/notifier"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Webhook", func() {
+	var (
+		ctx       context.Context
+		server    *httptest.Server
+		status    int
+		reminder  model.ExpiryReminder
+		errAction error
+
+		lastRequest *http.Request
+		lastBody    []byte
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		status = http.StatusNoContent
+		reminder = model.ExpiryReminder{
+			OrgID:     uuid.New(),
+			FlagID:    uuid.New(),
+			Key:       "expired-flag",
+			Owner:     "payments",
+			OwnerType: model.OwnerTypeTeam,
+			ExpiresAt: time.Now().UTC().Add(-time.Hour).Truncate(time.Second),
+			Status:    model.ExpiryStatusExpired,
+		}
+	})
+
+	JustBeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			lastRequest = r
+			lastBody, _ = io.ReadAll(r.Body)
+			w.WriteHeader(status)
+		}))
+		errAction = notifier.NewWebhook(server.URL+"/hooks/flags", server.Client()).NotifyExpiry(ctx, reminder)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("posts the reminder as an expiry event", func() {
+		Expect(errAction).NotTo(HaveOccurred())
+		Expect(lastRequest.Method).To(Equal(http.MethodPost))
+		Expect(lastRequest.URL.Path).To(Equal("/hooks/flags"))
+		Expect(lastRequest.Header.Get("Content-Type")).To(Equal("application/json"))
+
+		var event notifier.Event
+		Expect(json.Unmarshal(lastBody, &event)).To(Succeed())
+		Expect(event.Type).To(Equal(notifier.EventFlagExpiry))
+		Expect(event.Reminder).To(Equal(reminder))
+	})
+
+	Context("when the webhook does not accept the event", func() {
+		BeforeEach(func() {
+			status = http.StatusBadGateway
+		})
+
+		It("returns an error", func() {
+			Expect(errAction).To(MatchError(ContainSubstring("502")))
+		})
+	})
+})
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+// flagAccess holds what is needed to decide which flags the caller may read and write.
+type flagAccess struct {
+	unrestricted bool
+	groupIDs     []uuid.UUID
+	rules        []model.AccessRule
+}
+
+// flagAccess loads the access rules of the organization for the caller stored in the context.
+// Calls without a caller are rejected, background jobs run as the system principal.
+func (s *Service) flagAccess(ctx context.Context, orgID uuid.UUID) (flagAccess, error) {
+	principal, ok := model.PrincipalFromContext(ctx)
+	if !ok {
+		return flagAccess{}, model.ErrNoPrincipal
+	}
+	if principal.Unrestricted {
+		return flagAccess{unrestricted: true}, nil
+	}
+
+	rules, err := s.store.ListAccessRules(ctx, orgID)
+	if err != nil {
+		return flagAccess{}, fmt.Errorf("failed to list access rules: %w", err)
+	}
+	return flagAccess{groupIDs: principal.GroupIDs, rules: rules}, nil
+}
+
+// allows reports whether the caller has the permission on the flag. Flags no rule covers are open
+// to the whole organization, the others only to the groups the covering rules grant access to.
+func (a flagAccess) allows(flag model.FeatureFlag, permission model.Permission) bool {
+	if a.unrestricted {
+		return true
+	}
+
+	covered := false
+	for _, rule := range a.rules {
+		if !rule.Covers(flag) {
+			continue
+		}
+		covered = true
+		if slices.Contains(a.groupIDs, rule.GroupID) &&
+			(rule.Permission == model.PermissionWrite || permission == model.PermissionRead) {
+			return true
+		}
+	}
+	return !covered
+}
+
+// readable drops the flags the caller may not read.
+func (a flagAccess) readable(flags []model.FeatureFlag) []model.FeatureFlag {
+	return slices.DeleteFunc(flags, func(flag model.FeatureFlag) bool {
+		return !a.allows(flag, model.PermissionRead)
+	})
+}
+
+// ensureWriteAccess rejects the write unless the caller may write every given flag.
+func (s *Service) ensureWriteAccess(ctx context.Context, orgID uuid.UUID, flags ...model.FeatureFlag) error {
+	access, err := s.flagAccess(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	for _, flag := range flags {
+		if !access.allows(flag, model.PermissionWrite) {
+			return model.ErrFlagAccessDenied
+		}
+	}
+	return nil
+}
+
+// writableFlag returns the current flag if the caller may write it.
+func (s *Service) writableFlag(ctx context.Context, orgID, id uuid.UUID) (model.FeatureFlag, error) {
+	flag, err := s.fetchFlag(ctx, orgID, id)
+	if err != nil {
+		return model.FeatureFlag{}, err
+	}
+	if err := s.ensureWriteAccess(ctx, orgID, flag); err != nil {
+		return model.FeatureFlag{}, err
+	}
+	return flag, nil
+}
+
+func (s *Service) ListAccessRules(ctx context.Context, orgID uuid.UUID) ([]model.AccessRule, error) {
+	rules, err := s.store.ListAccessRules(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list access rules: %w", err)
+	}
+	return rules, nil
+}
+
+func (s *Service) CreateAccessRule(ctx context.Context, orgID uuid.UUID, req model.AccessRuleRequest) (model.AccessRule, error) {
+	if _, err := s.GetGroup(ctx, orgID, req.GroupID); err != nil {
+		return model.AccessRule{}, err
+	}
+	if req.FlagID != nil {
+		if _, err := s.fetchFlag(ctx, orgID, *req.FlagID); err != nil {
+			return model.AccessRule{}, err
+		}
+	}
+
+	rule := model.AccessRule{
+		ID:         uuid.New(),
+		OrgID:      orgID,
+		GroupID:    req.GroupID,
+		FlagID:     req.FlagID,
+		Tag:        req.Tag,
+		Permission: req.Permission,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if err := s.store.CreateAccessRule(ctx, rule); err != nil {
+		return model.AccessRule{}, fmt.Errorf("failed to create access rule: %w", err)
+	}
+	return rule, nil
+}
+
+func (s *Service) DeleteAccessRule(ctx context.Context, orgID, id uuid.UUID) error {
+	if err := s.store.DeleteAccessRule(ctx, orgID, id); err != nil {
+		if errors.Is(err, model.ErrAccessRuleNotFound) {
+			return model.ErrAccessRuleNotFound
+		}
+		return fmt.Errorf("failed to delete access rule: %w", err)
+	}
+	return nil
+}
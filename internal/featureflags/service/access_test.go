@@ -0,0 +1,473 @@
+package service_test
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service/servicefakes"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Flag access rules", func() {
+	var (
+		ctx       context.Context
+		errAction error
+		svc       *service.Service
+		store     *servicefakes.FakeStore
+		principal model.Principal
+		groupID   uuid.UUID
+
+		openFlag       model.FeatureFlag
+		restrictedFlag model.FeatureFlag
+		taggedFlag     model.FeatureFlag
+	)
+
+	BeforeEach(func() {
+		store = &servicefakes.FakeStore{}
+		svc = service.NewService(store, slog.New(slog.DiscardHandler))
+
+		groupID = uuid.New()
+		principal = model.Principal{UserID: uuid.New()}
+		ctx = model.ContextWithPrincipal(context.Background(), principal)
+
+		openFlag = model.FeatureFlag{ID: uuid.New(), OrgID: orgID, Key: "open_flag"}
+		restrictedFlag = model.FeatureFlag{ID: uuid.New(), OrgID: orgID, Key: "restricted_flag"}
+		taggedFlag = model.FeatureFlag{ID: uuid.New(), OrgID: orgID, Key: "tagged_flag", Tags: []string{"payments"}}
+
+		store.ListFlagsReturns([]model.FeatureFlag{openFlag, restrictedFlag, taggedFlag}, nil)
+		store.ListAccessRulesReturns([]model.AccessRule{
+			{ID: uuid.New(), OrgID: orgID, GroupID: groupID, FlagID: &restrictedFlag.ID, Permission: model.PermissionWrite},
+			{ID: uuid.New(), OrgID: orgID, GroupID: groupID, Tag: "payments", Permission: model.PermissionRead},
+		}, nil)
+	})
+
+	asMember := func() {
+		BeforeEach(func() {
+			principal.GroupIDs = []uuid.UUID{groupID}
+			ctx = model.ContextWithPrincipal(context.Background(), principal)
+		})
+	}
+
+	Describe("ListFlags", func() {
+		var flags []model.FeatureFlag
+
+		JustBeforeEach(func() {
+			flags, errAction = svc.ListFlags(ctx, orgID, model.FlagFilter{})
+		})
+
+		It("only returns the flags no rule covers", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			Expect(flags).To(HaveExactElements(MatchFields(IgnoreExtras, Fields{"ID": Equal(openFlag.ID)})))
+		})
+
+		It("loads the rules of the organization", func() {
+			Expect(store.ListAccessRulesCallCount()).To(Equal(1))
+			_, actualOrgID := store.ListAccessRulesArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+		})
+
+		Context("when the caller is a member of the granted group", func() {
+			asMember()
+
+			It("returns every flag", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				Expect(flags).To(HaveLen(3))
+			})
+		})
+
+		Context("when the caller is unrestricted", func() {
+			BeforeEach(func() {
+				ctx = model.ContextWithPrincipal(context.Background(), model.Principal{UserID: uuid.New(), Unrestricted: true})
+			})
+
+			It("returns every flag without loading the rules", func() {
+				Expect(flags).To(HaveLen(3))
+				Expect(store.ListAccessRulesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the system is the caller", func() {
+			BeforeEach(func() {
+				ctx = model.ContextWithPrincipal(context.Background(), model.SystemPrincipal)
+			})
+
+			It("returns every flag", func() {
+				Expect(flags).To(HaveLen(3))
+			})
+		})
+
+		Context("when there is no caller", func() {
+			BeforeEach(func() {
+				ctx = context.Background()
+			})
+
+			It("returns an error", func() {
+				Expect(errAction).To(MatchError(model.ErrNoPrincipal))
+			})
+		})
+
+		Context("when the rules cannot be loaded", func() {
+			BeforeEach(func() {
+				store.ListAccessRulesReturns(nil, ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+
+	Describe("GetFlagByID", func() {
+		BeforeEach(func() {
+			store.GetFlagByIDReturns(taggedFlag, nil)
+		})
+
+		JustBeforeEach(func() {
+			_, errAction = svc.GetFlagByID(ctx, orgID, taggedFlag.ID)
+		})
+
+		It("reports flags the caller may not read as not found", func() {
+			Expect(errAction).To(MatchError(model.ErrNotFound))
+		})
+
+		Context("when the caller is a member of the granted group", func() {
+			asMember()
+
+			It("succeeds", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("EvaluateFlag", func() {
+		BeforeEach(func() {
+			store.GetFlagByKeyReturns(restrictedFlag, nil)
+			store.GetActiveKillSwitchReturns(model.KillSwitch{}, model.ErrKillSwitchNotActive)
+		})
+
+		JustBeforeEach(func() {
+			_, errAction = svc.EvaluateFlag(ctx, orgID, restrictedFlag.Key)
+		})
+
+		It("reports flags the caller may not read as not found", func() {
+			Expect(errAction).To(MatchError(model.ErrNotFound))
+		})
+
+		Context("when the caller is a member of the granted group", func() {
+			asMember()
+
+			It("succeeds", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("UpdateFlag", func() {
+		var (
+			flag model.FeatureFlag
+			req  model.FeatureFlagRequest
+		)
+
+		BeforeEach(func() {
+			flag = restrictedFlag
+			req = model.FeatureFlagRequest{Key: "restricted_flag", Description: "description", Enabled: true}
+		})
+
+		JustBeforeEach(func() {
+			store.GetFlagByIDReturns(flag, nil)
+			errAction = svc.UpdateFlag(ctx, orgID, flag.ID, req)
+		})
+
+		It("rejects callers without write access", func() {
+			Expect(errAction).To(MatchError(model.ErrFlagAccessDenied))
+			Expect(store.UpdateFlagCallCount()).To(BeZero())
+		})
+
+		Context("when the caller is a member of the granted group", func() {
+			asMember()
+
+			It("updates the flag", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				Expect(store.UpdateFlagCallCount()).To(Equal(1))
+			})
+
+			Context("and the group may only read the flag", func() {
+				BeforeEach(func() {
+					flag = taggedFlag
+					req.Tags = []string{"payments"}
+				})
+
+				It("rejects the update", func() {
+					Expect(errAction).To(MatchError(model.ErrFlagAccessDenied))
+				})
+			})
+		})
+
+		Context("when the update adds a tag the caller may not write", func() {
+			BeforeEach(func() {
+				flag = openFlag
+				req.Tags = []string{"payments"}
+			})
+
+			It("rejects the update", func() {
+				Expect(errAction).To(MatchError(model.ErrFlagAccessDenied))
+				Expect(store.UpdateFlagCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("DeleteFlag", func() {
+		JustBeforeEach(func() {
+			store.GetFlagByIDReturns(restrictedFlag, nil)
+			errAction = svc.DeleteFlag(ctx, orgID, restrictedFlag.ID)
+		})
+
+		It("rejects callers without write access", func() {
+			Expect(errAction).To(MatchError(model.ErrFlagAccessDenied))
+			Expect(store.DeleteFlagCallCount()).To(BeZero())
+		})
+
+		Context("when the caller is a member of the granted group", func() {
+			asMember()
+
+			It("deletes the flag", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				Expect(store.DeleteFlagCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("CreateFlag", func() {
+		var req model.FeatureFlagRequest
+
+		BeforeEach(func() {
+			req = model.FeatureFlagRequest{Key: "new_flag", Description: "description"}
+		})
+
+		JustBeforeEach(func() {
+			_, errAction = svc.CreateFlag(ctx, orgID, req)
+		})
+
+		It("creates flags no rule covers", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			Expect(store.CreateFlagCallCount()).To(Equal(1))
+		})
+
+		Context("when the flag carries a restricted tag", func() {
+			BeforeEach(func() {
+				req.Tags = []string{"payments"}
+			})
+
+			It("rejects the flag", func() {
+				Expect(errAction).To(MatchError(model.ErrFlagAccessDenied))
+				Expect(store.CreateFlagCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("CreateChangeRequest", func() {
+		JustBeforeEach(func() {
+			store.GetFlagByIDReturns(restrictedFlag, nil)
+			_, errAction = svc.CreateChangeRequest(ctx, orgID, restrictedFlag.ID, principal.UserID, model.ChangeRequestRequest{
+				Action: model.ChangeRequestActionDelete,
+			})
+		})
+
+		It("rejects callers without write access", func() {
+			Expect(errAction).To(MatchError(model.ErrFlagAccessDenied))
+			Expect(store.CreateChangeRequestCallCount()).To(BeZero())
+		})
+
+		Context("when the caller is a member of the granted group", func() {
+			asMember()
+
+			It("creates the change request", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				Expect(store.CreateChangeRequestCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("ListChangeRequests", func() {
+		var changeRequests []model.ChangeRequest
+
+		BeforeEach(func() {
+			store.ListChangeRequestsReturns([]model.ChangeRequest{
+				{ID: uuid.New(), OrgID: orgID, FlagID: openFlag.ID},
+				{ID: uuid.New(), OrgID: orgID, FlagID: restrictedFlag.ID},
+				{ID: uuid.New(), OrgID: orgID, FlagID: taggedFlag.ID},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			changeRequests, errAction = svc.ListChangeRequests(ctx, orgID, "")
+		})
+
+		It("only returns the change requests of flags the caller may read", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			Expect(changeRequests).To(HaveExactElements(MatchFields(IgnoreExtras, Fields{"FlagID": Equal(openFlag.ID)})))
+		})
+
+		Context("when the caller is a member of the granted group", func() {
+			asMember()
+
+			It("returns every change request", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				Expect(changeRequests).To(HaveLen(3))
+			})
+		})
+
+		Context("when a restricted flag was deleted since", func() {
+			BeforeEach(func() {
+				store.ListFlagsReturns([]model.FeatureFlag{openFlag, taggedFlag}, nil)
+			})
+
+			It("still applies the rules on its ID", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				Expect(changeRequests).To(HaveExactElements(MatchFields(IgnoreExtras, Fields{"FlagID": Equal(openFlag.ID)})))
+			})
+		})
+
+		Context("when the caller is unrestricted", func() {
+			BeforeEach(func() {
+				ctx = model.ContextWithPrincipal(context.Background(), model.Principal{UserID: uuid.New(), Unrestricted: true})
+			})
+
+			It("returns every change request without loading the flags", func() {
+				Expect(changeRequests).To(HaveLen(3))
+				Expect(store.ListFlagsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the flags cannot be loaded", func() {
+			BeforeEach(func() {
+				store.ListFlagsReturns(nil, ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+
+	Describe("GetChangeRequest", func() {
+		BeforeEach(func() {
+			store.GetChangeRequestByIDReturns(model.ChangeRequest{ID: uuid.New(), OrgID: orgID, FlagID: taggedFlag.ID}, nil)
+			store.GetFlagByIDReturns(taggedFlag, nil)
+		})
+
+		JustBeforeEach(func() {
+			_, errAction = svc.GetChangeRequest(ctx, orgID, uuid.New())
+		})
+
+		It("reports change requests of flags the caller may not read as not found", func() {
+			Expect(errAction).To(MatchError(model.ErrChangeRequestNotFound))
+		})
+
+		Context("when the caller is a member of the granted group", func() {
+			asMember()
+
+			It("succeeds", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the flag was deleted since", func() {
+			BeforeEach(func() {
+				store.GetFlagByIDReturns(model.FeatureFlag{}, model.ErrNotFound)
+			})
+
+			It("succeeds unless a rule covers its ID", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ApproveChangeRequest", func() {
+		BeforeEach(func() {
+			store.GetChangeRequestByIDReturns(model.ChangeRequest{
+				ID:       uuid.New(),
+				OrgID:    orgID,
+				FlagID:   taggedFlag.ID,
+				Status:   model.ChangeRequestStatusPending,
+				AuthorID: uuid.New(),
+			}, nil)
+			store.GetFlagByIDReturns(taggedFlag, nil)
+		})
+
+		JustBeforeEach(func() {
+			errAction = svc.ApproveChangeRequest(ctx, orgID, uuid.New(), principal.UserID, "")
+		})
+
+		It("reports change requests of flags the caller may not read as not found", func() {
+			Expect(errAction).To(MatchError(model.ErrChangeRequestNotFound))
+			Expect(store.ReviewChangeRequestCallCount()).To(BeZero())
+		})
+
+		Context("when the group may only read the flag", func() {
+			asMember()
+
+			It("rejects the review", func() {
+				Expect(errAction).To(MatchError(model.ErrFlagAccessDenied))
+				Expect(store.ReviewChangeRequestCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the group may write the flag", func() {
+			asMember()
+
+			BeforeEach(func() {
+				store.GetChangeRequestByIDReturns(model.ChangeRequest{
+					ID:       uuid.New(),
+					OrgID:    orgID,
+					FlagID:   restrictedFlag.ID,
+					Status:   model.ChangeRequestStatusPending,
+					AuthorID: uuid.New(),
+				}, nil)
+				store.GetFlagByIDReturns(restrictedFlag, nil)
+			})
+
+			It("records the review", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				Expect(store.ReviewChangeRequestCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("WatchFlags", func() {
+		var (
+			watchCtx context.Context
+			cancel   context.CancelFunc
+			changes  <-chan model.FlagChange
+		)
+
+		BeforeEach(func() {
+			adminCtx := model.ContextWithPrincipal(context.Background(), model.Principal{UserID: uuid.New(), Unrestricted: true})
+			watchCtx, cancel = context.WithCancel(ctx)
+			var err error
+			changes, err = svc.WatchFlags(watchCtx, orgID)
+			Expect(err).ToNot(HaveOccurred())
+
+			store.GetFlagByIDReturns(restrictedFlag, nil)
+			Expect(svc.UpdateFlag(adminCtx, orgID, restrictedFlag.ID, model.FeatureFlagRequest{Key: "restricted_flag"})).To(Succeed())
+			store.GetFlagByIDReturns(openFlag, nil)
+			Expect(svc.UpdateFlag(adminCtx, orgID, openFlag.ID, model.FeatureFlagRequest{Key: "open_flag"})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		It("only receives changes of flags the caller may read", func() {
+			Eventually(changes).Should(Receive(MatchFields(IgnoreExtras, Fields{
+				"Flag": MatchFields(IgnoreExtras, Fields{"ID": Equal(openFlag.ID)}),
+			})))
+			Consistently(changes).ShouldNot(Receive())
+		})
+	})
+})
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+func (s *Service) CreateChangeRequest(
+	ctx context.Context,
+	orgID uuid.UUID,
+	flagID uuid.UUID,
+	authorID uuid.UUID,
+	req model.ChangeRequestRequest,
+) (uuid.UUID, error) {
+	flag, err := s.writableFlag(ctx, orgID, flagID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	// Managed flags are changed by their tool only, never through a change request.
+	if flag.ManagedBy != "" {
+		return uuid.Nil, model.ErrFlagManaged
+	}
+	if req.Action == model.ChangeRequestActionUpdate && req.Flag != nil {
+		if err := s.ensureWriteAccess(ctx, orgID, flagFromRequest(orgID, flagID, *req.Flag)); err != nil {
+			return uuid.Nil, err
+		}
+		if req.Flag.ManagedBy != "" {
+			return uuid.Nil, model.ErrFlagManaged
+		}
+	}
+
+	changeRequest := model.ChangeRequest{
+		ID:       uuid.New(),
+		OrgID:    orgID,
+		FlagID:   flagID,
+		Action:   req.Action,
+		Status:   model.ChangeRequestStatusPending,
+		Comment:  req.Comment,
+		AuthorID: authorID,
+	}
+	if req.Action == model.ChangeRequestActionUpdate && req.Flag != nil {
+		flag := req.Flag.WithDefaults()
+		changeRequest.Flag = &flag
+	}
+	if req.ScheduledAt != nil {
+		scheduledAt := req.ScheduledAt.UTC()
+		changeRequest.ScheduledAt = &scheduledAt
+	}
+
+	if err := s.store.CreateChangeRequest(ctx, changeRequest); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create change request: %w", err)
+	}
+
+	return changeRequest.ID, nil
+}
+
+func (s *Service) ListChangeRequests(
+	ctx context.Context,
+	orgID uuid.UUID,
+	status model.ChangeRequestStatus,
+) ([]model.ChangeRequest, error) {
+	changeRequests, err := s.store.ListChangeRequests(ctx, orgID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list change requests: %w", err)
+	}
+	access, err := s.flagAccess(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if access.unrestricted {
+		return changeRequests, nil
+	}
+
+	flags, err := s.store.ListFlags(ctx, orgID, model.FlagFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flags: %w", err)
+	}
+	flagsByID := make(map[uuid.UUID]model.FeatureFlag, len(flags))
+	for _, flag := range flags {
+		flagsByID[flag.ID] = flag
+	}
+	return slices.DeleteFunc(changeRequests, func(changeRequest model.ChangeRequest) bool {
+		flag, ok := flagsByID[changeRequest.FlagID]
+		if !ok {
+			flag = deletedFlag(changeRequest.FlagID)
+		}
+		return !access.allows(flag, model.PermissionRead)
+	}), nil
+}
+
+// GetChangeRequest returns the change request, requests for flags the caller may not read are
+// reported as not found.
+func (s *Service) GetChangeRequest(ctx context.Context, orgID, id uuid.UUID) (model.ChangeRequest, error) {
+	changeRequest, err := s.store.GetChangeRequestByID(ctx, orgID, id)
+	if err != nil {
+		if errors.Is(err, model.ErrChangeRequestNotFound) {
+			return model.ChangeRequest{}, model.ErrChangeRequestNotFound
+		}
+		return model.ChangeRequest{}, fmt.Errorf("failed to fetch change request: %w", err)
+	}
+
+	access, err := s.flagAccess(ctx, orgID)
+	if err != nil {
+		return model.ChangeRequest{}, err
+	}
+	if access.unrestricted {
+		return changeRequest, nil
+	}
+	flag, err := s.fetchFlag(ctx, orgID, changeRequest.FlagID)
+	if errors.Is(err, model.ErrNotFound) {
+		flag, err = deletedFlag(changeRequest.FlagID), nil
+	}
+	if err != nil {
+		return model.ChangeRequest{}, err
+	}
+	if !access.allows(flag, model.PermissionRead) {
+		return model.ChangeRequest{}, model.ErrChangeRequestNotFound
+	}
+	return changeRequest, nil
+}
+
+// deletedFlag stands in for a flag deleted since the change request, the rules on its ID still
+// cover it.
+func deletedFlag(id uuid.UUID) model.FeatureFlag {
+	return model.FeatureFlag{ID: id}
+}
+
+func (s *Service) ApproveChangeRequest(ctx context.Context, orgID, id uuid.UUID, reviewerID uuid.UUID, comment string) error {
+	return s.reviewChangeRequest(ctx, orgID, id, model.ChangeRequestStatusApproved, reviewerID, comment)
+}
+
+func (s *Service) RejectChangeRequest(ctx context.Context, orgID, id uuid.UUID, reviewerID uuid.UUID, comment string) error {
+	return s.reviewChangeRequest(ctx, orgID, id, model.ChangeRequestStatusRejected, reviewerID, comment)
+}
+
+func (s *Service) reviewChangeRequest(
+	ctx context.Context,
+	orgID uuid.UUID,
+	id uuid.UUID,
+	status model.ChangeRequestStatus,
+	reviewerID uuid.UUID,
+	comment string,
+) error {
+	changeRequest, err := s.GetChangeRequest(ctx, orgID, id)
+	if err != nil {
+		return err
+	}
+	if changeRequest.AuthorID == reviewerID {
+		return model.ErrSelfReview
+	}
+	if changeRequest.Status != model.ChangeRequestStatusPending {
+		return model.ErrChangeRequestState
+	}
+	if _, err := s.writableFlag(ctx, orgID, changeRequest.FlagID); err != nil {
+		return err
+	}
+
+	if err := s.store.ReviewChangeRequest(ctx, orgID, id, status, reviewerID, comment); err != nil {
+		if errors.Is(err, model.ErrChangeRequestState) {
+			return model.ErrChangeRequestState
+		}
+		return fmt.Errorf("failed to review change request: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) CancelChangeRequest(ctx context.Context, orgID, id uuid.UUID, userID uuid.UUID) error {
+	changeRequest, err := s.GetChangeRequest(ctx, orgID, id)
+	if err != nil {
+		return err
+	}
+	if changeRequest.AuthorID != userID {
+		return model.ErrNotChangeRequestAuthor
+	}
+
+	if err := s.store.CancelChangeRequest(ctx, orgID, id, userID); err != nil {
+		if errors.Is(err, model.ErrChangeRequestState) {
+			return model.ErrChangeRequestState
+		}
+		return fmt.Errorf("failed to cancel change request: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) ApplyChangeRequest(ctx context.Context, orgID, id uuid.UUID, userID uuid.UUID) error {
+	changeRequest, err := s.GetChangeRequest(ctx, orgID, id)
+	if err != nil {
+		return err
+	}
+	if changeRequest.Status != model.ChangeRequestStatusApproved {
+		return model.ErrChangeRequestState
+	}
+	if changeRequest.ScheduledAt != nil && changeRequest.ScheduledAt.After(time.Now().UTC()) {
+		return model.ErrChangeRequestScheduled
+	}
+	if _, err := s.writableFlag(ctx, orgID, changeRequest.FlagID); err != nil {
+		return err
+	}
+
+	return s.applyChangeRequest(ctx, changeRequest, &userID)
+}
+
+// ApplyDueChangeRequests applies every approved change request whose scheduled time has passed
+// and returns how many of them were applied. Requests of organizations with an active kill switch
+// are left for later.
+func (s *Service) ApplyDueChangeRequests(ctx context.Context) (int, error) {
+	changeRequests, err := s.store.ListDueChangeRequests(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due change requests: %w", err)
+	}
+
+	var (
+		applied int
+		errs    []error
+		frozen  = map[uuid.UUID]bool{}
+	)
+	for _, changeRequest := range changeRequests {
+		isFrozen, checked := frozen[changeRequest.OrgID]
+		if !checked {
+			isFrozen, err = s.killSwitchActive(ctx, changeRequest.OrgID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			frozen[changeRequest.OrgID] = isFrozen
+		}
+		if isFrozen {
+			continue
+		}
+
+		if err := s.applyChangeRequest(ctx, changeRequest, nil); err != nil {
+			errs = append(errs, fmt.Errorf("change request %s: %w", changeRequest.ID, err))
+			continue
+		}
+		applied++
+	}
+
+	return applied, errors.Join(errs...)
+}
+
+// StartChangeRequestScheduler periodically applies due change requests until the context is
+// canceled. It runs as the system principal.
+func (s *Service) StartChangeRequestScheduler(ctx context.Context, interval time.Duration) {
+	ctx = model.ContextWithPrincipal(ctx, model.SystemPrincipal)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.ApplyDueChangeRequests(ctx); err != nil {
+				s.logger.ErrorContext(ctx, "failed to apply scheduled change requests", "error", err)
+			}
+		}
+	}
+}
+
+// applyChangeRequest rejects requests for flags that became managed after the request was created.
+func (s *Service) applyChangeRequest(ctx context.Context, changeRequest model.ChangeRequest, appliedBy *uuid.UUID) error {
+	flag, err := s.fetchFlag(ctx, changeRequest.OrgID, changeRequest.FlagID)
+	if err != nil {
+		return err
+	}
+	if flag.ManagedBy != "" {
+		return model.ErrFlagManaged
+	}
+
+	if err := s.store.ApplyChangeRequest(ctx, changeRequest, appliedBy); err != nil {
+		switch {
+		case errors.Is(err, model.ErrChangeRequestState):
+			return model.ErrChangeRequestState
+		case errors.Is(err, model.ErrNotFound):
+			return model.ErrNotFound
+		}
+		return fmt.Errorf("failed to apply change request: %w", err)
+	}
+
+	s.publishAppliedChangeRequest(ctx, changeRequest)
+	return nil
+}
@@ -0,0 +1,393 @@
+package service_test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service/servicefakes"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Change Requests", func() {
+	var (
+		ctx       context.Context
+		errAction error
+		svc       *service.Service
+		store     *servicefakes.FakeStore
+
+		authorID        uuid.UUID
+		reviewerID      uuid.UUID
+		changeRequestID uuid.UUID
+		changeRequest   model.ChangeRequest
+	)
+
+	BeforeEach(func() {
+		ctx = adminContext()
+		store = &servicefakes.FakeStore{}
+		svc = service.NewService(store, slog.New(slog.DiscardHandler))
+
+		authorID = uuid.New()
+		reviewerID = uuid.New()
+		changeRequestID = uuid.New()
+		changeRequest = model.ChangeRequest{
+			ID:       changeRequestID,
+			OrgID:    orgID,
+			FlagID:   uuid.New(),
+			Action:   model.ChangeRequestActionUpdate,
+			Flag:     &model.FeatureFlagRequest{Key: "flag", Description: "description", Enabled: true},
+			Status:   model.ChangeRequestStatusPending,
+			AuthorID: authorID,
+		}
+		store.GetChangeRequestByIDReturns(changeRequest, nil)
+		store.GetActiveKillSwitchReturns(model.KillSwitch{}, model.ErrKillSwitchNotActive)
+	})
+
+	ItSucceeds := func() {
+		It("succeeds", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+		})
+	}
+
+	Describe("CreateChangeRequest", func() {
+		var (
+			flagID      uuid.UUID
+			req         model.ChangeRequestRequest
+			scheduledAt time.Time
+		)
+
+		BeforeEach(func() {
+			flagID = uuid.New()
+			scheduledAt = time.Now().Add(time.Hour)
+			req = model.ChangeRequestRequest{
+				Action:      model.ChangeRequestActionUpdate,
+				Flag:        &model.FeatureFlagRequest{Key: "flag", Description: "description", Enabled: false},
+				Comment:     "turn it off",
+				ScheduledAt: &scheduledAt,
+			}
+			store.GetFlagByIDReturns(model.FeatureFlag{ID: flagID}, nil)
+		})
+
+		JustBeforeEach(func() {
+			changeRequestID, errAction = svc.CreateChangeRequest(ctx, orgID, flagID, authorID, req)
+		})
+
+		ItSucceeds()
+		It("stores a pending change request recorded against the author", func() {
+			Expect(store.CreateChangeRequestCallCount()).To(Equal(1))
+			_, actual := store.CreateChangeRequestArgsForCall(0)
+			Expect(actual).To(MatchFields(IgnoreExtras, Fields{
+				"ID":          Equal(changeRequestID),
+				"FlagID":      Equal(flagID),
+				"Action":      Equal(model.ChangeRequestActionUpdate),
+				"Flag":        PointTo(Equal(req.Flag.WithDefaults())),
+				"Status":      Equal(model.ChangeRequestStatusPending),
+				"Comment":     Equal(req.Comment),
+				"AuthorID":    Equal(authorID),
+				"ScheduledAt": PointTo(BeTemporally("==", scheduledAt)),
+			}))
+		})
+
+		Context("when the change request deletes the flag", func() {
+			BeforeEach(func() {
+				req.Action = model.ChangeRequestActionDelete
+			})
+
+			It("does not store a flag payload", func() {
+				_, actual := store.CreateChangeRequestArgsForCall(0)
+				Expect(actual.Flag).To(BeNil())
+			})
+		})
+
+		Context("when the flag does not exist", func() {
+			BeforeEach(func() {
+				store.GetFlagByIDReturns(model.FeatureFlag{}, model.ErrNotFound)
+			})
+
+			It("returns the not found error", func() {
+				Expect(errAction).To(MatchError(model.ErrNotFound))
+				Expect(store.CreateChangeRequestCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the flag is managed by another tool", func() {
+			BeforeEach(func() {
+				store.GetFlagByIDReturns(model.FeatureFlag{ID: flagID, ManagedBy: model.ManagedByGit}, nil)
+				ctx = model.ContextWithPrincipal(ctx, model.Principal{Unrestricted: true, Sync: true})
+			})
+
+			It("returns the managed error even for the sync", func() {
+				Expect(errAction).To(MatchError(model.ErrFlagManaged))
+				Expect(store.CreateChangeRequestCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the change request marks the flag as managed", func() {
+			BeforeEach(func() {
+				req.Flag.ManagedBy = model.ManagedByGit
+			})
+
+			It("returns the managed error", func() {
+				Expect(errAction).To(MatchError(model.ErrFlagManaged))
+				Expect(store.CreateChangeRequestCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the store returns an error", func() {
+			BeforeEach(func() {
+				store.CreateChangeRequestReturns(ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+
+	Describe("ApproveChangeRequest", func() {
+		JustBeforeEach(func() {
+			errAction = svc.ApproveChangeRequest(ctx, orgID, changeRequestID, reviewerID, "looks good")
+		})
+
+		ItSucceeds()
+		It("records the approval against the reviewer", func() {
+			Expect(store.ReviewChangeRequestCallCount()).To(Equal(1))
+			_, actualOrgID, actualID, actualStatus, actualReviewerID, actualComment := store.ReviewChangeRequestArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(changeRequestID))
+			Expect(actualStatus).To(Equal(model.ChangeRequestStatusApproved))
+			Expect(actualReviewerID).To(Equal(reviewerID))
+			Expect(actualComment).To(Equal("looks good"))
+		})
+
+		Context("when the reviewer is the author", func() {
+			BeforeEach(func() {
+				reviewerID = authorID
+			})
+
+			It("returns the self review error", func() {
+				Expect(errAction).To(MatchError(model.ErrSelfReview))
+				Expect(store.ReviewChangeRequestCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the change request is no longer pending", func() {
+			BeforeEach(func() {
+				changeRequest.Status = model.ChangeRequestStatusRejected
+				store.GetChangeRequestByIDReturns(changeRequest, nil)
+			})
+
+			It("returns the state error", func() {
+				Expect(errAction).To(MatchError(model.ErrChangeRequestState))
+			})
+		})
+
+		Context("when the change request does not exist", func() {
+			BeforeEach(func() {
+				store.GetChangeRequestByIDReturns(model.ChangeRequest{}, model.ErrChangeRequestNotFound)
+			})
+
+			It("returns the not found error", func() {
+				Expect(errAction).To(MatchError(model.ErrChangeRequestNotFound))
+			})
+		})
+	})
+
+	Describe("RejectChangeRequest", func() {
+		JustBeforeEach(func() {
+			errAction = svc.RejectChangeRequest(ctx, orgID, changeRequestID, reviewerID, "not now")
+		})
+
+		ItSucceeds()
+		It("records the rejection against the reviewer", func() {
+			_, actualOrgID, _, actualStatus, actualReviewerID, _ := store.ReviewChangeRequestArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualStatus).To(Equal(model.ChangeRequestStatusRejected))
+			Expect(actualReviewerID).To(Equal(reviewerID))
+		})
+	})
+
+	Describe("CancelChangeRequest", func() {
+		var userID uuid.UUID
+
+		BeforeEach(func() {
+			userID = authorID
+		})
+
+		JustBeforeEach(func() {
+			errAction = svc.CancelChangeRequest(ctx, orgID, changeRequestID, userID)
+		})
+
+		ItSucceeds()
+		It("cancels the change request", func() {
+			Expect(store.CancelChangeRequestCallCount()).To(Equal(1))
+			_, actualOrgID, actualID, actualUserID := store.CancelChangeRequestArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(changeRequestID))
+			Expect(actualUserID).To(Equal(authorID))
+		})
+
+		Context("when the user is not the author", func() {
+			BeforeEach(func() {
+				userID = reviewerID
+			})
+
+			It("returns the not author error", func() {
+				Expect(errAction).To(MatchError(model.ErrNotChangeRequestAuthor))
+				Expect(store.CancelChangeRequestCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("ApplyChangeRequest", func() {
+		BeforeEach(func() {
+			changeRequest.Status = model.ChangeRequestStatusApproved
+			store.GetChangeRequestByIDReturns(changeRequest, nil)
+		})
+
+		JustBeforeEach(func() {
+			errAction = svc.ApplyChangeRequest(ctx, orgID, changeRequestID, authorID)
+		})
+
+		ItSucceeds()
+		It("applies the change request on behalf of the user", func() {
+			Expect(store.ApplyChangeRequestCallCount()).To(Equal(1))
+			_, actual, actualAppliedBy := store.ApplyChangeRequestArgsForCall(0)
+			Expect(actual.ID).To(Equal(changeRequestID))
+			Expect(actualAppliedBy).To(PointTo(Equal(authorID)))
+		})
+
+		Context("when the change request is not approved", func() {
+			BeforeEach(func() {
+				changeRequest.Status = model.ChangeRequestStatusPending
+				store.GetChangeRequestByIDReturns(changeRequest, nil)
+			})
+
+			It("returns the state error", func() {
+				Expect(errAction).To(MatchError(model.ErrChangeRequestState))
+				Expect(store.ApplyChangeRequestCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the change request is scheduled in the future", func() {
+			BeforeEach(func() {
+				scheduledAt := time.Now().UTC().Add(time.Hour)
+				changeRequest.ScheduledAt = &scheduledAt
+				store.GetChangeRequestByIDReturns(changeRequest, nil)
+			})
+
+			It("returns the scheduled error", func() {
+				Expect(errAction).To(MatchError(model.ErrChangeRequestScheduled))
+			})
+		})
+
+		Context("when the flag no longer exists", func() {
+			BeforeEach(func() {
+				store.ApplyChangeRequestReturns(model.ErrNotFound)
+			})
+
+			It("returns the not found error", func() {
+				Expect(errAction).To(MatchError(model.ErrNotFound))
+			})
+		})
+	})
+
+	Describe("ApplyDueChangeRequests", func() {
+		var applied int
+
+		BeforeEach(func() {
+			changeRequest.Status = model.ChangeRequestStatusApproved
+			store.ListDueChangeRequestsReturns([]model.ChangeRequest{changeRequest, changeRequest}, nil)
+		})
+
+		JustBeforeEach(func() {
+			applied, errAction = svc.ApplyDueChangeRequests(ctx)
+		})
+
+		ItSucceeds()
+		It("applies every due change request without a user", func() {
+			Expect(applied).To(Equal(2))
+			Expect(store.ApplyChangeRequestCallCount()).To(Equal(2))
+			_, _, actualAppliedBy := store.ApplyChangeRequestArgsForCall(0)
+			Expect(actualAppliedBy).To(BeNil())
+		})
+
+		Context("when a flag became managed after the change request was created", func() {
+			BeforeEach(func() {
+				store.GetFlagByIDReturnsOnCall(0, model.FeatureFlag{ID: changeRequest.FlagID, ManagedBy: model.ManagedByGit}, nil)
+			})
+
+			It("skips it and returns the managed error", func() {
+				Expect(applied).To(Equal(1))
+				Expect(store.ApplyChangeRequestCallCount()).To(Equal(1))
+				Expect(errAction).To(MatchError(model.ErrFlagManaged))
+			})
+		})
+
+		Context("when one of the change requests fails", func() {
+			BeforeEach(func() {
+				store.ApplyChangeRequestReturnsOnCall(0, ErrDatabaseError)
+			})
+
+			It("applies the rest and returns the error", func() {
+				Expect(applied).To(Equal(1))
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+
+		Context("when a kill switch is active", func() {
+			BeforeEach(func() {
+				store.GetActiveKillSwitchReturns(model.KillSwitch{ID: uuid.New()}, nil)
+			})
+
+			ItSucceeds()
+			It("does not apply anything", func() {
+				Expect(applied).To(BeZero())
+				Expect(store.ApplyChangeRequestCallCount()).To(BeZero())
+			})
+
+			It("checks the kill switch once per organization", func() {
+				Expect(store.GetActiveKillSwitchCallCount()).To(Equal(1))
+				_, actualOrgID := store.GetActiveKillSwitchArgsForCall(0)
+				Expect(actualOrgID).To(Equal(orgID))
+			})
+		})
+
+		Context("when a kill switch is active in another organization", func() {
+			BeforeEach(func() {
+				otherOrgID := uuid.New()
+				otherRequest := changeRequest
+				otherRequest.OrgID = otherOrgID
+				store.ListDueChangeRequestsReturns([]model.ChangeRequest{changeRequest, otherRequest}, nil)
+				store.GetActiveKillSwitchStub = func(_ context.Context, killSwitchOrgID uuid.UUID) (model.KillSwitch, error) {
+					if killSwitchOrgID == otherOrgID {
+						return model.KillSwitch{ID: uuid.New(), OrgID: otherOrgID}, nil
+					}
+					return model.KillSwitch{}, model.ErrKillSwitchNotActive
+				}
+			})
+
+			ItSucceeds()
+			It("applies only the change requests of unfrozen organizations", func() {
+				Expect(applied).To(Equal(1))
+				_, actualRequest, _ := store.ApplyChangeRequestArgsForCall(0)
+				Expect(actualRequest.OrgID).To(Equal(orgID))
+			})
+		})
+
+		Context("when listing the due change requests fails", func() {
+			BeforeEach(func() {
+				store.ListDueChangeRequestsReturns(nil, ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+})
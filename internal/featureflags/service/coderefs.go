@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+// UploadCodeReferences stores the result of a code references scan for a repository and
+// returns how many distinct references were kept.
+func (s *Service) UploadCodeReferences(
+	ctx context.Context,
+	orgID uuid.UUID,
+	userID uuid.UUID,
+	upload model.CodeReferencesUpload,
+) (int, error) {
+	seen := map[model.CodeReference]bool{}
+	references := make([]model.CodeReference, 0, len(upload.References))
+	for _, entry := range upload.References {
+		reference := model.CodeReference{
+			Repository: upload.Repository,
+			Key:        entry.Key,
+			Path:       entry.Path,
+			Line:       entry.Line,
+		}
+		if seen[reference] {
+			continue
+		}
+		seen[reference] = true
+		references = append(references, reference)
+	}
+
+	if err := s.store.ReplaceCodeReferences(ctx, orgID, upload.Repository, references, userID); err != nil {
+		return 0, fmt.Errorf("failed to store code references: %w", err)
+	}
+
+	return len(references), nil
+}
+
+func (s *Service) ListCodeReferences(ctx context.Context, orgID uuid.UUID, key string) ([]model.CodeReference, error) {
+	references, err := s.store.ListCodeReferences(ctx, orgID, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list code references: %w", err)
+	}
+	return references, nil
+}
+
+// codeReferenceCounts returns the number of code references per flag key. Before any repository
+// of the organization has been scanned nothing is known, so it returns nil and no flag counts as unreferenced.
+func (s *Service) codeReferenceCounts(ctx context.Context, orgID uuid.UUID) (map[string]int, error) {
+	scanned, err := s.store.HasCodeReferenceScans(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check code reference scans: %w", err)
+	}
+	if !scanned {
+		return nil, nil
+	}
+
+	counts, err := s.store.CountCodeReferences(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count code references: %w", err)
+	}
+	return counts, nil
+}
@@ -0,0 +1,107 @@
+package service_test
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service/servicefakes"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Code References", func() {
+	var (
+		ctx       context.Context
+		errAction error
+		svc       *service.Service
+		store     *servicefakes.FakeStore
+		userID    uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctx = adminContext()
+		store = &servicefakes.FakeStore{}
+		svc = service.NewService(store, slog.New(slog.DiscardHandler))
+		userID = uuid.New()
+	})
+
+	Describe("UploadCodeReferences", func() {
+		var (
+			upload model.CodeReferencesUpload
+			stored int
+		)
+
+		BeforeEach(func() {
+			upload = model.CodeReferencesUpload{
+				Repository: "github.com/acme/shop",
+				References: []model.CodeReferenceEntry{
+					{Key: "new-checkout", Path: "main.go", Line: 4},
+					{Key: "new-checkout", Path: "main.go", Line: 4},
+					{Key: "dark-mode", Path: "web/app.tsx", Line: 1},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			stored, errAction = svc.UploadCodeReferences(ctx, orgID, userID, upload)
+		})
+
+		It("replaces the repository references without duplicates", func() {
+			Expect(errAction).NotTo(HaveOccurred())
+			Expect(stored).To(Equal(2))
+
+			Expect(store.ReplaceCodeReferencesCallCount()).To(Equal(1))
+			_, actualOrgID, actualRepository, actualReferences, actualUserID := store.ReplaceCodeReferencesArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualRepository).To(Equal(upload.Repository))
+			Expect(actualUserID).To(Equal(userID))
+			Expect(actualReferences).To(Equal([]model.CodeReference{
+				{Repository: "github.com/acme/shop", Key: "new-checkout", Path: "main.go", Line: 4},
+				{Repository: "github.com/acme/shop", Key: "dark-mode", Path: "web/app.tsx", Line: 1},
+			}))
+		})
+
+		Context("when the store returns an error", func() {
+			BeforeEach(func() {
+				store.ReplaceCodeReferencesReturns(ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+
+	Describe("ListCodeReferences", func() {
+		var references []model.CodeReference
+
+		BeforeEach(func() {
+			store.ListCodeReferencesReturns([]model.CodeReference{{Key: "new-checkout", Path: "main.go", Line: 4}}, nil)
+		})
+
+		JustBeforeEach(func() {
+			references, errAction = svc.ListCodeReferences(ctx, orgID, "new-checkout")
+		})
+
+		It("returns the references of the key", func() {
+			Expect(errAction).NotTo(HaveOccurred())
+			Expect(references).To(HaveLen(1))
+			_, actualOrgID, actualKey := store.ListCodeReferencesArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualKey).To(Equal("new-checkout"))
+		})
+
+		Context("when the store returns an error", func() {
+			BeforeEach(func() {
+				store.ListCodeReferencesReturns(nil, ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+})
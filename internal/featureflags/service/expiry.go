@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+//counterfeiter:generate . Notifier
+type Notifier interface {
+	// NotifyExpiry delivers the reminder to the owners of the flag.
+	NotifyExpiry(ctx context.Context, reminder model.ExpiryReminder) error
+}
+
+// ExpiryReminders returns a reminder for every flag of every organization that has already expired
+// or expires within the given window. Permanent flags never expire.
+func (s *Service) ExpiryReminders(ctx context.Context, window time.Duration) ([]model.ExpiryReminder, error) {
+	now := time.Now().UTC()
+	flags, err := s.store.ListExpiringFlags(ctx, now.Add(window))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expiring flags: %w", err)
+	}
+
+	reminders := make([]model.ExpiryReminder, 0, len(flags))
+	for _, flag := range flags {
+		if flag.Permanent || flag.ExpiresAt == nil {
+			continue
+		}
+
+		status := model.ExpiryStatusExpiringSoon
+		if !flag.ExpiresAt.After(now) {
+			status = model.ExpiryStatusExpired
+		}
+		reminders = append(reminders, model.ExpiryReminder{
+			OrgID:       flag.OrgID,
+			FlagID:      flag.ID,
+			Key:         flag.Key,
+			Owner:       flag.Owner,
+			OwnerType:   flag.OwnerType,
+			Maintainers: flag.Maintainers,
+			ExpiresAt:   *flag.ExpiresAt,
+			Status:      status,
+		})
+	}
+
+	return reminders, nil
+}
+
+// ListOverdueFlags returns the expired flags of the organization grouped by owner, soonest expired
+// first. An empty owner lists every owner, with unowned flags grouped under an empty owner name.
+func (s *Service) ListOverdueFlags(ctx context.Context, orgID uuid.UUID, owner string) ([]model.OwnerOverdueFlags, error) {
+	flags, err := s.store.ListFlags(ctx, orgID, model.FlagFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overdue flags: %w", err)
+	}
+	access, err := s.flagAccess(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	flags = access.readable(flags)
+	now := time.Now().UTC()
+	flags = slices.DeleteFunc(flags, func(flag model.FeatureFlag) bool {
+		return flag.Permanent || flag.ExpiresAt == nil || flag.ExpiresAt.After(now) ||
+			(owner != "" && flag.Owner != owner)
+	})
+	slices.SortStableFunc(flags, func(a, b model.FeatureFlag) int {
+		return a.ExpiresAt.Compare(*b.ExpiresAt)
+	})
+
+	byOwner := map[string]*model.OwnerOverdueFlags{}
+	for _, flag := range flags {
+		group, ok := byOwner[flag.Owner]
+		if !ok {
+			group = &model.OwnerOverdueFlags{Owner: flag.Owner, OwnerType: flag.OwnerType}
+			byOwner[flag.Owner] = group
+		}
+		group.Flags = append(group.Flags, flag)
+	}
+
+	overdue := make([]model.OwnerOverdueFlags, 0, len(byOwner))
+	for _, group := range byOwner {
+		overdue = append(overdue, *group)
+	}
+	sort.Slice(overdue, func(i, j int) bool {
+		return overdue[i].Owner < overdue[j].Owner
+	})
+
+	return overdue, nil
+}
+
+// StartExpiryReminderJob sends a reminder through the notifier for every expired or soon to expire
+// flag right away and then on every interval until the context is canceled. It runs as the system
+// principal.
+func (s *Service) StartExpiryReminderJob(ctx context.Context, interval, window time.Duration, notifier Notifier) {
+	ctx = model.ContextWithPrincipal(ctx, model.SystemPrincipal)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.remindExpiringFlags(ctx, window, notifier)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// remindExpiringFlags keeps going when a reminder cannot be delivered, so that one failing
+// delivery does not hold back the others.
+func (s *Service) remindExpiringFlags(ctx context.Context, window time.Duration, notifier Notifier) {
+	reminders, err := s.ExpiryReminders(ctx, window)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to collect flag expiry reminders", "error", err)
+		return
+	}
+
+	for _, reminder := range reminders {
+		if err := notifier.NotifyExpiry(ctx, reminder); err != nil {
+			s.logger.ErrorContext(ctx, "failed to send flag expiry reminder", "flag_id", reminder.FlagID, "error", err)
+		}
+	}
+}
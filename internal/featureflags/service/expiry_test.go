@@ -0,0 +1,206 @@
+package service_test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service/servicefakes"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Flag Expiry", func() {
+	var (
+		ctx       context.Context
+		errAction error
+		svc       *service.Service
+		store     *servicefakes.FakeStore
+
+		expiredAt    time.Time
+		expiringAt   time.Time
+		expiredFlag  model.FeatureFlag
+		expiringFlag model.FeatureFlag
+		unownedFlag  model.FeatureFlag
+	)
+
+	BeforeEach(func() {
+		ctx = adminContext()
+		store = &servicefakes.FakeStore{}
+		svc = service.NewService(store, slog.New(slog.DiscardHandler))
+
+		expiredAt = time.Now().UTC().Add(-time.Hour)
+		expiringAt = time.Now().UTC().Add(48 * time.Hour)
+		expiredFlag = model.FeatureFlag{
+			ID:          uuid.New(),
+			OrgID:       uuid.New(),
+			Key:         "expired-flag",
+			Owner:       "payments",
+			OwnerType:   model.OwnerTypeTeam,
+			Maintainers: []string{"john"},
+			ExpiresAt:   &expiredAt,
+		}
+		expiringFlag = model.FeatureFlag{ID: uuid.New(), Key: "expiring-flag", Owner: "payments", ExpiresAt: &expiringAt}
+		unownedFlag = model.FeatureFlag{ID: uuid.New(), Key: "unowned-flag", ExpiresAt: &expiredAt}
+	})
+
+	Describe("ExpiryReminders", func() {
+		var reminders []model.ExpiryReminder
+
+		BeforeEach(func() {
+			store.ListExpiringFlagsReturns([]model.FeatureFlag{expiredFlag, expiringFlag}, nil)
+		})
+
+		JustBeforeEach(func() {
+			reminders, errAction = svc.ExpiryReminders(ctx, 7*24*time.Hour)
+		})
+
+		It("looks up flags expiring within the window", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			_, actualBefore := store.ListExpiringFlagsArgsForCall(0)
+			Expect(actualBefore).To(BeTemporally("~", time.Now().UTC().Add(7*24*time.Hour), time.Second))
+		})
+
+		It("classifies expired and soon to expire flags", func() {
+			Expect(reminders).To(HaveExactElements(
+				MatchFields(IgnoreExtras, Fields{
+					"OrgID":       Equal(expiredFlag.OrgID),
+					"FlagID":      Equal(expiredFlag.ID),
+					"Owner":       Equal("payments"),
+					"OwnerType":   Equal(model.OwnerTypeTeam),
+					"Maintainers": Equal([]string{"john"}),
+					"ExpiresAt":   Equal(expiredAt),
+					"Status":      Equal(model.ExpiryStatusExpired),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"FlagID": Equal(expiringFlag.ID),
+					"Status": Equal(model.ExpiryStatusExpiringSoon),
+				}),
+			))
+		})
+
+		Context("when a permanent flag is returned", func() {
+			BeforeEach(func() {
+				expiredFlag.Permanent = true
+				store.ListExpiringFlagsReturns([]model.FeatureFlag{expiredFlag}, nil)
+			})
+
+			It("does not remind about it", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				Expect(reminders).To(BeEmpty())
+			})
+		})
+
+		Context("when the store returns an error", func() {
+			BeforeEach(func() {
+				store.ListExpiringFlagsReturns(nil, ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+
+	Describe("StartExpiryReminderJob", func() {
+		var notifier *servicefakes.FakeNotifier
+
+		BeforeEach(func() {
+			notifier = &servicefakes.FakeNotifier{}
+			store.ListExpiringFlagsReturns([]model.FeatureFlag{expiredFlag, expiringFlag}, nil)
+		})
+
+		JustBeforeEach(func() {
+			// A canceled context makes the job send the first round of reminders and return.
+			canceledCtx, cancel := context.WithCancel(ctx)
+			cancel()
+			svc.StartExpiryReminderJob(canceledCtx, time.Hour, 7*24*time.Hour, notifier)
+		})
+
+		It("sends a reminder for every flag through the notifier", func() {
+			Expect(notifier.NotifyExpiryCallCount()).To(Equal(2))
+			_, first := notifier.NotifyExpiryArgsForCall(0)
+			Expect(first.FlagID).To(Equal(expiredFlag.ID))
+			_, second := notifier.NotifyExpiryArgsForCall(1)
+			Expect(second.FlagID).To(Equal(expiringFlag.ID))
+		})
+
+		Context("when a reminder cannot be delivered", func() {
+			BeforeEach(func() {
+				notifier.NotifyExpiryReturnsOnCall(0, ErrDatabaseError)
+			})
+
+			It("still sends the other reminders", func() {
+				Expect(notifier.NotifyExpiryCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the flags cannot be listed", func() {
+			BeforeEach(func() {
+				store.ListExpiringFlagsReturns(nil, ErrDatabaseError)
+			})
+
+			It("sends no reminders", func() {
+				Expect(notifier.NotifyExpiryCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("ListOverdueFlags", func() {
+		var (
+			owner   string
+			overdue []model.OwnerOverdueFlags
+		)
+
+		BeforeEach(func() {
+			owner = ""
+			store.ListFlagsReturns([]model.FeatureFlag{expiredFlag, expiringFlag, unownedFlag}, nil)
+		})
+
+		JustBeforeEach(func() {
+			overdue, errAction = svc.ListOverdueFlags(ctx, orgID, owner)
+		})
+
+		It("groups the expired flags by owner", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			_, actualOrgID, _ := store.ListFlagsArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+
+			Expect(overdue).To(HaveExactElements(
+				MatchFields(IgnoreExtras, Fields{
+					"Owner": BeEmpty(),
+					"Flags": HaveExactElements(MatchFields(IgnoreExtras, Fields{"ID": Equal(unownedFlag.ID)})),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Owner":     Equal("payments"),
+					"OwnerType": Equal(model.OwnerTypeTeam),
+					"Flags":     HaveExactElements(MatchFields(IgnoreExtras, Fields{"ID": Equal(expiredFlag.ID)})),
+				}),
+			))
+		})
+
+		Context("when an owner is given", func() {
+			BeforeEach(func() {
+				owner = "payments"
+			})
+
+			It("returns only that owner's flags", func() {
+				Expect(overdue).To(HaveExactElements(MatchFields(IgnoreExtras, Fields{"Owner": Equal("payments")})))
+			})
+		})
+
+		Context("when the store returns an error", func() {
+			BeforeEach(func() {
+				store.ListFlagsReturns(nil, ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+})
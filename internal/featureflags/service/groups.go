@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+func (s *Service) ListGroups(ctx context.Context, orgID uuid.UUID) ([]model.Group, error) {
+	groups, err := s.store.ListGroups(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+	return groups, nil
+}
+
+func (s *Service) GetGroup(ctx context.Context, orgID, id uuid.UUID) (model.Group, error) {
+	group, err := s.store.GetGroupByID(ctx, orgID, id)
+	if err != nil {
+		if errors.Is(err, model.ErrGroupNotFound) {
+			return model.Group{}, model.ErrGroupNotFound
+		}
+		return model.Group{}, fmt.Errorf("failed to fetch group: %w", err)
+	}
+	return group, nil
+}
+
+func (s *Service) CreateGroup(ctx context.Context, orgID uuid.UUID, req model.GroupRequest) (model.Group, error) {
+	group := model.Group{
+		ID:          uuid.New(),
+		OrgID:       orgID,
+		Name:        req.Name,
+		Description: req.Description,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err := s.store.CreateGroup(ctx, group); err != nil {
+		if errors.Is(err, model.ErrGroupExists) {
+			return model.Group{}, model.ErrGroupExists
+		}
+		return model.Group{}, fmt.Errorf("failed to create group: %w", err)
+	}
+	return group, nil
+}
+
+func (s *Service) UpdateGroup(ctx context.Context, orgID, id uuid.UUID, req model.GroupRequest) (model.Group, error) {
+	group, err := s.GetGroup(ctx, orgID, id)
+	if err != nil {
+		return model.Group{}, err
+	}
+	group.Name = req.Name
+	group.Description = req.Description
+
+	if err := s.store.UpdateGroup(ctx, group); err != nil {
+		switch {
+		case errors.Is(err, model.ErrGroupNotFound):
+			return model.Group{}, model.ErrGroupNotFound
+		case errors.Is(err, model.ErrGroupExists):
+			return model.Group{}, model.ErrGroupExists
+		}
+		return model.Group{}, fmt.Errorf("failed to update group: %w", err)
+	}
+	return group, nil
+}
+
+// DeleteGroup removes the group together with its memberships and access rules.
+func (s *Service) DeleteGroup(ctx context.Context, orgID, id uuid.UUID) error {
+	if err := s.store.DeleteGroup(ctx, orgID, id); err != nil {
+		if errors.Is(err, model.ErrGroupNotFound) {
+			return model.ErrGroupNotFound
+		}
+		return fmt.Errorf("failed to delete group: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) ListGroupMembers(ctx context.Context, orgID, groupID uuid.UUID) ([]model.GroupMember, error) {
+	if _, err := s.GetGroup(ctx, orgID, groupID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.ListGroupMembers(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list group members: %w", err)
+	}
+	return members, nil
+}
+
+func (s *Service) AddGroupMember(ctx context.Context, orgID, groupID, userID uuid.UUID) error {
+	if _, err := s.GetGroup(ctx, orgID, groupID); err != nil {
+		return err
+	}
+
+	if err := s.store.AddGroupMember(ctx, groupID, userID); err != nil {
+		if errors.Is(err, model.ErrGroupNotFound) {
+			return model.ErrGroupNotFound
+		}
+		return fmt.Errorf("failed to add group member: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) RemoveGroupMember(ctx context.Context, orgID, groupID, userID uuid.UUID) error {
+	if _, err := s.GetGroup(ctx, orgID, groupID); err != nil {
+		return err
+	}
+
+	if err := s.store.RemoveGroupMember(ctx, groupID, userID); err != nil {
+		if errors.Is(err, model.ErrGroupMemberNotFound) {
+			return model.ErrGroupMemberNotFound
+		}
+		return fmt.Errorf("failed to remove group member: %w", err)
+	}
+	return nil
+}
+
+// ListUserGroupIDs returns the groups of the organization the user is a member of.
+func (s *Service) ListUserGroupIDs(ctx context.Context, orgID, userID uuid.UUID) ([]uuid.UUID, error) {
+	groupIDs, err := s.store.ListUserGroupIDs(ctx, orgID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user groups: %w", err)
+	}
+	return groupIDs, nil
+}
@@ -0,0 +1,179 @@
+package service_test
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service/servicefakes"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Groups", func() {
+	var (
+		ctx       context.Context
+		errAction error
+		svc       *service.Service
+		store     *servicefakes.FakeStore
+		group     model.Group
+		userID    uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctx = adminContext()
+		store = &servicefakes.FakeStore{}
+		svc = service.NewService(store, slog.New(slog.DiscardHandler))
+
+		userID = uuid.New()
+		group = model.Group{ID: uuid.New(), OrgID: orgID, Name: "payments"}
+		store.GetGroupByIDReturns(group, nil)
+	})
+
+	Describe("CreateGroup", func() {
+		var created model.Group
+
+		JustBeforeEach(func() {
+			created, errAction = svc.CreateGroup(ctx, orgID, model.GroupRequest{Name: "payments", Description: "Payments team"})
+		})
+
+		It("stores the group in the organization", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			Expect(store.CreateGroupCallCount()).To(Equal(1))
+			_, stored := store.CreateGroupArgsForCall(0)
+			Expect(stored).To(Equal(created))
+			Expect(created).To(MatchFields(IgnoreExtras, Fields{
+				"ID":          Not(Equal(uuid.Nil)),
+				"OrgID":       Equal(orgID),
+				"Name":        Equal("payments"),
+				"Description": Equal("Payments team"),
+				"CreatedAt":   Not(BeZero()),
+			}))
+		})
+
+		Context("when the name is taken", func() {
+			BeforeEach(func() {
+				store.CreateGroupReturns(model.ErrGroupExists)
+			})
+
+			It("returns ErrGroupExists", func() {
+				Expect(errAction).To(Equal(model.ErrGroupExists))
+			})
+		})
+	})
+
+	Describe("AddGroupMember", func() {
+		JustBeforeEach(func() {
+			errAction = svc.AddGroupMember(ctx, orgID, group.ID, userID)
+		})
+
+		It("adds the member", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			_, actualGroupID, actualUserID := store.AddGroupMemberArgsForCall(0)
+			Expect(actualGroupID).To(Equal(group.ID))
+			Expect(actualUserID).To(Equal(userID))
+		})
+
+		Context("when the group belongs to another organization", func() {
+			BeforeEach(func() {
+				store.GetGroupByIDReturns(model.Group{}, model.ErrGroupNotFound)
+			})
+
+			It("returns ErrGroupNotFound", func() {
+				Expect(errAction).To(Equal(model.ErrGroupNotFound))
+				Expect(store.AddGroupMemberCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("RemoveGroupMember", func() {
+		BeforeEach(func() {
+			store.RemoveGroupMemberReturns(model.ErrGroupMemberNotFound)
+		})
+
+		It("returns ErrGroupMemberNotFound for users outside the group", func() {
+			err := svc.RemoveGroupMember(ctx, orgID, group.ID, userID)
+			Expect(err).To(Equal(model.ErrGroupMemberNotFound))
+		})
+	})
+
+	Describe("CreateAccessRule", func() {
+		var (
+			req  model.AccessRuleRequest
+			rule model.AccessRule
+		)
+
+		BeforeEach(func() {
+			flagID := uuid.New()
+			req = model.AccessRuleRequest{GroupID: group.ID, FlagID: &flagID, Permission: model.PermissionWrite}
+		})
+
+		JustBeforeEach(func() {
+			rule, errAction = svc.CreateAccessRule(ctx, orgID, req)
+		})
+
+		It("stores the rule", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+			_, stored := store.CreateAccessRuleArgsForCall(0)
+			Expect(stored).To(Equal(rule))
+			Expect(rule).To(MatchFields(IgnoreExtras, Fields{
+				"OrgID":      Equal(orgID),
+				"GroupID":    Equal(group.ID),
+				"FlagID":     Equal(req.FlagID),
+				"Permission": Equal(model.PermissionWrite),
+			}))
+
+			_, actualOrgID, actualFlagID := store.GetFlagByIDArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualFlagID).To(Equal(*req.FlagID))
+		})
+
+		Context("when the flag does not exist", func() {
+			BeforeEach(func() {
+				store.GetFlagByIDReturns(model.FeatureFlag{}, model.ErrNotFound)
+			})
+
+			It("returns ErrNotFound", func() {
+				Expect(errAction).To(Equal(model.ErrNotFound))
+				Expect(store.CreateAccessRuleCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the group does not exist", func() {
+			BeforeEach(func() {
+				store.GetGroupByIDReturns(model.Group{}, model.ErrGroupNotFound)
+			})
+
+			It("returns ErrGroupNotFound", func() {
+				Expect(errAction).To(Equal(model.ErrGroupNotFound))
+				Expect(store.CreateAccessRuleCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the rule is for a tag", func() {
+			BeforeEach(func() {
+				req.FlagID = nil
+				req.Tag = "payments"
+			})
+
+			It("does not look up a flag", func() {
+				Expect(errAction).ToNot(HaveOccurred())
+				Expect(store.GetFlagByIDCallCount()).To(BeZero())
+				Expect(rule.Tag).To(Equal("payments"))
+			})
+		})
+	})
+
+	Describe("DeleteAccessRule", func() {
+		BeforeEach(func() {
+			store.DeleteAccessRuleReturns(model.ErrAccessRuleNotFound)
+		})
+
+		It("returns ErrAccessRuleNotFound for unknown rules", func() {
+			Expect(svc.DeleteAccessRule(ctx, orgID, uuid.New())).To(Equal(model.ErrAccessRuleNotFound))
+		})
+	})
+})
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+func (s *Service) GetKillSwitchStatus(ctx context.Context, orgID uuid.UUID) (model.KillSwitchStatus, error) {
+	killSwitch, err := s.store.GetActiveKillSwitch(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, model.ErrKillSwitchNotActive) {
+			return model.KillSwitchStatus{Active: false}, nil
+		}
+		return model.KillSwitchStatus{}, fmt.Errorf("failed to fetch kill switch: %w", err)
+	}
+	return model.KillSwitchStatus{Active: true, KillSwitch: &killSwitch}, nil
+}
+
+// ActivateKillSwitch forces the flags covered by the kill switch off and freezes the writes of the
+// organization until it is released.
+func (s *Service) ActivateKillSwitch(
+	ctx context.Context,
+	orgID, userID uuid.UUID,
+	req model.KillSwitchRequest,
+) (model.KillSwitch, error) {
+	killSwitch := model.KillSwitch{
+		ID:          uuid.New(),
+		OrgID:       orgID,
+		Reason:      req.Reason,
+		Tag:         req.Tag,
+		ActivatedBy: userID,
+		ActivatedAt: time.Now().UTC(),
+	}
+
+	if err := s.store.CreateKillSwitch(ctx, killSwitch); err != nil {
+		if errors.Is(err, model.ErrKillSwitchActive) {
+			return model.KillSwitch{}, model.ErrKillSwitchActive
+		}
+		return model.KillSwitch{}, fmt.Errorf("failed to activate kill switch: %w", err)
+	}
+
+	s.changes.publish(model.FlagChange{
+		Type:             model.FlagChangeKillSwitch,
+		OrgID:            orgID,
+		KillSwitchActive: true,
+		KillSwitchTag:    killSwitch.Tag,
+	})
+	return killSwitch, nil
+}
+
+func (s *Service) ReleaseKillSwitch(ctx context.Context, orgID, userID uuid.UUID) error {
+	if err := s.store.ReleaseKillSwitch(ctx, orgID, userID); err != nil {
+		if errors.Is(err, model.ErrKillSwitchNotActive) {
+			return model.ErrKillSwitchNotActive
+		}
+		return fmt.Errorf("failed to release kill switch: %w", err)
+	}
+
+	s.changes.publish(model.FlagChange{Type: model.FlagChangeKillSwitch, OrgID: orgID})
+	return nil
+}
+
+func (s *Service) EvaluateFlag(ctx context.Context, orgID uuid.UUID, key string) (model.FlagEvaluation, error) {
+	flag, err := s.store.GetFlagByKey(ctx, orgID, key)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.FlagEvaluation{}, model.ErrNotFound
+		}
+		return model.FlagEvaluation{}, fmt.Errorf("failed to fetch flag: %w", err)
+	}
+	access, err := s.flagAccess(ctx, orgID)
+	if err != nil {
+		return model.FlagEvaluation{}, err
+	}
+	if !access.allows(flag, model.PermissionRead) {
+		return model.FlagEvaluation{}, model.ErrNotFound
+	}
+	s.recordEvaluation(ctx, flag)
+
+	killSwitch, err := s.GetKillSwitchStatus(ctx, orgID)
+	if err != nil {
+		return model.FlagEvaluation{}, err
+	}
+	if killSwitch.Active && killSwitch.KillSwitch.Covers(flag) {
+		return model.FlagEvaluation{Key: flag.Key, Value: false, Reason: model.EvaluationReasonKillSwitch}, nil
+	}
+
+	return model.FlagEvaluation{Key: flag.Key, Value: flag.Enabled, Reason: model.EvaluationReasonStatic}, nil
+}
+
+// recordEvaluation stores the evaluation time for the unused flags report. A failure is only logged,
+// the evaluation itself does not depend on it.
+func (s *Service) recordEvaluation(ctx context.Context, flag model.FeatureFlag) {
+	now := time.Now().UTC()
+	if flag.LastEvaluatedAt != nil && now.Sub(*flag.LastEvaluatedAt) < model.EvaluationRecordInterval {
+		return
+	}
+	if err := s.store.RecordFlagEvaluation(ctx, flag.OrgID, flag.ID, now); err != nil {
+		s.logger.ErrorContext(ctx, "failed to record the flag evaluation", "flag_id", flag.ID, "error", err)
+	}
+}
+
+func (s *Service) killSwitchActive(ctx context.Context, orgID uuid.UUID) (bool, error) {
+	status, err := s.GetKillSwitchStatus(ctx, orgID)
+	if err != nil {
+		return false, err
+	}
+	return status.Active, nil
+}
@@ -0,0 +1,267 @@
+package service_test
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service/servicefakes"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Kill Switch", func() {
+	var (
+		ctx        context.Context
+		errAction  error
+		svc        *service.Service
+		store      *servicefakes.FakeStore
+		userID     uuid.UUID
+		killSwitch model.KillSwitch
+	)
+
+	BeforeEach(func() {
+		ctx = adminContext()
+		store = &servicefakes.FakeStore{}
+		svc = service.NewService(store, slog.New(slog.DiscardHandler))
+
+		userID = uuid.New()
+		killSwitch = model.KillSwitch{ID: uuid.New(), Reason: "incident", ActivatedBy: userID}
+		store.GetActiveKillSwitchReturns(model.KillSwitch{}, model.ErrKillSwitchNotActive)
+	})
+
+	ItSucceeds := func() {
+		It("succeeds", func() {
+			Expect(errAction).ToNot(HaveOccurred())
+		})
+	}
+
+	Describe("GetKillSwitchStatus", func() {
+		var status model.KillSwitchStatus
+
+		JustBeforeEach(func() {
+			status, errAction = svc.GetKillSwitchStatus(ctx, orgID)
+		})
+
+		ItSucceeds()
+		It("reports an inactive kill switch", func() {
+			Expect(status.Active).To(BeFalse())
+			Expect(status.KillSwitch).To(BeNil())
+		})
+
+		Context("when a kill switch is active", func() {
+			BeforeEach(func() {
+				store.GetActiveKillSwitchReturns(killSwitch, nil)
+			})
+
+			ItSucceeds()
+			It("returns the active kill switch", func() {
+				Expect(status.Active).To(BeTrue())
+				Expect(status.KillSwitch).To(PointTo(Equal(killSwitch)))
+			})
+		})
+
+		Context("when the store returns an error", func() {
+			BeforeEach(func() {
+				store.GetActiveKillSwitchReturns(model.KillSwitch{}, ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+
+	Describe("ActivateKillSwitch", func() {
+		var (
+			activated model.KillSwitch
+			req       model.KillSwitchRequest
+		)
+
+		BeforeEach(func() {
+			req = model.KillSwitchRequest{Reason: "incident", Tag: "payments"}
+		})
+
+		JustBeforeEach(func() {
+			activated, errAction = svc.ActivateKillSwitch(ctx, orgID, userID, req)
+		})
+
+		ItSucceeds()
+		It("records who activated the kill switch and why", func() {
+			Expect(store.CreateKillSwitchCallCount()).To(Equal(1))
+			_, actualKillSwitch := store.CreateKillSwitchArgsForCall(0)
+			Expect(actualKillSwitch).To(MatchFields(IgnoreExtras, Fields{
+				"ID":          Not(Equal(uuid.Nil)),
+				"Reason":      Equal("incident"),
+				"Tag":         Equal("payments"),
+				"ActivatedBy": Equal(userID),
+				"ActivatedAt": Not(BeZero()),
+			}))
+			Expect(activated).To(Equal(actualKillSwitch))
+		})
+
+		Context("when a kill switch is already active", func() {
+			BeforeEach(func() {
+				store.CreateKillSwitchReturns(model.ErrKillSwitchActive)
+			})
+
+			It("returns an already active error", func() {
+				Expect(errAction).To(MatchError(model.ErrKillSwitchActive))
+			})
+		})
+
+		Context("when the store returns an error", func() {
+			BeforeEach(func() {
+				store.CreateKillSwitchReturns(ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+
+	Describe("ReleaseKillSwitch", func() {
+		JustBeforeEach(func() {
+			errAction = svc.ReleaseKillSwitch(ctx, orgID, userID)
+		})
+
+		ItSucceeds()
+		It("releases the kill switch on behalf of the user", func() {
+			Expect(store.ReleaseKillSwitchCallCount()).To(Equal(1))
+			_, actualOrgID, actualUserID := store.ReleaseKillSwitchArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualUserID).To(Equal(userID))
+		})
+
+		Context("when no kill switch is active", func() {
+			BeforeEach(func() {
+				store.ReleaseKillSwitchReturns(model.ErrKillSwitchNotActive)
+			})
+
+			It("returns a not active error", func() {
+				Expect(errAction).To(MatchError(model.ErrKillSwitchNotActive))
+			})
+		})
+	})
+
+	Describe("EvaluateFlag", func() {
+		var (
+			evaluation model.FlagEvaluation
+			flag       model.FeatureFlag
+		)
+
+		BeforeEach(func() {
+			flag = model.FeatureFlag{ID: uuid.New(), OrgID: orgID, Key: "flag", Enabled: true}
+			store.GetFlagByKeyReturns(flag, nil)
+		})
+
+		JustBeforeEach(func() {
+			evaluation, errAction = svc.EvaluateFlag(ctx, orgID, "flag")
+		})
+
+		ItSucceeds()
+		It("serves the stored value", func() {
+			Expect(store.GetFlagByKeyCallCount()).To(Equal(1))
+			_, actualOrgID, actualKey := store.GetFlagByKeyArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualKey).To(Equal("flag"))
+			Expect(evaluation).To(Equal(model.FlagEvaluation{Key: "flag", Value: true, Reason: model.EvaluationReasonStatic}))
+		})
+
+		It("records the evaluation", func() {
+			Expect(store.RecordFlagEvaluationCallCount()).To(Equal(1))
+			_, actualOrgID, actualID, evaluatedAt := store.RecordFlagEvaluationArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualID).To(Equal(flag.ID))
+			Expect(evaluatedAt).To(BeTemporally("~", time.Now(), time.Second))
+		})
+
+		Context("when the flag was evaluated recently", func() {
+			BeforeEach(func() {
+				evaluatedAt := time.Now().Add(-time.Minute)
+				flag.LastEvaluatedAt = &evaluatedAt
+				store.GetFlagByKeyReturns(flag, nil)
+			})
+
+			ItSucceeds()
+			It("does not record the evaluation again", func() {
+				Expect(store.RecordFlagEvaluationCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the evaluation cannot be recorded", func() {
+			BeforeEach(func() {
+				store.RecordFlagEvaluationReturns(ErrDatabaseError)
+			})
+
+			ItSucceeds()
+			It("still serves the stored value", func() {
+				Expect(evaluation.Value).To(BeTrue())
+			})
+		})
+
+		Context("when a kill switch is active", func() {
+			BeforeEach(func() {
+				store.GetActiveKillSwitchReturns(killSwitch, nil)
+			})
+
+			ItSucceeds()
+			It("serves false with the kill switch reason", func() {
+				Expect(evaluation).To(Equal(model.FlagEvaluation{Key: "flag", Value: false, Reason: model.EvaluationReasonKillSwitch}))
+			})
+
+			Context("and limited to a tag of the flag", func() {
+				BeforeEach(func() {
+					flag.Tags = []string{"web", "payments"}
+					store.GetFlagByKeyReturns(flag, nil)
+					killSwitch.Tag = "payments"
+					store.GetActiveKillSwitchReturns(killSwitch, nil)
+				})
+
+				ItSucceeds()
+				It("serves false with the kill switch reason", func() {
+					Expect(evaluation.Reason).To(Equal(model.EvaluationReasonKillSwitch))
+					Expect(evaluation.Value).To(BeFalse())
+				})
+			})
+
+			Context("and limited to a tag the flag does not have", func() {
+				BeforeEach(func() {
+					killSwitch.Tag = "payments"
+					store.GetActiveKillSwitchReturns(killSwitch, nil)
+				})
+
+				ItSucceeds()
+				It("serves the stored value", func() {
+					Expect(evaluation).To(Equal(model.FlagEvaluation{Key: "flag", Value: true, Reason: model.EvaluationReasonStatic}))
+				})
+			})
+		})
+
+		Context("when the flag does not exist", func() {
+			BeforeEach(func() {
+				store.GetFlagByKeyReturns(model.FeatureFlag{}, model.ErrNotFound)
+			})
+
+			It("returns a not found error", func() {
+				Expect(errAction).To(MatchError(model.ErrNotFound))
+				Expect(store.RecordFlagEvaluationCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the kill switch lookup fails", func() {
+			BeforeEach(func() {
+				store.GetActiveKillSwitchReturns(model.KillSwitch{}, ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
+})
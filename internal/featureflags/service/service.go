@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
 	"github.com/google/uuid"
 )
 
 type Service struct {
-	store Store
+	store   Store
+	changes *changeBroker
+	logger  *slog.Logger
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -18,27 +22,96 @@ type Service struct {
 //go:generate gowrap gen -g -p ./ -i Store -t ../../observability/templates/otel_metric.tmpl -o ./wrapped/metric/store.go
 //counterfeiter:generate . Store
 type Store interface {
-	ListFlags(ctx context.Context) ([]model.FeatureFlag, error)
-	GetFlagByID(ctx context.Context, id uuid.UUID) (model.FeatureFlag, error)
+	ListFlags(ctx context.Context, orgID uuid.UUID, filter model.FlagFilter) ([]model.FeatureFlag, error)
+	GetFlagByID(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (model.FeatureFlag, error)
+	GetFlagByKey(ctx context.Context, orgID uuid.UUID, key string) (model.FeatureFlag, error)
+	ListExpiringFlags(ctx context.Context, before time.Time) ([]model.FeatureFlag, error)
 	CreateFlag(ctx context.Context, flag model.FeatureFlag) error
 	UpdateFlag(ctx context.Context, flag model.FeatureFlag) error
-	DeleteFlag(ctx context.Context, id uuid.UUID) error
+	DeleteFlag(ctx context.Context, orgID uuid.UUID, id uuid.UUID) error
+	RecordFlagEvaluation(ctx context.Context, orgID uuid.UUID, id uuid.UUID, evaluatedAt time.Time) error
+
+	CreateChangeRequest(ctx context.Context, changeRequest model.ChangeRequest) error
+	ListChangeRequests(ctx context.Context, orgID uuid.UUID, status model.ChangeRequestStatus) ([]model.ChangeRequest, error)
+	ListDueChangeRequests(ctx context.Context, now time.Time) ([]model.ChangeRequest, error)
+	GetChangeRequestByID(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (model.ChangeRequest, error)
+	ReviewChangeRequest(
+		ctx context.Context,
+		orgID uuid.UUID,
+		id uuid.UUID,
+		status model.ChangeRequestStatus,
+		reviewerID uuid.UUID,
+		comment string,
+	) error
+	CancelChangeRequest(ctx context.Context, orgID uuid.UUID, id uuid.UUID, userID uuid.UUID) error
+	ApplyChangeRequest(ctx context.Context, changeRequest model.ChangeRequest, appliedBy *uuid.UUID) error
+
+	ReplaceCodeReferences(
+		ctx context.Context,
+		orgID uuid.UUID,
+		repository string,
+		references []model.CodeReference,
+		scannedBy uuid.UUID,
+	) error
+	ListCodeReferences(ctx context.Context, orgID uuid.UUID, key string) ([]model.CodeReference, error)
+	CountCodeReferences(ctx context.Context, orgID uuid.UUID) (map[string]int, error)
+	HasCodeReferenceScans(ctx context.Context, orgID uuid.UUID) (bool, error)
+
+	GetActiveKillSwitch(ctx context.Context, orgID uuid.UUID) (model.KillSwitch, error)
+	CreateKillSwitch(ctx context.Context, killSwitch model.KillSwitch) error
+	ReleaseKillSwitch(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) error
+
+	ListGroups(ctx context.Context, orgID uuid.UUID) ([]model.Group, error)
+	GetGroupByID(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (model.Group, error)
+	CreateGroup(ctx context.Context, group model.Group) error
+	UpdateGroup(ctx context.Context, group model.Group) error
+	DeleteGroup(ctx context.Context, orgID uuid.UUID, id uuid.UUID) error
+	ListGroupMembers(ctx context.Context, groupID uuid.UUID) ([]model.GroupMember, error)
+	AddGroupMember(ctx context.Context, groupID uuid.UUID, userID uuid.UUID) error
+	RemoveGroupMember(ctx context.Context, groupID uuid.UUID, userID uuid.UUID) error
+	ListUserGroupIDs(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) ([]uuid.UUID, error)
+
+	ListAccessRules(ctx context.Context, orgID uuid.UUID) ([]model.AccessRule, error)
+	CreateAccessRule(ctx context.Context, rule model.AccessRule) error
+	DeleteAccessRule(ctx context.Context, orgID uuid.UUID, id uuid.UUID) error
 }
 
-func NewService(store Store) *Service {
-	return &Service{store: store}
+// NewService creates the service, logger receives the errors of the background jobs and of the
+// work that does not fail the request, such as recording evaluations.
+func NewService(store Store, logger *slog.Logger) *Service {
+	return &Service{store: store, changes: newChangeBroker(), logger: logger}
 }
 
-func (s *Service) ListFlags(ctx context.Context) ([]model.FeatureFlag, error) {
-	flags, err := s.store.ListFlags(ctx)
+func (s *Service) ListFlags(ctx context.Context, orgID uuid.UUID, filter model.FlagFilter) ([]model.FeatureFlag, error) {
+	flags, err := s.store.ListFlags(ctx, orgID, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list flags: %w", err)
 	}
-	return flags, nil
+	access, err := s.flagAccess(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return access.readable(flags), nil
+}
+
+// GetFlagByID returns the flag, flags the caller may not read are reported as not found.
+func (s *Service) GetFlagByID(ctx context.Context, orgID, id uuid.UUID) (model.FeatureFlag, error) {
+	flag, err := s.fetchFlag(ctx, orgID, id)
+	if err != nil {
+		return model.FeatureFlag{}, err
+	}
+	access, err := s.flagAccess(ctx, orgID)
+	if err != nil {
+		return model.FeatureFlag{}, err
+	}
+	if !access.allows(flag, model.PermissionRead) {
+		return model.FeatureFlag{}, model.ErrNotFound
+	}
+	return flag, nil
 }
 
-func (s *Service) GetFlagByID(ctx context.Context, id uuid.UUID) (model.FeatureFlag, error) {
-	flag, err := s.store.GetFlagByID(ctx, id)
+func (s *Service) fetchFlag(ctx context.Context, orgID, id uuid.UUID) (model.FeatureFlag, error) {
+	flag, err := s.store.GetFlagByID(ctx, orgID, id)
 	if err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return model.FeatureFlag{}, model.ErrNotFound
@@ -48,27 +121,40 @@ func (s *Service) GetFlagByID(ctx context.Context, id uuid.UUID) (model.FeatureF
 	return flag, nil
 }
 
-func (s *Service) CreateFlag(ctx context.Context, req model.FeatureFlagRequest) (uuid.UUID, error) {
-	newFlag := model.FeatureFlag{
-		ID:          uuid.New(),
-		Key:         req.Key,
-		Description: req.Description,
-		Enabled:     req.Enabled,
+func (s *Service) CreateFlag(ctx context.Context, orgID uuid.UUID, req model.FeatureFlagRequest) (uuid.UUID, error) {
+	newFlag := flagFromRequest(orgID, uuid.New(), req)
+	if err := s.ensureWriteAccess(ctx, orgID, newFlag); err != nil {
+		return uuid.Nil, err
+	}
+	if err := ensureNotManaged(ctx, newFlag); err != nil {
+		return uuid.Nil, err
 	}
 
 	if err := s.store.CreateFlag(ctx, newFlag); err != nil {
 		return uuid.Nil, fmt.Errorf("failed to create flag: %w", err)
 	}
 
+	newFlag.CreatedAt = time.Now().UTC()
+	newFlag.UpdatedAt = newFlag.CreatedAt
+	s.changes.publish(model.FlagChange{Type: model.FlagChangeCreated, OrgID: orgID, Flag: newFlag})
+
 	return newFlag.ID, nil
 }
 
-func (s *Service) UpdateFlag(ctx context.Context, id uuid.UUID, req model.FeatureFlagRequest) error {
-	flagToUpdate := model.FeatureFlag{
-		ID:          id,
-		Key:         req.Key,
-		Description: req.Description,
-		Enabled:     req.Enabled,
+func (s *Service) UpdateFlag(ctx context.Context, orgID, id uuid.UUID, req model.FeatureFlagRequest) error {
+	current, err := s.ensureNotProtected(ctx, orgID, id)
+	if err != nil {
+		return err
+	}
+
+	flagToUpdate := flagFromRequest(orgID, id, req)
+	// The updated flag is checked as well, so a flag cannot be moved out of a restricted tag or be
+	// marked as managed.
+	if err := s.ensureWriteAccess(ctx, orgID, flagToUpdate); err != nil {
+		return err
+	}
+	if err := ensureNotManaged(ctx, flagToUpdate); err != nil {
+		return err
 	}
 
 	if err := s.store.UpdateFlag(ctx, flagToUpdate); err != nil {
@@ -77,15 +163,141 @@ func (s *Service) UpdateFlag(ctx context.Context, id uuid.UUID, req model.Featur
 		}
 		return fmt.Errorf("failed to update flag: %w", err)
 	}
+
+	flagToUpdate.CreatedAt = current.CreatedAt
+	flagToUpdate.UpdatedAt = time.Now().UTC()
+	s.changes.publish(model.FlagChange{Type: model.FlagChangeUpdated, OrgID: orgID, Flag: flagToUpdate})
 	return nil
 }
 
-func (s *Service) DeleteFlag(ctx context.Context, id uuid.UUID) error {
-	if err := s.store.DeleteFlag(ctx, id); err != nil {
+func (s *Service) DeleteFlag(ctx context.Context, orgID, id uuid.UUID) error {
+	current, err := s.ensureNotProtected(ctx, orgID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.store.DeleteFlag(ctx, orgID, id); err != nil {
 		if errors.Is(err, model.ErrNotFound) {
 			return model.ErrNotFound
 		}
 		return fmt.Errorf("failed to delete flag: %w", err)
 	}
+
+	s.changes.publish(model.FlagChange{Type: model.FlagChangeDeleted, OrgID: orgID, Flag: current})
 	return nil
 }
+
+func flagFromRequest(orgID, id uuid.UUID, req model.FeatureFlagRequest) model.FeatureFlag {
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.UTC()
+		req.ExpiresAt = &expiresAt
+	}
+	flag := req.Flag(id)
+	flag.OrgID = orgID
+	return flag
+}
+
+// ensureNotProtected returns the current flag if the caller may write it and rejects direct writes
+// to protected flags, which may only be changed through an approved change request, and to managed
+// flags.
+func (s *Service) ensureNotProtected(ctx context.Context, orgID, id uuid.UUID) (model.FeatureFlag, error) {
+	flag, err := s.writableFlag(ctx, orgID, id)
+	if err != nil {
+		return model.FeatureFlag{}, err
+	}
+	if flag.Protected {
+		return model.FeatureFlag{}, model.ErrFlagProtected
+	}
+	if err := ensureNotManaged(ctx, flag); err != nil {
+		return model.FeatureFlag{}, err
+	}
+	return flag, nil
+}
+
+// ensureNotManaged rejects changes to flags managed by another tool, such as the GitOps sync,
+// unless the caller authenticated with the sync scope.
+func ensureNotManaged(ctx context.Context, flag model.FeatureFlag) error {
+	if flag.ManagedBy == "" {
+		return nil
+	}
+	if principal, ok := model.PrincipalFromContext(ctx); ok && principal.Sync {
+		return nil
+	}
+	return model.ErrFlagManaged
+}
+
+func (s *Service) GenerateReport(ctx context.Context, orgID uuid.UUID, staleAfterDays int) (model.FlagReport, error) {
+	if staleAfterDays <= 0 {
+		return model.FlagReport{}, model.ErrInvalidReportRange
+	}
+
+	flags, err := s.ListFlags(ctx, orgID, model.FlagFilter{})
+	if err != nil {
+		return model.FlagReport{}, err
+	}
+	referenceCounts, err := s.codeReferenceCounts(ctx, orgID)
+	if err != nil {
+		return model.FlagReport{}, err
+	}
+
+	now := time.Now().UTC()
+	report := model.FlagReport{
+		GeneratedAt:    now,
+		StaleAfterDays: staleAfterDays,
+		Flags:          []model.FlagReportEntry{},
+	}
+	for _, flag := range flags {
+		daysSinceUpdate := int(now.Sub(flag.UpdatedAt).Hours() / 24)
+		unreferenced := referenceCounts != nil && referenceCounts[flag.Key] == 0
+		categories := classifyFlag(flag, now, daysSinceUpdate, staleAfterDays, unreferenced)
+		if len(categories) == 0 {
+			continue
+		}
+
+		report.Flags = append(report.Flags, model.FlagReportEntry{
+			ID:              flag.ID,
+			Key:             flag.Key,
+			Enabled:         flag.Enabled,
+			Categories:      categories,
+			DaysSinceUpdate: daysSinceUpdate,
+			CreatedAt:       flag.CreatedAt,
+			UpdatedAt:       flag.UpdatedAt,
+			LastEvaluatedAt: flag.LastEvaluatedAt,
+		})
+	}
+
+	return report, nil
+}
+
+func classifyFlag(
+	flag model.FeatureFlag,
+	now time.Time,
+	daysSinceUpdate, staleAfterDays int,
+	unreferenced bool,
+) []model.FlagCategory {
+	// Permanent flags are kept on purpose, so they never show up as cleanup candidates.
+	if flag.Permanent {
+		return []model.FlagCategory{model.FlagCategoryPermanent}
+	}
+
+	var categories []model.FlagCategory
+	if daysSinceUpdate >= staleAfterDays {
+		categories = append(categories, model.FlagCategoryStale)
+		if flag.Enabled {
+			categories = append(categories, model.FlagCategoryFullyRolledOut)
+		}
+	}
+	// Flags that were never evaluated count from their creation, so new flags get the same window.
+	lastUsed := flag.CreatedAt
+	if flag.LastEvaluatedAt != nil {
+		lastUsed = *flag.LastEvaluatedAt
+	}
+	if int(now.Sub(lastUsed).Hours()/24) >= staleAfterDays {
+		categories = append(categories, model.FlagCategoryUnused)
+	}
+	if unreferenced {
+		categories = append(categories, model.FlagCategoryUnreferenced)
+	}
+
+	return categories
+}
@@ -1,13 +1,24 @@
 package service_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/google/uuid"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
 )
 
 func TestService(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Feature Flags Service Suite")
 }
+
+// adminContext returns a context with a flag admin as the caller, who is not subject to the flag
+// access rules.
+func adminContext() context.Context {
+	return model.ContextWithPrincipal(context.Background(), model.Principal{UserID: uuid.New(), Unrestricted: true})
+}
@@ -3,6 +3,8 @@ package service_test
 import (
 	"context"
 	"errors"
+	"log/slog"
+	"time"
 
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
@@ -15,6 +17,8 @@ import (
 
 var (
 	ErrDatabaseError = errors.New("database error")
+
+	orgID = uuid.New()
 )
 
 var _ = Describe("Service", func() {
@@ -28,9 +32,9 @@ var _ = Describe("Service", func() {
 	)
 
 	BeforeEach(func() {
-		ctx = context.Background()
+		ctx = adminContext()
 		store = &servicefakes.FakeStore{}
-		svc = service.NewService(store)
+		svc = service.NewService(store, slog.New(slog.DiscardHandler))
 	})
 
 	ItSucceeds := func() {
@@ -43,18 +47,27 @@ var _ = Describe("Service", func() {
 		var (
 			flags       []model.FeatureFlag
 			featureFlag model.FeatureFlag
+			filter      model.FlagFilter
 		)
 
 		BeforeEach(func() {
 			featureFlag = model.FeatureFlag{ID: uuid.New(), Key: "test-flag", Description: "description", Enabled: true}
+			filter = model.FlagFilter{Owner: "payments", Tags: []string{"checkout"}}
 			store.ListFlagsReturns([]model.FeatureFlag{featureFlag}, nil)
 		})
 
 		JustBeforeEach(func() {
-			flags, errAction = svc.ListFlags(ctx)
+			flags, errAction = svc.ListFlags(ctx, orgID, filter)
 		})
 
 		ItSucceeds()
+		It("passes the filter to the store", func() {
+			Expect(store.ListFlagsCallCount()).To(Equal(1))
+			_, actualOrgID, actualFilter := store.ListFlagsArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
+			Expect(actualFilter).To(Equal(filter))
+		})
+
 		It("returns the list of feature flags", func() {
 			Expect(flags).To(ContainElement(MatchFields(IgnoreExtras, Fields{
 				"ID":          Equal(featureFlag.ID),
@@ -87,7 +100,7 @@ var _ = Describe("Service", func() {
 		})
 
 		JustBeforeEach(func() {
-			featureFlag, errAction = svc.GetFlagByID(ctx, flagID)
+			featureFlag, errAction = svc.GetFlagByID(ctx, orgID, flagID)
 		})
 
 		ItSucceeds()
@@ -132,7 +145,7 @@ var _ = Describe("Service", func() {
 		})
 
 		JustBeforeEach(func() {
-			flagID, errAction = svc.CreateFlag(ctx, featureFlagRequest)
+			flagID, errAction = svc.CreateFlag(ctx, orgID, featureFlagRequest)
 		})
 
 		ItSucceeds()
@@ -148,6 +161,62 @@ var _ = Describe("Service", func() {
 			})))
 		})
 
+		It("defaults the kind and metadata of the feature flag", func() {
+			_, actualFlag := store.CreateFlagArgsForCall(0)
+			Expect(actualFlag).To((MatchFields(IgnoreExtras, Fields{
+				"Kind":        Equal(model.FlagKindRelease),
+				"Maintainers": BeEmpty(),
+				"Tags":        And(BeEmpty(), Not(BeNil())),
+				"IssueURLs":   And(BeEmpty(), Not(BeNil())),
+				"Metadata":    And(BeEmpty(), Not(BeNil())),
+			})))
+		})
+
+		Context("when ownership and metadata are provided", func() {
+			BeforeEach(func() {
+				featureFlagRequest.Owner = "payments"
+				featureFlagRequest.OwnerType = model.OwnerTypeTeam
+				featureFlagRequest.Maintainers = []string{"john"}
+				featureFlagRequest.Tags = []string{"checkout"}
+				featureFlagRequest.Kind = model.FlagKindExperiment
+				featureFlagRequest.IssueURLs = []string{"https://issues.example.com/PAY-1"}
+				featureFlagRequest.Metadata = map[string]string{"cost_center": "42"}
+			})
+
+			ItSucceeds()
+			It("stores them on the feature flag", func() {
+				_, actualFlag := store.CreateFlagArgsForCall(0)
+				Expect(actualFlag).To((MatchFields(IgnoreExtras, Fields{
+					"Owner":       Equal("payments"),
+					"OwnerType":   Equal(model.OwnerTypeTeam),
+					"Maintainers": Equal([]string{"john"}),
+					"Tags":        Equal([]string{"checkout"}),
+					"Kind":        Equal(model.FlagKindExperiment),
+					"IssueURLs":   Equal([]string{"https://issues.example.com/PAY-1"}),
+					"Metadata":    Equal(map[string]string{"cost_center": "42"}),
+				})))
+			})
+		})
+
+		Context("when the flag is marked as managed", func() {
+			BeforeEach(func() {
+				featureFlagRequest.ManagedBy = model.ManagedByGit
+			})
+
+			It("returns the managed error", func() {
+				Expect(errAction).To(MatchError(model.ErrFlagManaged))
+				Expect(store.CreateFlagCallCount()).To(BeZero())
+			})
+
+			Context("and the caller is the sync", func() {
+				BeforeEach(func() {
+					ctx = model.ContextWithPrincipal(ctx, model.Principal{Unrestricted: true, Sync: true})
+				})
+
+				ItSucceeds()
+			})
+		})
+
 		Context("when the store returns an error", func() {
 			BeforeEach(func() {
 				store.CreateFlagReturns(ErrDatabaseError)
@@ -171,7 +240,7 @@ var _ = Describe("Service", func() {
 		})
 
 		JustBeforeEach(func() {
-			errAction = svc.UpdateFlag(ctx, newUUID, featureFlagRequest)
+			errAction = svc.UpdateFlag(ctx, orgID, newUUID, featureFlagRequest)
 		})
 
 		ItSucceeds()
@@ -185,6 +254,48 @@ var _ = Describe("Service", func() {
 			Expect(actualFlag.Enabled).To(BeFalse())
 		})
 
+		Context("when the flag is protected", func() {
+			BeforeEach(func() {
+				store.GetFlagByIDReturns(model.FeatureFlag{ID: newUUID, Protected: true}, nil)
+			})
+
+			It("returns the protected error", func() {
+				Expect(errAction).To(MatchError(model.ErrFlagProtected))
+				Expect(store.UpdateFlagCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the flag is managed by another tool", func() {
+			BeforeEach(func() {
+				store.GetFlagByIDReturns(model.FeatureFlag{ID: newUUID, ManagedBy: model.ManagedByGit}, nil)
+				featureFlagRequest.ManagedBy = model.ManagedByGit
+			})
+
+			It("returns the managed error", func() {
+				Expect(errAction).To(MatchError(model.ErrFlagManaged))
+				Expect(store.UpdateFlagCallCount()).To(BeZero())
+			})
+
+			Context("and the caller is the sync", func() {
+				BeforeEach(func() {
+					ctx = model.ContextWithPrincipal(ctx, model.Principal{Unrestricted: true, Sync: true})
+				})
+
+				ItSucceeds()
+			})
+		})
+
+		Context("when the update marks the flag as managed", func() {
+			BeforeEach(func() {
+				featureFlagRequest.ManagedBy = model.ManagedByGit
+			})
+
+			It("returns the managed error", func() {
+				Expect(errAction).To(MatchError(model.ErrFlagManaged))
+				Expect(store.UpdateFlagCallCount()).To(BeZero())
+			})
+		})
+
 		Context("when the flag does not exist", func() {
 			BeforeEach(func() {
 				store.UpdateFlagReturns(model.ErrNotFound)
@@ -215,17 +326,40 @@ var _ = Describe("Service", func() {
 		})
 
 		JustBeforeEach(func() {
-			errAction = svc.DeleteFlag(ctx, flagID)
+			errAction = svc.DeleteFlag(ctx, orgID, flagID)
 		})
 
 		ItSucceeds()
 		It("deletes the feature flag", func() {
 			Expect(store.DeleteFlagCallCount()).To(Equal(1))
-			actualCtx, actualFlagID := store.DeleteFlagArgsForCall(0)
+			actualCtx, actualOrgID, actualFlagID := store.DeleteFlagArgsForCall(0)
+			Expect(actualOrgID).To(Equal(orgID))
 			Expect(actualCtx).To(Equal(ctx))
 			Expect(actualFlagID).To(Equal(flagID))
 		})
 
+		Context("when the flag is protected", func() {
+			BeforeEach(func() {
+				store.GetFlagByIDReturns(model.FeatureFlag{ID: flagID, Protected: true}, nil)
+			})
+
+			It("returns the protected error", func() {
+				Expect(errAction).To(MatchError(model.ErrFlagProtected))
+				Expect(store.DeleteFlagCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the flag is managed by another tool", func() {
+			BeforeEach(func() {
+				store.GetFlagByIDReturns(model.FeatureFlag{ID: flagID, ManagedBy: model.ManagedByGit}, nil)
+			})
+
+			It("returns the managed error", func() {
+				Expect(errAction).To(MatchError(model.ErrFlagManaged))
+				Expect(store.DeleteFlagCallCount()).To(BeZero())
+			})
+		})
+
 		Context("when the flag does not exist", func() {
 			BeforeEach(func() {
 				store.DeleteFlagReturns(model.ErrNotFound)
@@ -246,4 +380,153 @@ var _ = Describe("Service", func() {
 			})
 		})
 	})
+
+	Describe("GenerateReport", func() {
+		var (
+			report         model.FlagReport
+			staleAfterDays int
+
+			activeFlag    model.FeatureFlag
+			staleFlag     model.FeatureFlag
+			rolledOutFlag model.FeatureFlag
+			permanentFlag model.FeatureFlag
+			unusedFlag    model.FeatureFlag
+			newFlag       model.FeatureFlag
+		)
+
+		BeforeEach(func() {
+			staleAfterDays = 30
+			now := time.Now().UTC()
+			longAgo := now.Add(-60 * 24 * time.Hour)
+			evaluated := func(at time.Time) *time.Time { return &at }
+
+			activeFlag = model.FeatureFlag{ID: uuid.New(), Key: "active-flag", Enabled: true, CreatedAt: longAgo, UpdatedAt: now,
+				LastEvaluatedAt: evaluated(now)}
+			staleFlag = model.FeatureFlag{ID: uuid.New(), Key: "stale-flag", Enabled: false, CreatedAt: longAgo, UpdatedAt: longAgo,
+				LastEvaluatedAt: evaluated(now)}
+			rolledOutFlag = model.FeatureFlag{ID: uuid.New(), Key: "rolled-out-flag", Enabled: true, CreatedAt: longAgo, UpdatedAt: longAgo,
+				LastEvaluatedAt: evaluated(now)}
+			permanentFlag = model.FeatureFlag{ID: uuid.New(), Key: "permanent-flag", Enabled: true, Permanent: true, CreatedAt: longAgo,
+				UpdatedAt: longAgo}
+			unusedFlag = model.FeatureFlag{ID: uuid.New(), Key: "unused-flag", Enabled: true, CreatedAt: longAgo, UpdatedAt: now,
+				LastEvaluatedAt: evaluated(longAgo)}
+			newFlag = model.FeatureFlag{ID: uuid.New(), Key: "new-flag", Enabled: true, CreatedAt: now, UpdatedAt: now}
+			store.ListFlagsReturns([]model.FeatureFlag{activeFlag, staleFlag, rolledOutFlag, permanentFlag, unusedFlag, newFlag}, nil)
+		})
+
+		JustBeforeEach(func() {
+			report, errAction = svc.GenerateReport(ctx, orgID, staleAfterDays)
+		})
+
+		ItSucceeds()
+		It("classifies the flags", func() {
+			Expect(report.StaleAfterDays).To(Equal(staleAfterDays))
+			Expect(report.Flags).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					"Key":             Equal(staleFlag.Key),
+					"Categories":      ConsistOf(model.FlagCategoryStale),
+					"DaysSinceUpdate": Equal(60),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Key":        Equal(rolledOutFlag.Key),
+					"Categories": ConsistOf(model.FlagCategoryStale, model.FlagCategoryFullyRolledOut),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Key":        Equal(permanentFlag.Key),
+					"Categories": ConsistOf(model.FlagCategoryPermanent),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"Key":             Equal(unusedFlag.Key),
+					"Categories":      ConsistOf(model.FlagCategoryUnused),
+					"LastEvaluatedAt": Equal(unusedFlag.LastEvaluatedAt),
+				}),
+			))
+		})
+
+		Context("when an old flag was never evaluated", func() {
+			BeforeEach(func() {
+				newFlag.CreatedAt = newFlag.CreatedAt.Add(-31 * 24 * time.Hour)
+				store.ListFlagsReturns([]model.FeatureFlag{newFlag}, nil)
+			})
+
+			ItSucceeds()
+			It("marks it as unused", func() {
+				Expect(report.Flags).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{
+						"Key":        Equal(newFlag.Key),
+						"Categories": ConsistOf(model.FlagCategoryUnused),
+					}),
+				))
+			})
+		})
+
+		Context("when repositories have been scanned for code references", func() {
+			BeforeEach(func() {
+				store.HasCodeReferenceScansReturns(true, nil)
+				store.CountCodeReferencesReturns(map[string]int{staleFlag.Key: 2}, nil)
+			})
+
+			ItSucceeds()
+			It("marks the non-permanent flags without references as unreferenced", func() {
+				Expect(report.Flags).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{
+						"Key":        Equal(activeFlag.Key),
+						"Categories": ConsistOf(model.FlagCategoryUnreferenced),
+					}),
+					MatchFields(IgnoreExtras, Fields{
+						"Key":        Equal(staleFlag.Key),
+						"Categories": ConsistOf(model.FlagCategoryStale),
+					}),
+					MatchFields(IgnoreExtras, Fields{
+						"Key":        Equal(rolledOutFlag.Key),
+						"Categories": ConsistOf(model.FlagCategoryStale, model.FlagCategoryFullyRolledOut, model.FlagCategoryUnreferenced),
+					}),
+					MatchFields(IgnoreExtras, Fields{
+						"Key":        Equal(permanentFlag.Key),
+						"Categories": ConsistOf(model.FlagCategoryPermanent),
+					}),
+					MatchFields(IgnoreExtras, Fields{
+						"Key":        Equal(unusedFlag.Key),
+						"Categories": ConsistOf(model.FlagCategoryUnused, model.FlagCategoryUnreferenced),
+					}),
+					MatchFields(IgnoreExtras, Fields{
+						"Key":        Equal(newFlag.Key),
+						"Categories": ConsistOf(model.FlagCategoryUnreferenced),
+					}),
+				))
+			})
+		})
+
+		Context("when the code references cannot be counted", func() {
+			BeforeEach(func() {
+				store.HasCodeReferenceScansReturns(true, nil)
+				store.CountCodeReferencesReturns(nil, ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+
+		Context("when the stale period is not positive", func() {
+			BeforeEach(func() {
+				staleAfterDays = 0
+			})
+
+			It("returns an invalid range error", func() {
+				Expect(errAction).To(MatchError(model.ErrInvalidReportRange))
+				Expect(store.ListFlagsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the store returns an error", func() {
+			BeforeEach(func() {
+				store.ListFlagsReturns(nil, ErrDatabaseError)
+			})
+
+			It("returns the error", func() {
+				Expect(errAction).To(MatchError(ErrDatabaseError))
+			})
+		})
+	})
 })
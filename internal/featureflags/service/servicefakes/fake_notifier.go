@@ -0,0 +1,113 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package servicefakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
+)
+
+type FakeNotifier struct {
+	NotifyExpiryStub        func(context.Context, model.ExpiryReminder) error
+	notifyExpiryMutex       sync.RWMutex
+	notifyExpiryArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.ExpiryReminder
+	}
+	notifyExpiryReturns struct {
+		result1 error
+	}
+	notifyExpiryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNotifier) NotifyExpiry(arg1 context.Context, arg2 model.ExpiryReminder) error {
+	fake.notifyExpiryMutex.Lock()
+	ret, specificReturn := fake.notifyExpiryReturnsOnCall[len(fake.notifyExpiryArgsForCall)]
+	fake.notifyExpiryArgsForCall = append(fake.notifyExpiryArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.ExpiryReminder
+	}{arg1, arg2})
+	stub := fake.NotifyExpiryStub
+	fakeReturns := fake.notifyExpiryReturns
+	fake.recordInvocation("NotifyExpiry", []interface{}{arg1, arg2})
+	fake.notifyExpiryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeNotifier) NotifyExpiryCallCount() int {
+	fake.notifyExpiryMutex.RLock()
+	defer fake.notifyExpiryMutex.RUnlock()
+	return len(fake.notifyExpiryArgsForCall)
+}
+
+func (fake *FakeNotifier) NotifyExpiryCalls(stub func(context.Context, model.ExpiryReminder) error) {
+	fake.notifyExpiryMutex.Lock()
+	defer fake.notifyExpiryMutex.Unlock()
+	fake.NotifyExpiryStub = stub
+}
+
+func (fake *FakeNotifier) NotifyExpiryArgsForCall(i int) (context.Context, model.ExpiryReminder) {
+	fake.notifyExpiryMutex.RLock()
+	defer fake.notifyExpiryMutex.RUnlock()
+	argsForCall := fake.notifyExpiryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNotifier) NotifyExpiryReturns(result1 error) {
+	fake.notifyExpiryMutex.Lock()
+	defer fake.notifyExpiryMutex.Unlock()
+	fake.NotifyExpiryStub = nil
+	fake.notifyExpiryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotifier) NotifyExpiryReturnsOnCall(i int, result1 error) {
+	fake.notifyExpiryMutex.Lock()
+	defer fake.notifyExpiryMutex.Unlock()
+	fake.NotifyExpiryStub = nil
+	if fake.notifyExpiryReturnsOnCall == nil {
+		fake.notifyExpiryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.notifyExpiryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotifier) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeNotifier) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.Notifier = new(FakeNotifier)
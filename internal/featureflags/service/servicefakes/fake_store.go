@@ -4,6 +4,7 @@ package servicefakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
@@ -11,6 +12,84 @@ import (
 )
 
 type FakeStore struct {
+	AddGroupMemberStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	addGroupMemberMutex       sync.RWMutex
+	addGroupMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	addGroupMemberReturns struct {
+		result1 error
+	}
+	addGroupMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ApplyChangeRequestStub        func(context.Context, model.ChangeRequest, *uuid.UUID) error
+	applyChangeRequestMutex       sync.RWMutex
+	applyChangeRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.ChangeRequest
+		arg3 *uuid.UUID
+	}
+	applyChangeRequestReturns struct {
+		result1 error
+	}
+	applyChangeRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CancelChangeRequestStub        func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error
+	cancelChangeRequestMutex       sync.RWMutex
+	cancelChangeRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	cancelChangeRequestReturns struct {
+		result1 error
+	}
+	cancelChangeRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CountCodeReferencesStub        func(context.Context, uuid.UUID) (map[string]int, error)
+	countCodeReferencesMutex       sync.RWMutex
+	countCodeReferencesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	countCodeReferencesReturns struct {
+		result1 map[string]int
+		result2 error
+	}
+	countCodeReferencesReturnsOnCall map[int]struct {
+		result1 map[string]int
+		result2 error
+	}
+	CreateAccessRuleStub        func(context.Context, model.AccessRule) error
+	createAccessRuleMutex       sync.RWMutex
+	createAccessRuleArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.AccessRule
+	}
+	createAccessRuleReturns struct {
+		result1 error
+	}
+	createAccessRuleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateChangeRequestStub        func(context.Context, model.ChangeRequest) error
+	createChangeRequestMutex       sync.RWMutex
+	createChangeRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.ChangeRequest
+	}
+	createChangeRequestReturns struct {
+		result1 error
+	}
+	createChangeRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CreateFlagStub        func(context.Context, model.FeatureFlag) error
 	createFlagMutex       sync.RWMutex
 	createFlagArgsForCall []struct {
@@ -23,11 +102,49 @@ type FakeStore struct {
 	createFlagReturnsOnCall map[int]struct {
 		result1 error
 	}
-	DeleteFlagStub        func(context.Context, uuid.UUID) error
+	CreateGroupStub        func(context.Context, model.Group) error
+	createGroupMutex       sync.RWMutex
+	createGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.Group
+	}
+	createGroupReturns struct {
+		result1 error
+	}
+	createGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateKillSwitchStub        func(context.Context, model.KillSwitch) error
+	createKillSwitchMutex       sync.RWMutex
+	createKillSwitchArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.KillSwitch
+	}
+	createKillSwitchReturns struct {
+		result1 error
+	}
+	createKillSwitchReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteAccessRuleStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	deleteAccessRuleMutex       sync.RWMutex
+	deleteAccessRuleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deleteAccessRuleReturns struct {
+		result1 error
+	}
+	deleteAccessRuleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteFlagStub        func(context.Context, uuid.UUID, uuid.UUID) error
 	deleteFlagMutex       sync.RWMutex
 	deleteFlagArgsForCall []struct {
 		arg1 context.Context
 		arg2 uuid.UUID
+		arg3 uuid.UUID
 	}
 	deleteFlagReturns struct {
 		result1 error
@@ -35,62 +152,2151 @@ type FakeStore struct {
 	deleteFlagReturnsOnCall map[int]struct {
 		result1 error
 	}
-	GetFlagByIDStub        func(context.Context, uuid.UUID) (model.FeatureFlag, error)
+	DeleteGroupStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	deleteGroupMutex       sync.RWMutex
+	deleteGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deleteGroupReturns struct {
+		result1 error
+	}
+	deleteGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetActiveKillSwitchStub        func(context.Context, uuid.UUID) (model.KillSwitch, error)
+	getActiveKillSwitchMutex       sync.RWMutex
+	getActiveKillSwitchArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getActiveKillSwitchReturns struct {
+		result1 model.KillSwitch
+		result2 error
+	}
+	getActiveKillSwitchReturnsOnCall map[int]struct {
+		result1 model.KillSwitch
+		result2 error
+	}
+	GetChangeRequestByIDStub        func(context.Context, uuid.UUID, uuid.UUID) (model.ChangeRequest, error)
+	getChangeRequestByIDMutex       sync.RWMutex
+	getChangeRequestByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getChangeRequestByIDReturns struct {
+		result1 model.ChangeRequest
+		result2 error
+	}
+	getChangeRequestByIDReturnsOnCall map[int]struct {
+		result1 model.ChangeRequest
+		result2 error
+	}
+	GetFlagByIDStub        func(context.Context, uuid.UUID, uuid.UUID) (model.FeatureFlag, error)
 	getFlagByIDMutex       sync.RWMutex
 	getFlagByIDArgsForCall []struct {
 		arg1 context.Context
 		arg2 uuid.UUID
+		arg3 uuid.UUID
 	}
 	getFlagByIDReturns struct {
 		result1 model.FeatureFlag
 		result2 error
 	}
-	getFlagByIDReturnsOnCall map[int]struct {
-		result1 model.FeatureFlag
+	getFlagByIDReturnsOnCall map[int]struct {
+		result1 model.FeatureFlag
+		result2 error
+	}
+	GetFlagByKeyStub        func(context.Context, uuid.UUID, string) (model.FeatureFlag, error)
+	getFlagByKeyMutex       sync.RWMutex
+	getFlagByKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	getFlagByKeyReturns struct {
+		result1 model.FeatureFlag
+		result2 error
+	}
+	getFlagByKeyReturnsOnCall map[int]struct {
+		result1 model.FeatureFlag
+		result2 error
+	}
+	GetGroupByIDStub        func(context.Context, uuid.UUID, uuid.UUID) (model.Group, error)
+	getGroupByIDMutex       sync.RWMutex
+	getGroupByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	getGroupByIDReturns struct {
+		result1 model.Group
+		result2 error
+	}
+	getGroupByIDReturnsOnCall map[int]struct {
+		result1 model.Group
+		result2 error
+	}
+	HasCodeReferenceScansStub        func(context.Context, uuid.UUID) (bool, error)
+	hasCodeReferenceScansMutex       sync.RWMutex
+	hasCodeReferenceScansArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	hasCodeReferenceScansReturns struct {
+		result1 bool
+		result2 error
+	}
+	hasCodeReferenceScansReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ListAccessRulesStub        func(context.Context, uuid.UUID) ([]model.AccessRule, error)
+	listAccessRulesMutex       sync.RWMutex
+	listAccessRulesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listAccessRulesReturns struct {
+		result1 []model.AccessRule
+		result2 error
+	}
+	listAccessRulesReturnsOnCall map[int]struct {
+		result1 []model.AccessRule
+		result2 error
+	}
+	ListChangeRequestsStub        func(context.Context, uuid.UUID, model.ChangeRequestStatus) ([]model.ChangeRequest, error)
+	listChangeRequestsMutex       sync.RWMutex
+	listChangeRequestsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.ChangeRequestStatus
+	}
+	listChangeRequestsReturns struct {
+		result1 []model.ChangeRequest
+		result2 error
+	}
+	listChangeRequestsReturnsOnCall map[int]struct {
+		result1 []model.ChangeRequest
+		result2 error
+	}
+	ListCodeReferencesStub        func(context.Context, uuid.UUID, string) ([]model.CodeReference, error)
+	listCodeReferencesMutex       sync.RWMutex
+	listCodeReferencesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	listCodeReferencesReturns struct {
+		result1 []model.CodeReference
+		result2 error
+	}
+	listCodeReferencesReturnsOnCall map[int]struct {
+		result1 []model.CodeReference
+		result2 error
+	}
+	ListDueChangeRequestsStub        func(context.Context, time.Time) ([]model.ChangeRequest, error)
+	listDueChangeRequestsMutex       sync.RWMutex
+	listDueChangeRequestsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	listDueChangeRequestsReturns struct {
+		result1 []model.ChangeRequest
+		result2 error
+	}
+	listDueChangeRequestsReturnsOnCall map[int]struct {
+		result1 []model.ChangeRequest
+		result2 error
+	}
+	ListExpiringFlagsStub        func(context.Context, time.Time) ([]model.FeatureFlag, error)
+	listExpiringFlagsMutex       sync.RWMutex
+	listExpiringFlagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	listExpiringFlagsReturns struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}
+	listExpiringFlagsReturnsOnCall map[int]struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}
+	ListFlagsStub        func(context.Context, uuid.UUID, model.FlagFilter) ([]model.FeatureFlag, error)
+	listFlagsMutex       sync.RWMutex
+	listFlagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.FlagFilter
+	}
+	listFlagsReturns struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}
+	listFlagsReturnsOnCall map[int]struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}
+	ListGroupMembersStub        func(context.Context, uuid.UUID) ([]model.GroupMember, error)
+	listGroupMembersMutex       sync.RWMutex
+	listGroupMembersArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listGroupMembersReturns struct {
+		result1 []model.GroupMember
+		result2 error
+	}
+	listGroupMembersReturnsOnCall map[int]struct {
+		result1 []model.GroupMember
+		result2 error
+	}
+	ListGroupsStub        func(context.Context, uuid.UUID) ([]model.Group, error)
+	listGroupsMutex       sync.RWMutex
+	listGroupsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listGroupsReturns struct {
+		result1 []model.Group
+		result2 error
+	}
+	listGroupsReturnsOnCall map[int]struct {
+		result1 []model.Group
+		result2 error
+	}
+	ListUserGroupIDsStub        func(context.Context, uuid.UUID, uuid.UUID) ([]uuid.UUID, error)
+	listUserGroupIDsMutex       sync.RWMutex
+	listUserGroupIDsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	listUserGroupIDsReturns struct {
+		result1 []uuid.UUID
+		result2 error
+	}
+	listUserGroupIDsReturnsOnCall map[int]struct {
+		result1 []uuid.UUID
+		result2 error
+	}
+	RecordFlagEvaluationStub        func(context.Context, uuid.UUID, uuid.UUID, time.Time) error
+	recordFlagEvaluationMutex       sync.RWMutex
+	recordFlagEvaluationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 time.Time
+	}
+	recordFlagEvaluationReturns struct {
+		result1 error
+	}
+	recordFlagEvaluationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReleaseKillSwitchStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	releaseKillSwitchMutex       sync.RWMutex
+	releaseKillSwitchArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	releaseKillSwitchReturns struct {
+		result1 error
+	}
+	releaseKillSwitchReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveGroupMemberStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	removeGroupMemberMutex       sync.RWMutex
+	removeGroupMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	removeGroupMemberReturns struct {
+		result1 error
+	}
+	removeGroupMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReplaceCodeReferencesStub        func(context.Context, uuid.UUID, string, []model.CodeReference, uuid.UUID) error
+	replaceCodeReferencesMutex       sync.RWMutex
+	replaceCodeReferencesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 []model.CodeReference
+		arg5 uuid.UUID
+	}
+	replaceCodeReferencesReturns struct {
+		result1 error
+	}
+	replaceCodeReferencesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReviewChangeRequestStub        func(context.Context, uuid.UUID, uuid.UUID, model.ChangeRequestStatus, uuid.UUID, string) error
+	reviewChangeRequestMutex       sync.RWMutex
+	reviewChangeRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.ChangeRequestStatus
+		arg5 uuid.UUID
+		arg6 string
+	}
+	reviewChangeRequestReturns struct {
+		result1 error
+	}
+	reviewChangeRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateFlagStub        func(context.Context, model.FeatureFlag) error
+	updateFlagMutex       sync.RWMutex
+	updateFlagArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.FeatureFlag
+	}
+	updateFlagReturns struct {
+		result1 error
+	}
+	updateFlagReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateGroupStub        func(context.Context, model.Group) error
+	updateGroupMutex       sync.RWMutex
+	updateGroupArgsForCall []struct {
+		arg1 context.Context
+		arg2 model.Group
+	}
+	updateGroupReturns struct {
+		result1 error
+	}
+	updateGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStore) AddGroupMember(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.addGroupMemberMutex.Lock()
+	ret, specificReturn := fake.addGroupMemberReturnsOnCall[len(fake.addGroupMemberArgsForCall)]
+	fake.addGroupMemberArgsForCall = append(fake.addGroupMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.AddGroupMemberStub
+	fakeReturns := fake.addGroupMemberReturns
+	fake.recordInvocation("AddGroupMember", []interface{}{arg1, arg2, arg3})
+	fake.addGroupMemberMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) AddGroupMemberCallCount() int {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	return len(fake.addGroupMemberArgsForCall)
+}
+
+func (fake *FakeStore) AddGroupMemberCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.addGroupMemberMutex.Lock()
+	defer fake.addGroupMemberMutex.Unlock()
+	fake.AddGroupMemberStub = stub
+}
+
+func (fake *FakeStore) AddGroupMemberArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	argsForCall := fake.addGroupMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) AddGroupMemberReturns(result1 error) {
+	fake.addGroupMemberMutex.Lock()
+	defer fake.addGroupMemberMutex.Unlock()
+	fake.AddGroupMemberStub = nil
+	fake.addGroupMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) AddGroupMemberReturnsOnCall(i int, result1 error) {
+	fake.addGroupMemberMutex.Lock()
+	defer fake.addGroupMemberMutex.Unlock()
+	fake.AddGroupMemberStub = nil
+	if fake.addGroupMemberReturnsOnCall == nil {
+		fake.addGroupMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addGroupMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) ApplyChangeRequest(arg1 context.Context, arg2 model.ChangeRequest, arg3 *uuid.UUID) error {
+	fake.applyChangeRequestMutex.Lock()
+	ret, specificReturn := fake.applyChangeRequestReturnsOnCall[len(fake.applyChangeRequestArgsForCall)]
+	fake.applyChangeRequestArgsForCall = append(fake.applyChangeRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.ChangeRequest
+		arg3 *uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ApplyChangeRequestStub
+	fakeReturns := fake.applyChangeRequestReturns
+	fake.recordInvocation("ApplyChangeRequest", []interface{}{arg1, arg2, arg3})
+	fake.applyChangeRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) ApplyChangeRequestCallCount() int {
+	fake.applyChangeRequestMutex.RLock()
+	defer fake.applyChangeRequestMutex.RUnlock()
+	return len(fake.applyChangeRequestArgsForCall)
+}
+
+func (fake *FakeStore) ApplyChangeRequestCalls(stub func(context.Context, model.ChangeRequest, *uuid.UUID) error) {
+	fake.applyChangeRequestMutex.Lock()
+	defer fake.applyChangeRequestMutex.Unlock()
+	fake.ApplyChangeRequestStub = stub
+}
+
+func (fake *FakeStore) ApplyChangeRequestArgsForCall(i int) (context.Context, model.ChangeRequest, *uuid.UUID) {
+	fake.applyChangeRequestMutex.RLock()
+	defer fake.applyChangeRequestMutex.RUnlock()
+	argsForCall := fake.applyChangeRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) ApplyChangeRequestReturns(result1 error) {
+	fake.applyChangeRequestMutex.Lock()
+	defer fake.applyChangeRequestMutex.Unlock()
+	fake.ApplyChangeRequestStub = nil
+	fake.applyChangeRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) ApplyChangeRequestReturnsOnCall(i int, result1 error) {
+	fake.applyChangeRequestMutex.Lock()
+	defer fake.applyChangeRequestMutex.Unlock()
+	fake.ApplyChangeRequestStub = nil
+	if fake.applyChangeRequestReturnsOnCall == nil {
+		fake.applyChangeRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.applyChangeRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CancelChangeRequest(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 uuid.UUID) error {
+	fake.cancelChangeRequestMutex.Lock()
+	ret, specificReturn := fake.cancelChangeRequestReturnsOnCall[len(fake.cancelChangeRequestArgsForCall)]
+	fake.cancelChangeRequestArgsForCall = append(fake.cancelChangeRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.CancelChangeRequestStub
+	fakeReturns := fake.cancelChangeRequestReturns
+	fake.recordInvocation("CancelChangeRequest", []interface{}{arg1, arg2, arg3, arg4})
+	fake.cancelChangeRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CancelChangeRequestCallCount() int {
+	fake.cancelChangeRequestMutex.RLock()
+	defer fake.cancelChangeRequestMutex.RUnlock()
+	return len(fake.cancelChangeRequestArgsForCall)
+}
+
+func (fake *FakeStore) CancelChangeRequestCalls(stub func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) {
+	fake.cancelChangeRequestMutex.Lock()
+	defer fake.cancelChangeRequestMutex.Unlock()
+	fake.CancelChangeRequestStub = stub
+}
+
+func (fake *FakeStore) CancelChangeRequestArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, uuid.UUID) {
+	fake.cancelChangeRequestMutex.RLock()
+	defer fake.cancelChangeRequestMutex.RUnlock()
+	argsForCall := fake.cancelChangeRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) CancelChangeRequestReturns(result1 error) {
+	fake.cancelChangeRequestMutex.Lock()
+	defer fake.cancelChangeRequestMutex.Unlock()
+	fake.CancelChangeRequestStub = nil
+	fake.cancelChangeRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CancelChangeRequestReturnsOnCall(i int, result1 error) {
+	fake.cancelChangeRequestMutex.Lock()
+	defer fake.cancelChangeRequestMutex.Unlock()
+	fake.CancelChangeRequestStub = nil
+	if fake.cancelChangeRequestReturnsOnCall == nil {
+		fake.cancelChangeRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cancelChangeRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CountCodeReferences(arg1 context.Context, arg2 uuid.UUID) (map[string]int, error) {
+	fake.countCodeReferencesMutex.Lock()
+	ret, specificReturn := fake.countCodeReferencesReturnsOnCall[len(fake.countCodeReferencesArgsForCall)]
+	fake.countCodeReferencesArgsForCall = append(fake.countCodeReferencesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.CountCodeReferencesStub
+	fakeReturns := fake.countCodeReferencesReturns
+	fake.recordInvocation("CountCodeReferences", []interface{}{arg1, arg2})
+	fake.countCodeReferencesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) CountCodeReferencesCallCount() int {
+	fake.countCodeReferencesMutex.RLock()
+	defer fake.countCodeReferencesMutex.RUnlock()
+	return len(fake.countCodeReferencesArgsForCall)
+}
+
+func (fake *FakeStore) CountCodeReferencesCalls(stub func(context.Context, uuid.UUID) (map[string]int, error)) {
+	fake.countCodeReferencesMutex.Lock()
+	defer fake.countCodeReferencesMutex.Unlock()
+	fake.CountCodeReferencesStub = stub
+}
+
+func (fake *FakeStore) CountCodeReferencesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.countCodeReferencesMutex.RLock()
+	defer fake.countCodeReferencesMutex.RUnlock()
+	argsForCall := fake.countCodeReferencesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CountCodeReferencesReturns(result1 map[string]int, result2 error) {
+	fake.countCodeReferencesMutex.Lock()
+	defer fake.countCodeReferencesMutex.Unlock()
+	fake.CountCodeReferencesStub = nil
+	fake.countCodeReferencesReturns = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) CountCodeReferencesReturnsOnCall(i int, result1 map[string]int, result2 error) {
+	fake.countCodeReferencesMutex.Lock()
+	defer fake.countCodeReferencesMutex.Unlock()
+	fake.CountCodeReferencesStub = nil
+	if fake.countCodeReferencesReturnsOnCall == nil {
+		fake.countCodeReferencesReturnsOnCall = make(map[int]struct {
+			result1 map[string]int
+			result2 error
+		})
+	}
+	fake.countCodeReferencesReturnsOnCall[i] = struct {
+		result1 map[string]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) CreateAccessRule(arg1 context.Context, arg2 model.AccessRule) error {
+	fake.createAccessRuleMutex.Lock()
+	ret, specificReturn := fake.createAccessRuleReturnsOnCall[len(fake.createAccessRuleArgsForCall)]
+	fake.createAccessRuleArgsForCall = append(fake.createAccessRuleArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.AccessRule
+	}{arg1, arg2})
+	stub := fake.CreateAccessRuleStub
+	fakeReturns := fake.createAccessRuleReturns
+	fake.recordInvocation("CreateAccessRule", []interface{}{arg1, arg2})
+	fake.createAccessRuleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateAccessRuleCallCount() int {
+	fake.createAccessRuleMutex.RLock()
+	defer fake.createAccessRuleMutex.RUnlock()
+	return len(fake.createAccessRuleArgsForCall)
+}
+
+func (fake *FakeStore) CreateAccessRuleCalls(stub func(context.Context, model.AccessRule) error) {
+	fake.createAccessRuleMutex.Lock()
+	defer fake.createAccessRuleMutex.Unlock()
+	fake.CreateAccessRuleStub = stub
+}
+
+func (fake *FakeStore) CreateAccessRuleArgsForCall(i int) (context.Context, model.AccessRule) {
+	fake.createAccessRuleMutex.RLock()
+	defer fake.createAccessRuleMutex.RUnlock()
+	argsForCall := fake.createAccessRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CreateAccessRuleReturns(result1 error) {
+	fake.createAccessRuleMutex.Lock()
+	defer fake.createAccessRuleMutex.Unlock()
+	fake.CreateAccessRuleStub = nil
+	fake.createAccessRuleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateAccessRuleReturnsOnCall(i int, result1 error) {
+	fake.createAccessRuleMutex.Lock()
+	defer fake.createAccessRuleMutex.Unlock()
+	fake.CreateAccessRuleStub = nil
+	if fake.createAccessRuleReturnsOnCall == nil {
+		fake.createAccessRuleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createAccessRuleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateChangeRequest(arg1 context.Context, arg2 model.ChangeRequest) error {
+	fake.createChangeRequestMutex.Lock()
+	ret, specificReturn := fake.createChangeRequestReturnsOnCall[len(fake.createChangeRequestArgsForCall)]
+	fake.createChangeRequestArgsForCall = append(fake.createChangeRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.ChangeRequest
+	}{arg1, arg2})
+	stub := fake.CreateChangeRequestStub
+	fakeReturns := fake.createChangeRequestReturns
+	fake.recordInvocation("CreateChangeRequest", []interface{}{arg1, arg2})
+	fake.createChangeRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateChangeRequestCallCount() int {
+	fake.createChangeRequestMutex.RLock()
+	defer fake.createChangeRequestMutex.RUnlock()
+	return len(fake.createChangeRequestArgsForCall)
+}
+
+func (fake *FakeStore) CreateChangeRequestCalls(stub func(context.Context, model.ChangeRequest) error) {
+	fake.createChangeRequestMutex.Lock()
+	defer fake.createChangeRequestMutex.Unlock()
+	fake.CreateChangeRequestStub = stub
+}
+
+func (fake *FakeStore) CreateChangeRequestArgsForCall(i int) (context.Context, model.ChangeRequest) {
+	fake.createChangeRequestMutex.RLock()
+	defer fake.createChangeRequestMutex.RUnlock()
+	argsForCall := fake.createChangeRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CreateChangeRequestReturns(result1 error) {
+	fake.createChangeRequestMutex.Lock()
+	defer fake.createChangeRequestMutex.Unlock()
+	fake.CreateChangeRequestStub = nil
+	fake.createChangeRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateChangeRequestReturnsOnCall(i int, result1 error) {
+	fake.createChangeRequestMutex.Lock()
+	defer fake.createChangeRequestMutex.Unlock()
+	fake.CreateChangeRequestStub = nil
+	if fake.createChangeRequestReturnsOnCall == nil {
+		fake.createChangeRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createChangeRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateFlag(arg1 context.Context, arg2 model.FeatureFlag) error {
+	fake.createFlagMutex.Lock()
+	ret, specificReturn := fake.createFlagReturnsOnCall[len(fake.createFlagArgsForCall)]
+	fake.createFlagArgsForCall = append(fake.createFlagArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.FeatureFlag
+	}{arg1, arg2})
+	stub := fake.CreateFlagStub
+	fakeReturns := fake.createFlagReturns
+	fake.recordInvocation("CreateFlag", []interface{}{arg1, arg2})
+	fake.createFlagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateFlagCallCount() int {
+	fake.createFlagMutex.RLock()
+	defer fake.createFlagMutex.RUnlock()
+	return len(fake.createFlagArgsForCall)
+}
+
+func (fake *FakeStore) CreateFlagCalls(stub func(context.Context, model.FeatureFlag) error) {
+	fake.createFlagMutex.Lock()
+	defer fake.createFlagMutex.Unlock()
+	fake.CreateFlagStub = stub
+}
+
+func (fake *FakeStore) CreateFlagArgsForCall(i int) (context.Context, model.FeatureFlag) {
+	fake.createFlagMutex.RLock()
+	defer fake.createFlagMutex.RUnlock()
+	argsForCall := fake.createFlagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CreateFlagReturns(result1 error) {
+	fake.createFlagMutex.Lock()
+	defer fake.createFlagMutex.Unlock()
+	fake.CreateFlagStub = nil
+	fake.createFlagReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateFlagReturnsOnCall(i int, result1 error) {
+	fake.createFlagMutex.Lock()
+	defer fake.createFlagMutex.Unlock()
+	fake.CreateFlagStub = nil
+	if fake.createFlagReturnsOnCall == nil {
+		fake.createFlagReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createFlagReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateGroup(arg1 context.Context, arg2 model.Group) error {
+	fake.createGroupMutex.Lock()
+	ret, specificReturn := fake.createGroupReturnsOnCall[len(fake.createGroupArgsForCall)]
+	fake.createGroupArgsForCall = append(fake.createGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.Group
+	}{arg1, arg2})
+	stub := fake.CreateGroupStub
+	fakeReturns := fake.createGroupReturns
+	fake.recordInvocation("CreateGroup", []interface{}{arg1, arg2})
+	fake.createGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateGroupCallCount() int {
+	fake.createGroupMutex.RLock()
+	defer fake.createGroupMutex.RUnlock()
+	return len(fake.createGroupArgsForCall)
+}
+
+func (fake *FakeStore) CreateGroupCalls(stub func(context.Context, model.Group) error) {
+	fake.createGroupMutex.Lock()
+	defer fake.createGroupMutex.Unlock()
+	fake.CreateGroupStub = stub
+}
+
+func (fake *FakeStore) CreateGroupArgsForCall(i int) (context.Context, model.Group) {
+	fake.createGroupMutex.RLock()
+	defer fake.createGroupMutex.RUnlock()
+	argsForCall := fake.createGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CreateGroupReturns(result1 error) {
+	fake.createGroupMutex.Lock()
+	defer fake.createGroupMutex.Unlock()
+	fake.CreateGroupStub = nil
+	fake.createGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateGroupReturnsOnCall(i int, result1 error) {
+	fake.createGroupMutex.Lock()
+	defer fake.createGroupMutex.Unlock()
+	fake.CreateGroupStub = nil
+	if fake.createGroupReturnsOnCall == nil {
+		fake.createGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateKillSwitch(arg1 context.Context, arg2 model.KillSwitch) error {
+	fake.createKillSwitchMutex.Lock()
+	ret, specificReturn := fake.createKillSwitchReturnsOnCall[len(fake.createKillSwitchArgsForCall)]
+	fake.createKillSwitchArgsForCall = append(fake.createKillSwitchArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.KillSwitch
+	}{arg1, arg2})
+	stub := fake.CreateKillSwitchStub
+	fakeReturns := fake.createKillSwitchReturns
+	fake.recordInvocation("CreateKillSwitch", []interface{}{arg1, arg2})
+	fake.createKillSwitchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CreateKillSwitchCallCount() int {
+	fake.createKillSwitchMutex.RLock()
+	defer fake.createKillSwitchMutex.RUnlock()
+	return len(fake.createKillSwitchArgsForCall)
+}
+
+func (fake *FakeStore) CreateKillSwitchCalls(stub func(context.Context, model.KillSwitch) error) {
+	fake.createKillSwitchMutex.Lock()
+	defer fake.createKillSwitchMutex.Unlock()
+	fake.CreateKillSwitchStub = stub
+}
+
+func (fake *FakeStore) CreateKillSwitchArgsForCall(i int) (context.Context, model.KillSwitch) {
+	fake.createKillSwitchMutex.RLock()
+	defer fake.createKillSwitchMutex.RUnlock()
+	argsForCall := fake.createKillSwitchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) CreateKillSwitchReturns(result1 error) {
+	fake.createKillSwitchMutex.Lock()
+	defer fake.createKillSwitchMutex.Unlock()
+	fake.CreateKillSwitchStub = nil
+	fake.createKillSwitchReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CreateKillSwitchReturnsOnCall(i int, result1 error) {
+	fake.createKillSwitchMutex.Lock()
+	defer fake.createKillSwitchMutex.Unlock()
+	fake.CreateKillSwitchStub = nil
+	if fake.createKillSwitchReturnsOnCall == nil {
+		fake.createKillSwitchReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createKillSwitchReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteAccessRule(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deleteAccessRuleMutex.Lock()
+	ret, specificReturn := fake.deleteAccessRuleReturnsOnCall[len(fake.deleteAccessRuleArgsForCall)]
+	fake.deleteAccessRuleArgsForCall = append(fake.deleteAccessRuleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteAccessRuleStub
+	fakeReturns := fake.deleteAccessRuleReturns
+	fake.recordInvocation("DeleteAccessRule", []interface{}{arg1, arg2, arg3})
+	fake.deleteAccessRuleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteAccessRuleCallCount() int {
+	fake.deleteAccessRuleMutex.RLock()
+	defer fake.deleteAccessRuleMutex.RUnlock()
+	return len(fake.deleteAccessRuleArgsForCall)
+}
+
+func (fake *FakeStore) DeleteAccessRuleCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deleteAccessRuleMutex.Lock()
+	defer fake.deleteAccessRuleMutex.Unlock()
+	fake.DeleteAccessRuleStub = stub
+}
+
+func (fake *FakeStore) DeleteAccessRuleArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteAccessRuleMutex.RLock()
+	defer fake.deleteAccessRuleMutex.RUnlock()
+	argsForCall := fake.deleteAccessRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) DeleteAccessRuleReturns(result1 error) {
+	fake.deleteAccessRuleMutex.Lock()
+	defer fake.deleteAccessRuleMutex.Unlock()
+	fake.DeleteAccessRuleStub = nil
+	fake.deleteAccessRuleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteAccessRuleReturnsOnCall(i int, result1 error) {
+	fake.deleteAccessRuleMutex.Lock()
+	defer fake.deleteAccessRuleMutex.Unlock()
+	fake.DeleteAccessRuleStub = nil
+	if fake.deleteAccessRuleReturnsOnCall == nil {
+		fake.deleteAccessRuleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAccessRuleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteFlag(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deleteFlagMutex.Lock()
+	ret, specificReturn := fake.deleteFlagReturnsOnCall[len(fake.deleteFlagArgsForCall)]
+	fake.deleteFlagArgsForCall = append(fake.deleteFlagArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteFlagStub
+	fakeReturns := fake.deleteFlagReturns
+	fake.recordInvocation("DeleteFlag", []interface{}{arg1, arg2, arg3})
+	fake.deleteFlagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteFlagCallCount() int {
+	fake.deleteFlagMutex.RLock()
+	defer fake.deleteFlagMutex.RUnlock()
+	return len(fake.deleteFlagArgsForCall)
+}
+
+func (fake *FakeStore) DeleteFlagCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deleteFlagMutex.Lock()
+	defer fake.deleteFlagMutex.Unlock()
+	fake.DeleteFlagStub = stub
+}
+
+func (fake *FakeStore) DeleteFlagArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteFlagMutex.RLock()
+	defer fake.deleteFlagMutex.RUnlock()
+	argsForCall := fake.deleteFlagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) DeleteFlagReturns(result1 error) {
+	fake.deleteFlagMutex.Lock()
+	defer fake.deleteFlagMutex.Unlock()
+	fake.DeleteFlagStub = nil
+	fake.deleteFlagReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteFlagReturnsOnCall(i int, result1 error) {
+	fake.deleteFlagMutex.Lock()
+	defer fake.deleteFlagMutex.Unlock()
+	fake.DeleteFlagStub = nil
+	if fake.deleteFlagReturnsOnCall == nil {
+		fake.deleteFlagReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteFlagReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteGroup(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deleteGroupMutex.Lock()
+	ret, specificReturn := fake.deleteGroupReturnsOnCall[len(fake.deleteGroupArgsForCall)]
+	fake.deleteGroupArgsForCall = append(fake.deleteGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteGroupStub
+	fakeReturns := fake.deleteGroupReturns
+	fake.recordInvocation("DeleteGroup", []interface{}{arg1, arg2, arg3})
+	fake.deleteGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) DeleteGroupCallCount() int {
+	fake.deleteGroupMutex.RLock()
+	defer fake.deleteGroupMutex.RUnlock()
+	return len(fake.deleteGroupArgsForCall)
+}
+
+func (fake *FakeStore) DeleteGroupCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.deleteGroupMutex.Lock()
+	defer fake.deleteGroupMutex.Unlock()
+	fake.DeleteGroupStub = stub
+}
+
+func (fake *FakeStore) DeleteGroupArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deleteGroupMutex.RLock()
+	defer fake.deleteGroupMutex.RUnlock()
+	argsForCall := fake.deleteGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) DeleteGroupReturns(result1 error) {
+	fake.deleteGroupMutex.Lock()
+	defer fake.deleteGroupMutex.Unlock()
+	fake.DeleteGroupStub = nil
+	fake.deleteGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteGroupReturnsOnCall(i int, result1 error) {
+	fake.deleteGroupMutex.Lock()
+	defer fake.deleteGroupMutex.Unlock()
+	fake.DeleteGroupStub = nil
+	if fake.deleteGroupReturnsOnCall == nil {
+		fake.deleteGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) GetActiveKillSwitch(arg1 context.Context, arg2 uuid.UUID) (model.KillSwitch, error) {
+	fake.getActiveKillSwitchMutex.Lock()
+	ret, specificReturn := fake.getActiveKillSwitchReturnsOnCall[len(fake.getActiveKillSwitchArgsForCall)]
+	fake.getActiveKillSwitchArgsForCall = append(fake.getActiveKillSwitchArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetActiveKillSwitchStub
+	fakeReturns := fake.getActiveKillSwitchReturns
+	fake.recordInvocation("GetActiveKillSwitch", []interface{}{arg1, arg2})
+	fake.getActiveKillSwitchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetActiveKillSwitchCallCount() int {
+	fake.getActiveKillSwitchMutex.RLock()
+	defer fake.getActiveKillSwitchMutex.RUnlock()
+	return len(fake.getActiveKillSwitchArgsForCall)
+}
+
+func (fake *FakeStore) GetActiveKillSwitchCalls(stub func(context.Context, uuid.UUID) (model.KillSwitch, error)) {
+	fake.getActiveKillSwitchMutex.Lock()
+	defer fake.getActiveKillSwitchMutex.Unlock()
+	fake.GetActiveKillSwitchStub = stub
+}
+
+func (fake *FakeStore) GetActiveKillSwitchArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getActiveKillSwitchMutex.RLock()
+	defer fake.getActiveKillSwitchMutex.RUnlock()
+	argsForCall := fake.getActiveKillSwitchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) GetActiveKillSwitchReturns(result1 model.KillSwitch, result2 error) {
+	fake.getActiveKillSwitchMutex.Lock()
+	defer fake.getActiveKillSwitchMutex.Unlock()
+	fake.GetActiveKillSwitchStub = nil
+	fake.getActiveKillSwitchReturns = struct {
+		result1 model.KillSwitch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetActiveKillSwitchReturnsOnCall(i int, result1 model.KillSwitch, result2 error) {
+	fake.getActiveKillSwitchMutex.Lock()
+	defer fake.getActiveKillSwitchMutex.Unlock()
+	fake.GetActiveKillSwitchStub = nil
+	if fake.getActiveKillSwitchReturnsOnCall == nil {
+		fake.getActiveKillSwitchReturnsOnCall = make(map[int]struct {
+			result1 model.KillSwitch
+			result2 error
+		})
+	}
+	fake.getActiveKillSwitchReturnsOnCall[i] = struct {
+		result1 model.KillSwitch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetChangeRequestByID(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (model.ChangeRequest, error) {
+	fake.getChangeRequestByIDMutex.Lock()
+	ret, specificReturn := fake.getChangeRequestByIDReturnsOnCall[len(fake.getChangeRequestByIDArgsForCall)]
+	fake.getChangeRequestByIDArgsForCall = append(fake.getChangeRequestByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetChangeRequestByIDStub
+	fakeReturns := fake.getChangeRequestByIDReturns
+	fake.recordInvocation("GetChangeRequestByID", []interface{}{arg1, arg2, arg3})
+	fake.getChangeRequestByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetChangeRequestByIDCallCount() int {
+	fake.getChangeRequestByIDMutex.RLock()
+	defer fake.getChangeRequestByIDMutex.RUnlock()
+	return len(fake.getChangeRequestByIDArgsForCall)
+}
+
+func (fake *FakeStore) GetChangeRequestByIDCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (model.ChangeRequest, error)) {
+	fake.getChangeRequestByIDMutex.Lock()
+	defer fake.getChangeRequestByIDMutex.Unlock()
+	fake.GetChangeRequestByIDStub = stub
+}
+
+func (fake *FakeStore) GetChangeRequestByIDArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getChangeRequestByIDMutex.RLock()
+	defer fake.getChangeRequestByIDMutex.RUnlock()
+	argsForCall := fake.getChangeRequestByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) GetChangeRequestByIDReturns(result1 model.ChangeRequest, result2 error) {
+	fake.getChangeRequestByIDMutex.Lock()
+	defer fake.getChangeRequestByIDMutex.Unlock()
+	fake.GetChangeRequestByIDStub = nil
+	fake.getChangeRequestByIDReturns = struct {
+		result1 model.ChangeRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetChangeRequestByIDReturnsOnCall(i int, result1 model.ChangeRequest, result2 error) {
+	fake.getChangeRequestByIDMutex.Lock()
+	defer fake.getChangeRequestByIDMutex.Unlock()
+	fake.GetChangeRequestByIDStub = nil
+	if fake.getChangeRequestByIDReturnsOnCall == nil {
+		fake.getChangeRequestByIDReturnsOnCall = make(map[int]struct {
+			result1 model.ChangeRequest
+			result2 error
+		})
+	}
+	fake.getChangeRequestByIDReturnsOnCall[i] = struct {
+		result1 model.ChangeRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetFlagByID(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (model.FeatureFlag, error) {
+	fake.getFlagByIDMutex.Lock()
+	ret, specificReturn := fake.getFlagByIDReturnsOnCall[len(fake.getFlagByIDArgsForCall)]
+	fake.getFlagByIDArgsForCall = append(fake.getFlagByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetFlagByIDStub
+	fakeReturns := fake.getFlagByIDReturns
+	fake.recordInvocation("GetFlagByID", []interface{}{arg1, arg2, arg3})
+	fake.getFlagByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetFlagByIDCallCount() int {
+	fake.getFlagByIDMutex.RLock()
+	defer fake.getFlagByIDMutex.RUnlock()
+	return len(fake.getFlagByIDArgsForCall)
+}
+
+func (fake *FakeStore) GetFlagByIDCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (model.FeatureFlag, error)) {
+	fake.getFlagByIDMutex.Lock()
+	defer fake.getFlagByIDMutex.Unlock()
+	fake.GetFlagByIDStub = stub
+}
+
+func (fake *FakeStore) GetFlagByIDArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getFlagByIDMutex.RLock()
+	defer fake.getFlagByIDMutex.RUnlock()
+	argsForCall := fake.getFlagByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) GetFlagByIDReturns(result1 model.FeatureFlag, result2 error) {
+	fake.getFlagByIDMutex.Lock()
+	defer fake.getFlagByIDMutex.Unlock()
+	fake.GetFlagByIDStub = nil
+	fake.getFlagByIDReturns = struct {
+		result1 model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetFlagByIDReturnsOnCall(i int, result1 model.FeatureFlag, result2 error) {
+	fake.getFlagByIDMutex.Lock()
+	defer fake.getFlagByIDMutex.Unlock()
+	fake.GetFlagByIDStub = nil
+	if fake.getFlagByIDReturnsOnCall == nil {
+		fake.getFlagByIDReturnsOnCall = make(map[int]struct {
+			result1 model.FeatureFlag
+			result2 error
+		})
+	}
+	fake.getFlagByIDReturnsOnCall[i] = struct {
+		result1 model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetFlagByKey(arg1 context.Context, arg2 uuid.UUID, arg3 string) (model.FeatureFlag, error) {
+	fake.getFlagByKeyMutex.Lock()
+	ret, specificReturn := fake.getFlagByKeyReturnsOnCall[len(fake.getFlagByKeyArgsForCall)]
+	fake.getFlagByKeyArgsForCall = append(fake.getFlagByKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetFlagByKeyStub
+	fakeReturns := fake.getFlagByKeyReturns
+	fake.recordInvocation("GetFlagByKey", []interface{}{arg1, arg2, arg3})
+	fake.getFlagByKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetFlagByKeyCallCount() int {
+	fake.getFlagByKeyMutex.RLock()
+	defer fake.getFlagByKeyMutex.RUnlock()
+	return len(fake.getFlagByKeyArgsForCall)
+}
+
+func (fake *FakeStore) GetFlagByKeyCalls(stub func(context.Context, uuid.UUID, string) (model.FeatureFlag, error)) {
+	fake.getFlagByKeyMutex.Lock()
+	defer fake.getFlagByKeyMutex.Unlock()
+	fake.GetFlagByKeyStub = stub
+}
+
+func (fake *FakeStore) GetFlagByKeyArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.getFlagByKeyMutex.RLock()
+	defer fake.getFlagByKeyMutex.RUnlock()
+	argsForCall := fake.getFlagByKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) GetFlagByKeyReturns(result1 model.FeatureFlag, result2 error) {
+	fake.getFlagByKeyMutex.Lock()
+	defer fake.getFlagByKeyMutex.Unlock()
+	fake.GetFlagByKeyStub = nil
+	fake.getFlagByKeyReturns = struct {
+		result1 model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetFlagByKeyReturnsOnCall(i int, result1 model.FeatureFlag, result2 error) {
+	fake.getFlagByKeyMutex.Lock()
+	defer fake.getFlagByKeyMutex.Unlock()
+	fake.GetFlagByKeyStub = nil
+	if fake.getFlagByKeyReturnsOnCall == nil {
+		fake.getFlagByKeyReturnsOnCall = make(map[int]struct {
+			result1 model.FeatureFlag
+			result2 error
+		})
+	}
+	fake.getFlagByKeyReturnsOnCall[i] = struct {
+		result1 model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetGroupByID(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (model.Group, error) {
+	fake.getGroupByIDMutex.Lock()
+	ret, specificReturn := fake.getGroupByIDReturnsOnCall[len(fake.getGroupByIDArgsForCall)]
+	fake.getGroupByIDArgsForCall = append(fake.getGroupByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.GetGroupByIDStub
+	fakeReturns := fake.getGroupByIDReturns
+	fake.recordInvocation("GetGroupByID", []interface{}{arg1, arg2, arg3})
+	fake.getGroupByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetGroupByIDCallCount() int {
+	fake.getGroupByIDMutex.RLock()
+	defer fake.getGroupByIDMutex.RUnlock()
+	return len(fake.getGroupByIDArgsForCall)
+}
+
+func (fake *FakeStore) GetGroupByIDCalls(stub func(context.Context, uuid.UUID, uuid.UUID) (model.Group, error)) {
+	fake.getGroupByIDMutex.Lock()
+	defer fake.getGroupByIDMutex.Unlock()
+	fake.GetGroupByIDStub = stub
+}
+
+func (fake *FakeStore) GetGroupByIDArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.getGroupByIDMutex.RLock()
+	defer fake.getGroupByIDMutex.RUnlock()
+	argsForCall := fake.getGroupByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) GetGroupByIDReturns(result1 model.Group, result2 error) {
+	fake.getGroupByIDMutex.Lock()
+	defer fake.getGroupByIDMutex.Unlock()
+	fake.GetGroupByIDStub = nil
+	fake.getGroupByIDReturns = struct {
+		result1 model.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetGroupByIDReturnsOnCall(i int, result1 model.Group, result2 error) {
+	fake.getGroupByIDMutex.Lock()
+	defer fake.getGroupByIDMutex.Unlock()
+	fake.GetGroupByIDStub = nil
+	if fake.getGroupByIDReturnsOnCall == nil {
+		fake.getGroupByIDReturnsOnCall = make(map[int]struct {
+			result1 model.Group
+			result2 error
+		})
+	}
+	fake.getGroupByIDReturnsOnCall[i] = struct {
+		result1 model.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) HasCodeReferenceScans(arg1 context.Context, arg2 uuid.UUID) (bool, error) {
+	fake.hasCodeReferenceScansMutex.Lock()
+	ret, specificReturn := fake.hasCodeReferenceScansReturnsOnCall[len(fake.hasCodeReferenceScansArgsForCall)]
+	fake.hasCodeReferenceScansArgsForCall = append(fake.hasCodeReferenceScansArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.HasCodeReferenceScansStub
+	fakeReturns := fake.hasCodeReferenceScansReturns
+	fake.recordInvocation("HasCodeReferenceScans", []interface{}{arg1, arg2})
+	fake.hasCodeReferenceScansMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) HasCodeReferenceScansCallCount() int {
+	fake.hasCodeReferenceScansMutex.RLock()
+	defer fake.hasCodeReferenceScansMutex.RUnlock()
+	return len(fake.hasCodeReferenceScansArgsForCall)
+}
+
+func (fake *FakeStore) HasCodeReferenceScansCalls(stub func(context.Context, uuid.UUID) (bool, error)) {
+	fake.hasCodeReferenceScansMutex.Lock()
+	defer fake.hasCodeReferenceScansMutex.Unlock()
+	fake.HasCodeReferenceScansStub = stub
+}
+
+func (fake *FakeStore) HasCodeReferenceScansArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.hasCodeReferenceScansMutex.RLock()
+	defer fake.hasCodeReferenceScansMutex.RUnlock()
+	argsForCall := fake.hasCodeReferenceScansArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) HasCodeReferenceScansReturns(result1 bool, result2 error) {
+	fake.hasCodeReferenceScansMutex.Lock()
+	defer fake.hasCodeReferenceScansMutex.Unlock()
+	fake.HasCodeReferenceScansStub = nil
+	fake.hasCodeReferenceScansReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) HasCodeReferenceScansReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.hasCodeReferenceScansMutex.Lock()
+	defer fake.hasCodeReferenceScansMutex.Unlock()
+	fake.HasCodeReferenceScansStub = nil
+	if fake.hasCodeReferenceScansReturnsOnCall == nil {
+		fake.hasCodeReferenceScansReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasCodeReferenceScansReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListAccessRules(arg1 context.Context, arg2 uuid.UUID) ([]model.AccessRule, error) {
+	fake.listAccessRulesMutex.Lock()
+	ret, specificReturn := fake.listAccessRulesReturnsOnCall[len(fake.listAccessRulesArgsForCall)]
+	fake.listAccessRulesArgsForCall = append(fake.listAccessRulesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListAccessRulesStub
+	fakeReturns := fake.listAccessRulesReturns
+	fake.recordInvocation("ListAccessRules", []interface{}{arg1, arg2})
+	fake.listAccessRulesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListAccessRulesCallCount() int {
+	fake.listAccessRulesMutex.RLock()
+	defer fake.listAccessRulesMutex.RUnlock()
+	return len(fake.listAccessRulesArgsForCall)
+}
+
+func (fake *FakeStore) ListAccessRulesCalls(stub func(context.Context, uuid.UUID) ([]model.AccessRule, error)) {
+	fake.listAccessRulesMutex.Lock()
+	defer fake.listAccessRulesMutex.Unlock()
+	fake.ListAccessRulesStub = stub
+}
+
+func (fake *FakeStore) ListAccessRulesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listAccessRulesMutex.RLock()
+	defer fake.listAccessRulesMutex.RUnlock()
+	argsForCall := fake.listAccessRulesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListAccessRulesReturns(result1 []model.AccessRule, result2 error) {
+	fake.listAccessRulesMutex.Lock()
+	defer fake.listAccessRulesMutex.Unlock()
+	fake.ListAccessRulesStub = nil
+	fake.listAccessRulesReturns = struct {
+		result1 []model.AccessRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListAccessRulesReturnsOnCall(i int, result1 []model.AccessRule, result2 error) {
+	fake.listAccessRulesMutex.Lock()
+	defer fake.listAccessRulesMutex.Unlock()
+	fake.ListAccessRulesStub = nil
+	if fake.listAccessRulesReturnsOnCall == nil {
+		fake.listAccessRulesReturnsOnCall = make(map[int]struct {
+			result1 []model.AccessRule
+			result2 error
+		})
+	}
+	fake.listAccessRulesReturnsOnCall[i] = struct {
+		result1 []model.AccessRule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListChangeRequests(arg1 context.Context, arg2 uuid.UUID, arg3 model.ChangeRequestStatus) ([]model.ChangeRequest, error) {
+	fake.listChangeRequestsMutex.Lock()
+	ret, specificReturn := fake.listChangeRequestsReturnsOnCall[len(fake.listChangeRequestsArgsForCall)]
+	fake.listChangeRequestsArgsForCall = append(fake.listChangeRequestsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.ChangeRequestStatus
+	}{arg1, arg2, arg3})
+	stub := fake.ListChangeRequestsStub
+	fakeReturns := fake.listChangeRequestsReturns
+	fake.recordInvocation("ListChangeRequests", []interface{}{arg1, arg2, arg3})
+	fake.listChangeRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListChangeRequestsCallCount() int {
+	fake.listChangeRequestsMutex.RLock()
+	defer fake.listChangeRequestsMutex.RUnlock()
+	return len(fake.listChangeRequestsArgsForCall)
+}
+
+func (fake *FakeStore) ListChangeRequestsCalls(stub func(context.Context, uuid.UUID, model.ChangeRequestStatus) ([]model.ChangeRequest, error)) {
+	fake.listChangeRequestsMutex.Lock()
+	defer fake.listChangeRequestsMutex.Unlock()
+	fake.ListChangeRequestsStub = stub
+}
+
+func (fake *FakeStore) ListChangeRequestsArgsForCall(i int) (context.Context, uuid.UUID, model.ChangeRequestStatus) {
+	fake.listChangeRequestsMutex.RLock()
+	defer fake.listChangeRequestsMutex.RUnlock()
+	argsForCall := fake.listChangeRequestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) ListChangeRequestsReturns(result1 []model.ChangeRequest, result2 error) {
+	fake.listChangeRequestsMutex.Lock()
+	defer fake.listChangeRequestsMutex.Unlock()
+	fake.ListChangeRequestsStub = nil
+	fake.listChangeRequestsReturns = struct {
+		result1 []model.ChangeRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListChangeRequestsReturnsOnCall(i int, result1 []model.ChangeRequest, result2 error) {
+	fake.listChangeRequestsMutex.Lock()
+	defer fake.listChangeRequestsMutex.Unlock()
+	fake.ListChangeRequestsStub = nil
+	if fake.listChangeRequestsReturnsOnCall == nil {
+		fake.listChangeRequestsReturnsOnCall = make(map[int]struct {
+			result1 []model.ChangeRequest
+			result2 error
+		})
+	}
+	fake.listChangeRequestsReturnsOnCall[i] = struct {
+		result1 []model.ChangeRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListCodeReferences(arg1 context.Context, arg2 uuid.UUID, arg3 string) ([]model.CodeReference, error) {
+	fake.listCodeReferencesMutex.Lock()
+	ret, specificReturn := fake.listCodeReferencesReturnsOnCall[len(fake.listCodeReferencesArgsForCall)]
+	fake.listCodeReferencesArgsForCall = append(fake.listCodeReferencesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ListCodeReferencesStub
+	fakeReturns := fake.listCodeReferencesReturns
+	fake.recordInvocation("ListCodeReferences", []interface{}{arg1, arg2, arg3})
+	fake.listCodeReferencesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListCodeReferencesCallCount() int {
+	fake.listCodeReferencesMutex.RLock()
+	defer fake.listCodeReferencesMutex.RUnlock()
+	return len(fake.listCodeReferencesArgsForCall)
+}
+
+func (fake *FakeStore) ListCodeReferencesCalls(stub func(context.Context, uuid.UUID, string) ([]model.CodeReference, error)) {
+	fake.listCodeReferencesMutex.Lock()
+	defer fake.listCodeReferencesMutex.Unlock()
+	fake.ListCodeReferencesStub = stub
+}
+
+func (fake *FakeStore) ListCodeReferencesArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.listCodeReferencesMutex.RLock()
+	defer fake.listCodeReferencesMutex.RUnlock()
+	argsForCall := fake.listCodeReferencesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) ListCodeReferencesReturns(result1 []model.CodeReference, result2 error) {
+	fake.listCodeReferencesMutex.Lock()
+	defer fake.listCodeReferencesMutex.Unlock()
+	fake.ListCodeReferencesStub = nil
+	fake.listCodeReferencesReturns = struct {
+		result1 []model.CodeReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListCodeReferencesReturnsOnCall(i int, result1 []model.CodeReference, result2 error) {
+	fake.listCodeReferencesMutex.Lock()
+	defer fake.listCodeReferencesMutex.Unlock()
+	fake.ListCodeReferencesStub = nil
+	if fake.listCodeReferencesReturnsOnCall == nil {
+		fake.listCodeReferencesReturnsOnCall = make(map[int]struct {
+			result1 []model.CodeReference
+			result2 error
+		})
+	}
+	fake.listCodeReferencesReturnsOnCall[i] = struct {
+		result1 []model.CodeReference
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListDueChangeRequests(arg1 context.Context, arg2 time.Time) ([]model.ChangeRequest, error) {
+	fake.listDueChangeRequestsMutex.Lock()
+	ret, specificReturn := fake.listDueChangeRequestsReturnsOnCall[len(fake.listDueChangeRequestsArgsForCall)]
+	fake.listDueChangeRequestsArgsForCall = append(fake.listDueChangeRequestsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.ListDueChangeRequestsStub
+	fakeReturns := fake.listDueChangeRequestsReturns
+	fake.recordInvocation("ListDueChangeRequests", []interface{}{arg1, arg2})
+	fake.listDueChangeRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListDueChangeRequestsCallCount() int {
+	fake.listDueChangeRequestsMutex.RLock()
+	defer fake.listDueChangeRequestsMutex.RUnlock()
+	return len(fake.listDueChangeRequestsArgsForCall)
+}
+
+func (fake *FakeStore) ListDueChangeRequestsCalls(stub func(context.Context, time.Time) ([]model.ChangeRequest, error)) {
+	fake.listDueChangeRequestsMutex.Lock()
+	defer fake.listDueChangeRequestsMutex.Unlock()
+	fake.ListDueChangeRequestsStub = stub
+}
+
+func (fake *FakeStore) ListDueChangeRequestsArgsForCall(i int) (context.Context, time.Time) {
+	fake.listDueChangeRequestsMutex.RLock()
+	defer fake.listDueChangeRequestsMutex.RUnlock()
+	argsForCall := fake.listDueChangeRequestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListDueChangeRequestsReturns(result1 []model.ChangeRequest, result2 error) {
+	fake.listDueChangeRequestsMutex.Lock()
+	defer fake.listDueChangeRequestsMutex.Unlock()
+	fake.ListDueChangeRequestsStub = nil
+	fake.listDueChangeRequestsReturns = struct {
+		result1 []model.ChangeRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListDueChangeRequestsReturnsOnCall(i int, result1 []model.ChangeRequest, result2 error) {
+	fake.listDueChangeRequestsMutex.Lock()
+	defer fake.listDueChangeRequestsMutex.Unlock()
+	fake.ListDueChangeRequestsStub = nil
+	if fake.listDueChangeRequestsReturnsOnCall == nil {
+		fake.listDueChangeRequestsReturnsOnCall = make(map[int]struct {
+			result1 []model.ChangeRequest
+			result2 error
+		})
+	}
+	fake.listDueChangeRequestsReturnsOnCall[i] = struct {
+		result1 []model.ChangeRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListExpiringFlags(arg1 context.Context, arg2 time.Time) ([]model.FeatureFlag, error) {
+	fake.listExpiringFlagsMutex.Lock()
+	ret, specificReturn := fake.listExpiringFlagsReturnsOnCall[len(fake.listExpiringFlagsArgsForCall)]
+	fake.listExpiringFlagsArgsForCall = append(fake.listExpiringFlagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.ListExpiringFlagsStub
+	fakeReturns := fake.listExpiringFlagsReturns
+	fake.recordInvocation("ListExpiringFlags", []interface{}{arg1, arg2})
+	fake.listExpiringFlagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListExpiringFlagsCallCount() int {
+	fake.listExpiringFlagsMutex.RLock()
+	defer fake.listExpiringFlagsMutex.RUnlock()
+	return len(fake.listExpiringFlagsArgsForCall)
+}
+
+func (fake *FakeStore) ListExpiringFlagsCalls(stub func(context.Context, time.Time) ([]model.FeatureFlag, error)) {
+	fake.listExpiringFlagsMutex.Lock()
+	defer fake.listExpiringFlagsMutex.Unlock()
+	fake.ListExpiringFlagsStub = stub
+}
+
+func (fake *FakeStore) ListExpiringFlagsArgsForCall(i int) (context.Context, time.Time) {
+	fake.listExpiringFlagsMutex.RLock()
+	defer fake.listExpiringFlagsMutex.RUnlock()
+	argsForCall := fake.listExpiringFlagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListExpiringFlagsReturns(result1 []model.FeatureFlag, result2 error) {
+	fake.listExpiringFlagsMutex.Lock()
+	defer fake.listExpiringFlagsMutex.Unlock()
+	fake.ListExpiringFlagsStub = nil
+	fake.listExpiringFlagsReturns = struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListExpiringFlagsReturnsOnCall(i int, result1 []model.FeatureFlag, result2 error) {
+	fake.listExpiringFlagsMutex.Lock()
+	defer fake.listExpiringFlagsMutex.Unlock()
+	fake.ListExpiringFlagsStub = nil
+	if fake.listExpiringFlagsReturnsOnCall == nil {
+		fake.listExpiringFlagsReturnsOnCall = make(map[int]struct {
+			result1 []model.FeatureFlag
+			result2 error
+		})
+	}
+	fake.listExpiringFlagsReturnsOnCall[i] = struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListFlags(arg1 context.Context, arg2 uuid.UUID, arg3 model.FlagFilter) ([]model.FeatureFlag, error) {
+	fake.listFlagsMutex.Lock()
+	ret, specificReturn := fake.listFlagsReturnsOnCall[len(fake.listFlagsArgsForCall)]
+	fake.listFlagsArgsForCall = append(fake.listFlagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 model.FlagFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListFlagsStub
+	fakeReturns := fake.listFlagsReturns
+	fake.recordInvocation("ListFlags", []interface{}{arg1, arg2, arg3})
+	fake.listFlagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListFlagsCallCount() int {
+	fake.listFlagsMutex.RLock()
+	defer fake.listFlagsMutex.RUnlock()
+	return len(fake.listFlagsArgsForCall)
+}
+
+func (fake *FakeStore) ListFlagsCalls(stub func(context.Context, uuid.UUID, model.FlagFilter) ([]model.FeatureFlag, error)) {
+	fake.listFlagsMutex.Lock()
+	defer fake.listFlagsMutex.Unlock()
+	fake.ListFlagsStub = stub
+}
+
+func (fake *FakeStore) ListFlagsArgsForCall(i int) (context.Context, uuid.UUID, model.FlagFilter) {
+	fake.listFlagsMutex.RLock()
+	defer fake.listFlagsMutex.RUnlock()
+	argsForCall := fake.listFlagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) ListFlagsReturns(result1 []model.FeatureFlag, result2 error) {
+	fake.listFlagsMutex.Lock()
+	defer fake.listFlagsMutex.Unlock()
+	fake.ListFlagsStub = nil
+	fake.listFlagsReturns = struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListFlagsReturnsOnCall(i int, result1 []model.FeatureFlag, result2 error) {
+	fake.listFlagsMutex.Lock()
+	defer fake.listFlagsMutex.Unlock()
+	fake.ListFlagsStub = nil
+	if fake.listFlagsReturnsOnCall == nil {
+		fake.listFlagsReturnsOnCall = make(map[int]struct {
+			result1 []model.FeatureFlag
+			result2 error
+		})
+	}
+	fake.listFlagsReturnsOnCall[i] = struct {
+		result1 []model.FeatureFlag
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListGroupMembers(arg1 context.Context, arg2 uuid.UUID) ([]model.GroupMember, error) {
+	fake.listGroupMembersMutex.Lock()
+	ret, specificReturn := fake.listGroupMembersReturnsOnCall[len(fake.listGroupMembersArgsForCall)]
+	fake.listGroupMembersArgsForCall = append(fake.listGroupMembersArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListGroupMembersStub
+	fakeReturns := fake.listGroupMembersReturns
+	fake.recordInvocation("ListGroupMembers", []interface{}{arg1, arg2})
+	fake.listGroupMembersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListGroupMembersCallCount() int {
+	fake.listGroupMembersMutex.RLock()
+	defer fake.listGroupMembersMutex.RUnlock()
+	return len(fake.listGroupMembersArgsForCall)
+}
+
+func (fake *FakeStore) ListGroupMembersCalls(stub func(context.Context, uuid.UUID) ([]model.GroupMember, error)) {
+	fake.listGroupMembersMutex.Lock()
+	defer fake.listGroupMembersMutex.Unlock()
+	fake.ListGroupMembersStub = stub
+}
+
+func (fake *FakeStore) ListGroupMembersArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listGroupMembersMutex.RLock()
+	defer fake.listGroupMembersMutex.RUnlock()
+	argsForCall := fake.listGroupMembersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListGroupMembersReturns(result1 []model.GroupMember, result2 error) {
+	fake.listGroupMembersMutex.Lock()
+	defer fake.listGroupMembersMutex.Unlock()
+	fake.ListGroupMembersStub = nil
+	fake.listGroupMembersReturns = struct {
+		result1 []model.GroupMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListGroupMembersReturnsOnCall(i int, result1 []model.GroupMember, result2 error) {
+	fake.listGroupMembersMutex.Lock()
+	defer fake.listGroupMembersMutex.Unlock()
+	fake.ListGroupMembersStub = nil
+	if fake.listGroupMembersReturnsOnCall == nil {
+		fake.listGroupMembersReturnsOnCall = make(map[int]struct {
+			result1 []model.GroupMember
+			result2 error
+		})
+	}
+	fake.listGroupMembersReturnsOnCall[i] = struct {
+		result1 []model.GroupMember
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListGroups(arg1 context.Context, arg2 uuid.UUID) ([]model.Group, error) {
+	fake.listGroupsMutex.Lock()
+	ret, specificReturn := fake.listGroupsReturnsOnCall[len(fake.listGroupsArgsForCall)]
+	fake.listGroupsArgsForCall = append(fake.listGroupsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListGroupsStub
+	fakeReturns := fake.listGroupsReturns
+	fake.recordInvocation("ListGroups", []interface{}{arg1, arg2})
+	fake.listGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListGroupsCallCount() int {
+	fake.listGroupsMutex.RLock()
+	defer fake.listGroupsMutex.RUnlock()
+	return len(fake.listGroupsArgsForCall)
+}
+
+func (fake *FakeStore) ListGroupsCalls(stub func(context.Context, uuid.UUID) ([]model.Group, error)) {
+	fake.listGroupsMutex.Lock()
+	defer fake.listGroupsMutex.Unlock()
+	fake.ListGroupsStub = stub
+}
+
+func (fake *FakeStore) ListGroupsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listGroupsMutex.RLock()
+	defer fake.listGroupsMutex.RUnlock()
+	argsForCall := fake.listGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) ListGroupsReturns(result1 []model.Group, result2 error) {
+	fake.listGroupsMutex.Lock()
+	defer fake.listGroupsMutex.Unlock()
+	fake.ListGroupsStub = nil
+	fake.listGroupsReturns = struct {
+		result1 []model.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListGroupsReturnsOnCall(i int, result1 []model.Group, result2 error) {
+	fake.listGroupsMutex.Lock()
+	defer fake.listGroupsMutex.Unlock()
+	fake.ListGroupsStub = nil
+	if fake.listGroupsReturnsOnCall == nil {
+		fake.listGroupsReturnsOnCall = make(map[int]struct {
+			result1 []model.Group
+			result2 error
+		})
+	}
+	fake.listGroupsReturnsOnCall[i] = struct {
+		result1 []model.Group
 		result2 error
-	}
-	ListFlagsStub        func(context.Context) ([]model.FeatureFlag, error)
-	listFlagsMutex       sync.RWMutex
-	listFlagsArgsForCall []struct {
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListUserGroupIDs(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]uuid.UUID, error) {
+	fake.listUserGroupIDsMutex.Lock()
+	ret, specificReturn := fake.listUserGroupIDsReturnsOnCall[len(fake.listUserGroupIDsArgsForCall)]
+	fake.listUserGroupIDsArgsForCall = append(fake.listUserGroupIDsArgsForCall, struct {
 		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ListUserGroupIDsStub
+	fakeReturns := fake.listUserGroupIDsReturns
+	fake.recordInvocation("ListUserGroupIDs", []interface{}{arg1, arg2, arg3})
+	fake.listUserGroupIDsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
-	listFlagsReturns struct {
-		result1 []model.FeatureFlag
-		result2 error
+	if specificReturn {
+		return ret.result1, ret.result2
 	}
-	listFlagsReturnsOnCall map[int]struct {
-		result1 []model.FeatureFlag
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) ListUserGroupIDsCallCount() int {
+	fake.listUserGroupIDsMutex.RLock()
+	defer fake.listUserGroupIDsMutex.RUnlock()
+	return len(fake.listUserGroupIDsArgsForCall)
+}
+
+func (fake *FakeStore) ListUserGroupIDsCalls(stub func(context.Context, uuid.UUID, uuid.UUID) ([]uuid.UUID, error)) {
+	fake.listUserGroupIDsMutex.Lock()
+	defer fake.listUserGroupIDsMutex.Unlock()
+	fake.ListUserGroupIDsStub = stub
+}
+
+func (fake *FakeStore) ListUserGroupIDsArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.listUserGroupIDsMutex.RLock()
+	defer fake.listUserGroupIDsMutex.RUnlock()
+	argsForCall := fake.listUserGroupIDsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStore) ListUserGroupIDsReturns(result1 []uuid.UUID, result2 error) {
+	fake.listUserGroupIDsMutex.Lock()
+	defer fake.listUserGroupIDsMutex.Unlock()
+	fake.ListUserGroupIDsStub = nil
+	fake.listUserGroupIDsReturns = struct {
+		result1 []uuid.UUID
 		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListUserGroupIDsReturnsOnCall(i int, result1 []uuid.UUID, result2 error) {
+	fake.listUserGroupIDsMutex.Lock()
+	defer fake.listUserGroupIDsMutex.Unlock()
+	fake.ListUserGroupIDsStub = nil
+	if fake.listUserGroupIDsReturnsOnCall == nil {
+		fake.listUserGroupIDsReturnsOnCall = make(map[int]struct {
+			result1 []uuid.UUID
+			result2 error
+		})
 	}
-	UpdateFlagStub        func(context.Context, model.FeatureFlag) error
-	updateFlagMutex       sync.RWMutex
-	updateFlagArgsForCall []struct {
+	fake.listUserGroupIDsReturnsOnCall[i] = struct {
+		result1 []uuid.UUID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) RecordFlagEvaluation(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 time.Time) error {
+	fake.recordFlagEvaluationMutex.Lock()
+	ret, specificReturn := fake.recordFlagEvaluationReturnsOnCall[len(fake.recordFlagEvaluationArgsForCall)]
+	fake.recordFlagEvaluationArgsForCall = append(fake.recordFlagEvaluationArgsForCall, struct {
 		arg1 context.Context
-		arg2 model.FeatureFlag
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RecordFlagEvaluationStub
+	fakeReturns := fake.recordFlagEvaluationReturns
+	fake.recordInvocation("RecordFlagEvaluation", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordFlagEvaluationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
-	updateFlagReturns struct {
-		result1 error
+	if specificReturn {
+		return ret.result1
 	}
-	updateFlagReturnsOnCall map[int]struct {
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) RecordFlagEvaluationCallCount() int {
+	fake.recordFlagEvaluationMutex.RLock()
+	defer fake.recordFlagEvaluationMutex.RUnlock()
+	return len(fake.recordFlagEvaluationArgsForCall)
+}
+
+func (fake *FakeStore) RecordFlagEvaluationCalls(stub func(context.Context, uuid.UUID, uuid.UUID, time.Time) error) {
+	fake.recordFlagEvaluationMutex.Lock()
+	defer fake.recordFlagEvaluationMutex.Unlock()
+	fake.RecordFlagEvaluationStub = stub
+}
+
+func (fake *FakeStore) RecordFlagEvaluationArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, time.Time) {
+	fake.recordFlagEvaluationMutex.RLock()
+	defer fake.recordFlagEvaluationMutex.RUnlock()
+	argsForCall := fake.recordFlagEvaluationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStore) RecordFlagEvaluationReturns(result1 error) {
+	fake.recordFlagEvaluationMutex.Lock()
+	defer fake.recordFlagEvaluationMutex.Unlock()
+	fake.RecordFlagEvaluationStub = nil
+	fake.recordFlagEvaluationReturns = struct {
 		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) RecordFlagEvaluationReturnsOnCall(i int, result1 error) {
+	fake.recordFlagEvaluationMutex.Lock()
+	defer fake.recordFlagEvaluationMutex.Unlock()
+	fake.RecordFlagEvaluationStub = nil
+	if fake.recordFlagEvaluationReturnsOnCall == nil {
+		fake.recordFlagEvaluationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
+	fake.recordFlagEvaluationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStore) CreateFlag(arg1 context.Context, arg2 model.FeatureFlag) error {
-	fake.createFlagMutex.Lock()
-	ret, specificReturn := fake.createFlagReturnsOnCall[len(fake.createFlagArgsForCall)]
-	fake.createFlagArgsForCall = append(fake.createFlagArgsForCall, struct {
+func (fake *FakeStore) ReleaseKillSwitch(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.releaseKillSwitchMutex.Lock()
+	ret, specificReturn := fake.releaseKillSwitchReturnsOnCall[len(fake.releaseKillSwitchArgsForCall)]
+	fake.releaseKillSwitchArgsForCall = append(fake.releaseKillSwitchArgsForCall, struct {
 		arg1 context.Context
-		arg2 model.FeatureFlag
-	}{arg1, arg2})
-	stub := fake.CreateFlagStub
-	fakeReturns := fake.createFlagReturns
-	fake.recordInvocation("CreateFlag", []interface{}{arg1, arg2})
-	fake.createFlagMutex.Unlock()
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.ReleaseKillSwitchStub
+	fakeReturns := fake.releaseKillSwitchReturns
+	fake.recordInvocation("ReleaseKillSwitch", []interface{}{arg1, arg2, arg3})
+	fake.releaseKillSwitchMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -98,61 +2304,62 @@ func (fake *FakeStore) CreateFlag(arg1 context.Context, arg2 model.FeatureFlag)
 	return fakeReturns.result1
 }
 
-func (fake *FakeStore) CreateFlagCallCount() int {
-	fake.createFlagMutex.RLock()
-	defer fake.createFlagMutex.RUnlock()
-	return len(fake.createFlagArgsForCall)
+func (fake *FakeStore) ReleaseKillSwitchCallCount() int {
+	fake.releaseKillSwitchMutex.RLock()
+	defer fake.releaseKillSwitchMutex.RUnlock()
+	return len(fake.releaseKillSwitchArgsForCall)
 }
 
-func (fake *FakeStore) CreateFlagCalls(stub func(context.Context, model.FeatureFlag) error) {
-	fake.createFlagMutex.Lock()
-	defer fake.createFlagMutex.Unlock()
-	fake.CreateFlagStub = stub
+func (fake *FakeStore) ReleaseKillSwitchCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.releaseKillSwitchMutex.Lock()
+	defer fake.releaseKillSwitchMutex.Unlock()
+	fake.ReleaseKillSwitchStub = stub
 }
 
-func (fake *FakeStore) CreateFlagArgsForCall(i int) (context.Context, model.FeatureFlag) {
-	fake.createFlagMutex.RLock()
-	defer fake.createFlagMutex.RUnlock()
-	argsForCall := fake.createFlagArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStore) ReleaseKillSwitchArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.releaseKillSwitchMutex.RLock()
+	defer fake.releaseKillSwitchMutex.RUnlock()
+	argsForCall := fake.releaseKillSwitchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStore) CreateFlagReturns(result1 error) {
-	fake.createFlagMutex.Lock()
-	defer fake.createFlagMutex.Unlock()
-	fake.CreateFlagStub = nil
-	fake.createFlagReturns = struct {
+func (fake *FakeStore) ReleaseKillSwitchReturns(result1 error) {
+	fake.releaseKillSwitchMutex.Lock()
+	defer fake.releaseKillSwitchMutex.Unlock()
+	fake.ReleaseKillSwitchStub = nil
+	fake.releaseKillSwitchReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStore) CreateFlagReturnsOnCall(i int, result1 error) {
-	fake.createFlagMutex.Lock()
-	defer fake.createFlagMutex.Unlock()
-	fake.CreateFlagStub = nil
-	if fake.createFlagReturnsOnCall == nil {
-		fake.createFlagReturnsOnCall = make(map[int]struct {
+func (fake *FakeStore) ReleaseKillSwitchReturnsOnCall(i int, result1 error) {
+	fake.releaseKillSwitchMutex.Lock()
+	defer fake.releaseKillSwitchMutex.Unlock()
+	fake.ReleaseKillSwitchStub = nil
+	if fake.releaseKillSwitchReturnsOnCall == nil {
+		fake.releaseKillSwitchReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.createFlagReturnsOnCall[i] = struct {
+	fake.releaseKillSwitchReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStore) DeleteFlag(arg1 context.Context, arg2 uuid.UUID) error {
-	fake.deleteFlagMutex.Lock()
-	ret, specificReturn := fake.deleteFlagReturnsOnCall[len(fake.deleteFlagArgsForCall)]
-	fake.deleteFlagArgsForCall = append(fake.deleteFlagArgsForCall, struct {
+func (fake *FakeStore) RemoveGroupMember(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.removeGroupMemberMutex.Lock()
+	ret, specificReturn := fake.removeGroupMemberReturnsOnCall[len(fake.removeGroupMemberArgsForCall)]
+	fake.removeGroupMemberArgsForCall = append(fake.removeGroupMemberArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-	}{arg1, arg2})
-	stub := fake.DeleteFlagStub
-	fakeReturns := fake.deleteFlagReturns
-	fake.recordInvocation("DeleteFlag", []interface{}{arg1, arg2})
-	fake.deleteFlagMutex.Unlock()
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveGroupMemberStub
+	fakeReturns := fake.removeGroupMemberReturns
+	fake.recordInvocation("RemoveGroupMember", []interface{}{arg1, arg2, arg3})
+	fake.removeGroupMemberMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -160,175 +2367,182 @@ func (fake *FakeStore) DeleteFlag(arg1 context.Context, arg2 uuid.UUID) error {
 	return fakeReturns.result1
 }
 
-func (fake *FakeStore) DeleteFlagCallCount() int {
-	fake.deleteFlagMutex.RLock()
-	defer fake.deleteFlagMutex.RUnlock()
-	return len(fake.deleteFlagArgsForCall)
+func (fake *FakeStore) RemoveGroupMemberCallCount() int {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	return len(fake.removeGroupMemberArgsForCall)
 }
 
-func (fake *FakeStore) DeleteFlagCalls(stub func(context.Context, uuid.UUID) error) {
-	fake.deleteFlagMutex.Lock()
-	defer fake.deleteFlagMutex.Unlock()
-	fake.DeleteFlagStub = stub
+func (fake *FakeStore) RemoveGroupMemberCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.removeGroupMemberMutex.Lock()
+	defer fake.removeGroupMemberMutex.Unlock()
+	fake.RemoveGroupMemberStub = stub
 }
 
-func (fake *FakeStore) DeleteFlagArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.deleteFlagMutex.RLock()
-	defer fake.deleteFlagMutex.RUnlock()
-	argsForCall := fake.deleteFlagArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStore) RemoveGroupMemberArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	argsForCall := fake.removeGroupMemberArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStore) DeleteFlagReturns(result1 error) {
-	fake.deleteFlagMutex.Lock()
-	defer fake.deleteFlagMutex.Unlock()
-	fake.DeleteFlagStub = nil
-	fake.deleteFlagReturns = struct {
+func (fake *FakeStore) RemoveGroupMemberReturns(result1 error) {
+	fake.removeGroupMemberMutex.Lock()
+	defer fake.removeGroupMemberMutex.Unlock()
+	fake.RemoveGroupMemberStub = nil
+	fake.removeGroupMemberReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStore) DeleteFlagReturnsOnCall(i int, result1 error) {
-	fake.deleteFlagMutex.Lock()
-	defer fake.deleteFlagMutex.Unlock()
-	fake.DeleteFlagStub = nil
-	if fake.deleteFlagReturnsOnCall == nil {
-		fake.deleteFlagReturnsOnCall = make(map[int]struct {
+func (fake *FakeStore) RemoveGroupMemberReturnsOnCall(i int, result1 error) {
+	fake.removeGroupMemberMutex.Lock()
+	defer fake.removeGroupMemberMutex.Unlock()
+	fake.RemoveGroupMemberStub = nil
+	if fake.removeGroupMemberReturnsOnCall == nil {
+		fake.removeGroupMemberReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.deleteFlagReturnsOnCall[i] = struct {
+	fake.removeGroupMemberReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStore) GetFlagByID(arg1 context.Context, arg2 uuid.UUID) (model.FeatureFlag, error) {
-	fake.getFlagByIDMutex.Lock()
-	ret, specificReturn := fake.getFlagByIDReturnsOnCall[len(fake.getFlagByIDArgsForCall)]
-	fake.getFlagByIDArgsForCall = append(fake.getFlagByIDArgsForCall, struct {
+func (fake *FakeStore) ReplaceCodeReferences(arg1 context.Context, arg2 uuid.UUID, arg3 string, arg4 []model.CodeReference, arg5 uuid.UUID) error {
+	var arg4Copy []model.CodeReference
+	if arg4 != nil {
+		arg4Copy = make([]model.CodeReference, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.replaceCodeReferencesMutex.Lock()
+	ret, specificReturn := fake.replaceCodeReferencesReturnsOnCall[len(fake.replaceCodeReferencesArgsForCall)]
+	fake.replaceCodeReferencesArgsForCall = append(fake.replaceCodeReferencesArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-	}{arg1, arg2})
-	stub := fake.GetFlagByIDStub
-	fakeReturns := fake.getFlagByIDReturns
-	fake.recordInvocation("GetFlagByID", []interface{}{arg1, arg2})
-	fake.getFlagByIDMutex.Unlock()
+		arg3 string
+		arg4 []model.CodeReference
+		arg5 uuid.UUID
+	}{arg1, arg2, arg3, arg4Copy, arg5})
+	stub := fake.ReplaceCodeReferencesStub
+	fakeReturns := fake.replaceCodeReferencesReturns
+	fake.recordInvocation("ReplaceCodeReferences", []interface{}{arg1, arg2, arg3, arg4Copy, arg5})
+	fake.replaceCodeReferencesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStore) GetFlagByIDCallCount() int {
-	fake.getFlagByIDMutex.RLock()
-	defer fake.getFlagByIDMutex.RUnlock()
-	return len(fake.getFlagByIDArgsForCall)
+func (fake *FakeStore) ReplaceCodeReferencesCallCount() int {
+	fake.replaceCodeReferencesMutex.RLock()
+	defer fake.replaceCodeReferencesMutex.RUnlock()
+	return len(fake.replaceCodeReferencesArgsForCall)
 }
 
-func (fake *FakeStore) GetFlagByIDCalls(stub func(context.Context, uuid.UUID) (model.FeatureFlag, error)) {
-	fake.getFlagByIDMutex.Lock()
-	defer fake.getFlagByIDMutex.Unlock()
-	fake.GetFlagByIDStub = stub
+func (fake *FakeStore) ReplaceCodeReferencesCalls(stub func(context.Context, uuid.UUID, string, []model.CodeReference, uuid.UUID) error) {
+	fake.replaceCodeReferencesMutex.Lock()
+	defer fake.replaceCodeReferencesMutex.Unlock()
+	fake.ReplaceCodeReferencesStub = stub
 }
 
-func (fake *FakeStore) GetFlagByIDArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.getFlagByIDMutex.RLock()
-	defer fake.getFlagByIDMutex.RUnlock()
-	argsForCall := fake.getFlagByIDArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStore) ReplaceCodeReferencesArgsForCall(i int) (context.Context, uuid.UUID, string, []model.CodeReference, uuid.UUID) {
+	fake.replaceCodeReferencesMutex.RLock()
+	defer fake.replaceCodeReferencesMutex.RUnlock()
+	argsForCall := fake.replaceCodeReferencesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakeStore) GetFlagByIDReturns(result1 model.FeatureFlag, result2 error) {
-	fake.getFlagByIDMutex.Lock()
-	defer fake.getFlagByIDMutex.Unlock()
-	fake.GetFlagByIDStub = nil
-	fake.getFlagByIDReturns = struct {
-		result1 model.FeatureFlag
-		result2 error
-	}{result1, result2}
+func (fake *FakeStore) ReplaceCodeReferencesReturns(result1 error) {
+	fake.replaceCodeReferencesMutex.Lock()
+	defer fake.replaceCodeReferencesMutex.Unlock()
+	fake.ReplaceCodeReferencesStub = nil
+	fake.replaceCodeReferencesReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStore) GetFlagByIDReturnsOnCall(i int, result1 model.FeatureFlag, result2 error) {
-	fake.getFlagByIDMutex.Lock()
-	defer fake.getFlagByIDMutex.Unlock()
-	fake.GetFlagByIDStub = nil
-	if fake.getFlagByIDReturnsOnCall == nil {
-		fake.getFlagByIDReturnsOnCall = make(map[int]struct {
-			result1 model.FeatureFlag
-			result2 error
+func (fake *FakeStore) ReplaceCodeReferencesReturnsOnCall(i int, result1 error) {
+	fake.replaceCodeReferencesMutex.Lock()
+	defer fake.replaceCodeReferencesMutex.Unlock()
+	fake.ReplaceCodeReferencesStub = nil
+	if fake.replaceCodeReferencesReturnsOnCall == nil {
+		fake.replaceCodeReferencesReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.getFlagByIDReturnsOnCall[i] = struct {
-		result1 model.FeatureFlag
-		result2 error
-	}{result1, result2}
+	fake.replaceCodeReferencesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStore) ListFlags(arg1 context.Context) ([]model.FeatureFlag, error) {
-	fake.listFlagsMutex.Lock()
-	ret, specificReturn := fake.listFlagsReturnsOnCall[len(fake.listFlagsArgsForCall)]
-	fake.listFlagsArgsForCall = append(fake.listFlagsArgsForCall, struct {
+func (fake *FakeStore) ReviewChangeRequest(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 model.ChangeRequestStatus, arg5 uuid.UUID, arg6 string) error {
+	fake.reviewChangeRequestMutex.Lock()
+	ret, specificReturn := fake.reviewChangeRequestReturnsOnCall[len(fake.reviewChangeRequestArgsForCall)]
+	fake.reviewChangeRequestArgsForCall = append(fake.reviewChangeRequestArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.ListFlagsStub
-	fakeReturns := fake.listFlagsReturns
-	fake.recordInvocation("ListFlags", []interface{}{arg1})
-	fake.listFlagsMutex.Unlock()
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 model.ChangeRequestStatus
+		arg5 uuid.UUID
+		arg6 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.ReviewChangeRequestStub
+	fakeReturns := fake.reviewChangeRequestReturns
+	fake.recordInvocation("ReviewChangeRequest", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.reviewChangeRequestMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStore) ListFlagsCallCount() int {
-	fake.listFlagsMutex.RLock()
-	defer fake.listFlagsMutex.RUnlock()
-	return len(fake.listFlagsArgsForCall)
+func (fake *FakeStore) ReviewChangeRequestCallCount() int {
+	fake.reviewChangeRequestMutex.RLock()
+	defer fake.reviewChangeRequestMutex.RUnlock()
+	return len(fake.reviewChangeRequestArgsForCall)
 }
 
-func (fake *FakeStore) ListFlagsCalls(stub func(context.Context) ([]model.FeatureFlag, error)) {
-	fake.listFlagsMutex.Lock()
-	defer fake.listFlagsMutex.Unlock()
-	fake.ListFlagsStub = stub
+func (fake *FakeStore) ReviewChangeRequestCalls(stub func(context.Context, uuid.UUID, uuid.UUID, model.ChangeRequestStatus, uuid.UUID, string) error) {
+	fake.reviewChangeRequestMutex.Lock()
+	defer fake.reviewChangeRequestMutex.Unlock()
+	fake.ReviewChangeRequestStub = stub
 }
 
-func (fake *FakeStore) ListFlagsArgsForCall(i int) context.Context {
-	fake.listFlagsMutex.RLock()
-	defer fake.listFlagsMutex.RUnlock()
-	argsForCall := fake.listFlagsArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStore) ReviewChangeRequestArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, model.ChangeRequestStatus, uuid.UUID, string) {
+	fake.reviewChangeRequestMutex.RLock()
+	defer fake.reviewChangeRequestMutex.RUnlock()
+	argsForCall := fake.reviewChangeRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
-func (fake *FakeStore) ListFlagsReturns(result1 []model.FeatureFlag, result2 error) {
-	fake.listFlagsMutex.Lock()
-	defer fake.listFlagsMutex.Unlock()
-	fake.ListFlagsStub = nil
-	fake.listFlagsReturns = struct {
-		result1 []model.FeatureFlag
-		result2 error
-	}{result1, result2}
+func (fake *FakeStore) ReviewChangeRequestReturns(result1 error) {
+	fake.reviewChangeRequestMutex.Lock()
+	defer fake.reviewChangeRequestMutex.Unlock()
+	fake.ReviewChangeRequestStub = nil
+	fake.reviewChangeRequestReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStore) ListFlagsReturnsOnCall(i int, result1 []model.FeatureFlag, result2 error) {
-	fake.listFlagsMutex.Lock()
-	defer fake.listFlagsMutex.Unlock()
-	fake.ListFlagsStub = nil
-	if fake.listFlagsReturnsOnCall == nil {
-		fake.listFlagsReturnsOnCall = make(map[int]struct {
-			result1 []model.FeatureFlag
-			result2 error
+func (fake *FakeStore) ReviewChangeRequestReturnsOnCall(i int, result1 error) {
+	fake.reviewChangeRequestMutex.Lock()
+	defer fake.reviewChangeRequestMutex.Unlock()
+	fake.ReviewChangeRequestStub = nil
+	if fake.reviewChangeRequestReturnsOnCall == nil {
+		fake.reviewChangeRequestReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.listFlagsReturnsOnCall[i] = struct {
-		result1 []model.FeatureFlag
-		result2 error
-	}{result1, result2}
+	fake.reviewChangeRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
 func (fake *FakeStore) UpdateFlag(arg1 context.Context, arg2 model.FeatureFlag) error {
@@ -393,6 +2607,68 @@ func (fake *FakeStore) UpdateFlagReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStore) UpdateGroup(arg1 context.Context, arg2 model.Group) error {
+	fake.updateGroupMutex.Lock()
+	ret, specificReturn := fake.updateGroupReturnsOnCall[len(fake.updateGroupArgsForCall)]
+	fake.updateGroupArgsForCall = append(fake.updateGroupArgsForCall, struct {
+		arg1 context.Context
+		arg2 model.Group
+	}{arg1, arg2})
+	stub := fake.UpdateGroupStub
+	fakeReturns := fake.updateGroupReturns
+	fake.recordInvocation("UpdateGroup", []interface{}{arg1, arg2})
+	fake.updateGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) UpdateGroupCallCount() int {
+	fake.updateGroupMutex.RLock()
+	defer fake.updateGroupMutex.RUnlock()
+	return len(fake.updateGroupArgsForCall)
+}
+
+func (fake *FakeStore) UpdateGroupCalls(stub func(context.Context, model.Group) error) {
+	fake.updateGroupMutex.Lock()
+	defer fake.updateGroupMutex.Unlock()
+	fake.UpdateGroupStub = stub
+}
+
+func (fake *FakeStore) UpdateGroupArgsForCall(i int) (context.Context, model.Group) {
+	fake.updateGroupMutex.RLock()
+	defer fake.updateGroupMutex.RUnlock()
+	argsForCall := fake.updateGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) UpdateGroupReturns(result1 error) {
+	fake.updateGroupMutex.Lock()
+	defer fake.updateGroupMutex.Unlock()
+	fake.UpdateGroupStub = nil
+	fake.updateGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) UpdateGroupReturnsOnCall(i int, result1 error) {
+	fake.updateGroupMutex.Lock()
+	defer fake.updateGroupMutex.Unlock()
+	fake.UpdateGroupStub = nil
+	if fake.updateGroupReturnsOnCall == nil {
+		fake.updateGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStore) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
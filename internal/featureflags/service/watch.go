@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+const watchBufferSize = 64
+
+type changeBroker struct {
+	mu          sync.Mutex
+	subscribers map[chan model.FlagChange]uuid.UUID
+}
+
+func newChangeBroker() *changeBroker {
+	return &changeBroker{subscribers: map[chan model.FlagChange]uuid.UUID{}}
+}
+
+// WatchFlags returns a channel that receives every change to the flags of the organization made
+// through this service from now on, limited to the flags the caller may read. The channel is
+// closed once the context is canceled, or earlier if the watcher falls so far behind that changes
+// would have to be dropped. Watches without a caller are rejected.
+func (s *Service) WatchFlags(ctx context.Context, orgID uuid.UUID) (<-chan model.FlagChange, error) {
+	principal, ok := model.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, model.ErrNoPrincipal
+	}
+
+	changes := make(chan model.FlagChange, watchBufferSize)
+	s.changes.subscribe(orgID, changes)
+
+	go func() {
+		<-ctx.Done()
+		s.changes.unsubscribe(changes)
+	}()
+
+	if !principal.Unrestricted {
+		return s.readableChanges(ctx, orgID, changes), nil
+	}
+	return changes, nil
+}
+
+// readableChanges forwards the changes of the flags the caller may read under the access rules
+// at the time of the change. Kill switch changes concern every flag and are always forwarded.
+func (s *Service) readableChanges(ctx context.Context, orgID uuid.UUID, changes <-chan model.FlagChange) <-chan model.FlagChange {
+	readable := make(chan model.FlagChange, watchBufferSize)
+
+	go func() {
+		defer close(readable)
+		for change := range changes {
+			if change.Type != model.FlagChangeKillSwitch {
+				access, err := s.flagAccess(ctx, orgID)
+				if err != nil {
+					s.logger.ErrorContext(ctx, "failed to check access to a changed flag", "flag_id", change.Flag.ID, "error", err)
+					continue
+				}
+				if !access.allows(change.Flag, model.PermissionRead) {
+					continue
+				}
+			}
+
+			select {
+			case readable <- change:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return readable
+}
+
+func (b *changeBroker) subscribe(orgID uuid.UUID, changes chan model.FlagChange) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers[changes] = orgID
+}
+
+func (b *changeBroker) unsubscribe(changes chan model.FlagChange) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subscribers[changes]; ok {
+		delete(b.subscribers, changes)
+		close(changes)
+	}
+}
+
+func (b *changeBroker) publish(change model.FlagChange) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for changes, orgID := range b.subscribers {
+		if orgID != change.OrgID {
+			continue
+		}
+		select {
+		case changes <- change:
+		default:
+			delete(b.subscribers, changes)
+			close(changes)
+		}
+	}
+}
+
+func (s *Service) publishAppliedChangeRequest(ctx context.Context, changeRequest model.ChangeRequest) {
+	if changeRequest.Action == model.ChangeRequestActionDelete {
+		s.changes.publish(model.FlagChange{
+			Type:  model.FlagChangeDeleted,
+			OrgID: changeRequest.OrgID,
+			Flag:  model.FeatureFlag{ID: changeRequest.FlagID, OrgID: changeRequest.OrgID},
+		})
+		return
+	}
+
+	flag, err := s.store.GetFlagByID(ctx, changeRequest.OrgID, changeRequest.FlagID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to fetch flag after applying change request",
+			"flag_id", changeRequest.FlagID, "change_request_id", changeRequest.ID, "error", err)
+		return
+	}
+	s.changes.publish(model.FlagChange{Type: model.FlagChangeUpdated, OrgID: changeRequest.OrgID, Flag: flag})
+}
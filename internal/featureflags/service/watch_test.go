@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service/servicefakes"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("WatchFlags", func() {
+	var (
+		ctx     context.Context
+		cancel  context.CancelFunc
+		svc     *service.Service
+		store   *servicefakes.FakeStore
+		changes <-chan model.FlagChange
+
+		existingFlag model.FeatureFlag
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(adminContext())
+		store = &servicefakes.FakeStore{}
+		svc = service.NewService(store, slog.New(slog.DiscardHandler))
+
+		existingFlag = model.FeatureFlag{ID: uuid.New(), OrgID: orgID, Key: "dark_mode"}
+		store.GetFlagByIDReturns(existingFlag, nil)
+
+		var err error
+		changes, err = svc.WatchFlags(ctx, orgID)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("rejects watches without a caller", func() {
+		_, err := svc.WatchFlags(context.Background(), orgID)
+		Expect(err).To(MatchError(model.ErrNoPrincipal))
+	})
+
+	It("receives created flags", func() {
+		flagID, err := svc.CreateFlag(ctx, orgID, model.FeatureFlagRequest{Key: "new_checkout", Description: "description"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(changes).Should(Receive(MatchFields(IgnoreExtras, Fields{
+			"Type": Equal(model.FlagChangeCreated),
+			"Flag": MatchFields(IgnoreExtras, Fields{"ID": Equal(flagID), "Key": Equal("new_checkout")}),
+		})))
+	})
+
+	It("receives updated flags", func() {
+		Expect(svc.UpdateFlag(ctx, orgID, existingFlag.ID, model.FeatureFlagRequest{Key: "dark_mode", Enabled: true})).To(Succeed())
+
+		Eventually(changes).Should(Receive(MatchFields(IgnoreExtras, Fields{
+			"Type": Equal(model.FlagChangeUpdated),
+			"Flag": MatchFields(IgnoreExtras, Fields{"ID": Equal(existingFlag.ID), "Enabled": BeTrue()}),
+		})))
+	})
+
+	It("receives deleted flags", func() {
+		Expect(svc.DeleteFlag(ctx, orgID, existingFlag.ID)).To(Succeed())
+
+		Eventually(changes).Should(Receive(Equal(model.FlagChange{Type: model.FlagChangeDeleted, OrgID: orgID, Flag: existingFlag})))
+	})
+
+	It("receives flags changed by applied change requests", func() {
+		store.GetActiveKillSwitchReturns(model.KillSwitch{}, model.ErrKillSwitchNotActive)
+		store.ListDueChangeRequestsReturns([]model.ChangeRequest{{
+			ID:     uuid.New(),
+			OrgID:  orgID,
+			FlagID: existingFlag.ID,
+			Action: model.ChangeRequestActionUpdate,
+			Status: model.ChangeRequestStatusApproved,
+		}}, nil)
+
+		_, err := svc.ApplyDueChangeRequests(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(changes).Should(Receive(Equal(model.FlagChange{Type: model.FlagChangeUpdated, OrgID: orgID, Flag: existingFlag})))
+	})
+
+	It("receives kill switch changes", func() {
+		_, err := svc.ActivateKillSwitch(ctx, orgID, uuid.New(), model.KillSwitchRequest{Reason: "outage", Tag: "payments"})
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(changes).Should(Receive(Equal(model.FlagChange{
+			Type:             model.FlagChangeKillSwitch,
+			OrgID:            orgID,
+			KillSwitchActive: true,
+			KillSwitchTag:    "payments",
+		})))
+
+		Expect(svc.ReleaseKillSwitch(ctx, orgID, uuid.New())).To(Succeed())
+		Eventually(changes).Should(Receive(Equal(model.FlagChange{Type: model.FlagChangeKillSwitch, OrgID: orgID})))
+	})
+
+	It("does not receive changes of other organizations", func() {
+		_, err := svc.CreateFlag(ctx, uuid.New(), model.FeatureFlagRequest{Key: "new_checkout", Description: "description"})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = svc.ActivateKillSwitch(ctx, uuid.New(), uuid.New(), model.KillSwitchRequest{Reason: "outage"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Consistently(changes).ShouldNot(Receive())
+	})
+
+	It("does not receive failed writes", func() {
+		store.DeleteFlagReturns(ErrDatabaseError)
+		Expect(svc.DeleteFlag(ctx, orgID, existingFlag.ID)).ToNot(Succeed())
+
+		Consistently(changes).ShouldNot(Receive())
+	})
+
+	It("closes the channel when the context is canceled", func() {
+		cancel()
+
+		Eventually(changes).Should(BeClosed())
+	})
+
+	It("closes the channel of a watcher that falls behind", func() {
+		for range 100 {
+			Expect(svc.DeleteFlag(ctx, orgID, existingFlag.ID)).To(Succeed())
+		}
+
+		Eventually(func() bool {
+			_, ok := <-changes
+			return ok
+		}).Should(BeFalse())
+	})
+})
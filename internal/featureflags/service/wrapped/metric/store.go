@@ -41,6 +41,180 @@ func NewStoreWithMetrics(base _sourceService.Store) *StoreWithMetrics {
 	}
 }
 
+func (_d *StoreWithMetrics) AddGroupMember(ctx context.Context, groupID uuid.UUID, userID uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "AddGroupMember"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "AddGroupMember")))
+	}()
+	return _d.base.AddGroupMember(ctx, groupID, userID)
+}
+
+func (_d *StoreWithMetrics) ApplyChangeRequest(ctx context.Context, changeRequest model.ChangeRequest, appliedBy *uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ApplyChangeRequest"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ApplyChangeRequest")))
+	}()
+	return _d.base.ApplyChangeRequest(ctx, changeRequest, appliedBy)
+}
+
+func (_d *StoreWithMetrics) CancelChangeRequest(ctx context.Context, orgID uuid.UUID, id uuid.UUID, userID uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CancelChangeRequest"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CancelChangeRequest")))
+	}()
+	return _d.base.CancelChangeRequest(ctx, orgID, id, userID)
+}
+
+func (_d *StoreWithMetrics) CountCodeReferences(ctx context.Context, orgID uuid.UUID) (m1 map[string]int, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CountCodeReferences"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CountCodeReferences")))
+	}()
+	return _d.base.CountCodeReferences(ctx, orgID)
+}
+
+func (_d *StoreWithMetrics) CreateAccessRule(ctx context.Context, rule model.AccessRule) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CreateAccessRule"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateAccessRule")))
+	}()
+	return _d.base.CreateAccessRule(ctx, rule)
+}
+
+func (_d *StoreWithMetrics) CreateChangeRequest(ctx context.Context, changeRequest model.ChangeRequest) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CreateChangeRequest"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateChangeRequest")))
+	}()
+	return _d.base.CreateChangeRequest(ctx, changeRequest)
+}
+
 func (_d *StoreWithMetrics) CreateFlag(ctx context.Context, flag model.FeatureFlag) (err error) {
 	startTime := time.Now()
 
@@ -60,17 +234,423 @@ func (_d *StoreWithMetrics) CreateFlag(ctx context.Context, flag model.FeatureFl
 
 		_d.metrics.RequestCounter.Add(metricCtx, 1,
 			metric.WithAttributes(
-				attribute.String("method", "CreateFlag"),
+				attribute.String("method", "CreateFlag"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateFlag")))
+	}()
+	return _d.base.CreateFlag(ctx, flag)
+}
+
+func (_d *StoreWithMetrics) CreateGroup(ctx context.Context, group model.Group) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CreateGroup"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateGroup")))
+	}()
+	return _d.base.CreateGroup(ctx, group)
+}
+
+func (_d *StoreWithMetrics) CreateKillSwitch(ctx context.Context, killSwitch model.KillSwitch) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "CreateKillSwitch"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateKillSwitch")))
+	}()
+	return _d.base.CreateKillSwitch(ctx, killSwitch)
+}
+
+func (_d *StoreWithMetrics) DeleteAccessRule(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "DeleteAccessRule"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "DeleteAccessRule")))
+	}()
+	return _d.base.DeleteAccessRule(ctx, orgID, id)
+}
+
+func (_d *StoreWithMetrics) DeleteFlag(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "DeleteFlag"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "DeleteFlag")))
+	}()
+	return _d.base.DeleteFlag(ctx, orgID, id)
+}
+
+func (_d *StoreWithMetrics) DeleteGroup(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "DeleteGroup"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "DeleteGroup")))
+	}()
+	return _d.base.DeleteGroup(ctx, orgID, id)
+}
+
+func (_d *StoreWithMetrics) GetActiveKillSwitch(ctx context.Context, orgID uuid.UUID) (k1 model.KillSwitch, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetActiveKillSwitch"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetActiveKillSwitch")))
+	}()
+	return _d.base.GetActiveKillSwitch(ctx, orgID)
+}
+
+func (_d *StoreWithMetrics) GetChangeRequestByID(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (c2 model.ChangeRequest, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetChangeRequestByID"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetChangeRequestByID")))
+	}()
+	return _d.base.GetChangeRequestByID(ctx, orgID, id)
+}
+
+func (_d *StoreWithMetrics) GetFlagByID(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (f1 model.FeatureFlag, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetFlagByID"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetFlagByID")))
+	}()
+	return _d.base.GetFlagByID(ctx, orgID, id)
+}
+
+func (_d *StoreWithMetrics) GetFlagByKey(ctx context.Context, orgID uuid.UUID, key string) (f1 model.FeatureFlag, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetFlagByKey"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetFlagByKey")))
+	}()
+	return _d.base.GetFlagByKey(ctx, orgID, key)
+}
+
+func (_d *StoreWithMetrics) GetGroupByID(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (g1 model.Group, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "GetGroupByID"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetGroupByID")))
+	}()
+	return _d.base.GetGroupByID(ctx, orgID, id)
+}
+
+func (_d *StoreWithMetrics) HasCodeReferenceScans(ctx context.Context, orgID uuid.UUID) (b1 bool, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "HasCodeReferenceScans"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "HasCodeReferenceScans")))
+	}()
+	return _d.base.HasCodeReferenceScans(ctx, orgID)
+}
+
+func (_d *StoreWithMetrics) ListAccessRules(ctx context.Context, orgID uuid.UUID) (aa1 []model.AccessRule, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListAccessRules"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListAccessRules")))
+	}()
+	return _d.base.ListAccessRules(ctx, orgID)
+}
+
+func (_d *StoreWithMetrics) ListChangeRequests(ctx context.Context, orgID uuid.UUID, status model.ChangeRequestStatus) (ca1 []model.ChangeRequest, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListChangeRequests"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListChangeRequests")))
+	}()
+	return _d.base.ListChangeRequests(ctx, orgID, status)
+}
+
+func (_d *StoreWithMetrics) ListCodeReferences(ctx context.Context, orgID uuid.UUID, key string) (ca1 []model.CodeReference, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListCodeReferences"),
 				attribute.String("status", result),
 			),
 		)
 		duration := float64(time.Since(startTime).Milliseconds())
-		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "CreateFlag")))
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListCodeReferences")))
 	}()
-	return _d.base.CreateFlag(ctx, flag)
+	return _d.base.ListCodeReferences(ctx, orgID, key)
 }
 
-func (_d *StoreWithMetrics) DeleteFlag(ctx context.Context, id uuid.UUID) (err error) {
+func (_d *StoreWithMetrics) ListDueChangeRequests(ctx context.Context, now time.Time) (ca1 []model.ChangeRequest, err error) {
 	startTime := time.Now()
 
 	var metricCtx context.Context
@@ -89,17 +669,17 @@ func (_d *StoreWithMetrics) DeleteFlag(ctx context.Context, id uuid.UUID) (err e
 
 		_d.metrics.RequestCounter.Add(metricCtx, 1,
 			metric.WithAttributes(
-				attribute.String("method", "DeleteFlag"),
+				attribute.String("method", "ListDueChangeRequests"),
 				attribute.String("status", result),
 			),
 		)
 		duration := float64(time.Since(startTime).Milliseconds())
-		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "DeleteFlag")))
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListDueChangeRequests")))
 	}()
-	return _d.base.DeleteFlag(ctx, id)
+	return _d.base.ListDueChangeRequests(ctx, now)
 }
 
-func (_d *StoreWithMetrics) GetFlagByID(ctx context.Context, id uuid.UUID) (f1 model.FeatureFlag, err error) {
+func (_d *StoreWithMetrics) ListExpiringFlags(ctx context.Context, before time.Time) (fa1 []model.FeatureFlag, err error) {
 	startTime := time.Now()
 
 	var metricCtx context.Context
@@ -118,17 +698,17 @@ func (_d *StoreWithMetrics) GetFlagByID(ctx context.Context, id uuid.UUID) (f1 m
 
 		_d.metrics.RequestCounter.Add(metricCtx, 1,
 			metric.WithAttributes(
-				attribute.String("method", "GetFlagByID"),
+				attribute.String("method", "ListExpiringFlags"),
 				attribute.String("status", result),
 			),
 		)
 		duration := float64(time.Since(startTime).Milliseconds())
-		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "GetFlagByID")))
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListExpiringFlags")))
 	}()
-	return _d.base.GetFlagByID(ctx, id)
+	return _d.base.ListExpiringFlags(ctx, before)
 }
 
-func (_d *StoreWithMetrics) ListFlags(ctx context.Context) (fa1 []model.FeatureFlag, err error) {
+func (_d *StoreWithMetrics) ListFlags(ctx context.Context, orgID uuid.UUID, filter model.FlagFilter) (fa1 []model.FeatureFlag, err error) {
 	startTime := time.Now()
 
 	var metricCtx context.Context
@@ -154,7 +734,239 @@ func (_d *StoreWithMetrics) ListFlags(ctx context.Context) (fa1 []model.FeatureF
 		duration := float64(time.Since(startTime).Milliseconds())
 		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListFlags")))
 	}()
-	return _d.base.ListFlags(ctx)
+	return _d.base.ListFlags(ctx, orgID, filter)
+}
+
+func (_d *StoreWithMetrics) ListGroupMembers(ctx context.Context, groupID uuid.UUID) (ga1 []model.GroupMember, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListGroupMembers"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListGroupMembers")))
+	}()
+	return _d.base.ListGroupMembers(ctx, groupID)
+}
+
+func (_d *StoreWithMetrics) ListGroups(ctx context.Context, orgID uuid.UUID) (ga1 []model.Group, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListGroups"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListGroups")))
+	}()
+	return _d.base.ListGroups(ctx, orgID)
+}
+
+func (_d *StoreWithMetrics) ListUserGroupIDs(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) (ua1 []uuid.UUID, err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ListUserGroupIDs"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ListUserGroupIDs")))
+	}()
+	return _d.base.ListUserGroupIDs(ctx, orgID, userID)
+}
+
+func (_d *StoreWithMetrics) RecordFlagEvaluation(ctx context.Context, orgID uuid.UUID, id uuid.UUID, evaluatedAt time.Time) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "RecordFlagEvaluation"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "RecordFlagEvaluation")))
+	}()
+	return _d.base.RecordFlagEvaluation(ctx, orgID, id, evaluatedAt)
+}
+
+func (_d *StoreWithMetrics) ReleaseKillSwitch(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ReleaseKillSwitch"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ReleaseKillSwitch")))
+	}()
+	return _d.base.ReleaseKillSwitch(ctx, orgID, userID)
+}
+
+func (_d *StoreWithMetrics) RemoveGroupMember(ctx context.Context, groupID uuid.UUID, userID uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "RemoveGroupMember"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "RemoveGroupMember")))
+	}()
+	return _d.base.RemoveGroupMember(ctx, groupID, userID)
+}
+
+func (_d *StoreWithMetrics) ReplaceCodeReferences(ctx context.Context, orgID uuid.UUID, repository string, references []model.CodeReference, scannedBy uuid.UUID) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ReplaceCodeReferences"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ReplaceCodeReferences")))
+	}()
+	return _d.base.ReplaceCodeReferences(ctx, orgID, repository, references, scannedBy)
+}
+
+func (_d *StoreWithMetrics) ReviewChangeRequest(ctx context.Context, orgID uuid.UUID, id uuid.UUID, status model.ChangeRequestStatus, reviewerID uuid.UUID, comment string) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "ReviewChangeRequest"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "ReviewChangeRequest")))
+	}()
+	return _d.base.ReviewChangeRequest(ctx, orgID, id, status, reviewerID, comment)
 }
 
 func (_d *StoreWithMetrics) UpdateFlag(ctx context.Context, flag model.FeatureFlag) (err error) {
@@ -185,3 +997,32 @@ func (_d *StoreWithMetrics) UpdateFlag(ctx context.Context, flag model.FeatureFl
 	}()
 	return _d.base.UpdateFlag(ctx, flag)
 }
+
+func (_d *StoreWithMetrics) UpdateGroup(ctx context.Context, group model.Group) (err error) {
+	startTime := time.Now()
+
+	var metricCtx context.Context
+
+	metricCtx = ctx
+
+	if metricCtx == nil {
+		metricCtx = context.Background()
+	}
+
+	defer func() {
+		result := "ok"
+		if err != nil {
+			result = "error"
+		}
+
+		_d.metrics.RequestCounter.Add(metricCtx, 1,
+			metric.WithAttributes(
+				attribute.String("method", "UpdateGroup"),
+				attribute.String("status", result),
+			),
+		)
+		duration := float64(time.Since(startTime).Milliseconds())
+		_d.metrics.RequestDuration.Record(metricCtx, duration, metric.WithAttributes(attribute.String("method", "UpdateGroup")))
+	}()
+	return _d.base.UpdateGroup(ctx, group)
+}
@@ -6,6 +6,7 @@ package trace
 
 import (
 	"context"
+	"time"
 
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
 	_sourceService "github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
@@ -34,6 +35,108 @@ func NewStoreWithTracing(base _sourceService.Store) StoreWithTracing {
 	return d
 }
 
+// AddGroupMember implements Store
+func (_d StoreWithTracing) AddGroupMember(ctx context.Context, groupID uuid.UUID, userID uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.AddGroupMember")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.AddGroupMember(ctx, groupID, userID)
+}
+
+// ApplyChangeRequest implements Store
+func (_d StoreWithTracing) ApplyChangeRequest(ctx context.Context, changeRequest model.ChangeRequest, appliedBy *uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ApplyChangeRequest")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ApplyChangeRequest(ctx, changeRequest, appliedBy)
+}
+
+// CancelChangeRequest implements Store
+func (_d StoreWithTracing) CancelChangeRequest(ctx context.Context, orgID uuid.UUID, id uuid.UUID, userID uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CancelChangeRequest")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CancelChangeRequest(ctx, orgID, id, userID)
+}
+
+// CountCodeReferences implements Store
+func (_d StoreWithTracing) CountCodeReferences(ctx context.Context, orgID uuid.UUID) (m1 map[string]int, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CountCodeReferences")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CountCodeReferences(ctx, orgID)
+}
+
+// CreateAccessRule implements Store
+func (_d StoreWithTracing) CreateAccessRule(ctx context.Context, rule model.AccessRule) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CreateAccessRule")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CreateAccessRule(ctx, rule)
+}
+
+// CreateChangeRequest implements Store
+func (_d StoreWithTracing) CreateChangeRequest(ctx context.Context, changeRequest model.ChangeRequest) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CreateChangeRequest")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CreateChangeRequest(ctx, changeRequest)
+}
+
 // CreateFlag implements Store
 func (_d StoreWithTracing) CreateFlag(ctx context.Context, flag model.FeatureFlag) (err error) {
 	ctx, _span := _d.tracer.Start(ctx, "Store.CreateFlag")
@@ -51,8 +154,59 @@ func (_d StoreWithTracing) CreateFlag(ctx context.Context, flag model.FeatureFla
 	return _d.Store.CreateFlag(ctx, flag)
 }
 
+// CreateGroup implements Store
+func (_d StoreWithTracing) CreateGroup(ctx context.Context, group model.Group) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CreateGroup")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CreateGroup(ctx, group)
+}
+
+// CreateKillSwitch implements Store
+func (_d StoreWithTracing) CreateKillSwitch(ctx context.Context, killSwitch model.KillSwitch) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.CreateKillSwitch")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.CreateKillSwitch(ctx, killSwitch)
+}
+
+// DeleteAccessRule implements Store
+func (_d StoreWithTracing) DeleteAccessRule(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.DeleteAccessRule")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.DeleteAccessRule(ctx, orgID, id)
+}
+
 // DeleteFlag implements Store
-func (_d StoreWithTracing) DeleteFlag(ctx context.Context, id uuid.UUID) (err error) {
+func (_d StoreWithTracing) DeleteFlag(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (err error) {
 	ctx, _span := _d.tracer.Start(ctx, "Store.DeleteFlag")
 	defer func() {
 		if err != nil {
@@ -65,11 +219,62 @@ func (_d StoreWithTracing) DeleteFlag(ctx context.Context, id uuid.UUID) (err er
 		}
 		_span.End()
 	}()
-	return _d.Store.DeleteFlag(ctx, id)
+	return _d.Store.DeleteFlag(ctx, orgID, id)
+}
+
+// DeleteGroup implements Store
+func (_d StoreWithTracing) DeleteGroup(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.DeleteGroup")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.DeleteGroup(ctx, orgID, id)
+}
+
+// GetActiveKillSwitch implements Store
+func (_d StoreWithTracing) GetActiveKillSwitch(ctx context.Context, orgID uuid.UUID) (k1 model.KillSwitch, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.GetActiveKillSwitch")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.GetActiveKillSwitch(ctx, orgID)
+}
+
+// GetChangeRequestByID implements Store
+func (_d StoreWithTracing) GetChangeRequestByID(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (c2 model.ChangeRequest, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.GetChangeRequestByID")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.GetChangeRequestByID(ctx, orgID, id)
 }
 
 // GetFlagByID implements Store
-func (_d StoreWithTracing) GetFlagByID(ctx context.Context, id uuid.UUID) (f1 model.FeatureFlag, err error) {
+func (_d StoreWithTracing) GetFlagByID(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (f1 model.FeatureFlag, err error) {
 	ctx, _span := _d.tracer.Start(ctx, "Store.GetFlagByID")
 	defer func() {
 		if err != nil {
@@ -82,11 +287,147 @@ func (_d StoreWithTracing) GetFlagByID(ctx context.Context, id uuid.UUID) (f1 mo
 		}
 		_span.End()
 	}()
-	return _d.Store.GetFlagByID(ctx, id)
+	return _d.Store.GetFlagByID(ctx, orgID, id)
+}
+
+// GetFlagByKey implements Store
+func (_d StoreWithTracing) GetFlagByKey(ctx context.Context, orgID uuid.UUID, key string) (f1 model.FeatureFlag, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.GetFlagByKey")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.GetFlagByKey(ctx, orgID, key)
+}
+
+// GetGroupByID implements Store
+func (_d StoreWithTracing) GetGroupByID(ctx context.Context, orgID uuid.UUID, id uuid.UUID) (g1 model.Group, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.GetGroupByID")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.GetGroupByID(ctx, orgID, id)
+}
+
+// HasCodeReferenceScans implements Store
+func (_d StoreWithTracing) HasCodeReferenceScans(ctx context.Context, orgID uuid.UUID) (b1 bool, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.HasCodeReferenceScans")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.HasCodeReferenceScans(ctx, orgID)
+}
+
+// ListAccessRules implements Store
+func (_d StoreWithTracing) ListAccessRules(ctx context.Context, orgID uuid.UUID) (aa1 []model.AccessRule, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListAccessRules")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListAccessRules(ctx, orgID)
+}
+
+// ListChangeRequests implements Store
+func (_d StoreWithTracing) ListChangeRequests(ctx context.Context, orgID uuid.UUID, status model.ChangeRequestStatus) (ca1 []model.ChangeRequest, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListChangeRequests")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListChangeRequests(ctx, orgID, status)
+}
+
+// ListCodeReferences implements Store
+func (_d StoreWithTracing) ListCodeReferences(ctx context.Context, orgID uuid.UUID, key string) (ca1 []model.CodeReference, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListCodeReferences")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListCodeReferences(ctx, orgID, key)
+}
+
+// ListDueChangeRequests implements Store
+func (_d StoreWithTracing) ListDueChangeRequests(ctx context.Context, now time.Time) (ca1 []model.ChangeRequest, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListDueChangeRequests")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListDueChangeRequests(ctx, now)
+}
+
+// ListExpiringFlags implements Store
+func (_d StoreWithTracing) ListExpiringFlags(ctx context.Context, before time.Time) (fa1 []model.FeatureFlag, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListExpiringFlags")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListExpiringFlags(ctx, before)
 }
 
 // ListFlags implements Store
-func (_d StoreWithTracing) ListFlags(ctx context.Context) (fa1 []model.FeatureFlag, err error) {
+func (_d StoreWithTracing) ListFlags(ctx context.Context, orgID uuid.UUID, filter model.FlagFilter) (fa1 []model.FeatureFlag, err error) {
 	ctx, _span := _d.tracer.Start(ctx, "Store.ListFlags")
 	defer func() {
 		if err != nil {
@@ -99,7 +440,143 @@ func (_d StoreWithTracing) ListFlags(ctx context.Context) (fa1 []model.FeatureFl
 		}
 		_span.End()
 	}()
-	return _d.Store.ListFlags(ctx)
+	return _d.Store.ListFlags(ctx, orgID, filter)
+}
+
+// ListGroupMembers implements Store
+func (_d StoreWithTracing) ListGroupMembers(ctx context.Context, groupID uuid.UUID) (ga1 []model.GroupMember, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListGroupMembers")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListGroupMembers(ctx, groupID)
+}
+
+// ListGroups implements Store
+func (_d StoreWithTracing) ListGroups(ctx context.Context, orgID uuid.UUID) (ga1 []model.Group, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListGroups")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListGroups(ctx, orgID)
+}
+
+// ListUserGroupIDs implements Store
+func (_d StoreWithTracing) ListUserGroupIDs(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) (ua1 []uuid.UUID, err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ListUserGroupIDs")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ListUserGroupIDs(ctx, orgID, userID)
+}
+
+// RecordFlagEvaluation implements Store
+func (_d StoreWithTracing) RecordFlagEvaluation(ctx context.Context, orgID uuid.UUID, id uuid.UUID, evaluatedAt time.Time) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.RecordFlagEvaluation")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.RecordFlagEvaluation(ctx, orgID, id, evaluatedAt)
+}
+
+// ReleaseKillSwitch implements Store
+func (_d StoreWithTracing) ReleaseKillSwitch(ctx context.Context, orgID uuid.UUID, userID uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ReleaseKillSwitch")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ReleaseKillSwitch(ctx, orgID, userID)
+}
+
+// RemoveGroupMember implements Store
+func (_d StoreWithTracing) RemoveGroupMember(ctx context.Context, groupID uuid.UUID, userID uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.RemoveGroupMember")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.RemoveGroupMember(ctx, groupID, userID)
+}
+
+// ReplaceCodeReferences implements Store
+func (_d StoreWithTracing) ReplaceCodeReferences(ctx context.Context, orgID uuid.UUID, repository string, references []model.CodeReference, scannedBy uuid.UUID) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ReplaceCodeReferences")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ReplaceCodeReferences(ctx, orgID, repository, references, scannedBy)
+}
+
+// ReviewChangeRequest implements Store
+func (_d StoreWithTracing) ReviewChangeRequest(ctx context.Context, orgID uuid.UUID, id uuid.UUID, status model.ChangeRequestStatus, reviewerID uuid.UUID, comment string) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.ReviewChangeRequest")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.ReviewChangeRequest(ctx, orgID, id, status, reviewerID, comment)
 }
 
 // UpdateFlag implements Store
@@ -118,3 +595,20 @@ func (_d StoreWithTracing) UpdateFlag(ctx context.Context, flag model.FeatureFla
 	}()
 	return _d.Store.UpdateFlag(ctx, flag)
 }
+
+// UpdateGroup implements Store
+func (_d StoreWithTracing) UpdateGroup(ctx context.Context, group model.Group) (err error) {
+	ctx, _span := _d.tracer.Start(ctx, "Store.UpdateGroup")
+	defer func() {
+		if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+		_span.End()
+	}()
+	return _d.Store.UpdateGroup(ctx, group)
+}
@@ -0,0 +1,202 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const ChangeRequestsTable = "change_requests"
+
+const changeRequestColumns = `id, org_id, flag_id, action, payload, status, comment, author_id, reviewer_id, review_comment,
+	reviewed_at, scheduled_at, applied_by, applied_at, cancelled_by, created_at, updated_at`
+
+func (s *Store) CreateChangeRequest(ctx context.Context, changeRequest model.ChangeRequest) error {
+	query := fmt.Sprintf(`INSERT INTO %s (id, org_id, flag_id, action, payload, status, comment, author_id, scheduled_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`, ChangeRequestsTable)
+	_, err := s.pool.Exec(
+		ctx, query,
+		changeRequest.ID,
+		changeRequest.OrgID,
+		changeRequest.FlagID,
+		changeRequest.Action,
+		changeRequest.Flag,
+		changeRequest.Status,
+		changeRequest.Comment,
+		changeRequest.AuthorID,
+		changeRequest.ScheduledAt,
+	)
+	return err
+}
+
+func (s *Store) ListChangeRequests(
+	ctx context.Context,
+	orgID uuid.UUID,
+	status model.ChangeRequestStatus,
+) ([]model.ChangeRequest, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE org_id = $1 AND ($2 = '' OR status = $2) ORDER BY created_at DESC`,
+		changeRequestColumns, ChangeRequestsTable)
+	return s.queryChangeRequests(ctx, query, orgID, status)
+}
+
+// ListDueChangeRequests returns the approved change requests of every organization that are
+// scheduled at or before the given time.
+func (s *Store) ListDueChangeRequests(ctx context.Context, now time.Time) ([]model.ChangeRequest, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE status = $1 AND scheduled_at IS NOT NULL AND scheduled_at <= $2
+		ORDER BY scheduled_at`, changeRequestColumns, ChangeRequestsTable)
+	return s.queryChangeRequests(ctx, query, model.ChangeRequestStatusApproved, now)
+}
+
+func (s *Store) GetChangeRequestByID(ctx context.Context, orgID, id uuid.UUID) (model.ChangeRequest, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE org_id = $1 AND id = $2`, changeRequestColumns, ChangeRequestsTable)
+	changeRequest, err := scanChangeRequest(s.pool.QueryRow(ctx, query, orgID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ChangeRequest{}, model.ErrChangeRequestNotFound
+		}
+		return model.ChangeRequest{}, err
+	}
+
+	return changeRequest, nil
+}
+
+func (s *Store) ReviewChangeRequest(
+	ctx context.Context,
+	orgID uuid.UUID,
+	id uuid.UUID,
+	status model.ChangeRequestStatus,
+	reviewerID uuid.UUID,
+	comment string,
+) error {
+	query := fmt.Sprintf(`UPDATE %s SET status = $1, reviewer_id = $2, review_comment = $3, reviewed_at = NOW(), updated_at = NOW()
+		WHERE id = $4 AND org_id = $5 AND status = $6`, ChangeRequestsTable)
+	result, err := s.pool.Exec(ctx, query, status, reviewerID, comment, id, orgID, model.ChangeRequestStatusPending)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrChangeRequestState
+	}
+	return nil
+}
+
+func (s *Store) CancelChangeRequest(ctx context.Context, orgID, id uuid.UUID, userID uuid.UUID) error {
+	query := fmt.Sprintf(`UPDATE %s SET status = $1, cancelled_by = $2, updated_at = NOW()
+		WHERE id = $3 AND org_id = $4 AND status IN ($5, $6)`, ChangeRequestsTable)
+	result, err := s.pool.Exec(
+		ctx, query,
+		model.ChangeRequestStatusCancelled,
+		userID,
+		id,
+		orgID,
+		model.ChangeRequestStatusPending,
+		model.ChangeRequestStatusApproved,
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrChangeRequestState
+	}
+	return nil
+}
+
+// ApplyChangeRequest marks an approved change request as applied and performs the requested
+// change on its flag in a single transaction, so a request is never applied twice.
+func (s *Store) ApplyChangeRequest(ctx context.Context, changeRequest model.ChangeRequest, appliedBy *uuid.UUID) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	query := fmt.Sprintf(`UPDATE %s SET status = $1, applied_by = $2, applied_at = NOW(), updated_at = NOW()
+		WHERE id = $3 AND org_id = $4 AND status = $5`, ChangeRequestsTable)
+	result, err := tx.Exec(
+		ctx, query,
+		model.ChangeRequestStatusApplied,
+		appliedBy,
+		changeRequest.ID,
+		changeRequest.OrgID,
+		model.ChangeRequestStatusApproved,
+	)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrChangeRequestState
+	}
+
+	switch changeRequest.Action {
+	case model.ChangeRequestActionUpdate:
+		if changeRequest.Flag == nil {
+			return fmt.Errorf("change request %s has no flag payload", changeRequest.ID)
+		}
+		flag := changeRequest.Flag.Flag(changeRequest.FlagID)
+		flag.OrgID = changeRequest.OrgID
+		result, err = tx.Exec(ctx, updateFlagQuery, updateFlagArgs(flag)...)
+	case model.ChangeRequestActionDelete:
+		query = fmt.Sprintf(`DELETE FROM %s WHERE org_id = $1 AND id = $2`, FeatureFlagsTable)
+		result, err = tx.Exec(ctx, query, changeRequest.OrgID, changeRequest.FlagID)
+	default:
+		return fmt.Errorf("unsupported change request action: %s", changeRequest.Action)
+	}
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *Store) queryChangeRequests(ctx context.Context, query string, args ...any) ([]model.ChangeRequest, error) {
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changeRequests []model.ChangeRequest
+	for rows.Next() {
+		changeRequest, err := scanChangeRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		changeRequests = append(changeRequests, changeRequest)
+	}
+
+	return changeRequests, rows.Err()
+}
+
+func scanChangeRequest(row pgx.Row) (model.ChangeRequest, error) {
+	var changeRequest model.ChangeRequest
+	err := row.Scan(
+		&changeRequest.ID,
+		&changeRequest.OrgID,
+		&changeRequest.FlagID,
+		&changeRequest.Action,
+		&changeRequest.Flag,
+		&changeRequest.Status,
+		&changeRequest.Comment,
+		&changeRequest.AuthorID,
+		&changeRequest.ReviewerID,
+		&changeRequest.ReviewComment,
+		&changeRequest.ReviewedAt,
+		&changeRequest.ScheduledAt,
+		&changeRequest.AppliedBy,
+		&changeRequest.AppliedAt,
+		&changeRequest.CancelledBy,
+		&changeRequest.CreatedAt,
+		&changeRequest.UpdatedAt,
+	)
+	return changeRequest, err
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	CodeReferencesTable     = "code_references"
+	CodeReferenceScansTable = "code_reference_scans"
+)
+
+// ReplaceCodeReferences swaps the stored references of a repository for the given ones and
+// records the scan in a single transaction.
+func (s *Store) ReplaceCodeReferences(
+	ctx context.Context,
+	orgID uuid.UUID,
+	repository string,
+	references []model.CodeReference,
+	scannedBy uuid.UUID,
+) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = $1 AND repository = $2`, CodeReferencesTable)
+	if _, err := tx.Exec(ctx, query, orgID, repository); err != nil {
+		return err
+	}
+
+	_, err = tx.CopyFrom(
+		ctx,
+		pgx.Identifier{CodeReferencesTable},
+		[]string{"org_id", "repository", "flag_key", "path", "line"},
+		pgx.CopyFromSlice(len(references), func(i int) ([]any, error) {
+			return []any{orgID, repository, references[i].Key, references[i].Path, references[i].Line}, nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	query = fmt.Sprintf(`INSERT INTO %s (org_id, repository, scanned_by, scanned_at) VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (org_id, repository) DO UPDATE SET scanned_by = EXCLUDED.scanned_by, scanned_at = EXCLUDED.scanned_at`,
+		CodeReferenceScansTable)
+	if _, err := tx.Exec(ctx, query, orgID, repository, scannedBy); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *Store) ListCodeReferences(ctx context.Context, orgID uuid.UUID, key string) ([]model.CodeReference, error) {
+	query := fmt.Sprintf(`SELECT repository, flag_key, path, line FROM %s WHERE org_id = $1 AND flag_key = $2
+		ORDER BY repository, path, line`, CodeReferencesTable)
+	rows, err := s.pool.Query(ctx, query, orgID, key)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	references := []model.CodeReference{}
+	for rows.Next() {
+		var reference model.CodeReference
+		if err := rows.Scan(&reference.Repository, &reference.Key, &reference.Path, &reference.Line); err != nil {
+			return nil, err
+		}
+		references = append(references, reference)
+	}
+
+	return references, rows.Err()
+}
+
+func (s *Store) CountCodeReferences(ctx context.Context, orgID uuid.UUID) (map[string]int, error) {
+	query := fmt.Sprintf(`SELECT flag_key, COUNT(*) FROM %s WHERE org_id = $1 GROUP BY flag_key`, CodeReferencesTable)
+	rows, err := s.pool.Query(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := map[string]int{}
+	for rows.Next() {
+		var (
+			key   string
+			count int
+		)
+		if err := rows.Scan(&key, &count); err != nil {
+			return nil, err
+		}
+		counts[key] = count
+	}
+
+	return counts, rows.Err()
+}
+
+func (s *Store) HasCodeReferenceScans(ctx context.Context, orgID uuid.UUID) (bool, error) {
+	var exists bool
+	query := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE org_id = $1)`, CodeReferenceScansTable)
+	err := s.pool.QueryRow(ctx, query, orgID).Scan(&exists)
+	return exists, err
+}
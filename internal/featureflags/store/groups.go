@@ -0,0 +1,213 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	GroupsTable          = "groups"
+	GroupMembersTable    = "group_members"
+	FlagAccessRulesTable = "flag_access_rules"
+)
+
+const foreignKeyViolationCode = "23503"
+
+func (s *Store) ListGroups(ctx context.Context, orgID uuid.UUID) ([]model.Group, error) {
+	query := fmt.Sprintf(`SELECT id, org_id, name, description, created_at FROM %s WHERE org_id = $1 ORDER BY name`,
+		GroupsTable)
+	rows, err := s.pool.Query(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	groups := []model.Group{}
+	for rows.Next() {
+		var group model.Group
+		if err := rows.Scan(&group.ID, &group.OrgID, &group.Name, &group.Description, &group.CreatedAt); err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, rows.Err()
+}
+
+func (s *Store) GetGroupByID(ctx context.Context, orgID, id uuid.UUID) (model.Group, error) {
+	var group model.Group
+	query := fmt.Sprintf(`SELECT id, org_id, name, description, created_at FROM %s WHERE org_id = $1 AND id = $2`,
+		GroupsTable)
+	err := s.pool.QueryRow(ctx, query, orgID, id).Scan(
+		&group.ID,
+		&group.OrgID,
+		&group.Name,
+		&group.Description,
+		&group.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Group{}, model.ErrGroupNotFound
+		}
+		return model.Group{}, err
+	}
+
+	return group, nil
+}
+
+func (s *Store) CreateGroup(ctx context.Context, group model.Group) error {
+	query := fmt.Sprintf(`INSERT INTO %s (id, org_id, name, description, created_at) VALUES ($1, $2, $3, $4, $5)`,
+		GroupsTable)
+	_, err := s.pool.Exec(ctx, query, group.ID, group.OrgID, group.Name, group.Description, group.CreatedAt)
+	return groupError(err)
+}
+
+func (s *Store) UpdateGroup(ctx context.Context, group model.Group) error {
+	query := fmt.Sprintf(`UPDATE %s SET name = $1, description = $2 WHERE org_id = $3 AND id = $4`, GroupsTable)
+	result, err := s.pool.Exec(ctx, query, group.Name, group.Description, group.OrgID, group.ID)
+	if err != nil {
+		return groupError(err)
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrGroupNotFound
+	}
+	return nil
+}
+
+// DeleteGroup removes the group together with its memberships and access rules.
+func (s *Store) DeleteGroup(ctx context.Context, orgID, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = $1 AND id = $2`, GroupsTable)
+	result, err := s.pool.Exec(ctx, query, orgID, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrGroupNotFound
+	}
+	return nil
+}
+
+func (s *Store) ListGroupMembers(ctx context.Context, groupID uuid.UUID) ([]model.GroupMember, error) {
+	query := fmt.Sprintf(`SELECT user_id, created_at FROM %s WHERE group_id = $1 ORDER BY created_at, user_id`,
+		GroupMembersTable)
+	rows, err := s.pool.Query(ctx, query, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := []model.GroupMember{}
+	for rows.Next() {
+		var member model.GroupMember
+		if err := rows.Scan(&member.UserID, &member.AddedAt); err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+// AddGroupMember adds the user to the group, adding an existing member again is a no-op.
+func (s *Store) AddGroupMember(ctx context.Context, groupID, userID uuid.UUID) error {
+	query := fmt.Sprintf(`INSERT INTO %s (group_id, user_id) VALUES ($1, $2) ON CONFLICT (group_id, user_id) DO NOTHING`,
+		GroupMembersTable)
+	_, err := s.pool.Exec(ctx, query, groupID, userID)
+	return groupError(err)
+}
+
+func (s *Store) RemoveGroupMember(ctx context.Context, groupID, userID uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE group_id = $1 AND user_id = $2`, GroupMembersTable)
+	result, err := s.pool.Exec(ctx, query, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrGroupMemberNotFound
+	}
+	return nil
+}
+
+// ListUserGroupIDs returns the groups of the organization the user is a member of.
+func (s *Store) ListUserGroupIDs(ctx context.Context, orgID, userID uuid.UUID) ([]uuid.UUID, error) {
+	query := fmt.Sprintf(`SELECT g.id FROM %s g JOIN %s m ON m.group_id = g.id WHERE g.org_id = $1 AND m.user_id = $2
+		ORDER BY g.id`, GroupsTable, GroupMembersTable)
+	rows, err := s.pool.Query(ctx, query, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	groupIDs := []uuid.UUID{}
+	for rows.Next() {
+		var groupID uuid.UUID
+		if err := rows.Scan(&groupID); err != nil {
+			return nil, err
+		}
+		groupIDs = append(groupIDs, groupID)
+	}
+
+	return groupIDs, rows.Err()
+}
+
+func (s *Store) ListAccessRules(ctx context.Context, orgID uuid.UUID) ([]model.AccessRule, error) {
+	query := fmt.Sprintf(`SELECT id, org_id, group_id, flag_id, COALESCE(tag, ''), permission, created_at FROM %s
+		WHERE org_id = $1 ORDER BY created_at, id`, FlagAccessRulesTable)
+	rows, err := s.pool.Query(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := []model.AccessRule{}
+	for rows.Next() {
+		var rule model.AccessRule
+		err := rows.Scan(&rule.ID, &rule.OrgID, &rule.GroupID, &rule.FlagID, &rule.Tag, &rule.Permission, &rule.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+func (s *Store) CreateAccessRule(ctx context.Context, rule model.AccessRule) error {
+	query := fmt.Sprintf(`INSERT INTO %s (id, org_id, group_id, flag_id, tag, permission, created_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7)`, FlagAccessRulesTable)
+	_, err := s.pool.Exec(ctx, query, rule.ID, rule.OrgID, rule.GroupID, rule.FlagID, rule.Tag, rule.Permission, rule.CreatedAt)
+	return groupError(err)
+}
+
+func (s *Store) DeleteAccessRule(ctx context.Context, orgID, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = $1 AND id = $2`, FlagAccessRulesTable)
+	result, err := s.pool.Exec(ctx, query, orgID, id)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrAccessRuleNotFound
+	}
+	return nil
+}
+
+// groupError translates the constraint violations of group writes. Foreign keys only point at
+// groups, except for the flag of an access rule, which the service checks beforehand.
+func groupError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolationCode:
+			return model.ErrGroupExists
+		case foreignKeyViolationCode:
+			return model.ErrGroupNotFound
+		}
+	}
+	return err
+}
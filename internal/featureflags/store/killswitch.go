@@ -0,0 +1,76 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const KillSwitchesTable = "kill_switches"
+
+const uniqueViolationCode = "23505"
+
+func (s *Store) GetActiveKillSwitch(ctx context.Context, orgID uuid.UUID) (model.KillSwitch, error) {
+	var killSwitch model.KillSwitch
+	query := fmt.Sprintf(`SELECT id, org_id, reason, tag, activated_by, activated_at, released_by, released_at FROM %s
+		WHERE org_id = $1 AND released_at IS NULL`, KillSwitchesTable)
+	err := s.pool.QueryRow(ctx, query, orgID).Scan(
+		&killSwitch.ID,
+		&killSwitch.OrgID,
+		&killSwitch.Reason,
+		&killSwitch.Tag,
+		&killSwitch.ActivatedBy,
+		&killSwitch.ActivatedAt,
+		&killSwitch.ReleasedBy,
+		&killSwitch.ReleasedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.KillSwitch{}, model.ErrKillSwitchNotActive
+		}
+		return model.KillSwitch{}, err
+	}
+
+	return killSwitch, nil
+}
+
+func (s *Store) CreateKillSwitch(ctx context.Context, killSwitch model.KillSwitch) error {
+	query := fmt.Sprintf(`INSERT INTO %s (id, org_id, reason, tag, activated_by, activated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		KillSwitchesTable)
+	_, err := s.pool.Exec(
+		ctx, query,
+		killSwitch.ID,
+		killSwitch.OrgID,
+		killSwitch.Reason,
+		killSwitch.Tag,
+		killSwitch.ActivatedBy,
+		killSwitch.ActivatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return model.ErrKillSwitchActive
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Store) ReleaseKillSwitch(ctx context.Context, orgID, userID uuid.UUID) error {
+	query := fmt.Sprintf(`UPDATE %s SET released_by = $1, released_at = NOW() WHERE org_id = $2 AND released_at IS NULL`,
+		KillSwitchesTable)
+	result, err := s.pool.Exec(ctx, query, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrKillSwitchNotActive
+	}
+	return nil
+}
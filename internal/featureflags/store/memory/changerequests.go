@@ -0,0 +1,174 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) CreateChangeRequest(_ context.Context, changeRequest model.ChangeRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.changeRequests[changeRequest.ID]; ok {
+		return fmt.Errorf("change request %s already exists", changeRequest.ID)
+	}
+	now := time.Now().UTC()
+	changeRequest.CreatedAt = now
+	changeRequest.UpdatedAt = now
+	s.changeRequests[changeRequest.ID] = cloneChangeRequest(changeRequest)
+	return nil
+}
+
+func (s *Store) ListChangeRequests(
+	_ context.Context,
+	orgID uuid.UUID,
+	status model.ChangeRequestStatus,
+) ([]model.ChangeRequest, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	changeRequests := s.collectChangeRequests(func(changeRequest model.ChangeRequest) bool {
+		return changeRequest.OrgID == orgID && (status == "" || changeRequest.Status == status)
+	})
+	slices.SortFunc(changeRequests, func(a, b model.ChangeRequest) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return changeRequests, nil
+}
+
+func (s *Store) ListDueChangeRequests(_ context.Context, now time.Time) ([]model.ChangeRequest, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	changeRequests := s.collectChangeRequests(func(changeRequest model.ChangeRequest) bool {
+		return changeRequest.Status == model.ChangeRequestStatusApproved &&
+			changeRequest.ScheduledAt != nil && !changeRequest.ScheduledAt.After(now)
+	})
+	slices.SortFunc(changeRequests, func(a, b model.ChangeRequest) int {
+		return a.ScheduledAt.Compare(*b.ScheduledAt)
+	})
+	return changeRequests, nil
+}
+
+func (s *Store) GetChangeRequestByID(_ context.Context, orgID, id uuid.UUID) (model.ChangeRequest, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	changeRequest, ok := s.changeRequests[id]
+	if !ok || changeRequest.OrgID != orgID {
+		return model.ChangeRequest{}, model.ErrChangeRequestNotFound
+	}
+	return cloneChangeRequest(changeRequest), nil
+}
+
+func (s *Store) ReviewChangeRequest(
+	_ context.Context,
+	orgID uuid.UUID,
+	id uuid.UUID,
+	status model.ChangeRequestStatus,
+	reviewerID uuid.UUID,
+	comment string,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changeRequest, ok := s.changeRequests[id]
+	if !ok || changeRequest.OrgID != orgID || changeRequest.Status != model.ChangeRequestStatusPending {
+		return model.ErrChangeRequestState
+	}
+	now := time.Now().UTC()
+	changeRequest.Status = status
+	changeRequest.ReviewerID = &reviewerID
+	changeRequest.ReviewComment = comment
+	changeRequest.ReviewedAt = &now
+	changeRequest.UpdatedAt = now
+	s.changeRequests[id] = changeRequest
+	return nil
+}
+
+func (s *Store) CancelChangeRequest(_ context.Context, orgID, id uuid.UUID, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changeRequest, ok := s.changeRequests[id]
+	if !ok || changeRequest.OrgID != orgID || (changeRequest.Status != model.ChangeRequestStatusPending &&
+		changeRequest.Status != model.ChangeRequestStatusApproved) {
+		return model.ErrChangeRequestState
+	}
+	changeRequest.Status = model.ChangeRequestStatusCancelled
+	changeRequest.CancelledBy = &userID
+	changeRequest.UpdatedAt = time.Now().UTC()
+	s.changeRequests[id] = changeRequest
+	return nil
+}
+
+func (s *Store) ApplyChangeRequest(_ context.Context, changeRequest model.ChangeRequest, appliedBy *uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.changeRequests[changeRequest.ID]
+	if !ok || stored.OrgID != changeRequest.OrgID || stored.Status != model.ChangeRequestStatusApproved {
+		return model.ErrChangeRequestState
+	}
+
+	var err error
+	switch changeRequest.Action {
+	case model.ChangeRequestActionUpdate:
+		if changeRequest.Flag == nil {
+			return fmt.Errorf("change request %s has no flag payload", changeRequest.ID)
+		}
+		flag := changeRequest.Flag.Flag(changeRequest.FlagID)
+		flag.OrgID = changeRequest.OrgID
+		err = s.updateFlag(flag)
+	case model.ChangeRequestActionDelete:
+		err = s.deleteFlag(changeRequest.OrgID, changeRequest.FlagID)
+	default:
+		return fmt.Errorf("unsupported change request action: %s", changeRequest.Action)
+	}
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	stored.Status = model.ChangeRequestStatusApplied
+	stored.AppliedBy = cloneID(appliedBy)
+	stored.AppliedAt = &now
+	stored.UpdatedAt = now
+	s.changeRequests[stored.ID] = stored
+	return nil
+}
+
+func (s *Store) collectChangeRequests(keep func(model.ChangeRequest) bool) []model.ChangeRequest {
+	var changeRequests []model.ChangeRequest
+	for _, changeRequest := range s.changeRequests {
+		if keep(changeRequest) {
+			changeRequests = append(changeRequests, cloneChangeRequest(changeRequest))
+		}
+	}
+	return changeRequests
+}
+
+func cloneChangeRequest(changeRequest model.ChangeRequest) model.ChangeRequest {
+	if changeRequest.Flag != nil {
+		flag := *changeRequest.Flag
+		flag.Maintainers = slices.Clone(flag.Maintainers)
+		flag.Tags = slices.Clone(flag.Tags)
+		flag.IssueURLs = slices.Clone(flag.IssueURLs)
+		flag.Metadata = maps.Clone(flag.Metadata)
+		flag.ExpiresAt = cloneTime(flag.ExpiresAt)
+		changeRequest.Flag = &flag
+	}
+	changeRequest.ReviewerID = cloneID(changeRequest.ReviewerID)
+	changeRequest.ReviewedAt = cloneTime(changeRequest.ReviewedAt)
+	changeRequest.ScheduledAt = cloneTime(changeRequest.ScheduledAt)
+	changeRequest.AppliedBy = cloneID(changeRequest.AppliedBy)
+	changeRequest.AppliedAt = cloneTime(changeRequest.AppliedAt)
+	changeRequest.CancelledBy = cloneID(changeRequest.CancelledBy)
+	return changeRequest
+}
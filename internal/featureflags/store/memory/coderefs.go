@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) ReplaceCodeReferences(
+	_ context.Context,
+	orgID uuid.UUID,
+	repository string,
+	references []model.CodeReference,
+	scannedBy uuid.UUID,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored := make([]model.CodeReference, len(references))
+	for i, reference := range references {
+		reference.Repository = repository
+		stored[i] = reference
+	}
+	key := repositoryKey{orgID: orgID, repository: repository}
+	s.codeReferences[key] = stored
+	s.codeReferenceScans[key] = codeReferenceScan{scannedBy: scannedBy, scannedAt: time.Now().UTC()}
+	return nil
+}
+
+func (s *Store) ListCodeReferences(_ context.Context, orgID uuid.UUID, key string) ([]model.CodeReference, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	references := []model.CodeReference{}
+	for repository, repositoryReferences := range s.codeReferences {
+		if repository.orgID != orgID {
+			continue
+		}
+		for _, reference := range repositoryReferences {
+			if reference.Key == key {
+				references = append(references, reference)
+			}
+		}
+	}
+	slices.SortFunc(references, func(a, b model.CodeReference) int {
+		return cmp.Or(
+			cmp.Compare(a.Repository, b.Repository),
+			cmp.Compare(a.Path, b.Path),
+			cmp.Compare(a.Line, b.Line),
+		)
+	})
+	return references, nil
+}
+
+func (s *Store) CountCodeReferences(_ context.Context, orgID uuid.UUID) (map[string]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := map[string]int{}
+	for repository, repositoryReferences := range s.codeReferences {
+		if repository.orgID != orgID {
+			continue
+		}
+		for _, reference := range repositoryReferences {
+			counts[reference.Key]++
+		}
+	}
+	return counts, nil
+}
+
+func (s *Store) HasCodeReferenceScans(_ context.Context, orgID uuid.UUID) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for repository := range s.codeReferenceScans {
+		if repository.orgID == orgID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,194 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) ListGroups(_ context.Context, orgID uuid.UUID) ([]model.Group, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	groups := []model.Group{}
+	for _, group := range s.groups {
+		if group.OrgID == orgID {
+			groups = append(groups, group)
+		}
+	}
+	slices.SortFunc(groups, func(a, b model.Group) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return groups, nil
+}
+
+func (s *Store) GetGroupByID(_ context.Context, orgID, id uuid.UUID) (model.Group, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	group, ok := s.groups[id]
+	if !ok || group.OrgID != orgID {
+		return model.Group{}, model.ErrGroupNotFound
+	}
+	return group, nil
+}
+
+func (s *Store) CreateGroup(_ context.Context, group model.Group) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.groupNameTaken(group) {
+		return model.ErrGroupExists
+	}
+	group.CreatedAt = group.CreatedAt.UTC()
+	s.groups[group.ID] = group
+	s.groupMembers[group.ID] = map[uuid.UUID]time.Time{}
+	return nil
+}
+
+func (s *Store) UpdateGroup(_ context.Context, group model.Group) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.groups[group.ID]
+	if !ok || stored.OrgID != group.OrgID {
+		return model.ErrGroupNotFound
+	}
+	if s.groupNameTaken(group) {
+		return model.ErrGroupExists
+	}
+	stored.Name = group.Name
+	stored.Description = group.Description
+	s.groups[group.ID] = stored
+	return nil
+}
+
+func (s *Store) DeleteGroup(_ context.Context, orgID, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if group, ok := s.groups[id]; !ok || group.OrgID != orgID {
+		return model.ErrGroupNotFound
+	}
+	s.deleteGroup(id)
+	return nil
+}
+
+func (s *Store) ListGroupMembers(_ context.Context, groupID uuid.UUID) ([]model.GroupMember, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	members := []model.GroupMember{}
+	for userID, addedAt := range s.groupMembers[groupID] {
+		members = append(members, model.GroupMember{UserID: userID, AddedAt: addedAt})
+	}
+	slices.SortFunc(members, func(a, b model.GroupMember) int {
+		return cmp.Or(a.AddedAt.Compare(b.AddedAt), cmp.Compare(a.UserID.String(), b.UserID.String()))
+	})
+	return members, nil
+}
+
+func (s *Store) AddGroupMember(_ context.Context, groupID, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	members, ok := s.groupMembers[groupID]
+	if !ok {
+		return model.ErrGroupNotFound
+	}
+	if _, ok := members[userID]; !ok {
+		members[userID] = time.Now().UTC()
+	}
+	return nil
+}
+
+func (s *Store) RemoveGroupMember(_ context.Context, groupID, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.groupMembers[groupID][userID]; !ok {
+		return model.ErrGroupMemberNotFound
+	}
+	delete(s.groupMembers[groupID], userID)
+	return nil
+}
+
+func (s *Store) ListUserGroupIDs(_ context.Context, orgID, userID uuid.UUID) ([]uuid.UUID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	groupIDs := []uuid.UUID{}
+	for groupID, members := range s.groupMembers {
+		if _, ok := members[userID]; ok && s.groups[groupID].OrgID == orgID {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+	slices.SortFunc(groupIDs, func(a, b uuid.UUID) int {
+		return cmp.Compare(a.String(), b.String())
+	})
+	return groupIDs, nil
+}
+
+func (s *Store) ListAccessRules(_ context.Context, orgID uuid.UUID) ([]model.AccessRule, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rules := []model.AccessRule{}
+	for _, rule := range s.accessRules {
+		if rule.OrgID == orgID {
+			rule.FlagID = cloneID(rule.FlagID)
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+func (s *Store) CreateAccessRule(_ context.Context, rule model.AccessRule) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.groups[rule.GroupID]; !ok {
+		return model.ErrGroupNotFound
+	}
+	rule.FlagID = cloneID(rule.FlagID)
+	rule.CreatedAt = rule.CreatedAt.UTC()
+	s.accessRules = append(s.accessRules, rule)
+	return nil
+}
+
+func (s *Store) DeleteAccessRule(_ context.Context, orgID, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := slices.IndexFunc(s.accessRules, func(rule model.AccessRule) bool {
+		return rule.ID == id && rule.OrgID == orgID
+	})
+	if i < 0 {
+		return model.ErrAccessRuleNotFound
+	}
+	s.accessRules = slices.Delete(s.accessRules, i, i+1)
+	return nil
+}
+
+func (s *Store) groupNameTaken(group model.Group) bool {
+	for _, other := range s.groups {
+		if other.ID != group.ID && other.OrgID == group.OrgID && other.Name == group.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteGroup drops the group together with its members and access rules, like the cascading
+// foreign keys of the SQL stores.
+func (s *Store) deleteGroup(id uuid.UUID) {
+	delete(s.groups, id)
+	delete(s.groupMembers, id)
+	s.accessRules = slices.DeleteFunc(s.accessRules, func(rule model.AccessRule) bool {
+		return rule.GroupID == id
+	})
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) GetActiveKillSwitch(_ context.Context, orgID uuid.UUID) (model.KillSwitch, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	i := s.activeKillSwitch(orgID)
+	if i < 0 {
+		return model.KillSwitch{}, model.ErrKillSwitchNotActive
+	}
+	return s.killSwitches[i], nil
+}
+
+func (s *Store) CreateKillSwitch(_ context.Context, killSwitch model.KillSwitch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.activeKillSwitch(killSwitch.OrgID) >= 0 {
+		return model.ErrKillSwitchActive
+	}
+	killSwitch.ActivatedAt = killSwitch.ActivatedAt.UTC()
+	killSwitch.ReleasedBy = nil
+	killSwitch.ReleasedAt = nil
+	s.killSwitches = append(s.killSwitches, killSwitch)
+	return nil
+}
+
+func (s *Store) ReleaseKillSwitch(_ context.Context, orgID, userID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.activeKillSwitch(orgID)
+	if i < 0 {
+		return model.ErrKillSwitchNotActive
+	}
+	now := time.Now().UTC()
+	s.killSwitches[i].ReleasedBy = &userID
+	s.killSwitches[i].ReleasedAt = &now
+	return nil
+}
+
+func (s *Store) activeKillSwitch(orgID uuid.UUID) int {
+	for i, killSwitch := range s.killSwitches {
+		if killSwitch.OrgID == orgID && killSwitch.ReleasedAt == nil {
+			return i
+		}
+	}
+	return -1
+}
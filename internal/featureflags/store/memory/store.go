@@ -0,0 +1,218 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+// Store keeps everything in memory, the state is lost on restart. It returns copies so callers
+// cannot modify the stored values.
+type Store struct {
+	mu                 sync.RWMutex
+	flags              map[uuid.UUID]model.FeatureFlag
+	changeRequests     map[uuid.UUID]model.ChangeRequest
+	codeReferences     map[repositoryKey][]model.CodeReference
+	codeReferenceScans map[repositoryKey]codeReferenceScan
+	killSwitches       []model.KillSwitch
+	groups             map[uuid.UUID]model.Group
+	groupMembers       map[uuid.UUID]map[uuid.UUID]time.Time
+	accessRules        []model.AccessRule
+}
+
+// repositoryKey identifies a repository, the same repository may be scanned by several organizations.
+type repositoryKey struct {
+	orgID      uuid.UUID
+	repository string
+}
+
+type codeReferenceScan struct {
+	scannedBy uuid.UUID
+	scannedAt time.Time
+}
+
+func NewStore() *Store {
+	return &Store{
+		flags:              map[uuid.UUID]model.FeatureFlag{},
+		changeRequests:     map[uuid.UUID]model.ChangeRequest{},
+		codeReferences:     map[repositoryKey][]model.CodeReference{},
+		codeReferenceScans: map[repositoryKey]codeReferenceScan{},
+		groups:             map[uuid.UUID]model.Group{},
+		groupMembers:       map[uuid.UUID]map[uuid.UUID]time.Time{},
+	}
+}
+
+func (s *Store) ListFlags(_ context.Context, orgID uuid.UUID, filter model.FlagFilter) ([]model.FeatureFlag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	flags := s.collectFlags(func(flag model.FeatureFlag) bool {
+		return flag.OrgID == orgID && filter.Matches(flag)
+	})
+	slices.SortFunc(flags, func(a, b model.FeatureFlag) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.Key, b.Key))
+	})
+	return flags, nil
+}
+
+func (s *Store) ListExpiringFlags(_ context.Context, before time.Time) ([]model.FeatureFlag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	flags := s.collectFlags(func(flag model.FeatureFlag) bool {
+		return !flag.Permanent && flag.ExpiresAt != nil && !flag.ExpiresAt.After(before)
+	})
+	slices.SortFunc(flags, func(a, b model.FeatureFlag) int {
+		return a.ExpiresAt.Compare(*b.ExpiresAt)
+	})
+	return flags, nil
+}
+
+func (s *Store) GetFlagByID(_ context.Context, orgID, id uuid.UUID) (model.FeatureFlag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	flag, ok := s.flags[id]
+	if !ok || flag.OrgID != orgID {
+		return model.FeatureFlag{}, model.ErrNotFound
+	}
+	return cloneFlag(flag), nil
+}
+
+func (s *Store) GetFlagByKey(_ context.Context, orgID uuid.UUID, key string) (model.FeatureFlag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	flags := s.collectFlags(func(flag model.FeatureFlag) bool {
+		return flag.OrgID == orgID && flag.Key == key
+	})
+	if len(flags) == 0 {
+		return model.FeatureFlag{}, model.ErrNotFound
+	}
+	return slices.MinFunc(flags, func(a, b model.FeatureFlag) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	}), nil
+}
+
+func (s *Store) CreateFlag(_ context.Context, flag model.FeatureFlag) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	flag.CreatedAt = now
+	flag.UpdatedAt = now
+	s.flags[flag.ID] = cloneFlag(flag)
+	return nil
+}
+
+func (s *Store) UpdateFlag(_ context.Context, flag model.FeatureFlag) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.updateFlag(flag)
+}
+
+func (s *Store) DeleteFlag(_ context.Context, orgID, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.deleteFlag(orgID, id)
+}
+
+func (s *Store) RecordFlagEvaluation(_ context.Context, orgID, id uuid.UUID, evaluatedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	flag, ok := s.flags[id]
+	if !ok || flag.OrgID != orgID {
+		return model.ErrNotFound
+	}
+	flag.LastEvaluatedAt = cloneTime(&evaluatedAt)
+	s.flags[id] = flag
+	return nil
+}
+
+// Ping always succeeds, there is no connection that could be lost.
+func (s *Store) Ping(context.Context) error {
+	return nil
+}
+
+func (s *Store) collectFlags(keep func(model.FeatureFlag) bool) []model.FeatureFlag {
+	var flags []model.FeatureFlag
+	for _, flag := range s.flags {
+		if keep(flag) {
+			flags = append(flags, cloneFlag(flag))
+		}
+	}
+	return flags
+}
+
+// updateFlag keeps the creation and evaluation time of the stored flag, like the UPDATE of the SQL stores.
+func (s *Store) updateFlag(flag model.FeatureFlag) error {
+	stored, ok := s.flags[flag.ID]
+	if !ok || stored.OrgID != flag.OrgID {
+		return model.ErrNotFound
+	}
+	flag.CreatedAt = stored.CreatedAt
+	flag.LastEvaluatedAt = stored.LastEvaluatedAt
+	flag.UpdatedAt = time.Now().UTC()
+	s.flags[flag.ID] = cloneFlag(flag)
+	return nil
+}
+
+func (s *Store) deleteFlag(orgID, id uuid.UUID) error {
+	if stored, ok := s.flags[id]; !ok || stored.OrgID != orgID {
+		return model.ErrNotFound
+	}
+	delete(s.flags, id)
+	s.accessRules = slices.DeleteFunc(s.accessRules, func(rule model.AccessRule) bool {
+		return rule.FlagID != nil && *rule.FlagID == id
+	})
+	return nil
+}
+
+func cloneFlag(flag model.FeatureFlag) model.FeatureFlag {
+	flag.Maintainers = cloneList(flag.Maintainers)
+	flag.Tags = cloneList(flag.Tags)
+	flag.IssueURLs = cloneList(flag.IssueURLs)
+	flag.Metadata = cloneMetadata(flag.Metadata)
+	flag.ExpiresAt = cloneTime(flag.ExpiresAt)
+	flag.LastEvaluatedAt = cloneTime(flag.LastEvaluatedAt)
+	return flag
+}
+
+func cloneList(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return slices.Clone(values)
+}
+
+func cloneMetadata(metadata map[string]string) map[string]string {
+	if metadata == nil {
+		return map[string]string{}
+	}
+	return maps.Clone(metadata)
+}
+
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+func cloneID(id *uuid.UUID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	clone := *id
+	return &clone
+}
@@ -0,0 +1,13 @@
+package memory_test
+
+import (
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store/memory"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store/storetest"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = Describe("Memory Store", func() {
+	storetest.DescribeStore(func() storetest.Store {
+		return memory.NewStore()
+	})
+})
@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+func (s *Store) AddTestFlag(_ context.Context, flag model.FeatureFlag) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if flag.Kind == "" {
+		flag.Kind = model.FlagKindRelease
+	}
+	if _, ok := s.flags[flag.ID]; ok {
+		return fmt.Errorf("flag %s already exists", flag.ID)
+	}
+	flag.CreatedAt = flag.CreatedAt.UTC()
+	flag.UpdatedAt = flag.UpdatedAt.UTC()
+	s.flags[flag.ID] = cloneFlag(flag)
+	return nil
+}
+
+func (s *Store) RemoveTestFlag(_ context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.flags, id)
+	return nil
+}
+
+func (s *Store) FetchTestFlagByID(_ context.Context, id uuid.UUID) (model.FeatureFlag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	flag, ok := s.flags[id]
+	if !ok {
+		return model.FeatureFlag{}, fmt.Errorf("failed to get test feature flag: %w", model.ErrNotFound)
+	}
+	return cloneFlag(flag), nil
+}
+
+func (s *Store) RemoveTestChangeRequest(_ context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.changeRequests, id)
+	return nil
+}
+
+func (s *Store) RemoveTestKillSwitch(_ context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.killSwitches = slices.DeleteFunc(s.killSwitches, func(killSwitch model.KillSwitch) bool {
+		return killSwitch.ID == id
+	})
+	return nil
+}
+
+func (s *Store) RemoveTestCodeReferences(_ context.Context, repository string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key := range s.codeReferences {
+		if key.repository == repository {
+			delete(s.codeReferences, key)
+		}
+	}
+	for key := range s.codeReferenceScans {
+		if key.repository == repository {
+			delete(s.codeReferenceScans, key)
+		}
+	}
+	return nil
+}
+
+func (s *Store) RemoveTestGroup(_ context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deleteGroup(id)
+	return nil
+}
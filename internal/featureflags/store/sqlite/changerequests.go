@@ -0,0 +1,229 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store"
+	"github.com/google/uuid"
+)
+
+const changeRequestColumns = `id, org_id, flag_id, action, payload, status, comment, author_id, reviewer_id, review_comment,
+	reviewed_at, scheduled_at, applied_by, applied_at, cancelled_by, created_at, updated_at`
+
+func (s *Store) CreateChangeRequest(ctx context.Context, changeRequest model.ChangeRequest) error {
+	var payload *string
+	if changeRequest.Flag != nil {
+		data, err := json.Marshal(changeRequest.Flag)
+		if err != nil {
+			return fmt.Errorf("failed to encode change request payload: %w", err)
+		}
+		encoded := string(data)
+		payload = &encoded
+	}
+
+	var scheduledAt *time.Time
+	if changeRequest.ScheduledAt != nil {
+		utc := changeRequest.ScheduledAt.UTC()
+		scheduledAt = &utc
+	}
+
+	now := time.Now().UTC()
+	query := fmt.Sprintf(`INSERT INTO %s (id, flag_id, action, payload, status, comment, author_id, scheduled_at,
+		created_at, updated_at, org_id) VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?9, ?10)`, store.ChangeRequestsTable)
+	_, err := s.db.ExecContext(
+		ctx, query,
+		changeRequest.ID,
+		changeRequest.FlagID,
+		changeRequest.Action,
+		payload,
+		changeRequest.Status,
+		changeRequest.Comment,
+		changeRequest.AuthorID,
+		scheduledAt,
+		now,
+		changeRequest.OrgID,
+	)
+	return err
+}
+
+func (s *Store) ListChangeRequests(
+	ctx context.Context,
+	orgID uuid.UUID,
+	status model.ChangeRequestStatus,
+) ([]model.ChangeRequest, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE org_id = ?1 AND (?2 = '' OR status = ?2) ORDER BY created_at DESC`,
+		changeRequestColumns, store.ChangeRequestsTable)
+	return s.queryChangeRequests(ctx, query, orgID, status)
+}
+
+func (s *Store) ListDueChangeRequests(ctx context.Context, now time.Time) ([]model.ChangeRequest, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE status = ?1 AND scheduled_at IS NOT NULL AND scheduled_at <= ?2
+		ORDER BY scheduled_at`, changeRequestColumns, store.ChangeRequestsTable)
+	return s.queryChangeRequests(ctx, query, model.ChangeRequestStatusApproved, now.UTC())
+}
+
+func (s *Store) GetChangeRequestByID(ctx context.Context, orgID, id uuid.UUID) (model.ChangeRequest, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE org_id = ?1 AND id = ?2`, changeRequestColumns, store.ChangeRequestsTable)
+	changeRequest, err := scanChangeRequest(s.db.QueryRowContext(ctx, query, orgID, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ChangeRequest{}, model.ErrChangeRequestNotFound
+		}
+		return model.ChangeRequest{}, err
+	}
+
+	return changeRequest, nil
+}
+
+func (s *Store) ReviewChangeRequest(
+	ctx context.Context,
+	orgID uuid.UUID,
+	id uuid.UUID,
+	status model.ChangeRequestStatus,
+	reviewerID uuid.UUID,
+	comment string,
+) error {
+	query := fmt.Sprintf(`UPDATE %s SET status = ?1, reviewer_id = ?2, review_comment = ?3, reviewed_at = ?4, updated_at = ?4
+		WHERE id = ?5 AND status = ?6 AND org_id = ?7`, store.ChangeRequestsTable)
+	result, err := s.db.ExecContext(
+		ctx, query,
+		status,
+		reviewerID,
+		comment,
+		time.Now().UTC(),
+		id,
+		model.ChangeRequestStatusPending,
+		orgID,
+	)
+	if err != nil {
+		return err
+	}
+	return expectAffected(result, model.ErrChangeRequestState)
+}
+
+func (s *Store) CancelChangeRequest(ctx context.Context, orgID, id uuid.UUID, userID uuid.UUID) error {
+	query := fmt.Sprintf(`UPDATE %s SET status = ?1, cancelled_by = ?2, updated_at = ?3
+		WHERE id = ?4 AND status IN (?5, ?6) AND org_id = ?7`, store.ChangeRequestsTable)
+	result, err := s.db.ExecContext(
+		ctx, query,
+		model.ChangeRequestStatusCancelled,
+		userID,
+		time.Now().UTC(),
+		id,
+		model.ChangeRequestStatusPending,
+		model.ChangeRequestStatusApproved,
+		orgID,
+	)
+	if err != nil {
+		return err
+	}
+	return expectAffected(result, model.ErrChangeRequestState)
+}
+
+func (s *Store) ApplyChangeRequest(ctx context.Context, changeRequest model.ChangeRequest, appliedBy *uuid.UUID) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := fmt.Sprintf(`UPDATE %s SET status = ?1, applied_by = ?2, applied_at = ?3, updated_at = ?3
+		WHERE id = ?4 AND status = ?5 AND org_id = ?6`, store.ChangeRequestsTable)
+	result, err := tx.ExecContext(
+		ctx, query,
+		model.ChangeRequestStatusApplied,
+		appliedBy,
+		time.Now().UTC(),
+		changeRequest.ID,
+		model.ChangeRequestStatusApproved,
+		changeRequest.OrgID,
+	)
+	if err != nil {
+		return err
+	}
+	if err := expectAffected(result, model.ErrChangeRequestState); err != nil {
+		return err
+	}
+
+	switch changeRequest.Action {
+	case model.ChangeRequestActionUpdate:
+		if changeRequest.Flag == nil {
+			return fmt.Errorf("change request %s has no flag payload", changeRequest.ID)
+		}
+		flag := changeRequest.Flag.Flag(changeRequest.FlagID)
+		flag.OrgID = changeRequest.OrgID
+		err = updateFlag(ctx, tx, flag)
+	case model.ChangeRequestActionDelete:
+		err = deleteFlag(ctx, tx, changeRequest.OrgID, changeRequest.FlagID)
+	default:
+		return fmt.Errorf("unsupported change request action: %s", changeRequest.Action)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *Store) queryChangeRequests(ctx context.Context, query string, args ...any) ([]model.ChangeRequest, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changeRequests []model.ChangeRequest
+	for rows.Next() {
+		changeRequest, err := scanChangeRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		changeRequests = append(changeRequests, changeRequest)
+	}
+
+	return changeRequests, rows.Err()
+}
+
+func scanChangeRequest(r row) (model.ChangeRequest, error) {
+	var (
+		changeRequest model.ChangeRequest
+		payload       *string
+	)
+	err := r.Scan(
+		&changeRequest.ID,
+		&changeRequest.OrgID,
+		&changeRequest.FlagID,
+		&changeRequest.Action,
+		&payload,
+		&changeRequest.Status,
+		&changeRequest.Comment,
+		&changeRequest.AuthorID,
+		&changeRequest.ReviewerID,
+		&changeRequest.ReviewComment,
+		&changeRequest.ReviewedAt,
+		&changeRequest.ScheduledAt,
+		&changeRequest.AppliedBy,
+		&changeRequest.AppliedAt,
+		&changeRequest.CancelledBy,
+		&changeRequest.CreatedAt,
+		&changeRequest.UpdatedAt,
+	)
+	if err != nil {
+		return model.ChangeRequest{}, err
+	}
+
+	if payload != nil {
+		if err := json.Unmarshal([]byte(*payload), &changeRequest.Flag); err != nil {
+			return model.ChangeRequest{}, fmt.Errorf("failed to decode change request %s: %w", changeRequest.ID, err)
+		}
+	}
+	return changeRequest, nil
+}
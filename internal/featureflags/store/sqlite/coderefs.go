@@ -0,0 +1,104 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store"
+	"github.com/google/uuid"
+)
+
+func (s *Store) ReplaceCodeReferences(
+	ctx context.Context,
+	orgID uuid.UUID,
+	repository string,
+	references []model.CodeReference,
+	scannedBy uuid.UUID,
+) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = ?1 AND repository = ?2`, store.CodeReferencesTable)
+	if _, err := tx.ExecContext(ctx, query, orgID, repository); err != nil {
+		return err
+	}
+
+	insert, err := tx.PrepareContext(ctx, fmt.Sprintf(`INSERT INTO %s (org_id, repository, flag_key, path, line)
+		VALUES (?1, ?2, ?3, ?4, ?5)`, store.CodeReferencesTable))
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+	for _, reference := range references {
+		if _, err := insert.ExecContext(ctx, orgID, repository, reference.Key, reference.Path, reference.Line); err != nil {
+			return err
+		}
+	}
+
+	query = fmt.Sprintf(`INSERT INTO %s (org_id, repository, scanned_by, scanned_at) VALUES (?1, ?2, ?3, ?4)
+		ON CONFLICT (org_id, repository) DO UPDATE SET scanned_by = excluded.scanned_by, scanned_at = excluded.scanned_at`,
+		store.CodeReferenceScansTable)
+	if _, err := tx.ExecContext(ctx, query, orgID, repository, scannedBy, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *Store) ListCodeReferences(ctx context.Context, orgID uuid.UUID, key string) ([]model.CodeReference, error) {
+	query := fmt.Sprintf(`SELECT repository, flag_key, path, line FROM %s WHERE org_id = ?1 AND flag_key = ?2
+		ORDER BY repository, path, line`, store.CodeReferencesTable)
+	rows, err := s.db.QueryContext(ctx, query, orgID, key)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	references := []model.CodeReference{}
+	for rows.Next() {
+		var reference model.CodeReference
+		if err := rows.Scan(&reference.Repository, &reference.Key, &reference.Path, &reference.Line); err != nil {
+			return nil, err
+		}
+		references = append(references, reference)
+	}
+
+	return references, rows.Err()
+}
+
+func (s *Store) CountCodeReferences(ctx context.Context, orgID uuid.UUID) (map[string]int, error) {
+	query := fmt.Sprintf(`SELECT flag_key, COUNT(*) FROM %s WHERE org_id = ?1 GROUP BY flag_key`, store.CodeReferencesTable)
+	rows, err := s.db.QueryContext(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := map[string]int{}
+	for rows.Next() {
+		var (
+			key   string
+			count int
+		)
+		if err := rows.Scan(&key, &count); err != nil {
+			return nil, err
+		}
+		counts[key] = count
+	}
+
+	return counts, rows.Err()
+}
+
+func (s *Store) HasCodeReferenceScans(ctx context.Context, orgID uuid.UUID) (bool, error) {
+	var exists bool
+	query := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE org_id = ?1)`, store.CodeReferenceScansTable)
+	err := s.db.QueryRowContext(ctx, query, orgID).Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,70 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store"
+	sqlitedb "github.com/georgisomnoev/feature-flag-api/internal/sqlite"
+	"github.com/google/uuid"
+)
+
+func (s *Store) GetActiveKillSwitch(ctx context.Context, orgID uuid.UUID) (model.KillSwitch, error) {
+	var killSwitch model.KillSwitch
+	query := fmt.Sprintf(`SELECT id, org_id, reason, tag, activated_by, activated_at, released_by, released_at FROM %s
+		WHERE org_id = ?1 AND released_at IS NULL`, store.KillSwitchesTable)
+	err := s.db.QueryRowContext(ctx, query, orgID).Scan(
+		&killSwitch.ID,
+		&killSwitch.OrgID,
+		&killSwitch.Reason,
+		&killSwitch.Tag,
+		&killSwitch.ActivatedBy,
+		&killSwitch.ActivatedAt,
+		&killSwitch.ReleasedBy,
+		&killSwitch.ReleasedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.KillSwitch{}, model.ErrKillSwitchNotActive
+		}
+		return model.KillSwitch{}, err
+	}
+
+	return killSwitch, nil
+}
+
+func (s *Store) CreateKillSwitch(ctx context.Context, killSwitch model.KillSwitch) error {
+	query := fmt.Sprintf(`INSERT INTO %s (id, org_id, reason, tag, activated_by, activated_at)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6)`,
+		store.KillSwitchesTable)
+	_, err := s.db.ExecContext(
+		ctx, query,
+		killSwitch.ID,
+		killSwitch.OrgID,
+		killSwitch.Reason,
+		killSwitch.Tag,
+		killSwitch.ActivatedBy,
+		killSwitch.ActivatedAt.UTC(),
+	)
+	if err != nil {
+		if sqlitedb.IsUniqueViolation(err) {
+			return model.ErrKillSwitchActive
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Store) ReleaseKillSwitch(ctx context.Context, orgID, userID uuid.UUID) error {
+	query := fmt.Sprintf(`UPDATE %s SET released_by = ?1, released_at = ?2 WHERE org_id = ?3 AND released_at IS NULL`,
+		store.KillSwitchesTable)
+	result, err := s.db.ExecContext(ctx, query, userID, time.Now().UTC(), orgID)
+	if err != nil {
+		return err
+	}
+	return expectAffected(result, model.ErrKillSwitchNotActive)
+}
@@ -0,0 +1,30 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	sqlitedb "github.com/georgisomnoev/feature-flag-api/internal/sqlite"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var db *sql.DB
+
+func TestSQLite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Feature Flags SQLite Store Suite")
+}
+
+var _ = BeforeSuite(func() {
+	var err error
+	db, err = sqlitedb.Open(context.Background(), filepath.Join(GinkgoT().TempDir(), "featureflags.db"))
+	Expect(err).NotTo(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	Expect(db.Close()).To(Succeed())
+})
@@ -0,0 +1,284 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store"
+	"github.com/google/uuid"
+)
+
+const flagColumns = `id, org_id, key, description, enabled, permanent, protected, owner, owner_type, maintainers, tags, kind,
+	issue_urls, metadata, managed_by, expires_at, created_at, updated_at, last_evaluated_at`
+
+const updateFlagQuery = `UPDATE ` + store.FeatureFlagsTable + ` SET key = ?1, description = ?2, enabled = ?3, permanent = ?4,
+	protected = ?5, owner = ?6, owner_type = ?7, maintainers = ?8, tags = ?9, kind = ?10, issue_urls = ?11, metadata = ?12,
+	managed_by = ?13, expires_at = ?14, updated_at = ?15 WHERE id = ?16 AND org_id = ?17`
+
+// execer is implemented by both the database and its transactions.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+type row interface {
+	Scan(dest ...any) error
+}
+
+// Store keeps the feature flags in SQLite. The list columns are stored as JSON arrays and the
+// metadata as a JSON object.
+type Store struct {
+	db *sql.DB
+}
+
+func NewStore(db *sql.DB) *Store {
+	return &Store{db: db}
+}
+
+func (s *Store) ListFlags(ctx context.Context, orgID uuid.UUID, filter model.FlagFilter) ([]model.FeatureFlag, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s f
+		WHERE org_id = ?6
+			AND (?1 = '' OR owner = ?1)
+			AND (?2 = '' OR EXISTS (SELECT 1 FROM json_each(f.maintainers) WHERE value = ?2))
+			AND (?3 = '' OR kind = ?3)
+			AND NOT EXISTS (SELECT 1 FROM json_each(?4) t WHERE t.value NOT IN (SELECT value FROM json_each(f.tags)))
+			AND NOT EXISTS (SELECT 1 FROM json_each(?5) m
+				WHERE NOT EXISTS (SELECT 1 FROM json_each(f.metadata) fm WHERE fm.key = m.key AND fm.value = m.value))
+		ORDER BY created_at, key`, flagColumns, store.FeatureFlagsTable)
+
+	tags, err := encodeList(filter.Tags)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := encodeMetadata(filter.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.queryFlags(ctx, query, filter.Owner, filter.Maintainer, string(filter.Kind), tags, metadata, orgID)
+}
+
+func (s *Store) ListExpiringFlags(ctx context.Context, before time.Time) ([]model.FeatureFlag, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE NOT permanent AND expires_at IS NOT NULL AND expires_at <= ?1
+		ORDER BY expires_at`, flagColumns, store.FeatureFlagsTable)
+	return s.queryFlags(ctx, query, before.UTC())
+}
+
+func (s *Store) GetFlagByID(ctx context.Context, orgID, id uuid.UUID) (model.FeatureFlag, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE org_id = ?1 AND id = ?2`, flagColumns, store.FeatureFlagsTable)
+	return getFlag(s.db.QueryRowContext(ctx, query, orgID, id))
+}
+
+func (s *Store) GetFlagByKey(ctx context.Context, orgID uuid.UUID, key string) (model.FeatureFlag, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE org_id = ?1 AND key = ?2 ORDER BY created_at LIMIT 1`,
+		flagColumns, store.FeatureFlagsTable)
+	return getFlag(s.db.QueryRowContext(ctx, query, orgID, key))
+}
+
+func (s *Store) CreateFlag(ctx context.Context, flag model.FeatureFlag) error {
+	now := time.Now().UTC()
+	flag.CreatedAt = now
+	flag.UpdatedAt = now
+	return insertFlag(ctx, s.db, flag)
+}
+
+func (s *Store) UpdateFlag(ctx context.Context, flag model.FeatureFlag) error {
+	return updateFlag(ctx, s.db, flag)
+}
+
+func (s *Store) DeleteFlag(ctx context.Context, orgID, id uuid.UUID) error {
+	return deleteFlag(ctx, s.db, orgID, id)
+}
+
+func (s *Store) RecordFlagEvaluation(ctx context.Context, orgID, id uuid.UUID, evaluatedAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET last_evaluated_at = ?3 WHERE org_id = ?1 AND id = ?2`, store.FeatureFlagsTable)
+	result, err := s.db.ExecContext(ctx, query, orgID, id, evaluatedAt.UTC())
+	if err != nil {
+		return err
+	}
+	return expectAffected(result, model.ErrNotFound)
+}
+
+// Ping checks that the database can still be queried.
+func (s *Store) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+func (s *Store) queryFlags(ctx context.Context, query string, args ...any) ([]model.FeatureFlag, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var flags []model.FeatureFlag
+	for rows.Next() {
+		flag, err := scanFlag(rows)
+		if err != nil {
+			return nil, err
+		}
+		flags = append(flags, flag)
+	}
+
+	return flags, rows.Err()
+}
+
+func getFlag(r row) (model.FeatureFlag, error) {
+	flag, err := scanFlag(r)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.FeatureFlag{}, model.ErrNotFound
+		}
+		return model.FeatureFlag{}, err
+	}
+	return flag, nil
+}
+
+func insertFlag(ctx context.Context, db execer, flag model.FeatureFlag) error {
+	args, err := flagArgs(flag)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`INSERT INTO %s (key, description, enabled, permanent, protected, owner, owner_type, maintainers,
+		tags, kind, issue_urls, metadata, managed_by, expires_at, id, org_id, created_at, updated_at)
+		VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13, ?14, ?15, ?16, ?17, ?18)`, store.FeatureFlagsTable)
+	_, err = db.ExecContext(ctx, query, append(args, flag.ID, flag.OrgID, flag.CreatedAt.UTC(), flag.UpdatedAt.UTC())...)
+	return err
+}
+
+func updateFlag(ctx context.Context, db execer, flag model.FeatureFlag) error {
+	args, err := flagArgs(flag)
+	if err != nil {
+		return err
+	}
+	result, err := db.ExecContext(ctx, updateFlagQuery, append(args, time.Now().UTC(), flag.ID, flag.OrgID)...)
+	if err != nil {
+		return err
+	}
+	return expectAffected(result, model.ErrNotFound)
+}
+
+func deleteFlag(ctx context.Context, db execer, orgID, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = ?1 AND id = ?2`, store.FeatureFlagsTable)
+	result, err := db.ExecContext(ctx, query, orgID, id)
+	if err != nil {
+		return err
+	}
+	return expectAffected(result, model.ErrNotFound)
+}
+
+// flagArgs returns the values of the columns that can be updated, in the order of updateFlagQuery.
+func flagArgs(flag model.FeatureFlag) ([]any, error) {
+	maintainers, err := encodeList(flag.Maintainers)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := encodeList(flag.Tags)
+	if err != nil {
+		return nil, err
+	}
+	issueURLs, err := encodeList(flag.IssueURLs)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := encodeMetadata(flag.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+	if flag.ExpiresAt != nil {
+		utc := flag.ExpiresAt.UTC()
+		expiresAt = &utc
+	}
+
+	return []any{
+		flag.Key,
+		flag.Description,
+		flag.Enabled,
+		flag.Permanent,
+		flag.Protected,
+		flag.Owner,
+		flag.OwnerType,
+		maintainers,
+		tags,
+		flag.Kind,
+		issueURLs,
+		metadata,
+		flag.ManagedBy,
+		expiresAt,
+	}, nil
+}
+
+func scanFlag(r row) (model.FeatureFlag, error) {
+	var (
+		flag                                   model.FeatureFlag
+		maintainers, tags, issueURLs, metadata string
+	)
+	err := r.Scan(
+		&flag.ID,
+		&flag.OrgID,
+		&flag.Key,
+		&flag.Description,
+		&flag.Enabled,
+		&flag.Permanent,
+		&flag.Protected,
+		&flag.Owner,
+		&flag.OwnerType,
+		&maintainers,
+		&tags,
+		&flag.Kind,
+		&issueURLs,
+		&metadata,
+		&flag.ManagedBy,
+		&flag.ExpiresAt,
+		&flag.CreatedAt,
+		&flag.UpdatedAt,
+		&flag.LastEvaluatedAt,
+	)
+	if err != nil {
+		return model.FeatureFlag{}, err
+	}
+
+	err = errors.Join(
+		json.Unmarshal([]byte(maintainers), &flag.Maintainers),
+		json.Unmarshal([]byte(tags), &flag.Tags),
+		json.Unmarshal([]byte(issueURLs), &flag.IssueURLs),
+		json.Unmarshal([]byte(metadata), &flag.Metadata),
+	)
+	if err != nil {
+		return model.FeatureFlag{}, fmt.Errorf("failed to decode flag %s: %w", flag.ID, err)
+	}
+	return flag, nil
+}
+
+func encodeList(values []string) (string, error) {
+	if values == nil {
+		values = []string{}
+	}
+	data, err := json.Marshal(values)
+	return string(data), err
+}
+
+func encodeMetadata(metadata map[string]string) (string, error) {
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	data, err := json.Marshal(metadata)
+	return string(data), err
+}
+
+func expectAffected(result sql.Result, errNone error) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errNone
+	}
+	return nil
+}
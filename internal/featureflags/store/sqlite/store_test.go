@@ -0,0 +1,13 @@
+package sqlite_test
+
+import (
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store/sqlite"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store/storetest"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = Describe("SQLite Store", func() {
+	storetest.DescribeStore(func() storetest.Store {
+		return sqlite.NewStore(db)
+	})
+})
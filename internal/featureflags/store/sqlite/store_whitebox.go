@@ -0,0 +1,61 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store"
+	"github.com/google/uuid"
+)
+
+func (s *Store) AddTestFlag(ctx context.Context, flag model.FeatureFlag) error {
+	if flag.Kind == "" {
+		flag.Kind = model.FlagKindRelease
+	}
+	return insertFlag(ctx, s.db, flag)
+}
+
+func (s *Store) RemoveTestFlag(ctx context.Context, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = ?1`, store.FeatureFlagsTable)
+	_, err := s.db.ExecContext(ctx, query, id)
+	return err
+}
+
+func (s *Store) FetchTestFlagByID(ctx context.Context, id uuid.UUID) (model.FeatureFlag, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE id = ?1`, flagColumns, store.FeatureFlagsTable)
+	flag, err := scanFlag(s.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		return model.FeatureFlag{}, fmt.Errorf("failed to get test feature flag: %w", err)
+	}
+
+	return flag, nil
+}
+
+func (s *Store) RemoveTestChangeRequest(ctx context.Context, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = ?1`, store.ChangeRequestsTable)
+	_, err := s.db.ExecContext(ctx, query, id)
+	return err
+}
+
+func (s *Store) RemoveTestKillSwitch(ctx context.Context, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = ?1`, store.KillSwitchesTable)
+	_, err := s.db.ExecContext(ctx, query, id)
+	return err
+}
+
+func (s *Store) RemoveTestCodeReferences(ctx context.Context, repository string) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE repository = ?1`, store.CodeReferencesTable)
+	if _, err := s.db.ExecContext(ctx, query, repository); err != nil {
+		return err
+	}
+	query = fmt.Sprintf(`DELETE FROM %s WHERE repository = ?1`, store.CodeReferenceScansTable)
+	_, err := s.db.ExecContext(ctx, query, repository)
+	return err
+}
+
+func (s *Store) RemoveTestGroup(ctx context.Context, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = ?1`, store.GroupsTable)
+	_, err := s.db.ExecContext(ctx, query, id)
+	return err
+}
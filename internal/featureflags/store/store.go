@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
 	"github.com/google/uuid"
@@ -13,6 +14,13 @@ import (
 
 const FeatureFlagsTable = "feature_flags"
 
+const flagColumns = `id, org_id, key, description, enabled, permanent, protected, owner, owner_type, maintainers, tags, kind,
+	issue_urls, metadata, managed_by, expires_at, created_at, updated_at, last_evaluated_at`
+
+const updateFlagQuery = `UPDATE ` + FeatureFlagsTable + ` SET key = $1, description = $2, enabled = $3, permanent = $4,
+	protected = $5, owner = $6, owner_type = $7, maintainers = $8, tags = $9, kind = $10, issue_urls = $11, metadata = $12,
+	managed_by = $13, expires_at = $14, updated_at = NOW() WHERE id = $15 AND org_id = $16`
+
 type Store struct {
 	pool *pgxpool.Pool
 }
@@ -21,9 +29,25 @@ func NewStore(pool *pgxpool.Pool) *Store {
 	return &Store{pool: pool}
 }
 
-func (s *Store) ListFlags(ctx context.Context) ([]model.FeatureFlag, error) {
-	query := fmt.Sprintf(`SELECT id, key, description, enabled, created_at, updated_at FROM %s`, FeatureFlagsTable)
-	rows, err := s.pool.Query(ctx, query)
+func (s *Store) ListFlags(ctx context.Context, orgID uuid.UUID, filter model.FlagFilter) ([]model.FeatureFlag, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s
+		WHERE org_id = $1
+			AND ($2 = '' OR owner = $2)
+			AND ($3 = '' OR $3 = ANY(maintainers))
+			AND ($4 = '' OR kind = $4)
+			AND tags @> $5
+			AND metadata @> $6`, flagColumns, FeatureFlagsTable)
+
+	tags := filter.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	metadata := filter.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	rows, err := s.pool.Query(ctx, query, orgID, filter.Owner, filter.Maintainer, string(filter.Kind), tags, metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -31,8 +55,8 @@ func (s *Store) ListFlags(ctx context.Context) ([]model.FeatureFlag, error) {
 
 	var flags []model.FeatureFlag
 	for rows.Next() {
-		var flag model.FeatureFlag
-		if err := rows.Scan(&flag.ID, &flag.Key, &flag.Description, &flag.Enabled, &flag.CreatedAt, &flag.UpdatedAt); err != nil {
+		flag, err := scanFlag(rows)
+		if err != nil {
 			return nil, err
 		}
 		flags = append(flags, flag)
@@ -41,10 +65,46 @@ func (s *Store) ListFlags(ctx context.Context) ([]model.FeatureFlag, error) {
 	return flags, nil
 }
 
-func (s *Store) GetFlagByID(ctx context.Context, id uuid.UUID) (model.FeatureFlag, error) {
-	var flag model.FeatureFlag
-	query := fmt.Sprintf(`SELECT id, key, description, enabled, created_at, updated_at FROM %s WHERE id = $1`, FeatureFlagsTable)
-	err := s.pool.QueryRow(ctx, query, id).Scan(&flag.ID, &flag.Key, &flag.Description, &flag.Enabled, &flag.CreatedAt, &flag.UpdatedAt)
+// ListExpiringFlags returns the non-permanent flags of every organization that expire at or
+// before the given time, soonest first.
+func (s *Store) ListExpiringFlags(ctx context.Context, before time.Time) ([]model.FeatureFlag, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE NOT permanent AND expires_at IS NOT NULL AND expires_at <= $1
+		ORDER BY expires_at`, flagColumns, FeatureFlagsTable)
+	rows, err := s.pool.Query(ctx, query, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var flags []model.FeatureFlag
+	for rows.Next() {
+		flag, err := scanFlag(rows)
+		if err != nil {
+			return nil, err
+		}
+		flags = append(flags, flag)
+	}
+
+	return flags, rows.Err()
+}
+
+func (s *Store) GetFlagByID(ctx context.Context, orgID, id uuid.UUID) (model.FeatureFlag, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE org_id = $1 AND id = $2`, flagColumns, FeatureFlagsTable)
+	flag, err := scanFlag(s.pool.QueryRow(ctx, query, orgID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.FeatureFlag{}, model.ErrNotFound
+		}
+		return model.FeatureFlag{}, err
+	}
+
+	return flag, nil
+}
+
+func (s *Store) GetFlagByKey(ctx context.Context, orgID uuid.UUID, key string) (model.FeatureFlag, error) {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE org_id = $1 AND key = $2 ORDER BY created_at LIMIT 1`,
+		flagColumns, FeatureFlagsTable)
+	flag, err := scanFlag(s.pool.QueryRow(ctx, query, orgID, key))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return model.FeatureFlag{}, model.ErrNotFound
@@ -56,9 +116,29 @@ func (s *Store) GetFlagByID(ctx context.Context, id uuid.UUID) (model.FeatureFla
 }
 
 func (s *Store) CreateFlag(ctx context.Context, flag model.FeatureFlag) error {
-	query := fmt.Sprintf(`INSERT INTO %s (id, key, description, enabled) 
-		VALUES ($1, $2, $3, $4)`, FeatureFlagsTable)
-	_, err := s.pool.Exec(ctx, query, flag.ID, flag.Key, flag.Description, flag.Enabled)
+	query := fmt.Sprintf(`INSERT INTO %s (id, org_id, key, description, enabled, permanent, protected, owner, owner_type,
+		maintainers, tags, kind, issue_urls, metadata, managed_by, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
+		FeatureFlagsTable)
+	_, err := s.pool.Exec(
+		ctx, query,
+		flag.ID,
+		flag.OrgID,
+		flag.Key,
+		flag.Description,
+		flag.Enabled,
+		flag.Permanent,
+		flag.Protected,
+		flag.Owner,
+		flag.OwnerType,
+		flag.Maintainers,
+		flag.Tags,
+		flag.Kind,
+		flag.IssueURLs,
+		flag.Metadata,
+		flag.ManagedBy,
+		flag.ExpiresAt,
+	)
 	if err != nil {
 		return err
 	}
@@ -66,8 +146,7 @@ func (s *Store) CreateFlag(ctx context.Context, flag model.FeatureFlag) error {
 }
 
 func (s *Store) UpdateFlag(ctx context.Context, flag model.FeatureFlag) error {
-	query := fmt.Sprintf(`UPDATE %s SET key = $1, description = $2, enabled = $3, updated_at = NOW() WHERE id = $4`, FeatureFlagsTable)
-	result, err := s.pool.Exec(ctx, query, flag.Key, flag.Description, flag.Enabled, flag.ID)
+	result, err := s.pool.Exec(ctx, updateFlagQuery, updateFlagArgs(flag)...)
 	if err != nil {
 		return err
 	}
@@ -77,9 +156,9 @@ func (s *Store) UpdateFlag(ctx context.Context, flag model.FeatureFlag) error {
 	return nil
 }
 
-func (s *Store) DeleteFlag(ctx context.Context, id uuid.UUID) error {
-	query := fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, FeatureFlagsTable)
-	result, err := s.pool.Exec(ctx, query, id)
+func (s *Store) DeleteFlag(ctx context.Context, orgID, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE org_id = $1 AND id = $2`, FeatureFlagsTable)
+	result, err := s.pool.Exec(ctx, query, orgID, id)
 	if err != nil {
 		return err
 	}
@@ -88,3 +167,63 @@ func (s *Store) DeleteFlag(ctx context.Context, id uuid.UUID) error {
 	}
 	return nil
 }
+
+// RecordFlagEvaluation stores when the flag was evaluated, without touching updated_at.
+func (s *Store) RecordFlagEvaluation(ctx context.Context, orgID, id uuid.UUID, evaluatedAt time.Time) error {
+	query := fmt.Sprintf(`UPDATE %s SET last_evaluated_at = $3 WHERE org_id = $1 AND id = $2`, FeatureFlagsTable)
+	result, err := s.pool.Exec(ctx, query, orgID, id, evaluatedAt)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+func scanFlag(row pgx.Row) (model.FeatureFlag, error) {
+	var flag model.FeatureFlag
+	err := row.Scan(
+		&flag.ID,
+		&flag.OrgID,
+		&flag.Key,
+		&flag.Description,
+		&flag.Enabled,
+		&flag.Permanent,
+		&flag.Protected,
+		&flag.Owner,
+		&flag.OwnerType,
+		&flag.Maintainers,
+		&flag.Tags,
+		&flag.Kind,
+		&flag.IssueURLs,
+		&flag.Metadata,
+		&flag.ManagedBy,
+		&flag.ExpiresAt,
+		&flag.CreatedAt,
+		&flag.UpdatedAt,
+		&flag.LastEvaluatedAt,
+	)
+	return flag, err
+}
+
+func updateFlagArgs(flag model.FeatureFlag) []any {
+	return []any{
+		flag.Key,
+		flag.Description,
+		flag.Enabled,
+		flag.Permanent,
+		flag.Protected,
+		flag.Owner,
+		flag.OwnerType,
+		flag.Maintainers,
+		flag.Tags,
+		flag.Kind,
+		flag.IssueURLs,
+		flag.Metadata,
+		flag.ManagedBy,
+		flag.ExpiresAt,
+		flag.ID,
+		flag.OrgID,
+	}
+}
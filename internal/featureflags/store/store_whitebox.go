@@ -9,16 +9,45 @@ import (
 )
 
 func (store *Store) AddTestFlag(ctx context.Context, flag model.FeatureFlag) error {
+	if flag.Kind == "" {
+		flag.Kind = model.FlagKindRelease
+	}
+	if flag.Maintainers == nil {
+		flag.Maintainers = []string{}
+	}
+	if flag.Tags == nil {
+		flag.Tags = []string{}
+	}
+	if flag.IssueURLs == nil {
+		flag.IssueURLs = []string{}
+	}
+	if flag.Metadata == nil {
+		flag.Metadata = map[string]string{}
+	}
+
 	query := fmt.Sprintf(`
-        INSERT INTO %s (id, key, description, enabled, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5, $6)
+        INSERT INTO %s (id, org_id, key, description, enabled, permanent, protected, owner, owner_type, maintainers, tags,
+            kind, issue_urls, metadata, managed_by, expires_at, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
     `, FeatureFlagsTable)
 	_, err := store.pool.Exec(
 		ctx, query,
 		flag.ID,
+		flag.OrgID,
 		flag.Key,
 		flag.Description,
 		flag.Enabled,
+		flag.Permanent,
+		flag.Protected,
+		flag.Owner,
+		flag.OwnerType,
+		flag.Maintainers,
+		flag.Tags,
+		flag.Kind,
+		flag.IssueURLs,
+		flag.Metadata,
+		flag.ManagedBy,
+		flag.ExpiresAt,
 		flag.CreatedAt,
 		flag.UpdatedAt,
 	)
@@ -32,13 +61,39 @@ func (store *Store) RemoveTestFlag(ctx context.Context, id uuid.UUID) error {
 }
 
 func (store *Store) FetchTestFlagByID(ctx context.Context, id uuid.UUID) (model.FeatureFlag, error) {
-	query := fmt.Sprintf(`SELECT id, key, description, enabled, created_at, updated_at FROM %s WHERE id = $1`, FeatureFlagsTable)
-	var flag model.FeatureFlag
-	if err := store.pool.QueryRow(ctx, query, id).Scan(
-		&flag.ID, &flag.Key, &flag.Description, &flag.Enabled, &flag.CreatedAt, &flag.UpdatedAt,
-	); err != nil {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE id = $1`, flagColumns, FeatureFlagsTable)
+	flag, err := scanFlag(store.pool.QueryRow(ctx, query, id))
+	if err != nil {
 		return model.FeatureFlag{}, fmt.Errorf("failed to get test feature flag: %w", err)
 	}
 
 	return flag, nil
 }
+
+func (store *Store) RemoveTestChangeRequest(ctx context.Context, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, ChangeRequestsTable)
+	_, err := store.pool.Exec(ctx, query, id)
+	return err
+}
+
+func (store *Store) RemoveTestKillSwitch(ctx context.Context, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, KillSwitchesTable)
+	_, err := store.pool.Exec(ctx, query, id)
+	return err
+}
+
+func (store *Store) RemoveTestCodeReferences(ctx context.Context, repository string) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE repository = $1`, CodeReferencesTable)
+	if _, err := store.pool.Exec(ctx, query, repository); err != nil {
+		return err
+	}
+	query = fmt.Sprintf(`DELETE FROM %s WHERE repository = $1`, CodeReferenceScansTable)
+	_, err := store.pool.Exec(ctx, query, repository)
+	return err
+}
+
+func (store *Store) RemoveTestGroup(ctx context.Context, id uuid.UUID) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, GroupsTable)
+	_, err := store.pool.Exec(ctx, query, id)
+	return err
+}
@@ -0,0 +1,299 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func describeChangeRequests(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("Change Requests Store", func() {
+		var (
+			s             Store
+			orgID         uuid.UUID
+			flag          model.FeatureFlag
+			changeRequest model.ChangeRequest
+			authorID      uuid.UUID
+			reviewerID    uuid.UUID
+			errAction     error
+		)
+
+		BeforeEach(func() {
+			s = newStore()
+			orgID = uuid.New()
+			authorID = uuid.New()
+			reviewerID = uuid.New()
+
+			flag = model.FeatureFlag{
+				ID:          uuid.New(),
+				OrgID:       orgID,
+				Key:         "protected-flag",
+				Description: "protected description",
+				Enabled:     true,
+				Protected:   true,
+				CreatedAt:   time.Now().UTC(),
+				UpdatedAt:   time.Now().UTC(),
+			}
+			Expect(s.AddTestFlag(ctx, flag)).To(Succeed())
+
+			changeRequest = model.ChangeRequest{
+				ID:       uuid.New(),
+				OrgID:    orgID,
+				FlagID:   flag.ID,
+				Action:   model.ChangeRequestActionUpdate,
+				Flag:     &model.FeatureFlagRequest{Key: "protected-flag", Description: "updated description", Enabled: false, Protected: true},
+				Status:   model.ChangeRequestStatusPending,
+				Comment:  "disable it",
+				AuthorID: authorID,
+			}
+			Expect(s.CreateChangeRequest(ctx, changeRequest)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(s.RemoveTestChangeRequest(ctx, changeRequest.ID)).To(Succeed())
+			Expect(s.RemoveTestFlag(ctx, flag.ID)).To(Succeed())
+		})
+
+		ItSucceeds := func() {
+			It("succeeds", func() {
+				Expect(errAction).NotTo(HaveOccurred())
+			})
+		}
+
+		Describe("GetChangeRequestByID", func() {
+			var fetched model.ChangeRequest
+
+			JustBeforeEach(func() {
+				fetched, errAction = s.GetChangeRequestByID(ctx, orgID, changeRequest.ID)
+			})
+
+			ItSucceeds()
+			It("returns the change request with its payload", func() {
+				Expect(fetched).To(MatchFields(IgnoreExtras, Fields{
+					"ID":         Equal(changeRequest.ID),
+					"OrgID":      Equal(orgID),
+					"FlagID":     Equal(flag.ID),
+					"Action":     Equal(model.ChangeRequestActionUpdate),
+					"Flag":       Equal(changeRequest.Flag),
+					"Status":     Equal(model.ChangeRequestStatusPending),
+					"Comment":    Equal(changeRequest.Comment),
+					"AuthorID":   Equal(authorID),
+					"ReviewerID": BeNil(),
+					"AppliedAt":  BeNil(),
+				}))
+			})
+
+			Context("when the change request does not exist", func() {
+				JustBeforeEach(func() {
+					_, errAction = s.GetChangeRequestByID(ctx, orgID, uuid.New())
+				})
+
+				It("returns not found error", func() {
+					Expect(errAction).To(MatchError(model.ErrChangeRequestNotFound))
+				})
+			})
+
+			Context("when the change request belongs to another organization", func() {
+				JustBeforeEach(func() {
+					_, errAction = s.GetChangeRequestByID(ctx, uuid.New(), changeRequest.ID)
+				})
+
+				It("returns not found error", func() {
+					Expect(errAction).To(MatchError(model.ErrChangeRequestNotFound))
+				})
+			})
+		})
+
+		Describe("ListChangeRequests", func() {
+			var changeRequests []model.ChangeRequest
+
+			JustBeforeEach(func() {
+				changeRequests, errAction = s.ListChangeRequests(ctx, orgID, model.ChangeRequestStatusPending)
+			})
+
+			ItSucceeds()
+			It("returns the change requests with the status", func() {
+				Expect(changeRequests).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"ID": Equal(changeRequest.ID),
+				})))
+			})
+
+			Context("when filtering by another status", func() {
+				JustBeforeEach(func() {
+					changeRequests, errAction = s.ListChangeRequests(ctx, orgID, model.ChangeRequestStatusApplied)
+				})
+
+				It("does not return the change request", func() {
+					Expect(changeRequests).NotTo(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"ID": Equal(changeRequest.ID),
+					})))
+				})
+			})
+
+			Context("when listing another organization", func() {
+				JustBeforeEach(func() {
+					changeRequests, errAction = s.ListChangeRequests(ctx, uuid.New(), "")
+				})
+
+				It("does not return the change request", func() {
+					Expect(changeRequests).To(BeEmpty())
+				})
+			})
+		})
+
+		Describe("ReviewChangeRequest", func() {
+			JustBeforeEach(func() {
+				errAction = s.ReviewChangeRequest(ctx, orgID, changeRequest.ID, model.ChangeRequestStatusApproved, reviewerID, "lgtm")
+			})
+
+			ItSucceeds()
+			It("records the review", func() {
+				fetched, err := s.GetChangeRequestByID(ctx, orgID, changeRequest.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fetched.Status).To(Equal(model.ChangeRequestStatusApproved))
+				Expect(fetched.ReviewerID).To(PointTo(Equal(reviewerID)))
+				Expect(fetched.ReviewComment).To(Equal("lgtm"))
+				Expect(fetched.ReviewedAt).NotTo(BeNil())
+			})
+
+			Context("when the change request was already reviewed", func() {
+				BeforeEach(func() {
+					Expect(s.ReviewChangeRequest(ctx, orgID, changeRequest.ID, model.ChangeRequestStatusRejected, reviewerID, "")).To(Succeed())
+				})
+
+				It("returns the state error", func() {
+					Expect(errAction).To(MatchError(model.ErrChangeRequestState))
+				})
+			})
+
+			Context("when reviewing from another organization", func() {
+				JustBeforeEach(func() {
+					errAction = s.ReviewChangeRequest(ctx, uuid.New(), changeRequest.ID, model.ChangeRequestStatusRejected, reviewerID, "")
+				})
+
+				It("returns the state error and keeps the review", func() {
+					Expect(errAction).To(MatchError(model.ErrChangeRequestState))
+					fetched, err := s.GetChangeRequestByID(ctx, orgID, changeRequest.ID)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fetched.Status).To(Equal(model.ChangeRequestStatusApproved))
+				})
+			})
+		})
+
+		Describe("CancelChangeRequest", func() {
+			JustBeforeEach(func() {
+				errAction = s.CancelChangeRequest(ctx, orgID, changeRequest.ID, authorID)
+			})
+
+			ItSucceeds()
+			It("records who cancelled the change request", func() {
+				fetched, err := s.GetChangeRequestByID(ctx, orgID, changeRequest.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fetched.Status).To(Equal(model.ChangeRequestStatusCancelled))
+				Expect(fetched.CancelledBy).To(PointTo(Equal(authorID)))
+			})
+		})
+
+		Describe("ApplyChangeRequest", func() {
+			BeforeEach(func() {
+				Expect(s.ReviewChangeRequest(ctx, orgID, changeRequest.ID, model.ChangeRequestStatusApproved, reviewerID, "")).To(Succeed())
+			})
+
+			JustBeforeEach(func() {
+				errAction = s.ApplyChangeRequest(ctx, changeRequest, &authorID)
+			})
+
+			ItSucceeds()
+			It("updates the flag and records who applied the change request", func() {
+				updatedFlag, err := s.FetchTestFlagByID(ctx, flag.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedFlag.Description).To(Equal("updated description"))
+				Expect(updatedFlag.Enabled).To(BeFalse())
+
+				fetched, err := s.GetChangeRequestByID(ctx, orgID, changeRequest.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fetched.Status).To(Equal(model.ChangeRequestStatusApplied))
+				Expect(fetched.AppliedBy).To(PointTo(Equal(authorID)))
+				Expect(fetched.AppliedAt).NotTo(BeNil())
+			})
+
+			Context("when the change request deletes the flag", func() {
+				BeforeEach(func() {
+					changeRequest.Action = model.ChangeRequestActionDelete
+				})
+
+				It("deletes the flag", func() {
+					_, err := s.GetFlagByID(ctx, orgID, flag.ID)
+					Expect(err).To(MatchError(model.ErrNotFound))
+				})
+			})
+
+			Context("when the change request was already applied", func() {
+				BeforeEach(func() {
+					Expect(s.ApplyChangeRequest(ctx, changeRequest, &authorID)).To(Succeed())
+				})
+
+				It("returns the state error", func() {
+					Expect(errAction).To(MatchError(model.ErrChangeRequestState))
+				})
+			})
+
+			Context("when the change request belongs to another organization", func() {
+				BeforeEach(func() {
+					changeRequest.OrgID = uuid.New()
+				})
+
+				It("returns the state error and leaves the flag unchanged", func() {
+					Expect(errAction).To(MatchError(model.ErrChangeRequestState))
+					stored, err := s.FetchTestFlagByID(ctx, flag.ID)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(stored.Description).To(Equal(flag.Description))
+				})
+			})
+
+			Context("when the flag no longer exists", func() {
+				BeforeEach(func() {
+					Expect(s.RemoveTestFlag(ctx, flag.ID)).To(Succeed())
+				})
+
+				It("returns not found error and keeps the change request approved", func() {
+					Expect(errAction).To(MatchError(model.ErrNotFound))
+
+					fetched, err := s.GetChangeRequestByID(ctx, orgID, changeRequest.ID)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fetched.Status).To(Equal(model.ChangeRequestStatusApproved))
+				})
+			})
+		})
+
+		Describe("ListDueChangeRequests", func() {
+			var changeRequests []model.ChangeRequest
+
+			BeforeEach(func() {
+				Expect(s.RemoveTestChangeRequest(ctx, changeRequest.ID)).To(Succeed())
+				scheduledAt := time.Now().UTC().Add(-time.Minute)
+				changeRequest.ScheduledAt = &scheduledAt
+				Expect(s.CreateChangeRequest(ctx, changeRequest)).To(Succeed())
+				Expect(s.ReviewChangeRequest(ctx, orgID, changeRequest.ID, model.ChangeRequestStatusApproved, reviewerID, "")).To(Succeed())
+			})
+
+			JustBeforeEach(func() {
+				changeRequests, errAction = s.ListDueChangeRequests(ctx, time.Now().UTC())
+			})
+
+			ItSucceeds()
+			It("returns the approved change requests scheduled in the past", func() {
+				Expect(changeRequests).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"ID": Equal(changeRequest.ID),
+				})))
+			})
+		})
+	})
+}
@@ -0,0 +1,96 @@
+package storetest
+
+import (
+	"context"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func describeCodeReferences(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("Code References Store", func() {
+		const repository = "github.com/acme/store-test"
+
+		var (
+			s          Store
+			orgID      uuid.UUID
+			references []model.CodeReference
+		)
+
+		BeforeEach(func() {
+			s = newStore()
+			orgID = uuid.New()
+			references = []model.CodeReference{
+				{Repository: repository, Key: "coderefs-flag", Path: "main.go", Line: 4},
+				{Repository: repository, Key: "coderefs-flag", Path: "web/app.tsx", Line: 1},
+			}
+			Expect(s.ReplaceCodeReferences(ctx, orgID, repository, references, uuid.New())).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(s.RemoveTestCodeReferences(ctx, repository)).To(Succeed())
+		})
+
+		It("lists the references of a key", func() {
+			stored, err := s.ListCodeReferences(ctx, orgID, "coderefs-flag")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored).To(Equal(references))
+		})
+
+		It("counts the references per key and records the scan", func() {
+			counts, err := s.CountCodeReferences(ctx, orgID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(HaveKeyWithValue("coderefs-flag", 2))
+
+			scanned, err := s.HasCodeReferenceScans(ctx, orgID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scanned).To(BeTrue())
+		})
+
+		Context("when the repository is scanned again", func() {
+			BeforeEach(func() {
+				references = references[:1]
+				Expect(s.ReplaceCodeReferences(ctx, orgID, repository, references, uuid.New())).To(Succeed())
+			})
+
+			It("replaces the previous references", func() {
+				stored, err := s.ListCodeReferences(ctx, orgID, "coderefs-flag")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored).To(Equal(references))
+			})
+		})
+
+		Context("when another organization scans the same repository", func() {
+			var otherOrgID uuid.UUID
+
+			BeforeEach(func() {
+				otherOrgID = uuid.New()
+				Expect(s.ReplaceCodeReferences(ctx, otherOrgID, repository, references[:1], uuid.New())).To(Succeed())
+			})
+
+			It("keeps the references of each organization apart", func() {
+				stored, err := s.ListCodeReferences(ctx, orgID, "coderefs-flag")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored).To(Equal(references))
+
+				counts, err := s.CountCodeReferences(ctx, otherOrgID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(counts).To(HaveKeyWithValue("coderefs-flag", 1))
+			})
+		})
+
+		It("reports no scans for other organizations", func() {
+			scanned, err := s.HasCodeReferenceScans(ctx, uuid.New())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scanned).To(BeFalse())
+
+			stored, err := s.ListCodeReferences(ctx, uuid.New(), "coderefs-flag")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored).To(BeEmpty())
+		})
+	})
+}
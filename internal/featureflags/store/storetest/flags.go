@@ -0,0 +1,459 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func describeFlags(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("Feature Flags Store", func() {
+		var (
+			s          Store
+			orgID      uuid.UUID
+			otherOrgID uuid.UUID
+			flagID     uuid.UUID
+			flag       model.FeatureFlag
+			errAction  error
+		)
+
+		BeforeEach(func() {
+			s = newStore()
+
+			orgID = uuid.New()
+			otherOrgID = uuid.New()
+			flagID = uuid.New()
+			flag = model.FeatureFlag{
+				ID:          flagID,
+				OrgID:       orgID,
+				Key:         "test-flag",
+				Description: "test-description",
+				Enabled:     true,
+				Permanent:   true,
+				Owner:       "payments",
+				OwnerType:   model.OwnerTypeTeam,
+				Maintainers: []string{"john"},
+				Tags:        []string{"checkout", "web"},
+				Kind:        model.FlagKindOps,
+				IssueURLs:   []string{"https://issues.example.com/PAY-1"},
+				Metadata:    map[string]string{"cost_center": "42"},
+				CreatedAt:   time.Now().UTC(),
+				UpdatedAt:   time.Now().UTC(),
+			}
+		})
+
+		ItSucceeds := func() {
+			It("succeeds", func() {
+				Expect(errAction).NotTo(HaveOccurred())
+			})
+		}
+
+		Describe("ListFlags", func() {
+			var (
+				flags  []model.FeatureFlag
+				filter model.FlagFilter
+			)
+
+			BeforeEach(func() {
+				filter = model.FlagFilter{}
+				err := s.AddTestFlag(ctx, flag)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				err := s.RemoveTestFlag(ctx, flag.ID)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			JustBeforeEach(func() {
+				flags, errAction = s.ListFlags(ctx, orgID, filter)
+			})
+
+			ItSucceeds()
+			It("returns all the feature flags", func() {
+				Expect(flags).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"ID":          Equal(flag.ID),
+					"OrgID":       Equal(orgID),
+					"Key":         Equal(flag.Key),
+					"Description": Equal(flag.Description),
+					"Enabled":     Equal(flag.Enabled),
+					"Permanent":   Equal(flag.Permanent),
+					"Owner":       Equal(flag.Owner),
+					"OwnerType":   Equal(flag.OwnerType),
+					"Maintainers": Equal(flag.Maintainers),
+					"Tags":        Equal(flag.Tags),
+					"Kind":        Equal(flag.Kind),
+					"IssueURLs":   Equal(flag.IssueURLs),
+					"Metadata":    Equal(flag.Metadata),
+					"CreatedAt":   BeTemporally("~", time.Now().UTC(), time.Second),
+					"UpdatedAt":   BeTemporally("~", time.Now().UTC(), time.Second),
+				})))
+			})
+
+			Context("when filtering by matching metadata", func() {
+				BeforeEach(func() {
+					filter = model.FlagFilter{
+						Owner:      "payments",
+						Maintainer: "john",
+						Kind:       model.FlagKindOps,
+						Tags:       []string{"checkout"},
+						Metadata:   map[string]string{"cost_center": "42"},
+					}
+				})
+
+				ItSucceeds()
+				It("returns the feature flag", func() {
+					Expect(flags).To(ContainElement(MatchFields(IgnoreExtras, Fields{"ID": Equal(flag.ID)})))
+				})
+			})
+
+			Context("when a filter does not match", func() {
+				BeforeEach(func() {
+					filter = model.FlagFilter{Tags: []string{"checkout", "mobile"}}
+				})
+
+				ItSucceeds()
+				It("does not return the feature flag", func() {
+					Expect(flags).NotTo(ContainElement(MatchFields(IgnoreExtras, Fields{"ID": Equal(flag.ID)})))
+				})
+			})
+
+			Context("when listing another organization", func() {
+				BeforeEach(func() {
+					orgID = otherOrgID
+				})
+
+				ItSucceeds()
+				It("does not return the feature flag", func() {
+					Expect(flags).To(BeEmpty())
+				})
+			})
+		})
+
+		Describe("GetFlagByID", func() {
+			var fetchedFlag model.FeatureFlag
+
+			BeforeEach(func() {
+				err := s.AddTestFlag(ctx, flag)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				err := s.RemoveTestFlag(ctx, flag.ID)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			JustBeforeEach(func() {
+				fetchedFlag, errAction = s.GetFlagByID(ctx, orgID, flagID)
+			})
+
+			ItSucceeds()
+			It("returns the matching feature flag", func() {
+				Expect(fetchedFlag.ID).To(Equal(flag.ID))
+				Expect(fetchedFlag.OrgID).To(Equal(flag.OrgID))
+				Expect(fetchedFlag.Key).To(Equal(flag.Key))
+				Expect(fetchedFlag.Description).To(Equal(flag.Description))
+				Expect(fetchedFlag.Enabled).To(Equal(flag.Enabled))
+			})
+
+			Context("when the feature flag does not exist", func() {
+				BeforeEach(func() {
+					flagID = uuid.New()
+				})
+
+				It("returns an error", func() {
+					Expect(errAction).To(MatchError(model.ErrNotFound))
+				})
+			})
+
+			Context("when the feature flag belongs to another organization", func() {
+				BeforeEach(func() {
+					orgID = otherOrgID
+				})
+
+				It("returns not found error", func() {
+					Expect(errAction).To(MatchError(model.ErrNotFound))
+				})
+			})
+		})
+
+		Describe("ListExpiringFlags", func() {
+			var (
+				flags         []model.FeatureFlag
+				permanentFlag model.FeatureFlag
+			)
+
+			BeforeEach(func() {
+				expiresAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Microsecond)
+				flag.Permanent = false
+				flag.ExpiresAt = &expiresAt
+				Expect(s.AddTestFlag(ctx, flag)).To(Succeed())
+
+				permanentFlag = flag
+				permanentFlag.ID = uuid.New()
+				permanentFlag.Key = "permanent-flag"
+				permanentFlag.Permanent = true
+				Expect(s.AddTestFlag(ctx, permanentFlag)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(s.RemoveTestFlag(ctx, flag.ID)).To(Succeed())
+				Expect(s.RemoveTestFlag(ctx, permanentFlag.ID)).To(Succeed())
+			})
+
+			JustBeforeEach(func() {
+				flags, errAction = s.ListExpiringFlags(ctx, time.Now().UTC())
+			})
+
+			ItSucceeds()
+			It("returns the expired flags except permanent ones", func() {
+				Expect(flags).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"ID":        Equal(flag.ID),
+					"ExpiresAt": PointTo(BeTemporally("==", *flag.ExpiresAt)),
+				})))
+				Expect(flags).NotTo(ContainElement(MatchFields(IgnoreExtras, Fields{"ID": Equal(permanentFlag.ID)})))
+			})
+		})
+
+		Describe("GetFlagByKey", func() {
+			var (
+				fetchedFlag model.FeatureFlag
+				key         string
+			)
+
+			BeforeEach(func() {
+				key = flag.Key
+				err := s.AddTestFlag(ctx, flag)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				err := s.RemoveTestFlag(ctx, flag.ID)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			JustBeforeEach(func() {
+				fetchedFlag, errAction = s.GetFlagByKey(ctx, orgID, key)
+			})
+
+			ItSucceeds()
+			It("returns the matching feature flag", func() {
+				Expect(fetchedFlag.ID).To(Equal(flag.ID))
+				Expect(fetchedFlag.Enabled).To(Equal(flag.Enabled))
+			})
+
+			Context("when no feature flag has the key", func() {
+				BeforeEach(func() {
+					key = "missing-key"
+				})
+
+				It("returns an error", func() {
+					Expect(errAction).To(MatchError(model.ErrNotFound))
+				})
+			})
+
+			Context("when only another organization has the key", func() {
+				BeforeEach(func() {
+					orgID = otherOrgID
+				})
+
+				It("returns not found error", func() {
+					Expect(errAction).To(MatchError(model.ErrNotFound))
+				})
+			})
+		})
+
+		Describe("CreateFlag", func() {
+			JustBeforeEach(func() {
+				errAction = s.CreateFlag(ctx, flag)
+			})
+
+			JustAfterEach(func() {
+				err := s.RemoveTestFlag(ctx, flag.ID)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			ItSucceeds()
+			It("inserts the feature flag into the database", func() {
+				insertedFlag, err := s.FetchTestFlagByID(ctx, flag.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(insertedFlag).To((MatchFields(IgnoreExtras, Fields{
+					"ID":          Equal(flag.ID),
+					"OrgID":       Equal(flag.OrgID),
+					"Key":         Equal(flag.Key),
+					"Description": Equal(flag.Description),
+					"Enabled":     Equal(flag.Enabled),
+					"Permanent":   Equal(flag.Permanent),
+					"Owner":       Equal(flag.Owner),
+					"Maintainers": Equal(flag.Maintainers),
+					"Metadata":    Equal(flag.Metadata),
+					"CreatedAt":   BeTemporally("~", time.Now().UTC(), time.Second),
+					"UpdatedAt":   BeTemporally("~", time.Now().UTC(), time.Second),
+				})))
+			})
+		})
+
+		Describe("UpdateFlag", func() {
+			BeforeEach(func() {
+				err := s.AddTestFlag(ctx, flag)
+				Expect(err).NotTo(HaveOccurred())
+
+				flag.Key = "updated-flag"
+				flag.Description = "updated-description"
+				flag.Permanent = false
+				flag.Tags = []string{"checkout"}
+				flag.Kind = model.FlagKindExperiment
+				flag.UpdatedAt = time.Now().UTC()
+			})
+
+			AfterEach(func() {
+				err := s.RemoveTestFlag(ctx, flag.ID)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			JustBeforeEach(func() {
+				errAction = s.UpdateFlag(ctx, flag)
+			})
+
+			ItSucceeds()
+			It("updates the feature flag in the database", func() {
+				updatedFlag, err := s.FetchTestFlagByID(ctx, flag.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedFlag).To((MatchFields(IgnoreExtras, Fields{
+					"ID":          Equal(flag.ID),
+					"Key":         Equal(flag.Key),
+					"Description": Equal(flag.Description),
+					"Enabled":     Equal(flag.Enabled),
+					"Permanent":   Equal(flag.Permanent),
+					"Tags":        Equal(flag.Tags),
+					"Kind":        Equal(flag.Kind),
+					"CreatedAt":   BeTemporally("~", time.Now().UTC(), time.Second),
+					"UpdatedAt":   BeTemporally("~", time.Now().UTC(), time.Second),
+				})))
+			})
+
+			Context("when the feature flag belongs to another organization", func() {
+				var original model.FeatureFlag
+
+				BeforeEach(func() {
+					original, _ = s.FetchTestFlagByID(ctx, flag.ID)
+					flag.OrgID = otherOrgID
+				})
+
+				It("returns not found error", func() {
+					Expect(errAction).To(MatchError(model.ErrNotFound))
+				})
+
+				It("leaves the feature flag unchanged", func() {
+					stored, err := s.FetchTestFlagByID(ctx, flag.ID)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(stored.Key).To(Equal(original.Key))
+					Expect(stored.OrgID).To(Equal(orgID))
+				})
+			})
+		})
+
+		Describe("RecordFlagEvaluation", func() {
+			var (
+				evaluatedAt time.Time
+				original    model.FeatureFlag
+			)
+
+			BeforeEach(func() {
+				Expect(s.AddTestFlag(ctx, flag)).To(Succeed())
+				original, _ = s.FetchTestFlagByID(ctx, flag.ID)
+				evaluatedAt = time.Now().UTC().Add(time.Minute).Truncate(time.Second)
+			})
+
+			AfterEach(func() {
+				Expect(s.RemoveTestFlag(ctx, flag.ID)).To(Succeed())
+			})
+
+			JustBeforeEach(func() {
+				errAction = s.RecordFlagEvaluation(ctx, orgID, flag.ID, evaluatedAt)
+			})
+
+			ItSucceeds()
+			It("stores the evaluation time without changing the update time", func() {
+				stored, err := s.FetchTestFlagByID(ctx, flag.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored.LastEvaluatedAt).To(PointTo(BeTemporally("==", evaluatedAt)))
+				Expect(stored.UpdatedAt).To(BeTemporally("==", original.UpdatedAt))
+			})
+
+			It("keeps the evaluation time when the flag is updated", func() {
+				flag.Description = "updated-description"
+				Expect(s.UpdateFlag(ctx, flag)).To(Succeed())
+
+				stored, err := s.FetchTestFlagByID(ctx, flag.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stored.LastEvaluatedAt).To(PointTo(BeTemporally("==", evaluatedAt)))
+			})
+
+			Context("when the feature flag belongs to another organization", func() {
+				BeforeEach(func() {
+					orgID = otherOrgID
+				})
+
+				It("returns not found error and leaves the feature flag unchanged", func() {
+					Expect(errAction).To(MatchError(model.ErrNotFound))
+					stored, err := s.FetchTestFlagByID(ctx, flag.ID)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(stored.LastEvaluatedAt).To(BeNil())
+				})
+			})
+		})
+
+		Describe("DeleteFlag", func() {
+			JustBeforeEach(func() {
+				errAction = s.DeleteFlag(ctx, orgID, flag.ID)
+			})
+
+			Context("when the feature flag exist", func() {
+				BeforeEach(func() {
+					err := s.AddTestFlag(ctx, flag)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				ItSucceeds()
+				It("deletes the feature flag", func() {
+					_, err := s.GetFlagByID(ctx, flag.OrgID, flag.ID)
+					Expect(err).To(MatchError(model.ErrNotFound))
+				})
+
+				Context("and belongs to another organization", func() {
+					BeforeEach(func() {
+						orgID = otherOrgID
+					})
+
+					AfterEach(func() {
+						Expect(s.RemoveTestFlag(ctx, flag.ID)).To(Succeed())
+					})
+
+					It("returns not found error and keeps the feature flag", func() {
+						Expect(errAction).To(MatchError(model.ErrNotFound))
+						_, err := s.GetFlagByID(ctx, flag.OrgID, flag.ID)
+						Expect(err).NotTo(HaveOccurred())
+					})
+				})
+			})
+
+			Context("when the feature flag does not exist", func() {
+				BeforeEach(func() {
+					flag.ID = uuid.New()
+				})
+
+				It("returns not found error", func() {
+					Expect(errAction).To(MatchError(model.ErrNotFound))
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,205 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func describeGroups(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("Groups Store", func() {
+		var (
+			s      Store
+			orgID  uuid.UUID
+			group  model.Group
+			userID uuid.UUID
+		)
+
+		BeforeEach(func() {
+			s = newStore()
+			orgID = uuid.New()
+			userID = uuid.New()
+			group = model.Group{
+				ID:          uuid.New(),
+				OrgID:       orgID,
+				Name:        "payments",
+				Description: "Payments team",
+				CreatedAt:   time.Now().UTC(),
+			}
+			Expect(s.CreateGroup(ctx, group)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(s.RemoveTestGroup(ctx, group.ID)).To(Succeed())
+		})
+
+		It("returns the group", func() {
+			stored, err := s.GetGroupByID(ctx, orgID, group.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored).To(MatchFields(IgnoreExtras, Fields{
+				"ID":          Equal(group.ID),
+				"OrgID":       Equal(orgID),
+				"Name":        Equal("payments"),
+				"Description": Equal("Payments team"),
+			}))
+
+			groups, err := s.ListGroups(ctx, orgID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(ConsistOf(MatchFields(IgnoreExtras, Fields{"ID": Equal(group.ID)})))
+		})
+
+		It("does not return it to other organizations", func() {
+			_, err := s.GetGroupByID(ctx, uuid.New(), group.ID)
+			Expect(err).To(MatchError(model.ErrGroupNotFound))
+			Expect(s.DeleteGroup(ctx, uuid.New(), group.ID)).To(MatchError(model.ErrGroupNotFound))
+		})
+
+		It("refuses a second group with the same name", func() {
+			err := s.CreateGroup(ctx, model.Group{ID: uuid.New(), OrgID: orgID, Name: "payments", CreatedAt: time.Now().UTC()})
+			Expect(err).To(MatchError(model.ErrGroupExists))
+		})
+
+		It("updates the group", func() {
+			group.Name = "billing"
+			group.Description = ""
+			Expect(s.UpdateGroup(ctx, group)).To(Succeed())
+
+			stored, err := s.GetGroupByID(ctx, orgID, group.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored.Name).To(Equal("billing"))
+			Expect(stored.Description).To(BeEmpty())
+
+			Expect(s.UpdateGroup(ctx, model.Group{ID: uuid.New(), OrgID: orgID, Name: "other"})).
+				To(MatchError(model.ErrGroupNotFound))
+		})
+
+		It("manages the members", func() {
+			Expect(s.AddGroupMember(ctx, group.ID, userID)).To(Succeed())
+			Expect(s.AddGroupMember(ctx, group.ID, userID)).To(Succeed())
+
+			members, err := s.ListGroupMembers(ctx, group.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(ConsistOf(MatchFields(IgnoreExtras, Fields{"UserID": Equal(userID)})))
+
+			groupIDs, err := s.ListUserGroupIDs(ctx, orgID, userID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groupIDs).To(Equal([]uuid.UUID{group.ID}))
+
+			groupIDs, err = s.ListUserGroupIDs(ctx, uuid.New(), userID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groupIDs).To(BeEmpty())
+
+			Expect(s.RemoveGroupMember(ctx, group.ID, userID)).To(Succeed())
+			Expect(s.RemoveGroupMember(ctx, group.ID, userID)).To(MatchError(model.ErrGroupMemberNotFound))
+		})
+
+		It("refuses members of a missing group", func() {
+			Expect(s.AddGroupMember(ctx, uuid.New(), userID)).To(MatchError(model.ErrGroupNotFound))
+		})
+
+		Context("with access rules", func() {
+			var (
+				flag    model.FeatureFlag
+				tagRule model.AccessRule
+			)
+
+			BeforeEach(func() {
+				flag = model.FeatureFlag{ID: uuid.New(), OrgID: orgID, Key: "groups-flag", Description: "description"}
+				Expect(s.AddTestFlag(ctx, flag)).To(Succeed())
+
+				flagRule := model.AccessRule{
+					ID:         uuid.New(),
+					OrgID:      orgID,
+					GroupID:    group.ID,
+					FlagID:     &flag.ID,
+					Permission: model.PermissionWrite,
+					CreatedAt:  time.Now().UTC(),
+				}
+				tagRule = model.AccessRule{
+					ID:         uuid.New(),
+					OrgID:      orgID,
+					GroupID:    group.ID,
+					Tag:        "payments",
+					Permission: model.PermissionRead,
+					CreatedAt:  time.Now().UTC().Add(time.Second),
+				}
+				Expect(s.CreateAccessRule(ctx, flagRule)).To(Succeed())
+				Expect(s.CreateAccessRule(ctx, tagRule)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(s.RemoveTestFlag(ctx, flag.ID)).To(Succeed())
+			})
+
+			It("lists the rules of the organization", func() {
+				rules, err := s.ListAccessRules(ctx, orgID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rules).To(HaveExactElements(
+					MatchFields(IgnoreExtras, Fields{
+						"FlagID":     PointTo(Equal(flag.ID)),
+						"Tag":        BeEmpty(),
+						"Permission": Equal(model.PermissionWrite),
+					}),
+					MatchFields(IgnoreExtras, Fields{
+						"FlagID":     BeNil(),
+						"Tag":        Equal("payments"),
+						"Permission": Equal(model.PermissionRead),
+					}),
+				))
+
+				rules, err = s.ListAccessRules(ctx, uuid.New())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rules).To(BeEmpty())
+			})
+
+			It("deletes a rule", func() {
+				Expect(s.DeleteAccessRule(ctx, orgID, tagRule.ID)).To(Succeed())
+				Expect(s.DeleteAccessRule(ctx, orgID, tagRule.ID)).To(MatchError(model.ErrAccessRuleNotFound))
+
+				rules, err := s.ListAccessRules(ctx, orgID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rules).To(HaveLen(1))
+			})
+
+			It("drops the rules of a deleted flag", func() {
+				Expect(s.DeleteFlag(ctx, orgID, flag.ID)).To(Succeed())
+
+				rules, err := s.ListAccessRules(ctx, orgID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rules).To(ConsistOf(MatchFields(IgnoreExtras, Fields{"ID": Equal(tagRule.ID)})))
+			})
+
+			It("drops the rules and members of a deleted group", func() {
+				Expect(s.AddGroupMember(ctx, group.ID, userID)).To(Succeed())
+				Expect(s.DeleteGroup(ctx, orgID, group.ID)).To(Succeed())
+
+				rules, err := s.ListAccessRules(ctx, orgID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rules).To(BeEmpty())
+
+				groupIDs, err := s.ListUserGroupIDs(ctx, orgID, userID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(groupIDs).To(BeEmpty())
+			})
+
+			It("refuses rules for a missing group", func() {
+				err := s.CreateAccessRule(ctx, model.AccessRule{
+					ID:         uuid.New(),
+					OrgID:      orgID,
+					GroupID:    uuid.New(),
+					Tag:        "payments",
+					Permission: model.PermissionRead,
+					CreatedAt:  time.Now().UTC(),
+				})
+				Expect(err).To(MatchError(model.ErrGroupNotFound))
+			})
+		})
+	})
+}
@@ -0,0 +1,103 @@
+package storetest
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+func describeKillSwitch(newStore func() Store) {
+	ctx := context.Background()
+
+	Describe("Kill Switch Store", func() {
+		var (
+			s          Store
+			orgID      uuid.UUID
+			killSwitch model.KillSwitch
+			userID     uuid.UUID
+		)
+
+		BeforeEach(func() {
+			s = newStore()
+			orgID = uuid.New()
+			userID = uuid.New()
+			killSwitch = model.KillSwitch{
+				ID:          uuid.New(),
+				OrgID:       orgID,
+				Reason:      "incident",
+				Tag:         "payments",
+				ActivatedBy: userID,
+				ActivatedAt: time.Now().UTC(),
+			}
+		})
+
+		AfterEach(func() {
+			Expect(s.RemoveTestKillSwitch(ctx, killSwitch.ID)).To(Succeed())
+		})
+
+		It("reports no active kill switch by default", func() {
+			_, err := s.GetActiveKillSwitch(ctx, orgID)
+			Expect(err).To(MatchError(model.ErrKillSwitchNotActive))
+		})
+
+		Context("when a kill switch is activated", func() {
+			BeforeEach(func() {
+				Expect(s.CreateKillSwitch(ctx, killSwitch)).To(Succeed())
+			})
+
+			It("returns it as the active kill switch", func() {
+				active, err := s.GetActiveKillSwitch(ctx, orgID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(active).To(MatchFields(IgnoreExtras, Fields{
+					"ID":          Equal(killSwitch.ID),
+					"OrgID":       Equal(orgID),
+					"Reason":      Equal("incident"),
+					"Tag":         Equal("payments"),
+					"ActivatedBy": Equal(userID),
+					"ReleasedAt":  BeNil(),
+				}))
+			})
+
+			It("refuses a second active kill switch", func() {
+				err := s.CreateKillSwitch(ctx, model.KillSwitch{
+					ID:          uuid.New(),
+					OrgID:       orgID,
+					Reason:      "another incident",
+					ActivatedBy: userID,
+					ActivatedAt: time.Now().UTC(),
+				})
+				Expect(err).To(MatchError(model.ErrKillSwitchActive))
+			})
+
+			It("does not affect other organizations", func() {
+				otherOrgID := uuid.New()
+				_, err := s.GetActiveKillSwitch(ctx, otherOrgID)
+				Expect(err).To(MatchError(model.ErrKillSwitchNotActive))
+				Expect(s.ReleaseKillSwitch(ctx, otherOrgID, userID)).To(MatchError(model.ErrKillSwitchNotActive))
+
+				other := model.KillSwitch{
+					ID:          uuid.New(),
+					OrgID:       otherOrgID,
+					Reason:      "unrelated incident",
+					ActivatedBy: userID,
+					ActivatedAt: time.Now().UTC(),
+				}
+				Expect(s.CreateKillSwitch(ctx, other)).To(Succeed())
+				Expect(s.RemoveTestKillSwitch(ctx, other.ID)).To(Succeed())
+			})
+
+			It("releases it", func() {
+				Expect(s.ReleaseKillSwitch(ctx, orgID, userID)).To(Succeed())
+
+				_, err := s.GetActiveKillSwitch(ctx, orgID)
+				Expect(err).To(MatchError(model.ErrKillSwitchNotActive))
+				Expect(s.ReleaseKillSwitch(ctx, orgID, userID)).To(MatchError(model.ErrKillSwitchNotActive))
+			})
+		})
+	})
+}
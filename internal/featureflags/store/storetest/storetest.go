@@ -0,0 +1,33 @@
+// Package storetest holds the specs every feature flags store backend has to pass.
+package storetest
+
+import (
+	"context"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
+	"github.com/google/uuid"
+)
+
+// Store is a backend together with the helpers the specs use to arrange and clean up data.
+type Store interface {
+	service.Store
+
+	AddTestFlag(ctx context.Context, flag model.FeatureFlag) error
+	RemoveTestFlag(ctx context.Context, id uuid.UUID) error
+	FetchTestFlagByID(ctx context.Context, id uuid.UUID) (model.FeatureFlag, error)
+	RemoveTestChangeRequest(ctx context.Context, id uuid.UUID) error
+	RemoveTestKillSwitch(ctx context.Context, id uuid.UUID) error
+	RemoveTestCodeReferences(ctx context.Context, repository string) error
+	RemoveTestGroup(ctx context.Context, id uuid.UUID) error
+}
+
+// DescribeStore registers the specs against the stores returned by newStore, which is called
+// before each spec.
+func DescribeStore(newStore func() Store) {
+	describeFlags(newStore)
+	describeChangeRequests(newStore)
+	describeCodeReferences(newStore)
+	describeKillSwitch(newStore)
+	describeGroups(newStore)
+}
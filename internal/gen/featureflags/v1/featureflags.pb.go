@@ -0,0 +1,1476 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: featureflags/v1/featureflags.proto
+
+package featureflagsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FlagKind int32
+
+const (
+	FlagKind_FLAG_KIND_UNSPECIFIED FlagKind = 0
+	FlagKind_FLAG_KIND_RELEASE     FlagKind = 1
+	FlagKind_FLAG_KIND_EXPERIMENT  FlagKind = 2
+	FlagKind_FLAG_KIND_OPS         FlagKind = 3
+	FlagKind_FLAG_KIND_PERMISSION  FlagKind = 4
+	FlagKind_FLAG_KIND_KILL_SWITCH FlagKind = 5
+)
+
+// Enum value maps for FlagKind.
+var (
+	FlagKind_name = map[int32]string{
+		0: "FLAG_KIND_UNSPECIFIED",
+		1: "FLAG_KIND_RELEASE",
+		2: "FLAG_KIND_EXPERIMENT",
+		3: "FLAG_KIND_OPS",
+		4: "FLAG_KIND_PERMISSION",
+		5: "FLAG_KIND_KILL_SWITCH",
+	}
+	FlagKind_value = map[string]int32{
+		"FLAG_KIND_UNSPECIFIED": 0,
+		"FLAG_KIND_RELEASE":     1,
+		"FLAG_KIND_EXPERIMENT":  2,
+		"FLAG_KIND_OPS":         3,
+		"FLAG_KIND_PERMISSION":  4,
+		"FLAG_KIND_KILL_SWITCH": 5,
+	}
+)
+
+func (x FlagKind) Enum() *FlagKind {
+	p := new(FlagKind)
+	*p = x
+	return p
+}
+
+func (x FlagKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlagKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_featureflags_v1_featureflags_proto_enumTypes[0].Descriptor()
+}
+
+func (FlagKind) Type() protoreflect.EnumType {
+	return &file_featureflags_v1_featureflags_proto_enumTypes[0]
+}
+
+func (x FlagKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlagKind.Descriptor instead.
+func (FlagKind) EnumDescriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{0}
+}
+
+type OwnerType int32
+
+const (
+	OwnerType_OWNER_TYPE_UNSPECIFIED OwnerType = 0
+	OwnerType_OWNER_TYPE_USER        OwnerType = 1
+	OwnerType_OWNER_TYPE_TEAM        OwnerType = 2
+)
+
+// Enum value maps for OwnerType.
+var (
+	OwnerType_name = map[int32]string{
+		0: "OWNER_TYPE_UNSPECIFIED",
+		1: "OWNER_TYPE_USER",
+		2: "OWNER_TYPE_TEAM",
+	}
+	OwnerType_value = map[string]int32{
+		"OWNER_TYPE_UNSPECIFIED": 0,
+		"OWNER_TYPE_USER":        1,
+		"OWNER_TYPE_TEAM":        2,
+	}
+)
+
+func (x OwnerType) Enum() *OwnerType {
+	p := new(OwnerType)
+	*p = x
+	return p
+}
+
+func (x OwnerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OwnerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_featureflags_v1_featureflags_proto_enumTypes[1].Descriptor()
+}
+
+func (OwnerType) Type() protoreflect.EnumType {
+	return &file_featureflags_v1_featureflags_proto_enumTypes[1]
+}
+
+func (x OwnerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OwnerType.Descriptor instead.
+func (OwnerType) EnumDescriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{1}
+}
+
+type EvaluationReason int32
+
+const (
+	EvaluationReason_EVALUATION_REASON_UNSPECIFIED EvaluationReason = 0
+	EvaluationReason_EVALUATION_REASON_STATIC      EvaluationReason = 1
+	EvaluationReason_EVALUATION_REASON_KILL_SWITCH EvaluationReason = 2
+)
+
+// Enum value maps for EvaluationReason.
+var (
+	EvaluationReason_name = map[int32]string{
+		0: "EVALUATION_REASON_UNSPECIFIED",
+		1: "EVALUATION_REASON_STATIC",
+		2: "EVALUATION_REASON_KILL_SWITCH",
+	}
+	EvaluationReason_value = map[string]int32{
+		"EVALUATION_REASON_UNSPECIFIED": 0,
+		"EVALUATION_REASON_STATIC":      1,
+		"EVALUATION_REASON_KILL_SWITCH": 2,
+	}
+)
+
+func (x EvaluationReason) Enum() *EvaluationReason {
+	p := new(EvaluationReason)
+	*p = x
+	return p
+}
+
+func (x EvaluationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvaluationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_featureflags_v1_featureflags_proto_enumTypes[2].Descriptor()
+}
+
+func (EvaluationReason) Type() protoreflect.EnumType {
+	return &file_featureflags_v1_featureflags_proto_enumTypes[2]
+}
+
+func (x EvaluationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvaluationReason.Descriptor instead.
+func (EvaluationReason) EnumDescriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{2}
+}
+
+type FlagEventType int32
+
+const (
+	FlagEventType_FLAG_EVENT_TYPE_UNSPECIFIED FlagEventType = 0
+	FlagEventType_FLAG_EVENT_TYPE_SNAPSHOT    FlagEventType = 1
+	FlagEventType_FLAG_EVENT_TYPE_CREATED     FlagEventType = 2
+	FlagEventType_FLAG_EVENT_TYPE_UPDATED     FlagEventType = 3
+	FlagEventType_FLAG_EVENT_TYPE_DELETED     FlagEventType = 4
+	// SNAPSHOT_COMPLETE follows the last snapshot event, flags missing from the snapshot no longer exist.
+	FlagEventType_FLAG_EVENT_TYPE_SNAPSHOT_COMPLETE FlagEventType = 5
+	FlagEventType_FLAG_EVENT_TYPE_KILL_SWITCH       FlagEventType = 6
+)
+
+// Enum value maps for FlagEventType.
+var (
+	FlagEventType_name = map[int32]string{
+		0: "FLAG_EVENT_TYPE_UNSPECIFIED",
+		1: "FLAG_EVENT_TYPE_SNAPSHOT",
+		2: "FLAG_EVENT_TYPE_CREATED",
+		3: "FLAG_EVENT_TYPE_UPDATED",
+		4: "FLAG_EVENT_TYPE_DELETED",
+		5: "FLAG_EVENT_TYPE_SNAPSHOT_COMPLETE",
+		6: "FLAG_EVENT_TYPE_KILL_SWITCH",
+	}
+	FlagEventType_value = map[string]int32{
+		"FLAG_EVENT_TYPE_UNSPECIFIED":       0,
+		"FLAG_EVENT_TYPE_SNAPSHOT":          1,
+		"FLAG_EVENT_TYPE_CREATED":           2,
+		"FLAG_EVENT_TYPE_UPDATED":           3,
+		"FLAG_EVENT_TYPE_DELETED":           4,
+		"FLAG_EVENT_TYPE_SNAPSHOT_COMPLETE": 5,
+		"FLAG_EVENT_TYPE_KILL_SWITCH":       6,
+	}
+)
+
+func (x FlagEventType) Enum() *FlagEventType {
+	p := new(FlagEventType)
+	*p = x
+	return p
+}
+
+func (x FlagEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlagEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_featureflags_v1_featureflags_proto_enumTypes[3].Descriptor()
+}
+
+func (FlagEventType) Type() protoreflect.EnumType {
+	return &file_featureflags_v1_featureflags_proto_enumTypes[3]
+}
+
+func (x FlagEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlagEventType.Descriptor instead.
+func (FlagEventType) EnumDescriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{3}
+}
+
+type Flag struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key         string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Enabled     bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Permanent   bool                   `protobuf:"varint,5,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	Protected   bool                   `protobuf:"varint,6,opt,name=protected,proto3" json:"protected,omitempty"`
+	Owner       string                 `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	OwnerType   OwnerType              `protobuf:"varint,8,opt,name=owner_type,json=ownerType,proto3,enum=featureflags.v1.OwnerType" json:"owner_type,omitempty"`
+	Maintainers []string               `protobuf:"bytes,9,rep,name=maintainers,proto3" json:"maintainers,omitempty"`
+	Tags        []string               `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	Kind        FlagKind               `protobuf:"varint,11,opt,name=kind,proto3,enum=featureflags.v1.FlagKind" json:"kind,omitempty"`
+	IssueUrls   []string               `protobuf:"bytes,12,rep,name=issue_urls,json=issueUrls,proto3" json:"issue_urls,omitempty"`
+	Metadata    map[string]string      `protobuf:"bytes,13,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// managed_by names the tool that owns the flag definition, e.g. "git".
+	ManagedBy     string `protobuf:"bytes,17,opt,name=managed_by,json=managedBy,proto3" json:"managed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Flag) Reset() {
+	*x = Flag{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Flag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flag) ProtoMessage() {}
+
+func (x *Flag) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flag.ProtoReflect.Descriptor instead.
+func (*Flag) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Flag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Flag) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Flag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Flag) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Flag) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+func (x *Flag) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *Flag) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Flag) GetOwnerType() OwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *Flag) GetMaintainers() []string {
+	if x != nil {
+		return x.Maintainers
+	}
+	return nil
+}
+
+func (x *Flag) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Flag) GetKind() FlagKind {
+	if x != nil {
+		return x.Kind
+	}
+	return FlagKind_FLAG_KIND_UNSPECIFIED
+}
+
+func (x *Flag) GetIssueUrls() []string {
+	if x != nil {
+		return x.IssueUrls
+	}
+	return nil
+}
+
+func (x *Flag) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Flag) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Flag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Flag) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Flag) GetManagedBy() string {
+	if x != nil {
+		return x.ManagedBy
+	}
+	return ""
+}
+
+// FlagInput holds the writable fields of a flag.
+type FlagInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Permanent     bool                   `protobuf:"varint,4,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	Protected     bool                   `protobuf:"varint,5,opt,name=protected,proto3" json:"protected,omitempty"`
+	Owner         string                 `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	OwnerType     OwnerType              `protobuf:"varint,7,opt,name=owner_type,json=ownerType,proto3,enum=featureflags.v1.OwnerType" json:"owner_type,omitempty"`
+	Maintainers   []string               `protobuf:"bytes,8,rep,name=maintainers,proto3" json:"maintainers,omitempty"`
+	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Kind          FlagKind               `protobuf:"varint,10,opt,name=kind,proto3,enum=featureflags.v1.FlagKind" json:"kind,omitempty"`
+	IssueUrls     []string               `protobuf:"bytes,11,rep,name=issue_urls,json=issueUrls,proto3" json:"issue_urls,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ManagedBy     string                 `protobuf:"bytes,14,opt,name=managed_by,json=managedBy,proto3" json:"managed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagInput) Reset() {
+	*x = FlagInput{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagInput) ProtoMessage() {}
+
+func (x *FlagInput) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagInput.ProtoReflect.Descriptor instead.
+func (*FlagInput) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FlagInput) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *FlagInput) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FlagInput) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *FlagInput) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+func (x *FlagInput) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *FlagInput) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *FlagInput) GetOwnerType() OwnerType {
+	if x != nil {
+		return x.OwnerType
+	}
+	return OwnerType_OWNER_TYPE_UNSPECIFIED
+}
+
+func (x *FlagInput) GetMaintainers() []string {
+	if x != nil {
+		return x.Maintainers
+	}
+	return nil
+}
+
+func (x *FlagInput) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *FlagInput) GetKind() FlagKind {
+	if x != nil {
+		return x.Kind
+	}
+	return FlagKind_FLAG_KIND_UNSPECIFIED
+}
+
+func (x *FlagInput) GetIssueUrls() []string {
+	if x != nil {
+		return x.IssueUrls
+	}
+	return nil
+}
+
+func (x *FlagInput) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *FlagInput) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *FlagInput) GetManagedBy() string {
+	if x != nil {
+		return x.ManagedBy
+	}
+	return ""
+}
+
+type ListFlagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Maintainer    string                 `protobuf:"bytes,2,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
+	Kind          FlagKind               `protobuf:"varint,3,opt,name=kind,proto3,enum=featureflags.v1.FlagKind" json:"kind,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlagsRequest) Reset() {
+	*x = ListFlagsRequest{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlagsRequest) ProtoMessage() {}
+
+func (x *ListFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlagsRequest.ProtoReflect.Descriptor instead.
+func (*ListFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListFlagsRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListFlagsRequest) GetMaintainer() string {
+	if x != nil {
+		return x.Maintainer
+	}
+	return ""
+}
+
+func (x *ListFlagsRequest) GetKind() FlagKind {
+	if x != nil {
+		return x.Kind
+	}
+	return FlagKind_FLAG_KIND_UNSPECIFIED
+}
+
+func (x *ListFlagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListFlagsRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ListFlagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flags         []*Flag                `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlagsResponse) Reset() {
+	*x = ListFlagsResponse{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlagsResponse) ProtoMessage() {}
+
+func (x *ListFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlagsResponse.ProtoReflect.Descriptor instead.
+func (*ListFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListFlagsResponse) GetFlags() []*Flag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+type GetFlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFlagRequest) Reset() {
+	*x = GetFlagRequest{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlagRequest) ProtoMessage() {}
+
+func (x *GetFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlagRequest.ProtoReflect.Descriptor instead.
+func (*GetFlagRequest) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetFlagRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetFlagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flag          *Flag                  `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFlagResponse) Reset() {
+	*x = GetFlagResponse{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlagResponse) ProtoMessage() {}
+
+func (x *GetFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlagResponse.ProtoReflect.Descriptor instead.
+func (*GetFlagResponse) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetFlagResponse) GetFlag() *Flag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type CreateFlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Flag          *FlagInput             `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFlagRequest) Reset() {
+	*x = CreateFlagRequest{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFlagRequest) ProtoMessage() {}
+
+func (x *CreateFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFlagRequest.ProtoReflect.Descriptor instead.
+func (*CreateFlagRequest) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateFlagRequest) GetFlag() *FlagInput {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type CreateFlagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFlagResponse) Reset() {
+	*x = CreateFlagResponse{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFlagResponse) ProtoMessage() {}
+
+func (x *CreateFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFlagResponse.ProtoReflect.Descriptor instead.
+func (*CreateFlagResponse) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateFlagResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateFlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Flag          *FlagInput             `protobuf:"bytes,2,opt,name=flag,proto3" json:"flag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFlagRequest) Reset() {
+	*x = UpdateFlagRequest{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFlagRequest) ProtoMessage() {}
+
+func (x *UpdateFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFlagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFlagRequest) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateFlagRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFlagRequest) GetFlag() *FlagInput {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type UpdateFlagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFlagResponse) Reset() {
+	*x = UpdateFlagResponse{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFlagResponse) ProtoMessage() {}
+
+func (x *UpdateFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFlagResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFlagResponse) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{9}
+}
+
+type DeleteFlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFlagRequest) Reset() {
+	*x = DeleteFlagRequest{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFlagRequest) ProtoMessage() {}
+
+func (x *DeleteFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFlagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFlagRequest) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteFlagRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteFlagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFlagResponse) Reset() {
+	*x = DeleteFlagResponse{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFlagResponse) ProtoMessage() {}
+
+func (x *DeleteFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFlagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFlagResponse) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{11}
+}
+
+type EvaluateFlagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateFlagRequest) Reset() {
+	*x = EvaluateFlagRequest{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateFlagRequest) ProtoMessage() {}
+
+func (x *EvaluateFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateFlagRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateFlagRequest) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EvaluateFlagRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type EvaluateFlagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         bool                   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Reason        EvaluationReason       `protobuf:"varint,3,opt,name=reason,proto3,enum=featureflags.v1.EvaluationReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateFlagResponse) Reset() {
+	*x = EvaluateFlagResponse{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateFlagResponse) ProtoMessage() {}
+
+func (x *EvaluateFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateFlagResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateFlagResponse) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EvaluateFlagResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EvaluateFlagResponse) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+func (x *EvaluateFlagResponse) GetReason() EvaluationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return EvaluationReason_EVALUATION_REASON_UNSPECIFIED
+}
+
+type WatchFlagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchFlagsRequest) Reset() {
+	*x = WatchFlagsRequest{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchFlagsRequest) ProtoMessage() {}
+
+func (x *WatchFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchFlagsRequest.ProtoReflect.Descriptor instead.
+func (*WatchFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{14}
+}
+
+type WatchFlagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  FlagEventType          `protobuf:"varint,1,opt,name=type,proto3,enum=featureflags.v1.FlagEventType" json:"type,omitempty"`
+	// For deleted flags only the id is guaranteed to be set. Not set for SNAPSHOT_COMPLETE and KILL_SWITCH.
+	Flag *Flag `protobuf:"bytes,2,opt,name=flag,proto3" json:"flag,omitempty"`
+	// Whether the global kill switch is active, set on SNAPSHOT_COMPLETE and KILL_SWITCH events.
+	KillSwitchActive bool `protobuf:"varint,3,opt,name=kill_switch_active,json=killSwitchActive,proto3" json:"kill_switch_active,omitempty"`
+	// The tag the active kill switch is limited to, empty when it covers every flag.
+	KillSwitchTag string `protobuf:"bytes,4,opt,name=kill_switch_tag,json=killSwitchTag,proto3" json:"kill_switch_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchFlagsResponse) Reset() {
+	*x = WatchFlagsResponse{}
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchFlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchFlagsResponse) ProtoMessage() {}
+
+func (x *WatchFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_featureflags_v1_featureflags_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchFlagsResponse.ProtoReflect.Descriptor instead.
+func (*WatchFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_featureflags_v1_featureflags_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchFlagsResponse) GetType() FlagEventType {
+	if x != nil {
+		return x.Type
+	}
+	return FlagEventType_FLAG_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchFlagsResponse) GetFlag() *Flag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+func (x *WatchFlagsResponse) GetKillSwitchActive() bool {
+	if x != nil {
+		return x.KillSwitchActive
+	}
+	return false
+}
+
+func (x *WatchFlagsResponse) GetKillSwitchTag() string {
+	if x != nil {
+		return x.KillSwitchTag
+	}
+	return ""
+}
+
+var File_featureflags_v1_featureflags_proto protoreflect.FileDescriptor
+
+const file_featureflags_v1_featureflags_proto_rawDesc = "" +
+	"\n" +
+	"\"featureflags/v1/featureflags.proto\x12\x0ffeatureflags.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x05\n" +
+	"\x04Flag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x12\x1c\n" +
+	"\tpermanent\x18\x05 \x01(\bR\tpermanent\x12\x1c\n" +
+	"\tprotected\x18\x06 \x01(\bR\tprotected\x12\x14\n" +
+	"\x05owner\x18\a \x01(\tR\x05owner\x129\n" +
+	"\n" +
+	"owner_type\x18\b \x01(\x0e2\x1a.featureflags.v1.OwnerTypeR\townerType\x12 \n" +
+	"\vmaintainers\x18\t \x03(\tR\vmaintainers\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12-\n" +
+	"\x04kind\x18\v \x01(\x0e2\x19.featureflags.v1.FlagKindR\x04kind\x12\x1d\n" +
+	"\n" +
+	"issue_urls\x18\f \x03(\tR\tissueUrls\x12?\n" +
+	"\bmetadata\x18\r \x03(\v2#.featureflags.v1.Flag.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"expires_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"managed_by\x18\x11 \x01(\tR\tmanagedBy\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc7\x04\n" +
+	"\tFlagInput\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x1c\n" +
+	"\tpermanent\x18\x04 \x01(\bR\tpermanent\x12\x1c\n" +
+	"\tprotected\x18\x05 \x01(\bR\tprotected\x12\x14\n" +
+	"\x05owner\x18\x06 \x01(\tR\x05owner\x129\n" +
+	"\n" +
+	"owner_type\x18\a \x01(\x0e2\x1a.featureflags.v1.OwnerTypeR\townerType\x12 \n" +
+	"\vmaintainers\x18\b \x03(\tR\vmaintainers\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12-\n" +
+	"\x04kind\x18\n" +
+	" \x01(\x0e2\x19.featureflags.v1.FlagKindR\x04kind\x12\x1d\n" +
+	"\n" +
+	"issue_urls\x18\v \x03(\tR\tissueUrls\x12D\n" +
+	"\bmetadata\x18\f \x03(\v2(.featureflags.v1.FlagInput.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"expires_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"managed_by\x18\x0e \x01(\tR\tmanagedBy\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x95\x02\n" +
+	"\x10ListFlagsRequest\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x1e\n" +
+	"\n" +
+	"maintainer\x18\x02 \x01(\tR\n" +
+	"maintainer\x12-\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x19.featureflags.v1.FlagKindR\x04kind\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12K\n" +
+	"\bmetadata\x18\x05 \x03(\v2/.featureflags.v1.ListFlagsRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"@\n" +
+	"\x11ListFlagsResponse\x12+\n" +
+	"\x05flags\x18\x01 \x03(\v2\x15.featureflags.v1.FlagR\x05flags\" \n" +
+	"\x0eGetFlagRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
+	"\x0fGetFlagResponse\x12)\n" +
+	"\x04flag\x18\x01 \x01(\v2\x15.featureflags.v1.FlagR\x04flag\"C\n" +
+	"\x11CreateFlagRequest\x12.\n" +
+	"\x04flag\x18\x01 \x01(\v2\x1a.featureflags.v1.FlagInputR\x04flag\"$\n" +
+	"\x12CreateFlagResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"S\n" +
+	"\x11UpdateFlagRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x04flag\x18\x02 \x01(\v2\x1a.featureflags.v1.FlagInputR\x04flag\"\x14\n" +
+	"\x12UpdateFlagResponse\"#\n" +
+	"\x11DeleteFlagRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteFlagResponse\"'\n" +
+	"\x13EvaluateFlagRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"y\n" +
+	"\x14EvaluateFlagResponse\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value\x129\n" +
+	"\x06reason\x18\x03 \x01(\x0e2!.featureflags.v1.EvaluationReasonR\x06reason\"\x13\n" +
+	"\x11WatchFlagsRequest\"\xc9\x01\n" +
+	"\x12WatchFlagsResponse\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.featureflags.v1.FlagEventTypeR\x04type\x12)\n" +
+	"\x04flag\x18\x02 \x01(\v2\x15.featureflags.v1.FlagR\x04flag\x12,\n" +
+	"\x12kill_switch_active\x18\x03 \x01(\bR\x10killSwitchActive\x12&\n" +
+	"\x0fkill_switch_tag\x18\x04 \x01(\tR\rkillSwitchTag*\x9e\x01\n" +
+	"\bFlagKind\x12\x19\n" +
+	"\x15FLAG_KIND_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11FLAG_KIND_RELEASE\x10\x01\x12\x18\n" +
+	"\x14FLAG_KIND_EXPERIMENT\x10\x02\x12\x11\n" +
+	"\rFLAG_KIND_OPS\x10\x03\x12\x18\n" +
+	"\x14FLAG_KIND_PERMISSION\x10\x04\x12\x19\n" +
+	"\x15FLAG_KIND_KILL_SWITCH\x10\x05*Q\n" +
+	"\tOwnerType\x12\x1a\n" +
+	"\x16OWNER_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fOWNER_TYPE_USER\x10\x01\x12\x13\n" +
+	"\x0fOWNER_TYPE_TEAM\x10\x02*v\n" +
+	"\x10EvaluationReason\x12!\n" +
+	"\x1dEVALUATION_REASON_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18EVALUATION_REASON_STATIC\x10\x01\x12!\n" +
+	"\x1dEVALUATION_REASON_KILL_SWITCH\x10\x02*\xed\x01\n" +
+	"\rFlagEventType\x12\x1f\n" +
+	"\x1bFLAG_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18FLAG_EVENT_TYPE_SNAPSHOT\x10\x01\x12\x1b\n" +
+	"\x17FLAG_EVENT_TYPE_CREATED\x10\x02\x12\x1b\n" +
+	"\x17FLAG_EVENT_TYPE_UPDATED\x10\x03\x12\x1b\n" +
+	"\x17FLAG_EVENT_TYPE_DELETED\x10\x04\x12%\n" +
+	"!FLAG_EVENT_TYPE_SNAPSHOT_COMPLETE\x10\x05\x12\x1f\n" +
+	"\x1bFLAG_EVENT_TYPE_KILL_SWITCH\x10\x062\xf1\x04\n" +
+	"\x12FeatureFlagService\x12R\n" +
+	"\tListFlags\x12!.featureflags.v1.ListFlagsRequest\x1a\".featureflags.v1.ListFlagsResponse\x12L\n" +
+	"\aGetFlag\x12\x1f.featureflags.v1.GetFlagRequest\x1a .featureflags.v1.GetFlagResponse\x12U\n" +
+	"\n" +
+	"CreateFlag\x12\".featureflags.v1.CreateFlagRequest\x1a#.featureflags.v1.CreateFlagResponse\x12U\n" +
+	"\n" +
+	"UpdateFlag\x12\".featureflags.v1.UpdateFlagRequest\x1a#.featureflags.v1.UpdateFlagResponse\x12U\n" +
+	"\n" +
+	"DeleteFlag\x12\".featureflags.v1.DeleteFlagRequest\x1a#.featureflags.v1.DeleteFlagResponse\x12[\n" +
+	"\fEvaluateFlag\x12$.featureflags.v1.EvaluateFlagRequest\x1a%.featureflags.v1.EvaluateFlagResponse\x12W\n" +
+	"\n" +
+	"WatchFlags\x12\".featureflags.v1.WatchFlagsRequest\x1a#.featureflags.v1.WatchFlagsResponse0\x01BWZUgithub.com/georgisomnoev/feature-flag-api/internal/gen/featureflags/v1;featureflagsv1b\x06proto3"
+
+var (
+	file_featureflags_v1_featureflags_proto_rawDescOnce sync.Once
+	file_featureflags_v1_featureflags_proto_rawDescData []byte
+)
+
+func file_featureflags_v1_featureflags_proto_rawDescGZIP() []byte {
+	file_featureflags_v1_featureflags_proto_rawDescOnce.Do(func() {
+		file_featureflags_v1_featureflags_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_featureflags_v1_featureflags_proto_rawDesc), len(file_featureflags_v1_featureflags_proto_rawDesc)))
+	})
+	return file_featureflags_v1_featureflags_proto_rawDescData
+}
+
+var file_featureflags_v1_featureflags_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_featureflags_v1_featureflags_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_featureflags_v1_featureflags_proto_goTypes = []any{
+	(FlagKind)(0),                 // 0: featureflags.v1.FlagKind
+	(OwnerType)(0),                // 1: featureflags.v1.OwnerType
+	(EvaluationReason)(0),         // 2: featureflags.v1.EvaluationReason
+	(FlagEventType)(0),            // 3: featureflags.v1.FlagEventType
+	(*Flag)(nil),                  // 4: featureflags.v1.Flag
+	(*FlagInput)(nil),             // 5: featureflags.v1.FlagInput
+	(*ListFlagsRequest)(nil),      // 6: featureflags.v1.ListFlagsRequest
+	(*ListFlagsResponse)(nil),     // 7: featureflags.v1.ListFlagsResponse
+	(*GetFlagRequest)(nil),        // 8: featureflags.v1.GetFlagRequest
+	(*GetFlagResponse)(nil),       // 9: featureflags.v1.GetFlagResponse
+	(*CreateFlagRequest)(nil),     // 10: featureflags.v1.CreateFlagRequest
+	(*CreateFlagResponse)(nil),    // 11: featureflags.v1.CreateFlagResponse
+	(*UpdateFlagRequest)(nil),     // 12: featureflags.v1.UpdateFlagRequest
+	(*UpdateFlagResponse)(nil),    // 13: featureflags.v1.UpdateFlagResponse
+	(*DeleteFlagRequest)(nil),     // 14: featureflags.v1.DeleteFlagRequest
+	(*DeleteFlagResponse)(nil),    // 15: featureflags.v1.DeleteFlagResponse
+	(*EvaluateFlagRequest)(nil),   // 16: featureflags.v1.EvaluateFlagRequest
+	(*EvaluateFlagResponse)(nil),  // 17: featureflags.v1.EvaluateFlagResponse
+	(*WatchFlagsRequest)(nil),     // 18: featureflags.v1.WatchFlagsRequest
+	(*WatchFlagsResponse)(nil),    // 19: featureflags.v1.WatchFlagsResponse
+	nil,                           // 20: featureflags.v1.Flag.MetadataEntry
+	nil,                           // 21: featureflags.v1.FlagInput.MetadataEntry
+	nil,                           // 22: featureflags.v1.ListFlagsRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil), // 23: google.protobuf.Timestamp
+}
+var file_featureflags_v1_featureflags_proto_depIdxs = []int32{
+	1,  // 0: featureflags.v1.Flag.owner_type:type_name -> featureflags.v1.OwnerType
+	0,  // 1: featureflags.v1.Flag.kind:type_name -> featureflags.v1.FlagKind
+	20, // 2: featureflags.v1.Flag.metadata:type_name -> featureflags.v1.Flag.MetadataEntry
+	23, // 3: featureflags.v1.Flag.expires_at:type_name -> google.protobuf.Timestamp
+	23, // 4: featureflags.v1.Flag.created_at:type_name -> google.protobuf.Timestamp
+	23, // 5: featureflags.v1.Flag.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: featureflags.v1.FlagInput.owner_type:type_name -> featureflags.v1.OwnerType
+	0,  // 7: featureflags.v1.FlagInput.kind:type_name -> featureflags.v1.FlagKind
+	21, // 8: featureflags.v1.FlagInput.metadata:type_name -> featureflags.v1.FlagInput.MetadataEntry
+	23, // 9: featureflags.v1.FlagInput.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 10: featureflags.v1.ListFlagsRequest.kind:type_name -> featureflags.v1.FlagKind
+	22, // 11: featureflags.v1.ListFlagsRequest.metadata:type_name -> featureflags.v1.ListFlagsRequest.MetadataEntry
+	4,  // 12: featureflags.v1.ListFlagsResponse.flags:type_name -> featureflags.v1.Flag
+	4,  // 13: featureflags.v1.GetFlagResponse.flag:type_name -> featureflags.v1.Flag
+	5,  // 14: featureflags.v1.CreateFlagRequest.flag:type_name -> featureflags.v1.FlagInput
+	5,  // 15: featureflags.v1.UpdateFlagRequest.flag:type_name -> featureflags.v1.FlagInput
+	2,  // 16: featureflags.v1.EvaluateFlagResponse.reason:type_name -> featureflags.v1.EvaluationReason
+	3,  // 17: featureflags.v1.WatchFlagsResponse.type:type_name -> featureflags.v1.FlagEventType
+	4,  // 18: featureflags.v1.WatchFlagsResponse.flag:type_name -> featureflags.v1.Flag
+	6,  // 19: featureflags.v1.FeatureFlagService.ListFlags:input_type -> featureflags.v1.ListFlagsRequest
+	8,  // 20: featureflags.v1.FeatureFlagService.GetFlag:input_type -> featureflags.v1.GetFlagRequest
+	10, // 21: featureflags.v1.FeatureFlagService.CreateFlag:input_type -> featureflags.v1.CreateFlagRequest
+	12, // 22: featureflags.v1.FeatureFlagService.UpdateFlag:input_type -> featureflags.v1.UpdateFlagRequest
+	14, // 23: featureflags.v1.FeatureFlagService.DeleteFlag:input_type -> featureflags.v1.DeleteFlagRequest
+	16, // 24: featureflags.v1.FeatureFlagService.EvaluateFlag:input_type -> featureflags.v1.EvaluateFlagRequest
+	18, // 25: featureflags.v1.FeatureFlagService.WatchFlags:input_type -> featureflags.v1.WatchFlagsRequest
+	7,  // 26: featureflags.v1.FeatureFlagService.ListFlags:output_type -> featureflags.v1.ListFlagsResponse
+	9,  // 27: featureflags.v1.FeatureFlagService.GetFlag:output_type -> featureflags.v1.GetFlagResponse
+	11, // 28: featureflags.v1.FeatureFlagService.CreateFlag:output_type -> featureflags.v1.CreateFlagResponse
+	13, // 29: featureflags.v1.FeatureFlagService.UpdateFlag:output_type -> featureflags.v1.UpdateFlagResponse
+	15, // 30: featureflags.v1.FeatureFlagService.DeleteFlag:output_type -> featureflags.v1.DeleteFlagResponse
+	17, // 31: featureflags.v1.FeatureFlagService.EvaluateFlag:output_type -> featureflags.v1.EvaluateFlagResponse
+	19, // 32: featureflags.v1.FeatureFlagService.WatchFlags:output_type -> featureflags.v1.WatchFlagsResponse
+	26, // [26:33] is the sub-list for method output_type
+	19, // [19:26] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_featureflags_v1_featureflags_proto_init() }
+func file_featureflags_v1_featureflags_proto_init() {
+	if File_featureflags_v1_featureflags_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_featureflags_v1_featureflags_proto_rawDesc), len(file_featureflags_v1_featureflags_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_featureflags_v1_featureflags_proto_goTypes,
+		DependencyIndexes: file_featureflags_v1_featureflags_proto_depIdxs,
+		EnumInfos:         file_featureflags_v1_featureflags_proto_enumTypes,
+		MessageInfos:      file_featureflags_v1_featureflags_proto_msgTypes,
+	}.Build()
+	File_featureflags_v1_featureflags_proto = out.File
+	file_featureflags_v1_featureflags_proto_goTypes = nil
+	file_featureflags_v1_featureflags_proto_depIdxs = nil
+}
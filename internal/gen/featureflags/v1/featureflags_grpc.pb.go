@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: featureflags/v1/featureflags.proto
+
+package featureflagsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FeatureFlagService_ListFlags_FullMethodName    = "/featureflags.v1.FeatureFlagService/ListFlags"
+	FeatureFlagService_GetFlag_FullMethodName      = "/featureflags.v1.FeatureFlagService/GetFlag"
+	FeatureFlagService_CreateFlag_FullMethodName   = "/featureflags.v1.FeatureFlagService/CreateFlag"
+	FeatureFlagService_UpdateFlag_FullMethodName   = "/featureflags.v1.FeatureFlagService/UpdateFlag"
+	FeatureFlagService_DeleteFlag_FullMethodName   = "/featureflags.v1.FeatureFlagService/DeleteFlag"
+	FeatureFlagService_EvaluateFlag_FullMethodName = "/featureflags.v1.FeatureFlagService/EvaluateFlag"
+	FeatureFlagService_WatchFlags_FullMethodName   = "/featureflags.v1.FeatureFlagService/WatchFlags"
+)
+
+// FeatureFlagServiceClient is the client API for FeatureFlagService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FeatureFlagService exposes the feature flags API over gRPC. Every call must carry a
+// "authorization: Bearer <token>" metadata entry with a token issued by POST /auth.
+type FeatureFlagServiceClient interface {
+	// Requires the read:flags scope.
+	ListFlags(ctx context.Context, in *ListFlagsRequest, opts ...grpc.CallOption) (*ListFlagsResponse, error)
+	// Requires the read:flags scope.
+	GetFlag(ctx context.Context, in *GetFlagRequest, opts ...grpc.CallOption) (*GetFlagResponse, error)
+	// Requires the write:flags scope.
+	CreateFlag(ctx context.Context, in *CreateFlagRequest, opts ...grpc.CallOption) (*CreateFlagResponse, error)
+	// Requires the write:flags scope.
+	UpdateFlag(ctx context.Context, in *UpdateFlagRequest, opts ...grpc.CallOption) (*UpdateFlagResponse, error)
+	// Requires the write:flags scope.
+	DeleteFlag(ctx context.Context, in *DeleteFlagRequest, opts ...grpc.CallOption) (*DeleteFlagResponse, error)
+	// Requires the read:flags scope.
+	EvaluateFlag(ctx context.Context, in *EvaluateFlagRequest, opts ...grpc.CallOption) (*EvaluateFlagResponse, error)
+	// WatchFlags first sends every existing flag as a snapshot event and then streams the changes
+	// made through this server instance. Requires the read:flags scope.
+	WatchFlags(ctx context.Context, in *WatchFlagsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchFlagsResponse], error)
+}
+
+type featureFlagServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFeatureFlagServiceClient(cc grpc.ClientConnInterface) FeatureFlagServiceClient {
+	return &featureFlagServiceClient{cc}
+}
+
+func (c *featureFlagServiceClient) ListFlags(ctx context.Context, in *ListFlagsRequest, opts ...grpc.CallOption) (*ListFlagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlagsResponse)
+	err := c.cc.Invoke(ctx, FeatureFlagService_ListFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureFlagServiceClient) GetFlag(ctx context.Context, in *GetFlagRequest, opts ...grpc.CallOption) (*GetFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFlagResponse)
+	err := c.cc.Invoke(ctx, FeatureFlagService_GetFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureFlagServiceClient) CreateFlag(ctx context.Context, in *CreateFlagRequest, opts ...grpc.CallOption) (*CreateFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFlagResponse)
+	err := c.cc.Invoke(ctx, FeatureFlagService_CreateFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureFlagServiceClient) UpdateFlag(ctx context.Context, in *UpdateFlagRequest, opts ...grpc.CallOption) (*UpdateFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFlagResponse)
+	err := c.cc.Invoke(ctx, FeatureFlagService_UpdateFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureFlagServiceClient) DeleteFlag(ctx context.Context, in *DeleteFlagRequest, opts ...grpc.CallOption) (*DeleteFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFlagResponse)
+	err := c.cc.Invoke(ctx, FeatureFlagService_DeleteFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureFlagServiceClient) EvaluateFlag(ctx context.Context, in *EvaluateFlagRequest, opts ...grpc.CallOption) (*EvaluateFlagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateFlagResponse)
+	err := c.cc.Invoke(ctx, FeatureFlagService_EvaluateFlag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *featureFlagServiceClient) WatchFlags(ctx context.Context, in *WatchFlagsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchFlagsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FeatureFlagService_ServiceDesc.Streams[0], FeatureFlagService_WatchFlags_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchFlagsRequest, WatchFlagsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FeatureFlagService_WatchFlagsClient = grpc.ServerStreamingClient[WatchFlagsResponse]
+
+// FeatureFlagServiceServer is the server API for FeatureFlagService service.
+// All implementations must embed UnimplementedFeatureFlagServiceServer
+// for forward compatibility.
+//
+// FeatureFlagService exposes the feature flags API over gRPC. Every call must carry a
+// "authorization: Bearer <token>" metadata entry with a token issued by POST /auth.
+type FeatureFlagServiceServer interface {
+	// Requires the read:flags scope.
+	ListFlags(context.Context, *ListFlagsRequest) (*ListFlagsResponse, error)
+	// Requires the read:flags scope.
+	GetFlag(context.Context, *GetFlagRequest) (*GetFlagResponse, error)
+	// Requires the write:flags scope.
+	CreateFlag(context.Context, *CreateFlagRequest) (*CreateFlagResponse, error)
+	// Requires the write:flags scope.
+	UpdateFlag(context.Context, *UpdateFlagRequest) (*UpdateFlagResponse, error)
+	// Requires the write:flags scope.
+	DeleteFlag(context.Context, *DeleteFlagRequest) (*DeleteFlagResponse, error)
+	// Requires the read:flags scope.
+	EvaluateFlag(context.Context, *EvaluateFlagRequest) (*EvaluateFlagResponse, error)
+	// WatchFlags first sends every existing flag as a snapshot event and then streams the changes
+	// made through this server instance. Requires the read:flags scope.
+	WatchFlags(*WatchFlagsRequest, grpc.ServerStreamingServer[WatchFlagsResponse]) error
+	mustEmbedUnimplementedFeatureFlagServiceServer()
+}
+
+// UnimplementedFeatureFlagServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFeatureFlagServiceServer struct{}
+
+func (UnimplementedFeatureFlagServiceServer) ListFlags(context.Context, *ListFlagsRequest) (*ListFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlags not implemented")
+}
+func (UnimplementedFeatureFlagServiceServer) GetFlag(context.Context, *GetFlagRequest) (*GetFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlag not implemented")
+}
+func (UnimplementedFeatureFlagServiceServer) CreateFlag(context.Context, *CreateFlagRequest) (*CreateFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFlag not implemented")
+}
+func (UnimplementedFeatureFlagServiceServer) UpdateFlag(context.Context, *UpdateFlagRequest) (*UpdateFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFlag not implemented")
+}
+func (UnimplementedFeatureFlagServiceServer) DeleteFlag(context.Context, *DeleteFlagRequest) (*DeleteFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFlag not implemented")
+}
+func (UnimplementedFeatureFlagServiceServer) EvaluateFlag(context.Context, *EvaluateFlagRequest) (*EvaluateFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateFlag not implemented")
+}
+func (UnimplementedFeatureFlagServiceServer) WatchFlags(*WatchFlagsRequest, grpc.ServerStreamingServer[WatchFlagsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchFlags not implemented")
+}
+func (UnimplementedFeatureFlagServiceServer) mustEmbedUnimplementedFeatureFlagServiceServer() {}
+func (UnimplementedFeatureFlagServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeFeatureFlagServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FeatureFlagServiceServer will
+// result in compilation errors.
+type UnsafeFeatureFlagServiceServer interface {
+	mustEmbedUnimplementedFeatureFlagServiceServer()
+}
+
+func RegisterFeatureFlagServiceServer(s grpc.ServiceRegistrar, srv FeatureFlagServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFeatureFlagServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FeatureFlagService_ServiceDesc, srv)
+}
+
+func _FeatureFlagService_ListFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureFlagServiceServer).ListFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureFlagService_ListFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureFlagServiceServer).ListFlags(ctx, req.(*ListFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureFlagService_GetFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureFlagServiceServer).GetFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureFlagService_GetFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureFlagServiceServer).GetFlag(ctx, req.(*GetFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureFlagService_CreateFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureFlagServiceServer).CreateFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureFlagService_CreateFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureFlagServiceServer).CreateFlag(ctx, req.(*CreateFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureFlagService_UpdateFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureFlagServiceServer).UpdateFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureFlagService_UpdateFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureFlagServiceServer).UpdateFlag(ctx, req.(*UpdateFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureFlagService_DeleteFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureFlagServiceServer).DeleteFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureFlagService_DeleteFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureFlagServiceServer).DeleteFlag(ctx, req.(*DeleteFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureFlagService_EvaluateFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeatureFlagServiceServer).EvaluateFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FeatureFlagService_EvaluateFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeatureFlagServiceServer).EvaluateFlag(ctx, req.(*EvaluateFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FeatureFlagService_WatchFlags_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFlagsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FeatureFlagServiceServer).WatchFlags(m, &grpc.GenericServerStream[WatchFlagsRequest, WatchFlagsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FeatureFlagService_WatchFlagsServer = grpc.ServerStreamingServer[WatchFlagsResponse]
+
+// FeatureFlagService_ServiceDesc is the grpc.ServiceDesc for FeatureFlagService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FeatureFlagService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "featureflags.v1.FeatureFlagService",
+	HandlerType: (*FeatureFlagServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFlags",
+			Handler:    _FeatureFlagService_ListFlags_Handler,
+		},
+		{
+			MethodName: "GetFlag",
+			Handler:    _FeatureFlagService_GetFlag_Handler,
+		},
+		{
+			MethodName: "CreateFlag",
+			Handler:    _FeatureFlagService_CreateFlag_Handler,
+		},
+		{
+			MethodName: "UpdateFlag",
+			Handler:    _FeatureFlagService_UpdateFlag_Handler,
+		},
+		{
+			MethodName: "DeleteFlag",
+			Handler:    _FeatureFlagService_DeleteFlag_Handler,
+		},
+		{
+			MethodName: "EvaluateFlag",
+			Handler:    _FeatureFlagService_EvaluateFlag_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchFlags",
+			Handler:       _FeatureFlagService_WatchFlags_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "featureflags/v1/featureflags.proto",
+}
@@ -0,0 +1,90 @@
+package gitops
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadDir reads the flag definitions from every YAML file in dir and its subdirectories.
+// A file holds a single definition or a list of them. Every definition is marked as managed by git.
+func LoadDir(dir string) ([]model.FeatureFlagRequest, error) {
+	var definitions []model.FeatureFlagRequest
+	sources := make(map[string]string)
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if path != dir && strings.HasPrefix(entry.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if ext := filepath.Ext(path); ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		fileDefinitions, err := readFile(path)
+		if err != nil {
+			return err
+		}
+		for _, definition := range fileDefinitions {
+			if definition.Key == "" {
+				return fmt.Errorf("%s: definition without a key", path)
+			}
+			if source, ok := sources[definition.Key]; ok {
+				return fmt.Errorf("%s: flag %q is already defined in %s", path, definition.Key, source)
+			}
+			sources[definition.Key] = path
+
+			definition.ManagedBy = model.ManagedByGit
+			definitions = append(definitions, definition)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return definitions, nil
+}
+
+func readFile(path string) ([]model.FeatureFlagRequest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var generic any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if generic == nil {
+		return nil, nil
+	}
+	if _, isList := generic.([]any); !isList {
+		generic = []any{generic}
+	}
+	normalized, err := json.Marshal(generic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	// Unknown fields are rejected, so that a typo in a reviewed file does not go unnoticed.
+	decoder := json.NewDecoder(bytes.NewReader(normalized))
+	decoder.DisallowUnknownFields()
+	var definitions []model.FeatureFlagRequest
+	if err := decoder.Decode(&definitions); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return definitions, nil
+}
@@ -0,0 +1,63 @@
+package gitops_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/gitops"
+)
+
+var _ = Describe("LoadDir", func() {
+	var dir string
+
+	writeFile := func(name, content string) {
+		path := filepath.Join(dir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	It("reads single definitions and lists from nested YAML files", func() {
+		writeFile("checkout.yaml", "key: new_checkout\ndescription: New checkout\nenabled: true\n")
+		writeFile("team/ops.yml", "- key: maintenance\n  description: Maintenance mode\n  kind: ops\n- key: read_only\n  description: Read only\n")
+		writeFile("README.md", "not a flag")
+		writeFile(".github/workflow.yaml", "on: push\n")
+
+		definitions, err := gitops.LoadDir(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(definitions).To(ConsistOf(
+			model.FeatureFlagRequest{Key: "new_checkout", Description: "New checkout", Enabled: true, ManagedBy: model.ManagedByGit},
+			model.FeatureFlagRequest{Key: "maintenance", Description: "Maintenance mode", Kind: model.FlagKindOps, ManagedBy: model.ManagedByGit},
+			model.FeatureFlagRequest{Key: "read_only", Description: "Read only", ManagedBy: model.ManagedByGit},
+		))
+	})
+
+	It("rejects keys defined twice", func() {
+		writeFile("a.yaml", "key: dark_mode\ndescription: Dark mode\n")
+		writeFile("b.yaml", "key: dark_mode\ndescription: Dark mode again\n")
+
+		_, err := gitops.LoadDir(dir)
+		Expect(err).To(MatchError(ContainSubstring(`flag "dark_mode" is already defined`)))
+	})
+
+	It("rejects definitions without a key", func() {
+		writeFile("a.yaml", "description: Nameless\n")
+
+		_, err := gitops.LoadDir(dir)
+		Expect(err).To(MatchError(ContainSubstring("definition without a key")))
+	})
+
+	It("rejects unknown fields", func() {
+		writeFile("a.yaml", "key: dark_mode\ndescription: Dark mode\nenabeld: true\n")
+
+		_, err := gitops.LoadDir(dir)
+		Expect(err).To(MatchError(ContainSubstring("enabeld")))
+	})
+})
@@ -0,0 +1,222 @@
+package gitops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"sort"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+type Change struct {
+	Action Action
+	Key    string
+	// ID is the ID of the existing flag, unset for creates.
+	ID uuid.UUID
+	// Definition is the flag definition from the directory, unset for deletes.
+	Definition model.FeatureFlagRequest
+	Fields     []FieldChange
+}
+
+// FieldChange holds the JSON encoded current and desired values of a changed field.
+type FieldChange struct {
+	Field   string
+	Current string
+	Desired string
+}
+
+type Plan struct {
+	Changes   []Change
+	Unchanged []string
+	// Orphaned are the flags managed by git without a definition in the directory. They are
+	// only deleted when pruning.
+	Orphaned []string
+}
+
+// NewPlan compares the definitions with the current flags, matching them by key. Flags that
+// are not managed by git are never deleted, even when pruning.
+func NewPlan(definitions []model.FeatureFlagRequest, flags []model.FeatureFlag, prune bool) (Plan, error) {
+	current := make(map[string]model.FeatureFlag, len(flags))
+	for _, flag := range flags {
+		current[flag.Key] = flag
+	}
+
+	var plan Plan
+	defined := make(map[string]bool, len(definitions))
+	for _, definition := range definitions {
+		defined[definition.Key] = true
+
+		flag, found := current[definition.Key]
+		if !found {
+			plan.Changes = append(plan.Changes, Change{Action: ActionCreate, Key: definition.Key, Definition: definition})
+			continue
+		}
+
+		fields, err := diff(flag.Request(), definition)
+		if err != nil {
+			return Plan{}, fmt.Errorf("failed to compare %s: %w", definition.Key, err)
+		}
+		if len(fields) == 0 {
+			plan.Unchanged = append(plan.Unchanged, definition.Key)
+			continue
+		}
+		plan.Changes = append(plan.Changes, Change{
+			Action:     ActionUpdate,
+			Key:        definition.Key,
+			ID:         flag.ID,
+			Definition: definition,
+			Fields:     fields,
+		})
+	}
+
+	for _, flag := range flags {
+		if defined[flag.Key] || flag.ManagedBy != model.ManagedByGit {
+			continue
+		}
+		if !prune {
+			plan.Orphaned = append(plan.Orphaned, flag.Key)
+			continue
+		}
+		plan.Changes = append(plan.Changes, Change{Action: ActionDelete, Key: flag.Key, ID: flag.ID})
+	}
+
+	sort.SliceStable(plan.Changes, func(i, j int) bool {
+		return plan.Changes[i].Key < plan.Changes[j].Key
+	})
+	slices.Sort(plan.Unchanged)
+	slices.Sort(plan.Orphaned)
+	return plan, nil
+}
+
+func (p Plan) HasChanges() bool {
+	return len(p.Changes) > 0
+}
+
+// Write prints the plan as a diff, followed by a summary line.
+func (p Plan) Write(w io.Writer) {
+	counts := make(map[Action]int)
+	for _, change := range p.Changes {
+		counts[change.Action]++
+		switch change.Action {
+		case ActionCreate:
+			fmt.Fprintf(w, "+ %s\n", change.Key)
+		case ActionUpdate:
+			fmt.Fprintf(w, "~ %s\n", change.Key)
+			for _, field := range change.Fields {
+				fmt.Fprintf(w, "    %s: %s -> %s\n", field.Field, field.Current, field.Desired)
+			}
+		case ActionDelete:
+			fmt.Fprintf(w, "- %s\n", change.Key)
+		}
+	}
+	for _, key := range p.Orphaned {
+		fmt.Fprintf(w, "! %s is managed by git but no longer defined, prune to delete it\n", key)
+	}
+
+	fmt.Fprintf(w, "Plan: %d to create, %d to update, %d to delete, %d unchanged.\n",
+		counts[ActionCreate], counts[ActionUpdate], counts[ActionDelete], len(p.Unchanged))
+}
+
+type Client interface {
+	CreateFlag(context.Context, model.FeatureFlagRequest) (uuid.UUID, error)
+	UpdateFlag(context.Context, uuid.UUID, model.FeatureFlagRequest) error
+	DeleteFlag(context.Context, uuid.UUID) error
+}
+
+// Apply executes every change of the plan, continuing past failed ones, and reports each of
+// them to w. The client has to identify itself as managing the flags.
+func Apply(ctx context.Context, client Client, plan Plan, w io.Writer) error {
+	var errs []error
+	for _, change := range plan.Changes {
+		var err error
+		switch change.Action {
+		case ActionCreate:
+			_, err = client.CreateFlag(ctx, change.Definition)
+		case ActionUpdate:
+			err = client.UpdateFlag(ctx, change.ID, change.Definition)
+		case ActionDelete:
+			err = client.DeleteFlag(ctx, change.ID)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to %s %s: %w", change.Action, change.Key, err))
+			continue
+		}
+		fmt.Fprintf(w, "%sd %s\n", change.Action, change.Key)
+	}
+	return errors.Join(errs...)
+}
+
+func diff(current, desired model.FeatureFlagRequest) ([]FieldChange, error) {
+	currentFields, err := fields(current)
+	if err != nil {
+		return nil, err
+	}
+	desiredFields, err := fields(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	names := slices.Collect(maps.Keys(currentFields))
+	for name := range desiredFields {
+		if _, ok := currentFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var changes []FieldChange
+	for _, name := range names {
+		currentValue, desiredValue := fieldValue(currentFields, name), fieldValue(desiredFields, name)
+		if currentValue == desiredValue {
+			continue
+		}
+		changes = append(changes, FieldChange{Field: name, Current: currentValue, Desired: desiredValue})
+	}
+	return changes, nil
+}
+
+// fields returns the JSON encoded value of every field of the normalized definition.
+func fields(definition model.FeatureFlagRequest) (map[string]string, error) {
+	definition = definition.WithDefaults()
+	if definition.ExpiresAt != nil {
+		expiresAt := definition.ExpiresAt.UTC()
+		definition.ExpiresAt = &expiresAt
+	}
+
+	encoded, err := json.Marshal(definition)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &raw); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(raw))
+	for name, value := range raw {
+		result[name] = string(value)
+	}
+	return result, nil
+}
+
+// fieldValue reports the fields left out of the JSON as null.
+func fieldValue(fields map[string]string, name string) string {
+	if value, ok := fields[name]; ok {
+		return value
+	}
+	return "null"
+}
@@ -0,0 +1,145 @@
+package gitops_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/gitops"
+)
+
+type fakeClient struct {
+	created []string
+	updated []uuid.UUID
+	deleted []uuid.UUID
+	err     error
+}
+
+func (c *fakeClient) CreateFlag(_ context.Context, req model.FeatureFlagRequest) (uuid.UUID, error) {
+	c.created = append(c.created, req.Key)
+	return uuid.New(), c.err
+}
+
+func (c *fakeClient) UpdateFlag(_ context.Context, id uuid.UUID, _ model.FeatureFlagRequest) error {
+	c.updated = append(c.updated, id)
+	return c.err
+}
+
+func (c *fakeClient) DeleteFlag(_ context.Context, id uuid.UUID) error {
+	c.deleted = append(c.deleted, id)
+	return c.err
+}
+
+var _ = Describe("Plan", func() {
+	var (
+		definitions []model.FeatureFlagRequest
+		flags       []model.FeatureFlag
+		prune       bool
+		plan        gitops.Plan
+
+		changedID = uuid.New()
+		orphanID  = uuid.New()
+		unmanaged = uuid.New()
+		unchanged = uuid.New()
+	)
+
+	BeforeEach(func() {
+		prune = false
+		definitions = []model.FeatureFlagRequest{
+			{Key: "new_checkout", Description: "New checkout", ManagedBy: model.ManagedByGit},
+			{Key: "dark_mode", Description: "Dark mode", Enabled: true, ManagedBy: model.ManagedByGit},
+			{Key: "search", Description: "Search", Tags: []string{}, ManagedBy: model.ManagedByGit},
+		}
+		flags = []model.FeatureFlag{
+			{ID: changedID, Key: "dark_mode", Description: "Dark mode", Kind: model.FlagKindRelease, ManagedBy: model.ManagedByGit},
+			{ID: unchanged, Key: "search", Description: "Search", Kind: model.FlagKindRelease, ManagedBy: model.ManagedByGit},
+			{ID: orphanID, Key: "legacy_banner", Description: "Legacy banner", ManagedBy: model.ManagedByGit},
+			{ID: unmanaged, Key: "ui_flag", Description: "Created in the UI"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		plan, err = gitops.NewPlan(definitions, flags, prune)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("creates missing flags and updates changed ones", func() {
+		Expect(plan.Changes).To(HaveLen(2))
+		Expect(plan.Changes[0].Action).To(Equal(gitops.ActionUpdate))
+		Expect(plan.Changes[0].ID).To(Equal(changedID))
+		Expect(plan.Changes[0].Fields).To(Equal([]gitops.FieldChange{{Field: "enabled", Current: "false", Desired: "true"}}))
+		Expect(plan.Changes[1].Action).To(Equal(gitops.ActionCreate))
+		Expect(plan.Changes[1].Key).To(Equal("new_checkout"))
+		Expect(plan.Unchanged).To(Equal([]string{"search"}))
+	})
+
+	It("reports managed flags without a definition but keeps them", func() {
+		Expect(plan.Orphaned).To(Equal([]string{"legacy_banner"}))
+
+		var out bytes.Buffer
+		plan.Write(&out)
+		Expect(out.String()).To(Equal(`~ dark_mode
+    enabled: false -> true
++ new_checkout
+! legacy_banner is managed by git but no longer defined, prune to delete it
+Plan: 1 to create, 1 to update, 0 to delete, 1 unchanged.
+`))
+	})
+
+	Context("when an unmanaged flag gets a definition", func() {
+		BeforeEach(func() {
+			definitions = append(definitions, model.FeatureFlagRequest{
+				Key: "ui_flag", Description: "Created in the UI", ManagedBy: model.ManagedByGit,
+			})
+		})
+
+		It("takes it over", func() {
+			Expect(plan.Changes).To(ContainElement(gitops.Change{
+				Action:     gitops.ActionUpdate,
+				Key:        "ui_flag",
+				ID:         unmanaged,
+				Definition: definitions[3],
+				Fields:     []gitops.FieldChange{{Field: "managed_by", Current: "null", Desired: `"git"`}},
+			}))
+		})
+	})
+
+	Context("when pruning", func() {
+		BeforeEach(func() {
+			prune = true
+		})
+
+		It("deletes only the managed flags without a definition", func() {
+			Expect(plan.Orphaned).To(BeEmpty())
+			Expect(plan.Changes).To(ContainElement(gitops.Change{Action: gitops.ActionDelete, Key: "legacy_banner", ID: orphanID}))
+			Expect(plan.Changes).NotTo(ContainElement(HaveField("ID", unmanaged)))
+		})
+
+		It("applies every change", func() {
+			client := &fakeClient{}
+			var out bytes.Buffer
+			Expect(gitops.Apply(context.Background(), client, plan, &out)).To(Succeed())
+
+			Expect(client.created).To(Equal([]string{"new_checkout"}))
+			Expect(client.updated).To(Equal([]uuid.UUID{changedID}))
+			Expect(client.deleted).To(Equal([]uuid.UUID{orphanID}))
+			Expect(out.String()).To(Equal("updated dark_mode\ndeleted legacy_banner\ncreated new_checkout\n"))
+		})
+	})
+
+	It("keeps applying after a failed change", func() {
+		client := &fakeClient{err: errors.New("api returned 409 Conflict")}
+		err := gitops.Apply(context.Background(), client, plan, &bytes.Buffer{})
+
+		Expect(err).To(MatchError(ContainSubstring("failed to update dark_mode")))
+		Expect(err).To(MatchError(ContainSubstring("failed to create new_checkout")))
+		Expect(client.created).To(HaveLen(1))
+		Expect(client.updated).To(HaveLen(1))
+	})
+})
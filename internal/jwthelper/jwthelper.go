@@ -1,10 +1,18 @@
 package jwthelper
 
 import (
+	"context"
 	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"log/slog"
+	"math/big"
 	"os"
+	"path/filepath"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -14,42 +22,146 @@ var (
 	ErrUnexpectedSigningMethod = errors.New("unexpected signing method")
 	ErrInvalidToken            = errors.New("invalid token")
 	ErrExpiredToken            = errors.New("token expired")
+	ErrUnknownKey              = errors.New("token signed with an unknown key")
 )
 
+// JWK is the public part of a signing key as published in the JWKS document.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// keys holds the key tokens are signed with and every key tokens are accepted from, by key ID.
+type keys struct {
+	signing      *rsa.PrivateKey
+	signingKID   string
+	legacyKID    string
+	verification map[string]*rsa.PublicKey
+}
+
+// JWTHelper signs the tokens with the private key and validates them with the public key or one of
+// the keys in the verification keys directory. Keys are rotated by adding the public key of the next
+// signing key to the directory, switching the key pair once every consumer picked it up and removing
+// the old public key after the last token it signed has expired. Reload picks up the changes.
+// Only tokens of the configured issuer are accepted.
 type JWTHelper struct {
-	privateKey *rsa.PrivateKey
-	publicKey  *rsa.PublicKey
+	privateKeyPath      string
+	publicKeyPath       string
+	verificationKeysDir string
+	issuer              string
+	logger              *slog.Logger
+
+	mu   sync.RWMutex
+	keys keys
 }
 
-func NewJWTHelper(privateKeyPath, publicKeyPath string) (*JWTHelper, error) {
-	privateKeyBytes, err := os.ReadFile(privateKeyPath)
+func NewJWTHelper(
+	privateKeyPath string,
+	publicKeyPath string,
+	verificationKeysDir string,
+	issuer string,
+	logger *slog.Logger,
+) (*JWTHelper, error) {
+	if issuer == "" {
+		return nil, errors.New("missing token issuer")
+	}
+	h := &JWTHelper{
+		privateKeyPath:      privateKeyPath,
+		publicKeyPath:       publicKeyPath,
+		verificationKeysDir: verificationKeysDir,
+		issuer:              issuer,
+		logger:              logger,
+	}
+	if err := h.Reload(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Reload reads the keys again. The current keys are kept when one of them cannot be read.
+func (h *JWTHelper) Reload() error {
+	privateKeyBytes, err := os.ReadFile(h.privateKeyPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read private key: %w", err)
+		return fmt.Errorf("failed to read private key: %w", err)
 	}
 	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privateKeyBytes)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse private key: %w", err)
+		return fmt.Errorf("failed to parse private key: %w", err)
 	}
 
-	publicKeyBytes, err := os.ReadFile(publicKeyPath)
+	publicKey, err := readPublicKey(h.publicKeyPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read public key: %w", err)
+		return err
 	}
-	publicKey, err := jwt.ParseRSAPublicKeyFromPEM(publicKeyBytes)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse public key: %w", err)
+
+	loaded := keys{
+		signing:      privateKey,
+		signingKID:   keyID(&privateKey.PublicKey),
+		legacyKID:    keyID(publicKey),
+		verification: map[string]*rsa.PublicKey{},
+	}
+	loaded.verification[loaded.signingKID] = &privateKey.PublicKey
+	loaded.verification[loaded.legacyKID] = publicKey
+
+	if h.verificationKeysDir != "" {
+		paths, err := filepath.Glob(filepath.Join(h.verificationKeysDir, "*.pem"))
+		if err != nil {
+			return fmt.Errorf("failed to list verification keys: %w", err)
+		}
+		for _, path := range paths {
+			key, err := readPublicKey(path)
+			if err != nil {
+				return err
+			}
+			loaded.verification[keyID(key)] = key
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.keys = loaded
+	return nil
+}
+
+// Start reloads the keys on every interval until the context is done.
+func (h *JWTHelper) Start(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := h.Reload(); err != nil {
+				h.logger.ErrorContext(ctx, "failed to reload JWT keys", "error", err)
+			}
+		}
 	}
+}
 
-	return &JWTHelper{
-		privateKey: privateKey,
-		publicKey:  publicKey,
-	}, nil
+// Issuer returns the iss claim of the tokens of the API, the base URL it is reached at.
+func (h *JWTHelper) Issuer() string {
+	return h.issuer
 }
 
 func (h *JWTHelper) GenerateToken(claims jwt.Claims) (string, error) {
+	h.mu.RLock()
+	signing, kid := h.keys.signing, h.keys.signingKID
+	h.mu.RUnlock()
+
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
 
-	signedToken, err := token.SignedString(h.privateKey)
+	signedToken, err := token.SignedString(signing)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %w", err)
 	}
@@ -62,8 +174,8 @@ func (h *JWTHelper) ValidateToken(tokenString string) (jwt.MapClaims, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
 			return nil, ErrUnexpectedSigningMethod
 		}
-		return h.publicKey, nil
-	})
+		return h.verificationKey(token)
+	}, jwt.WithIssuer(h.issuer))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse token: %w", err)
@@ -81,3 +193,68 @@ func (h *JWTHelper) ValidateToken(tokenString string) (jwt.MapClaims, error) {
 
 	return claims, nil
 }
+
+// verificationKey returns the key named by the kid header. Tokens signed before the header was
+// added are checked with the public key.
+func (h *JWTHelper) verificationKey(token *jwt.Token) (*rsa.PublicKey, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		kid = h.keys.legacyKID
+	}
+	key, ok := h.keys.verification[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+// JWKS returns the keys tokens are accepted from, the signing key first.
+func (h *JWTHelper) JWKS() JWKSet {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	kids := make([]string, 0, len(h.keys.verification))
+	for kid := range h.keys.verification {
+		if kid != h.keys.signingKID {
+			kids = append(kids, kid)
+		}
+	}
+	sort.Strings(kids)
+
+	set := JWKSet{Keys: make([]JWK, 0, len(h.keys.verification))}
+	for _, kid := range append([]string{h.keys.signingKID}, kids...) {
+		key := h.keys.verification[kid]
+		set.Keys = append(set.Keys, JWK{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: jwt.SigningMethodRS256.Alg(),
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	return set
+}
+
+func readPublicKey(path string) (*rsa.PublicKey, error) {
+	publicKeyBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key: %w", err)
+	}
+	publicKey, err := jwt.ParseRSAPublicKeyFromPEM(publicKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key %s: %w", path, err)
+	}
+	return publicKey, nil
+}
+
+// keyID returns the JWK thumbprint of the key (RFC 7638), which stays the same across reloads.
+func keyID(key *rsa.PublicKey) string {
+	e := base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+	n := base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+	sum := sha256.Sum256([]byte(`{"e":"` + e + `","kty":"RSA","n":"` + n + `"}`))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,13 @@
+package jwthelper_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestJWTHelper(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "JWT Helper Suite")
+}
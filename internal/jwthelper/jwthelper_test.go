@@ -0,0 +1,174 @@
+package jwthelper_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/jwthelper"
+)
+
+const issuer = "https://flags.example.com"
+
+func writeKeyPair(privatePath, publicPath string) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).ToNot(HaveOccurred())
+
+	private := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	Expect(os.WriteFile(privatePath, private, 0o600)).To(Succeed())
+	writePublicKey(publicPath, &key.PublicKey)
+	return key
+}
+
+func writePublicKey(path string, key *rsa.PublicKey) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600)).To(Succeed())
+}
+
+func kid(token string) string {
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, jwt.MapClaims{})
+	Expect(err).ToNot(HaveOccurred())
+	kid, _ := parsed.Header["kid"].(string)
+	return kid
+}
+
+var _ = Describe("JWTHelper", func() {
+	var (
+		privatePath string
+		publicPath  string
+		keysDir     string
+		currentKey  *rsa.PrivateKey
+		helper      *jwthelper.JWTHelper
+		claims      jwt.MapClaims
+	)
+
+	BeforeEach(func() {
+		dir := GinkgoT().TempDir()
+		privatePath = filepath.Join(dir, "private.pem")
+		publicPath = filepath.Join(dir, "public.pem")
+		keysDir = filepath.Join(dir, "keys")
+		Expect(os.Mkdir(keysDir, 0o700)).To(Succeed())
+		currentKey = writeKeyPair(privatePath, publicPath)
+
+		var err error
+		helper, err = jwthelper.NewJWTHelper(privatePath, publicPath, keysDir, issuer, slog.New(slog.DiscardHandler))
+		Expect(err).ToNot(HaveOccurred())
+
+		claims = jwt.MapClaims{"iss": issuer, "sub": "user", "exp": time.Now().Add(time.Hour).Unix()}
+	})
+
+	It("names the signing key in the tokens", func() {
+		token, err := helper.GenerateToken(claims)
+		Expect(err).ToNot(HaveOccurred())
+
+		keys := helper.JWKS().Keys
+		Expect(keys).To(HaveLen(1))
+		Expect(keys[0].Kid).To(Equal(kid(token)))
+		Expect(keys[0].Alg).To(Equal("RS256"))
+
+		validated, err := helper.ValidateToken(token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(validated["sub"]).To(Equal("user"))
+	})
+
+	It("validates tokens signed before the key ID was added", func() {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(currentKey)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = helper.ValidateToken(token)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rejects tokens signed with an unknown key", func() {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "unknown"
+		signed, err := token.SignedString(currentKey)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = helper.ValidateToken(signed)
+		Expect(err).To(MatchError(jwthelper.ErrUnknownKey))
+	})
+
+	It("rejects the tokens of other issuers", func() {
+		claims["iss"] = "https://other.example.com"
+		token, err := helper.GenerateToken(claims)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = helper.ValidateToken(token)
+		Expect(err).To(MatchError(jwt.ErrTokenInvalidIssuer))
+
+		delete(claims, "iss")
+		token, err = helper.GenerateToken(claims)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = helper.ValidateToken(token)
+		Expect(err).To(MatchError(jwt.ErrTokenRequiredClaimMissing))
+	})
+
+	It("requires an issuer", func() {
+		_, err := jwthelper.NewJWTHelper(privatePath, publicPath, keysDir, "", slog.New(slog.DiscardHandler))
+		Expect(err).To(MatchError("missing token issuer"))
+	})
+
+	Describe("rotating the signing key", func() {
+		var oldToken string
+
+		BeforeEach(func() {
+			var err error
+			oldToken, err = helper.GenerateToken(claims)
+			Expect(err).ToNot(HaveOccurred())
+
+			writePublicKey(filepath.Join(keysDir, "previous.pem"), &currentKey.PublicKey)
+			writeKeyPair(privatePath, publicPath)
+			Expect(helper.Reload()).To(Succeed())
+		})
+
+		It("signs with the new key and still accepts the old one", func() {
+			newToken, err := helper.GenerateToken(claims)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kid(newToken)).ToNot(Equal(kid(oldToken)))
+
+			keys := helper.JWKS().Keys
+			Expect(keys).To(HaveLen(2))
+			Expect(keys[0].Kid).To(Equal(kid(newToken)))
+			Expect(keys[1].Kid).To(Equal(kid(oldToken)))
+
+			_, err = helper.ValidateToken(newToken)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = helper.ValidateToken(oldToken)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("rejects the tokens of the old key once it is retired", func() {
+			Expect(os.Remove(filepath.Join(keysDir, "previous.pem"))).To(Succeed())
+			Expect(helper.Reload()).To(Succeed())
+
+			Expect(helper.JWKS().Keys).To(HaveLen(1))
+			_, err := helper.ValidateToken(oldToken)
+			Expect(err).To(MatchError(jwthelper.ErrUnknownKey))
+		})
+	})
+
+	Context("when the keys cannot be reloaded", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(privatePath, []byte("not a key"), 0o600)).To(Succeed())
+		})
+
+		It("keeps the current keys", func() {
+			Expect(helper.Reload()).ToNot(Succeed())
+
+			token, err := helper.GenerateToken(claims)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = helper.ValidateToken(token)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+const docsPage = `<!DOCTYPE html>
+<html>
+  <head>
+    <title>Feature Flags API</title>
+    <meta charset="utf-8"/>
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+  </head>
+  <body>
+    <redoc spec-url="/openapi.json"></redoc>
+    <script src="https://cdn.redoc.ly/redoc/latest/bundles/redoc.standalone.js"></script>
+  </body>
+</html>
+`
+
+func RegisterHandlers(srv *echo.Echo, spec *Spec, middlewares ...echo.MiddlewareFunc) {
+	srv.GET("/openapi.json", func(c echo.Context) error {
+		return c.JSONBlob(http.StatusOK, spec.Document())
+	}, middlewares...)
+	srv.GET("/docs", func(c echo.Context) error {
+		return c.HTML(http.StatusOK, docsPage)
+	}, middlewares...)
+}
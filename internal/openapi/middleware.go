@@ -0,0 +1,242 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+var printer = message.NewPrinter(language.English)
+
+type Options struct {
+	// ValidateRequests rejects requests that do not match the spec with 400 Bad Request.
+	ValidateRequests bool
+	// ValidateResponses replaces responses that do not match the spec with 500 Internal Server Error.
+	// Every response is buffered, so it is meant for tests.
+	ValidateResponses bool
+}
+
+// Middleware validates the requests and responses of the documented operations against the spec.
+// Routes missing from the spec are passed through.
+func Middleware(spec *Spec, opts Options) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			op, ok := spec.operation(c.Request().Method, c.Path())
+			if !ok {
+				return next(c)
+			}
+
+			if opts.ValidateRequests {
+				if err := op.validateRequest(c); err != nil {
+					return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid request: %v", err))
+				}
+			}
+
+			if !opts.ValidateResponses {
+				return next(c)
+			}
+			return op.validateResponse(c, next)
+		}
+	}
+}
+
+func (op *operation) validateRequest(c echo.Context) error {
+	for _, param := range op.parameters {
+		var values []string
+		switch param.in {
+		case "path":
+			if value := c.Param(param.name); value != "" {
+				values = []string{value}
+			}
+		case "query":
+			values = c.QueryParams()[param.name]
+		case "header":
+			values = c.Request().Header.Values(param.name)
+		default:
+			continue
+		}
+
+		if len(values) == 0 {
+			if param.required {
+				return fmt.Errorf("missing %s parameter %q", param.in, param.name)
+			}
+			continue
+		}
+		if err := param.schema.Validate(param.value(values)); err != nil {
+			return fmt.Errorf("%s parameter %q: %w", param.in, param.name, validationError(err))
+		}
+	}
+
+	if op.body == nil {
+		return nil
+	}
+	req := c.Request()
+	if !strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		// Other content types are left to the handler to reject.
+		return nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		if op.bodyRequired {
+			return errors.New("request body is required")
+		}
+		return nil
+	}
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(body))
+	if err != nil {
+		return errors.New("request body is not valid JSON")
+	}
+	if err := op.body.Validate(instance); err != nil {
+		return fmt.Errorf("request body: %w", validationError(err))
+	}
+	return nil
+}
+
+func (op *operation) validateResponse(c echo.Context, next echo.HandlerFunc) error {
+	res := c.Response()
+	original := res.Writer
+	buffer := &bufferedWriter{header: original.Header()}
+	res.Writer = buffer
+
+	err := next(c)
+	if err != nil {
+		// Render the error into the buffer as well, so that error responses are validated too.
+		c.Error(err)
+	}
+	res.Writer = original
+	if buffer.status == 0 {
+		buffer.status = http.StatusOK
+	}
+
+	if err := op.checkResponse(buffer.status, buffer.body.Bytes()); err != nil {
+		c.Logger().Errorf("%s %s: response does not match the API specification: %v", c.Request().Method, c.Path(), err)
+		original.Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		original.Header().Del(echo.HeaderContentLength)
+		original.WriteHeader(http.StatusInternalServerError)
+		return json.NewEncoder(original).Encode(map[string]string{
+			"message": fmt.Sprintf("response does not match the API specification: %v", err),
+		})
+	}
+
+	original.WriteHeader(buffer.status)
+	_, err = original.Write(buffer.body.Bytes())
+	return err
+}
+
+func (op *operation) checkResponse(status int, body []byte) error {
+	schema, ok := op.responses[strconv.Itoa(status)]
+	if !ok {
+		return fmt.Errorf("status %d is not documented", status)
+	}
+	if schema == nil {
+		if len(bytes.TrimSpace(body)) > 0 {
+			return fmt.Errorf("status %d is documented without a body", status)
+		}
+		return nil
+	}
+
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(body))
+	if err != nil {
+		return errors.New("response body is not valid JSON")
+	}
+	if err := schema.Validate(instance); err != nil {
+		return validationError(err)
+	}
+	return nil
+}
+
+// value converts the raw parameter values to the type of the schema. Values that cannot be converted
+// are kept as strings, so that the schema reports them.
+func (p parameter) value(values []string) any {
+	if p.schemaType != "array" {
+		return convert(values[0], p.schemaType)
+	}
+	items := make([]any, len(values))
+	for i, value := range values {
+		items[i] = convert(value, p.itemsType)
+	}
+	return items
+}
+
+func convert(value, schemaType string) any {
+	switch schemaType {
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return json.Number(value)
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
+// validationError flattens the schema validation error into the failed keywords and their instance locations.
+func validationError(err error) error {
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+
+	messages := failedKeywords(validationErr, nil)
+	if len(messages) == 0 {
+		return err
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
+
+func failedKeywords(err *jsonschema.ValidationError, messages []string) []string {
+	if len(err.Causes) > 0 {
+		for _, cause := range err.Causes {
+			messages = failedKeywords(cause, messages)
+		}
+		return messages
+	}
+
+	switch err.ErrorKind.(type) {
+	case *kind.Group, *kind.Reference, *kind.Schema:
+		return messages
+	}
+	location := "/" + strings.Join(err.InstanceLocation, "/")
+	return append(messages, fmt.Sprintf("at '%s': %s", location, err.ErrorKind.LocalizedString(printer)))
+}
+
+type bufferedWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
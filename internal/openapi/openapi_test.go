@@ -0,0 +1,273 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/labstack/echo/v4"
+)
+
+var _ = Describe("OpenAPI", func() {
+	var (
+		spec     *openapi.Spec
+		e        *echo.Echo
+		recorder *httptest.ResponseRecorder
+		opts     openapi.Options
+		handler  echo.HandlerFunc
+	)
+
+	serve := func(method, target, body string) {
+		request := httptest.NewRequest(method, target, strings.NewReader(body))
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		e.ServeHTTP(recorder, request)
+	}
+
+	BeforeEach(func() {
+		spec = openapi.MustLoad()
+		recorder = httptest.NewRecorder()
+		opts = openapi.Options{}
+		handler = func(c echo.Context) error {
+			return c.NoContent(http.StatusOK)
+		}
+	})
+
+	JustBeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(spec, opts))
+		openapi.RegisterHandlers(e, spec)
+
+		route := func(c echo.Context) error { return handler(c) }
+		e.POST("/auth", route)
+		e.GET("/flags", route)
+		e.GET("/flags/report", route)
+		e.GET("/flags/:id", route)
+		e.PUT("/flags/:id", route)
+		e.GET("/undocumented", route)
+	})
+
+	It("documents the auth, organization, user, MFA, API key, OAuth, flags, change request, kill switch, group and health check routes", func() {
+		Expect(spec.Routes()).To(ConsistOf(
+			"POST /auth",
+			"POST /auth/mfa",
+			"POST /auth/refresh",
+			"POST /auth/logout",
+			"POST /auth/revoke",
+			"GET /.well-known/jwks.json",
+			"GET /.well-known/openid-configuration",
+			"GET /orgs",
+			"POST /orgs",
+			"GET /orgs/:id",
+			"GET /orgs/:id/members",
+			"PUT /orgs/:id/members/:userID",
+			"DELETE /orgs/:id/members/:userID",
+			"GET /invitations",
+			"POST /invitations/:orgID/accept",
+			"DELETE /invitations/:orgID",
+			"GET /users",
+			"POST /users",
+			"GET /users/:id",
+			"DELETE /users/:id",
+			"PUT /users/:id/role",
+			"PUT /users/:id/password",
+			"POST /users/:id/disable",
+			"POST /users/:id/enable",
+			"POST /users/:id/unlock",
+			"DELETE /users/:id/mfa",
+			"GET /users/:id/identities",
+			"POST /users/:id/identities",
+			"DELETE /users/:id/identities/:identityID",
+			"GET /mfa",
+			"POST /mfa/enroll",
+			"POST /mfa/verify",
+			"POST /mfa/recovery-codes",
+			"POST /mfa/disable",
+			"GET /mfa/policy",
+			"PUT /mfa/policy",
+			"GET /api-keys",
+			"POST /api-keys",
+			"DELETE /api-keys/:id",
+			"POST /oauth/token",
+			"POST /oauth/introspect",
+			"POST /oauth/revoke",
+			"GET /oauth/clients",
+			"POST /oauth/clients",
+			"DELETE /oauth/clients/:id",
+			"GET /healthz",
+			"GET /flags",
+			"POST /flags",
+			"GET /flags/report",
+			"GET /flags/overdue",
+			"GET /flags/evaluate/:key",
+			"GET /flags/:id",
+			"PUT /flags/:id",
+			"DELETE /flags/:id",
+			"POST /flags/:id/change-requests",
+			"GET /change-requests",
+			"GET /change-requests/:id",
+			"POST /change-requests/:id/approve",
+			"POST /change-requests/:id/reject",
+			"POST /change-requests/:id/cancel",
+			"POST /change-requests/:id/apply",
+			"PUT /code-references",
+			"GET /kill-switch",
+			"POST /kill-switch",
+			"DELETE /kill-switch",
+			"GET /groups",
+			"POST /groups",
+			"GET /groups/:id",
+			"PUT /groups/:id",
+			"DELETE /groups/:id",
+			"GET /groups/:id/members",
+			"PUT /groups/:id/members/:userID",
+			"DELETE /groups/:id/members/:userID",
+			"GET /access-rules",
+			"POST /access-rules",
+			"DELETE /access-rules/:id",
+		))
+	})
+
+	It("serves the specification", func() {
+		serve(http.MethodGet, "/openapi.json", "")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		var document map[string]any
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &document)).To(Succeed())
+		Expect(document).To(HaveKeyWithValue("openapi", "3.1.0"))
+		Expect(document["paths"]).To(HaveKey("/flags/{id}"))
+	})
+
+	It("serves the docs page", func() {
+		serve(http.MethodGet, "/docs", "")
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(ContainSubstring(`spec-url="/openapi.json"`))
+	})
+
+	When("requests are validated", func() {
+		BeforeEach(func() {
+			opts.ValidateRequests = true
+		})
+
+		It("lets valid requests through with their body", func() {
+			handler = func(c echo.Context) error {
+				var req map[string]string
+				if err := c.Bind(&req); err != nil {
+					return err
+				}
+				return c.JSON(http.StatusOK, map[string]string{"token": req["username"]})
+			}
+			serve(http.MethodPost, "/auth", `{"username": "john", "password": "john"}`)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"token":"john"`))
+		})
+
+		It("rejects a body that does not match the schema", func() {
+			serve(http.MethodPost, "/auth", `{"username": "john"}`)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring("missing property 'password'"))
+		})
+
+		It("rejects a missing required body", func() {
+			serve(http.MethodPost, "/auth", "")
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring("request body is required"))
+		})
+
+		It("rejects a body that is not JSON", func() {
+			serve(http.MethodPost, "/auth", "{")
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring("not valid JSON"))
+		})
+
+		It("rejects invalid path parameters", func() {
+			serve(http.MethodGet, "/flags/not-a-uuid", "")
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring(`path parameter \"id\"`))
+		})
+
+		It("converts query parameters to the type of their schema", func() {
+			serve(http.MethodGet, "/flags/report?stale_days=10", "")
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			recorder = httptest.NewRecorder()
+			serve(http.MethodGet, "/flags/report?stale_days=0", "")
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring("minimum"))
+		})
+
+		It("validates every value of array query parameters", func() {
+			serve(http.MethodGet, "/flags?tag=checkout&tag=", "")
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring(`query parameter \"tag\"`))
+		})
+
+		It("passes undocumented routes through", func() {
+			serve(http.MethodGet, "/undocumented?anything=1", "")
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	When("responses are validated", func() {
+		BeforeEach(func() {
+			opts.ValidateResponses = true
+		})
+
+		It("writes responses that match the spec", func() {
+			handler = func(c echo.Context) error {
+				return c.JSON(http.StatusOK, map[string]any{
+					"token":         "token",
+					"refresh_token": "refresh",
+					"expires_in":    900,
+					"org_id":        uuid.NewString(),
+				})
+			}
+			serve(http.MethodPost, "/auth", `{}`)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"token":"token"`))
+			Expect(recorder.Header().Get(echo.HeaderContentType)).To(HavePrefix(echo.MIMEApplicationJSON))
+		})
+
+		It("validates the error responses of handlers", func() {
+			handler = func(c echo.Context) error {
+				return echo.NewHTTPError(http.StatusUnauthorized, "invalid credentials")
+			}
+			serve(http.MethodPost, "/auth", `{}`)
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			Expect(recorder.Body.String()).To(ContainSubstring("invalid credentials"))
+		})
+
+		It("fails responses that do not match their schema", func() {
+			handler = func(c echo.Context) error {
+				return c.JSON(http.StatusOK, map[string]int{"token": 42})
+			}
+			serve(http.MethodPost, "/auth", `{}`)
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			Expect(recorder.Body.String()).To(ContainSubstring("response does not match the API specification"))
+			Expect(recorder.Body.String()).To(ContainSubstring("at '/token'"))
+		})
+
+		It("fails undocumented status codes", func() {
+			handler = func(c echo.Context) error {
+				return c.NoContent(http.StatusTeapot)
+			}
+			serve(http.MethodPut, "/flags/c9c15117-ca25-49c6-b857-3eb640a61234", `{}`)
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			Expect(recorder.Body.String()).To(ContainSubstring("status 418 is not documented"))
+		})
+
+		It("leaves invalid requests to the handler", func() {
+			handler = func(c echo.Context) error {
+				return echo.NewHTTPError(http.StatusBadRequest, "invalid flag ID")
+			}
+			serve(http.MethodGet, "/flags/not-a-uuid", "")
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Body.String()).To(ContainSubstring("invalid flag ID"))
+		})
+	})
+})
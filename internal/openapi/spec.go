@@ -0,0 +1,273 @@
+package openapi
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"gopkg.in/yaml.v3"
+)
+
+const documentURL = "openapi.json"
+
+//go:embed openapi.yaml
+var specYAML []byte
+
+var httpMethods = []string{"get", "put", "post", "delete", "patch", "head", "options"}
+
+// Spec is the parsed API specification with the schemas of every operation compiled.
+type Spec struct {
+	document []byte
+	// operations are keyed by the HTTP method and the echo route path, e.g. "GET /flags/:id".
+	operations map[string]*operation
+}
+
+type operation struct {
+	parameters   []parameter
+	body         *jsonschema.Schema
+	bodyRequired bool
+	// responses maps the documented status codes to the schema of their JSON body, nil when they have none.
+	responses map[string]*jsonschema.Schema
+}
+
+type parameter struct {
+	name     string
+	in       string
+	required bool
+	// schemaType is the JSON type of the schema, used to convert the raw string values before validation.
+	schemaType string
+	itemsType  string
+	schema     *jsonschema.Schema
+}
+
+func Load() (*Spec, error) {
+	var raw any
+	if err := yaml.Unmarshal(specYAML, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse spec: %w", err)
+	}
+	document, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert spec to JSON: %w", err)
+	}
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(document))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse spec: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	compiler.AssertFormat()
+	if err := compiler.AddResource(documentURL, doc); err != nil {
+		return nil, fmt.Errorf("failed to add spec resource: %w", err)
+	}
+
+	l := &loader{doc: doc, compiler: compiler}
+	operations, err := l.operations()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Spec{document: document, operations: operations}, nil
+}
+
+func MustLoad() *Spec {
+	spec, err := Load()
+	if err != nil {
+		panic(err)
+	}
+	return spec
+}
+
+// Document returns the specification as JSON.
+func (s *Spec) Document() []byte {
+	return s.document
+}
+
+// Routes returns the documented operations as "METHOD /path" in echo route syntax.
+func (s *Spec) Routes() []string {
+	routes := make([]string, 0, len(s.operations))
+	for route := range s.operations {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+	return routes
+}
+
+func (s *Spec) operation(method, path string) (*operation, bool) {
+	op, ok := s.operations[method+" "+path]
+	return op, ok
+}
+
+type loader struct {
+	doc      any
+	compiler *jsonschema.Compiler
+}
+
+func (l *loader) operations() (map[string]*operation, error) {
+	paths, _ := asObject(l.doc)["paths"].(map[string]any)
+	operations := make(map[string]*operation)
+	for path, item := range paths {
+		itemPtr := "/paths/" + escapeToken(path)
+		itemObj := asObject(item)
+
+		shared, err := l.parameters(itemObj["parameters"], itemPtr+"/parameters")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, method := range httpMethods {
+			opObj, ok := itemObj[method].(map[string]any)
+			if !ok {
+				continue
+			}
+			op, err := l.operation(opObj, itemPtr+"/"+method, shared)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), path, err)
+			}
+			operations[strings.ToUpper(method)+" "+echoPath(path)] = op
+		}
+	}
+	return operations, nil
+}
+
+func (l *loader) operation(opObj map[string]any, ptr string, shared []parameter) (*operation, error) {
+	params, err := l.parameters(opObj["parameters"], ptr+"/parameters")
+	if err != nil {
+		return nil, err
+	}
+	op := &operation{
+		parameters: mergeParameters(shared, params),
+		responses:  make(map[string]*jsonschema.Schema),
+	}
+
+	if body, ok := opObj["requestBody"]; ok {
+		bodyObj, bodyPtr := l.resolve(body, ptr+"/requestBody")
+		op.bodyRequired, _ = bodyObj["required"].(bool)
+		if op.body, err = l.jsonContentSchema(bodyObj, bodyPtr); err != nil {
+			return nil, err
+		}
+	}
+
+	responses := asObject(opObj["responses"])
+	for status, response := range responses {
+		respObj, respPtr := l.resolve(response, ptr+"/responses/"+escapeToken(status))
+		schema, err := l.jsonContentSchema(respObj, respPtr)
+		if err != nil {
+			return nil, err
+		}
+		op.responses[status] = schema
+	}
+
+	return op, nil
+}
+
+func (l *loader) parameters(v any, ptr string) ([]parameter, error) {
+	list, _ := v.([]any)
+	params := make([]parameter, 0, len(list))
+	for i, item := range list {
+		paramObj, paramPtr := l.resolve(item, fmt.Sprintf("%s/%d", ptr, i))
+		schemaObj := asObject(paramObj["schema"])
+
+		param := parameter{
+			name:       asString(paramObj["name"]),
+			in:         asString(paramObj["in"]),
+			schemaType: asString(schemaObj["type"]),
+			itemsType:  asString(asObject(schemaObj["items"])["type"]),
+		}
+		param.required, _ = paramObj["required"].(bool)
+
+		schema, err := l.compile(paramPtr + "/schema")
+		if err != nil {
+			return nil, err
+		}
+		param.schema = schema
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+func (l *loader) jsonContentSchema(obj map[string]any, ptr string) (*jsonschema.Schema, error) {
+	content := asObject(obj["content"])
+	media, ok := content["application/json"].(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	if _, ok := media["schema"]; !ok {
+		return nil, nil
+	}
+	return l.compile(ptr + "/content/" + escapeToken("application/json") + "/schema")
+}
+
+// resolve follows a local $ref and returns the referenced object with its JSON pointer.
+func (l *loader) resolve(v any, ptr string) (map[string]any, string) {
+	obj := asObject(v)
+	ref, ok := obj["$ref"].(string)
+	if !ok || !strings.HasPrefix(ref, "#") {
+		return obj, ptr
+	}
+
+	target := l.doc
+	refPtr := strings.TrimPrefix(ref, "#")
+	for _, token := range strings.Split(strings.TrimPrefix(refPtr, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		target = asObject(target)[token]
+	}
+	return l.resolve(target, refPtr)
+}
+
+func (l *loader) compile(ptr string) (*jsonschema.Schema, error) {
+	schema, err := l.compiler.Compile(documentURL + "#" + ptr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile schema %s: %w", ptr, err)
+	}
+	return schema, nil
+}
+
+func mergeParameters(shared, own []parameter) []parameter {
+	params := append([]parameter{}, own...)
+	for _, param := range shared {
+		overridden := false
+		for _, p := range own {
+			if p.name == param.name && p.in == param.in {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			params = append(params, param)
+		}
+	}
+	return params
+}
+
+// echoPath converts an OpenAPI path template to the echo route syntax, "/flags/{id}" to "/flags/:id".
+func echoPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segments[i] = ":" + strings.Trim(segment, "{}")
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// escapeToken escapes a JSON pointer token so that it can also be used in the fragment of the schema URL.
+func escapeToken(token string) string {
+	token = strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+	return url.PathEscape(token)
+}
+
+func asObject(v any) map[string]any {
+	obj, _ := v.(map[string]any)
+	return obj
+}
+
+func asString(v any) string {
+	s, _ := v.(string)
+	return s
+}
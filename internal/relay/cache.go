@@ -0,0 +1,231 @@
+package relay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+)
+
+// Cache holds the last known good flags received from the upstream.
+type Cache struct {
+	// path is the file the cache is persisted to, empty to keep it in memory only.
+	path string
+
+	mu               sync.RWMutex
+	flags            map[uuid.UUID]model.FeatureFlag
+	killSwitchActive bool
+	killSwitchTag    string
+	syncedAt         time.Time
+}
+
+type cacheFile struct {
+	Flags            []model.FeatureFlag `json:"flags"`
+	KillSwitchActive bool                `json:"kill_switch_active"`
+	KillSwitchTag    string              `json:"kill_switch_tag,omitempty"`
+	SyncedAt         time.Time           `json:"synced_at"`
+}
+
+func NewCache(path string) *Cache {
+	return &Cache{path: path, flags: make(map[uuid.UUID]model.FeatureFlag)}
+}
+
+// Load restores the cache from its file. A missing file leaves the cache empty.
+func (c *Cache) Load() error {
+	if c.path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to read cache file: %w", err)
+	}
+
+	var file cacheFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse cache file: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.flags = make(map[uuid.UUID]model.FeatureFlag, len(file.Flags))
+	for _, flag := range file.Flags {
+		c.flags[flag.ID] = flag
+	}
+	c.killSwitchActive = file.KillSwitchActive
+	c.killSwitchTag = file.KillSwitchTag
+	c.syncedAt = file.SyncedAt
+	return nil
+}
+
+// Replace swaps the cached flags for a complete snapshot.
+func (c *Cache) Replace(flags []model.FeatureFlag, killSwitchActive bool, killSwitchTag string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.flags = make(map[uuid.UUID]model.FeatureFlag, len(flags))
+	for _, flag := range flags {
+		c.flags[flag.ID] = flag
+	}
+	c.killSwitchActive = killSwitchActive
+	c.killSwitchTag = killSwitchTag
+	c.syncedAt = time.Now().UTC()
+	return c.persist()
+}
+
+// Apply updates the cache with a change received after the snapshot.
+func (c *Cache) Apply(change model.FlagChange) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch change.Type {
+	case model.FlagChangeCreated, model.FlagChangeUpdated:
+		c.flags[change.Flag.ID] = change.Flag
+	case model.FlagChangeDeleted:
+		delete(c.flags, change.Flag.ID)
+	case model.FlagChangeKillSwitch:
+		c.killSwitchActive = change.KillSwitchActive
+		c.killSwitchTag = change.KillSwitchTag
+	default:
+		return fmt.Errorf("unknown change type %q", change.Type)
+	}
+	c.syncedAt = time.Now().UTC()
+	return c.persist()
+}
+
+// ListFlags returns the flags matching the filter, ordered by key.
+func (c *Cache) ListFlags(filter model.FlagFilter) []model.FeatureFlag {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var flags []model.FeatureFlag
+	for _, flag := range c.flags {
+		if filter.Matches(flag) {
+			flags = append(flags, flag)
+		}
+	}
+	slices.SortFunc(flags, func(a, b model.FeatureFlag) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return flags
+}
+
+func (c *Cache) GetFlagByID(id uuid.UUID) (model.FeatureFlag, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	flag, ok := c.flags[id]
+	if !ok {
+		return model.FeatureFlag{}, model.ErrNotFound
+	}
+	return flag, nil
+}
+
+// GetFlagByKey returns the oldest flag with the key, the same one the API evaluates.
+func (c *Cache) GetFlagByKey(key string) (model.FeatureFlag, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var found *model.FeatureFlag
+	for _, flag := range c.flags {
+		if flag.Key != key {
+			continue
+		}
+		if found == nil || flag.CreatedAt.Before(found.CreatedAt) {
+			found = &flag
+		}
+	}
+	if found == nil {
+		return model.FeatureFlag{}, model.ErrNotFound
+	}
+	return *found, nil
+}
+
+func (c *Cache) EvaluateFlag(key string) (model.FlagEvaluation, error) {
+	flag, err := c.GetFlagByKey(key)
+	if err != nil {
+		return model.FlagEvaluation{}, err
+	}
+
+	c.mu.RLock()
+	killSwitch := model.KillSwitch{Tag: c.killSwitchTag}
+	killed := c.killSwitchActive && killSwitch.Covers(flag)
+	c.mu.RUnlock()
+	if killed {
+		return model.FlagEvaluation{Key: flag.Key, Value: false, Reason: model.EvaluationReasonKillSwitch}, nil
+	}
+	return model.FlagEvaluation{Key: flag.Key, Value: flag.Enabled, Reason: model.EvaluationReasonStatic}, nil
+}
+
+func (c *Cache) KillSwitchActive() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.killSwitchActive
+}
+
+// SyncedAt returns when the cached data was last received from the upstream, zero if it never was.
+func (c *Cache) SyncedAt() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.syncedAt
+}
+
+func (c *Cache) Name() string {
+	return "flags"
+}
+
+// Check fails only until the first snapshot is received or restored from disk. Afterwards the relay
+// keeps serving the cached flags while the upstream is unreachable.
+func (c *Cache) Check() error {
+	if c.SyncedAt().IsZero() {
+		return errors.New("no flags received from the upstream yet")
+	}
+	return nil
+}
+
+// persist writes the cache to a temporary file first, so that a crash never leaves a partial file behind.
+func (c *Cache) persist() error {
+	if c.path == "" {
+		return nil
+	}
+
+	file := cacheFile{
+		Flags:            slices.Collect(maps.Values(c.flags)),
+		KillSwitchActive: c.killSwitchActive,
+		KillSwitchTag:    c.killSwitchTag,
+		SyncedAt:         c.syncedAt,
+	}
+	data, err := json.Marshal(file)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		return fmt.Errorf("failed to replace cache file: %w", err)
+	}
+	return nil
+}
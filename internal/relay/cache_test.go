@@ -0,0 +1,124 @@
+package relay_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/relay"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		cache     *relay.Cache
+		checkout  model.FeatureFlag
+		darkMode  model.FeatureFlag
+		cacheFile string
+	)
+
+	BeforeEach(func() {
+		cacheFile = filepath.Join(GinkgoT().TempDir(), "flags.json")
+		cache = relay.NewCache(cacheFile)
+
+		checkout = model.FeatureFlag{
+			ID:       uuid.New(),
+			Key:      "new_checkout",
+			Enabled:  true,
+			Owner:    "payments",
+			Tags:     []string{"web", "beta"},
+			Kind:     model.FlagKindRelease,
+			Metadata: map[string]string{"jira": "PAY-1"},
+		}
+		darkMode = model.FeatureFlag{
+			ID:        uuid.New(),
+			Key:       "dark_mode",
+			Owner:     "design",
+			Kind:      model.FlagKindExperiment,
+			CreatedAt: time.Now(),
+		}
+		Expect(cache.Replace([]model.FeatureFlag{checkout, darkMode}, false, "")).To(Succeed())
+	})
+
+	It("lists the flags ordered by key", func() {
+		Expect(cache.ListFlags(model.FlagFilter{})).To(Equal([]model.FeatureFlag{darkMode, checkout}))
+	})
+
+	It("filters like the API", func() {
+		Expect(cache.ListFlags(model.FlagFilter{Owner: "payments"})).To(ConsistOf(checkout))
+		Expect(cache.ListFlags(model.FlagFilter{Kind: model.FlagKindExperiment})).To(ConsistOf(darkMode))
+		Expect(cache.ListFlags(model.FlagFilter{Tags: []string{"beta", "web"}})).To(ConsistOf(checkout))
+		Expect(cache.ListFlags(model.FlagFilter{Tags: []string{"mobile"}})).To(BeEmpty())
+		Expect(cache.ListFlags(model.FlagFilter{Metadata: map[string]string{"jira": "PAY-1"}})).To(ConsistOf(checkout))
+		Expect(cache.ListFlags(model.FlagFilter{Metadata: map[string]string{"jira": "PAY-2"}})).To(BeEmpty())
+	})
+
+	It("applies changes", func() {
+		darkMode.Enabled = true
+		Expect(cache.Apply(model.FlagChange{Type: model.FlagChangeUpdated, Flag: darkMode})).To(Succeed())
+		Expect(cache.Apply(model.FlagChange{Type: model.FlagChangeDeleted, Flag: model.FeatureFlag{ID: checkout.ID}})).To(Succeed())
+
+		Expect(cache.ListFlags(model.FlagFilter{})).To(Equal([]model.FeatureFlag{darkMode}))
+		_, err := cache.GetFlagByID(checkout.ID)
+		Expect(err).To(MatchError(model.ErrNotFound))
+	})
+
+	It("evaluates the oldest flag with the key", func() {
+		older := model.FeatureFlag{ID: uuid.New(), Key: "dark_mode", Enabled: true, CreatedAt: time.Now().Add(-time.Hour)}
+		Expect(cache.Apply(model.FlagChange{Type: model.FlagChangeCreated, Flag: older})).To(Succeed())
+
+		Expect(cache.EvaluateFlag("dark_mode")).To(Equal(model.FlagEvaluation{
+			Key: "dark_mode", Value: true, Reason: model.EvaluationReasonStatic,
+		}))
+	})
+
+	It("evaluates every flag off while the kill switch is active", func() {
+		Expect(cache.Apply(model.FlagChange{Type: model.FlagChangeKillSwitch, KillSwitchActive: true})).To(Succeed())
+
+		Expect(cache.EvaluateFlag("new_checkout")).To(Equal(model.FlagEvaluation{
+			Key: "new_checkout", Value: false, Reason: model.EvaluationReasonKillSwitch,
+		}))
+	})
+
+	It("evaluates only the flags with the tag off while a tagged kill switch is active", func() {
+		Expect(cache.Apply(model.FlagChange{Type: model.FlagChangeKillSwitch, KillSwitchActive: true, KillSwitchTag: "beta"})).
+			To(Succeed())
+
+		Expect(cache.EvaluateFlag("new_checkout")).To(HaveField("Reason", model.EvaluationReasonKillSwitch))
+		Expect(cache.EvaluateFlag("dark_mode")).To(HaveField("Reason", model.EvaluationReasonStatic))
+	})
+
+	It("returns not found for unknown keys", func() {
+		_, err := cache.EvaluateFlag("missing")
+		Expect(err).To(MatchError(model.ErrNotFound))
+	})
+
+	It("restores the persisted flags", func() {
+		Expect(cache.Apply(model.FlagChange{Type: model.FlagChangeKillSwitch, KillSwitchActive: true, KillSwitchTag: "beta"})).
+			To(Succeed())
+
+		restored := relay.NewCache(cacheFile)
+		Expect(restored.Check()).ToNot(Succeed())
+		Expect(restored.Load()).To(Succeed())
+
+		Expect(restored.ListFlags(model.FlagFilter{})).To(HaveLen(2))
+		Expect(restored.GetFlagByKey("new_checkout")).To(HaveField("Metadata", checkout.Metadata))
+		Expect(restored.KillSwitchActive()).To(BeTrue())
+		Expect(restored.EvaluateFlag("dark_mode")).To(HaveField("Reason", model.EvaluationReasonStatic))
+		Expect(restored.Check()).To(Succeed())
+	})
+
+	It("starts empty without a cache file", func() {
+		restored := relay.NewCache(filepath.Join(GinkgoT().TempDir(), "missing.json"))
+		Expect(restored.Load()).To(Succeed())
+		Expect(restored.ListFlags(model.FlagFilter{})).To(BeEmpty())
+	})
+
+	It("rejects a corrupt cache file", func() {
+		Expect(os.WriteFile(cacheFile, []byte("{"), 0o600)).To(Succeed())
+		Expect(relay.NewCache(cacheFile).Load()).To(MatchError(ContainSubstring("failed to parse cache file")))
+	})
+})
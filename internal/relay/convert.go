@@ -0,0 +1,60 @@
+package relay
+
+import (
+	"fmt"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	featureflagsv1 "github.com/georgisomnoev/feature-flag-api/internal/gen/featureflags/v1"
+	"github.com/google/uuid"
+)
+
+var flagKinds = map[featureflagsv1.FlagKind]model.FlagKind{
+	featureflagsv1.FlagKind_FLAG_KIND_RELEASE:     model.FlagKindRelease,
+	featureflagsv1.FlagKind_FLAG_KIND_EXPERIMENT:  model.FlagKindExperiment,
+	featureflagsv1.FlagKind_FLAG_KIND_OPS:         model.FlagKindOps,
+	featureflagsv1.FlagKind_FLAG_KIND_PERMISSION:  model.FlagKindPermission,
+	featureflagsv1.FlagKind_FLAG_KIND_KILL_SWITCH: model.FlagKindKillSwitch,
+}
+
+var ownerTypes = map[featureflagsv1.OwnerType]model.OwnerType{
+	featureflagsv1.OwnerType_OWNER_TYPE_USER: model.OwnerTypeUser,
+	featureflagsv1.OwnerType_OWNER_TYPE_TEAM: model.OwnerTypeTeam,
+}
+
+var changeTypes = map[featureflagsv1.FlagEventType]model.FlagChangeType{
+	featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_CREATED:     model.FlagChangeCreated,
+	featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_UPDATED:     model.FlagChangeUpdated,
+	featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_DELETED:     model.FlagChangeDeleted,
+	featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_KILL_SWITCH: model.FlagChangeKillSwitch,
+}
+
+func flagFromProto(flag *featureflagsv1.Flag) (model.FeatureFlag, error) {
+	id, err := uuid.Parse(flag.GetId())
+	if err != nil {
+		return model.FeatureFlag{}, fmt.Errorf("invalid flag ID %q: %w", flag.GetId(), err)
+	}
+
+	result := model.FeatureFlag{
+		ID:          id,
+		Key:         flag.GetKey(),
+		Description: flag.GetDescription(),
+		Enabled:     flag.GetEnabled(),
+		Permanent:   flag.GetPermanent(),
+		Protected:   flag.GetProtected(),
+		Owner:       flag.GetOwner(),
+		OwnerType:   ownerTypes[flag.GetOwnerType()],
+		Maintainers: flag.GetMaintainers(),
+		Tags:        flag.GetTags(),
+		Kind:        flagKinds[flag.GetKind()],
+		IssueURLs:   flag.GetIssueUrls(),
+		Metadata:    flag.GetMetadata(),
+		ManagedBy:   flag.GetManagedBy(),
+		CreatedAt:   flag.GetCreatedAt().AsTime(),
+		UpdatedAt:   flag.GetUpdatedAt().AsTime(),
+	}
+	if flag.GetExpiresAt() != nil {
+		expiresAt := flag.GetExpiresAt().AsTime()
+		result.ExpiresAt = &expiresAt
+	}
+	return result, nil
+}
@@ -0,0 +1,112 @@
+package relay
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const metadataQueryPrefix = "metadata."
+
+// Handler serves the read and evaluation endpoints of the main API from the cache.
+type Handler struct {
+	cache   *Cache
+	apiKeys [][]byte
+}
+
+func NewHandler(cache *Cache, apiKeys []string) *Handler {
+	keys := make([][]byte, len(apiKeys))
+	for i, key := range apiKeys {
+		keys[i] = []byte(key)
+	}
+	return &Handler{cache: cache, apiKeys: keys}
+}
+
+func (h *Handler) RegisterHandlers(srv *echo.Echo) {
+	flagsGroup := srv.Group("/flags", h.apiKeyMiddleware)
+	flagsGroup.GET("", h.listFlags)
+	flagsGroup.GET("/evaluate/:key", h.evaluateFlag)
+	flagsGroup.GET("/:id", h.getFlagByID)
+}
+
+// apiKeyMiddleware accepts the relay's own API keys as bearer tokens, the upstream tokens are not valid here.
+func (h *Handler) apiKeyMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if !ok || key == "" {
+			return echo.NewHTTPError(http.StatusUnauthorized, "missing token")
+		}
+		for _, apiKey := range h.apiKeys {
+			if subtle.ConstantTimeCompare([]byte(key), apiKey) == 1 {
+				return next(c)
+			}
+		}
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid API key")
+	}
+}
+
+func (h *Handler) listFlags(c echo.Context) error {
+	filter := flagFilterFromQuery(c)
+	if err := c.Validate(&filter); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid filter: %w", err))
+	}
+
+	return c.JSON(http.StatusOK, h.cache.ListFlags(filter))
+}
+
+func flagFilterFromQuery(c echo.Context) model.FlagFilter {
+	params := c.QueryParams()
+	filter := model.FlagFilter{
+		Owner:      params.Get("owner"),
+		Maintainer: params.Get("maintainer"),
+		Kind:       model.FlagKind(params.Get("kind")),
+		Tags:       params["tag"],
+	}
+	for name, values := range params {
+		key, ok := strings.CutPrefix(name, metadataQueryPrefix)
+		if !ok || len(values) == 0 {
+			continue
+		}
+		if filter.Metadata == nil {
+			filter.Metadata = map[string]string{}
+		}
+		filter.Metadata[key] = values[0]
+	}
+	return filter
+}
+
+// getFlagByID answers like the main API, but code references are not relayed and always empty.
+func (h *Handler) getFlagByID(c echo.Context) error {
+	flagID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid flag ID")
+	}
+
+	flag, err := h.cache.GetFlagByID(flagID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "feature flag not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, model.FlagDetails{FeatureFlag: flag, CodeReferences: []model.CodeReference{}})
+}
+
+func (h *Handler) evaluateFlag(c echo.Context) error {
+	evaluation, err := h.cache.EvaluateFlag(c.Param("key"))
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, "feature flag not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, evaluation)
+}
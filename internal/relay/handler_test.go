@@ -0,0 +1,122 @@
+package relay_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
+	"github.com/georgisomnoev/feature-flag-api/internal/relay"
+	"github.com/georgisomnoev/feature-flag-api/internal/validator"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		e        *echo.Echo
+		recorder *httptest.ResponseRecorder
+		cache    *relay.Cache
+		request  *http.Request
+		flag     model.FeatureFlag
+	)
+
+	BeforeEach(func() {
+		e = echo.New()
+		e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateResponses: true}))
+		e.Validator = validator.GetValidator()
+		recorder = httptest.NewRecorder()
+
+		flag = model.FeatureFlag{
+			ID:        uuid.New(),
+			Key:       "new_checkout",
+			Enabled:   true,
+			Owner:     "payments",
+			OwnerType: model.OwnerTypeTeam,
+			Kind:      model.FlagKindRelease,
+			CreatedAt: time.Now().UTC(),
+			UpdatedAt: time.Now().UTC(),
+		}
+		cache = relay.NewCache("")
+		Expect(cache.Replace([]model.FeatureFlag{flag}, false, "")).To(Succeed())
+
+		relay.NewHandler(cache, []string{"relay-key", "other-key"}).RegisterHandlers(e)
+		request = httptest.NewRequest(http.MethodGet, "/flags", nil)
+		request.Header.Set(echo.HeaderAuthorization, "Bearer other-key")
+	})
+
+	It("rejects requests without an API key", func() {
+		request.Header.Del(echo.HeaderAuthorization)
+		e.ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		Expect(recorder.Body.String()).To(ContainSubstring("missing token"))
+	})
+
+	It("rejects unknown API keys", func() {
+		request.Header.Set(echo.HeaderAuthorization, "Bearer upstream-token")
+		e.ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		Expect(recorder.Body.String()).To(ContainSubstring("invalid API key"))
+	})
+
+	It("lists the cached flags", func() {
+		e.ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		var flags []model.FeatureFlag
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &flags)).To(Succeed())
+		Expect(flags).To(HaveLen(1))
+		Expect(flags[0].Key).To(Equal("new_checkout"))
+	})
+
+	It("filters the cached flags", func() {
+		request.URL.RawQuery = "owner=design"
+		e.ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON("null"))
+	})
+
+	It("returns a flag by ID", func() {
+		request.URL.Path = "/flags/" + flag.ID.String()
+		e.ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		var details model.FlagDetails
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &details)).To(Succeed())
+		Expect(details.ID).To(Equal(flag.ID))
+		Expect(details.CodeReferences).To(BeEmpty())
+	})
+
+	It("returns not found for unknown flags", func() {
+		request.URL.Path = "/flags/" + uuid.NewString()
+		e.ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		Expect(recorder.Body.String()).To(ContainSubstring("feature flag not found"))
+	})
+
+	It("returns bad request for invalid IDs", func() {
+		request.URL.Path = "/flags/not-a-uuid"
+		e.ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("evaluates flags", func() {
+		request.URL.Path = "/flags/evaluate/new_checkout"
+		e.ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{"key": "new_checkout", "value": true, "reason": "STATIC"}`))
+	})
+
+	It("evaluates flags off while the kill switch is active", func() {
+		Expect(cache.Apply(model.FlagChange{Type: model.FlagChangeKillSwitch, KillSwitchActive: true})).To(Succeed())
+
+		request.URL.Path = "/flags/evaluate/new_checkout"
+		e.ServeHTTP(recorder, request)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{"key": "new_checkout", "value": false, "reason": "KILL_SWITCH"}`))
+	})
+})
@@ -0,0 +1,137 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	featureflagsv1 "github.com/georgisomnoev/feature-flag-api/internal/gen/featureflags/v1"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	minBackoff = time.Second
+	maxBackoff = 30 * time.Second
+)
+
+// Upstream keeps the cache in sync with the WatchFlags stream of the main API. When the stream
+// breaks it reconnects with a backoff, while the cache keeps serving the last known good data.
+// It authenticates with an API key, which unlike an access token does not expire, and declares the
+// environment it serves, so that an upstream of another environment rejects it.
+type Upstream struct {
+	client      featureflagsv1.FeatureFlagServiceClient
+	apiKey      string
+	environment string
+	cache       *Cache
+	logger      echo.Logger
+}
+
+func NewUpstream(
+	client featureflagsv1.FeatureFlagServiceClient,
+	apiKey string,
+	environment string,
+	cache *Cache,
+	logger echo.Logger,
+) *Upstream {
+	return &Upstream{client: client, apiKey: apiKey, environment: environment, cache: cache, logger: logger}
+}
+
+// Run watches the upstream until the context is canceled.
+func (u *Upstream) Run(ctx context.Context) {
+	backoff := minBackoff
+	for {
+		synced, err := u.watch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if synced {
+			// The stream worked before it broke, so start over with the shortest backoff.
+			backoff = minBackoff
+		}
+		u.logger.Errorf("upstream watch failed, serving cached flags and retrying in %s: %v", backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// watch applies the stream to the cache until it breaks and reports whether a snapshot was received.
+func (u *Upstream) watch(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", u.apiKey, "x-environment", u.environment)
+	stream, err := u.client.WatchFlags(ctx, &featureflagsv1.WatchFlagsRequest{})
+	if err != nil {
+		return false, fmt.Errorf("failed to open stream: %w", err)
+	}
+
+	var snapshot []model.FeatureFlag
+	synced := false
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return synced, errors.New("stream closed by the upstream")
+			}
+			return synced, err
+		}
+
+		switch event.GetType() {
+		case featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_SNAPSHOT:
+			flag, err := flagFromProto(event.GetFlag())
+			if err != nil {
+				return synced, err
+			}
+			snapshot = append(snapshot, flag)
+		case featureflagsv1.FlagEventType_FLAG_EVENT_TYPE_SNAPSHOT_COMPLETE:
+			if err := u.cache.Replace(snapshot, event.GetKillSwitchActive(), event.GetKillSwitchTag()); err != nil {
+				u.logger.Errorf("failed to persist flags snapshot: %v", err)
+			}
+			u.logger.Infof("synced %d flags from the upstream", len(snapshot))
+			snapshot = nil
+			synced = true
+		default:
+			if !synced {
+				return false, fmt.Errorf("unexpected %s event before the snapshot completed", event.GetType())
+			}
+			change, err := changeFromProto(event)
+			if err != nil {
+				return synced, err
+			}
+			if err := u.cache.Apply(change); err != nil {
+				u.logger.Errorf("failed to apply %s change: %v", change.Type, err)
+			}
+		}
+	}
+}
+
+func changeFromProto(event *featureflagsv1.WatchFlagsResponse) (model.FlagChange, error) {
+	changeType, ok := changeTypes[event.GetType()]
+	if !ok {
+		return model.FlagChange{}, fmt.Errorf("unknown event type %s", event.GetType())
+	}
+
+	change := model.FlagChange{
+		Type:             changeType,
+		KillSwitchActive: event.GetKillSwitchActive(),
+		KillSwitchTag:    event.GetKillSwitchTag(),
+	}
+	if changeType == model.FlagChangeKillSwitch {
+		return change, nil
+	}
+	flag, err := flagFromProto(event.GetFlag())
+	if err != nil {
+		return model.FlagChange{}, err
+	}
+	change.Flag = flag
+	return change, nil
+}
@@ -0,0 +1,159 @@
+package relay_test
+
+import (
+	"context"
+	"log/slog"
+	"net"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/grpchandler"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/grpchandler/grpchandlerfakes"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/model"
+	featureflagsv1 "github.com/georgisomnoev/feature-flag-api/internal/gen/featureflags/v1"
+	"github.com/georgisomnoev/feature-flag-api/internal/relay"
+	"github.com/georgisomnoev/feature-flag-api/internal/webapi"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var _ = Describe("Upstream", func() {
+	var (
+		ctx         context.Context
+		cancel      context.CancelFunc
+		environment string
+		svc         *grpchandlerfakes.FakeService
+		apiKeys     *grpchandlerfakes.FakeAPIKeyAuthenticator
+		grpcSrv     *webapi.GRPCServer
+		conn        *grpc.ClientConn
+		cache       *relay.Cache
+		changes     chan model.FlagChange
+		flag        model.FeatureFlag
+		done        chan struct{}
+	)
+
+	BeforeEach(func() {
+		environment = "production"
+	})
+
+	JustBeforeEach(func() {
+		svc = &grpchandlerfakes.FakeService{}
+		apiKeys = &grpchandlerfakes.FakeAPIKeyAuthenticator{}
+		authStore := &grpchandlerfakes.FakeAuthStore{}
+		authStore.UserExistsReturns(true, nil)
+		authStore.IsOrgMemberReturns(true, nil)
+		apiKeys.AuthenticateReturns(jwt.MapClaims{
+			"sub":     uuid.NewString(),
+			"org":     uuid.NewString(),
+			"scopes":  []string{"read:flags"},
+			"api_key": uuid.NewString(),
+			"env":     "production",
+		}, nil)
+
+		flag = model.FeatureFlag{ID: uuid.New(), Key: "new_checkout", Enabled: true, Kind: model.FlagKindRelease}
+		changes = make(chan model.FlagChange, 1)
+		svc.ListFlagsReturns([]model.FeatureFlag{flag}, nil)
+		svc.WatchFlagsReturns(changes, nil)
+
+		grpcSrv = webapi.NewGRPCServer(slog.New(slog.DiscardHandler))
+		grpchandler.NewHandler(svc, authStore, &grpchandlerfakes.FakeDenylist{}, &grpchandlerfakes.FakeJWTHelper{}, apiKeys,
+			"production").RegisterHandlers(grpcSrv)
+		listener := bufconn.Listen(1024 * 1024)
+		go func() {
+			defer GinkgoRecover()
+			Expect(grpcSrv.Server().Serve(listener)).To(Succeed())
+		}()
+
+		var err error
+		conn, err = grpc.NewClient(
+			"passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		logger := echo.New().Logger
+		logger.SetOutput(GinkgoWriter)
+		cache = relay.NewCache("")
+		upstream := relay.NewUpstream(featureflagsv1.NewFeatureFlagServiceClient(conn), "ffk_upstream", environment, cache, logger)
+
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			upstream.Run(ctx)
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(BeClosed())
+		Expect(conn.Close()).To(Succeed())
+		grpcSrv.Server().Stop()
+	})
+
+	It("authenticates with the upstream API key", func() {
+		Eventually(apiKeys.AuthenticateCallCount).Should(Equal(1))
+		_, key := apiKeys.AuthenticateArgsForCall(0)
+		Expect(key).To(Equal("ffk_upstream"))
+	})
+
+	Context("when the relay serves another environment than the upstream", func() {
+		BeforeEach(func() {
+			environment = "staging"
+		})
+
+		It("is rejected and caches no flags", func() {
+			Consistently(cache.Check).ShouldNot(Succeed())
+			Expect(svc.ListFlagsCallCount()).To(BeZero())
+			Expect(svc.WatchFlagsCallCount()).To(BeZero())
+		})
+	})
+
+	It("loads the snapshot and applies the changes", func() {
+		Eventually(cache.Check).Should(Succeed())
+		Expect(cache.GetFlagByID(flag.ID)).To(HaveField("Key", "new_checkout"))
+
+		created := model.FeatureFlag{ID: uuid.New(), Key: "dark_mode", Kind: model.FlagKindExperiment}
+		changes <- model.FlagChange{Type: model.FlagChangeCreated, Flag: created}
+		Eventually(func() error {
+			_, err := cache.GetFlagByID(created.ID)
+			return err
+		}).Should(Succeed())
+
+		changes <- model.FlagChange{Type: model.FlagChangeKillSwitch, KillSwitchActive: true}
+		Eventually(cache.KillSwitchActive).Should(BeTrue())
+	})
+
+	It("keeps serving the cached flags while the upstream is down", func() {
+		Eventually(cache.Check).Should(Succeed())
+
+		grpcSrv.Server().Stop()
+
+		Consistently(func() error {
+			_, err := cache.EvaluateFlag("new_checkout")
+			return err
+		}).Should(Succeed())
+		Expect(cache.Check()).To(Succeed())
+	})
+
+	It("resyncs after the stream breaks", func() {
+		Eventually(cache.Check).Should(Succeed())
+
+		// Closing the subscription makes the API abort the stream, like for a watcher that fell behind.
+		replacement := model.FeatureFlag{ID: uuid.New(), Key: "dark_mode", Kind: model.FlagKindExperiment}
+		svc.ListFlagsReturns([]model.FeatureFlag{replacement}, nil)
+		svc.WatchFlagsReturns(make(chan model.FlagChange), nil)
+		close(changes)
+
+		Eventually(func() []model.FeatureFlag {
+			return cache.ListFlags(model.FlagFilter{})
+		}, "5s").Should(ConsistOf(HaveField("ID", replacement.ID)))
+	})
+})
@@ -0,0 +1,90 @@
+CREATE TABLE IF NOT EXISTS users (
+    id TEXT PRIMARY KEY NOT NULL,
+    username TEXT NOT NULL,
+    password TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'editor', 'admin')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_username ON users (username);
+
+INSERT INTO users (id, username, password, role) VALUES
+('0b6e3c1c-8a35-4a37-9d8c-6a2f3f8c1a01', 'ted', '$2a$12$4VS2sXb5iPx/Thy2YBCDfe09CT5zFKBb68A8xiC8TtjYRE1jGaXLG', 'viewer'),
+('0b6e3c1c-8a35-4a37-9d8c-6a2f3f8c1a02', 'mike', '$2a$12$vhaVXeHEWYix8mewZio3PechUVn8NSQYkBVEsWTs0vAHeXtJXvRzq', 'viewer'),
+('0b6e3c1c-8a35-4a37-9d8c-6a2f3f8c1a03', 'john', '$2a$12$I69KxNA/5IZF0lutR4wGl.i2ZxjpAp1QhAujnIZ0Z9roEyhLOs8BO', 'editor'),
+('0b6e3c1c-8a35-4a37-9d8c-6a2f3f8c1a04', 'uncle_bob', '$2a$12$FHTITVO0Gz4xoTqsvUXIP.srslqQNqdQK5NaOuzg0yS9TkRfaA9G2', 'editor'),
+('0b6e3c1c-8a35-4a37-9d8c-6a2f3f8c1a05', 'admin', '$2a$12$afT..jULSH.B76jDd8HgkOWMz3B2d/Qp1dhFJlve7s8G0py4GcjHa', 'admin')
+ON CONFLICT (username) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS feature_flags (
+    id TEXT PRIMARY KEY NOT NULL,
+    key TEXT NOT NULL,
+    description TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    permanent BOOLEAN NOT NULL DEFAULT FALSE,
+    protected BOOLEAN NOT NULL DEFAULT FALSE,
+    owner TEXT NOT NULL DEFAULT '',
+    owner_type TEXT NOT NULL DEFAULT '' CHECK (owner_type IN ('', 'user', 'team')),
+    maintainers TEXT NOT NULL DEFAULT '[]',
+    tags TEXT NOT NULL DEFAULT '[]',
+    kind TEXT NOT NULL DEFAULT 'release' CHECK (kind IN ('release', 'experiment', 'ops', 'permission', 'kill-switch')),
+    issue_urls TEXT NOT NULL DEFAULT '[]',
+    metadata TEXT NOT NULL DEFAULT '{}',
+    managed_by TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    last_evaluated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_feature_flags_key ON feature_flags (key);
+CREATE INDEX IF NOT EXISTS idx_feature_flags_expires_at ON feature_flags (expires_at) WHERE expires_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS change_requests (
+    id TEXT PRIMARY KEY NOT NULL,
+    flag_id TEXT NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('update', 'delete')),
+    payload TEXT,
+    status TEXT NOT NULL CHECK (status IN ('pending', 'approved', 'rejected', 'applied', 'cancelled')),
+    comment TEXT NOT NULL DEFAULT '',
+    author_id TEXT NOT NULL,
+    reviewer_id TEXT,
+    review_comment TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMP,
+    scheduled_at TIMESTAMP,
+    applied_by TEXT,
+    applied_at TIMESTAMP,
+    cancelled_by TEXT,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_change_requests_flag_id ON change_requests (flag_id);
+CREATE INDEX IF NOT EXISTS idx_change_requests_status ON change_requests (status);
+
+CREATE TABLE IF NOT EXISTS kill_switches (
+    id TEXT PRIMARY KEY NOT NULL,
+    reason TEXT NOT NULL,
+    activated_by TEXT NOT NULL,
+    activated_at TIMESTAMP NOT NULL,
+    released_by TEXT,
+    released_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_kill_switches_active ON kill_switches ((released_at IS NULL)) WHERE released_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS code_references (
+    repository TEXT NOT NULL,
+    flag_key TEXT NOT NULL,
+    path TEXT NOT NULL,
+    line INTEGER NOT NULL,
+    PRIMARY KEY (repository, flag_key, path, line)
+);
+
+CREATE INDEX IF NOT EXISTS idx_code_references_flag_key ON code_references (flag_key);
+
+CREATE TABLE IF NOT EXISTS code_reference_scans (
+    repository TEXT PRIMARY KEY NOT NULL,
+    scanned_by TEXT NOT NULL,
+    scanned_at TIMESTAMP NOT NULL
+);
@@ -0,0 +1,68 @@
+CREATE TABLE IF NOT EXISTS organizations (
+    id TEXT PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organizations_name ON organizations (name);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    org_id TEXT NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (org_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_members_user_id ON organization_members (user_id);
+
+-- Everything that existed before organizations were introduced moves to the default organization.
+INSERT INTO organizations (id, name, created_at)
+VALUES ('7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f', 'default', strftime('%Y-%m-%d %H:%M:%f', 'now'))
+ON CONFLICT (id) DO NOTHING;
+
+INSERT INTO organization_members (org_id, user_id, created_at)
+SELECT '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f', id, strftime('%Y-%m-%d %H:%M:%f', 'now') FROM users
+WHERE true
+ON CONFLICT (org_id, user_id) DO NOTHING;
+
+ALTER TABLE feature_flags ADD COLUMN org_id TEXT NOT NULL DEFAULT '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+DROP INDEX IF EXISTS idx_feature_flags_key;
+CREATE INDEX IF NOT EXISTS idx_feature_flags_org_id_key ON feature_flags (org_id, key);
+
+ALTER TABLE change_requests ADD COLUMN org_id TEXT NOT NULL DEFAULT '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+CREATE INDEX IF NOT EXISTS idx_change_requests_org_id ON change_requests (org_id);
+
+ALTER TABLE kill_switches ADD COLUMN org_id TEXT NOT NULL DEFAULT '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+DROP INDEX IF EXISTS idx_kill_switches_active;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_kill_switches_active ON kill_switches (org_id) WHERE released_at IS NULL;
+
+-- SQLite cannot change a primary key in place, so the code reference tables are rebuilt.
+CREATE TABLE code_references_new (
+    org_id TEXT NOT NULL,
+    repository TEXT NOT NULL,
+    flag_key TEXT NOT NULL,
+    path TEXT NOT NULL,
+    line INTEGER NOT NULL,
+    PRIMARY KEY (org_id, repository, flag_key, path, line)
+);
+
+INSERT INTO code_references_new (org_id, repository, flag_key, path, line)
+SELECT '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f', repository, flag_key, path, line FROM code_references;
+
+DROP TABLE code_references;
+ALTER TABLE code_references_new RENAME TO code_references;
+CREATE INDEX IF NOT EXISTS idx_code_references_org_id_flag_key ON code_references (org_id, flag_key);
+
+CREATE TABLE code_reference_scans_new (
+    org_id TEXT NOT NULL,
+    repository TEXT NOT NULL,
+    scanned_by TEXT NOT NULL,
+    scanned_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (org_id, repository)
+);
+
+INSERT INTO code_reference_scans_new (org_id, repository, scanned_by, scanned_at)
+SELECT '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f', repository, scanned_by, scanned_at FROM code_reference_scans;
+
+DROP TABLE code_reference_scans;
+ALTER TABLE code_reference_scans_new RENAME TO code_reference_scans;
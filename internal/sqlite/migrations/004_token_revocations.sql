@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS token_revocations (
+    id TEXT PRIMARY KEY NOT NULL,
+    token_id TEXT,
+    org_id TEXT NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    user_id TEXT,
+    issued_before TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_by TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_token_revocations_expires_at ON token_revocations (expires_at);
@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS groups (
+    id TEXT PRIMARY KEY NOT NULL,
+    org_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_groups_org_id_name ON groups (org_id, name);
+
+CREATE TABLE IF NOT EXISTS group_members (
+    group_id TEXT NOT NULL REFERENCES groups (id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_members_user_id ON group_members (user_id);
+
+CREATE TABLE IF NOT EXISTS flag_access_rules (
+    id TEXT PRIMARY KEY NOT NULL,
+    org_id TEXT NOT NULL,
+    group_id TEXT NOT NULL REFERENCES groups (id) ON DELETE CASCADE,
+    flag_id TEXT REFERENCES feature_flags (id) ON DELETE CASCADE,
+    tag TEXT,
+    permission TEXT NOT NULL CHECK (permission IN ('read', 'write')),
+    created_at TIMESTAMP NOT NULL,
+    CHECK ((flag_id IS NULL) <> (tag IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_flag_access_rules_org_id ON flag_access_rules (org_id);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS login_failures (
+    key TEXT PRIMARY KEY NOT NULL,
+    count INTEGER NOT NULL,
+    last_failed_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_failures_last_failed_at ON login_failures (last_failed_at);
@@ -0,0 +1 @@
+ALTER TABLE kill_switches ADD COLUMN tag TEXT NOT NULL DEFAULT '';
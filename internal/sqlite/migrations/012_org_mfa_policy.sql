@@ -0,0 +1,13 @@
+-- The roles that require MFA are set per organization. Every organization keeps the roles of the
+-- former global policy, and SQLite cannot change a primary key in place, so the table is rebuilt.
+CREATE TABLE mfa_required_roles_new (
+    org_id TEXT NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    role TEXT NOT NULL,
+    PRIMARY KEY (org_id, role)
+);
+
+INSERT INTO mfa_required_roles_new (org_id, role)
+SELECT organizations.id, mfa_required_roles.role FROM organizations CROSS JOIN mfa_required_roles;
+
+DROP TABLE mfa_required_roles;
+ALTER TABLE mfa_required_roles_new RENAME TO mfa_required_roles;
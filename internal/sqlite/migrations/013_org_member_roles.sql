@@ -0,0 +1,20 @@
+-- The role moves from the user to the membership, a user can be an admin of one organization and a
+-- viewer of another. Existing members keep the role they had.
+ALTER TABLE organization_members ADD COLUMN role TEXT NOT NULL DEFAULT 'viewer'
+    CHECK (role IN ('viewer', 'editor', 'admin'));
+
+UPDATE organization_members SET role = (SELECT users.role FROM users WHERE users.id = organization_members.user_id);
+
+ALTER TABLE users DROP COLUMN role;
+
+-- Users join an organization by accepting an invitation, the role is granted on acceptance.
+CREATE TABLE IF NOT EXISTS organization_invitations (
+    org_id TEXT NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'editor', 'admin')),
+    invited_by TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (org_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_invitations_user_id ON organization_invitations (user_id);
@@ -0,0 +1,172 @@
+// Package storage opens the store backend selected by the configuration.
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	authModel "github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	authStore "github.com/georgisomnoev/feature-flag-api/internal/auth/store"
+	authSQLite "github.com/georgisomnoev/feature-flag-api/internal/auth/store/sqlite"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/service"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store"
+	"github.com/georgisomnoev/feature-flag-api/internal/featureflags/store/memory"
+	ffSQLite "github.com/georgisomnoev/feature-flag-api/internal/featureflags/store/sqlite"
+	"github.com/georgisomnoev/feature-flag-api/internal/pg"
+	"github.com/georgisomnoev/feature-flag-api/internal/sqlite"
+	"github.com/georgisomnoev/feature-flag-api/internal/webapi/ratelimit"
+	"github.com/google/uuid"
+)
+
+type Backend string
+
+const (
+	BackendPostgres Backend = "postgres"
+	BackendSQLite   Backend = "sqlite"
+	BackendMemory   Backend = "memory"
+)
+
+type Config struct {
+	Backend     Backend
+	PostgresURL string
+	Postgres    pg.PoolConfig
+	SQLitePath  string
+}
+
+type AuthStore interface {
+	GetByUsername(ctx context.Context, username string) (*authModel.User, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*authModel.User, error)
+	UserExists(ctx context.Context, id uuid.UUID) (bool, error)
+
+	ListUsers(ctx context.Context, orgID uuid.UUID) ([]authModel.User, error)
+	CreateUser(ctx context.Context, user authModel.User, orgID uuid.UUID) error
+	SetUserDisabled(ctx context.Context, id uuid.UUID, disabled bool) error
+	UpdateUserPassword(ctx context.Context, id uuid.UUID, password string) error
+	DeleteUser(ctx context.Context, id uuid.UUID) error
+
+	ListUserOrgs(ctx context.Context, userID uuid.UUID) ([]authModel.Organization, error)
+	IsOrgMember(ctx context.Context, orgID, userID uuid.UUID) (bool, error)
+	GetOrgMemberRole(ctx context.Context, orgID, userID uuid.UUID) (authModel.RoleType, error)
+	CreateOrg(ctx context.Context, org authModel.Organization, ownerID uuid.UUID) error
+	GetOrgByID(ctx context.Context, id uuid.UUID) (authModel.Organization, error)
+	ListOrgMembers(ctx context.Context, orgID uuid.UUID) ([]authModel.OrganizationMember, error)
+	AddOrgMember(ctx context.Context, orgID, userID uuid.UUID, role authModel.RoleType) error
+	UpdateOrgMemberRole(ctx context.Context, orgID, userID uuid.UUID, role authModel.RoleType) error
+	RemoveOrgMember(ctx context.Context, orgID, userID uuid.UUID) error
+
+	CreateOrgInvitation(ctx context.Context, invitation authModel.OrgInvitation) error
+	ListUserInvitations(ctx context.Context, userID uuid.UUID) ([]authModel.OrgInvitation, error)
+	AcceptOrgInvitation(ctx context.Context, orgID, userID uuid.UUID) error
+	DeleteOrgInvitation(ctx context.Context, orgID, userID uuid.UUID) error
+
+	CreateRefreshToken(ctx context.Context, token authModel.RefreshToken) error
+	GetRefreshToken(ctx context.Context, hash string) (authModel.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, hash string, next authModel.RefreshToken) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID uuid.UUID, revokedAt time.Time) error
+	RevokeRefreshTokensIssuedBefore(ctx context.Context, orgID, userID uuid.UUID, issuedBefore, revokedAt time.Time) error
+
+	CreateTokenRevocation(ctx context.Context, revocation authModel.TokenRevocation) error
+	ListTokenRevocations(ctx context.Context, now time.Time) ([]authModel.TokenRevocation, error)
+	DeleteExpiredTokenRevocations(ctx context.Context, now time.Time) error
+
+	CreateAPIKey(ctx context.Context, key authModel.APIKey) error
+	ListAPIKeys(ctx context.Context, orgID uuid.UUID) ([]authModel.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, hash string) (authModel.APIKey, error)
+	RevokeAPIKey(ctx context.Context, orgID, id uuid.UUID, revokedAt time.Time) error
+	TouchAPIKey(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+
+	CreateOAuthClient(ctx context.Context, client authModel.OAuthClient) error
+	ListOAuthClients(ctx context.Context, orgID uuid.UUID) ([]authModel.OAuthClient, error)
+	GetOAuthClient(ctx context.Context, id uuid.UUID) (authModel.OAuthClient, error)
+	DeleteOAuthClient(ctx context.Context, orgID, id uuid.UUID) error
+
+	GetLoginFailures(ctx context.Context, key string) (authModel.LoginFailures, error)
+	RecordLoginFailure(ctx context.Context, key string, failedAt, forgetBefore time.Time) (authModel.LoginFailures, error)
+	ReleaseLoginFailure(ctx context.Context, key string, count int, lastFailedAt time.Time) error
+	ClearLoginFailures(ctx context.Context, key string) error
+
+	GetUserMFA(ctx context.Context, userID uuid.UUID) (authModel.UserMFA, error)
+	SaveUserMFA(ctx context.Context, mfa authModel.UserMFA) error
+	EnableUserMFA(ctx context.Context, userID uuid.UUID, enabledAt time.Time) error
+	UseMFAStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+	DeleteUserMFA(ctx context.Context, userID uuid.UUID) error
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, hashes []string) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string) (bool, error)
+	CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int, error)
+	GetMFAPolicy(ctx context.Context, orgID uuid.UUID) (authModel.MFAPolicy, error)
+	SaveMFAPolicy(ctx context.Context, orgID uuid.UUID, policy authModel.MFAPolicy) error
+
+	CreateExternalIdentity(ctx context.Context, identity authModel.ExternalIdentity) error
+	ListExternalIdentities(ctx context.Context, userID uuid.UUID) ([]authModel.ExternalIdentity, error)
+	DeleteExternalIdentity(ctx context.Context, userID, id uuid.UUID) error
+	GetUserByExternalIdentity(ctx context.Context, issuer, subject string) (*authModel.User, error)
+}
+
+// Storage holds the stores of one backend.
+type Storage struct {
+	FeatureFlags service.Store
+	Auth         AuthStore
+	// RateLimits shares the rate limit counters between the instances, only Postgres has them.
+	RateLimits *ratelimit.PostgresCounter
+
+	ping  func(ctx context.Context) error
+	close func()
+}
+
+func Open(ctx context.Context, cfg Config) (*Storage, error) {
+	switch cfg.Backend {
+	case BackendPostgres:
+		pool, err := pg.InitPool(ctx, cfg.PostgresURL, cfg.Postgres)
+		if err != nil {
+			return nil, fmt.Errorf("failed initializing DB pool: %w", err)
+		}
+		return &Storage{
+			FeatureFlags: store.NewStore(pool),
+			Auth:         authStore.NewStore(pool),
+			RateLimits:   ratelimit.NewPostgresCounter(pool),
+			ping:         pool.Ping,
+			close:        pool.Close,
+		}, nil
+	case BackendSQLite:
+		db, err := sqlite.Open(ctx, cfg.SQLitePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed opening SQLite database: %w", err)
+		}
+		return &Storage{
+			FeatureFlags: ffSQLite.NewStore(db),
+			Auth:         authSQLite.NewStore(db),
+			ping:         db.PingContext,
+			close:        closeDB(db),
+		}, nil
+	case BackendMemory:
+		auth, err := newMemoryAuthStore(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &Storage{
+			FeatureFlags: memory.NewStore(),
+			Auth:         auth,
+			ping:         func(context.Context) error { return nil },
+			close:        func() {},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported storage backend %q", cfg.Backend)
+	}
+}
+
+// Ping checks that the backend is reachable.
+func (s *Storage) Ping(ctx context.Context) error {
+	return s.ping(ctx)
+}
+
+func (s *Storage) Close() {
+	s.close()
+}
+
+func closeDB(db *sql.DB) func() {
+	return func() {
+		_ = db.Close()
+	}
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/model"
+	authMemory "github.com/georgisomnoev/feature-flag-api/internal/auth/store/memory"
+	"github.com/google/uuid"
+)
+
+// defaultUsers seeds the memory backend with the users the SQL migrations create.
+var defaultUsers = []model.User{
+	{
+		ID:       uuid.MustParse("0b6e3c1c-8a35-4a37-9d8c-6a2f3f8c1a01"),
+		Username: "ted",
+		Password: "$2a$12$4VS2sXb5iPx/Thy2YBCDfe09CT5zFKBb68A8xiC8TtjYRE1jGaXLG",
+		Role:     model.RoleViewer,
+	},
+	{
+		ID:       uuid.MustParse("0b6e3c1c-8a35-4a37-9d8c-6a2f3f8c1a02"),
+		Username: "mike",
+		Password: "$2a$12$vhaVXeHEWYix8mewZio3PechUVn8NSQYkBVEsWTs0vAHeXtJXvRzq",
+		Role:     model.RoleViewer,
+	},
+	{
+		ID:       uuid.MustParse("0b6e3c1c-8a35-4a37-9d8c-6a2f3f8c1a03"),
+		Username: "john",
+		Password: "$2a$12$I69KxNA/5IZF0lutR4wGl.i2ZxjpAp1QhAujnIZ0Z9roEyhLOs8BO",
+		Role:     model.RoleEditor,
+	},
+	{
+		ID:       uuid.MustParse("0b6e3c1c-8a35-4a37-9d8c-6a2f3f8c1a04"),
+		Username: "uncle_bob",
+		Password: "$2a$12$FHTITVO0Gz4xoTqsvUXIP.srslqQNqdQK5NaOuzg0yS9TkRfaA9G2",
+		Role:     model.RoleEditor,
+	},
+	{
+		ID:       uuid.MustParse("0b6e3c1c-8a35-4a37-9d8c-6a2f3f8c1a05"),
+		Username: "admin",
+		Password: "$2a$12$afT..jULSH.B76jDd8HgkOWMz3B2d/Qp1dhFJlve7s8G0py4GcjHa",
+		Role:     model.RoleAdmin,
+	},
+}
+
+// newMemoryAuthStore returns a memory store with the default users as members of the default
+// organization with their roles, like the SQL migrations leave the database.
+func newMemoryAuthStore(ctx context.Context) (*authMemory.Store, error) {
+	s := authMemory.NewStore(defaultUsers...)
+	defaultOrg := model.Organization{ID: model.DefaultOrgID, Name: "default", CreatedAt: time.Now().UTC()}
+	// The owner of an organization is its admin.
+	owner := defaultUsers[len(defaultUsers)-1]
+	if err := s.CreateOrg(ctx, defaultOrg, owner.ID); err != nil {
+		return nil, fmt.Errorf("failed creating default organization: %w", err)
+	}
+	for _, user := range defaultUsers {
+		if err := s.AddOrgMember(ctx, model.DefaultOrgID, user.ID, user.Role); err != nil {
+			return nil, fmt.Errorf("failed adding default organization member: %w", err)
+		}
+	}
+	return s, nil
+}
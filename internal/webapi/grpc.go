@@ -0,0 +1,85 @@
+package webapi
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcService struct {
+	desc *grpc.ServiceDesc
+	impl any
+}
+
+// GRPCServer collects the services and interceptors registered by the modules and builds the
+// gRPC server from them on the first call to Server.
+type GRPCServer struct {
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
+	services           []grpcService
+
+	once   sync.Once
+	server *grpc.Server
+}
+
+// NewGRPCServer creates the server, logger receives the panics recovered from the handlers.
+func NewGRPCServer(logger *slog.Logger) *GRPCServer {
+	return &GRPCServer{
+		unaryInterceptors:  []grpc.UnaryServerInterceptor{recoverUnaryInterceptor(logger)},
+		streamInterceptors: []grpc.StreamServerInterceptor{recoverStreamInterceptor(logger)},
+	}
+}
+
+func (s *GRPCServer) UseUnary(interceptors ...grpc.UnaryServerInterceptor) {
+	s.unaryInterceptors = append(s.unaryInterceptors, interceptors...)
+}
+
+func (s *GRPCServer) UseStream(interceptors ...grpc.StreamServerInterceptor) {
+	s.streamInterceptors = append(s.streamInterceptors, interceptors...)
+}
+
+// RegisterService implements grpc.ServiceRegistrar so that the generated Register functions can be used.
+func (s *GRPCServer) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.services = append(s.services, grpcService{desc: desc, impl: impl})
+}
+
+func (s *GRPCServer) Server() *grpc.Server {
+	s.once.Do(func() {
+		s.server = grpc.NewServer(
+			grpc.ChainUnaryInterceptor(s.unaryInterceptors...),
+			grpc.ChainStreamInterceptor(s.streamInterceptors...),
+		)
+		for _, service := range s.services {
+			s.server.RegisterService(service.desc, service.impl)
+		}
+	})
+	return s.server
+}
+
+func recoverUnaryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.ErrorContext(ctx, "recovered from panic", "method", info.FullMethod, "panic", r)
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func recoverStreamInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.ErrorContext(stream.Context(), "recovered from panic", "method", info.FullMethod, "panic", r)
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,51 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type window struct {
+	start time.Time
+	end   time.Time
+	count int
+}
+
+// MemoryCounter counts the requests of a single instance.
+type MemoryCounter struct {
+	mu        sync.Mutex
+	windows   map[string]window
+	lastSweep time.Time
+}
+
+func NewMemoryCounter() *MemoryCounter {
+	return &MemoryCounter{windows: map[string]window{}}
+}
+
+func (m *MemoryCounter) Increment(_ context.Context, key string, windowStart, windowEnd time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sweep(time.Now())
+	current := m.windows[key]
+	if !current.start.Equal(windowStart) {
+		current = window{start: windowStart, end: windowEnd}
+	}
+	current.count++
+	m.windows[key] = current
+	return current.count, nil
+}
+
+// sweep drops the windows that ended, at most once a minute.
+func (m *MemoryCounter) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < time.Minute {
+		return
+	}
+	m.lastSweep = now
+	for key, w := range m.windows {
+		if !w.end.After(now) {
+			delete(m.windows, key)
+		}
+	}
+}
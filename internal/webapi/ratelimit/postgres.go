@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const RateLimitsTable = "rate_limits"
+
+// PostgresCounter counts the requests of every instance sharing the database, so that the limits
+// hold across replicas.
+type PostgresCounter struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresCounter(pool *pgxpool.Pool) *PostgresCounter {
+	return &PostgresCounter{pool: pool}
+}
+
+func (p *PostgresCounter) Increment(ctx context.Context, key string, windowStart, windowEnd time.Time) (int, error) {
+	query := fmt.Sprintf(`INSERT INTO %s (key, window_start, window_end, count) VALUES ($1, $2, $3, 1)
+		ON CONFLICT (key, window_start) DO UPDATE SET count = %[1]s.count + 1
+		RETURNING count`, RateLimitsTable)
+	var count int
+	if err := p.pool.QueryRow(ctx, query, key, windowStart, windowEnd).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// DeleteExpired deletes the windows that ended before now.
+func (p *PostgresCounter) DeleteExpired(ctx context.Context, now time.Time) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE window_end < $1`, RateLimitsTable)
+	_, err := p.pool.Exec(ctx, query, now)
+	return err
+}
+
+// Start deletes the windows that ended at every interval until the context is canceled.
+func (p *PostgresCounter) Start(ctx context.Context, interval time.Duration, logger *slog.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.DeleteExpired(ctx, time.Now()); err != nil {
+				logger.ErrorContext(ctx, "failed to delete expired rate limit windows", "error", err)
+			}
+		}
+	}
+}
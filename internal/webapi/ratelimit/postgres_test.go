@@ -0,0 +1,54 @@
+package ratelimit_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/webapi/ratelimit"
+	testdb "github.com/georgisomnoev/feature-flag-api/test/pg"
+)
+
+var _ = Describe("PostgresCounter", func() {
+	var (
+		ctx         context.Context
+		pool        *pgxpool.Pool
+		counter     *ratelimit.PostgresCounter
+		key         string
+		windowStart time.Time
+	)
+
+	BeforeEach(func() {
+		if !testdb.Enabled() {
+			Skip(testdb.DBConnectionURLEnv + " is not set")
+		}
+		ctx = context.Background()
+		pool = testdb.MustInitDBPool(ctx)
+		DeferCleanup(pool.Close)
+		counter = ratelimit.NewPostgresCounter(pool)
+		key = "read:user:" + uuid.NewString()
+		windowStart = time.Now().Truncate(time.Minute)
+	})
+
+	increment := func(start time.Time) int {
+		count, err := counter.Increment(ctx, key, start, start.Add(time.Minute))
+		Expect(err).ToNot(HaveOccurred())
+		return count
+	}
+
+	It("counts the requests of a key per window", func() {
+		Expect(increment(windowStart)).To(Equal(1))
+		Expect(increment(windowStart)).To(Equal(2))
+		Expect(increment(windowStart.Add(time.Minute))).To(Equal(1))
+	})
+
+	It("deletes the windows that ended", func() {
+		Expect(increment(windowStart.Add(-time.Hour))).To(Equal(1))
+		Expect(counter.DeleteExpired(ctx, windowStart)).To(Succeed())
+		Expect(increment(windowStart.Add(-time.Hour))).To(Equal(1))
+	})
+})
@@ -0,0 +1,128 @@
+// Package ratelimit limits the requests of every caller per group of routes. Callers are told
+// apart by the API key or the user they were authenticated as or, on the routes that do not
+// authenticate, by their IP address.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type Group string
+
+const (
+	GroupAuth     Group = "auth"
+	GroupRead     Group = "read"
+	GroupWrite    Group = "write"
+	GroupEvaluate Group = "evaluate"
+)
+
+const (
+	headerLimit     = "RateLimit-Limit"
+	headerRemaining = "RateLimit-Remaining"
+	headerReset     = "RateLimit-Reset"
+)
+
+// Limit allows Requests per Window. A limit without requests does not limit anything.
+type Limit struct {
+	Requests int
+	Window   time.Duration
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate . Counter
+type Counter interface {
+	// Increment counts a request of the key in the window that starts at windowStart and lasts
+	// until windowEnd, and returns the requests of the window so far.
+	Increment(ctx context.Context, key string, windowStart, windowEnd time.Time) (int, error)
+}
+
+type Limiter struct {
+	limits  map[Group]Limit
+	counter Counter
+}
+
+func NewLimiter(limits map[Group]Limit, counter Counter) *Limiter {
+	return &Limiter{
+		limits:  limits,
+		counter: counter,
+	}
+}
+
+// Middleware counts the requests in fixed windows and answers 429 once a caller used up the limit
+// of the group of the route. Every limited response describes the limit in the RateLimit headers.
+// On the routes that authenticate it has to run after authn.Middleware, whose caller it counts.
+func (l *Limiter) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			group, ok := routeGroup(c)
+			if !ok {
+				return next(c)
+			}
+			limit := l.limits[group]
+			if limit.Requests <= 0 || limit.Window <= 0 {
+				return next(c)
+			}
+
+			now := time.Now()
+			windowStart := now.Truncate(limit.Window)
+			windowEnd := windowStart.Add(limit.Window)
+			key := string(group) + ":" + identity(c)
+			count, err := l.counter.Increment(c.Request().Context(), key, windowStart, windowEnd)
+			if err != nil {
+				// The API stays available when the counter is not.
+				c.Logger().Errorf("failed to count request: %v", err)
+				return next(c)
+			}
+
+			reset := strconv.Itoa(int(math.Ceil(windowEnd.Sub(now).Seconds())))
+			header := c.Response().Header()
+			header.Set(headerLimit, strconv.Itoa(limit.Requests))
+			header.Set(headerRemaining, strconv.Itoa(max(limit.Requests-count, 0)))
+			header.Set(headerReset, reset)
+			if count > limit.Requests {
+				header.Set(echo.HeaderRetryAfter, reset)
+				return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded")
+			}
+			return next(c)
+		}
+	}
+}
+
+// routeGroup returns the group of the matched route. Health checks are not limited.
+func routeGroup(c echo.Context) (Group, bool) {
+	path := c.Path()
+	switch {
+	case path == "/healthz":
+		return "", false
+	case strings.HasPrefix(path, "/auth"), strings.HasPrefix(path, "/oauth/"):
+		return GroupAuth, true
+	case strings.HasPrefix(path, "/flags/evaluate/"):
+		return GroupEvaluate, true
+	case c.Request().Method == http.MethodGet, c.Request().Method == http.MethodHead:
+		return GroupRead, true
+	default:
+		return GroupWrite, true
+	}
+}
+
+// identity returns the key of the caller that authn.Middleware stored. API keys are counted apart
+// from the bearer tokens of the user who created them.
+func identity(c echo.Context) string {
+	caller, err := authn.CallerFromContext(c)
+	if err != nil {
+		return "ip:" + c.RealIP()
+	}
+	if caller.APIKeyID != uuid.Nil {
+		return "apikey:" + caller.APIKeyID.String()
+	}
+	return "user:" + caller.UserID.String()
+}
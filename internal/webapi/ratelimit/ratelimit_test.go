@@ -0,0 +1,184 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/auth/authn"
+	"github.com/georgisomnoev/feature-flag-api/internal/webapi/ratelimit"
+	"github.com/georgisomnoev/feature-flag-api/internal/webapi/ratelimit/ratelimitfakes"
+)
+
+var _ = Describe("Limiter", func() {
+	var (
+		e        *echo.Echo
+		counter  *ratelimitfakes.FakeCounter
+		limits   map[ratelimit.Group]ratelimit.Limit
+		caller   *authn.Caller
+		recorder *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		counter = &ratelimitfakes.FakeCounter{}
+		counter.IncrementReturns(1, nil)
+		limits = map[ratelimit.Group]ratelimit.Limit{
+			ratelimit.GroupAuth:     {Requests: 5, Window: time.Minute},
+			ratelimit.GroupRead:     {Requests: 100, Window: time.Minute},
+			ratelimit.GroupWrite:    {Requests: 10, Window: time.Minute},
+			ratelimit.GroupEvaluate: {Requests: 1000, Window: time.Second},
+		}
+		caller = &authn.Caller{UserID: uuid.MustParse("6f1c1e52-8f7b-4c43-9d7e-0a3c2b9d4e11")}
+		recorder = httptest.NewRecorder()
+	})
+
+	serve := func(method, path string) {
+		e = echo.New()
+		e.IPExtractor = echo.ExtractIPDirect()
+		e.Logger.SetOutput(GinkgoWriter)
+		// authenticate stands in for authn.Middleware, which runs before the limiter.
+		authenticate := func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				if caller != nil {
+					authn.SetCaller(c, *caller)
+				}
+				return next(c)
+			}
+		}
+		limit := ratelimit.NewLimiter(limits, counter).Middleware()
+		ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+		e.POST("/auth", ok, authenticate, limit)
+		e.GET("/flags", ok, authenticate, limit)
+		e.POST("/flags", ok, authenticate, limit)
+		e.GET("/flags/evaluate/:key", ok, authenticate, limit)
+		e.GET("/healthz", ok, limit)
+
+		e.ServeHTTP(recorder, httptest.NewRequest(method, path, nil))
+	}
+
+	It("describes the limit of the route in the headers", func() {
+		counter.IncrementReturns(3, nil)
+		serve(http.MethodGet, "/flags")
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("RateLimit-Limit")).To(Equal("100"))
+		Expect(recorder.Header().Get("RateLimit-Remaining")).To(Equal("97"))
+		reset, err := strconv.Atoi(recorder.Header().Get("RateLimit-Reset"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reset).To(BeNumerically(">=", 1))
+		Expect(reset).To(BeNumerically("<=", 60))
+		Expect(recorder.Header().Get("Retry-After")).To(BeEmpty())
+	})
+
+	It("counts the requests in fixed windows", func() {
+		serve(http.MethodGet, "/flags")
+
+		_, _, windowStart, windowEnd := counter.IncrementArgsForCall(0)
+		Expect(windowStart).To(Equal(windowStart.Truncate(time.Minute)))
+		Expect(windowStart).To(BeTemporally("<=", time.Now()))
+		Expect(windowEnd).To(Equal(windowStart.Add(time.Minute)))
+	})
+
+	It("rejects requests over the limit", func() {
+		counter.IncrementReturns(101, nil)
+		serve(http.MethodGet, "/flags")
+
+		Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(recorder.Header().Get("RateLimit-Remaining")).To(Equal("0"))
+		Expect(recorder.Header().Get("Retry-After")).To(Equal(recorder.Header().Get("RateLimit-Reset")))
+	})
+
+	DescribeTable("limits the routes by group",
+		func(method, path, expectedKey, expectedLimit string) {
+			serve(method, path)
+
+			_, key, _, _ := counter.IncrementArgsForCall(0)
+			Expect(key).To(Equal(expectedKey))
+			Expect(recorder.Header().Get("RateLimit-Limit")).To(Equal(expectedLimit))
+		},
+		Entry("for the login", http.MethodPost, "/auth", "auth:user:6f1c1e52-8f7b-4c43-9d7e-0a3c2b9d4e11", "5"),
+		Entry("for reads", http.MethodGet, "/flags", "read:user:6f1c1e52-8f7b-4c43-9d7e-0a3c2b9d4e11", "100"),
+		Entry("for writes", http.MethodPost, "/flags", "write:user:6f1c1e52-8f7b-4c43-9d7e-0a3c2b9d4e11", "10"),
+		Entry("for evaluations", http.MethodGet, "/flags/evaluate/checkout", "evaluate:user:6f1c1e52-8f7b-4c43-9d7e-0a3c2b9d4e11", "1000"),
+	)
+
+	It("identifies callers with a bearer token by their user", func() {
+		serve(http.MethodGet, "/flags")
+
+		_, key, _, _ := counter.IncrementArgsForCall(0)
+		Expect(key).To(Equal("read:user:6f1c1e52-8f7b-4c43-9d7e-0a3c2b9d4e11"))
+	})
+
+	It("identifies callers with an API key by the key", func() {
+		caller.APIKeyID = uuid.MustParse("0b6f3f0e-3c2a-4d7e-9a51-2f4b8c6d1e73")
+		serve(http.MethodGet, "/flags")
+
+		_, key, _, _ := counter.IncrementArgsForCall(0)
+		Expect(key).To(Equal("read:apikey:0b6f3f0e-3c2a-4d7e-9a51-2f4b8c6d1e73"))
+	})
+
+	It("identifies callers by their IP address on the routes that do not authenticate", func() {
+		caller = nil
+		serve(http.MethodPost, "/auth")
+
+		_, key, _, _ := counter.IncrementArgsForCall(0)
+		Expect(key).To(Equal("auth:ip:192.0.2.1"))
+	})
+
+	It("does not limit groups without a limit", func() {
+		delete(limits, ratelimit.GroupRead)
+		serve(http.MethodGet, "/flags")
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(counter.IncrementCallCount()).To(BeZero())
+		Expect(recorder.Header().Get("RateLimit-Limit")).To(BeEmpty())
+	})
+
+	It("does not limit the health check", func() {
+		serve(http.MethodGet, "/healthz")
+		Expect(counter.IncrementCallCount()).To(BeZero())
+	})
+
+	It("lets requests through when the counter fails", func() {
+		counter.IncrementReturns(0, errors.New("database error"))
+		serve(http.MethodGet, "/flags")
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("RateLimit-Limit")).To(BeEmpty())
+	})
+})
+
+var _ = Describe("MemoryCounter", func() {
+	var (
+		ctx         context.Context
+		counter     *ratelimit.MemoryCounter
+		windowStart time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		counter = ratelimit.NewMemoryCounter()
+		windowStart = time.Now().Truncate(time.Minute)
+	})
+
+	increment := func(key string, start time.Time) int {
+		count, err := counter.Increment(ctx, key, start, start.Add(time.Minute))
+		Expect(err).ToNot(HaveOccurred())
+		return count
+	}
+
+	It("counts the requests of a key per window", func() {
+		Expect(increment("read:ip:192.0.2.1", windowStart)).To(Equal(1))
+		Expect(increment("read:ip:192.0.2.1", windowStart)).To(Equal(2))
+		Expect(increment("read:ip:192.0.2.2", windowStart)).To(Equal(1))
+		Expect(increment("read:ip:192.0.2.1", windowStart.Add(time.Minute))).To(Equal(1))
+	})
+})
@@ -0,0 +1,122 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package ratelimitfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/georgisomnoev/feature-flag-api/internal/webapi/ratelimit"
+)
+
+type FakeCounter struct {
+	IncrementStub        func(context.Context, string, time.Time, time.Time) (int, error)
+	incrementMutex       sync.RWMutex
+	incrementArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+		arg4 time.Time
+	}
+	incrementReturns struct {
+		result1 int
+		result2 error
+	}
+	incrementReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCounter) Increment(arg1 context.Context, arg2 string, arg3 time.Time, arg4 time.Time) (int, error) {
+	fake.incrementMutex.Lock()
+	ret, specificReturn := fake.incrementReturnsOnCall[len(fake.incrementArgsForCall)]
+	fake.incrementArgsForCall = append(fake.incrementArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.IncrementStub
+	fakeReturns := fake.incrementReturns
+	fake.recordInvocation("Increment", []interface{}{arg1, arg2, arg3, arg4})
+	fake.incrementMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCounter) IncrementCallCount() int {
+	fake.incrementMutex.RLock()
+	defer fake.incrementMutex.RUnlock()
+	return len(fake.incrementArgsForCall)
+}
+
+func (fake *FakeCounter) IncrementCalls(stub func(context.Context, string, time.Time, time.Time) (int, error)) {
+	fake.incrementMutex.Lock()
+	defer fake.incrementMutex.Unlock()
+	fake.IncrementStub = stub
+}
+
+func (fake *FakeCounter) IncrementArgsForCall(i int) (context.Context, string, time.Time, time.Time) {
+	fake.incrementMutex.RLock()
+	defer fake.incrementMutex.RUnlock()
+	argsForCall := fake.incrementArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCounter) IncrementReturns(result1 int, result2 error) {
+	fake.incrementMutex.Lock()
+	defer fake.incrementMutex.Unlock()
+	fake.IncrementStub = nil
+	fake.incrementReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCounter) IncrementReturnsOnCall(i int, result1 int, result2 error) {
+	fake.incrementMutex.Lock()
+	defer fake.incrementMutex.Unlock()
+	fake.IncrementStub = nil
+	if fake.incrementReturnsOnCall == nil {
+		fake.incrementReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.incrementReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCounter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCounter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ratelimit.Counter = new(FakeCounter)
@@ -3,9 +3,11 @@ package webapi
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
+	"github.com/georgisomnoev/feature-flag-api/internal/openapi"
 	"github.com/georgisomnoev/feature-flag-api/internal/validator"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -17,7 +19,46 @@ const (
 	contextTimeout          = 5 * time.Second
 )
 
-func NewWebAPI() *echo.Echo {
+// NewWebAPI creates the server of the API. The middlewares run on the routes of the documentation,
+// the modules add them to their own routes.
+func NewWebAPI(ipExtractor echo.IPExtractor, middlewares ...echo.MiddlewareFunc) *echo.Echo {
+	e := newEcho()
+	e.IPExtractor = ipExtractor
+
+	spec := openapi.MustLoad()
+	e.Use(openapi.Middleware(spec, openapi.Options{ValidateRequests: true}))
+	openapi.RegisterHandlers(e, spec, middlewares...)
+
+	return e
+}
+
+// NewRelayWebAPI creates the server of the relay proxy. It serves a subset of the API, so requests are
+// validated against the same spec, but the spec itself is not published.
+func NewRelayWebAPI() *echo.Echo {
+	e := newEcho()
+	e.Use(openapi.Middleware(openapi.MustLoad(), openapi.Options{ValidateRequests: true}))
+	return e
+}
+
+// IPExtractor determines the address of the client. Behind the trusted proxies, given as CIDRs, it
+// is taken from X-Forwarded-For, otherwise it is the address of the connection, so that clients
+// cannot choose it.
+func IPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, cidr := range trustedProxies {
+		_, ipRange, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", cidr, err)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
+func newEcho() *echo.Echo {
 	e := echo.New()
 	e.HideBanner = true
 	e.HidePort = true
@@ -32,7 +73,26 @@ func NewWebAPI() *echo.Echo {
 	return e
 }
 
-func Start(ctx context.Context, e *echo.Echo, apiPort string) {
+// StartHTTP serves only the WebAPI until the context is canceled.
+func StartHTTP(ctx context.Context, e *echo.Echo, apiPort string) {
+	go func() {
+		e.Logger.Infof("starting the WebAPI server on port: %s", apiPort)
+		if err := e.Start(fmt.Sprintf(":%s", apiPort)); err != nil && err != http.ErrServerClosed {
+			e.Logger.Fatalf("failed to start WebAPI server: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+	e.Logger.Info("context canceled, shutting down WebAPI server")
+
+	ctxGrace, cancel := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
+	defer cancel()
+	if err := e.Shutdown(ctxGrace); err != nil {
+		e.Logger.Errorf("failed to shutdown WebAPI server: %v", err)
+	}
+}
+
+func Start(ctx context.Context, e *echo.Echo, apiPort string, grpcSrv *GRPCServer, grpcPort string) {
 	go func() {
 		e.Logger.Infof("starting the WebAPI server on port: %s", apiPort)
 		addr := fmt.Sprintf(":%s", apiPort)
@@ -41,13 +101,38 @@ func Start(ctx context.Context, e *echo.Echo, apiPort string) {
 		}
 	}()
 
+	server := grpcSrv.Server()
+	go func() {
+		e.Logger.Infof("starting the gRPC server on port: %s", grpcPort)
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%s", grpcPort))
+		if err != nil {
+			e.Logger.Fatalf("failed to listen on gRPC port: %v", err)
+		}
+		if err := server.Serve(listener); err != nil {
+			e.Logger.Fatalf("failed to start gRPC server: %v", err)
+		}
+	}()
+
 	<-ctx.Done()
-	e.Logger.Info("context canceled, shutting down WebAPI server")
+	e.Logger.Info("context canceled, shutting down WebAPI and gRPC servers")
 
 	ctxGrace, cancel := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
 	defer cancel()
 
+	grpcStopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(grpcStopped)
+	}()
+
 	if err := e.Shutdown(ctxGrace); err != nil {
 		e.Logger.Errorf("failed to shutdown WebAPI server: %v", err)
 	}
+
+	// Open WatchFlags streams never finish on their own, so they are cut off after the grace period.
+	select {
+	case <-grpcStopped:
+	case <-ctxGrace.Done():
+		server.Stop()
+	}
 }
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE feature_flags DROP COLUMN IF EXISTS last_evaluated_at;
+ALTER TABLE feature_flags DROP COLUMN IF EXISTS permanent;
+
+COMMIT;
\ No newline at end of file
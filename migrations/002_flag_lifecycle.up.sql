@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE feature_flags ADD COLUMN IF NOT EXISTS permanent BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE feature_flags ADD COLUMN IF NOT EXISTS last_evaluated_at TIMESTAMP;
+
+COMMIT;
\ No newline at end of file
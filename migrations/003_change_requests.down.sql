@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS change_requests;
+ALTER TABLE feature_flags DROP COLUMN IF EXISTS protected;
+
+COMMIT;
\ No newline at end of file
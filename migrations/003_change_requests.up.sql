@@ -0,0 +1,27 @@
+BEGIN;
+
+ALTER TABLE feature_flags ADD COLUMN IF NOT EXISTS protected BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS change_requests (
+    id UUID PRIMARY KEY NOT NULL,
+    flag_id UUID NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('update', 'delete')),
+    payload JSONB,
+    status TEXT NOT NULL CHECK (status IN ('pending', 'approved', 'rejected', 'applied', 'cancelled')),
+    comment TEXT NOT NULL DEFAULT '',
+    author_id UUID NOT NULL,
+    reviewer_id UUID,
+    review_comment TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMP,
+    scheduled_at TIMESTAMP,
+    applied_by UUID,
+    applied_at TIMESTAMP,
+    cancelled_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_change_requests_flag_id ON change_requests (flag_id);
+CREATE INDEX IF NOT EXISTS idx_change_requests_status ON change_requests (status);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TABLE IF EXISTS kill_switches;
+
+DELETE FROM users WHERE role = 'admin';
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users ADD CONSTRAINT users_role_check CHECK (role IN ('viewer', 'editor'));
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,21 @@
+BEGIN;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users ADD CONSTRAINT users_role_check CHECK (role IN ('viewer', 'editor', 'admin'));
+
+INSERT INTO users (username, password, role) VALUES
+('admin', '$2a$12$afT..jULSH.B76jDd8HgkOWMz3B2d/Qp1dhFJlve7s8G0py4GcjHa', 'admin')
+ON CONFLICT (username) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS kill_switches (
+    id UUID PRIMARY KEY NOT NULL,
+    reason TEXT NOT NULL,
+    activated_by UUID NOT NULL,
+    activated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    released_by UUID,
+    released_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_kill_switches_active ON kill_switches ((released_at IS NULL)) WHERE released_at IS NULL;
+
+COMMIT;
\ No newline at end of file
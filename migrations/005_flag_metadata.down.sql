@@ -0,0 +1,16 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_feature_flags_metadata;
+DROP INDEX IF EXISTS idx_feature_flags_tags;
+DROP INDEX IF EXISTS idx_feature_flags_owner;
+
+ALTER TABLE feature_flags
+    DROP COLUMN IF EXISTS metadata,
+    DROP COLUMN IF EXISTS issue_urls,
+    DROP COLUMN IF EXISTS kind,
+    DROP COLUMN IF EXISTS tags,
+    DROP COLUMN IF EXISTS maintainers,
+    DROP COLUMN IF EXISTS owner_type,
+    DROP COLUMN IF EXISTS owner;
+
+COMMIT;
\ No newline at end of file
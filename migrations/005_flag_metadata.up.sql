@@ -0,0 +1,16 @@
+BEGIN;
+
+ALTER TABLE feature_flags
+    ADD COLUMN IF NOT EXISTS owner TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS owner_type TEXT NOT NULL DEFAULT '' CHECK (owner_type IN ('', 'user', 'team')),
+    ADD COLUMN IF NOT EXISTS maintainers TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS kind TEXT NOT NULL DEFAULT 'release' CHECK (kind IN ('release', 'experiment', 'ops', 'permission', 'kill-switch')),
+    ADD COLUMN IF NOT EXISTS issue_urls TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_feature_flags_owner ON feature_flags (owner);
+CREATE INDEX IF NOT EXISTS idx_feature_flags_tags ON feature_flags USING GIN (tags);
+CREATE INDEX IF NOT EXISTS idx_feature_flags_metadata ON feature_flags USING GIN (metadata);
+
+COMMIT;
\ No newline at end of file
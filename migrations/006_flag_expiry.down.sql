@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_feature_flags_expires_at;
+ALTER TABLE feature_flags DROP COLUMN IF EXISTS expires_at;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE feature_flags ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_feature_flags_expires_at ON feature_flags (expires_at) WHERE expires_at IS NOT NULL;
+
+COMMIT;
\ No newline at end of file
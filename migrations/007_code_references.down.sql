@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS code_reference_scans;
+DROP TABLE IF EXISTS code_references;
+
+COMMIT;
\ No newline at end of file
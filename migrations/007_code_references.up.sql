@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS code_references (
+    repository TEXT NOT NULL,
+    flag_key TEXT NOT NULL,
+    path TEXT NOT NULL,
+    line INTEGER NOT NULL,
+    PRIMARY KEY (repository, flag_key, path, line)
+);
+
+CREATE INDEX IF NOT EXISTS idx_code_references_flag_key ON code_references (flag_key);
+
+CREATE TABLE IF NOT EXISTS code_reference_scans (
+    repository TEXT PRIMARY KEY NOT NULL,
+    scanned_by UUID NOT NULL,
+    scanned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE feature_flags DROP COLUMN IF EXISTS managed_by;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE feature_flags ADD COLUMN IF NOT EXISTS managed_by VARCHAR(64) NOT NULL DEFAULT '';
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,32 @@
+BEGIN;
+
+-- Data of every organization other than the default one is dropped, it would clash once merged.
+DELETE FROM code_reference_scans WHERE org_id <> '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+ALTER TABLE code_reference_scans DROP CONSTRAINT IF EXISTS code_reference_scans_pkey;
+ALTER TABLE code_reference_scans DROP COLUMN IF EXISTS org_id;
+ALTER TABLE code_reference_scans ADD PRIMARY KEY (repository);
+
+DELETE FROM code_references WHERE org_id <> '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+DROP INDEX IF EXISTS idx_code_references_org_id_flag_key;
+ALTER TABLE code_references DROP CONSTRAINT IF EXISTS code_references_pkey;
+ALTER TABLE code_references DROP COLUMN IF EXISTS org_id;
+ALTER TABLE code_references ADD PRIMARY KEY (repository, flag_key, path, line);
+CREATE INDEX IF NOT EXISTS idx_code_references_flag_key ON code_references (flag_key);
+
+DELETE FROM kill_switches WHERE org_id <> '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+DROP INDEX IF EXISTS idx_kill_switches_active;
+ALTER TABLE kill_switches DROP COLUMN IF EXISTS org_id;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_kill_switches_active ON kill_switches ((released_at IS NULL)) WHERE released_at IS NULL;
+
+DELETE FROM change_requests WHERE org_id <> '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+DROP INDEX IF EXISTS idx_change_requests_org_id;
+ALTER TABLE change_requests DROP COLUMN IF EXISTS org_id;
+
+DELETE FROM feature_flags WHERE org_id <> '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+DROP INDEX IF EXISTS idx_feature_flags_org_id_key;
+ALTER TABLE feature_flags DROP COLUMN IF EXISTS org_id;
+
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
+
+COMMIT;
@@ -0,0 +1,53 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS organizations (
+    id UUID PRIMARY KEY NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organizations_name ON organizations (name);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    org_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (org_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_members_user_id ON organization_members (user_id);
+
+-- Everything that existed before organizations were introduced moves to the default organization.
+INSERT INTO organizations (id, name) VALUES ('7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f', 'default')
+ON CONFLICT (id) DO NOTHING;
+
+INSERT INTO organization_members (org_id, user_id)
+SELECT '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f', id FROM users
+ON CONFLICT (org_id, user_id) DO NOTHING;
+
+ALTER TABLE feature_flags ADD COLUMN IF NOT EXISTS org_id UUID NOT NULL DEFAULT '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+ALTER TABLE feature_flags ALTER COLUMN org_id DROP DEFAULT;
+CREATE INDEX IF NOT EXISTS idx_feature_flags_org_id_key ON feature_flags (org_id, key);
+
+ALTER TABLE change_requests ADD COLUMN IF NOT EXISTS org_id UUID NOT NULL DEFAULT '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+ALTER TABLE change_requests ALTER COLUMN org_id DROP DEFAULT;
+CREATE INDEX IF NOT EXISTS idx_change_requests_org_id ON change_requests (org_id);
+
+ALTER TABLE kill_switches ADD COLUMN IF NOT EXISTS org_id UUID NOT NULL DEFAULT '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+ALTER TABLE kill_switches ALTER COLUMN org_id DROP DEFAULT;
+DROP INDEX IF EXISTS idx_kill_switches_active;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_kill_switches_active ON kill_switches (org_id) WHERE released_at IS NULL;
+
+ALTER TABLE code_references ADD COLUMN IF NOT EXISTS org_id UUID NOT NULL DEFAULT '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+ALTER TABLE code_references ALTER COLUMN org_id DROP DEFAULT;
+ALTER TABLE code_references DROP CONSTRAINT IF EXISTS code_references_pkey;
+ALTER TABLE code_references ADD PRIMARY KEY (org_id, repository, flag_key, path, line);
+DROP INDEX IF EXISTS idx_code_references_flag_key;
+CREATE INDEX IF NOT EXISTS idx_code_references_org_id_flag_key ON code_references (org_id, flag_key);
+
+ALTER TABLE code_reference_scans ADD COLUMN IF NOT EXISTS org_id UUID NOT NULL DEFAULT '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+ALTER TABLE code_reference_scans ALTER COLUMN org_id DROP DEFAULT;
+ALTER TABLE code_reference_scans DROP CONSTRAINT IF EXISTS code_reference_scans_pkey;
+ALTER TABLE code_reference_scans ADD PRIMARY KEY (org_id, repository);
+
+COMMIT;
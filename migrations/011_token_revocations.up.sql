@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS token_revocations (
+    id UUID PRIMARY KEY NOT NULL,
+    token_id VARCHAR(64),
+    org_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    user_id UUID,
+    issued_before TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_token_revocations_expires_at ON token_revocations (expires_at);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS flag_access_rules;
+DROP TABLE IF EXISTS group_members;
+DROP TABLE IF EXISTS groups;
+
+COMMIT;
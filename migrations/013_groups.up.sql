@@ -0,0 +1,35 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS groups (
+    id UUID PRIMARY KEY NOT NULL,
+    org_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_groups_org_id_name ON groups (org_id, name);
+
+CREATE TABLE IF NOT EXISTS group_members (
+    group_id UUID NOT NULL REFERENCES groups (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_members_user_id ON group_members (user_id);
+
+CREATE TABLE IF NOT EXISTS flag_access_rules (
+    id UUID PRIMARY KEY NOT NULL,
+    org_id UUID NOT NULL,
+    group_id UUID NOT NULL REFERENCES groups (id) ON DELETE CASCADE,
+    flag_id UUID REFERENCES feature_flags (id) ON DELETE CASCADE,
+    tag VARCHAR(64),
+    permission VARCHAR(16) NOT NULL CHECK (permission IN ('read', 'write')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((flag_id IS NULL) <> (tag IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_flag_access_rules_org_id ON flag_access_rules (org_id);
+
+COMMIT;
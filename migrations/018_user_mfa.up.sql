@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS user_mfa (
+    user_id UUID PRIMARY KEY NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    secret VARCHAR(64) NOT NULL,
+    enabled_at TIMESTAMP,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+    user_id UUID NOT NULL REFERENCES user_mfa (user_id) ON DELETE CASCADE,
+    hash VARCHAR(64) NOT NULL,
+    PRIMARY KEY (user_id, hash)
+);
+
+CREATE TABLE IF NOT EXISTS mfa_required_roles (
+    role TEXT PRIMARY KEY NOT NULL
+);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE kill_switches DROP COLUMN IF EXISTS tag;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE kill_switches ADD COLUMN IF NOT EXISTS tag VARCHAR(64) NOT NULL DEFAULT '';
+
+COMMIT;
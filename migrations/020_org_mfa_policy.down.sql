@@ -0,0 +1,9 @@
+BEGIN;
+
+-- Only the policy of the default organization survives as the global one.
+ALTER TABLE mfa_required_roles DROP CONSTRAINT IF EXISTS mfa_required_roles_pkey;
+DELETE FROM mfa_required_roles WHERE org_id <> '7d0c4a3e-2f1b-4c5d-9e8f-0a1b2c3d4e5f';
+ALTER TABLE mfa_required_roles DROP COLUMN IF EXISTS org_id;
+ALTER TABLE mfa_required_roles ADD PRIMARY KEY (role);
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+-- The roles that require MFA are set per organization. Every organization keeps the roles of the
+-- former global policy.
+ALTER TABLE mfa_required_roles ADD COLUMN IF NOT EXISTS org_id UUID REFERENCES organizations (id) ON DELETE CASCADE;
+ALTER TABLE mfa_required_roles DROP CONSTRAINT IF EXISTS mfa_required_roles_pkey;
+
+INSERT INTO mfa_required_roles (org_id, role)
+SELECT organizations.id, mfa_required_roles.role FROM organizations CROSS JOIN mfa_required_roles
+WHERE mfa_required_roles.org_id IS NULL;
+
+DELETE FROM mfa_required_roles WHERE org_id IS NULL;
+
+ALTER TABLE mfa_required_roles ALTER COLUMN org_id SET NOT NULL;
+ALTER TABLE mfa_required_roles ADD PRIMARY KEY (org_id, role);
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+DROP TABLE IF EXISTS organization_invitations;
+
+-- Users get back a single role, the highest one of their memberships.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'viewer'
+    CHECK (role IN ('viewer', 'editor', 'admin'));
+
+UPDATE users SET role = CASE
+    WHEN EXISTS (SELECT 1 FROM organization_members m WHERE m.user_id = users.id AND m.role = 'admin') THEN 'admin'
+    WHEN EXISTS (SELECT 1 FROM organization_members m WHERE m.user_id = users.id AND m.role = 'editor') THEN 'editor'
+    ELSE 'viewer'
+END;
+
+ALTER TABLE users ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE organization_members DROP COLUMN IF EXISTS role;
+
+COMMIT;
@@ -0,0 +1,25 @@
+BEGIN;
+
+-- The role moves from the user to the membership, a user can be an admin of one organization and a
+-- viewer of another. Existing members keep the role they had.
+ALTER TABLE organization_members ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'viewer'
+    CHECK (role IN ('viewer', 'editor', 'admin'));
+
+UPDATE organization_members SET role = users.role FROM users WHERE users.id = organization_members.user_id;
+
+ALTER TABLE organization_members ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE users DROP COLUMN IF EXISTS role;
+
+-- Users join an organization by accepting an invitation, the role is granted on acceptance.
+CREATE TABLE IF NOT EXISTS organization_invitations (
+    org_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'editor', 'admin')),
+    invited_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (org_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_invitations_user_id ON organization_invitations (user_id);
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- Links the subjects of OIDC providers to the local users their tokens act as. A subject is only
+-- unique within its issuer.
+CREATE TABLE IF NOT EXISTS external_identities (
+    id UUID PRIMARY KEY NOT NULL,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    issuer TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (issuer, subject)
+);
+
+CREATE INDEX IF NOT EXISTS idx_external_identities_user_id ON external_identities (user_id);
+
+COMMIT;